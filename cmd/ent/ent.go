@@ -18,8 +18,11 @@ func main() {
 	cmd.AddCommand(
 		base.NewCmd(),
 		base.DescribeCmd(),
+		base.DiffCmd(),
+		base.DocCmd(),
 		base.GenerateCmd(),
 		base.InitCmd(),
+		base.SnapshotCmd(),
 	)
 	_ = cmd.Execute()
 }
@@ -22,8 +22,11 @@ func main() {
 	cmd.AddCommand(
 		base.NewCmd(),
 		base.DescribeCmd(),
+		base.DiffCmd(),
+		base.DocCmd(),
 		base.GenerateCmd(migrate),
 		base.InitCmd(),
+		base.SnapshotCmd(),
 	)
 	_ = cmd.Execute()
 }
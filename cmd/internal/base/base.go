@@ -11,12 +11,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"text/template"
 	"unicode"
 
 	"entgo.io/ent/cmd/internal/printer"
+	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
@@ -140,6 +142,167 @@ func DescribeCmd() *cobra.Command {
 	}
 }
 
+// DocCmd returns the doc command for ent/c packages.
+func DocCmd() *cobra.Command {
+	var (
+		format string
+		output string
+		cmd    = &cobra.Command{
+			Use:   "doc [flags] path",
+			Short: "generate a documentation of the database schema",
+			Example: examples(
+				"ent doc ./ent/schema",
+				"ent doc --format html --output schema.html ./ent/schema",
+			),
+			Args: cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, path []string) {
+				graph, err := entc.LoadGraph(path[0], &gen.Config{})
+				if err != nil {
+					log.Fatalln(err)
+				}
+				w := os.Stdout
+				if output != "" {
+					if w, err = os.Create(output); err != nil {
+						log.Fatalln(err)
+					}
+					defer w.Close()
+				}
+				if err := printer.FprintDoc(w, graph, format); err != nil {
+					log.Fatalln(err)
+				}
+			},
+		}
+	)
+	cmd.Flags().StringVar(&format, "format", printer.Markdown, "output format of the documentation (md or html)")
+	cmd.Flags().StringVar(&output, "output", "", "output file of the documentation (defaults to stdout)")
+	return cmd
+}
+
+// DiffCmd returns the diff command for ent/c packages.
+func DiffCmd() *cobra.Command {
+	var (
+		base string
+		cmd  = &cobra.Command{
+			Use:   "diff [flags] path",
+			Short: "report the changes of the graph schema compared to a git revision",
+			Example: examples(
+				"ent diff ./ent/schema",
+				"ent diff --base origin/master ./ent/schema",
+			),
+			Args: cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, path []string) {
+				from, err := loadRevision(path[0], base)
+				if err != nil {
+					log.Fatalln(fmt.Errorf("ent/diff: load revision %s: %w", base, err))
+				}
+				to, err := entc.LoadGraph(path[0], &gen.Config{})
+				if err != nil {
+					log.Fatalln(err)
+				}
+				var breaking int
+				for _, c := range gen.DiffGraphs(from, to) {
+					if c.Breaking {
+						breaking++
+					}
+					fmt.Println(c)
+				}
+				if breaking > 0 {
+					log.Fatalf("ent/diff: found %d breaking changes\n", breaking)
+				}
+			},
+		}
+	)
+	cmd.Flags().StringVar(&base, "base", "HEAD", "git revision to compare the schema with")
+	return cmd
+}
+
+// SnapshotCmd returns the snapshot command for ent/c packages.
+func SnapshotCmd() *cobra.Command {
+	var (
+		output string
+		cmd    = &cobra.Command{
+			Use:   "snapshot [flags] path",
+			Short: "write a JSON snapshot of the database tables for detecting schema drifts",
+			Example: examples(
+				"ent snapshot ./ent/schema",
+				"ent snapshot --output schema.json ./ent/schema",
+			),
+			Args: cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, path []string) {
+				graph, err := entc.LoadGraph(path[0], &gen.Config{})
+				if err != nil {
+					log.Fatalln(err)
+				}
+				tables, err := graph.Tables()
+				if err != nil {
+					log.Fatalln(err)
+				}
+				b, err := schema.MarshalSnapshot(tables)
+				if err != nil {
+					log.Fatalln(err)
+				}
+				b = append(b, '\n')
+				if output == "" {
+					_, err = os.Stdout.Write(b)
+				} else {
+					err = os.WriteFile(output, b, 0644)
+				}
+				if err != nil {
+					log.Fatalln(err)
+				}
+			},
+		}
+	)
+	cmd.Flags().StringVar(&output, "output", "", "output file of the snapshot (defaults to stdout)")
+	return cmd
+}
+
+// loadRevision loads the graph of the schema path in the given git revision.
+// The revision is checked out in a temporary worktree, and the schema is loaded
+// from the same relative location of the working directory.
+func loadRevision(path, rev string) (_ *gen.Graph, err error) {
+	out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return nil, fmt.Errorf("resolve git root: %w", err)
+	}
+	root := strings.TrimSpace(string(out))
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if wd, err = filepath.EvalSymlinks(wd); err != nil {
+		return nil, err
+	}
+	rel, err := filepath.Rel(root, wd)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "ent-diff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if out, err := exec.Command("git", "worktree", "add", "--detach", dir, rev).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("git worktree add: %w: %s", err, out)
+	}
+	defer func() {
+		if out, rerr := exec.Command("git", "worktree", "remove", "--force", dir).CombinedOutput(); rerr != nil && err == nil {
+			err = fmt.Errorf("git worktree remove: %w: %s", rerr, out)
+		}
+	}()
+	// Schemas are loaded relative to the working directory.
+	if err := os.Chdir(filepath.Join(dir, rel)); err != nil {
+		return nil, err
+	}
+	defer os.Chdir(wd)
+	if filepath.IsAbs(path) {
+		if path, err = filepath.Rel(wd, path); err != nil {
+			return nil, err
+		}
+	}
+	return entc.LoadGraph(path, &gen.Config{})
+}
+
 // GenerateCmd returns the generate command for ent/c packages.
 func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 	var (
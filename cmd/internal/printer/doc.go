@@ -0,0 +1,236 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package printer
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/entc/gen"
+)
+
+// Doc formats supported by FprintDoc.
+const (
+	Markdown = "md"
+	HTML     = "html"
+)
+
+// FprintDoc writes a documentation of the database schema of the graph
+// to the given writer, in the given format (Markdown or HTML). It describes
+// all tables, including their columns, indexes and foreign-keys.
+func FprintDoc(w io.Writer, g *gen.Graph, format string) error {
+	tables, err := docTables(g)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case Markdown:
+		return mdTmpl.Execute(w, tables)
+	case HTML:
+		return htmlTmpl.Execute(w, tables)
+	default:
+		return fmt.Errorf("unsupported doc format %q", format)
+	}
+}
+
+type (
+	// docTable describes a table in the documentation.
+	docTable struct {
+		Name, Comment string
+		PrimaryKey    []string
+		Columns       []docColumn
+		Indexes       []docIndex
+		ForeignKeys   []docForeignKey
+	}
+	// docColumn describes a table column.
+	docColumn struct {
+		Name, Type, Default, Comment string
+		Nullable, Unique             bool
+	}
+	// docIndex describes a table index.
+	docIndex struct {
+		Name    string
+		Columns []string
+		Unique  bool
+	}
+	// docForeignKey describes a table foreign-key.
+	docForeignKey struct {
+		Symbol, RefTable, OnDelete string
+		Columns, RefColumns        []string
+	}
+)
+
+// docTables returns the tables of the graph along with
+// their comments that were defined in the ent schema.
+func docTables(g *gen.Graph) ([]docTable, error) {
+	tables, err := g.Tables()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		typeC  = make(map[string]string)
+		fieldC = make(map[string]map[string]string)
+	)
+	for _, n := range g.Nodes {
+		comments := make(map[string]string)
+		if n.ID != nil {
+			comments[n.ID.StorageKey()] = n.ID.Comment()
+		}
+		for _, f := range n.Fields {
+			comments[f.StorageKey()] = f.Comment()
+		}
+		typeC[n.Table()], fieldC[n.Table()] = n.Name, comments
+	}
+	docs := make([]docTable, 0, len(tables))
+	for _, t := range tables {
+		d := docTable{Name: t.Name, Comment: t.Comment, PrimaryKey: columnNames(t.PrimaryKey)}
+		if d.Comment == "" && typeC[t.Name] != "" {
+			d.Comment = fmt.Sprintf("Stores the %s entities.", typeC[t.Name])
+		}
+		for _, c := range t.Columns {
+			dc := docColumn{
+				Name:     c.Name,
+				Type:     columnType(c),
+				Nullable: c.Nullable,
+				Unique:   c.Unique,
+				Comment:  c.Comment,
+			}
+			if c.Default != nil {
+				dc.Default = fmt.Sprint(c.Default)
+			}
+			if dc.Comment == "" {
+				dc.Comment = fieldC[t.Name][c.Name]
+			}
+			d.Columns = append(d.Columns, dc)
+		}
+		for _, idx := range t.Indexes {
+			d.Indexes = append(d.Indexes, docIndex{Name: idx.Name, Columns: columnNames(idx.Columns), Unique: idx.Unique})
+		}
+		for _, fk := range t.ForeignKeys {
+			d.ForeignKeys = append(d.ForeignKeys, docForeignKey{
+				Symbol:     fk.Symbol,
+				RefTable:   fk.RefTable.Name,
+				OnDelete:   string(fk.OnDelete),
+				Columns:    columnNames(fk.Columns),
+				RefColumns: columnNames(fk.RefColumns),
+			})
+		}
+		docs = append(docs, d)
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].Name < docs[j].Name })
+	return docs, nil
+}
+
+// columnType returns the description of the column type.
+func columnType(c *schema.Column) string {
+	typ := c.Type.String()
+	switch {
+	case len(c.Enums) > 0:
+		typ = "enum(" + strings.Join(c.Enums, ", ") + ")"
+	case c.Size > 0:
+		typ += fmt.Sprintf("(%d)", c.Size)
+	}
+	return typ
+}
+
+// columnNames returns the names of the given columns.
+func columnNames(columns []*schema.Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+var (
+	docFuncs = template.FuncMap{
+		"join": strings.Join,
+		"mdEscape": func(s string) string {
+			return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+		},
+	}
+	mdTmpl = template.Must(template.New("md").Funcs(docFuncs).Parse(`# Database Schema
+{{ range $t := . }}
+## {{ $t.Name }}
+{{ with $t.Comment }}
+{{ mdEscape . }}
+{{ end }}
+| Column | Type | Nullable | Unique | Default | Comment |
+|--------|------|----------|--------|---------|---------|
+{{- range $c := $t.Columns }}
+| {{ $c.Name }} | {{ mdEscape $c.Type }} | {{ $c.Nullable }} | {{ $c.Unique }} | {{ mdEscape $c.Default }} | {{ mdEscape $c.Comment }} |
+{{- end }}
+{{ with $t.PrimaryKey }}
+Primary key: {{ join . ", " }}
+{{ end }}
+{{- with $t.Indexes }}
+### Indexes
+
+| Name | Columns | Unique |
+|------|---------|--------|
+{{- range $idx := . }}
+| {{ $idx.Name }} | {{ join $idx.Columns ", " }} | {{ $idx.Unique }} |
+{{- end }}
+{{ end }}
+{{- with $t.ForeignKeys }}
+### Foreign Keys
+
+| Name | Columns | References | On Delete |
+|------|---------|------------|-----------|
+{{- range $fk := . }}
+| {{ $fk.Symbol }} | {{ join $fk.Columns ", " }} | [{{ $fk.RefTable }}](#{{ $fk.RefTable }}) ({{ join $fk.RefColumns ", " }}) | {{ $fk.OnDelete }} |
+{{- end }}
+{{ end }}
+{{- end }}`))
+	htmlTmpl = htmltemplate.Must(htmltemplate.New("html").Funcs(htmltemplate.FuncMap{"join": strings.Join}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Database Schema</title>
+</head>
+<body>
+<h1>Database Schema</h1>
+{{- range $t := . }}
+<h2 id="{{ $t.Name }}">{{ $t.Name }}</h2>
+{{- with $t.Comment }}
+<p>{{ . }}</p>
+{{- end }}
+<table>
+<tr><th>Column</th><th>Type</th><th>Nullable</th><th>Unique</th><th>Default</th><th>Comment</th></tr>
+{{- range $c := $t.Columns }}
+<tr><td>{{ $c.Name }}</td><td>{{ $c.Type }}</td><td>{{ $c.Nullable }}</td><td>{{ $c.Unique }}</td><td>{{ $c.Default }}</td><td>{{ $c.Comment }}</td></tr>
+{{- end }}
+</table>
+{{- with $t.PrimaryKey }}
+<p>Primary key: {{ join . ", " }}</p>
+{{- end }}
+{{- with $t.Indexes }}
+<h3>Indexes</h3>
+<table>
+<tr><th>Name</th><th>Columns</th><th>Unique</th></tr>
+{{- range $idx := . }}
+<tr><td>{{ $idx.Name }}</td><td>{{ join $idx.Columns ", " }}</td><td>{{ $idx.Unique }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- with $t.ForeignKeys }}
+<h3>Foreign Keys</h3>
+<table>
+<tr><th>Name</th><th>Columns</th><th>References</th><th>On Delete</th></tr>
+{{- range $fk := . }}
+<tr><td>{{ $fk.Symbol }}</td><td>{{ join $fk.Columns ", " }}</td><td><a href="#{{ $fk.RefTable }}">{{ $fk.RefTable }}</a> ({{ join $fk.RefColumns ", " }})</td><td>{{ $fk.OnDelete }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- end }}
+</body>
+</html>
+`))
+)
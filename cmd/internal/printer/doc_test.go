@@ -0,0 +1,65 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package printer
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFprintDoc(t *testing.T) {
+	storage, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+	g, err := gen.NewGraph(&gen.Config{Package: "entc/gen", Storage: storage},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Comment: "The user name."},
+				{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{V: "admin"}, {V: "user"}}, Default: true, DefaultValue: "user"},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+			},
+			Indexes: []*load.Index{
+				{Fields: []string{"name"}, Unique: true},
+			},
+		},
+		&load.Schema{
+			Name: "Pet",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			},
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	var b strings.Builder
+	require.NoError(t, FprintDoc(&b, g, Markdown))
+	out := b.String()
+	require.Contains(t, out, "## users\n\nStores the User entities.\n")
+	require.Contains(t, out, "| name | string | false | false |  | The user name. |")
+	require.Contains(t, out, "| role | enum(admin, user) | false | false | user |  |")
+	require.Contains(t, out, "| user_name | name | true |")
+	require.Contains(t, out, "| pets_users_pets | user_pets | [users](#users) (id) | SET NULL |")
+	require.Less(t, strings.Index(out, "## pets"), strings.Index(out, "## users"))
+
+	b.Reset()
+	require.NoError(t, FprintDoc(&b, g, HTML))
+	out = b.String()
+	require.Contains(t, out, `<h2 id="users">users</h2>`)
+	require.Contains(t, out, "<td>The user name.</td>")
+	require.Contains(t, out, `<a href="#users">users</a> (id)`)
+
+	require.Error(t, FprintDoc(&b, g, "pdf"))
+}
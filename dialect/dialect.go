@@ -16,10 +16,11 @@ import (
 
 // Dialect names for external usage.
 const (
-	MySQL    = "mysql"
-	SQLite   = "sqlite3"
-	Postgres = "postgres"
-	Gremlin  = "gremlin"
+	MySQL      = "mysql"
+	SQLite     = "sqlite3"
+	Postgres   = "postgres"
+	Gremlin    = "gremlin"
+	ClickHouse = "clickhouse"
 )
 
 // ExecQuerier wraps the 2 database operations.
@@ -63,12 +64,18 @@ func NopTx(d Driver) Tx {
 	return nopTx{d}
 }
 
-// DebugDriver is a driver that logs all driver operations.
+// DebugDriver is a driver that logs all driver operations. See LogQueries
+// for logging the operations with their latency to a structured logger.
 type DebugDriver struct {
 	Driver                               // underlying driver.
 	log    func(context.Context, ...any) // log function. defaults to log.Println.
+	redact Redactor                      // optional redactor of the logged arguments.
 }
 
+// Redactor gets a statement and its arguments, and returns the arguments that are logged for it.
+// It is used for hiding sensitive values (e.g. passwords or tokens) from logs and traces.
+type Redactor func(query string, args any) any
+
 // Debug gets a driver and an optional logging function, and returns
 // a new debugged-driver that prints all outgoing operations.
 func Debug(d Driver, logger ...func(...any)) Driver {
@@ -76,20 +83,36 @@ func Debug(d Driver, logger ...func(...any)) Driver {
 	if len(logger) == 1 {
 		logf = logger[0]
 	}
-	drv := &DebugDriver{d, func(_ context.Context, v ...any) { logf(v...) }}
+	drv := &DebugDriver{Driver: d, log: func(_ context.Context, v ...any) { logf(v...) }}
 	return drv
 }
 
 // DebugWithContext gets a driver and a logging function, and returns
 // a new debugged-driver that prints all outgoing operations with context.
 func DebugWithContext(d Driver, logger func(context.Context, ...any)) Driver {
-	drv := &DebugDriver{d, logger}
+	drv := &DebugDriver{Driver: d, log: logger}
+	return drv
+}
+
+// DebugWithRedactor gets a driver, a redactor and an optional logging function, and returns a new
+// debugged-driver that prints all outgoing operations with the arguments returned by the redactor.
+func DebugWithRedactor(d Driver, r Redactor, logger ...func(...any)) Driver {
+	drv := Debug(d, logger...).(*DebugDriver)
+	drv.redact = r
 	return drv
 }
 
+// args returns the arguments of the statement for logging.
+func (d *DebugDriver) args(query string, args any) any {
+	if d.redact == nil {
+		return args
+	}
+	return d.redact(query, args)
+}
+
 // Exec logs its params and calls the underlying driver Exec method.
 func (d *DebugDriver) Exec(ctx context.Context, query string, args, v any) error {
-	d.log(ctx, fmt.Sprintf("driver.Exec: query=%v args=%v", query, args))
+	d.log(ctx, fmt.Sprintf("driver.Exec: query=%v args=%v", query, d.args(query, args)))
 	return d.Driver.Exec(ctx, query, args, v)
 }
 
@@ -101,13 +124,13 @@ func (d *DebugDriver) ExecContext(ctx context.Context, query string, args ...any
 	if !ok {
 		return nil, fmt.Errorf("Driver.ExecContext is not supported")
 	}
-	d.log(ctx, fmt.Sprintf("driver.ExecContext: query=%v args=%v", query, args))
+	d.log(ctx, fmt.Sprintf("driver.ExecContext: query=%v args=%v", query, d.args(query, args)))
 	return drv.ExecContext(ctx, query, args...)
 }
 
 // Query logs its params and calls the underlying driver Query method.
 func (d *DebugDriver) Query(ctx context.Context, query string, args, v any) error {
-	d.log(ctx, fmt.Sprintf("driver.Query: query=%v args=%v", query, args))
+	d.log(ctx, fmt.Sprintf("driver.Query: query=%v args=%v", query, d.args(query, args)))
 	return d.Driver.Query(ctx, query, args, v)
 }
 
@@ -119,7 +142,7 @@ func (d *DebugDriver) QueryContext(ctx context.Context, query string, args ...an
 	if !ok {
 		return nil, fmt.Errorf("Driver.QueryContext is not supported")
 	}
-	d.log(ctx, fmt.Sprintf("driver.QueryContext: query=%v args=%v", query, args))
+	d.log(ctx, fmt.Sprintf("driver.QueryContext: query=%v args=%v", query, d.args(query, args)))
 	return drv.QueryContext(ctx, query, args...)
 }
 
@@ -131,7 +154,7 @@ func (d *DebugDriver) Tx(ctx context.Context) (Tx, error) {
 	}
 	id := uuid.New().String()
 	d.log(ctx, fmt.Sprintf("driver.Tx(%s): started", id))
-	return &DebugTx{tx, id, d.log, ctx}, nil
+	return &DebugTx{tx, id, d.log, ctx, d}, nil
 }
 
 // BeginTx adds an log-id for the transaction and calls the underlying driver BeginTx command if it is supported.
@@ -148,7 +171,7 @@ func (d *DebugDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, err
 	}
 	id := uuid.New().String()
 	d.log(ctx, fmt.Sprintf("driver.BeginTx(%s): started", id))
-	return &DebugTx{tx, id, d.log, ctx}, nil
+	return &DebugTx{tx, id, d.log, ctx, d}, nil
 }
 
 // DebugTx is a transaction implementation that logs all transaction operations.
@@ -157,11 +180,12 @@ type DebugTx struct {
 	id  string                        // transaction logging id.
 	log func(context.Context, ...any) // log function. defaults to fmt.Println.
 	ctx context.Context               // underlying transaction context.
+	drv *DebugDriver                  // the driver that started the transaction.
 }
 
 // Exec logs its params and calls the underlying transaction Exec method.
 func (d *DebugTx) Exec(ctx context.Context, query string, args, v any) error {
-	d.log(ctx, fmt.Sprintf("Tx(%s).Exec: query=%v args=%v", d.id, query, args))
+	d.log(ctx, fmt.Sprintf("Tx(%s).Exec: query=%v args=%v", d.id, query, d.drv.args(query, args)))
 	return d.Tx.Exec(ctx, query, args, v)
 }
 
@@ -173,13 +197,13 @@ func (d *DebugTx) ExecContext(ctx context.Context, query string, args ...any) (s
 	if !ok {
 		return nil, fmt.Errorf("Tx.ExecContext is not supported")
 	}
-	d.log(ctx, fmt.Sprintf("Tx(%s).ExecContext: query=%v args=%v", d.id, query, args))
+	d.log(ctx, fmt.Sprintf("Tx(%s).ExecContext: query=%v args=%v", d.id, query, d.drv.args(query, args)))
 	return drv.ExecContext(ctx, query, args...)
 }
 
 // Query logs its params and calls the underlying transaction Query method.
 func (d *DebugTx) Query(ctx context.Context, query string, args, v any) error {
-	d.log(ctx, fmt.Sprintf("Tx(%s).Query: query=%v args=%v", d.id, query, args))
+	d.log(ctx, fmt.Sprintf("Tx(%s).Query: query=%v args=%v", d.id, query, d.drv.args(query, args)))
 	return d.Tx.Query(ctx, query, args, v)
 }
 
@@ -191,7 +215,7 @@ func (d *DebugTx) QueryContext(ctx context.Context, query string, args ...any) (
 	if !ok {
 		return nil, fmt.Errorf("Tx.QueryContext is not supported")
 	}
-	d.log(ctx, fmt.Sprintf("Tx(%s).QueryContext: query=%v args=%v", d.id, query, args))
+	d.log(ctx, fmt.Sprintf("Tx(%s).QueryContext: query=%v args=%v", d.id, query, d.drv.args(query, args)))
 	return drv.QueryContext(ctx, query, args...)
 }
 
@@ -4,7 +4,12 @@
 
 package entsql
 
-import "entgo.io/ent/schema"
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema"
+)
 
 // Annotation is a builtin schema annotation for attaching
 // SQL metadata to schema objects for both codegen and runtime.
@@ -18,7 +23,17 @@ type Annotation struct {
 	//
 	Table string `json:"table,omitempty"`
 
-	// Charset defines the character-set of the table. For example:
+	// Schema defines the database schema (e.g. a Postgres schema) the table is
+	// created in. If empty, the schema of the migration (or connection) is used.
+	//
+	//	entsql.Annotation{
+	//		Schema: "tenant_a",
+	//	}
+	//
+	Schema string `json:"schema,omitempty"`
+
+	// Charset defines the character-set of the table. When used on a field, it
+	// defines the character-set of its column (MySQL only). For example:
 	//
 	//	entsql.Annotation{
 	//		Charset: "utf8mb4",
@@ -27,7 +42,8 @@ type Annotation struct {
 	Charset string `json:"charset,omitempty"`
 
 	// Collation defines the collation of the table (a set of rules for comparing
-	// characters in a character set). For example:
+	// characters in a character set). When used on a field, it defines the collation
+	// of its column. For example:
 	//
 	//	entsql.Annotation{
 	//		Collation: "utf8mb4_bin",
@@ -89,6 +105,26 @@ type Annotation struct {
 	//
 	Size int64 `json:"size,omitempty"`
 
+	// OldNames defines the previous names of a column. If the column does not exist in the
+	// database, but one of its old names does, the migration renames the existing column
+	// instead of adding a new one. For example:
+	//
+	//	entsql.Annotation{
+	//		OldNames: []string{"nickname"},
+	//	}
+	//
+	OldNames []string `json:"old_names,omitempty"`
+
+	// ConvertUsing defines the expression that is used for converting the values of a column
+	// when its type is changed, and an implicit conversion does not exist. For example, from
+	// varchar to integer. This option is supported by PostgreSQL. For example:
+	//
+	//	entsql.Annotation{
+	//		ConvertUsing: `"code"::integer`,
+	//	}
+	//
+	ConvertUsing string `json:"convert_using,omitempty"`
+
 	// WithComments specifies whether fields' comments should
 	// be stored in the database schema as column comments.
 	//
@@ -122,6 +158,40 @@ type Annotation struct {
 	//
 	OnDelete ReferenceOption `json:"on_delete,omitempty"`
 
+	// OnUpdate specifies a custom referential action for UPDATE operations on parent
+	// table that has matching rows in the child table.
+	//
+	// For example, in order to update the referencing rows in the child table when the
+	// referenced key is changed in the parent table, pass the following annotation:
+	//
+	//	entsql.Annotation{
+	//		OnUpdate: entsql.Cascade,
+	//	}
+	//
+	OnUpdate ReferenceOption `json:"on_update,omitempty"`
+
+	// Deferrable defines the foreign-key constraint of the edge as "DEFERRABLE INITIALLY DEFERRED",
+	// i.e. the constraint is checked at the end of the transaction and not after each statement.
+	// This option is supported by PostgreSQL.
+	//
+	//	entsql.Annotation{
+	//		Deferrable: true,
+	//	}
+	//
+	Deferrable bool `json:"deferrable,omitempty"`
+
+	// ForeignKey defines the foreign-key constraint of the edge as a composite foreign key, by
+	// prepending additional columns to the edge column. For example, the following annotation
+	// defines the constraint as "FOREIGN KEY (tenant_id, owner_id) REFERENCES users (tenant_id, id)":
+	//
+	//	entsql.Annotation{
+	//		ForeignKey: &entsql.ForeignKey{
+	//			Columns: []string{"tenant_id"},
+	//		},
+	//	}
+	//
+	ForeignKey *ForeignKey `json:"foreign_key,omitempty"`
+
 	// Check allows injecting custom "DDL" for setting an unnamed "CHECK" clause in "CREATE TABLE".
 	//
 	//	entsql.Annotation{
@@ -139,6 +209,323 @@ type Annotation struct {
 	//	}
 	//
 	Checks map[string]string `json:"checks,omitempty"`
+
+	// RowSecurity enables PostgreSQL row-level security on the table, and creates a policy
+	// that limits the visible and modified rows to the tenant of the connection. See the
+	// sql.TenantDriver for setting the tenant of the connection. Additional policies can
+	// be defined using the Policies option.
+	//
+	//	entsql.Annotation{
+	//		RowSecurity: &entsql.RowSecurity{
+	//			Column: "tenant_id",
+	//			Policies: []*entsql.Policy{
+	//				{Name: "users_admin", To: []string{"admin"}, Using: "true"},
+	//			},
+	//		},
+	//	}
+	//
+	RowSecurity *RowSecurity `json:"row_security,omitempty"`
+
+	// Partition defines the partitioning of the table. In PostgreSQL, the table is created
+	// as a (declaratively) partitioned table, and its partitions can be created using the
+	// sql.CreatePartition builder. For example:
+	//
+	//	entsql.Annotation{
+	//		Partition: &entsql.Partition{
+	//			Type:    entsql.PartitionRange,
+	//			Columns: []string{"created_at"},
+	//		},
+	//	}
+	//
+	Partition *Partition `json:"partition,omitempty"`
+
+	// Engine defines the table engine of ClickHouse tables. Tables without an engine
+	// are created using the MergeTree engine, sorted by their primary key. For example:
+	//
+	//	entsql.Annotation{
+	//		Engine: &entsql.Engine{
+	//			Name:        "ReplacingMergeTree",
+	//			OrderBy:     []string{"user_id", "created_at"},
+	//			PartitionBy: "toYYYYMM(created_at)",
+	//		},
+	//	}
+	//
+	// In MySQL, only the Name of the engine is used (e.g. "InnoDB" or "MyISAM"), and the
+	// engine of existing tables is altered by the migration if it was changed.
+	//
+	Engine *Engine `json:"engine,omitempty"`
+
+	// RowFormat defines the row format of MySQL tables (e.g. "DYNAMIC" or "COMPRESSED").
+	// The row format of existing tables is altered by the migration if it was changed.
+	//
+	//	entsql.Annotation{
+	//		RowFormat: "COMPRESSED",
+	//	}
+	//
+	RowFormat string `json:"row_format,omitempty"`
+
+	// KeyBlockSize defines the page size in KB of compressed MySQL tables. The key block
+	// size of existing tables is altered by the migration if it was changed.
+	//
+	//	entsql.Annotation{
+	//		RowFormat:    "COMPRESSED",
+	//		KeyBlockSize: 8,
+	//	}
+	//
+	KeyBlockSize int `json:"key_block_size,omitempty"`
+
+	// AutoIncrement defines the starting value of the AUTO_INCREMENT counter of MySQL tables.
+	// On existing tables, the counter is altered only if it is lower than the configured value.
+	// Note that this option is ignored if global unique ids are enabled, as the counter is set
+	// to the id range of the table.
+	//
+	//	entsql.Annotation{
+	//		AutoIncrement: 1000,
+	//	}
+	//
+	AutoIncrement int64 `json:"auto_increment,omitempty"`
+
+	// Sequence configures the identity column (and its underlying sequence) of PostgreSQL
+	// tables with an auto-increment primary key. By default, the primary key is defined as
+	// "GENERATED BY DEFAULT AS IDENTITY", using the default sequence options. For example:
+	//
+	//	entsql.Annotation{
+	//		Sequence: &entsql.Sequence{
+	//			Name:  "users_id_seq",
+	//			Start: 1000,
+	//			Cache: 20,
+	//		},
+	//	}
+	//
+	Sequence *Sequence `json:"sequence,omitempty"`
+
+	// Extensions defines the PostgreSQL extensions that are required by the table, for example,
+	// by its column types, default values or indexes. The migration creates the missing extensions
+	// using "CREATE EXTENSION IF NOT EXISTS", before the tables are created or modified.
+	//
+	//	entsql.Annotation{
+	//		Extensions: []string{"uuid-ossp", "pg_trgm"},
+	//	}
+	//
+	Extensions []string `json:"extensions,omitempty"`
+
+	// ViewAs defines the query (SELECT statement) of a schema that is backed by a
+	// database view (i.e. embeds ent.View). The view is created by the migration.
+	//
+	//	entsql.Annotation{
+	//		ViewAs: "SELECT id, name FROM users WHERE active",
+	//	}
+	//
+	ViewAs string `json:"view_as,omitempty"`
+
+	// ViewFor defines dialect-specific queries of the view, and
+	// it takes precedence over ViewAs for the listed dialects.
+	//
+	//	entsql.Annotation{
+	//		ViewFor: map[string]string{
+	//			dialect.Postgres: `SELECT "id", "name" FROM "users" WHERE "active"`,
+	//		},
+	//	}
+	//
+	ViewFor map[string]string `json:"view_for,omitempty"`
+
+	// Materialized defines the view as a materialized view.
+	// This option is supported by PostgreSQL.
+	//
+	//	entsql.Annotation{
+	//		ViewAs:       "SELECT user_id, COUNT(*) AS count FROM pets GROUP BY user_id",
+	//		Materialized: true,
+	//	}
+	//
+	Materialized bool `json:"materialized,omitempty"`
+
+	// Triggers defines the triggers of the table, that are created by the migration. A trigger is
+	// re-created when its definition changes, and dropped when it is removed from the annotation.
+	//
+	//	entsql.Annotation{
+	//		Triggers: []*entsql.Trigger{
+	//			{
+	//				Name:       "users_audit",
+	//				Definition: "CREATE TRIGGER users_audit AFTER INSERT ON users ...",
+	//			},
+	//		},
+	//	}
+	//
+	Triggers []*Trigger `json:"triggers,omitempty"`
+
+	// Persistence defines the persistence of PostgreSQL tables. Unlogged tables are not
+	// written to the write-ahead log, which makes them faster, but not crash-safe. Temporary
+	// tables exist only in the session that created them. For example:
+	//
+	//	entsql.Annotation{
+	//		Persistence: entsql.PersistenceUnlogged,
+	//	}
+	//
+	// The persistence of existing tables is altered by the migration if it was changed
+	// to PersistenceUnlogged or PersistenceLogged.
+	//
+	Persistence string `json:"persistence,omitempty"`
+}
+
+// Trigger defines a trigger of a table.
+type Trigger struct {
+	// Name of the trigger.
+	Name string `json:"name,omitempty"`
+	// Definition holds the statement that creates the trigger.
+	Definition string `json:"definition,omitempty"`
+	// Definitions holds dialect-specific statements of the trigger, and
+	// it takes precedence over Definition for the listed dialects.
+	Definitions map[string]string `json:"definitions,omitempty"`
+}
+
+// Partitioning methods.
+const (
+	PartitionRange = "RANGE"
+	PartitionList  = "LIST"
+	PartitionHash  = "HASH"
+	PartitionKey   = "KEY" // MySQL only.
+)
+
+// Table persistence options.
+const (
+	PersistenceLogged    = "LOGGED"
+	PersistenceUnlogged  = "UNLOGGED"
+	PersistenceTemporary = "TEMPORARY"
+)
+
+// ForeignKey configures the additional columns of a composite foreign key of an edge.
+// The referenced columns must be covered by a unique index in the referenced table,
+// and such an index is added to the table if it does not exist.
+type ForeignKey struct {
+	// Columns holds the additional columns of the foreign key, that are
+	// prepended to the edge column in the order they are defined.
+	Columns []string `json:"columns,omitempty"`
+	// RefColumns holds the referenced columns of the additional
+	// columns, in the same order. Defaults to Columns.
+	RefColumns []string `json:"ref_columns,omitempty"`
+}
+
+// Partition configures the partitioning of a table.
+type Partition struct {
+	// Type is the partitioning method. e.g. PartitionRange.
+	Type string `json:"type,omitempty"`
+	// Columns holds the columns or expressions of the partition key. e.g. "created_at" or "YEAR(created_at)".
+	Columns []string `json:"columns,omitempty"`
+	// Definitions holds the partition definitions of MySQL tables, as MySQL requires
+	// defining them in the CREATE TABLE statement. For example:
+	//
+	//	"PARTITION p2023 VALUES LESS THAN (2024), PARTITION pmax VALUES LESS THAN MAXVALUE"
+	//
+	Definitions string `json:"definitions,omitempty"`
+}
+
+// Engine configures the table engine of a ClickHouse or a MySQL table.
+type Engine struct {
+	// Name is the table engine, including its parameters. e.g. "MergeTree" or "ReplacingMergeTree(version)".
+	Name string `json:"name,omitempty"`
+	// OrderBy holds the columns or expressions of the sorting key. Defaults to the primary key.
+	OrderBy []string `json:"order_by,omitempty"`
+	// PartitionBy holds the partitioning expression of the table. e.g. "toYYYYMM(created_at)".
+	PartitionBy string `json:"partition_by,omitempty"`
+	// Settings holds the engine settings. e.g. {"index_granularity": "8192"}.
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// Sequence configures the identity column of a PostgreSQL table and its underlying sequence.
+// The options of existing identity columns are altered by the migration if they were changed.
+type Sequence struct {
+	// Name of the sequence. Defaults to the name that is chosen by
+	// PostgreSQL, i.e. "<table>_<column>_seq". An existing sequence
+	// is renamed if its name does not match the configured name.
+	Name string `json:"name,omitempty"`
+	// Always defines the column as "GENERATED ALWAYS AS IDENTITY", instead of
+	// "GENERATED BY DEFAULT AS IDENTITY". Note that inserting rows with explicit
+	// ids to such tables fails, unless "OVERRIDING SYSTEM VALUE" is used.
+	Always bool `json:"always,omitempty"`
+	// Start is the first value of the sequence. Defaults to 1. Note that this option
+	// is ignored if global unique ids are enabled, as the sequence starts at the id
+	// range of the table.
+	Start int64 `json:"start,omitempty"`
+	// Increment is the value that is added to the sequence on each call. Defaults to 1.
+	Increment int64 `json:"increment,omitempty"`
+	// Cache is the number of sequence values that are preallocated
+	// in memory for faster access. Defaults to 1 (no cache).
+	Cache int64 `json:"cache,omitempty"`
+}
+
+// DefaultTenantSetting is the default runtime parameter that holds
+// the tenant identifier of the connection in PostgreSQL.
+const DefaultTenantSetting = "ent.tenant_id"
+
+// RowSecurity configures the PostgreSQL row-level security of a table.
+type RowSecurity struct {
+	// Column holds the tenant identifier of the table rows. If set,
+	// a tenant policy is created for the table (see Policy method).
+	Column string `json:"column,omitempty"`
+	// Setting is the runtime parameter that holds the tenant identifier
+	// of the connection. Defaults to DefaultTenantSetting.
+	Setting string `json:"setting,omitempty"`
+	// Force applies the row-level security also to the table owner.
+	Force bool `json:"force,omitempty"`
+	// Policies holds additional policies of the table.
+	Policies []*Policy `json:"policies,omitempty"`
+}
+
+// Policy defines a PostgreSQL row-level security policy. For example:
+//
+//	&entsql.Policy{
+//		Name:  "posts_published",
+//		For:   "SELECT",
+//		Using: "published_at IS NOT NULL",
+//	}
+type Policy struct {
+	// Name of the policy.
+	Name string `json:"name,omitempty"`
+	// Restrictive creates the policy as a restrictive policy.
+	// Policies are permissive by default.
+	Restrictive bool `json:"restrictive,omitempty"`
+	// For is the command the policy applies to: ALL (default),
+	// SELECT, INSERT, UPDATE or DELETE.
+	For string `json:"for,omitempty"`
+	// To holds the roles the policy applies to. Defaults to PUBLIC.
+	To []string `json:"to,omitempty"`
+	// Using is the expression that is checked for existing rows.
+	Using string `json:"using,omitempty"`
+	// WithCheck is the expression that is checked for new and updated rows.
+	WithCheck string `json:"with_check,omitempty"`
+}
+
+// Policy returns the name of the tenant policy of the given table.
+func (r RowSecurity) Policy(table string) string {
+	return table + "_tenant_isolation"
+}
+
+// merge returns the merge of the row-level security options with the other options.
+// The policies are appended, and the other options are overridden if they are set.
+func (r *RowSecurity) merge(other *RowSecurity) *RowSecurity {
+	if r == nil {
+		return other
+	}
+	m := *r
+	if other.Column != "" {
+		m.Column = other.Column
+	}
+	if other.Setting != "" {
+		m.Setting = other.Setting
+	}
+	if other.Force {
+		m.Force = true
+	}
+	m.Policies = append(m.Policies[:len(m.Policies):len(m.Policies)], other.Policies...)
+	return &m
+}
+
+// TenantSetting returns the runtime parameter that holds the tenant identifier.
+func (r RowSecurity) TenantSetting() string {
+	if r.Setting != "" {
+		return r.Setting
+	}
+	return DefaultTenantSetting
 }
 
 // Name describes the annotation name.
@@ -229,6 +616,46 @@ func WithComments(b bool) *Annotation {
 	}
 }
 
+// Schema sets the database schema of the annotated table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Schema("tenant_a"),
+//		}
+//	}
+func Schema(name string) *Annotation {
+	return &Annotation{
+		Schema: name,
+	}
+}
+
+// OldNames defines the previous names of the annotated column. If the column does not
+// exist in the database, but one of its old names does, the migration renames the existing
+// column instead of adding a new one and leaving the old one orphaned.
+//
+//	field.String("nick").
+//		Annotations(
+//			entsql.OldNames("nickname"),
+//		)
+func OldNames(names ...string) *Annotation {
+	return &Annotation{
+		OldNames: names,
+	}
+}
+
+// ConvertUsing defines the expression for converting the values of the annotated column
+// when its type is changed. The expression is used by the "USING" clause of PostgreSQL.
+//
+//	field.Int("code").
+//		Annotations(
+//			entsql.ConvertUsing(`"code"::integer`),
+//		)
+func ConvertUsing(expr string) *Annotation {
+	return &Annotation{
+		ConvertUsing: expr,
+	}
+}
+
 // OnDelete specifies a custom referential action for DELETE operations on parent
 // table that has matching rows in the child table.
 //
@@ -246,6 +673,276 @@ func OnDelete(opt ReferenceOption) *Annotation {
 	}
 }
 
+// OnUpdate specifies a custom referential action for UPDATE operations on parent
+// table that has matching rows in the child table.
+//
+//	edge.To("pets", Pet.Type).
+//		Annotations(
+//			entsql.OnUpdate(entsql.Cascade),
+//		)
+func OnUpdate(opt ReferenceOption) *Annotation {
+	return &Annotation{
+		OnUpdate: opt,
+	}
+}
+
+// Deferrable defines the foreign-key constraint of the edge as deferrable. Hence, rows
+// can be inserted in the same transaction before the rows they reference.
+//
+//	edge.To("pets", Pet.Type).
+//		Annotations(
+//			entsql.Deferrable(),
+//		)
+func Deferrable() *Annotation {
+	return &Annotation{
+		Deferrable: true,
+	}
+}
+
+// CompositeForeignKey defines the foreign-key constraint of the edge as a composite foreign
+// key, by prepending the given columns to the edge column. The columns are expected to exist
+// in both tables, and they reference the columns with the same names.
+//
+//	edge.To("pets", Pet.Type).
+//		Annotations(
+//			entsql.CompositeForeignKey("tenant_id"),
+//		)
+func CompositeForeignKey(columns ...string) *Annotation {
+	return &Annotation{
+		ForeignKey: &ForeignKey{Columns: columns},
+	}
+}
+
+// TenantColumn enables PostgreSQL row-level security on the table, and limits
+// its rows to the tenant of the connection using the given column.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.TenantColumn("tenant_id"),
+//		}
+//	}
+func TenantColumn(column string) *Annotation {
+	return &Annotation{
+		RowSecurity: &RowSecurity{Column: column},
+	}
+}
+
+// Policies enables PostgreSQL row-level security on the table, and defines its policies.
+// Policies are re-created when their definition changes, and dropped when they are removed
+// from the annotation.
+//
+//	func (Post) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Policies(
+//				&entsql.Policy{Name: "posts_select", For: "SELECT", Using: "published_at IS NOT NULL"},
+//				&entsql.Policy{Name: "posts_owner", Using: "author = current_user"},
+//			),
+//		}
+//	}
+func Policies(policies ...*Policy) *Annotation {
+	return &Annotation{
+		RowSecurity: &RowSecurity{Policies: policies},
+	}
+}
+
+// PartitionBy defines the partitioning method and the partition key of the annotated table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.PartitionBy(entsql.PartitionRange, "created_at"),
+//		}
+//	}
+func PartitionBy(typ string, columns ...string) *Annotation {
+	return &Annotation{
+		Partition: &Partition{Type: typ, Columns: columns},
+	}
+}
+
+// TableEngine defines the engine and the sorting key of the annotated ClickHouse table,
+// or the engine of the annotated MySQL table (e.g. entsql.TableEngine("InnoDB")).
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.TableEngine("MergeTree", "created_at", "id"),
+//		}
+//	}
+func TableEngine(name string, orderBy ...string) *Annotation {
+	return &Annotation{
+		Engine: &Engine{Name: name, OrderBy: orderBy},
+	}
+}
+
+// RowFormat defines the row format of the annotated MySQL table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.RowFormat("COMPRESSED"),
+//		}
+//	}
+func RowFormat(format string) *Annotation {
+	return &Annotation{
+		RowFormat: format,
+	}
+}
+
+// KeyBlockSize defines the page size in KB of the annotated (compressed) MySQL table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.RowFormat("COMPRESSED"),
+//			entsql.KeyBlockSize(8),
+//		}
+//	}
+func KeyBlockSize(size int) *Annotation {
+	return &Annotation{
+		KeyBlockSize: size,
+	}
+}
+
+// AutoIncrement defines the starting value of the AUTO_INCREMENT counter of the annotated MySQL table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.AutoIncrement(1000),
+//		}
+//	}
+func AutoIncrement(start int64) *Annotation {
+	return &Annotation{
+		AutoIncrement: start,
+	}
+}
+
+// IdentitySequence configures the identity column of the annotated PostgreSQL table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.IdentitySequence(&entsql.Sequence{
+//				Always:    true,
+//				Start:     1000,
+//				Increment: 10,
+//			}),
+//		}
+//	}
+func IdentitySequence(s *Sequence) *Annotation {
+	return &Annotation{
+		Sequence: s,
+	}
+}
+
+// Extensions defines the PostgreSQL extensions that are required by the annotated table.
+//
+//	func (T) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Extensions("uuid-ossp", "postgis"),
+//		}
+//	}
+func Extensions(names ...string) *Annotation {
+	return &Annotation{
+		Extensions: names,
+	}
+}
+
+// View defines the query (SELECT statement) of the annotated view schema.
+//
+//	func (ActiveUser) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.View("SELECT id, name FROM users WHERE active"),
+//		}
+//	}
+func View(as string) *Annotation {
+	return &Annotation{
+		ViewAs: as,
+	}
+}
+
+// ViewFor defines the query of the annotated view schema for the given dialect, using the
+// SQL builder. Note that the query cannot contain arguments, as they are not supported in
+// view definitions. Hence, predicates should be defined using literals (e.g. sql.ExprP).
+//
+//	func (ActiveUser) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.ViewFor(dialect.Postgres, func(s *sql.Selector) {
+//				t := sql.Table("users")
+//				s.Select(t.C("id"), t.C("name")).
+//					From(t).
+//					Where(sql.ExprP("active"))
+//			}),
+//		}
+//	}
+func ViewFor(dialect string, as func(*sql.Selector)) *Annotation {
+	s := sql.Dialect(dialect).Select()
+	as(s)
+	query, args := s.Query()
+	switch {
+	case s.Err() != nil:
+		panic(fmt.Sprintf("entsql: building view query: %v", s.Err()))
+	case len(args) > 0:
+		panic(fmt.Sprintf("entsql: view query cannot contain arguments, got %d", len(args)))
+	}
+	return &Annotation{
+		ViewFor: map[string]string{dialect: query},
+	}
+}
+
+// MaterializedView defines the query of the annotated view schema,
+// and creates it as a materialized view. Supported by PostgreSQL.
+//
+//	func (UserStats) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.MaterializedView("SELECT user_id AS id, COUNT(*) AS pets FROM pets GROUP BY user_id"),
+//		}
+//	}
+func MaterializedView(as string) *Annotation {
+	return &Annotation{
+		ViewAs:       as,
+		Materialized: true,
+	}
+}
+
+// Triggers defines the triggers of the annotated table.
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Triggers(&entsql.Trigger{
+//				Name: "users_updated_at",
+//				Definitions: map[string]string{
+//					dialect.Postgres: `CREATE TRIGGER "users_updated_at" BEFORE UPDATE ON "users" FOR EACH ROW EXECUTE FUNCTION set_updated_at()`,
+//				},
+//			}),
+//		}
+//	}
+func Triggers(triggers ...*Trigger) *Annotation {
+	return &Annotation{
+		Triggers: triggers,
+	}
+}
+
+// Unlogged creates the annotated table as an unlogged table. Supported by PostgreSQL.
+//
+//	func (Session) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Unlogged(),
+//		}
+//	}
+func Unlogged() *Annotation {
+	return &Annotation{
+		Persistence: PersistenceUnlogged,
+	}
+}
+
+// Temporary creates the annotated table as a temporary table. Supported by PostgreSQL.
+//
+//	func (Scratch) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Temporary(),
+//		}
+//	}
+func Temporary() *Annotation {
+	return &Annotation{
+		Persistence: PersistenceTemporary,
+	}
+}
+
 // Merge implements the schema.Merger interface.
 func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	var ant Annotation
@@ -262,6 +959,9 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if t := ant.Table; t != "" {
 		a.Table = t
 	}
+	if s := ant.Schema; s != "" {
+		a.Schema = s
+	}
 	if c := ant.Charset; c != "" {
 		a.Charset = c
 	}
@@ -288,6 +988,12 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if s := ant.Size; s != 0 {
 		a.Size = s
 	}
+	if names := ant.OldNames; len(names) > 0 {
+		a.OldNames = append(a.OldNames[:len(a.OldNames):len(a.OldNames)], names...)
+	}
+	if x := ant.ConvertUsing; x != "" {
+		a.ConvertUsing = x
+	}
 	if b := ant.WithComments; b != nil {
 		a.WithComments = b
 	}
@@ -297,6 +1003,15 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if od := ant.OnDelete; od != "" {
 		a.OnDelete = od
 	}
+	if ou := ant.OnUpdate; ou != "" {
+		a.OnUpdate = ou
+	}
+	if ant.Deferrable {
+		a.Deferrable = true
+	}
+	if fk := ant.ForeignKey; fk != nil {
+		a.ForeignKey = fk
+	}
 	if c := ant.Check; c != "" {
 		a.Check = c
 	}
@@ -308,6 +1023,50 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 			a.Checks[name] = check
 		}
 	}
+	if r := ant.RowSecurity; r != nil {
+		a.RowSecurity = a.RowSecurity.merge(r)
+	}
+	if p := ant.Partition; p != nil {
+		a.Partition = p
+	}
+	if e := ant.Engine; e != nil {
+		a.Engine = e
+	}
+	if f := ant.RowFormat; f != "" {
+		a.RowFormat = f
+	}
+	if s := ant.KeyBlockSize; s != 0 {
+		a.KeyBlockSize = s
+	}
+	if i := ant.AutoIncrement; i != 0 {
+		a.AutoIncrement = i
+	}
+	if seq := ant.Sequence; seq != nil {
+		a.Sequence = seq
+	}
+	if names := ant.Extensions; len(names) > 0 {
+		a.Extensions = append(a.Extensions[:len(a.Extensions):len(a.Extensions)], names...)
+	}
+	if v := ant.ViewAs; v != "" {
+		a.ViewAs = v
+	}
+	if v := ant.ViewFor; len(v) > 0 {
+		if a.ViewFor == nil {
+			a.ViewFor = make(map[string]string)
+		}
+		for dialect, query := range v {
+			a.ViewFor[dialect] = query
+		}
+	}
+	if ant.Materialized {
+		a.Materialized = true
+	}
+	if t := ant.Triggers; len(t) > 0 {
+		a.Triggers = append(a.Triggers[:len(a.Triggers):len(a.Triggers)], t...)
+	}
+	if p := ant.Persistence; p != "" {
+		a.Persistence = p
+	}
 	return a
 }
 
@@ -377,6 +1136,22 @@ type IndexAnnotation struct {
 	//
 	DescColumns map[string]bool
 
+	// ExprColumns defines expressions that replace the columns of the index. In
+	// PostgreSQL, the following annotation maps to:
+	//
+	//	index.Fields("email").
+	//		Annotations(
+	//			entsql.ExprColumn("email", "lower(email)"),
+	//			entsql.Desc(),
+	//		)
+	//
+	//	CREATE INDEX "table_email" ON "table" ((lower(email)) DESC)
+	//
+	// Note that expressions should be defined exactly like they are stored
+	// in the database (i.e. normal form), in order to not be recreated on
+	// every migration.
+	ExprColumns map[string]string
+
 	// IncludeColumns defines the INCLUDE clause for the index.
 	// Works only in Postgres and its definition is as follows:
 	//
@@ -453,6 +1228,31 @@ type IndexAnnotation struct {
 	//		)
 	//	CREATE INDEX "table_a" ON "table"("a") WHERE (b AND c > 0)
 	Where string
+
+	// FullText defines a full-text index for a single string column, that is used by
+	// the generated <Field>Search predicates. In MySQL, it maps to a FULLTEXT index, in
+	// PostgreSQL to a GIN index on the tsvector of the column, and in SQLite to an FTS5
+	// table that is kept in sync with the column using triggers.
+	//
+	//	index.Fields("title").
+	//		Annotations(
+	//			entsql.FullText(),
+	//		)
+	//
+	//	CREATE FULLTEXT INDEX `table_title` ON `table`(`title`)
+	//	CREATE INDEX "table_title" ON "table" USING GIN (to_tsvector('simple'::regconfig, (title)::text))
+	//
+	FullText bool
+
+	// FullTextConfig defines the text search configuration of the full-text index
+	// in PostgreSQL. Defaults to "simple".
+	//
+	//	index.Fields("title").
+	//		Annotations(
+	//			entsql.FullTextConfig("english"),
+	//		)
+	//
+	FullTextConfig string
 }
 
 // Prefix returns a new index annotation with a single string column index.
@@ -553,6 +1353,21 @@ func DescColumns(names ...string) *IndexAnnotation {
 	return ant
 }
 
+// ExprColumn returns a new index annotation with an expression that replaces
+// the given column in the index. In PostgreSQL, the following annotation maps to:
+//
+//	index.Fields("email", "name").
+//		Annotations(
+//			entsql.ExprColumn("email", "lower(email)"),
+//		)
+//
+//	CREATE INDEX "table_email_name" ON "table" ((lower(email)), "name")
+func ExprColumn(name, expr string) *IndexAnnotation {
+	return &IndexAnnotation{
+		ExprColumns: map[string]string{name: expr},
+	}
+}
+
 // IncludeColumns defines the INCLUDE clause for the index.
 // Works only in Postgres and its definition is as follows:
 //
@@ -608,6 +1423,33 @@ func IndexWhere(pred string) *IndexAnnotation {
 	return &IndexAnnotation{Where: pred}
 }
 
+// FullText returns a new index annotation that defines a full-text index for a
+// single string column. In MySQL, the following annotation maps to:
+//
+//	index.Fields("title").
+//		Annotations(
+//			entsql.FullText(),
+//		)
+//
+//	CREATE FULLTEXT INDEX `table_title` ON `table`(`title`)
+func FullText() *IndexAnnotation {
+	return &IndexAnnotation{FullText: true}
+}
+
+// FullTextConfig returns a new index annotation that defines a full-text index
+// with the given text search configuration. The configuration is used only by
+// PostgreSQL, and maps to:
+//
+//	index.Fields("title").
+//		Annotations(
+//			entsql.FullTextConfig("english"),
+//		)
+//
+//	CREATE INDEX "table_title" ON "table" USING GIN (to_tsvector('english'::regconfig, (title)::text))
+func FullTextConfig(name string) *IndexAnnotation {
+	return &IndexAnnotation{FullText: true, FullTextConfig: name}
+}
+
 // Name describes the annotation name.
 func (IndexAnnotation) Name() string {
 	return "EntSQLIndexes"
@@ -659,6 +1501,14 @@ func (a IndexAnnotation) Merge(other schema.Annotation) schema.Annotation {
 			a.DescColumns[column] = desc
 		}
 	}
+	if ant.ExprColumns != nil {
+		if a.ExprColumns == nil {
+			a.ExprColumns = make(map[string]string)
+		}
+		for column, expr := range ant.ExprColumns {
+			a.ExprColumns[column] = expr
+		}
+	}
 	if ant.IncludeColumns != nil {
 		a.IncludeColumns = append(a.IncludeColumns, ant.IncludeColumns...)
 	}
@@ -676,6 +1526,12 @@ func (a IndexAnnotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.Where != "" {
 		a.Where = ant.Where
 	}
+	if ant.FullText {
+		a.FullText = ant.FullText
+	}
+	if ant.FullTextConfig != "" {
+		a.FullTextConfig = ant.FullTextConfig
+	}
 	return a
 }
 
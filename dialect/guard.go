@@ -0,0 +1,403 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Errors returned by the GuardDriver.
+var (
+	// ErrRateLimited is returned when an operation cannot acquire
+	// its rate limit before the deadline of its context.
+	ErrRateLimited = errors.New("dialect: rate limit exceeded")
+	// ErrCircuitOpen is returned when an operation is rejected by an open circuit breaker.
+	ErrCircuitOpen = errors.New("dialect: circuit breaker is open")
+)
+
+// Operation is a driver operation that is guarded by the GuardDriver, or intercepted by the Intercept middleware.
+type Operation string
+
+// Driver operations.
+const (
+	OpExec  Operation = "exec"  // Exec and ExecContext.
+	OpQuery Operation = "query" // Query and QueryContext.
+	OpTx    Operation = "tx"    // Tx and BeginTx.
+)
+
+// GuardDriver is a driver that limits the rate of the driver operations, and stops calling the
+// underlying driver while it is failing (circuit breaking). Operations that are executed on the
+// transactions it returns are not guarded, as their transaction was already admitted.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := ent.NewClient(ent.Driver(dialect.Guard(drv,
+//		dialect.WithRateLimit(dialect.OpExec, 100, 10),
+//		dialect.WithCircuitBreaker(dialect.BreakerConfig{
+//			Failures: 5,
+//			Latency:  time.Second,
+//			Timeout:  10 * time.Second,
+//		}),
+//	)))
+type GuardDriver struct {
+	Driver                           // underlying driver.
+	limits  map[Operation]*rateLimit // rate limits of operations.
+	breaker *breaker                 // optional circuit breaker.
+	now     func() time.Time         // defaults to time.Now.
+}
+
+// GuardOption configures the GuardDriver.
+type GuardOption func(*GuardDriver)
+
+// WithRateLimit limits the rate of the given operation to r operations per second, with bursts of up to
+// burst operations. Operations that exceed the rate wait for their turn, and fail with ErrRateLimited if
+// their turn comes after the deadline of their context. Non-positive rates are ignored.
+func WithRateLimit(op Operation, r float64, burst int) GuardOption {
+	return func(d *GuardDriver) {
+		// A token bucket without a refill rate would never admit waiting operations.
+		if !(r > 0) {
+			return
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		d.limits[op] = &rateLimit{rate: r, burst: float64(burst), tokens: float64(burst)}
+	}
+}
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState uint8
+
+// Circuit breaker states.
+const (
+	BreakerClosed   BreakerState = iota // Operations are executed.
+	BreakerOpen                         // Operations are rejected with ErrCircuitOpen.
+	BreakerHalfOpen                     // A limited number of probe operations are executed.
+)
+
+// String implements the fmt.Stringer interface.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("BreakerState(%d)", s)
+	}
+}
+
+// BreakerConfig configures the circuit breaker of the GuardDriver.
+type BreakerConfig struct {
+	// Failures is the number of consecutive failures that opens the circuit. Defaults to 5.
+	Failures int
+	// Latency, if set, counts operations that take longer as failures, even if they succeed.
+	Latency time.Duration
+	// Timeout is the time the circuit stays open, before it becomes half-open
+	// and probe operations are executed. Defaults to 30 seconds.
+	Timeout time.Duration
+	// Probes is the number of probe operations that are executed concurrently in the half-open
+	// state, and that should succeed for closing the circuit. Defaults to 1. A failing probe opens
+	// the circuit again.
+	Probes int
+	// IsFailure reports if an error returned by the underlying driver is a failure. By default,
+	// all errors, except for context.Canceled, are failures. Errors that are caused by the data
+	// and not by the database, like constraint violations, can be ignored as follows:
+	//
+	//	IsFailure: func(err error) bool {
+	//		return !errors.Is(err, context.Canceled) && !sqlgraph.IsConstraintError(err)
+	//	}
+	IsFailure func(error) bool
+	// OnStateChange, if set, is called when the state of the circuit changes.
+	OnStateChange func(from, to BreakerState)
+}
+
+// WithCircuitBreaker adds a circuit breaker to the driver. The circuit opens after a number of consecutive
+// failures, and rejects all operations until its timeout expires. Then, it becomes half-open and executes
+// probe operations, that either close the circuit if they succeed, or open it again if one of them fails.
+func WithCircuitBreaker(c BreakerConfig) GuardOption {
+	return func(d *GuardDriver) {
+		if c.Failures < 1 {
+			c.Failures = 5
+		}
+		if c.Timeout <= 0 {
+			c.Timeout = 30 * time.Second
+		}
+		if c.Probes < 1 {
+			c.Probes = 1
+		}
+		if c.IsFailure == nil {
+			c.IsFailure = func(err error) bool {
+				return !errors.Is(err, context.Canceled)
+			}
+		}
+		d.breaker = &breaker{BreakerConfig: c}
+	}
+}
+
+// Guard gets a driver and guard options, and returns a new driver
+// that applies the rate limits and the circuit breaker on its operations.
+func Guard(d Driver, opts ...GuardOption) *GuardDriver {
+	drv := &GuardDriver{Driver: d, limits: make(map[Operation]*rateLimit), now: time.Now}
+	for _, opt := range opts {
+		opt(drv)
+	}
+	return drv
+}
+
+// State returns the state of the circuit breaker. It is always closed if the driver has no circuit breaker.
+func (d *GuardDriver) State() BreakerState {
+	if d.breaker == nil {
+		return BreakerClosed
+	}
+	return d.breaker.state(d.now())
+}
+
+// Exec calls the underlying driver Exec method if it is admitted by the rate limit and the circuit breaker.
+func (d *GuardDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.guard(ctx, OpExec, func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// ExecContext calls the underlying driver ExecContext method if it is supported,
+// and if it is admitted by the rate limit and the circuit breaker.
+func (d *GuardDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	var res sql.Result
+	err := d.guard(ctx, OpExec, func() (err error) {
+		res, err = drv.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// Query calls the underlying driver Query method if it is admitted by the rate limit and the circuit breaker.
+func (d *GuardDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.guard(ctx, OpQuery, func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// QueryContext calls the underlying driver QueryContext method if it is supported,
+// and if it is admitted by the rate limit and the circuit breaker.
+func (d *GuardDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	var rows *sql.Rows
+	err := d.guard(ctx, OpQuery, func() (err error) {
+		rows, err = drv.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Tx calls the underlying driver Tx method if it is admitted by the rate limit and the circuit breaker.
+func (d *GuardDriver) Tx(ctx context.Context) (Tx, error) {
+	var tx Tx
+	err := d.guard(ctx, OpTx, func() (err error) {
+		tx, err = d.Driver.Tx(ctx)
+		return err
+	})
+	return tx, err
+}
+
+// BeginTx calls the underlying driver BeginTx method if it is supported,
+// and if it is admitted by the rate limit and the circuit breaker.
+func (d *GuardDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	var tx Tx
+	err := d.guard(ctx, OpTx, func() (err error) {
+		tx, err = drv.BeginTx(ctx, opts)
+		return err
+	})
+	return tx, err
+}
+
+// guard executes the given operation if it is admitted by its rate
+// limit and the circuit breaker, and reports its result to the breaker.
+func (d *GuardDriver) guard(ctx context.Context, op Operation, fn func() error) error {
+	if l, ok := d.limits[op]; ok {
+		if err := l.wait(ctx, d.now); err != nil {
+			return err
+		}
+	}
+	if d.breaker == nil {
+		return fn()
+	}
+	gen, err := d.breaker.allow(d.now())
+	if err != nil {
+		return err
+	}
+	start := d.now()
+	err = fn()
+	end := d.now()
+	d.breaker.done(end, gen, err, end.Sub(start))
+	return err
+}
+
+// rateLimit is a token bucket that is refilled at a constant rate.
+type rateLimit struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second.
+	burst  float64 // bucket size.
+	tokens float64 // available tokens. Negative if there are waiters.
+	last   time.Time
+}
+
+// wait waits until a token is available, or fails if the context is done before.
+func (l *rateLimit) wait(ctx context.Context, now func() time.Time) error {
+	t := now()
+	l.mu.Lock()
+	if !l.last.IsZero() {
+		l.tokens += t.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = t
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+	if delay == 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && t.Add(delay).After(deadline) {
+		l.release()
+		return ErrRateLimited
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.release()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// release returns a reserved token that was not used.
+func (l *rateLimit) release() {
+	l.mu.Lock()
+	l.tokens++
+	l.mu.Unlock()
+}
+
+// breaker implements the circuit breaker.
+type breaker struct {
+	BreakerConfig
+	mu       sync.Mutex
+	current  BreakerState
+	gen      uint64    // incremented on state changes, for ignoring results of previous states.
+	failures int       // consecutive failures in closed state.
+	openedAt time.Time // the time the circuit was opened.
+	probes   int       // probes in flight in half-open state.
+	passed   int       // probes that succeeded in half-open state.
+}
+
+// state returns the current state, and switches an open circuit to half-open if its timeout expired.
+func (b *breaker) state(now time.Time) BreakerState {
+	b.mu.Lock()
+	notify := b.expire(now)
+	s := b.current
+	b.mu.Unlock()
+	notify()
+	return s
+}
+
+// allow reports if an operation can be executed, and returns the generation it was admitted in.
+func (b *breaker) allow(now time.Time) (uint64, error) {
+	b.mu.Lock()
+	notify := b.expire(now)
+	defer notify()
+	defer b.mu.Unlock()
+	switch {
+	case b.current == BreakerOpen:
+		return 0, ErrCircuitOpen
+	case b.current == BreakerHalfOpen && b.probes >= b.Probes:
+		return 0, ErrCircuitOpen
+	case b.current == BreakerHalfOpen:
+		b.probes++
+	}
+	return b.gen, nil
+}
+
+// done records the result of an operation that was admitted in the given generation.
+func (b *breaker) done(now time.Time, gen uint64, err error, took time.Duration) {
+	failed := err != nil && b.IsFailure(err) || b.Latency > 0 && took > b.Latency
+	notify := func() {}
+	b.mu.Lock()
+	defer func() { notify() }()
+	defer b.mu.Unlock()
+	if gen != b.gen {
+		return
+	}
+	switch b.current {
+	case BreakerClosed:
+		if !failed {
+			b.failures = 0
+		} else if b.failures++; b.failures >= b.Failures {
+			notify = b.set(BreakerOpen, now)
+		}
+	case BreakerHalfOpen:
+		b.probes--
+		if failed {
+			notify = b.set(BreakerOpen, now)
+		} else if b.passed++; b.passed >= b.Probes {
+			notify = b.set(BreakerClosed, now)
+		}
+	}
+}
+
+// expire switches an open circuit to half-open if its timeout expired. It must be called under the lock.
+func (b *breaker) expire(now time.Time) func() {
+	if b.current == BreakerOpen && now.Sub(b.openedAt) >= b.Timeout {
+		return b.set(BreakerHalfOpen, now)
+	}
+	return func() {}
+}
+
+// set changes the state of the circuit, and returns a function for notifying
+// the OnStateChange hook after the lock is released. It must be called under the lock.
+func (b *breaker) set(s BreakerState, now time.Time) func() {
+	from := b.current
+	b.current, b.failures, b.probes, b.passed = s, 0, 0, 0
+	b.gen++
+	if s == BreakerOpen {
+		b.openedAt = now
+	}
+	return func() {
+		if b.OnStateChange != nil {
+			b.OnStateChange(from, s)
+		}
+	}
+}
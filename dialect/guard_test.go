@@ -0,0 +1,150 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockDriver returns the error of its next call, and sleeps on the clock of the test.
+type mockDriver struct {
+	Driver
+	errs  []error
+	calls int
+	clock *time.Time
+	took  time.Duration
+}
+
+func (d *mockDriver) Exec(context.Context, string, any, any) error {
+	d.calls++
+	*d.clock = d.clock.Add(d.took)
+	if len(d.errs) == 0 {
+		return nil
+	}
+	err := d.errs[0]
+	d.errs = d.errs[1:]
+	return err
+}
+
+func (d *mockDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.Exec(ctx, query, args, v)
+}
+
+func TestGuard_RateLimit(t *testing.T) {
+	now := time.Now()
+	drv := Guard(&mockDriver{clock: &now}, WithRateLimit(OpExec, 1, 2))
+	drv.now = func() time.Time { return now }
+	ctx, cancel := context.WithDeadline(context.Background(), now.Add(500*time.Millisecond))
+	defer cancel()
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	// The burst was consumed, and the next token is available after the deadline.
+	require.ErrorIs(t, drv.Exec(ctx, "", nil, nil), ErrRateLimited)
+	// Operations without limits are not affected.
+	require.NoError(t, drv.Query(ctx, "", nil, nil))
+	now = now.Add(time.Second)
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	require.ErrorIs(t, drv.Exec(ctx, "", nil, nil), ErrRateLimited)
+
+	drv = Guard(&mockDriver{clock: &now}, WithRateLimit(OpQuery, 100, 1))
+	require.NoError(t, drv.Query(context.Background(), "", nil, nil))
+	start := time.Now()
+	require.NoError(t, drv.Query(context.Background(), "", nil, nil))
+	require.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond, "waits for the next token")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, drv.Query(canceled, "", nil, nil), context.Canceled)
+
+	// Non-positive rates are ignored.
+	for _, r := range []float64{0, -1, math.NaN()} {
+		drv = Guard(&mockDriver{clock: &now}, WithRateLimit(OpExec, r, 1))
+		require.Empty(t, drv.limits)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, drv.Exec(ctx, "", nil, nil))
+		}
+	}
+}
+
+func TestGuard_CircuitBreaker(t *testing.T) {
+	var (
+		now     = time.Now()
+		ctx     = context.Background()
+		errConn = errors.New("connection refused")
+		changes []BreakerState
+		mock    = &mockDriver{clock: &now}
+	)
+	drv := Guard(mock, WithCircuitBreaker(BreakerConfig{
+		Failures: 2,
+		Latency:  time.Second,
+		Timeout:  time.Minute,
+		OnStateChange: func(_, to BreakerState) {
+			changes = append(changes, to)
+		},
+	}))
+	drv.now = func() time.Time { return now }
+
+	// Canceled operations and failures that are followed by a success do not open the circuit.
+	mock.errs = []error{errConn, context.Canceled, nil, errConn}
+	for range mock.errs {
+		_ = drv.Exec(ctx, "", nil, nil)
+	}
+	require.Equal(t, BreakerClosed, drv.State())
+
+	// Slow operations are failures.
+	mock.took = 2 * time.Second
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	require.Equal(t, BreakerOpen, drv.State())
+	calls := mock.calls
+	require.ErrorIs(t, drv.Query(ctx, "", nil, nil), ErrCircuitOpen)
+	require.Equal(t, calls, mock.calls, "underlying driver is not called")
+
+	// A failing probe opens the circuit again.
+	now = now.Add(time.Minute)
+	require.Equal(t, BreakerHalfOpen, drv.State())
+	mock.took, mock.errs = 0, []error{errConn}
+	require.ErrorIs(t, drv.Exec(ctx, "", nil, nil), errConn)
+	require.Equal(t, BreakerOpen, drv.State())
+	now = now.Add(30 * time.Second)
+	require.ErrorIs(t, drv.Exec(ctx, "", nil, nil), ErrCircuitOpen)
+
+	// A successful probe closes the circuit.
+	now = now.Add(30 * time.Second)
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	require.Equal(t, BreakerClosed, drv.State())
+	require.Equal(t, []BreakerState{BreakerOpen, BreakerHalfOpen, BreakerOpen, BreakerHalfOpen, BreakerClosed}, changes)
+}
+
+func TestGuard_Probes(t *testing.T) {
+	now := time.Now()
+	drv := Guard(&mockDriver{clock: &now}, WithCircuitBreaker(BreakerConfig{Failures: 1, Timeout: time.Second, Probes: 2}))
+	drv.now = func() time.Time { return now }
+	b := drv.breaker
+	gen, err := b.allow(now)
+	require.NoError(t, err)
+	b.done(now, gen, errors.New("failed"), 0)
+	require.Equal(t, BreakerOpen, drv.State())
+
+	now = now.Add(time.Second)
+	p1, err := b.allow(now)
+	require.NoError(t, err)
+	p2, err := b.allow(now)
+	require.NoError(t, err)
+	_, err = b.allow(now)
+	require.ErrorIs(t, err, ErrCircuitOpen, "probes limit was reached")
+	b.done(now, p1, nil, 0)
+	require.Equal(t, BreakerHalfOpen, drv.State())
+	b.done(now, p2, nil, 0)
+	require.Equal(t, BreakerClosed, drv.State())
+
+	// Results of operations that were admitted in previous states are ignored.
+	b.done(now, gen, errors.New("failed"), 0)
+	require.Equal(t, BreakerClosed, drv.State())
+}
@@ -0,0 +1,84 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"time"
+)
+
+// QueryLog describes a driver operation that is logged by the LogQueries middleware.
+type QueryLog struct {
+	Call                   // The operation, its query and its arguments.
+	Duration time.Duration // The time it took to execute the operation.
+	Err      error         // The error returned by the operation, if any.
+}
+
+// QueryLogger is the interface that wraps the LogQuery method, and is
+// implemented by the structured loggers of the LogQueries middleware.
+type QueryLogger interface {
+	LogQuery(context.Context, QueryLog)
+}
+
+// The QueryLoggerFunc type is an adapter to allow the use of
+// ordinary functions as QueryLogger.
+type QueryLoggerFunc func(context.Context, QueryLog)
+
+// LogQuery calls f(ctx, l).
+func (f QueryLoggerFunc) LogQuery(ctx context.Context, l QueryLog) {
+	f(ctx, l)
+}
+
+// LogOption configures the LogQueries middleware.
+type LogOption func(*logConfig)
+
+// logConfig holds the configuration of the LogQueries middleware.
+type logConfig struct {
+	threshold time.Duration
+	redact    Redactor
+}
+
+// WithSlowQueryThreshold logs only the operations that take longer than the given duration
+// to execute. Failed operations are logged regardless of their duration.
+func WithSlowQueryThreshold(d time.Duration) LogOption {
+	return func(c *logConfig) {
+		c.threshold = d
+	}
+}
+
+// WithRedactor sets the redactor of the logged arguments. See Redactor for more info.
+func WithRedactor(r Redactor) LogOption {
+	return func(c *logConfig) {
+		c.redact = r
+	}
+}
+
+// LogQueries returns a middleware that passes the driver operations, after they were executed, to
+// the given structured logger along with their latency and error. Unlike Debug, that prints the
+// operations before they are executed, it allows logging slow queries and filtering them by fields.
+//
+//	drv = dialect.Wrap(drv, dialect.LogQueries(
+//		dialect.QueryLoggerFunc(func(ctx context.Context, l dialect.QueryLog) {
+//			logger.Warn("slow query", zap.String("query", l.Query), zap.Duration("duration", l.Duration), zap.Error(l.Err))
+//		}),
+//		dialect.WithSlowQueryThreshold(100*time.Millisecond),
+//	))
+func LogQueries(l QueryLogger, opts ...LogOption) Middleware {
+	c := &logConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return Intercept(func(ctx context.Context, call Call, next func(context.Context) error) error {
+		start := time.Now()
+		err := next(ctx)
+		if took := time.Since(start); err != nil || took >= c.threshold {
+			if c.redact != nil {
+				call.Args = c.redact(call.Query, call.Args)
+			}
+			l.LogQuery(ctx, QueryLog{Call: call, Duration: took, Err: err})
+		}
+		return err
+	})
+}
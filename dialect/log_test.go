@@ -0,0 +1,95 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogQueries(t *testing.T) {
+	var (
+		logs []QueryLog
+		now  = time.Now()
+		ctx  = context.Background()
+		mock = &mockDriver{clock: &now}
+		l    = QueryLoggerFunc(func(_ context.Context, l QueryLog) {
+			logs = append(logs, l)
+		})
+	)
+	drv := Wrap(txDriver{mock}, LogQueries(l))
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users", []any{1}, nil))
+	require.Len(t, logs, 1)
+	require.Equal(t, Call{Op: OpExec, Query: "DELETE FROM users", Args: []any{1}}, logs[0].Call)
+	require.NoError(t, logs[0].Err)
+
+	logs = nil
+	errConn := errors.New("connection refused")
+	drv = Wrap(txDriver{mock}, LogQueries(l, WithSlowQueryThreshold(time.Hour)))
+	require.NoError(t, drv.Query(ctx, "SELECT name FROM users", []any{}, nil))
+	require.Empty(t, logs, "fast queries are not logged")
+	mock.errs = []error{errConn}
+	require.ErrorIs(t, drv.Exec(ctx, "DELETE FROM users", []any{}, nil), errConn)
+	require.Len(t, logs, 1, "failed operations are logged")
+	require.Equal(t, errConn, logs[0].Err)
+
+	logs = nil
+	drv = Wrap(sleepDriver{txDriver{mock}}, LogQueries(l, WithSlowQueryThreshold(time.Millisecond)))
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "SELECT name FROM users", []any{}, nil))
+	require.Len(t, logs, 1)
+	require.True(t, logs[0].Tx)
+	require.Equal(t, OpQuery, logs[0].Op)
+}
+
+// sleepDriver is a txDriver that sleeps before executing its queries.
+type sleepDriver struct {
+	txDriver
+}
+
+func (d sleepDriver) Query(ctx context.Context, query string, args, v any) error {
+	time.Sleep(5 * time.Millisecond)
+	return d.txDriver.Query(ctx, query, args, v)
+}
+
+func (d sleepDriver) Tx(context.Context) (Tx, error) {
+	return NopTx(d), nil
+}
+
+func TestRedactor(t *testing.T) {
+	var (
+		now    = time.Now()
+		ctx    = context.Background()
+		mock   = &mockDriver{clock: &now}
+		redact = func(query string, args any) any {
+			return []any{"<sensitive>"}
+		}
+	)
+	var logs []QueryLog
+	drv := Wrap(txDriver{mock}, LogQueries(QueryLoggerFunc(func(_ context.Context, l QueryLog) {
+		logs = append(logs, l)
+	}), WithRedactor(redact)))
+	require.NoError(t, drv.Exec(ctx, "UPDATE users SET password = ?", []any{"secret"}, nil))
+	require.Len(t, logs, 1)
+	require.Equal(t, []any{"<sensitive>"}, logs[0].Args)
+
+	var printed []string
+	drv = DebugWithRedactor(txDriver{mock}, redact, func(v ...any) {
+		printed = append(printed, fmt.Sprint(v...))
+	})
+	require.NoError(t, drv.Exec(ctx, "UPDATE users SET password = ?", []any{"secret"}, nil))
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "UPDATE users SET password = ?", []any{"secret"}, nil))
+	require.Len(t, printed, 3)
+	require.Equal(t, "driver.Exec: query=UPDATE users SET password = ? args=[<sensitive>]", printed[0])
+	require.NotContains(t, printed[2], "secret")
+}
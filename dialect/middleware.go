@@ -0,0 +1,266 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Middleware wraps a driver with additional behavior, like logging, metrics, retries or tracing.
+// The returned driver is passed to the generated client using the ent.Driver option:
+//
+//	drv := dialect.Wrap(sqldrv,
+//		dialect.Logging(logger),
+//		dialect.Retry(3, 10*time.Millisecond, isTransient),
+//	)
+//	client := ent.NewClient(ent.Driver(drv))
+type Middleware func(Driver) Driver
+
+// Chain returns a middleware that applies the given middlewares in order. That is, the first
+// middleware is the outermost one, and it is the first to see the operations of the driver.
+func Chain(mws ...Middleware) Middleware {
+	return func(d Driver) Driver {
+		for i := len(mws) - 1; i >= 0; i-- {
+			d = mws[i](d)
+		}
+		return d
+	}
+}
+
+// Wrap wraps the given driver with the middlewares. See Chain for the order they are applied.
+func Wrap(d Driver, mws ...Middleware) Driver {
+	return Chain(mws...)(d)
+}
+
+// Logging returns a middleware that logs all driver operations. See DebugWithContext for more info.
+func Logging(logger func(context.Context, ...any)) Middleware {
+	return func(d Driver) Driver {
+		return DebugWithContext(d, logger)
+	}
+}
+
+// Guarding returns a middleware that guards the driver operations. See Guard for more info.
+func Guarding(opts ...GuardOption) Middleware {
+	return func(d Driver) Driver {
+		return Guard(d, opts...)
+	}
+}
+
+// Transaction operations that are intercepted by the Intercept middleware.
+const (
+	OpCommit   Operation = "commit"   // Tx.Commit.
+	OpRollback Operation = "rollback" // Tx.Rollback.
+)
+
+// Call describes a driver operation that is intercepted by the Intercept middleware.
+type Call struct {
+	Op    Operation // The operation.
+	Query string    // The statement or query. Empty for transaction operations.
+	Args  any       // The arguments of the statement or query.
+	Tx    bool      // Reports if the operation is executed in a transaction.
+}
+
+// InterceptFunc intercepts a driver operation. It must call next for executing the operation,
+// and it may change the context that is passed to it. For example, for starting a tracing span.
+type InterceptFunc func(ctx context.Context, c Call, next func(context.Context) error) error
+
+// Intercept returns a middleware that calls the given function for every driver operation, including
+// the operations of the transactions it starts. It is the building block for metrics and tracing:
+//
+//	dialect.Intercept(func(ctx context.Context, c dialect.Call, next func(context.Context) error) error {
+//		start := time.Now()
+//		err := next(ctx)
+//		latency.WithLabelValues(string(c.Op)).Observe(time.Since(start).Seconds())
+//		return err
+//	})
+func Intercept(fn InterceptFunc) Middleware {
+	return func(d Driver) Driver {
+		return &interceptDriver{Driver: d, fn: fn}
+	}
+}
+
+// Retry returns a middleware that retries failed operations that are executed outside of transactions,
+// up to the given number of attempts. The delay between attempts starts at the given backoff and is doubled
+// after every attempt. If retryable is nil, all errors, except for context errors, are retried. Note that
+// retrying statements that are not idempotent is safe only if their errors guarantee they were not applied.
+func Retry(attempts int, backoff time.Duration, retryable func(error) bool) Middleware {
+	if retryable == nil {
+		retryable = func(err error) bool {
+			return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+		}
+	}
+	return Intercept(func(ctx context.Context, c Call, next func(context.Context) error) error {
+		err := next(ctx)
+		if c.Tx || c.Op == OpCommit || c.Op == OpRollback {
+			return err
+		}
+		for delay, i := backoff, 1; i < attempts && err != nil && retryable(err); delay, i = delay*2, i+1 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			err = next(ctx)
+		}
+		return err
+	})
+}
+
+// interceptDriver is a driver that calls an InterceptFunc for its operations.
+type interceptDriver struct {
+	Driver
+	fn InterceptFunc
+}
+
+// Exec calls the underlying driver Exec method through the interceptor.
+func (d *interceptDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.fn(ctx, Call{Op: OpExec, Query: query, Args: args}, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// ExecContext calls the underlying driver ExecContext method through the interceptor, if it is supported.
+func (d *interceptDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	var res sql.Result
+	err := d.fn(ctx, Call{Op: OpExec, Query: query, Args: args}, func(ctx context.Context) (err error) {
+		res, err = drv.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// Query calls the underlying driver Query method through the interceptor.
+func (d *interceptDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.fn(ctx, Call{Op: OpQuery, Query: query, Args: args}, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// QueryContext calls the underlying driver QueryContext method through the interceptor, if it is supported.
+func (d *interceptDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	var rows *sql.Rows
+	err := d.fn(ctx, Call{Op: OpQuery, Query: query, Args: args}, func(ctx context.Context) (err error) {
+		rows, err = drv.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Tx calls the underlying driver Tx method through the interceptor.
+func (d *interceptDriver) Tx(ctx context.Context) (Tx, error) {
+	var tx Tx
+	err := d.fn(ctx, Call{Op: OpTx}, func(ctx context.Context) (err error) {
+		tx, err = d.Driver.Tx(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &interceptTx{Tx: tx, fn: d.fn, ctx: ctx}, nil
+}
+
+// BeginTx calls the underlying driver BeginTx method through the interceptor, if it is supported.
+func (d *interceptDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	var tx Tx
+	err := d.fn(ctx, Call{Op: OpTx}, func(ctx context.Context) (err error) {
+		tx, err = drv.BeginTx(ctx, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &interceptTx{Tx: tx, fn: d.fn, ctx: ctx}, nil
+}
+
+// interceptTx is a transaction that calls an InterceptFunc for its operations.
+type interceptTx struct {
+	Tx
+	fn  InterceptFunc
+	ctx context.Context // the context the transaction was started with.
+}
+
+// Exec calls the underlying transaction Exec method through the interceptor.
+func (t *interceptTx) Exec(ctx context.Context, query string, args, v any) error {
+	return t.fn(ctx, Call{Op: OpExec, Query: query, Args: args, Tx: true}, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// ExecContext calls the underlying transaction ExecContext method through the interceptor, if it is supported.
+func (t *interceptTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	tx, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	var res sql.Result
+	err := t.fn(ctx, Call{Op: OpExec, Query: query, Args: args, Tx: true}, func(ctx context.Context) (err error) {
+		res, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// Query calls the underlying transaction Query method through the interceptor.
+func (t *interceptTx) Query(ctx context.Context, query string, args, v any) error {
+	return t.fn(ctx, Call{Op: OpQuery, Query: query, Args: args, Tx: true}, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// QueryContext calls the underlying transaction QueryContext method through the interceptor, if it is supported.
+func (t *interceptTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	tx, ok := t.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	var rows *sql.Rows
+	err := t.fn(ctx, Call{Op: OpQuery, Query: query, Args: args, Tx: true}, func(ctx context.Context) (err error) {
+		rows, err = tx.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Commit calls the underlying transaction Commit method through the interceptor.
+func (t *interceptTx) Commit() error {
+	return t.fn(t.ctx, Call{Op: OpCommit, Tx: true}, func(context.Context) error {
+		return t.Tx.Commit()
+	})
+}
+
+// Rollback calls the underlying transaction Rollback method through the interceptor.
+func (t *interceptTx) Rollback() error {
+	return t.fn(t.ctx, Call{Op: OpRollback, Tx: true}, func(context.Context) error {
+		return t.Tx.Rollback()
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package dialect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// txDriver is a mockDriver that supports no-op transactions.
+type txDriver struct {
+	*mockDriver
+}
+
+func (d txDriver) Tx(context.Context) (Tx, error) {
+	return NopTx(d), nil
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	mw := func(name string) Middleware {
+		return Intercept(func(ctx context.Context, c Call, next func(context.Context) error) error {
+			calls = append(calls, fmt.Sprintf("%s:%s", name, c.Op))
+			return next(ctx)
+		})
+	}
+	now := time.Now()
+	drv := Wrap(txDriver{&mockDriver{clock: &now}}, mw("a"), mw("b"))
+	ctx := context.Background()
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	require.Equal(t, []string{"a:exec", "b:exec"}, calls, "first middleware is the outermost")
+
+	calls = nil
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "", nil, nil))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, []string{"a:tx", "b:tx", "a:query", "b:query", "a:commit", "b:commit"}, calls)
+}
+
+func TestIntercept(t *testing.T) {
+	type ctxKey struct{}
+	var got []Call
+	now := time.Now()
+	mock := &mockDriver{clock: &now}
+	drv := Wrap(txDriver{mock}, Intercept(func(ctx context.Context, c Call, next func(context.Context) error) error {
+		got = append(got, c)
+		return next(context.WithValue(ctx, ctxKey{}, c.Op))
+	}))
+	ctx := context.Background()
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users", []any{1}, nil))
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM pets", []any{}, nil))
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, []Call{
+		{Op: OpExec, Query: "DELETE FROM users", Args: []any{1}},
+		{Op: OpTx},
+		{Op: OpExec, Query: "DELETE FROM pets", Args: []any{}, Tx: true},
+		{Op: OpRollback, Tx: true},
+	}, got)
+	require.Equal(t, 2, mock.calls)
+}
+
+func TestRetry(t *testing.T) {
+	var (
+		now     = time.Now()
+		ctx     = context.Background()
+		errConn = errors.New("connection refused")
+		mock    = &mockDriver{clock: &now}
+	)
+	drv := Wrap(txDriver{mock}, Retry(3, time.Millisecond, nil))
+	mock.errs = []error{errConn, errConn}
+	require.NoError(t, drv.Exec(ctx, "", nil, nil))
+	require.Equal(t, 3, mock.calls)
+
+	mock.calls, mock.errs = 0, []error{errConn, errConn, errConn}
+	require.ErrorIs(t, drv.Query(ctx, "", nil, nil), errConn)
+	require.Equal(t, 3, mock.calls, "attempts are limited")
+
+	mock.calls, mock.errs = 0, []error{context.DeadlineExceeded}
+	require.ErrorIs(t, drv.Exec(ctx, "", nil, nil), context.DeadlineExceeded)
+	require.Equal(t, 1, mock.calls, "context errors are not retried")
+
+	mock.calls, mock.errs = 0, []error{errConn}
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.ErrorIs(t, tx.Exec(ctx, "", nil, nil), errConn)
+	require.Equal(t, 1, mock.calls, "statements in transactions are not retried")
+
+	drv = Wrap(txDriver{mock}, Retry(3, time.Millisecond, func(err error) bool {
+		return !errors.Is(err, errConn)
+	}))
+	mock.calls, mock.errs = 0, []error{errConn}
+	require.ErrorIs(t, drv.Exec(ctx, "", nil, nil), errConn)
+	require.Equal(t, 1, mock.calls)
+}
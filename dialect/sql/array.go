@@ -0,0 +1,224 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// ArrayValue wraps a slice value (e.g. []string or []int) of a JSON field that is stored
+// in a native array column in PostgreSQL (e.g. text[] or bigint[]). In PostgreSQL, the value
+// is passed to the database as an array literal, and in other dialects as a JSON array.
+//
+//	Insert("users").
+//		Columns("tags").
+//		Values(ArrayValue{V: []string{"a", "b"}})
+type ArrayValue struct {
+	V any
+}
+
+// Literal returns the PostgreSQL array literal of the value. For example, `{"a","b"}`.
+func (a ArrayValue) Literal() (string, error) {
+	var b strings.Builder
+	if err := writeArray(&b, reflect.ValueOf(a.V)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// arg returns the argument of the value for the given dialect.
+func (a ArrayValue) arg(d string) (any, error) {
+	switch {
+	case a.V == nil:
+		return nil, nil
+	case d == dialect.Postgres:
+		return a.Literal()
+	default:
+		buf, err := json.Marshal(a.V)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(buf), nil
+	}
+}
+
+// writeArray writes the array literal of the given value.
+func writeArray(b *strings.Builder, rv reflect.Value) error {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			b.WriteString("NULL")
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		b.WriteByte('{')
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := writeArray(b, rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		b.WriteByte('}')
+	case reflect.String:
+		b.WriteByte('"')
+		for _, r := range rv.String() {
+			if r == '"' || r == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+		b.WriteByte('"')
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(rv.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(rv.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		b.WriteString(strconv.FormatFloat(rv.Float(), 'g', -1, 64))
+	default:
+		return fmt.Errorf("sql: unsupported array element type: %s", rv.Type())
+	}
+	return nil
+}
+
+// UnmarshalArray parses the value of a JSON field that is stored in a native array column
+// in PostgreSQL, and stores the result in the value pointed to by v. Both PostgreSQL array
+// literals (e.g. {a,b}) and JSON arrays (e.g. ["a","b"]) are supported.
+func UnmarshalArray(data []byte, v any) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return json.Unmarshal(data, v)
+	}
+	t := reflect.TypeOf(v)
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	p := &arrayParser{data: data}
+	if t != nil {
+		p.kind = t.Kind()
+	}
+	var b bytes.Buffer
+	if err := p.parse(&b); err != nil {
+		return err
+	}
+	if p.pos < len(data) {
+		return fmt.Errorf("sql: unexpected %q after array literal", data[p.pos:])
+	}
+	return json.Unmarshal(b.Bytes(), v)
+}
+
+// arrayParser converts PostgreSQL array literals to JSON arrays.
+type arrayParser struct {
+	data []byte
+	pos  int
+	kind reflect.Kind // kind of the elements
+}
+
+// parse converts the array at the current position.
+func (p *arrayParser) parse(b *bytes.Buffer) error {
+	if c := p.next(); c != '{' {
+		return fmt.Errorf("sql: unexpected %q at the start of array literal", c)
+	}
+	b.WriteByte('[')
+	if p.peek() == '}' {
+		p.pos++
+		b.WriteByte(']')
+		return nil
+	}
+	for i := 0; ; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		switch p.peek() {
+		case '{':
+			if err := p.parse(b); err != nil {
+				return err
+			}
+		case '"':
+			s, err := p.quoted()
+			if err != nil {
+				return err
+			}
+			p.elem(b, s)
+		default:
+			start := p.pos
+			for p.pos < len(p.data) && p.data[p.pos] != ',' && p.data[p.pos] != '}' {
+				p.pos++
+			}
+			s := strings.TrimSpace(string(p.data[start:p.pos]))
+			if strings.EqualFold(s, "NULL") {
+				b.WriteString("null")
+			} else {
+				p.elem(b, s)
+			}
+		}
+		switch c := p.next(); c {
+		case ',':
+		case '}':
+			b.WriteByte(']')
+			return nil
+		default:
+			return fmt.Errorf("sql: unexpected %q in array literal", c)
+		}
+	}
+}
+
+// quoted reads a double-quoted element at the current position.
+func (p *arrayParser) quoted() (string, error) {
+	var s strings.Builder
+	for p.pos++; p.pos < len(p.data); p.pos++ {
+		switch c := p.data[p.pos]; c {
+		case '\\':
+			p.pos++
+			if p.pos < len(p.data) {
+				s.WriteByte(p.data[p.pos])
+			}
+		case '"':
+			p.pos++
+			return s.String(), nil
+		default:
+			s.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("sql: unterminated quoted element in array literal")
+}
+
+// elem writes the JSON value of an array element.
+func (p *arrayParser) elem(b *bytes.Buffer, s string) {
+	switch p.kind {
+	case reflect.String, reflect.Interface:
+		buf, _ := json.Marshal(s)
+		b.Write(buf)
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(s == "t" || s == "true"))
+	default:
+		b.WriteString(s)
+	}
+}
+
+func (p *arrayParser) peek() byte {
+	if p.pos < len(p.data) {
+		return p.data[p.pos]
+	}
+	return 0
+}
+
+func (p *arrayParser) next() byte {
+	c := p.peek()
+	p.pos++
+	return c
+}
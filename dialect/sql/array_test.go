@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrayValue(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{v: []string(nil), want: "{}"},
+		{v: []string{}, want: "{}"},
+		{v: []string{"a", `b"c`, `d\e`, "f,g"}, want: `{"a","b\"c","d\\e","f,g"}`},
+		{v: []int{1, -2}, want: "{1,-2}"},
+		{v: []uint8{1, 2}, want: "{1,2}"},
+		{v: []bool{true, false}, want: "{true,false}"},
+		{v: []float64{1.5, 2}, want: "{1.5,2}"},
+		{v: [][]int{{1, 2}, {3, 4}}, want: "{{1,2},{3,4}}"},
+		{v: []*string{nil}, want: "{NULL}"},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			s, err := ArrayValue{V: tt.v}.Literal()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, s)
+		})
+	}
+	_, err := ArrayValue{V: []struct{}{{}}}.Literal()
+	require.Error(t, err)
+
+	query, args := Dialect(dialect.Postgres).
+		Insert("users").
+		Columns("tags").
+		Values(ArrayValue{V: []string{"a"}}).
+		Query()
+	require.Equal(t, `INSERT INTO "users" ("tags") VALUES ($1)`, query)
+	require.Equal(t, []any{`{"a"}`}, args)
+	query, args = Dialect(dialect.SQLite).
+		Insert("users").
+		Columns("tags").
+		Values(ArrayValue{V: []string{"a"}}).
+		Query()
+	require.Equal(t, "INSERT INTO `users` (`tags`) VALUES (?)", query)
+	require.Equal(t, []any{json.RawMessage(`["a"]`)}, args)
+}
+
+func TestUnmarshalArray(t *testing.T) {
+	var s []string
+	require.NoError(t, UnmarshalArray([]byte(`{a,"b\"c","d,e",NULL," f "}`), &s))
+	require.Equal(t, []string{"a", `b"c`, "d,e", "", " f "}, s)
+	require.NoError(t, UnmarshalArray([]byte(`["x"]`), &s))
+	require.Equal(t, []string{"x"}, s)
+	require.NoError(t, UnmarshalArray([]byte(`{}`), &s))
+	require.Empty(t, s)
+
+	var ints [][]int64
+	require.NoError(t, UnmarshalArray([]byte(`{{1,2},{3,4}}`), &ints))
+	require.Equal(t, [][]int64{{1, 2}, {3, 4}}, ints)
+
+	var bs []bool
+	require.NoError(t, UnmarshalArray([]byte(`{t,f}`), &bs))
+	require.Equal(t, []bool{true, false}, bs)
+
+	var fs []float64
+	require.NoError(t, UnmarshalArray([]byte(`{1.5,2}`), &fs))
+	require.Equal(t, []float64{1.5, 2}, fs)
+
+	require.Error(t, UnmarshalArray([]byte(`{"a`), &s))
+	require.Error(t, UnmarshalArray([]byte(`{a}b`), &s))
+}
+
+func TestArrayContains(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("users")).
+		Where(ArrayContains("tags", "a")).
+		Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE $1 = ANY("tags")`, query)
+	require.Equal(t, []any{"a"}, args)
+
+	query, args = Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("users")).
+		Where(ArrayContains("tags", "a")).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE JSON_CONTAINS(`tags`, ?)", query)
+	require.Equal(t, []any{`"a"`}, args)
+
+	query, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("users")).
+		Where(ArrayContains("tags", 1)).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE EXISTS(SELECT * FROM JSON_EACH(`tags`) WHERE `value` = ?)", query)
+	require.Equal(t, []any{1}, args)
+}
@@ -14,10 +14,14 @@ package sql
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"entgo.io/ent/dialect"
 )
@@ -340,9 +344,7 @@ func (t *TableAlter) AddIndex(idx *IndexBuilder) *TableAlter {
 	}
 	b.WriteString("INDEX ")
 	b.Ident(idx.name)
-	b.Wrap(func(b *Builder) {
-		b.IdentComma(idx.columns...)
-	})
+	b.Wrap(idx.writeColumns)
 	t.Queries = append(t.Queries, b)
 	return t
 }
@@ -411,10 +413,11 @@ func (i *IndexAlter) Query() (string, []any) {
 // ForeignKeyBuilder is the builder for the foreign-key constraint clause.
 type ForeignKeyBuilder struct {
 	Builder
-	symbol  string
-	columns []string
-	actions []string
-	ref     *ReferenceBuilder
+	symbol     string
+	columns    []string
+	actions    []string
+	ref        *ReferenceBuilder
+	deferrable bool
 }
 
 // ForeignKey returns a builder for the foreign-key constraint clause in create/alter table statements.
@@ -461,6 +464,14 @@ func (fk *ForeignKeyBuilder) OnUpdate(action string) *ForeignKeyBuilder {
 	return fk
 }
 
+// Deferrable marks the constraint as deferrable and initially deferred. i.e. the constraint
+// is checked at the end of the transaction. The clause is supported only by PostgreSQL and SQLite,
+// and it is omitted for other dialects.
+func (fk *ForeignKeyBuilder) Deferrable() *ForeignKeyBuilder {
+	fk.deferrable = true
+	return fk
+}
+
 // Query returns query representation of a foreign key constraint.
 func (fk *ForeignKeyBuilder) Query() (string, []any) {
 	if fk.symbol != "" {
@@ -474,6 +485,9 @@ func (fk *ForeignKeyBuilder) Query() (string, []any) {
 	for _, action := range fk.actions {
 		fk.Pad().WriteString(action)
 	}
+	if fk.deferrable && (fk.postgres() || fk.sqlite()) {
+		fk.WriteString(" DEFERRABLE INITIALLY DEFERRED")
+	}
 	return fk.String(), fk.args
 }
 
@@ -520,6 +534,8 @@ type IndexBuilder struct {
 	table   string
 	method  string
 	columns []string
+	exprs   map[int]bool // positions of raw expressions in columns.
+	where   *Predicate
 }
 
 // CreateIndex creates a builder for the `CREATE INDEX` statement.
@@ -557,7 +573,15 @@ func (i *IndexBuilder) Table(table string) *IndexBuilder {
 	return i
 }
 
-// Using sets the method to create the index with.
+// Using sets the method to create the index with. For example, GIN or BRIN in
+// PostgreSQL, or HASH in MySQL. In MySQL, FULLTEXT and SPATIAL are supported
+// as well, and are used as the kind of the index.
+//
+//	Dialect(dialect.MySQL).
+//		CreateIndex("users_bio").
+//		Table("users").
+//		Column("bio").
+//		Using("FULLTEXT")
 func (i *IndexBuilder) Using(method string) *IndexBuilder {
 	i.method = method
 	return i
@@ -575,10 +599,44 @@ func (i *IndexBuilder) Columns(columns ...string) *IndexBuilder {
 	return i
 }
 
+// ColumnExpr appends a raw expression to the column list for the index.
+// Note that the expression is written as is, and therefore, expressions
+// that are not column references should be wrapped with parentheses.
+//
+//	CreateIndex("users_email").
+//		Table("users").
+//		ColumnExpr("(lower(email)) DESC")
+func (i *IndexBuilder) ColumnExpr(x string) *IndexBuilder {
+	if i.exprs == nil {
+		i.exprs = make(map[int]bool)
+	}
+	i.exprs[len(i.columns)] = true
+	i.columns = append(i.columns, x)
+	return i
+}
+
+// Where sets the predicate of a partial index. Note that partial
+// indexes are supported only by PostgreSQL and SQLite, and therefore,
+// the predicate is ignored by MySQL.
+//
+//	CreateIndex("users_email").
+//		Table("users").
+//		Column("email").
+//		Where(IsNull("deleted_at"))
+func (i *IndexBuilder) Where(p *Predicate) *IndexBuilder {
+	i.where = p
+	return i
+}
+
 // Query returns query representation of a reference clause.
 func (i *IndexBuilder) Query() (string, []any) {
+	// FULLTEXT and SPATIAL are index kinds in MySQL, and not index methods.
+	kind := i.dialect == dialect.MySQL && (strings.EqualFold(i.method, "FULLTEXT") || strings.EqualFold(i.method, "SPATIAL"))
 	i.WriteString("CREATE ")
-	if i.unique {
+	switch {
+	case kind:
+		i.WriteString(strings.ToUpper(i.method) + " ")
+	case i.unique:
 		i.WriteString("UNIQUE ")
 	}
 	i.WriteString("INDEX ")
@@ -593,22 +651,34 @@ func (i *IndexBuilder) Query() (string, []any) {
 		if i.method != "" {
 			i.WriteString(" USING ").Ident(i.method)
 		}
-		i.Wrap(func(b *Builder) {
-			b.IdentComma(i.columns...)
-		})
+		i.Wrap(i.writeColumns)
 	case dialect.MySQL:
-		i.Wrap(func(b *Builder) {
-			b.IdentComma(i.columns...)
-		})
-		if i.method != "" {
+		i.Wrap(i.writeColumns)
+		if i.method != "" && !kind {
 			i.WriteString(" USING " + i.method)
 		}
 	default:
-		i.Wrap(func(b *Builder) {
-			b.IdentComma(i.columns...)
-		})
+		i.Wrap(i.writeColumns)
+	}
+	if i.where != nil && i.dialect != dialect.MySQL {
+		i.WriteString(" WHERE ")
+		i.Join(i.where)
+	}
+	return i.String(), i.args
+}
+
+// writeColumns writes the columns and the expressions of the index.
+func (i *IndexBuilder) writeColumns(b *Builder) {
+	for j, c := range i.columns {
+		if j > 0 {
+			b.Comma()
+		}
+		if i.exprs[j] {
+			b.WriteString(c)
+		} else {
+			b.Ident(c)
+		}
 	}
-	return i.String(), nil
 }
 
 // DropIndexBuilder is a builder for `DROP INDEX` statement.
@@ -651,6 +721,116 @@ func (d *DropIndexBuilder) Query() (string, []any) {
 	return d.String(), nil
 }
 
+// PartitionBuilder is a builder for the statements that create or attach partitions of partitioned tables.
+type PartitionBuilder struct {
+	Builder
+	table  string         // partitioned table.
+	name   string         // partition name.
+	attach bool           // attach an existing table.
+	bound  func(*Builder) // partition bound.
+}
+
+// CreatePartition returns a builder for the statement that creates a new partition of a
+// partitioned table. Note that partition bounds are SQL expressions, and not arguments.
+//
+//	Dialect(dialect.Postgres).
+//		CreatePartition("logs", "logs_2023").
+//		FromTo("'2023-01-01'", "'2024-01-01'")
+//
+//	CREATE TABLE "logs_2023" PARTITION OF "logs" FOR VALUES FROM ('2023-01-01') TO ('2024-01-01')
+//
+// In MySQL, the partition is added to the table using the ALTER TABLE statement:
+//
+//	ALTER TABLE `logs` ADD PARTITION (PARTITION `logs_2023` VALUES LESS THAN ('2024-01-01'))
+func CreatePartition(table, name string) *PartitionBuilder {
+	return &PartitionBuilder{table: table, name: name}
+}
+
+// AttachPartition returns a builder for the statement that attaches an existing
+// table as a partition of a partitioned table. Supported only by PostgreSQL.
+//
+//	Dialect(dialect.Postgres).
+//		AttachPartition("logs", "logs_2023").
+//		FromTo("'2023-01-01'", "'2024-01-01'")
+//
+//	ALTER TABLE "logs" ATTACH PARTITION "logs_2023" FOR VALUES FROM ('2023-01-01') TO ('2024-01-01')
+func AttachPartition(table, name string) *PartitionBuilder {
+	return &PartitionBuilder{table: table, name: name, attach: true}
+}
+
+// FromTo sets the bounds of a range partition. The lower bound is inclusive and the upper
+// bound is exclusive. In MySQL, only the upper bound is used (VALUES LESS THAN).
+func (p *PartitionBuilder) FromTo(from, to string) *PartitionBuilder {
+	p.bound = func(b *Builder) {
+		if b.mysql() {
+			b.WriteString("VALUES LESS THAN (").WriteString(to).WriteByte(')')
+			return
+		}
+		b.WriteString("FOR VALUES FROM (").WriteString(from).WriteString(") TO (").WriteString(to).WriteByte(')')
+	}
+	return p
+}
+
+// In sets the values of a list partition.
+func (p *PartitionBuilder) In(values ...string) *PartitionBuilder {
+	p.bound = func(b *Builder) {
+		if !b.mysql() {
+			b.WriteString("FOR ")
+		}
+		b.WriteString("VALUES IN (").WriteString(strings.Join(values, ", ")).WriteByte(')')
+	}
+	return p
+}
+
+// Modulus sets the modulus and the remainder of a hash partition. Supported only by PostgreSQL.
+func (p *PartitionBuilder) Modulus(modulus, remainder int) *PartitionBuilder {
+	p.bound = func(b *Builder) {
+		if b.mysql() {
+			b.AddError(errors.New("sql: hash partition bounds are not supported by mysql"))
+			return
+		}
+		b.WriteString("FOR VALUES WITH (MODULUS ").WriteString(strconv.Itoa(modulus)).
+			WriteString(", REMAINDER ").WriteString(strconv.Itoa(remainder)).WriteByte(')')
+	}
+	return p
+}
+
+// Default marks the partition as the default partition of the table. In MySQL,
+// it is a range partition that holds all values (VALUES LESS THAN MAXVALUE).
+func (p *PartitionBuilder) Default() *PartitionBuilder {
+	p.bound = func(b *Builder) {
+		if b.mysql() {
+			b.WriteString("VALUES LESS THAN MAXVALUE")
+			return
+		}
+		b.WriteString("DEFAULT")
+	}
+	return p
+}
+
+// Query returns query representation of the partition statement.
+func (p *PartitionBuilder) Query() (string, []any) {
+	switch {
+	case p.bound == nil:
+		p.AddError(fmt.Errorf("sql: missing bound for partition %q", p.name))
+	case p.mysql() && p.attach:
+		p.AddError(errors.New("sql: attaching partitions is not supported by mysql"))
+	case p.mysql():
+		p.WriteString("ALTER TABLE ").Ident(p.table).WriteString(" ADD PARTITION (PARTITION ").Ident(p.name).Pad()
+		p.bound(&p.Builder)
+		p.WriteByte(')')
+	case p.postgres() && p.attach:
+		p.WriteString("ALTER TABLE ").Ident(p.table).WriteString(" ATTACH PARTITION ").Ident(p.name).Pad()
+		p.bound(&p.Builder)
+	case p.postgres():
+		p.WriteString("CREATE TABLE ").Ident(p.name).WriteString(" PARTITION OF ").Ident(p.table).Pad()
+		p.bound(&p.Builder)
+	default:
+		p.AddError(fmt.Errorf("sql: table partitioning is not supported by %q", p.dialect))
+	}
+	return p.String(), p.args
+}
+
 // InsertBuilder is a builder for `INSERT INTO` statement.
 type InsertBuilder struct {
 	Builder
@@ -660,6 +840,7 @@ type InsertBuilder struct {
 	defaults  bool
 	returning []string
 	values    [][]any
+	selector  *Selector
 	conflict  *conflict
 }
 
@@ -702,6 +883,21 @@ func (i *InsertBuilder) Values(values ...any) *InsertBuilder {
 	return i
 }
 
+// Select sets the query that provides the rows of the INSERT statement, instead
+// of the VALUES clause. For example, copying rows into a history table:
+//
+//	t := Table("users")
+//	Insert("users_history").
+//		Columns("id", "name").
+//		Select(Select(t.C("id"), t.C("name")).From(t).Where(EQ(t.C("active"), false)))
+//
+// Note that in SQLite, a SELECT statement that is followed by an ON CONFLICT clause
+// must contain a WHERE clause (e.g. "WHERE true") to avoid a parsing ambiguity.
+func (i *InsertBuilder) Select(s *Selector) *InsertBuilder {
+	i.selector = s
+	return i
+}
+
 // Default sets the default values clause based on the dialect type.
 func (i *InsertBuilder) Default() *InsertBuilder {
 	i.defaults = true
@@ -960,9 +1156,18 @@ func (i *InsertBuilder) QueryErr() (string, []any, error) {
 	b.WriteString("INSERT INTO ")
 	b.writeSchema(i.schema)
 	b.Ident(i.table).Pad()
-	if i.defaults && len(i.columns) == 0 {
+	switch {
+	case i.selector != nil:
+		if len(i.values) > 0 {
+			b.AddError(errors.New("sql: INSERT statement cannot contain both VALUES and SELECT"))
+		}
+		if len(i.columns) > 0 {
+			b.WriteByte('(').IdentComma(i.columns...).WriteString(") ")
+		}
+		b.Join(i.selector)
+	case i.defaults && len(i.columns) == 0:
 		i.writeDefault(&b)
-	} else {
+	default:
 		b.WriteByte('(').IdentComma(i.columns...).WriteByte(')')
 		b.WriteString(" VALUES ")
 		for j, v := range i.values {
@@ -990,6 +1195,9 @@ func (i *InsertBuilder) writeDefault(b *Builder) {
 
 func (i *InsertBuilder) writeConflict(b *Builder) {
 	switch i.Dialect() {
+	case dialect.ClickHouse:
+		b.AddError(errors.New("ClickHouse does not support the 'ON CONFLICT' clause"))
+		return
 	case dialect.MySQL:
 		b.WriteString(" ON DUPLICATE KEY UPDATE ")
 		// Fallback to ResolveWithIgnore() as MySQL
@@ -1044,6 +1252,8 @@ type UpdateBuilder struct {
 	order     []any
 	limit     *int
 	prefix    Queries
+	from      []TableView
+	joins     []join
 }
 
 // Update creates a builder for the `UPDATE` statement.
@@ -1111,6 +1321,57 @@ func (u *UpdateBuilder) FromSelect(s *Selector) *UpdateBuilder {
 	return u
 }
 
+// From appends the given tables to the `FROM` clause of the UPDATE statement, to allow
+// updating the rows of the table using the values of other tables. The tables are joined
+// using the WHERE clause. In MySQL, the tables are added to the table references of the
+// (multiple-table) UPDATE statement. For example:
+//
+//	// UPDATE "users" SET "role" = "groups"."role" FROM "groups" WHERE "users"."group_id" = "groups"."id"
+//	t1, t2 := Table("users"), Table("groups")
+//	Dialect(dialect.Postgres).
+//		Update(t1.name).
+//		Set("role", Expr(t2.C("role"))).
+//		From(t2).
+//		Where(ColumnsEQ(t1.C("group_id"), t2.C("id")))
+func (u *UpdateBuilder) From(t ...TableView) *UpdateBuilder {
+	u.from = append(u.from, t...)
+	return u
+}
+
+// Join appends a `JOIN` clause to the UPDATE statement. In PostgreSQL and SQLite, that do not
+// support JOINs in UPDATE statements, the table is added to the FROM clause, and its ON clause
+// is added to the WHERE clause. For example:
+//
+//	// UPDATE `users` JOIN `groups` ON `users`.`group_id` = `groups`.`id` SET `role` = `groups`.`role`
+//	t1, t2 := Table("users"), Table("groups")
+//	Update(t1.name).
+//		Join(t2).
+//		On(t1.C("group_id"), t2.C("id")).
+//		Set("role", Expr(t2.C("role")))
+func (u *UpdateBuilder) Join(t TableView) *UpdateBuilder {
+	u.joins = append(u.joins, join{kind: "JOIN", table: t})
+	return u
+}
+
+// OnP sets or appends the given predicate for the `ON` clause of the last join.
+func (u *UpdateBuilder) OnP(p *Predicate) *UpdateBuilder {
+	if len(u.joins) > 0 {
+		join := &u.joins[len(u.joins)-1]
+		switch {
+		case join.on == nil:
+			join.on = p
+		default:
+			join.on = And(join.on, p)
+		}
+	}
+	return u
+}
+
+// On sets the `ON` clause of the last join.
+func (u *UpdateBuilder) On(c1, c2 string) *UpdateBuilder {
+	return u.OnP(ColumnsEQ(c1, c2))
+}
+
 // Empty reports whether this builder does not contain update changes.
 func (u *UpdateBuilder) Empty() bool {
 	return len(u.columns) == 0 && len(u.nulls) == 0
@@ -1146,7 +1407,7 @@ func (u *UpdateBuilder) Prefix(stmts ...Querier) *UpdateBuilder {
 	return u
 }
 
-// Returning adds the `RETURNING` clause to the insert statement.
+// Returning adds the `RETURNING` clause to the update statement.
 // Supported by SQLite and PostgreSQL.
 func (u *UpdateBuilder) Returning(columns ...string) *UpdateBuilder {
 	u.returning = columns
@@ -1162,11 +1423,60 @@ func (u *UpdateBuilder) Query() (string, []any) {
 	}
 	b.WriteString("UPDATE ")
 	b.writeSchema(u.schema)
-	b.Ident(u.table).WriteString(" SET ")
-	u.writeSetter(&b)
-	if u.where != nil {
+	b.Ident(u.table)
+	where := u.where
+	switch multi := len(u.from) > 0 || len(u.joins) > 0; {
+	case multi && (u.postgres() || u.sqlite()):
+		b.WriteString(" SET ")
+		u.writeSetter(&b)
+		b.WriteString(" FROM ")
+		for i, t := range u.from {
+			if i > 0 {
+				b.Comma()
+			}
+			b.writeTableView(t)
+		}
+		var on []*Predicate
+		for i, j := range u.joins {
+			if i > 0 || len(u.from) > 0 {
+				b.Comma()
+			}
+			b.writeTableView(j.table)
+			if j.on != nil {
+				on = append(on, j.on)
+			}
+		}
+		if len(on) > 0 {
+			if where != nil {
+				on = append(on, where)
+			}
+			where = And(on...)
+		}
+	case multi:
+		if len(u.order) > 0 || u.limit != nil {
+			b.AddError(errors.New("ORDER BY and LIMIT are not supported by multiple-table UPDATE statements"))
+		}
+		for _, t := range u.from {
+			b.Comma()
+			b.writeTableView(t)
+		}
+		for _, j := range u.joins {
+			b.WriteString(" " + j.kind + " ")
+			b.writeTableView(j.table)
+			if j.on != nil {
+				b.WriteString(" ON ")
+				b.Join(j.on)
+			}
+		}
+		b.WriteString(" SET ")
+		u.writeSetter(&b)
+	default:
+		b.WriteString(" SET ")
+		u.writeSetter(&b)
+	}
+	if where != nil {
 		b.WriteString(" WHERE ")
-		b.Join(u.where)
+		b.Join(where)
 	}
 	joinReturning(u.returning, &b)
 	joinOrder(u.order, &b)
@@ -1174,9 +1484,33 @@ func (u *UpdateBuilder) Query() (string, []any) {
 		b.WriteString(" LIMIT ")
 		b.WriteString(strconv.Itoa(*u.limit))
 	}
+	u.AddError(b.Err())
 	return b.String(), b.args
 }
 
+// writeTableView writes the given table view to the builder.
+func (b *Builder) writeTableView(t TableView) {
+	switch t := t.(type) {
+	case *SelectTable:
+		t.SetDialect(b.dialect)
+		b.WriteString(t.ref())
+	case *Selector:
+		t.SetDialect(b.dialect)
+		b.Wrap(func(b *Builder) {
+			b.Join(t)
+		})
+		if t.as != "" {
+			b.WriteString(" AS ")
+			b.Ident(t.as)
+		}
+	case *WithBuilder:
+		t.SetDialect(b.dialect)
+		b.Ident(t.Name())
+	case *queryView:
+		b.Join(t.Querier)
+	}
+}
+
 // writeSetter writes the "SET" clause for the UPDATE statement.
 func (u *UpdateBuilder) writeSetter(b *Builder) {
 	for i, c := range u.nulls {
@@ -1205,9 +1539,10 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 // DeleteBuilder is a builder for `DELETE` statement.
 type DeleteBuilder struct {
 	Builder
-	table  string
-	schema string
-	where  *Predicate
+	table     string
+	schema    string
+	where     *Predicate
+	returning []string
 }
 
 // Delete creates a builder for the `DELETE` statement.
@@ -1250,6 +1585,13 @@ func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
 	return d
 }
 
+// Returning adds the `RETURNING` clause to the delete statement.
+// Supported by SQLite and PostgreSQL.
+func (d *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	d.returning = columns
+	return d
+}
+
 // Query returns query representation of a `DELETE` statement.
 func (d *DeleteBuilder) Query() (string, []any) {
 	d.WriteString("DELETE FROM ")
@@ -1259,6 +1601,7 @@ func (d *DeleteBuilder) Query() (string, []any) {
 		d.WriteString(" WHERE ")
 		d.Join(d.where)
 	}
+	joinReturning(d.returning, &d.Builder)
 	return d.String(), d.args
 }
 
@@ -1774,23 +2117,36 @@ func ContainsFold(col, sub string) *Predicate { return P().ContainsFold(col, sub
 
 // ContainsFold is a helper predicate that applies the LIKE predicate with case-folding.
 func (p *Predicate) ContainsFold(col, substr string) *Predicate {
+	return p.escapedLikeFold(col, "%", "%", substr)
+}
+
+// HasPrefixFold is a helper predicate that checks prefix using the LIKE predicate with case-folding.
+func HasPrefixFold(col, prefix string) *Predicate { return P().HasPrefixFold(col, prefix) }
+
+// HasPrefixFold is a helper predicate that checks prefix using the LIKE predicate with case-folding.
+func (p *Predicate) HasPrefixFold(col, prefix string) *Predicate {
+	return p.escapedLikeFold(col, "", "%", prefix)
+}
+
+// escapedLikeFold is like escapedLike, but applies case-folding on the column.
+func (p *Predicate) escapedLikeFold(col, left, right, word string) *Predicate {
 	return p.Append(func(b *Builder) {
-		w, escaped := escape(substr)
+		w, escaped := escape(word)
 		switch b.dialect {
 		case dialect.MySQL:
 			// We assume the CHARACTER SET is configured to utf8mb4,
 			// because this how it is defined in dialect/sql/schema.
 			b.Ident(col).WriteString(" COLLATE utf8mb4_general_ci LIKE ")
-			b.Arg("%" + strings.ToLower(w) + "%")
+			b.Arg(left + strings.ToLower(w) + right)
 		case dialect.Postgres:
 			b.Ident(col).WriteString(" ILIKE ")
-			b.Arg("%" + strings.ToLower(w) + "%")
+			b.Arg(left + strings.ToLower(w) + right)
 		default: // SQLite.
 			var f Func
 			f.SetDialect(b.dialect)
 			f.Lower(col)
 			b.WriteString(f.String()).WriteString(" LIKE ")
-			b.Arg("%" + strings.ToLower(w) + "%")
+			b.Arg(left + strings.ToLower(w) + right)
 			if escaped {
 				p.WriteString(" ESCAPE ").Arg("\\")
 			}
@@ -1798,6 +2154,102 @@ func (p *Predicate) ContainsFold(col, substr string) *Predicate {
 	})
 }
 
+// ArrayContains is a helper predicate that checks if the array column contains the given value.
+// In PostgreSQL, the column is expected to be a native array (e.g. text[]), and in other dialects
+// a JSON array.
+func ArrayContains(col string, v any) *Predicate { return P().ArrayContains(col, v) }
+
+// ArrayContains is a helper predicate that checks if the array column contains the given value.
+func (p *Predicate) ArrayContains(col string, v any) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch b.dialect {
+		case dialect.Postgres:
+			b.Arg(v).WriteString(" = ANY(").Ident(col).WriteByte(')')
+		case dialect.MySQL:
+			buf, err := json.Marshal(v)
+			if err != nil {
+				b.AddError(err)
+				return
+			}
+			b.WriteString("JSON_CONTAINS(").Ident(col).Comma().Arg(string(buf)).WriteByte(')')
+		default: // SQLite.
+			b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ").
+				Ident("value").WriteOp(OpEQ).Arg(v).WriteByte(')')
+		}
+	})
+}
+
+// DefaultTextSearchConfig is the text search configuration that is used
+// by the TextSearch predicate in PostgreSQL, if no other was configured.
+const DefaultTextSearchConfig = "simple"
+
+type (
+	// TextSearchOptions defines the options of the TextSearch predicate.
+	TextSearchOptions struct {
+		// Config is the text search configuration in PostgreSQL.
+		Config string
+		// Table is the FTS5 table that indexes the column in SQLite,
+		// and RowID is the column that holds the rowid of its rows.
+		Table, RowID string
+	}
+	// TextSearchOption allows configuring the TextSearchOptions using functional options.
+	TextSearchOption func(*TextSearchOptions)
+)
+
+// WithTextSearchConfig sets the text search configuration of the predicate in PostgreSQL.
+func WithTextSearchConfig(name string) TextSearchOption {
+	return func(o *TextSearchOptions) {
+		o.Config = name
+	}
+}
+
+// WithTextSearchTable sets the FTS5 table and the rowid column of the predicate in SQLite.
+func WithTextSearchTable(name, rowid string) TextSearchOption {
+	return func(o *TextSearchOptions) {
+		o.Table, o.RowID = name, rowid
+	}
+}
+
+// FullTextTable returns the name of the FTS5 table that indexes the given column in SQLite.
+func FullTextTable(table, column string) string {
+	return fmt.Sprintf("%s_%s_fts", table, column)
+}
+
+// TextSearch is a helper predicate that checks if the column matches the given full-text
+// search query. The query syntax is dialect-specific, and the column is expected to have a
+// full-text index (see entsql.FullText).
+//
+//	PostgreSQL: to_tsvector('simple', "title") @@ to_tsquery('simple', $1)
+//	MySQL:      MATCH (`title`) AGAINST (?)
+//	SQLite:     `rowid` IN (SELECT `rowid` FROM `posts_title_fts` WHERE `posts_title_fts` MATCH ?)
+func TextSearch(col, query string, opts ...TextSearchOption) *Predicate {
+	return P().TextSearch(col, query, opts...)
+}
+
+// TextSearch is a helper predicate that checks if the column matches the given full-text search query.
+func (p *Predicate) TextSearch(col, query string, opts ...TextSearchOption) *Predicate {
+	o := &TextSearchOptions{Config: DefaultTextSearchConfig}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return p.Append(func(b *Builder) {
+		switch b.dialect {
+		case dialect.Postgres:
+			config := "'" + strings.ReplaceAll(o.Config, "'", "''") + "'"
+			b.WriteString("to_tsvector(" + config + ", ").Ident(col).WriteString(") @@ to_tsquery(" + config + ", ").Arg(query).WriteByte(')')
+		case dialect.MySQL:
+			b.WriteString("MATCH (").Ident(col).WriteString(") AGAINST (").Arg(query).WriteByte(')')
+		default: // SQLite.
+			if o.Table == "" || o.RowID == "" {
+				b.AddError(fmt.Errorf("sql: missing FTS5 table of column %q for full-text search", col))
+				return
+			}
+			b.Ident(o.RowID).WriteString(" IN (SELECT ").Ident("rowid").WriteString(" FROM ").Ident(o.Table).
+				WriteString(" WHERE ").Ident(o.Table).WriteString(" MATCH ").Arg(query).WriteByte(')')
+		}
+	})
+}
+
 // CompositeGT returns a composite ">" predicate
 func CompositeGT(columns []string, args ...any) *Predicate {
 	return P().CompositeGT(columns, args...)
@@ -1977,6 +2429,137 @@ func (f *Func) Avg(ident string) {
 	f.byName("AVG", ident)
 }
 
+// Variance wraps the ident with the population variance aggregation function.
+func Variance(ident string) string {
+	f := &Func{}
+	f.Variance(ident)
+	return f.String()
+}
+
+// Variance wraps the ident with the population variance aggregation function. SQLite
+// does not provide a variance function, and it is computed as AVG(x*x) - AVG(x)*AVG(x).
+func (f *Func) Variance(ident string) {
+	switch f.Dialect() {
+	case dialect.SQLite:
+		f.Append(func(*Builder) {
+			writeVariance(&f.Builder, ident)
+		})
+	default:
+		f.byName("VAR_POP", ident)
+	}
+}
+
+// StdDev wraps the ident with the population standard deviation aggregation function.
+func StdDev(ident string) string {
+	f := &Func{}
+	f.StdDev(ident)
+	return f.String()
+}
+
+// StdDev wraps the ident with the population standard deviation aggregation function.
+// In SQLite, it is computed using the SQRT function, that requires the math functions
+// to be enabled in the SQLite build.
+func (f *Func) StdDev(ident string) {
+	switch f.Dialect() {
+	case dialect.SQLite:
+		f.Append(func(*Builder) {
+			f.WriteString("SQRT")
+			f.Wrap(func(b *Builder) {
+				writeVariance(b, ident)
+			})
+		})
+	default:
+		f.byName("STDDEV_POP", ident)
+	}
+}
+
+// writeVariance writes the population variance of the ident using the AVG function.
+func writeVariance(b *Builder, ident string) {
+	b.WriteString("AVG(").Ident(ident).WriteString(" * ").Ident(ident).WriteString(") - AVG(")
+	b.Ident(ident).WriteString(") * AVG(").Ident(ident).WriteByte(')')
+}
+
+// BoolOr wraps the ident with an aggregation function that reports if any of the values is true.
+func BoolOr(ident string) string {
+	f := &Func{}
+	f.BoolOr(ident)
+	return f.String()
+}
+
+// BoolOr wraps the ident with an aggregation function that reports if any of the values is true.
+// BOOL_OR in PostgreSQL, and MAX in MySQL and SQLite.
+func (f *Func) BoolOr(ident string) {
+	switch f.Dialect() {
+	case dialect.Postgres:
+		f.byName("BOOL_OR", ident)
+	default:
+		f.byName("MAX", ident)
+	}
+}
+
+// BoolAnd wraps the ident with an aggregation function that reports if all values are true.
+func BoolAnd(ident string) string {
+	f := &Func{}
+	f.BoolAnd(ident)
+	return f.String()
+}
+
+// BoolAnd wraps the ident with an aggregation function that reports if all values are true.
+// BOOL_AND in PostgreSQL, and MIN in MySQL and SQLite.
+func (f *Func) BoolAnd(ident string) {
+	switch f.Dialect() {
+	case dialect.Postgres:
+		f.byName("BOOL_AND", ident)
+	default:
+		f.byName("MIN", ident)
+	}
+}
+
+// ArrayAgg wraps the ident with an aggregation function that collects the values into a JSON array.
+func ArrayAgg(ident string) string {
+	f := &Func{}
+	f.ArrayAgg(ident)
+	return f.String()
+}
+
+// ArrayAgg wraps the ident with an aggregation function that collects the values into a JSON array.
+// JSON_AGG in PostgreSQL, JSON_ARRAYAGG in MySQL and JSON_GROUP_ARRAY in SQLite.
+func (f *Func) ArrayAgg(ident string) {
+	switch f.Dialect() {
+	case dialect.Postgres:
+		f.byName("JSON_AGG", ident)
+	case dialect.SQLite:
+		f.byName("JSON_GROUP_ARRAY", ident)
+	default:
+		f.byName("JSON_ARRAYAGG", ident)
+	}
+}
+
+// GroupConcat wraps the ident with an aggregation function that concatenates
+// the values into a string, separated by the given separator.
+func GroupConcat(ident, sep string) string {
+	f := &Func{}
+	f.GroupConcat(ident, sep)
+	return f.String()
+}
+
+// GroupConcat wraps the ident with an aggregation function that concatenates the values into
+// a string, separated by the given separator. STRING_AGG in PostgreSQL, and GROUP_CONCAT in
+// MySQL and SQLite.
+func (f *Func) GroupConcat(ident, sep string) {
+	sep = strings.ReplaceAll(sep, "'", "''")
+	f.Append(func(*Builder) {
+		switch f.Dialect() {
+		case dialect.Postgres:
+			f.WriteString("STRING_AGG(CAST(").Ident(ident).WriteString(" AS TEXT), '" + sep + "')")
+		case dialect.SQLite:
+			f.WriteString("GROUP_CONCAT(").Ident(ident).WriteString(", '" + sep + "')")
+		default:
+			f.WriteString("GROUP_CONCAT(").Ident(ident).WriteString(" SEPARATOR '" + strings.ReplaceAll(sep, `\`, `\\`) + "')")
+		}
+	})
+}
+
 // byName wraps an identifier with a function name.
 func (f *Func) byName(fn, ident string) {
 	f.Append(func(b *Builder) {
@@ -2002,6 +2585,65 @@ func (f *Func) String() string {
 	return f.Builder.String()
 }
 
+// DateAdd returns an expression that adds the given duration to the time value of the column,
+// using the date arithmetic of the dialect. A negative duration is subtracted from the value.
+//
+//	Update("users").Set("expired_at", DateAdd("expired_at", 24*time.Hour))
+func DateAdd(column string, d time.Duration) Querier {
+	return ExprFunc(func(b *Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.Ident(column).WriteString(" + INTERVAL '")
+			b.WriteString(strconv.FormatInt(d.Microseconds(), 10)).WriteString(" microseconds'")
+		case dialect.SQLite:
+			sign := "+"
+			if d < 0 {
+				sign = ""
+			}
+			b.WriteString("STRFTIME('%Y-%m-%d %H:%M:%f', ").Ident(column).Comma()
+			b.WriteString("'" + sign + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + " seconds')")
+		default:
+			b.WriteString("DATE_ADD(").Ident(column).Comma()
+			b.WriteString("INTERVAL " + strconv.FormatInt(d.Microseconds(), 10) + " MICROSECOND)")
+		}
+	})
+}
+
+// DateTrunc returns an expression that truncates the time value of the column to the given
+// unit. The supported units are: "year", "month", "day", "hour", "minute" and "second".
+//
+//	Select(Count("*")).
+//		AppendSelectExprAs(DateTrunc("day", "created_at"), "day").
+//		From(Table("users")).
+//		GroupBy("day")
+func DateTrunc(unit, column string) Querier {
+	return ExprFunc(func(b *Builder) {
+		formats, ok := truncFormats[unit]
+		if !ok {
+			b.AddError(fmt.Errorf("sql: unsupported unit %q for DateTrunc", unit))
+			return
+		}
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.WriteString("DATE_TRUNC('" + unit + "', ").Ident(column).WriteByte(')')
+		case dialect.SQLite:
+			b.WriteString("STRFTIME('" + formats[1] + "', ").Ident(column).WriteByte(')')
+		default:
+			b.WriteString("CAST(DATE_FORMAT(").Ident(column).WriteString(", '" + formats[0] + "') AS DATETIME)")
+		}
+	})
+}
+
+// truncFormats holds the MySQL and SQLite formats of the DateTrunc units.
+var truncFormats = map[string][2]string{
+	"year":   {"%Y-01-01 00:00:00", "%Y-01-01 00:00:00"},
+	"month":  {"%Y-%m-01 00:00:00", "%Y-%m-01 00:00:00"},
+	"day":    {"%Y-%m-%d 00:00:00", "%Y-%m-%d 00:00:00"},
+	"hour":   {"%Y-%m-%d %H:00:00", "%Y-%m-%d %H:00:00"},
+	"minute": {"%Y-%m-%d %H:%i:00", "%Y-%m-%d %H:%M:00"},
+	"second": {"%Y-%m-%d %H:%i:%s", "%Y-%m-%d %H:%M:%S"},
+}
+
 // As suffixed the given column with an alias (`a` AS `b`).
 func As(ident string, as string) string {
 	b := &Builder{}
@@ -2158,6 +2800,9 @@ type Selector struct {
 	setOps    []setOp
 	prefix    Queries
 	lock      *LockOptions
+	// columns of the DISTINCT ON clause.
+	distinctOn []string
+	hints      []*Hint
 }
 
 // WithContext sets the context into the *Selector.
@@ -2367,6 +3012,28 @@ func (s *Selector) Distinct() *Selector {
 	return s
 }
 
+// DistinctOn adds the DISTINCT ON clause to the `SELECT` statement, that keeps only the
+// first row of each set of rows where the given columns are equal. The first row of each
+// set is determined by the ORDER BY clause, that must start with the given columns.
+// DISTINCT ON is supported only by PostgreSQL.
+//
+//	Select().
+//	From(Table("events")).
+//	DistinctOn("user_id").
+//	OrderBy("user_id", Desc("created_at"))
+func (s *Selector) DistinctOn(columns ...string) *Selector {
+	if s.Dialect() != dialect.Postgres {
+		s.AddError(errors.New("sql: DISTINCT ON is supported only by PostgreSQL"))
+	}
+	s.distinctOn = append(s.distinctOn, columns...)
+	return s
+}
+
+// HasDistinctOn reports if the selector has a DISTINCT ON clause.
+func (s *Selector) HasDistinctOn() bool {
+	return len(s.distinctOn) > 0
+}
+
 // SetDistinct sets explicitly if the returned rows are distinct or indistinct.
 func (s *Selector) SetDistinct(v bool) *Selector {
 	s.distinct = v
@@ -2479,6 +3146,11 @@ func (s *Selector) HasJoins() bool {
 	return len(s.joins) > 0
 }
 
+// HasSetOps reports if the selector has any set operations (UNION, EXCEPT or INTERSECT).
+func (s *Selector) HasSetOps() bool {
+	return len(s.setOps) > 0
+}
+
 // JoinedTable returns the first joined table with the given name.
 func (s *Selector) JoinedTable(name string) (*SelectTable, bool) {
 	for _, j := range s.joins {
@@ -2642,6 +3314,114 @@ func (s *Selector) IntersectAll(t TableView) *Selector {
 	return s
 }
 
+// Hint is an optimizer or an index hint of a SELECT statement.
+// Hints are advisory, and hints that are not supported by the
+// dialect of the statement are omitted from the query.
+type Hint struct {
+	kind    string   // kind of the index hint (e.g. "USE"), or empty for optimizer hints.
+	text    string   // text of the optimizer hint.
+	indexes []string // indexes of the index hint.
+}
+
+// OptimizerHint returns an optimizer hint. In MySQL, optimizer hints are placed after the
+// SELECT keyword, and in PostgreSQL, they are placed in the beginning of the query, where
+// they are read by the pg_hint_plan extension. For example:
+//
+//	// SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM `users`
+//	Select().From(Table("users")).Hint(OptimizerHint("MAX_EXECUTION_TIME(1000)"))
+//
+//	// /*+ SeqScan(users) */ SELECT * FROM "users"
+//	Dialect(dialect.Postgres).Select().From(Table("users")).Hint(OptimizerHint("SeqScan(users)"))
+func OptimizerHint(hint string) *Hint {
+	return &Hint{text: hint}
+}
+
+// UseIndex returns an index hint that tells MySQL to use one of the given indexes
+// of the table in the FROM clause. In PostgreSQL, it is translated to the IndexScan
+// hint of pg_hint_plan.
+//
+//	// SELECT * FROM `users` USE INDEX (`user_name`)
+//	Select().From(Table("users")).Hint(UseIndex("user_name"))
+func UseIndex(indexes ...string) *Hint {
+	return &Hint{kind: "USE", indexes: indexes}
+}
+
+// ForceIndex returns an index hint that tells MySQL to use one of the given indexes
+// of the table in the FROM clause, and to avoid a table scan. In PostgreSQL, it is
+// translated to the IndexScan hint of pg_hint_plan, and in SQLite, a single index is
+// forced using the INDEXED BY clause.
+//
+//	// SELECT * FROM `users` FORCE INDEX (`user_name`)
+//	Select().From(Table("users")).Hint(ForceIndex("user_name"))
+func ForceIndex(indexes ...string) *Hint {
+	return &Hint{kind: "FORCE", indexes: indexes}
+}
+
+// IgnoreIndex returns an index hint that tells MySQL to not use the given indexes
+// of the table in the FROM clause.
+//
+//	// SELECT * FROM `users` IGNORE INDEX (`user_name`)
+//	Select().From(Table("users")).Hint(IgnoreIndex("user_name"))
+func IgnoreIndex(indexes ...string) *Hint {
+	return &Hint{kind: "IGNORE", indexes: indexes}
+}
+
+// Hint appends the given optimizer or index hints to the SELECT statement. Index
+// hints apply to the first table in the FROM clause. It is usually used by query
+// modifiers. For example:
+//
+//	client.User.Query().
+//		Where(user.Name("a8m")).
+//		Modify(func(s *sql.Selector) {
+//			s.Hint(sql.ForceIndex("user_name"))
+//		}).
+//		AllX(ctx)
+func (s *Selector) Hint(hints ...*Hint) *Selector {
+	s.hints = append(s.hints, hints...)
+	return s
+}
+
+// joinOptimizerHints writes the optimizer hints of the selector, including the index
+// hints that are translated to optimizer hints in PostgreSQL (pg_hint_plan).
+func (s *Selector) joinOptimizerHints(b *Builder) {
+	var hints []string
+	for _, h := range s.hints {
+		switch {
+		case h.kind == "":
+			hints = append(hints, h.text)
+		case s.postgres() && h.kind != "IGNORE" && len(s.from) > 0:
+			if t, ok := s.from[0].(*SelectTable); ok {
+				name := t.name
+				if t.as != "" {
+					name = t.as
+				}
+				hints = append(hints, fmt.Sprintf("IndexScan(%s)", strings.Join(append([]string{name}, h.indexes...), " ")))
+			}
+		}
+	}
+	if len(hints) > 0 {
+		b.WriteString("/*+ ").WriteString(strings.Join(hints, " ")).WriteString(" */ ")
+	}
+}
+
+// joinIndexHints writes the index hints of the first table in the FROM clause.
+func (s *Selector) joinIndexHints(b *Builder) {
+	for _, h := range s.hints {
+		switch {
+		case h.kind == "" || len(h.indexes) == 0 || s.postgres():
+		case s.sqlite():
+			if h.kind == "FORCE" && len(h.indexes) == 1 {
+				b.WriteString(" INDEXED BY ").Ident(h.indexes[0])
+			}
+		default: // MySQL.
+			b.WriteString(" " + h.kind + " INDEX ")
+			b.Wrap(func(b *Builder) {
+				b.IdentComma(h.indexes...)
+			})
+		}
+	}
+}
+
 // Prefix prefixes the query with list of queries.
 func (s *Selector) Prefix(queries ...Querier) *Selector {
 	s.prefix = append(s.prefix, queries...)
@@ -2816,21 +3596,25 @@ func (s *Selector) Clone() *Selector {
 		joins[i] = s.joins[i].clone()
 	}
 	return &Selector{
-		Builder:   s.Builder.clone(),
-		ctx:       s.ctx,
-		as:        s.as,
-		or:        s.or,
-		not:       s.not,
-		from:      s.from,
-		limit:     s.limit,
-		offset:    s.offset,
-		distinct:  s.distinct,
-		where:     s.where.clone(),
-		having:    s.having.clone(),
-		joins:     append([]join{}, joins...),
-		group:     append([]string{}, s.group...),
-		order:     append([]any{}, s.order...),
-		selection: append([]selection{}, s.selection...),
+		Builder:    s.Builder.clone(),
+		ctx:        s.ctx,
+		as:         s.as,
+		or:         s.or,
+		not:        s.not,
+		from:       s.from,
+		limit:      s.limit,
+		offset:     s.offset,
+		distinct:   s.distinct,
+		where:      s.where.clone(),
+		having:     s.having.clone(),
+		joins:      append([]join{}, joins...),
+		group:      append([]string{}, s.group...),
+		order:      append([]any{}, s.order...),
+		selection:  append([]selection{}, s.selection...),
+		setOps:     append([]setOp{}, s.setOps...),
+		prefix:     append(Queries{}, s.prefix...),
+		distinctOn: append([]string{}, s.distinctOn...),
+		hints:      append([]*Hint{}, s.hints...),
 	}
 }
 
@@ -2914,9 +3698,22 @@ func (s *Selector) Having(p *Predicate) *Selector {
 // Query returns query representation of a `SELECT` statement.
 func (s *Selector) Query() (string, []any) {
 	b := s.Builder.clone()
+	if s.postgres() {
+		s.joinOptimizerHints(&b)
+	}
 	s.joinPrefix(&b)
 	b.WriteString("SELECT ")
-	if s.distinct {
+	if !s.postgres() && !s.sqlite() {
+		s.joinOptimizerHints(&b)
+	}
+	switch {
+	case len(s.distinctOn) > 0:
+		b.WriteString("DISTINCT ON ")
+		b.Wrap(func(b *Builder) {
+			b.IdentComma(s.distinctOn...)
+		})
+		b.Pad()
+	case s.distinct:
 		b.WriteString("DISTINCT ")
 	}
 	if len(s.selection) > 0 {
@@ -2935,6 +3732,9 @@ func (s *Selector) Query() (string, []any) {
 		case *SelectTable:
 			t.SetDialect(s.dialect)
 			b.WriteString(t.ref())
+			if i == 0 {
+				s.joinIndexHints(&b)
+			}
 		case *Selector:
 			t.SetDialect(s.dialect)
 			b.Wrap(func(b *Builder) {
@@ -2985,10 +3785,12 @@ func (s *Selector) Query() (string, []any) {
 		b.WriteString(" HAVING ")
 		b.Join(s.having)
 	}
+	order := s.order
 	if len(s.setOps) > 0 {
 		s.joinSetOps(&b)
+		order = setOpsOrder(order)
 	}
-	joinOrder(s.order, &b)
+	joinOrder(order, &b)
 	if s.limit != nil {
 		b.WriteString(" LIMIT ")
 		b.WriteString(strconv.Itoa(*s.limit))
@@ -3040,15 +3842,71 @@ func (s *Selector) joinSetOps(b *Builder) {
 			b.WriteString(view.ref())
 		case *Selector:
 			view.SetDialect(s.dialect)
-			b.Join(view)
-			if view.as != "" {
-				b.WriteString(" AS ")
-				b.Ident(view.as)
+			switch {
+			case !view.compound():
+				b.Join(view)
+				if view.as != "" {
+					b.WriteString(" AS ")
+					b.Ident(view.as)
+				}
+			// SQLite does not support parenthesized operands. Therefore,
+			// they are evaluated as subqueries in the FROM clause.
+			case s.sqlite():
+				b.WriteString("SELECT * FROM ")
+				b.Wrap(func(b *Builder) {
+					b.Join(view)
+				})
+			default:
+				b.Wrap(func(b *Builder) {
+					b.Join(view)
+				})
 			}
 		}
 	}
 }
 
+// compound reports if the selector has clauses that apply to its whole result, and
+// therefore, must be parenthesized when it is used as an operand of a set operation.
+func (s *Selector) compound() bool {
+	return len(s.setOps) > 0 || len(s.order) > 0 || s.limit != nil || s.offset != nil || len(s.prefix) > 0 || s.lock != nil
+}
+
+// setOpsOrder returns the unqualified version of the ORDER BY terms, as the
+// ORDER BY clause of a set operation applies to its result and not to the
+// tables of its operands. e.g. "t1"."c" DESC => "c" DESC.
+func setOpsOrder(order []any) []any {
+	terms := make([]any, len(order))
+	for i := range order {
+		terms[i] = order[i]
+		if s, ok := order[i].(string); ok {
+			terms[i] = unqualified(s)
+		}
+	}
+	return terms
+}
+
+// unqualified strips the table qualifier from the given column term.
+func unqualified(c string) string {
+	if c == "" {
+		return c
+	}
+	if q := c[0]; q == '`' || q == '"' {
+		if i := strings.IndexByte(c[1:], q) + 2; i > 1 && i < len(c) && c[i] == '.' {
+			return c[i+1:]
+		}
+		return c
+	}
+	for i := 0; i < len(c); i++ {
+		switch b := c[i]; {
+		case b == '.' && i > 0:
+			return c[i+1:]
+		case b != '_' && (b < 'a' || b > 'z') && (b < 'A' || b > 'Z') && (i == 0 || b < '0' || b > '9'):
+			return c
+		}
+	}
+	return c
+}
+
 func joinOrder(order []any, b *Builder) {
 	if len(order) == 0 {
 		return
@@ -3101,9 +3959,10 @@ type WithBuilder struct {
 	Builder
 	recursive bool
 	ctes      []struct {
-		name    string
-		columns []string
-		s       *Selector
+		name         string
+		columns      []string
+		s            *Selector
+		materialized *bool
 	}
 }
 
@@ -3117,9 +3976,10 @@ type WithBuilder struct {
 func With(name string, columns ...string) *WithBuilder {
 	return &WithBuilder{
 		ctes: []struct {
-			name    string
-			columns []string
-			s       *Selector
+			name         string
+			columns      []string
+			s            *Selector
+			materialized *bool
 		}{
 			{name: name, columns: columns},
 		},
@@ -3150,6 +4010,24 @@ func (w *WithBuilder) As(s *Selector) *WithBuilder {
 	return w
 }
 
+// Materialized marks the last CTE as materialized (AS MATERIALIZED), forcing
+// the database to compute it once, instead of inlining it into the query.
+// Supported by PostgreSQL, and ignored by other dialects.
+func (w *WithBuilder) Materialized() *WithBuilder {
+	v := true
+	w.ctes[len(w.ctes)-1].materialized = &v
+	return w
+}
+
+// NotMaterialized marks the last CTE as not materialized (AS NOT MATERIALIZED),
+// allowing the database to inline it into the query, even if it is referenced
+// more than once. Supported by PostgreSQL, and ignored by other dialects.
+func (w *WithBuilder) NotMaterialized() *WithBuilder {
+	v := false
+	w.ctes[len(w.ctes)-1].materialized = &v
+	return w
+}
+
 // With appends another named CTE to the statement.
 func (w *WithBuilder) With(name string, columns ...string) *WithBuilder {
 	w.ctes = append(w.ctes, With(name, columns...).ctes...)
@@ -3180,6 +4058,12 @@ func (w *WithBuilder) Query() (string, []any) {
 			w.WriteByte(')')
 		}
 		w.WriteString(" AS ")
+		if m := cte.materialized; m != nil && w.postgres() {
+			if !*m {
+				w.WriteString("NOT ")
+			}
+			w.WriteString("MATERIALIZED ")
+		}
 		w.Wrap(func(b *Builder) {
 			b.Join(cte.s)
 		})
@@ -3329,6 +4213,68 @@ type expr struct {
 
 func (e *expr) Query() (string, []any) { return e.s, e.args }
 
+// NamedExpr returns an SQL expression with named arguments. The arguments are referenced
+// using the ":name" syntax, and are replaced with the placeholders of the dialect when the
+// expression is built. For example:
+//
+//	NamedExpr("age > :age AND name <> :name", map[string]any{"age": 30, "name": "a8m"})
+//
+// A name can be referenced multiple times, and an error is added to the builder if one
+// of the names does not exist in the given map. Casts (e.g. "::text") and single-quoted
+// string literals are written as-is.
+func NamedExpr(exr string, args map[string]any) Querier {
+	return ExprFunc(func(b *Builder) {
+		for i := 0; i < len(exr); i++ {
+			switch c := exr[i]; {
+			case c == '\'':
+				// Skip string literals, including their escaped quotes ('').
+				j := i + 1
+				for ; j < len(exr); j++ {
+					if exr[j] != '\'' {
+						continue
+					}
+					if j+1 < len(exr) && exr[j+1] == '\'' {
+						j++
+						continue
+					}
+					break
+				}
+				if j == len(exr) {
+					j--
+				}
+				b.WriteString(exr[i : j+1])
+				i = j
+			case c == ':' && i+1 < len(exr) && exr[i+1] == ':':
+				b.WriteString("::")
+				i++
+			case c == ':' && i+1 < len(exr) && isNameStart(exr[i+1]):
+				j := i + 1
+				for j < len(exr) && isNamePart(exr[j]) {
+					j++
+				}
+				name := exr[i+1 : j]
+				v, ok := args[name]
+				if !ok {
+					b.AddError(fmt.Errorf("sql: missing named argument %q", name))
+				}
+				b.Arg(v)
+				i = j - 1
+			default:
+				b.WriteByte(c)
+			}
+		}
+	})
+}
+
+// NamedExprP creates a new predicate from the given expression with named arguments.
+//
+//	NamedExprP("A = :a AND B > :b", map[string]any{"a": 1, "b": 2})
+func NamedExprP(exr string, args map[string]any) *Predicate {
+	return P(func(b *Builder) {
+		b.Join(NamedExpr(exr, args))
+	})
+}
+
 // ExprFunc returns an expression function that implements the Querier interface.
 //
 //	Update("users").
@@ -3578,8 +4524,95 @@ type (
 	}
 )
 
+// Placeholder formats the placeholders of the statement arguments.
+type Placeholder interface {
+	// Placeholder returns the placeholder of the n-th
+	// argument in the statement, starting from 1.
+	Placeholder(n int) string
+}
+
+// The PlaceholderFunc type is an adapter to allow the use of ordinary
+// functions as Placeholder. If f is a function with the appropriate
+// signature, PlaceholderFunc(f) is a Placeholder that calls f.
+type PlaceholderFunc func(int) string
+
+// Placeholder calls f(n).
+func (f PlaceholderFunc) Placeholder(n int) string {
+	return f(n)
+}
+
+var (
+	// PlaceholderQuestion formats the arguments as '?'.
+	// It is the default placeholder, used by MySQL and SQLite.
+	PlaceholderQuestion Placeholder = PlaceholderFunc(func(int) string {
+		return "?"
+	})
+	// PlaceholderDollar formats the arguments as '$1', '$2', and so on.
+	// It is used by PostgreSQL.
+	PlaceholderDollar Placeholder = PlaceholderFunc(func(n int) string {
+		return "$" + strconv.Itoa(n)
+	})
+	// PlaceholderAtP formats the arguments as '@p1', '@p2', and so on.
+	// It is used by Microsoft SQL Server.
+	PlaceholderAtP Placeholder = PlaceholderFunc(func(n int) string {
+		return "@p" + strconv.Itoa(n)
+	})
+	// PlaceholderColon formats the arguments as ':1', ':2', and so on.
+	// It is used by Oracle.
+	PlaceholderColon Placeholder = PlaceholderFunc(func(n int) string {
+		return ":" + strconv.Itoa(n)
+	})
+)
+
+// placeholders holds the registered placeholders of the dialects. The map is copied on
+// registration, and therefore, it is read by the builders without acquiring a lock.
+var placeholders struct {
+	sync.Mutex // serializes registrations.
+	m          atomic.Pointer[map[string]Placeholder]
+}
+
+// RegisterPlaceholder registers the placeholder format of the given dialect.
+// It allows using the builders with dialects that are not supported by ent
+// out of the box. For example:
+//
+//	sql.RegisterPlaceholder("sqlserver", sql.PlaceholderAtP)
+func RegisterPlaceholder(name string, p Placeholder) {
+	placeholders.Lock()
+	defer placeholders.Unlock()
+	m := make(map[string]Placeholder)
+	if prev := placeholders.m.Load(); prev != nil {
+		for k, v := range *prev {
+			m[k] = v
+		}
+	}
+	m[name] = p
+	placeholders.m.Store(&m)
+}
+
+// PlaceholderOf returns the placeholder format of the given dialect.
+// PostgreSQL defaults to PlaceholderDollar, and other dialects without
+// a registered placeholder use PlaceholderQuestion.
+func PlaceholderOf(name string) Placeholder {
+	if m := placeholders.m.Load(); m != nil {
+		if p, ok := (*m)[name]; ok {
+			return p
+		}
+	}
+	if name == dialect.Postgres {
+		return PlaceholderDollar
+	}
+	return PlaceholderQuestion
+}
+
 // Arg appends an input argument to the builder.
 func (b *Builder) Arg(a any) *Builder {
+	if v, ok := a.(ArrayValue); ok {
+		arg, err := v.arg(b.dialect)
+		if err != nil {
+			b.AddError(err)
+		}
+		a = arg
+	}
 	switch v := a.(type) {
 	case nil:
 		b.WriteString("NULL")
@@ -3591,13 +4624,7 @@ func (b *Builder) Arg(a any) *Builder {
 		b.Join(v)
 		return b
 	}
-	// Default placeholder param (MySQL and SQLite).
-	format := "?"
-	if b.postgres() {
-		// Postgres' arguments are referenced using the syntax $n.
-		// $1 refers to the 1st argument, $2 to the 2nd, and so on.
-		format = "$" + strconv.Itoa(b.total+1)
-	}
+	format := PlaceholderOf(b.dialect).Placeholder(b.total + 1)
 	if f, ok := a.(ParamFormatter); ok {
 		format = f.FormatParam(format, &StmtInfo{
 			Dialect: b.dialect,
@@ -3666,6 +4693,17 @@ func (b *Builder) join(qs []Querier, sep string) *Builder {
 		if i > 0 {
 			b.WriteString(sep)
 		}
+		// Expression functions are executed on a builder that is derived from the parent, and
+		// they are not modified, as they may be shared by concurrent queries (e.g. in predicates).
+		if e, ok := q.(*exprFunc); ok {
+			nb := &Builder{dialect: b.dialect, total: b.total, sb: &strings.Builder{}}
+			e.fn(nb)
+			b.WriteString(nb.String())
+			b.args = append(b.args, nb.args...)
+			b.total = nb.total
+			b.errs = append(b.errs, nb.errs...)
+			continue
+		}
 		st, ok := q.(state)
 		if ok {
 			st.SetDialect(b.dialect)
@@ -3751,6 +4789,11 @@ func (b Builder) sqlite() bool {
 	return b.Dialect() == dialect.SQLite
 }
 
+// mysql reports if the builder dialect is MySQL.
+func (b Builder) mysql() bool {
+	return b.Dialect() == dialect.MySQL
+}
+
 // fromIdent sets the builder dialect from the identifier format.
 func (b *Builder) fromIdent(ident string) {
 	if strings.Contains(ident, `"`) {
@@ -3846,6 +4889,28 @@ func (d *DialectBuilder) CreateTable(name string) *TableBuilder {
 	return b
 }
 
+// CreatePartition creates a PartitionBuilder for the configured dialect.
+//
+//	Dialect(dialect.Postgres).
+//		CreatePartition("logs", "logs_2023").
+//		FromTo("'2023-01-01'", "'2024-01-01'")
+func (d *DialectBuilder) CreatePartition(table, name string) *PartitionBuilder {
+	b := CreatePartition(table, name)
+	b.SetDialect(d.dialect)
+	return b
+}
+
+// AttachPartition creates a PartitionBuilder for the configured dialect.
+//
+//	Dialect(dialect.Postgres).
+//		AttachPartition("logs", "logs_2023").
+//		FromTo("'2023-01-01'", "'2024-01-01'")
+func (d *DialectBuilder) AttachPartition(table, name string) *PartitionBuilder {
+	b := AttachPartition(table, name)
+	b.SetDialect(d.dialect)
+	return b
+}
+
 // AlterTable creates a TableAlter for the configured dialect.
 //
 //	Dialect(dialect.Postgres).
@@ -3994,3 +5059,13 @@ func isModifier(s string) bool {
 	}
 	return false
 }
+
+// isNameStart reports if the given byte can start the name of a named argument.
+func isNameStart(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// isNamePart reports if the given byte can be part of the name of a named argument.
+func isNamePart(c byte) bool {
+	return isNameStart(c) || '0' <= c && c <= '9'
+}
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"github.com/stretchr/testify/require"
@@ -122,6 +124,23 @@ func TestBuilder(t *testing.T) {
 				),
 			wantQuery: `ALTER TABLE "users" ADD COLUMN "group_id" int UNIQUE, ADD CONSTRAINT "constraint" FOREIGN KEY("group_id") REFERENCES "groups"("id") ON DELETE CASCADE`,
 		},
+		{
+			input: Dialect(dialect.Postgres).AlterTable("users").
+				AddForeignKey(ForeignKey("users_groups").Columns("group_id").
+					Reference(Reference().Table("groups").Columns("id")).
+					OnDelete("CASCADE").
+					Deferrable(),
+				),
+			wantQuery: `ALTER TABLE "users" ADD CONSTRAINT "users_groups" FOREIGN KEY("group_id") REFERENCES "groups"("id") ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED`,
+		},
+		{
+			input: Dialect(dialect.MySQL).AlterTable("users").
+				AddForeignKey(ForeignKey("users_groups").Columns("group_id").
+					Reference(Reference().Table("groups").Columns("id")).
+					Deferrable(),
+				),
+			wantQuery: "ALTER TABLE `users` ADD CONSTRAINT `users_groups` FOREIGN KEY(`group_id`) REFERENCES `groups`(`id`)",
+		},
 		{
 			input: AlterTable("users").
 				AddColumn(Column("group_id").Type("int").Attr("UNIQUE")).
@@ -637,6 +656,19 @@ func TestBuilder(t *testing.T) {
 				Schema("mydb"),
 			wantQuery: `DELETE FROM "mydb"."users" WHERE "parent_id" IS NULL`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id", "name"),
+			wantQuery: `DELETE FROM "users" WHERE "parent_id" IS NULL RETURNING "id", "name"`,
+		},
+		{
+			input: Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id"),
+			wantQuery: "DELETE FROM `users` WHERE `parent_id` IS NULL",
+		},
 		{
 			input: Delete("users").
 				Where(And(IsNull("parent_id"), NotIn("name", "foo", "bar"))),
@@ -1341,6 +1373,19 @@ func TestBuilder(t *testing.T) {
 			wantQuery: "WITH `groups` AS (SELECT * FROM `groups` WHERE `name` = ?) SELECT `age` FROM `groups`",
 			wantArgs:  []any{"bar"},
 		},
+		{
+			input: func() Querier {
+				d := Dialect(dialect.Postgres)
+				with := d.With("active").As(d.Select().From(Table("users")).Where(EQ("active", true))).Materialized().
+					With("names").As(d.Select("name").From(Table("active"))).NotMaterialized()
+				return d.Select("name").From(Table("names")).Prefix(with)
+			}(),
+			wantQuery: `WITH "active" AS MATERIALIZED (SELECT * FROM "users" WHERE "active"), "names" AS NOT MATERIALIZED (SELECT "name" FROM "active") SELECT "name" FROM "names"`,
+		},
+		{
+			input:     Queries{With("users_view").As(Select().From(Table("users"))).Materialized(), Select().From(Table("users_view"))},
+			wantQuery: "WITH `users_view` AS (SELECT * FROM `users`) SELECT * FROM `users_view`",
+		},
 		{
 			input:     SelectExpr(Raw("1")),
 			wantQuery: "SELECT 1",
@@ -1449,6 +1494,30 @@ func TestBuilder(t *testing.T) {
 				Column("name"),
 			wantQuery: "CREATE INDEX IF NOT EXISTS `name_index` ON `users`(`name`) USING HASH",
 		},
+		{
+			input: Dialect(dialect.MySQL).
+				CreateIndex("users_bio").
+				Table("users").
+				Using("fulltext").
+				Column("bio"),
+			wantQuery: "CREATE FULLTEXT INDEX `users_bio` ON `users`(`bio`)",
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				CreateIndex("users_location").
+				Table("users").
+				Using("SPATIAL").
+				Column("location"),
+			wantQuery: "CREATE SPATIAL INDEX `users_location` ON `users`(`location`)",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateIndex("users_email_name").
+				Table("users").
+				ColumnExpr("(lower(email)) DESC").
+				Column("name"),
+			wantQuery: `CREATE INDEX "users_email_name" ON "users"((lower(email)) DESC, "name")`,
+		},
 		{
 			input:     CreateIndex("unique_name").Unique().Table("users").Columns("first", "last"),
 			wantQuery: "CREATE UNIQUE INDEX `unique_name` ON `users`(`first`, `last`)",
@@ -1461,6 +1530,30 @@ func TestBuilder(t *testing.T) {
 				Columns("first", "last"),
 			wantQuery: `CREATE UNIQUE INDEX "unique_name" ON "users"("first", "last")`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateIndex("users_email").
+				Unique().
+				Table("users").
+				Column("email").
+				Where(IsNull("deleted_at")),
+			wantQuery: `CREATE UNIQUE INDEX "users_email" ON "users"("email") WHERE "deleted_at" IS NULL`,
+		},
+		{
+			input: CreateIndex("users_nickname").
+				Table("users").
+				Column("nickname").
+				Where(ExprP("`active`")),
+			wantQuery: "CREATE INDEX `users_nickname` ON `users`(`nickname`) WHERE `active`",
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				CreateIndex("users_nickname").
+				Table("users").
+				Column("nickname").
+				Where(ExprP("`active`")),
+			wantQuery: "CREATE INDEX `users_nickname` ON `users`(`nickname`)",
+		},
 		{
 			input:     DropIndex("name_index"),
 			wantQuery: "DROP INDEX `name_index`",
@@ -1952,7 +2045,100 @@ func TestSelector_UnionOrderBy(t *testing.T) {
 		Union(Select("*").From(Table("old_users1"))).
 		OrderBy(table.C("whatever")).
 		Query()
-	require.Equal(t, `SELECT * FROM "users" WHERE "active" UNION SELECT * FROM "old_users1" ORDER BY "users"."whatever"`, query)
+	require.Equal(t, `SELECT * FROM "users" WHERE "active" UNION SELECT * FROM "old_users1" ORDER BY "whatever"`, query)
+
+	query, _ = Dialect(dialect.MySQL).
+		Select("*").
+		From(table).
+		Union(Select("*").From(Table("old_users1"))).
+		OrderBy(Desc(table.C("whatever")), "name").
+		Limit(10).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` UNION SELECT * FROM `old_users1` ORDER BY `whatever` DESC, `name` LIMIT 10", query)
+}
+
+func TestTextSearch(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("posts")).
+		Where(TextSearch("title", "ent")).
+		Query()
+	require.Equal(t, `SELECT * FROM "posts" WHERE to_tsvector('simple', "title") @@ to_tsquery('simple', $1)`, query)
+	require.Equal(t, []any{"ent"}, args)
+
+	query, args = Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("posts")).
+		Where(TextSearch("title", "ent")).
+		Query()
+	require.Equal(t, "SELECT * FROM `posts` WHERE MATCH (`title`) AGAINST (?)", query)
+	require.Equal(t, []any{"ent"}, args)
+
+	query, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("posts")).
+		Where(TextSearch("title", "ent", WithTextSearchTable("posts_title_fts", "rowid"))).
+		Query()
+	require.Equal(t, "SELECT * FROM `posts` WHERE `rowid` IN (SELECT `rowid` FROM `posts_title_fts` WHERE `posts_title_fts` MATCH ?)", query)
+	require.Equal(t, []any{"ent"}, args)
+
+	s := Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("posts")).
+		Where(TextSearch("title", "ent"))
+	s.Query()
+	require.EqualError(t, s.Err(), `sql: missing FTS5 table of column "title" for full-text search`)
+}
+
+func TestSelector_DistinctOn(t *testing.T) {
+	t1 := Table("events")
+	s := Dialect(dialect.Postgres).
+		Select(t1.Columns("id", "user_id", "created_at")...).
+		From(t1).
+		Distinct().
+		DistinctOn(t1.C("user_id")).
+		Where(EQ(t1.C("kind"), "login")).
+		OrderBy(t1.C("user_id"), Desc(t1.C("created_at")))
+	c := s.Clone()
+	query, args := s.Query()
+	require.NoError(t, s.Err())
+	require.Equal(t, `SELECT DISTINCT ON ("events"."user_id") "events"."id", "events"."user_id", "events"."created_at" FROM "events" WHERE "events"."kind" = $1 ORDER BY "events"."user_id", "events"."created_at" DESC`, query)
+	require.Equal(t, []any{"login"}, args)
+	require.True(t, s.HasDistinctOn())
+	query, _ = c.Query()
+	require.Equal(t, `SELECT DISTINCT ON ("events"."user_id") "events"."id", "events"."user_id", "events"."created_at" FROM "events" WHERE "events"."kind" = $1 ORDER BY "events"."user_id", "events"."created_at" DESC`, query)
+
+	s = Dialect(dialect.MySQL).Select().From(Table("events")).DistinctOn("user_id")
+	require.EqualError(t, s.Err(), "sql: DISTINCT ON is supported only by PostgreSQL")
+}
+
+func TestSelector_SetOpsParentheses(t *testing.T) {
+	operand := func(d string) *Selector {
+		t1 := Table("old_users")
+		return Dialect(d).Select(t1.C("id")).From(t1).Where(EQ(t1.C("active"), true)).OrderBy(t1.C("id")).Limit(1)
+	}
+	query, args := Dialect(dialect.Postgres).
+		Select("id").
+		From(Table("users")).
+		Union(operand(dialect.Postgres)).
+		Except(Select("id").From(Table("a")).Intersect(Select("id").From(Table("b")))).
+		Query()
+	require.Equal(t, `SELECT "id" FROM "users" UNION (SELECT "old_users"."id" FROM "old_users" WHERE "old_users"."active" ORDER BY "old_users"."id" LIMIT 1) EXCEPT (SELECT "id" FROM "a" INTERSECT SELECT "id" FROM "b")`, query)
+	require.Empty(t, args)
+
+	query, _ = Dialect(dialect.MySQL).
+		Select("id").
+		From(Table("users")).
+		UnionAll(operand(dialect.MySQL)).
+		Query()
+	require.Equal(t, "SELECT `id` FROM `users` UNION ALL (SELECT `old_users`.`id` FROM `old_users` WHERE `old_users`.`active` ORDER BY `old_users`.`id` LIMIT 1)", query)
+
+	query, _ = Dialect(dialect.SQLite).
+		Select("id").
+		From(Table("users")).
+		Union(operand(dialect.SQLite)).
+		Query()
+	require.Equal(t, "SELECT `id` FROM `users` UNION SELECT * FROM (SELECT `old_users`.`id` FROM `old_users` WHERE `old_users`.`active` ORDER BY `old_users`.`id` LIMIT 1)", query)
 }
 
 func TestUpdateBuilder_SetExpr(t *testing.T) {
@@ -1973,6 +2159,62 @@ func TestUpdateBuilder_SetExpr(t *testing.T) {
 	require.Equal(t, []any{"Ariel", "~", "~"}, args)
 }
 
+func TestPartitionBuilder(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+	}{
+		{
+			input:     Dialect(dialect.Postgres).CreatePartition("logs", "logs_2023").FromTo("'2023-01-01'", "'2024-01-01'"),
+			wantQuery: `CREATE TABLE "logs_2023" PARTITION OF "logs" FOR VALUES FROM ('2023-01-01') TO ('2024-01-01')`,
+		},
+		{
+			input:     Dialect(dialect.Postgres).CreatePartition("logs", "logs_eu").In("'de'", "'fr'"),
+			wantQuery: `CREATE TABLE "logs_eu" PARTITION OF "logs" FOR VALUES IN ('de', 'fr')`,
+		},
+		{
+			input:     Dialect(dialect.Postgres).CreatePartition("logs", "logs_0").Modulus(4, 0),
+			wantQuery: `CREATE TABLE "logs_0" PARTITION OF "logs" FOR VALUES WITH (MODULUS 4, REMAINDER 0)`,
+		},
+		{
+			input:     Dialect(dialect.Postgres).CreatePartition("logs", "logs_default").Default(),
+			wantQuery: `CREATE TABLE "logs_default" PARTITION OF "logs" DEFAULT`,
+		},
+		{
+			input:     Dialect(dialect.Postgres).AttachPartition("logs", "logs_2023").FromTo("'2023-01-01'", "'2024-01-01'"),
+			wantQuery: `ALTER TABLE "logs" ATTACH PARTITION "logs_2023" FOR VALUES FROM ('2023-01-01') TO ('2024-01-01')`,
+		},
+		{
+			input:     Dialect(dialect.MySQL).CreatePartition("logs", "p2023").FromTo("2023", "2024"),
+			wantQuery: "ALTER TABLE `logs` ADD PARTITION (PARTITION `p2023` VALUES LESS THAN (2024))",
+		},
+		{
+			input:     Dialect(dialect.MySQL).CreatePartition("logs", "p_eu").In("1", "2"),
+			wantQuery: "ALTER TABLE `logs` ADD PARTITION (PARTITION `p_eu` VALUES IN (1, 2))",
+		},
+		{
+			input:     Dialect(dialect.MySQL).CreatePartition("logs", "pmax").Default(),
+			wantQuery: "ALTER TABLE `logs` ADD PARTITION (PARTITION `pmax` VALUES LESS THAN MAXVALUE)",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Empty(t, args)
+		})
+	}
+	for _, b := range []*PartitionBuilder{
+		Dialect(dialect.Postgres).CreatePartition("logs", "logs_2023"),
+		Dialect(dialect.MySQL).AttachPartition("logs", "p2023").FromTo("2023", "2024"),
+		Dialect(dialect.MySQL).CreatePartition("logs", "p0").Modulus(4, 0),
+		Dialect(dialect.SQLite).CreatePartition("logs", "logs_2023").FromTo("1", "2"),
+	} {
+		b.Query()
+		require.Error(t, b.Err())
+	}
+}
+
 func TestInsert_OnConflict(t *testing.T) {
 	t.Run("Postgres", func(t *testing.T) { // And SQLite.
 		query, args := Dialect(dialect.Postgres).
@@ -2113,6 +2355,26 @@ func TestInsert_OnConflict(t *testing.T) {
 		require.Equal(t, "INSERT INTO `users` (`name`, `rank`) VALUES (?, ?), (?, NULL) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `rank` = VALUES(`rank`), `id` = LAST_INSERT_ID(`id`)", query)
 		require.Equal(t, []any{"Ariel", 10, "Mashraki"}, args)
 	})
+
+	t.Run("ClickHouse", func(t *testing.T) {
+		query, args, err := Dialect(dialect.ClickHouse).
+			Insert("events").
+			Columns("id", "name").
+			Values(1, "login").
+			Returning("id").
+			QueryErr()
+		require.NoError(t, err)
+		require.Equal(t, "INSERT INTO `events` (`id`, `name`) VALUES (?, ?)", query)
+		require.Equal(t, []any{1, "login"}, args)
+
+		_, _, err = Dialect(dialect.ClickHouse).
+			Insert("events").
+			Columns("id", "name").
+			Values(1, "login").
+			OnConflict(DoNothing()).
+			QueryErr()
+		require.EqualError(t, err, "ClickHouse does not support the 'ON CONFLICT' clause")
+	})
 }
 
 func TestEscapePatterns(t *testing.T) {
@@ -2146,6 +2408,14 @@ func TestEscapePatterns(t *testing.T) {
 	require.Equal(t, "UPDATE `users` SET `name` = NULL WHERE `nickname` LIKE ? ESCAPE ? OR `nickname` LIKE ? ESCAPE ? OR `nickname` LIKE ? ESCAPE ? OR LOWER(`nickname`) LIKE ? ESCAPE ?", q)
 	require.Equal(t, []any{"\\%a8m\\%%", "\\", "%\\_alexsn\\_", "\\", "%\\\\pedro\\\\%", "\\", "%\\%abcd\\%efg%", "\\"}, args)
 
+	q, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("users")).
+		Where(HasPrefixFold("nickname", "A8m_")).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE LOWER(`nickname`) LIKE ? ESCAPE ?", q)
+	require.Equal(t, []any{"a8m\\_%", "\\"}, args)
+
 	q, args = Select("*").From(Table("dataset")).
 		Where(Contains("title", "_第一")).Query()
 	require.Equal(t, "SELECT * FROM `dataset` WHERE `title` LIKE ?", q)
@@ -2475,3 +2745,371 @@ func TestSelector_SelectedColumn(t *testing.T) {
 		require.Equal(t, []string{`"t2"."e"`, "t2.e", `"t1"."e"`, "t1.e", "e"}, s.FindSelection("e"))
 	})
 }
+
+func TestPlaceholder(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("users")).
+		Where(And(EQ("name", "a8m"), GT("age", 30))).
+		Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "name" = $1 AND "age" > $2`, query)
+	require.Equal(t, []any{"a8m", 30}, args)
+
+	for d, p := range map[string]Placeholder{"sqlserver": PlaceholderAtP, "oracle": PlaceholderColon} {
+		require.Equal(t, "?", PlaceholderOf(d).Placeholder(1))
+		RegisterPlaceholder(d, p)
+		require.Equal(t, p.Placeholder(2), PlaceholderOf(d).Placeholder(2))
+	}
+	query, args = Dialect("sqlserver").
+		Select("*").
+		From(Table("users")).
+		Where(And(EQ("name", "a8m"), GT("age", 30))).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `name` = @p1 AND `age` > @p2", query)
+	require.Equal(t, []any{"a8m", 30}, args)
+	query, _ = Dialect("oracle").
+		Update("users").
+		Set("name", "a8m").
+		Where(EQ("id", 1)).
+		Query()
+	require.Equal(t, "UPDATE `users` SET `name` = :1 WHERE `id` = :2", query)
+}
+
+func TestNamedExpr(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("users")).
+		Where(And(
+			EQ("active", true),
+			NamedExprP("age > :age AND (name = :name OR nickname = :name) AND role::text <> 'a:b''c' AND x = :_x1", map[string]any{
+				"age":  30,
+				"name": "a8m",
+				"_x1":  1,
+			}),
+		)).
+		Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "active" AND age > $1 AND (name = $2 OR nickname = $3) AND role::text <> 'a:b''c' AND x = $4`, query)
+	require.Equal(t, []any{30, "a8m", "a8m", 1}, args)
+
+	query, args = Select("*").
+		From(Table("users")).
+		Where(NamedExprP("name = :name", map[string]any{"name": "a8m"})).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE name = ?", query)
+	require.Equal(t, []any{"a8m"}, args)
+
+	s := Select("*").
+		From(Table("users")).
+		Where(NamedExprP("name = :name", nil))
+	s.Query()
+	require.EqualError(t, s.Err(), `sql: missing named argument "name"`)
+
+	// Expressions are not modified when they are built, and can be shared by concurrent queries.
+	expr := NamedExpr("name = :name", nil)
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := Select("*").From(Table("users")).Where(P(func(b *Builder) { b.Join(expr) }))
+			s.Query()
+			errs[i] = s.Err()
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.EqualError(t, err, `sql: missing named argument "name"`)
+	}
+	require.NoError(t, expr.(querierErr).Err(), "errors are not stored on the expression")
+}
+
+func TestSelector_Hint(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+	}{
+		{
+			input: Select().
+				Distinct().
+				From(Table("users")).
+				Where(EQ("name", "a8m")).
+				Hint(OptimizerHint("MAX_EXECUTION_TIME(1000)"), OptimizerHint("NO_ICP(users)"), ForceIndex("user_name", "user_age")),
+			wantQuery: "SELECT /*+ MAX_EXECUTION_TIME(1000) NO_ICP(users) */ DISTINCT * FROM `users` FORCE INDEX (`user_name`, `user_age`) WHERE `name` = ?",
+		},
+		{
+			input: Select().
+				From(Table("users").As("u")).
+				Hint(UseIndex("user_name"), IgnoreIndex("user_age")),
+			wantQuery: "SELECT * FROM `users` AS `u` USE INDEX (`user_name`) IGNORE INDEX (`user_age`)",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				From(Table("users").As("u")).
+				Where(EQ("name", "a8m")).
+				Hint(OptimizerHint("Leading(u pets)"), UseIndex("user_name"), IgnoreIndex("user_age")),
+			wantQuery: `/*+ Leading(u pets) IndexScan(u user_name) */ SELECT * FROM "users" AS "u" WHERE "name" = $1`,
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				From(Table("users")).
+				Hint(ForceIndex("user_name")).
+				Prefix(Expr("WITH t AS (SELECT 1)")),
+			wantQuery: `/*+ IndexScan(users user_name) */ WITH t AS (SELECT 1) SELECT * FROM "users"`,
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select().
+				From(Table("users")).
+				Hint(OptimizerHint("MAX_EXECUTION_TIME(1000)"), UseIndex("user_age"), ForceIndex("user_name")),
+			wantQuery: "SELECT * FROM `users` INDEXED BY `user_name`",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, _ := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+		})
+	}
+	s := Select().From(Table("users")).Hint(ForceIndex("user_name"))
+	query, _ := s.Clone().Query()
+	require.Equal(t, "SELECT * FROM `users` FORCE INDEX (`user_name`)", query)
+}
+
+func TestUpdateBuilder_FromJoin(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input: func() Querier {
+				u, g := Table("users"), Table("groups")
+				return Update("users").
+					Join(g).
+					On(u.C("group_id"), g.C("id")).
+					Set("role", Expr(g.C("role"))).
+					Where(EQ(g.C("active"), true))
+			}(),
+			wantQuery: "UPDATE `users` JOIN `groups` ON `users`.`group_id` = `groups`.`id` SET `role` = `groups`.`role` WHERE `groups`.`active`",
+		},
+		{
+			input: func() Querier {
+				u, g := Table("users"), Table("groups").As("g")
+				return Dialect(dialect.MySQL).
+					Update("users").
+					From(g).
+					Set("role", Expr(g.C("role"))).
+					Where(And(ColumnsEQ(u.C("group_id"), g.C("id")), EQ(g.C("name"), "admins")))
+			}(),
+			wantQuery: "UPDATE `users`, `groups` AS `g` SET `role` = `g`.`role` WHERE `users`.`group_id` = `g`.`id` AND `g`.`name` = ?",
+			wantArgs:  []any{"admins"},
+		},
+		{
+			input: func() Querier {
+				b := Dialect(dialect.Postgres)
+				u, g := b.Table("users"), b.Table("groups")
+				return b.Update("users").
+					From(g).
+					Set("role", Expr(g.C("role"))).
+					Where(And(ColumnsEQ(u.C("group_id"), g.C("id")), EQ(g.C("name"), "admins"))).
+					Returning("id")
+			}(),
+			wantQuery: `UPDATE "users" SET "role" = "groups"."role" FROM "groups" WHERE "users"."group_id" = "groups"."id" AND "groups"."name" = $1 RETURNING "id"`,
+			wantArgs:  []any{"admins"},
+		},
+		{
+			input: func() Querier {
+				b := Dialect(dialect.Postgres)
+				u, g := b.Table("users"), b.Table("groups")
+				c := b.Select(g.C("id")).From(g).Where(EQ(g.C("name"), "admins")).As("c")
+				return b.Update("users").
+					Set("name", "a8m").
+					Join(c).
+					On(u.C("group_id"), c.C("id")).
+					Where(EQ(u.C("active"), true))
+			}(),
+			wantQuery: `UPDATE "users" SET "name" = $1 FROM (SELECT "groups"."id" FROM "groups" WHERE "groups"."name" = $2) AS "c" WHERE "users"."group_id" = "c"."id" AND "users"."active"`,
+			wantArgs:  []any{"a8m", "admins"},
+		},
+		{
+			input: func() Querier {
+				b := Dialect(dialect.SQLite)
+				u, g, p := b.Table("users"), b.Table("groups"), b.Table("pets")
+				return b.Update("users").
+					Set("name", "a8m").
+					From(g).
+					Join(p).
+					On(u.C("id"), p.C("owner_id")).
+					Where(ColumnsEQ(u.C("group_id"), g.C("id")))
+			}(),
+			wantQuery: "UPDATE `users` SET `name` = ? FROM `groups`, `pets` WHERE `users`.`id` = `pets`.`owner_id` AND `users`.`group_id` = `groups`.`id`",
+			wantArgs:  []any{"a8m"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+	u := Dialect(dialect.MySQL).
+		Update("users").
+		Join(Table("groups")).
+		On("group_id", "id").
+		Set("name", "a8m").
+		OrderBy("id")
+	u.Query()
+	require.EqualError(t, u.Err(), "ORDER BY and LIMIT are not supported by multiple-table UPDATE statements")
+}
+
+func TestInsertBuilder_Select(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input: func() Querier {
+				t := Table("users")
+				return Insert("users_history").
+					Columns("id", "name").
+					Select(Select(t.C("id"), t.C("name")).From(t).Where(EQ(t.C("active"), false)))
+			}(),
+			wantQuery: "INSERT INTO `users_history` (`id`, `name`) SELECT `users`.`id`, `users`.`name` FROM `users` WHERE NOT `users`.`active`",
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Insert("users_history").
+				Select(Select().From(Table("users")).Where(GT("age", 30))),
+			wantQuery: "INSERT INTO `users_history` SELECT * FROM `users` WHERE `age` > ?",
+			wantArgs:  []any{30},
+		},
+		{
+			input: func() Querier {
+				b := Dialect(dialect.Postgres)
+				t := b.Table("users")
+				return b.Insert("users_history").
+					Columns("id", "name").
+					Select(b.Select(t.C("id"), t.C("name")).From(t).Where(EQ(t.C("name"), "a8m"))).
+					OnConflict(ConflictColumns("id"), ResolveWithNewValues()).
+					Returning("id")
+			}(),
+			wantQuery: `INSERT INTO "users_history" ("id", "name") SELECT "users"."id", "users"."name" FROM "users" WHERE "users"."name" = $1 ON CONFLICT ("id") DO UPDATE SET "id" = "excluded"."id", "name" = "excluded"."name" RETURNING "id"`,
+			wantArgs:  []any{"a8m"},
+		},
+		{
+			input: func() Querier {
+				b := Dialect(dialect.SQLite)
+				return b.Insert("users_history").
+					Columns("id", "name").
+					Select(b.Select("id", "name").From(b.Table("users")).Where(ExprP("true"))).
+					OnConflict(DoNothing())
+			}(),
+			wantQuery: "INSERT INTO `users_history` (`id`, `name`) SELECT `id`, `name` FROM `users` WHERE true ON CONFLICT DO NOTHING",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+	_, _, err := Insert("users_history").
+		Columns("name").
+		Values("a8m").
+		Select(Select("name").From(Table("users"))).
+		QueryErr()
+	require.EqualError(t, err, "sql: INSERT statement cannot contain both VALUES and SELECT")
+}
+
+func TestDateAdd(t *testing.T) {
+	query, args := Update("users").Set("expired_at", DateAdd("expired_at", 36*time.Hour)).Query()
+	require.Empty(t, args)
+	require.Equal(t, "UPDATE `users` SET `expired_at` = DATE_ADD(`expired_at`, INTERVAL 129600000000 MICROSECOND)", query)
+
+	query, _ = Dialect(dialect.Postgres).
+		Update("users").
+		Set("expired_at", DateAdd("expired_at", -time.Second)).
+		Query()
+	require.Equal(t, `UPDATE "users" SET "expired_at" = "expired_at" + INTERVAL '-1000000 microseconds'`, query)
+
+	query, _ = Dialect(dialect.SQLite).
+		Select().
+		From(Table("users")).
+		Where(P(func(b *Builder) {
+			b.Ident("expired_at").WriteOp(OpGT).Join(DateAdd("created_at", 1500*time.Millisecond))
+		})).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `expired_at` > STRFTIME('%Y-%m-%d %H:%M:%f', `created_at`, '+1.5 seconds')", query)
+}
+
+func TestDateTrunc(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+	}{
+		{
+			input:     Select(Count("*")).AppendSelectExprAs(DateTrunc("day", "created_at"), "day").From(Table("users")).GroupBy("day"),
+			wantQuery: "SELECT COUNT(*), (CAST(DATE_FORMAT(`created_at`, '%Y-%m-%d 00:00:00') AS DATETIME)) AS `day` FROM `users` GROUP BY `day`",
+		},
+		{
+			input:     Dialect(dialect.Postgres).Select(Count("*")).AppendSelectExprAs(DateTrunc("month", "created_at"), "month").From(Table("users")).GroupBy("month"),
+			wantQuery: `SELECT COUNT(*), (DATE_TRUNC('month', "created_at")) AS "month" FROM "users" GROUP BY "month"`,
+		},
+		{
+			input:     Dialect(dialect.SQLite).Select(Count("*")).AppendSelectExprAs(DateTrunc("minute", "created_at"), "minute").From(Table("users")).GroupBy("minute"),
+			wantQuery: "SELECT COUNT(*), (STRFTIME('%Y-%m-%d %H:%M:00', `created_at`)) AS `minute` FROM `users` GROUP BY `minute`",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Empty(t, args)
+		})
+	}
+	s := Select().From(Table("users")).Where(P(func(b *Builder) {
+		b.Join(DateTrunc("week", "created_at")).WriteOp(OpEQ).Arg("2023-01-01")
+	}))
+	s.Query()
+	require.EqualError(t, s.Err(), `sql: unsupported unit "week" for DateTrunc`)
+}
+
+func TestFunc_Aggregate(t *testing.T) {
+	tests := []struct {
+		dialect string
+		fn      func(*Func)
+		want    string
+	}{
+		{dialect.MySQL, func(f *Func) { f.Variance("age") }, "VAR_POP(`age`)"},
+		{dialect.Postgres, func(f *Func) { f.Variance("age") }, `VAR_POP("age")`},
+		{dialect.SQLite, func(f *Func) { f.Variance("age") }, "AVG(`age` * `age`) - AVG(`age`) * AVG(`age`)"},
+		{dialect.MySQL, func(f *Func) { f.StdDev("age") }, "STDDEV_POP(`age`)"},
+		{dialect.SQLite, func(f *Func) { f.StdDev("age") }, "SQRT(AVG(`age` * `age`) - AVG(`age`) * AVG(`age`))"},
+		{dialect.Postgres, func(f *Func) { f.BoolOr("active") }, `BOOL_OR("active")`},
+		{dialect.MySQL, func(f *Func) { f.BoolOr("active") }, "MAX(`active`)"},
+		{dialect.Postgres, func(f *Func) { f.BoolAnd("active") }, `BOOL_AND("active")`},
+		{dialect.SQLite, func(f *Func) { f.BoolAnd("active") }, "MIN(`active`)"},
+		{dialect.Postgres, func(f *Func) { f.ArrayAgg("name") }, `JSON_AGG("name")`},
+		{dialect.MySQL, func(f *Func) { f.ArrayAgg("name") }, "JSON_ARRAYAGG(`name`)"},
+		{dialect.SQLite, func(f *Func) { f.ArrayAgg("name") }, "JSON_GROUP_ARRAY(`name`)"},
+		{dialect.Postgres, func(f *Func) { f.GroupConcat("name", "', ") }, `STRING_AGG(CAST("name" AS TEXT), ''', ')`},
+		{dialect.MySQL, func(f *Func) { f.GroupConcat("name", `\`) }, "GROUP_CONCAT(`name` SEPARATOR '\\\\')"},
+		{dialect.SQLite, func(f *Func) { f.GroupConcat("name", ",") }, "GROUP_CONCAT(`name`, ',')"},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			f := &Func{}
+			f.SetDialect(tt.dialect)
+			tt.fn(f)
+			require.Equal(t, tt.want, f.String())
+		})
+	}
+	require.Equal(t, "VAR_POP(`age`)", Variance("age"))
+	require.Equal(t, "GROUP_CONCAT(`name` SEPARATOR ',')", GroupConcat("name", ","))
+}
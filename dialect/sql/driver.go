@@ -61,8 +61,13 @@ func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
 }
 
 // BeginTx starts a transaction with options.
+//
+//	tx, err := drv.BeginTx(ctx, &sql.TxOptions{
+//		Isolation: sql.LevelRepeatableRead,
+//		ReadOnly:  true,
+//	})
 func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
-	tx, err := d.DB().BeginTx(ctx, opts)
+	tx, err := beginTx(ctx, d.DB(), d.Dialect(), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,44 @@ func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, erro
 	}, nil
 }
 
+// txDeferrableKey is the context key for deferrable transactions.
+type txDeferrableKey struct{}
+
+// WithTxDeferrable returns a new context that marks the transactions that are started
+// with it as DEFERRABLE. Supported only by PostgreSQL, where it takes effect only in
+// SERIALIZABLE READ ONLY transactions, that wait until they can run without the risk
+// of serialization failures.
+//
+//	tx, err := client.BeginTx(sql.WithTxDeferrable(ctx), &sql.TxOptions{
+//		Isolation: sql.LevelSerializable,
+//		ReadOnly:  true,
+//	})
+func WithTxDeferrable(ctx context.Context) context.Context {
+	return context.WithValue(ctx, txDeferrableKey{}, true)
+}
+
+// beginTx starts a database transaction, and applies the options
+// that are not supported by database/sql (e.g. DEFERRABLE).
+func beginTx(ctx context.Context, db *sql.DB, name string, opts *TxOptions) (*sql.Tx, error) {
+	deferrable, _ := ctx.Value(txDeferrableKey{}).(bool)
+	if deferrable && name != dialect.Postgres {
+		return nil, fmt.Errorf("dialect/sql: deferrable transactions are not supported by %s", name)
+	}
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if deferrable {
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION DEFERRABLE"); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: %v", err, rerr)
+			}
+			return nil, fmt.Errorf("dialect/sql: setting deferrable transaction: %w", err)
+		}
+	}
+	return tx, nil
+}
+
 // Close closes the underlying connection.
 func (d *Driver) Close() error { return d.DB().Close() }
 
@@ -152,6 +195,20 @@ type (
 	NullTime = sql.NullTime
 	// TxOptions holds the transaction options to be used in DB.BeginTx.
 	TxOptions = sql.TxOptions
+	// IsolationLevel is the transaction isolation level used in TxOptions.
+	IsolationLevel = sql.IsolationLevel
+)
+
+// Isolation levels of TxOptions. Drivers may support only some of them.
+const (
+	LevelDefault         = sql.LevelDefault
+	LevelReadUncommitted = sql.LevelReadUncommitted
+	LevelReadCommitted   = sql.LevelReadCommitted
+	LevelWriteCommitted  = sql.LevelWriteCommitted
+	LevelRepeatableRead  = sql.LevelRepeatableRead
+	LevelSnapshot        = sql.LevelSnapshot
+	LevelSerializable    = sql.LevelSerializable
+	LevelLinearizable    = sql.LevelLinearizable
 )
 
 // NullScanner represents an sql.Scanner that may be null.
@@ -0,0 +1,45 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_BeginTx(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := OpenDB(dialect.Postgres, db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	tx, err := drv.BeginTx(ctx, &TxOptions{Isolation: LevelRepeatableRead})
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET TRANSACTION DEFERRABLE").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	tx, err = drv.BeginTx(WithTxDeferrable(ctx), &TxOptions{Isolation: LevelSerializable, ReadOnly: true})
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET TRANSACTION DEFERRABLE").WillReturnError(context.Canceled)
+	mock.ExpectRollback()
+	_, err = drv.BeginTx(WithTxDeferrable(ctx), nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = OpenDB(dialect.MySQL, db).BeginTx(WithTxDeferrable(ctx), nil)
+	require.EqualError(t, err, "dialect/sql: deferrable transactions are not supported by mysql")
+}
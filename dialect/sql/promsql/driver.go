@@ -0,0 +1,302 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package promsql provides a driver that exposes Prometheus metrics of the SQL operations it executes.
+package promsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Labels of the metrics that are exposed by the driver.
+const (
+	LabelOperation = "operation" // The driver operation. e.g. exec, query, tx, commit or rollback.
+	LabelTable     = "table"     // The table of the statement, that identifies its entity.
+	LabelCode      = "code"      // The SQLSTATE code of the error, or "unknown" if it was not reported.
+)
+
+// Driver is a driver that exposes the following Prometheus metrics of the operations it executes:
+//
+//   - <namespace>_sql_operation_duration_seconds: a histogram of the operation latencies, by operation and table.
+//   - <namespace>_sql_operation_errors_total: a counter of the failed operations, by operation and SQLSTATE code.
+//   - <namespace>_sql_open_transactions: a gauge of the transactions that were started and not committed or rolled back.
+//   - go_sql_*: the connection pool statistics of the underlying database, if it is exposed by the driver.
+//
+// For example:
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	pdrv, err := promsql.NewDriver(drv, prometheus.DefaultRegisterer)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := ent.NewClient(ent.Driver(pdrv))
+type Driver struct {
+	dialect.Driver
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	openTx   prometheus.Gauge
+}
+
+// config holds the configuration of the driver.
+type config struct {
+	namespace string
+	dbName    string
+	buckets   []float64
+}
+
+// Option configures the Driver.
+type Option func(*config)
+
+// WithNamespace sets the namespace of the metrics. Defaults to "ent".
+func WithNamespace(ns string) Option {
+	return func(c *config) {
+		c.namespace = ns
+	}
+}
+
+// WithDBName sets the "db_name" label of the connection pool metrics. Defaults to "ent".
+// It should be set when multiple drivers are registered on the same registerer.
+func WithDBName(name string) Option {
+	return func(c *config) {
+		c.dbName = name
+	}
+}
+
+// WithBuckets sets the buckets of the latency histogram. Defaults to prometheus.DefBuckets.
+func WithBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.buckets = buckets
+	}
+}
+
+// NewDriver returns a new Driver that wraps the given driver, and registers its metrics on the given registerer.
+func NewDriver(drv dialect.Driver, reg prometheus.Registerer, opts ...Option) (*Driver, error) {
+	c := &config{namespace: "ent", dbName: "ent", buckets: prometheus.DefBuckets}
+	for _, opt := range opts {
+		opt(c)
+	}
+	d := &Driver{
+		Driver: drv,
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: c.namespace,
+			Subsystem: "sql",
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of the SQL operations, by operation and table.",
+			Buckets:   c.buckets,
+		}, []string{LabelOperation, LabelTable}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: c.namespace,
+			Subsystem: "sql",
+			Name:      "operation_errors_total",
+			Help:      "Number of failed SQL operations, by operation and SQLSTATE code.",
+		}, []string{LabelOperation, LabelCode}),
+		openTx: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: c.namespace,
+			Subsystem: "sql",
+			Name:      "open_transactions",
+			Help:      "Number of transactions that were started and not committed or rolled back.",
+		}),
+	}
+	cs := []prometheus.Collector{d.duration, d.errors, d.openTx}
+	if db, ok := drv.(interface{ DB() *sql.DB }); ok {
+		cs = append(cs, collectors.NewDBStatsCollector(db.DB(), c.dbName))
+	}
+	for _, col := range cs {
+		if err := reg.Register(col); err != nil {
+			return nil, fmt.Errorf("promsql: registering collector: %w", err)
+		}
+	}
+	return d, nil
+}
+
+// Exec executes the statement, and records its metrics.
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.observe(dialect.OpExec, query, func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query executes the query, and records its metrics. The latency of
+// queries is the time it takes to execute them, until rows are returned.
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return d.observe(dialect.OpQuery, query, func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// ExecContext executes the statement, and records its metrics, if the
+// ExecContext method is supported by the underlying driver.
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return d.execContext(ctx, d.Driver, query, args...)
+}
+
+// QueryContext executes the query, and records its metrics, if the
+// QueryContext method is supported by the underlying driver.
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return d.queryContext(ctx, d.Driver, query, args...)
+}
+
+// Tx starts a transaction, and records its metrics.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with options, and records its metrics.
+func (d *Driver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	var tx dialect.Tx
+	err := d.observe(dialect.OpTx, "", func() (err error) {
+		if drv, ok := d.Driver.(interface {
+			BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+		}); ok {
+			tx, err = drv.BeginTx(ctx, opts)
+		} else if opts == nil {
+			tx, err = d.Driver.Tx(ctx)
+		} else {
+			err = fmt.Errorf("promsql: Driver.BeginTx is not supported")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	d.openTx.Inc()
+	return &txDriver{Tx: tx, drv: d}, nil
+}
+
+// execContext executes the statement on the given connection, and records its metrics.
+func (d *Driver) execContext(ctx context.Context, conn any, query string, args ...any) (res sql.Result, err error) {
+	c, ok := conn.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("promsql: %T.ExecContext is not supported", conn)
+	}
+	err = d.observe(dialect.OpExec, query, func() error {
+		res, err = c.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+// queryContext executes the query on the given connection, and records its metrics.
+func (d *Driver) queryContext(ctx context.Context, conn any, query string, args ...any) (rows *sql.Rows, err error) {
+	c, ok := conn.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("promsql: %T.QueryContext is not supported", conn)
+	}
+	err = d.observe(dialect.OpQuery, query, func() error {
+		rows, err = c.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// observe executes the given operation, and records its latency and error.
+func (d *Driver) observe(op dialect.Operation, query string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	d.duration.WithLabelValues(string(op), table(query)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		code := sqlgraph.SQLState(err)
+		if code == "" {
+			code = "unknown"
+		}
+		d.errors.WithLabelValues(string(op), code).Inc()
+	}
+	return err
+}
+
+// txDriver is a transaction that records the metrics of its operations.
+type txDriver struct {
+	dialect.Tx
+	drv  *Driver
+	once sync.Once
+}
+
+// Exec executes the statement in the transaction, and records its metrics.
+func (t *txDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return t.drv.observe(dialect.OpExec, query, func() error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query executes the query in the transaction, and records its metrics.
+func (t *txDriver) Query(ctx context.Context, query string, args, v any) error {
+	return t.drv.observe(dialect.OpQuery, query, func() error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// ExecContext executes the statement in the transaction, and records its metrics.
+func (t *txDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return t.drv.execContext(ctx, t.Tx, query, args...)
+}
+
+// QueryContext executes the query in the transaction, and records its metrics.
+func (t *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return t.drv.queryContext(ctx, t.Tx, query, args...)
+}
+
+// Commit commits the transaction, and records its metrics.
+func (t *txDriver) Commit() error {
+	defer t.done()
+	return t.drv.observe(dialect.OpCommit, "", t.Tx.Commit)
+}
+
+// Rollback rolls back the transaction, and records its metrics.
+func (t *txDriver) Rollback() error {
+	defer t.done()
+	return t.drv.observe(dialect.OpRollback, "", t.Tx.Rollback)
+}
+
+// done decrements the open transactions gauge once, as transactions
+// that failed to commit may be rolled back (again) by their callers.
+func (t *txDriver) done() {
+	t.once.Do(t.drv.openTx.Dec)
+}
+
+// table returns the (first) table of the given statement, or an empty string if it cannot be found.
+func table(query string) string {
+	var i int
+	for _, kw := range []string{"INSERT INTO ", "UPDATE ", "DELETE FROM "} {
+		if strings.HasPrefix(query, kw) {
+			i = len(kw)
+			break
+		}
+	}
+	if i == 0 {
+		if i = strings.Index(query, " FROM "); i == -1 {
+			return ""
+		}
+		i += len(" FROM ")
+	}
+	name := query[i:]
+	if end := strings.IndexAny(name, " (,;"); end != -1 {
+		name = name[:end]
+	}
+	// Strip the schema qualifier.
+	if j := strings.LastIndexByte(name, '.'); j != -1 {
+		name = name[j+1:]
+	}
+	return strings.Trim(name, "`\"")
+}
+
+var _ dialect.Driver = (*Driver)(nil)
@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package promsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	reg := prometheus.NewRegistry()
+	drv, err := NewDriver(entsql.OpenDB(dialect.Postgres, db), reg, WithNamespace("app"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	mock.ExpectExec(`INSERT INTO "users" ("name") VALUES ($1)`).WithArgs("a8m").WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, drv.Exec(ctx, `INSERT INTO "users" ("name") VALUES ($1)`, []any{"a8m"}, nil))
+	mock.ExpectQuery(`SELECT "name" FROM "users"`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	rows := &entsql.Rows{}
+	require.NoError(t, drv.Query(ctx, `SELECT "name" FROM "users"`, []any{}, rows))
+	require.NoError(t, rows.Close())
+	require.Equal(t, 2, testutil.CollectAndCount(drv.duration), "exec and query latencies of the users table")
+
+	// Errors are counted by their SQLSTATE code.
+	mock.ExpectExec(`DELETE FROM "pets"`).WillReturnError(pqError{'C': "23503"})
+	require.Error(t, drv.Exec(ctx, `DELETE FROM "pets"`, []any{}, nil))
+	mock.ExpectExec(`DELETE FROM "pets"`).WillReturnError(errors.New("connection refused"))
+	require.Error(t, drv.Exec(ctx, `DELETE FROM "pets"`, []any{}, nil))
+	require.Equal(t, float64(1), testutil.ToFloat64(drv.errors.WithLabelValues("exec", "23503")))
+	require.Equal(t, float64(1), testutil.ToFloat64(drv.errors.WithLabelValues("exec", "unknown")))
+
+	// Open transactions.
+	mock.ExpectBegin()
+	mock.ExpectCommit().WillReturnError(errors.New("connection reset"))
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), testutil.ToFloat64(drv.openTx))
+	require.Error(t, tx.Commit())
+	require.Error(t, tx.Rollback())
+	require.Equal(t, float64(0), testutil.ToFloat64(drv.openTx), "transactions are closed once")
+	require.Equal(t, float64(1), testutil.ToFloat64(drv.errors.WithLabelValues("commit", "unknown")))
+
+	// Statements that are executed with ExecContext and QueryContext.
+	n := testutil.CollectAndCount(drv.duration)
+	mock.ExpectExec(`DELETE FROM "groups"`).WillReturnError(pqError{'C': "23503"})
+	_, err = drv.ExecContext(ctx, `DELETE FROM "groups"`)
+	require.Error(t, err)
+	require.Equal(t, float64(2), testutil.ToFloat64(drv.errors.WithLabelValues("exec", "23503")))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "name" FROM "groups"`).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("GitHub"))
+	mock.ExpectCommit()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	qrows, err := tx.(entsql.ExecQuerier).QueryContext(ctx, `SELECT "name" FROM "groups"`)
+	require.NoError(t, err)
+	require.NoError(t, qrows.Close())
+	require.NoError(t, tx.Commit())
+	require.Equal(t, n+2, testutil.CollectAndCount(drv.duration), "exec and query latencies of the groups table")
+
+	// Metrics are registered on the registerer, including the pool statistics.
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	names := make(map[string]bool)
+	for _, mf := range mfs {
+		names[mf.GetName()] = true
+	}
+	for _, name := range []string{"app_sql_operation_duration_seconds", "app_sql_operation_errors_total", "app_sql_open_transactions", "go_sql_open_connections"} {
+		require.True(t, names[name], name)
+	}
+	_, err = NewDriver(entsql.OpenDB(dialect.Postgres, db), reg, WithNamespace("app"))
+	require.Error(t, err, "metrics are already registered")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTable(t *testing.T) {
+	for query, name := range map[string]string{
+		`SELECT "users"."id" FROM "users" WHERE "name" = $1`: "users",
+		"SELECT COUNT(*) FROM `pets` JOIN `users`":           "pets",
+		`INSERT INTO "public"."users" ("name") VALUES ($1)`:  "users",
+		`UPDATE "users" SET "name" = $1`:                     "users",
+		`DELETE FROM "pets" WHERE "id" = $1`:                 "pets",
+		`SELECT * FROM (SELECT * FROM "users") AS "t1"`:      "",
+		"SELECT 1": "",
+	} {
+		require.Equal(t, name, table(query), query)
+	}
+}
+
+// pqError mimics the pq.Error type.
+type pqError map[byte]string
+
+func (e pqError) Get(k byte) string { return e[k] }
+func (e pqError) Error() string     { return e['M'] }
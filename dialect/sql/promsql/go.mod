@@ -0,0 +1,28 @@
+module entgo.io/ent/dialect/sql/promsql
+
+go 1.20
+
+replace entgo.io/ent => ../../../
+
+require (
+	entgo.io/ent v0.12.2-0.20230420123650-f6de6bb2e04c
+	github.com/DATA-DOG/go-sqlmock v1.5.0
+	github.com/prometheus/client_golang v1.15.1
+	github.com/stretchr/testify v1.8.2
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.4 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/prometheus/client_model v0.3.0 // indirect
+	github.com/prometheus/common v0.42.0 // indirect
+	github.com/prometheus/procfs v0.9.0 // indirect
+	golang.org/x/sys v0.7.0 // indirect
+	google.golang.org/protobuf v1.30.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
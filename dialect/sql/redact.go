@@ -0,0 +1,154 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// Redacted is the value that replaces the redacted arguments.
+const Redacted = "<sensitive>"
+
+// redactOp matches the operators that may follow a column and precede its arguments.
+var redactOp = regexp.MustCompile(`^ (?:=|<>|<=|>=|<|>|(?:NOT )?(?:LIKE|IN)) \(?`)
+
+// RedactColumns returns a dialect.Redactor that replaces the arguments that are bound to the given
+// columns with the Redacted value. The arguments of a column are the values that are inserted into
+// it, the values it is set to in updates, and the values it is compared to in predicates. Columns are
+// matched by name regardless of their table. The generated clients use it for redacting the values of
+// the fields that are marked as Sensitive in the schema.
+//
+//	drv := dialect.DebugWithRedactor(drv, sql.RedactColumns("password", "token"))
+func RedactColumns(columns ...string) dialect.Redactor {
+	return func(query string, args any) any {
+		argv, ok := args.([]any)
+		if !ok || len(columns) == 0 {
+			return args
+		}
+		var redacted []any
+		for _, idx := range redactIndexes(query, columns) {
+			if idx >= len(argv) {
+				continue
+			}
+			if redacted == nil {
+				redacted = append(make([]any, 0, len(argv)), argv...)
+			}
+			redacted[idx] = Redacted
+		}
+		if redacted == nil {
+			return args
+		}
+		return redacted
+	}
+}
+
+// redactIndexes returns the indexes of the arguments that are bound to the given columns.
+func redactIndexes(query string, columns []string) []int {
+	var idx []int
+	for _, c := range columns {
+		for _, q := range []string{`"` + c + `"`, "`" + c + "`"} {
+			for i := strings.Index(query, q); i != -1; {
+				i += len(q)
+				if m := redactOp.FindString(query[i:]); m != "" {
+					idx = append(idx, argList(query, i+len(m))...)
+				}
+				j := strings.Index(query[i:], q)
+				if j == -1 {
+					break
+				}
+				i += j
+			}
+			idx = append(idx, insertArgs(query, q)...)
+		}
+	}
+	return idx
+}
+
+// argList returns the indexes of the list of placeholders that starts at the given position.
+func argList(query string, pos int) []int {
+	var idx []int
+	for {
+		i, ok := argIndex(query, pos)
+		if !ok {
+			return idx
+		}
+		idx = append(idx, i)
+		pos += placeholderLen(query[pos:])
+		if !strings.HasPrefix(query[pos:], ", ") {
+			return idx
+		}
+		pos += 2
+	}
+}
+
+// insertArgs returns the indexes of the arguments that are inserted into the given
+// (quoted) column. Inserts are generated as: INSERT INTO t (c1, c2) VALUES (v1, v2), ...
+func insertArgs(query, column string) []int {
+	if !strings.HasPrefix(query, "INSERT INTO ") {
+		return nil
+	}
+	start, end := strings.IndexByte(query, '('), strings.IndexByte(query, ')')
+	if start == -1 || end < start || !strings.HasPrefix(query[end+1:], " VALUES (") {
+		return nil
+	}
+	col := -1
+	for i, c := range strings.Split(query[start+1:end], ", ") {
+		if c == column {
+			col = i
+			break
+		}
+	}
+	if col == -1 {
+		return nil
+	}
+	var idx []int
+	for pos := end + len(" VALUES ") + 1; strings.HasPrefix(query[pos:], "("); {
+		pos++
+		for i := 0; ; i++ {
+			n := placeholderLen(query[pos:])
+			if n == 0 {
+				// Values that are not arguments end the scan, as
+				// their positions cannot be resolved safely.
+				return idx
+			}
+			if i == col {
+				if j, ok := argIndex(query, pos); ok {
+					idx = append(idx, j)
+				}
+			}
+			if pos += n; !strings.HasPrefix(query[pos:], ", ") {
+				break
+			}
+			pos += 2
+		}
+		if !strings.HasPrefix(query[pos:], "), ") {
+			break
+		}
+		pos += 3
+	}
+	return idx
+}
+
+// placeholderLen returns the length of the placeholder at the start of s, or 0 if there is none.
+func placeholderLen(s string) int {
+	switch {
+	case strings.HasPrefix(s, "?"):
+		return 1
+	case strings.HasPrefix(s, "$"):
+		n := 1
+		for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+			n++
+		}
+		if n == 1 {
+			return 0
+		}
+		return n
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactColumns(t *testing.T) {
+	redact := RedactColumns("password", "token")
+	tests := []struct {
+		query    string
+		args     []any
+		expected []any
+	}{
+		{
+			query:    `INSERT INTO "users" ("name", "password") VALUES ($1, $2), ($3, $4) RETURNING "id"`,
+			args:     []any{"a8m", "secret", "nati", "secret"},
+			expected: []any{"a8m", Redacted, "nati", Redacted},
+		},
+		{
+			query:    "INSERT INTO `users` (`token`, `name`) VALUES (?, ?)",
+			args:     []any{"secret", "a8m"},
+			expected: []any{Redacted, "a8m"},
+		},
+		{
+			query:    `UPDATE "users" SET "name" = $1, "password" = $2 WHERE "users"."token" IN ($3, $4) AND "id" = $5`,
+			args:     []any{"a8m", "secret", "t1", "t2", 1},
+			expected: []any{"a8m", Redacted, Redacted, Redacted, 1},
+		},
+		{
+			query:    "SELECT * FROM `users` WHERE `name` = ? AND `users`.`password` <> ? AND `age` > ?",
+			args:     []any{"a8m", "secret", 30},
+			expected: []any{"a8m", Redacted, 30},
+		},
+		{
+			query:    `SELECT "password" FROM "users" WHERE "name" LIKE $1`,
+			args:     []any{"a8m%"},
+			expected: []any{"a8m%"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			args := append([]any(nil), tt.args...)
+			require.Equal(t, tt.expected, redact(tt.query, tt.args))
+			require.Equal(t, args, tt.args, "original arguments are not modified")
+		})
+	}
+	require.Equal(t, 1, RedactColumns()(`UPDATE "users" SET "password" = $1`, 1))
+}
@@ -8,16 +8,23 @@ import (
 	"context"
 	"crypto/md5"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
 	"ariga.io/atlas/sql/schema"
 	"ariga.io/atlas/sql/sqlclient"
+	"ariga.io/atlas/sql/sqlite"
 	"ariga.io/atlas/sql/sqltool"
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -33,24 +40,52 @@ type Atlas struct {
 	withFixture bool // deprecated: with fks rename fixture
 	sum         bool // deprecated: sum file generation will be required
 
-	indent          string // plan indentation
-	errNoPlan       bool   // no plan error enabled
-	universalID     bool   // global unique ids
-	dropColumns     bool   // drop deleted columns
-	dropIndexes     bool   // drop deleted indexes
-	withForeignKeys bool   // with foreign keys
+	indent          string   // plan indentation
+	errNoPlan       bool     // no plan error enabled
+	universalID     bool     // global unique ids
+	dropColumns     bool     // drop deleted columns
+	dropIndexes     bool     // drop deleted indexes
+	dropForeignKeys bool     // drop deleted foreign keys (legacy)
+	dropTables      bool     // drop deleted tables
+	dropPrefixes    []string // prefixes of tables that can be dropped
+	withForeignKeys bool     // with foreign keys
+	sqliteRecreate  bool     // recreate SQLite tables on column changes (legacy engine)
+	nativeEnums     bool     // native enum types (Postgres)
+	migrationLock   bool     // serialize migrations using a database lock
+	strict          bool     // fail on destructive changes
 	mode            Mode
 	hooks           []Hook              // hooks to apply before creation
 	diffHooks       []DiffHook          // diff hooks to run when diffing current and desired
 	diffOptions     []schema.DiffOption // diff options to pass to the diff engine
 	applyHook       []ApplyHook         // apply hooks to run when applying the plan
+	beforeApply     []TxFunc            // functions to run in the transaction before applying the plan
+	afterApply      []TxFunc            // functions to run in the transaction after applying the plan
 	skip            ChangeKind          // what changes to skip and not apply
 	dir             migrate.Dir         // the migration directory to read from
 	fmt             migrate.Formatter   // how to format the plan into migration files
 
+	pinnedRanges map[string]IDRange // pinned id ranges of types (universal ids)
+	allocator    RangeAllocator     // allocator of id ranges (universal ids)
+
+	views []*View // views that are created after the tables are migrated
+
 	driver  dialect.Driver // driver passed in when not using an atlas URL
 	url     *url.URL       // url of database connection
 	dialect string         // Ent dialect to use when generating migration files
+	schema  string         // schema (named-database) to migrate, instead of the connected one
+	dryRun  io.Writer      // writer of the statements, instead of executing them
+	online  OnlineExecutor // executor of online ALTER TABLE statements (MySQL)
+
+	logger func(LogEntry) // logger of the executed statements
+
+	stmtTimeout time.Duration // statement timeout of the migration transaction
+	lockTimeout time.Duration // lock timeout of the migration transaction
+
+	concurrentIndexes bool // create indexes of existing tables concurrently (Postgres)
+
+	seeds []*seed // functions to run in the transaction after their tables were created
+
+	pkChanges bool // allow changing the type or the columns of primary keys
 
 	types []string // pre-existing pk range allocation for global unique id
 }
@@ -106,11 +141,37 @@ func NewMigrateURL(u string, opts ...MigrateOption) (*Atlas, error) {
 func (a *Atlas) Create(ctx context.Context, tables ...*Table) (err error) {
 	a.setupTables(tables)
 	var creator Creator = CreateFunc(a.create)
-	if a.legacy {
+	switch {
+	case a.dialect == dialect.ClickHouse:
+		if a.universalID {
+			return errors.New("sql/schema: global unique ids are not supported by the ClickHouse dialect")
+		}
+		if a.driver == nil {
+			return errors.New("sql/schema: the ClickHouse dialect requires a driver")
+		}
+		if len(a.views) > 0 {
+			return errors.New("sql/schema: views are not supported by the ClickHouse dialect")
+		}
+		if hasTriggers(tables) {
+			return errors.New("sql/schema: triggers are not supported by the ClickHouse dialect")
+		}
+		creator = CreateFunc((&ClickHouse{Driver: a.writeDriver(a.driver)}).create)
+	case a.legacy:
 		m, err := a.legacyMigrate()
 		if err != nil {
 			return err
 		}
+		for _, t := range tables {
+			if t.Schema != "" {
+				return fmt.Errorf("sql/schema: table %q: schemas are not supported by the legacy migration engine", t.Name)
+			}
+			if t.Annotation != nil && t.Annotation.Partition != nil {
+				return fmt.Errorf("sql/schema: table %q: partitioning is not supported by the legacy migration engine", t.Name)
+			}
+			if len(t.Triggers) > 0 {
+				return fmt.Errorf("sql/schema: table %q: triggers are not supported by the legacy migration engine", t.Name)
+			}
+		}
 		creator = CreateFunc(m.create)
 	}
 	for i := len(a.hooks) - 1; i >= 0; i-- {
@@ -119,6 +180,84 @@ func (a *Atlas) Create(ctx context.Context, tables ...*Table) (err error) {
 	return creator.Create(ctx, tables...)
 }
 
+// Changes returns the changes between the connected database and the given tables, without applying
+// them. The returned changes can be inspected, filtered or vetoed by the caller, before they are passed
+// to Apply. Note that the configured diff hooks are executed before the changes are returned.
+//
+//	changes, err := m.Changes(ctx, tables...)
+//	if err != nil {
+//		return err
+//	}
+//	for _, c := range changes {
+//		if d, ok := c.(*schema.DropTable); ok {
+//			return fmt.Errorf("unexpected drop of table %q", d.T.Name)
+//		}
+//	}
+//	if err := m.Apply(ctx, changes, tables...); err != nil {
+//		return err
+//	}
+func (a *Atlas) Changes(ctx context.Context, tables ...*Table) ([]schema.Change, error) {
+	if a.legacy {
+		return nil, errors.New("sql/schema: Changes is not supported by the legacy migration engine")
+	}
+	a.setupTables(tables)
+	if a.universalID {
+		tables = append(tables, NewTypesTable())
+	}
+	if len(a.views) > 0 {
+		tables = append(tables, NewViewsTable())
+	}
+	if hasTriggers(tables) {
+		tables = append(tables, NewTriggersTable())
+	}
+	release, err := a.openDialect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	a.atDriver, err = a.sqlDialect.atOpen(a.sqlDialect)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { a.atDriver = nil }()
+	st, err := a.inspectState(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: %w", err)
+	}
+	changes, err := a.changes(st.current, st.desired)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: %w", err)
+	}
+	return changes, nil
+}
+
+// Apply plans and applies the given changes, usually returned by Changes, on the connected database.
+// The tables are expected to be the same tables that were passed to Changes, and are used for planning
+// the parts of the schema that are managed by Ent, like row-level security policies and the pk ranges
+// of the universal ids. The changes are applied in a transaction, the same as Create.
+func (a *Atlas) Apply(ctx context.Context, changes []schema.Change, tables ...*Table) error {
+	if a.legacy {
+		return errors.New("sql/schema: Apply is not supported by the legacy migration engine")
+	}
+	a.setupTables(tables)
+	if a.universalID {
+		tables = append(tables, NewTypesTable())
+	}
+	if len(a.views) > 0 {
+		tables = append(tables, NewViewsTable())
+	}
+	if hasTriggers(tables) {
+		tables = append(tables, NewTriggersTable())
+	}
+	return a.apply(ctx, func(ctx context.Context, tx dialect.Tx) (*migrate.Plan, error) {
+		st, err := a.inspectState(ctx, tx, tables)
+		if err != nil {
+			return nil, err
+		}
+		return a.planState(ctx, "changes", st, changes, tables)
+	})
+}
+
 // Diff compares the state read from the connected database with the state defined by Ent.
 // Changes will be written to migration files by the configured Planner.
 func (a *Atlas) Diff(ctx context.Context, tables ...*Table) error {
@@ -131,6 +270,9 @@ func (a *Atlas) NamedDiff(ctx context.Context, name string, tables ...*Table) er
 	if a.dir == nil {
 		return errors.New("no migration directory given")
 	}
+	if a.concurrentIndexes {
+		return errors.New("sql/schema: WithConcurrentIndexes is not supported by versioned migrations")
+	}
 	opts := []migrate.PlannerOption{migrate.WithFormatter(a.fmt)}
 	if a.sum {
 		// Validate the migration directory before proceeding.
@@ -174,6 +316,12 @@ func (a *Atlas) NamedDiff(ctx context.Context, name string, tables ...*Table) er
 	if a.universalID {
 		tables = append(tables, NewTypesTable())
 	}
+	if len(a.views) > 0 {
+		tables = append(tables, NewViewsTable())
+	}
+	if hasTriggers(tables) {
+		tables = append(tables, NewTriggersTable())
+	}
 	var (
 		err  error
 		plan *migrate.Plan
@@ -216,6 +364,31 @@ func (a *Atlas) cleanSchema(ctx context.Context, name string, err0 error) (err e
 	return a.atDriver.ApplyChanges(ctx, drop)
 }
 
+// Inspect returns the tables of the connected database, including their columns, indexes and foreign keys,
+// as they are read by the Inspector. It is useful for building tools that compare the database structure
+// with the Ent schema.
+//
+//	tables, err := migrate.Inspect(ctx)
+//	if err != nil {
+//		log.Fatalf("failed inspecting database: %v", err)
+//	}
+func (a *Atlas) Inspect(ctx context.Context) ([]*Table, error) {
+	drv := a.driver
+	if drv == nil {
+		c, err := sqlclient.OpenURL(ctx, a.url)
+		if err != nil {
+			return nil, err
+		}
+		defer c.Close()
+		drv = entsql.OpenDB(a.dialect, c.DB)
+	}
+	i, err := NewInspect(drv, WithSchema(a.schema))
+	if err != nil {
+		return nil, err
+	}
+	return i.Tables(ctx)
+}
+
 // VerifyTableRange ensures, that the defined autoincrement starting value is set for each table as defined by the
 // TypTable. This is necessary for MySQL versions < 8.0. In those versions the defined starting value for AUTOINCREMENT
 // columns was stored in memory, and when a server restarts happens and there are no rows yet in a table, the defined
@@ -258,7 +431,11 @@ func (a *Atlas) VerifyTableRange(ctx context.Context, tables []*Table) error {
 		if id == -1 {
 			continue
 		}
-		if err := vr.verifyRange(ctx, a.sqlDialect, t, int64(id<<32)); err != nil {
+		r, err := a.idRange(t.Name, id)
+		if err != nil {
+			return err
+		}
+		if err := vr.verifyRange(ctx, a.sqlDialect, t, r.Start); err != nil {
 			return err
 		}
 	}
@@ -448,6 +625,264 @@ func withoutForeignKeys(next Differ) Differ {
 	})
 }
 
+// nativeEnums is a DiffHook for migrations with native enum types. Existing string columns of enum
+// fields are kept as they are, because the database cannot cast them to the enum type automatically,
+// and removals of enum values are rejected, because they cannot be dropped from an enum type.
+func nativeEnums(next Differ) Differ {
+	return DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			m, ok := c.(*schema.ModifyTable)
+			if !ok {
+				continue
+			}
+			filtered := make([]schema.Change, 0, len(m.Changes))
+			for _, change := range m.Changes {
+				mc, ok := change.(*schema.ModifyColumn)
+				if !ok || !mc.Change.Is(schema.ChangeType) {
+					filtered = append(filtered, change)
+					continue
+				}
+				to, ok := mc.To.Type.Type.(*schema.EnumType)
+				if !ok {
+					filtered = append(filtered, change)
+					continue
+				}
+				switch from := mc.From.Type.Type.(type) {
+				case *schema.StringType:
+					mc.To.Type.Type, mc.Change = from, mc.Change&^schema.ChangeType
+					if mc.Change == schema.NoChange {
+						continue
+					}
+				case *schema.EnumType:
+					for _, v := range from.Values {
+						if indexOf(to.Values, v) == -1 {
+							return nil, fmt.Errorf("sql/schema: value %q cannot be removed from enum type %q of column %q.%q", v, from.T, m.T.Name, mc.To.Name)
+						}
+					}
+				}
+				filtered = append(filtered, change)
+			}
+			m.Changes = filtered
+		}
+		return changes, nil
+	})
+}
+
+// defaultExprs is a DiffHook for dropping the changes of expression defaults that are identical to the
+// ones that were inspected from the database, but are formatted differently. For example, the default
+// expression "(gen_random_uuid())" is returned as "gen_random_uuid()" by PostgreSQL.
+func defaultExprs(next Differ) Differ {
+	return DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		filtered := make([]schema.Change, 0, len(changes))
+		for _, c := range changes {
+			m, ok := c.(*schema.ModifyTable)
+			if !ok {
+				filtered = append(filtered, c)
+				continue
+			}
+			mchanges := make([]schema.Change, 0, len(m.Changes))
+			for _, change := range m.Changes {
+				mc, ok := change.(*schema.ModifyColumn)
+				if ok && mc.Change.Is(schema.ChangeDefault) && equalExprs(mc.From.Default, mc.To.Default) {
+					if mc.Change &= ^schema.ChangeDefault; mc.Change == schema.NoChange {
+						continue
+					}
+				}
+				mchanges = append(mchanges, change)
+			}
+			// Skip tables that were modified only by default expressions.
+			if m.Changes = mchanges; len(m.Changes) > 0 {
+				filtered = append(filtered, m)
+			}
+		}
+		return filtered, nil
+	})
+}
+
+// equalExprs reports if the inspected default value is identical to the desired default expression.
+func equalExprs(from, to schema.Expr) bool {
+	x, ok := to.(*schema.RawExpr)
+	if !ok {
+		return false
+	}
+	var x1, x2 string
+	switch from := from.(type) {
+	case *schema.RawExpr:
+		x1 = from.X
+	case *schema.Literal:
+		x1 = from.V
+	default:
+		return false
+	}
+	x1, x2 = unwrapExpr(x1), unwrapExpr(x.X)
+	if x1 == x2 {
+		return true
+	}
+	// Keywords and function names are case-insensitive, but string literals are not.
+	return !strings.ContainsRune(x1, '\'') && !strings.ContainsRune(x2, '\'') && strings.EqualFold(x1, x2)
+}
+
+// unwrapExpr removes the parentheses that wrap the given expression.
+func unwrapExpr(x string) string {
+	x = strings.TrimSpace(x)
+	for len(x) > 1 && x[0] == '(' && x[len(x)-1] == ')' {
+		var depth int
+		for i, quoted := 0, false; i < len(x)-1; i++ {
+			switch c := x[i]; {
+			case c == '\'':
+				quoted = !quoted
+			case quoted:
+			case c == '(':
+				depth++
+			case c == ')':
+				depth--
+			}
+			// The opening parenthesis is closed before the end, e.g. "(a) + (b)".
+			if depth == 0 {
+				return x
+			}
+		}
+		x = strings.TrimSpace(x[1 : len(x)-1])
+	}
+	return x
+}
+
+// oldNames is a column attribute that holds the previous names of the column.
+type oldNames struct {
+	schema.Attr
+	names []string
+}
+
+// renameColumns is a DiffHook for replacing the changes of columns that were renamed, an addition
+// of the column and a drop of one of its old names, with a rename change of the existing column.
+func renameColumns(next Differ) Differ {
+	return DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			if c, ok := c.(*schema.ModifyTable); ok {
+				c.Changes = renameTableColumns(c.Changes)
+			}
+		}
+		return changes, nil
+	})
+}
+
+func renameTableColumns(changes []schema.Change) []schema.Change {
+	drops := make(map[string]int)
+	for i, c := range changes {
+		if c, ok := c.(*schema.DropColumn); ok {
+			drops[c.C.Name] = i
+		}
+	}
+	if len(drops) == 0 {
+		return changes
+	}
+	renamed := make(map[int]bool)
+	for i, c := range changes {
+		add, ok := c.(*schema.AddColumn)
+		if !ok {
+			continue
+		}
+		for _, a := range add.C.Attrs {
+			o, ok := a.(*oldNames)
+			if !ok {
+				continue
+			}
+			for _, name := range o.names {
+				if j, ok := drops[name]; ok && !renamed[j] {
+					changes[i] = &schema.RenameColumn{From: changes[j].(*schema.DropColumn).C, To: add.C}
+					renamed[j] = true
+					break
+				}
+			}
+		}
+	}
+	filtered := make([]schema.Change, 0, len(changes)-len(renamed))
+	for i, c := range changes {
+		if !renamed[i] {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// renameIndexes replaces index drops and creations of the same table with index renames,
+// if the dropped and the created indexes are identical, except of their names. For example,
+// when the naming scheme of the indexes was changed, and re-creating them is expensive.
+func (a *Atlas) renameIndexes(next Differ) Differ {
+	return DiffFunc(func(current, desired *schema.Schema) ([]schema.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			if c, ok := c.(*schema.ModifyTable); ok {
+				c.Changes = renameTableIndexes(a.atDriver, c.Changes)
+			}
+		}
+		return changes, nil
+	})
+}
+
+func renameTableIndexes(d schema.Differ, changes []schema.Change) []schema.Change {
+	drops := make([]int, 0, len(changes))
+	for i, c := range changes {
+		if _, ok := c.(*schema.DropIndex); ok {
+			drops = append(drops, i)
+		}
+	}
+	if len(drops) == 0 {
+		return changes
+	}
+	renamed := make(map[int]bool)
+	for i, c := range changes {
+		add, ok := c.(*schema.AddIndex)
+		if !ok {
+			continue
+		}
+		for _, j := range drops {
+			if drop := changes[j].(*schema.DropIndex); !renamed[j] && sameIndex(d, drop.I, add.I) {
+				changes[i] = &schema.RenameIndex{From: drop.I, To: add.I}
+				renamed[j] = true
+				break
+			}
+		}
+	}
+	filtered := make([]schema.Change, 0, len(changes)-len(renamed))
+	for i, c := range changes {
+		if !renamed[i] {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// sameIndex reports if the two indexes are identical, except of their names. The indexes are compared
+// using the differ of the dialect, by diffing two tables that contain them under the same name.
+func sameIndex(d schema.Differ, from, to *schema.Index) bool {
+	if from.Unique != to.Unique || len(from.Parts) != len(to.Parts) {
+		return false
+	}
+	i1, i2 := *from, *to
+	i1.Name = i2.Name
+	t1, t2 := &schema.Table{Name: "t"}, &schema.Table{Name: "t"}
+	i1.Table, i2.Table = t1, t2
+	t1.Indexes, t2.Indexes = []*schema.Index{&i1}, []*schema.Index{&i2}
+	changes, err := d.TableDiff(t1, t2)
+	return err == nil && len(changes) == 0
+}
+
 type (
 	// Applier is the interface that wraps the Apply method.
 	Applier interface {
@@ -488,99 +923,334 @@ func WithApplyHook(hooks ...ApplyHook) MigrateOption {
 	}
 }
 
-// WithAtlas is an opt-out option for v0.11 indicating the migration
-// should be executed using the deprecated legacy engine.
-// Note, in future versions, this option is going to be removed
-// and the Atlas (https://atlasgo.io) based migration engine should be used.
+// TxFunc is a function that runs inside the migration transaction. For example,
+// to create extensions before the tables are created, or to backfill data after
+// new columns were added.
+type TxFunc func(context.Context, dialect.ExecQuerier) error
+
+// WithBeforeApply adds a list of functions to run inside the migration transaction,
+// before the tables are created or altered.
 //
-// Deprecated: The legacy engine will be removed.
-func WithAtlas(b bool) MigrateOption {
+//	schema.WithBeforeApply(func(ctx context.Context, conn dialect.ExecQuerier) error {
+//		return conn.Exec(ctx, `CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`, []any{}, nil)
+//	})
+func WithBeforeApply(fns ...TxFunc) MigrateOption {
 	return func(a *Atlas) {
-		a.legacy = !b
+		a.beforeApply = append(a.beforeApply, fns...)
 	}
 }
 
-// WithDir sets the atlas migration directory to use to store migration files.
-func WithDir(dir migrate.Dir) MigrateOption {
+// WithSeed adds a function to run inside the migration transaction, after the given table was created by the
+// migration, for inserting its bootstrap rows. The function is not executed if the table already exists, and
+// if it returns an error, the transaction is rolled back and the table is not created.
+//
+//	schema.WithSeed("roles", func(ctx context.Context, conn dialect.ExecQuerier) error {
+//		return conn.Exec(ctx, "INSERT INTO roles (name) VALUES ('admin'), ('member')", []any{}, nil)
+//	})
+func WithSeed(table string, fn TxFunc) MigrateOption {
 	return func(a *Atlas) {
-		a.dir = dir
+		a.seeds = append(a.seeds, &seed{table: table, fn: fn})
 	}
 }
 
-// WithFormatter sets atlas formatter to use to write changes to migration files.
-func WithFormatter(fmt migrate.Formatter) MigrateOption {
+// seed is a function that inserts the bootstrap rows of a table.
+type seed struct {
+	table string
+	fn    TxFunc
+}
+
+// WithAfterApply adds a list of functions to run inside the migration transaction,
+// after the tables were created or altered, and before the transaction is committed.
+//
+//	schema.WithAfterApply(func(ctx context.Context, conn dialect.ExecQuerier) error {
+//		return conn.Exec(ctx, "UPDATE users SET nickname = name WHERE nickname IS NULL", []any{}, nil)
+//	})
+func WithAfterApply(fns ...TxFunc) MigrateOption {
 	return func(a *Atlas) {
-		a.fmt = fmt
+		a.afterApply = append(a.afterApply, fns...)
 	}
 }
 
-// WithDialect configures the Ent dialect to use when migrating for an Atlas supported dialect flavor.
-// As an example, Ent can work with TiDB in MySQL dialect and Atlas can handle TiDB migrations.
-func WithDialect(d string) MigrateOption {
+// WithMigrationLock configures the migration to acquire a database lock for its duration, in order to
+// prevent multiple instances of the application from migrating the database at the same time. The lock
+// is acquired using pg_advisory_xact_lock in PostgreSQL and GET_LOCK in MySQL, and is ignored in other
+// dialects.
+//
+//	if err := client.Schema.Create(ctx, schema.WithMigrationLock(true)); err != nil {
+//		log.Fatalf("failed creating schema resources: %v", err)
+//	}
+func WithMigrationLock(b bool) MigrateOption {
 	return func(a *Atlas) {
-		a.dialect = d
+		a.migrationLock = b
 	}
 }
 
-// WithSumFile instructs atlas to generate a migration directory integrity sum file.
+// WithStatementTimeout configures the maximum duration of each statement executed by the migration
+// transaction, by setting the statement_timeout of the transaction in PostgreSQL. It is ignored in
+// other dialects, as MySQL does not support limiting the execution time of DDL statements.
 //
-// Deprecated: generating the sum file is now opt-out. This method will be removed in future versions.
-func WithSumFile() MigrateOption {
-	return func(a *Atlas) {}
+//	err := client.Schema.Create(ctx, schema.WithStatementTimeout(5*time.Minute))
+func WithStatementTimeout(d time.Duration) MigrateOption {
+	return func(a *Atlas) {
+		a.stmtTimeout = d
+	}
 }
 
-// DisableChecksum instructs atlas to skip migration directory integrity sum file generation.
+// WithLockTimeout configures the maximum duration the statements of the migration wait for acquiring
+// table locks, so that an ALTER TABLE blocked by a long-running transaction fails fast, instead of blocking
+// the queries queued behind it. The timeout is set using lock_timeout in PostgreSQL and lock_wait_timeout
+// (in seconds) in MySQL, and is ignored in other dialects.
 //
-// Deprecated: generating the sum file will no longer be optional in future versions.
-func DisableChecksum() MigrateOption {
+//	err := client.Schema.Create(ctx, schema.WithLockTimeout(5*time.Second))
+func WithLockTimeout(d time.Duration) MigrateOption {
 	return func(a *Atlas) {
-		a.sum = false
+		a.lockTimeout = d
 	}
 }
 
-// WithMigrationMode instructs atlas how to compute the current state of the schema. This can be done by either
-// replaying (ModeReplay) the migration directory on the connected database, or by inspecting (ModeInspect) the
-// connection. Currently, ModeReplay is opt-in, and ModeInspect is the default. In future versions, ModeReplay will
-// become the default behavior. This option has no effect when using online migrations.
-func WithMigrationMode(mode Mode) MigrateOption {
+// WithConcurrentIndexes configures the migration to create the indexes of existing PostgreSQL tables using
+// CREATE INDEX CONCURRENTLY, which does not block writes to the tables while the indexes are built. Indexes
+// cannot be built concurrently inside a transaction, and therefore, they are created after the migration
+// transaction is committed. A failed build leaves an invalid index behind, that is dropped before the build
+// is retried. The option is ignored by other dialects.
+//
+//	err := client.Schema.Create(ctx, schema.WithConcurrentIndexes(true))
+func WithConcurrentIndexes(b bool) MigrateOption {
 	return func(a *Atlas) {
-		a.mode = mode
+		a.concurrentIndexes = b
 	}
 }
 
-// Mode to compute the current state.
-type Mode uint
+// WithStrict configures the migration to fail, instead of applying the plan, in case it contains destructive
+// changes, like dropping tables or columns, shrinking the size of string columns, or changing nullable columns
+// that hold NULL values to NOT NULL. The returned error is a *DestructiveError that lists the offending changes.
+//
+//	err := client.Schema.Create(ctx, schema.WithStrict(true))
+//	if de := (*schema.DestructiveError)(nil); errors.As(err, &de) {
+//		log.Fatalf("destructive changes were found in the migration plan: %v", de)
+//	}
+func WithStrict(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.strict = b
+	}
+}
 
-const (
-	// ModeReplay computes the current state by replaying the migration directory on the connected database.
-	ModeReplay = iota
-	// ModeInspect computes the current state by inspecting the connected database.
-	ModeInspect
+type (
+	// DestructiveChange describes a destructive change that was found in the migration plan.
+	DestructiveChange struct {
+		Reason string   // description of the change, e.g. drop column "age" of table "users".
+		Stmts  []string // statements that apply the change.
+	}
+
+	// DestructiveError is returned by migrations in strict mode, in case the plan contains destructive changes.
+	DestructiveError struct {
+		Changes []*DestructiveChange
+	}
 )
 
-// StateReader returns an atlas migrate.StateReader returning the state as described by the Ent table slice.
-func (a *Atlas) StateReader(tables ...*Table) migrate.StateReaderFunc {
-	return func(ctx context.Context) (*schema.Realm, error) {
-		if a.sqlDialect == nil {
-			drv, err := a.entDialect(ctx, a.driver)
-			if err != nil {
-				return nil, err
-			}
-			a.sqlDialect = drv
-		}
-		ts, err := a.tables(tables)
-		if err != nil {
-			return nil, err
-		}
-		return &schema.Realm{Schemas: []*schema.Schema{{Tables: ts}}}, nil
+// Error implements the error interface.
+func (e *DestructiveError) Error() string {
+	var b strings.Builder
+	b.WriteString("destructive changes are not allowed in strict mode:")
+	for _, c := range e.Changes {
+		fmt.Fprintf(&b, "\n\t%s: %s", c.Reason, strings.Join(c.Stmts, "; "))
 	}
+	return b.String()
 }
 
-// atBuilder must be implemented by the different drivers in
-// order to convert a dialect/sql/schema to atlas/sql/schema.
+// WithPrimaryKeyChanges allows the migration to change the primary key of existing tables, for example, when
+// the type of the ID field is changed from int to uuid. By default, such changes fail the migration with a
+// *PrimaryKeyError, because the database can rarely convert the existing values, and the foreign keys that
+// reference the table are not migrated. Enable it only for empty tables, or if the conversion is known to be safe.
+//
+//	err := client.Schema.Create(ctx, schema.WithPrimaryKeyChanges(true))
+func WithPrimaryKeyChanges(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.pkChanges = b
+	}
+}
+
+// PrimaryKeyError is returned by migrations in case the primary key of an existing table is changed,
+// and the WithPrimaryKeyChanges option is not enabled.
+type PrimaryKeyError struct {
+	Table  string // table name.
+	Column string // primary key column, if the change is limited to a single column.
+	Reason string // description of the change, e.g. change type of column "id" from bigint to uuid.
+}
+
+// Error implements the error interface.
+func (e *PrimaryKeyError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "cannot change primary key of table %q: %s.", e.Table, e.Reason)
+	b.WriteString(" Primary key changes are not applied automatically, as they require migrating the existing rows and the foreign keys that reference the table. To migrate the table manually:")
+	fmt.Fprintf(&b, "\n\t1. Add a new column with the desired type to table %q, and backfill it.", e.Table)
+	b.WriteString("\n\t2. Add new columns to the referencing tables, backfill them using the new column, and replace their foreign keys.")
+	b.WriteString("\n\t3. Replace the primary key with the new column, and drop the old one.")
+	b.WriteString("\nAlternatively, if the table is empty or the conversion is safe, enable the WithPrimaryKeyChanges option.")
+	return b.String()
+}
+
+// WithDryRun configures the migration to write the statements it would execute to the given writer,
+// instead of executing them on the database. Note that the database is still inspected, in order to
+// compute the changes, but nothing is modified.
+//
+//	if err := client.Schema.Create(ctx, schema.WithDryRun(os.Stdout)); err != nil {
+//		log.Fatalf("failed printing schema changes: %v", err)
+//	}
+func WithDryRun(w io.Writer) MigrateOption {
+	return func(a *Atlas) {
+		a.dryRun = w
+	}
+}
+
+// WithAtlas is an opt-out option for v0.11 indicating the migration
+// should be executed using the deprecated legacy engine.
+// Note, in future versions, this option is going to be removed
+// and the Atlas (https://atlasgo.io) based migration engine should be used.
+//
+// Deprecated: The legacy engine will be removed.
+func WithAtlas(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.legacy = !b
+	}
+}
+
+// WithDir sets the atlas migration directory to use to store migration files.
+func WithDir(dir migrate.Dir) MigrateOption {
+	return func(a *Atlas) {
+		a.dir = dir
+	}
+}
+
+// WithFormatter sets atlas formatter to use to write changes to migration files.
+func WithFormatter(fmt migrate.Formatter) MigrateOption {
+	return func(a *Atlas) {
+		a.fmt = fmt
+	}
+}
+
+// WithDialect configures the Ent dialect to use when migrating for an Atlas supported dialect flavor.
+// As an example, Ent can work with TiDB in MySQL dialect and Atlas can handle TiDB migrations.
+func WithDialect(d string) MigrateOption {
+	return func(a *Atlas) {
+		a.dialect = d
+	}
+}
+
+// WithSchemaName configures the schema (named-database) to migrate instead of the current schema of
+// the connection, e.g. a Postgres schema that is not the first in the search_path. Tables that set their
+// Schema field are created and inspected in their own schema, and can be referenced by foreign keys
+// from other schemas.
+func WithSchemaName(name string) MigrateOption {
+	return func(a *Atlas) {
+		a.schema = name
+	}
+}
+
+// WithNativeEnums configures the migration to use native enum types for enum fields in Postgres, instead
+// of varchar columns. The types are named after their table and column, and values that are added to the
+// fields are added to their types. Existing varchar columns are not converted. Defaults to false.
+func WithNativeEnums(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.nativeEnums = b
+	}
+}
+
+// WithSumFile instructs atlas to generate a migration directory integrity sum file.
+//
+// Deprecated: generating the sum file is now opt-out. This method will be removed in future versions.
+func WithSumFile() MigrateOption {
+	return func(a *Atlas) {}
+}
+
+// DisableChecksum instructs atlas to skip migration directory integrity sum file generation.
+//
+// Deprecated: generating the sum file will no longer be optional in future versions.
+func DisableChecksum() MigrateOption {
+	return func(a *Atlas) {
+		a.sum = false
+	}
+}
+
+// WithMigrationMode instructs atlas how to compute the current state of the schema. This can be done by either
+// replaying (ModeReplay) the migration directory on the connected database, or by inspecting (ModeInspect) the
+// connection. Currently, ModeReplay is opt-in, and ModeInspect is the default. In future versions, ModeReplay will
+// become the default behavior. This option has no effect when using online migrations.
+func WithMigrationMode(mode Mode) MigrateOption {
+	return func(a *Atlas) {
+		a.mode = mode
+	}
+}
+
+// Mode to compute the current state.
+type Mode uint
+
+const (
+	// ModeReplay computes the current state by replaying the migration directory on the connected database.
+	ModeReplay = iota
+	// ModeInspect computes the current state by inspecting the connected database.
+	ModeInspect
+)
+
+// StateReader returns an atlas migrate.StateReader returning the state as described by the Ent table slice.
+func (a *Atlas) StateReader(tables ...*Table) migrate.StateReaderFunc {
+	return func(ctx context.Context) (*schema.Realm, error) {
+		if a.sqlDialect == nil {
+			drv, err := a.entDialect(ctx, a.driver)
+			if err != nil {
+				return nil, err
+			}
+			a.sqlDialect = drv
+		}
+		ts, err := a.tables(tables)
+		if err != nil {
+			return nil, err
+		}
+		return &schema.Realm{Schemas: a.schemas(tables, ts)}, nil
+	}
+}
+
+// schemas groups the converted tables by their schema, in order of appearance. Tables
+// are linked to their schema only if it was set, to keep their statements unqualified.
+func (a *Atlas) schemas(tables []*Table, ts []*schema.Table) []*schema.Schema {
+	var (
+		ss     []*schema.Schema
+		byName = make(map[string]*schema.Schema)
+	)
+	for i, et := range tables {
+		name := a.tableSchema(et)
+		s, ok := byName[name]
+		if !ok {
+			s = &schema.Schema{Name: name}
+			byName[name] = s
+			ss = append(ss, s)
+		}
+		if name != "" {
+			s.AddTables(ts[i])
+		} else {
+			s.Tables = append(s.Tables, ts[i])
+		}
+	}
+	if len(ss) == 0 {
+		ss = append(ss, &schema.Schema{Name: a.schema})
+	}
+	return ss
+}
+
+// tableSchema returns the schema of the given table, or the migrated schema if it was not set.
+func (a *Atlas) tableSchema(t *Table) string {
+	if t.Schema != "" {
+		return t.Schema
+	}
+	return a.schema
+}
+
+// atBuilder must be implemented by the different drivers in
+// order to convert a dialect/sql/schema to atlas/sql/schema.
 type atBuilder interface {
 	atOpen(dialect.ExecQuerier) (migrate.Driver, error)
 	atTable(*Table, *schema.Table)
+	atPartition(*Table, *schema.Table) error
 	supportsDefault(*Column) bool
 	atTypeC(*Column, *schema.Column) error
 	atUniqueC(*Table, *Column, *schema.Table, *schema.Column)
@@ -590,6 +1260,77 @@ type atBuilder interface {
 	atTypeRangeSQL(t ...string) string
 }
 
+// rowSecurer is implemented by the drivers that support row-level security.
+type rowSecurer interface {
+	rowSecurity(context.Context, dialect.ExecQuerier, []*Table) (map[string]*rowSecurity, error)
+	rowSecurityChanges([]*Table, map[string]*rowSecurity) ([]*migrate.Change, error)
+}
+
+// deferrer is implemented by the drivers that support deferrable foreign keys.
+type deferrer interface {
+	deferrable(context.Context, dialect.ExecQuerier, []*Table) (map[string]map[string]bool, error)
+	deferrableChanges([]*Table, map[string]map[string]bool, []*migrate.Change) []*migrate.Change
+}
+
+// tableOptioner is implemented by the drivers that support altering the options of existing tables (e.g. the MySQL
+// engine). The planned changes are passed for options that must be set when tables are created (e.g. Postgres persistence).
+type tableOptioner interface {
+	tableOptions(context.Context, dialect.ExecQuerier, []*Table) (map[string]*tableOptions, error)
+	tableOptionsChanges([]*Table, map[string]*tableOptions, []*migrate.Change) []*migrate.Change
+}
+
+// sequencer is implemented by the drivers that support configuring the sequences of identity columns.
+type sequencer interface {
+	sequences(context.Context, dialect.ExecQuerier, []*Table) (map[string]*sequence, error)
+	sequenceChanges([]*Table, map[string]*sequence) []*migrate.Change
+}
+
+// extensionCreator is implemented by the drivers that support creating the extensions that are required by the tables.
+type extensionCreator interface {
+	extensions(context.Context, dialect.ExecQuerier, []*Table) (map[string]bool, error)
+	extensionChanges([]*Table, map[string]bool) []*migrate.Change
+}
+
+// typeConverter is implemented by the drivers that support conversion expressions for column type changes.
+type typeConverter interface {
+	convertUsing([]*Table, []*migrate.Change) error
+}
+
+// migrationLocker is implemented by the drivers that support serializing migrations using a database lock.
+// The returned function releases the lock, and is called before the migration transaction is completed.
+type migrationLocker interface {
+	migrationLock(context.Context, dialect.ExecQuerier) (func(context.Context) error, error)
+}
+
+// timeoutSetter is implemented by the drivers that support limiting the duration of the statements
+// of the migration transaction. The returned function restores the previous settings, and is called
+// before the migration transaction is completed.
+type timeoutSetter interface {
+	setTimeouts(_ context.Context, _ dialect.ExecQuerier, stmt, lock time.Duration) (func(context.Context) error, error)
+}
+
+// concurrentIndexer is implemented by the drivers that support creating indexes without blocking writes to
+// their tables. Such indexes are created outside the migration transaction, after it was committed.
+type concurrentIndexer interface {
+	// invalidIndexes returns the invalid indexes of the given tables, keyed by their table names.
+	invalidIndexes(context.Context, dialect.ExecQuerier, []*Table) (map[string][]string, error)
+	// concurrentIndexes marks the indexes that are added to existing tables to be created concurrently.
+	concurrentIndexes([]schema.Change)
+	// invalidIndexChanges prepends the drop of the invalid indexes to the changes that recreate them.
+	invalidIndexChanges([]*migrate.Change, map[string][]string) []*migrate.Change
+}
+
+// fullTextIndexer is implemented by the drivers that maintain the full-text indexes in auxiliary tables (e.g. SQLite FTS5).
+type fullTextIndexer interface {
+	fullTextTables(context.Context, dialect.ExecQuerier, []*Table) (map[string]bool, error)
+	fullTextChanges([]*Table, map[string]bool, []*migrate.Change) []*migrate.Change
+}
+
+// collationDiffer is implemented by the drivers that diff the collation of columns, in case it is not supported by Atlas.
+type collationDiffer interface {
+	collationChanges(current, desired *schema.Schema, changes []schema.Change) []schema.Change
+}
+
 // init initializes the configuration object based on the options passed in.
 func (a *Atlas) init() error {
 	skip := DropIndex | DropColumn
@@ -608,6 +1349,18 @@ func (a *Atlas) init() error {
 	if !a.withForeignKeys {
 		a.diffHooks = append(a.diffHooks, withoutForeignKeys)
 	}
+	if a.nativeEnums {
+		a.diffHooks = append(a.diffHooks, nativeEnums)
+	}
+	a.diffHooks = append(a.diffHooks, defaultExprs)
+	// Renames are detected before the other hooks are
+	// executed, as they may filter out the column drops.
+	a.diffHooks = append(a.diffHooks, renameColumns)
+	// Index renames are supported natively by PostgreSQL, and they are
+	// detected before the index drops are filtered out (if skipped).
+	if a.dialect == dialect.Postgres {
+		a.diffHooks = append(a.diffHooks, a.renameIndexes)
+	}
 	if a.dir != nil && a.fmt == nil {
 		switch a.dir.(type) {
 		case *sqltool.GooseDir:
@@ -640,26 +1393,59 @@ func (a *Atlas) create(ctx context.Context, tables ...*Table) (err error) {
 	if a.universalID {
 		tables = append(tables, NewTypesTable())
 	}
+	if len(a.views) > 0 {
+		tables = append(tables, NewViewsTable())
+	}
+	if hasTriggers(tables) {
+		tables = append(tables, NewTriggersTable())
+	}
+	return a.apply(ctx, func(ctx context.Context, tx dialect.Tx) (*migrate.Plan, error) {
+		return a.planInspect(ctx, tx, "changes", tables)
+	})
+}
+
+// openDialect opens the Ent dialect of the migration. The returned function should be called
+// to release the resources of the dialect, after the migration is done.
+func (a *Atlas) openDialect(ctx context.Context) (func(), error) {
+	var (
+		err     error
+		release = func() { a.sqlDialect = nil }
+	)
 	if a.driver != nil {
-		a.sqlDialect, err = a.entDialect(ctx, a.driver)
+		a.sqlDialect, err = a.entDialect(ctx, a.writeDriver(a.driver))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		c, err := sqlclient.OpenURL(ctx, a.url)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		defer c.Close()
-		a.sqlDialect, err = a.entDialect(ctx, entsql.OpenDB(a.dialect, c.DB))
+		a.sqlDialect, err = a.entDialect(ctx, a.writeDriver(entsql.OpenDB(a.dialect, c.DB)))
 		if err != nil {
-			return err
+			c.Close()
+			return nil, err
+		}
+		release = func() {
+			a.sqlDialect = nil
+			c.Close()
 		}
 	}
-	defer func() { a.sqlDialect = nil }()
 	if err := a.sqlDialect.init(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// apply executes the plan returned by the given function inside a transaction.
+func (a *Atlas) apply(ctx context.Context, planner func(context.Context, dialect.Tx) (*migrate.Plan, error)) error {
+	var concurrent []*migrate.Change
+	release, err := a.openDialect(ctx)
+	if err != nil {
 		return err
 	}
+	defer release()
 	// Open a transaction for backwards compatibility,
 	// even if the migration is not transactional.
 	tx, err := a.sqlDialect.Tx(ctx)
@@ -671,69 +1457,506 @@ func (a *Atlas) create(ctx context.Context, tables ...*Table) (err error) {
 		return err
 	}
 	defer func() { a.atDriver = nil }()
-	if err := func() error {
-		plan, err := a.planInspect(ctx, tx, "changes", tables)
+	if err := func() (err error) {
+		// Statements are not executed in dry-run mode, and there is no reason to serialize it.
+		if l, ok := a.sqlDialect.(migrationLocker); ok && a.migrationLock && a.dryRun == nil {
+			unlock, err := l.migrationLock(ctx, tx)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if uerr := unlock(ctx); uerr != nil && err == nil {
+					err = uerr
+				}
+			}()
+		}
+		if s, ok := a.sqlDialect.(timeoutSetter); ok && (a.stmtTimeout > 0 || a.lockTimeout > 0) && a.dryRun == nil {
+			restore, err := s.setTimeouts(ctx, tx, a.stmtTimeout, a.lockTimeout)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if rerr := restore(ctx); rerr != nil && err == nil {
+					err = rerr
+				}
+			}()
+		}
+		plan, err := planner(ctx, tx)
 		if err != nil {
 			return err
 		}
+		plan.Changes, concurrent = splitConcurrent(plan.Changes)
+		if a.strict {
+			changes, err := a.destructiveChanges(ctx, tx, plan)
+			if err != nil {
+				return err
+			}
+			if len(changes) > 0 {
+				return &DestructiveError{Changes: changes}
+			}
+		}
 		// Apply plan (changes).
 		var applier Applier = ApplyFunc(func(ctx context.Context, tx dialect.ExecQuerier, plan *migrate.Plan) error {
 			for _, c := range plan.Changes {
+				switch ok, err := a.execOnline(ctx, tx, c); {
+				case err != nil:
+					return err
+				case ok:
+					continue
+				}
 				if err := tx.Exec(ctx, c.Cmd, c.Args, nil); err != nil {
+					err = extensionError(c, err)
 					if c.Comment != "" {
 						err = fmt.Errorf("%s: %w", c.Comment, err)
 					}
 					return err
 				}
 			}
-			return nil
-		})
-		for i := len(a.applyHook) - 1; i >= 0; i-- {
-			applier = a.applyHook[i](applier)
-		}
-		return applier.Apply(ctx, tx, plan)
-	}(); err != nil {
-		err = fmt.Errorf("sql/schema: %w", err)
-		if rerr := tx.Rollback(); rerr != nil {
-			err = fmt.Errorf("%w: %v", err, rerr)
+			return nil
+		})
+		for i := len(a.applyHook) - 1; i >= 0; i-- {
+			applier = a.applyHook[i](applier)
+		}
+		for _, f := range a.beforeApply {
+			if err := f(ctx, tx); err != nil {
+				return err
+			}
+		}
+		if err := applier.Apply(ctx, tx, plan); err != nil {
+			return err
+		}
+		if err := a.applySeeds(ctx, tx, plan); err != nil {
+			return err
+		}
+		for _, f := range a.afterApply {
+			if err := f(ctx, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		err = fmt.Errorf("sql/schema: %w", err)
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return a.applyConcurrent(ctx, concurrent)
+}
+
+// applySeeds runs the seed functions of the tables that were created by the given plan.
+func (a *Atlas) applySeeds(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+	if len(a.seeds) == 0 {
+		return nil
+	}
+	created := make(map[string]bool)
+	for _, c := range plan.Changes {
+		if add, ok := c.Source.(*schema.AddTable); ok {
+			created[add.T.Name] = true
+		}
+	}
+	for _, s := range a.seeds {
+		if !created[s.table] {
+			continue
+		}
+		if err := s.fn(ctx, conn); err != nil {
+			return fmt.Errorf("seed table %q: %w", s.table, err)
+		}
+	}
+	return nil
+}
+
+// reConcurrent matches the statements that cannot be executed inside a transaction block.
+var reConcurrent = regexp.MustCompile(`^(?:CREATE (?:UNIQUE )?INDEX|DROP INDEX) CONCURRENTLY `)
+
+// splitConcurrent splits the changes that are executed outside the migration transaction from the rest.
+func splitConcurrent(changes []*migrate.Change) (tx, concurrent []*migrate.Change) {
+	for _, c := range changes {
+		if reConcurrent.MatchString(c.Cmd) {
+			concurrent = append(concurrent, c)
+		} else {
+			tx = append(tx, c)
+		}
+	}
+	return tx, concurrent
+}
+
+// concurrentIndexAttempts is the number of attempts for building an index concurrently.
+const concurrentIndexAttempts = 3
+
+// applyConcurrent executes the given changes outside the migration transaction. Failed concurrent index
+// builds leave invalid indexes behind, and therefore, such indexes are dropped before the build is retried.
+func (a *Atlas) applyConcurrent(ctx context.Context, changes []*migrate.Change) error {
+	for _, c := range changes {
+		for i := 1; ; i++ {
+			err := a.sqlDialect.Exec(ctx, c.Cmd, c.Args, nil)
+			if err == nil {
+				break
+			}
+			drop, ok := c.Reverse.(string)
+			if i == concurrentIndexAttempts || !ok || !strings.HasPrefix(c.Cmd, "CREATE") {
+				return fmt.Errorf("sql/schema: %s: %w", c.Comment, err)
+			}
+			drop = strings.Replace(drop, "DROP INDEX CONCURRENTLY", "DROP INDEX CONCURRENTLY IF EXISTS", 1)
+			if err := a.sqlDialect.Exec(ctx, drop, []any{}, nil); err != nil {
+				return fmt.Errorf("sql/schema: drop invalid index: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// destructiveChanges returns the destructive changes of the given plan. Changes to NOT NULL are
+// considered destructive only if the column holds NULL values, and therefore, the database is queried.
+func (a *Atlas) destructiveChanges(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) ([]*DestructiveChange, error) {
+	var (
+		changes []*DestructiveChange
+		sources = make(map[schema.Change][]*DestructiveChange)
+	)
+	for _, c := range plan.Changes {
+		if c.Source == nil {
+			continue
+		}
+		// A change can be applied by multiple statements. For example,
+		// table modifications in SQLite are done by copying the table.
+		if cs, ok := sources[c.Source]; ok {
+			for _, dc := range cs {
+				dc.Stmts = append(dc.Stmts, c.Cmd)
+			}
+			continue
+		}
+		var reasons []string
+		switch s := c.Source.(type) {
+		case *schema.DropTable:
+			reasons = append(reasons, fmt.Sprintf("drop table %q", s.T.Name))
+		case *schema.ModifyTable:
+			for _, mc := range s.Changes {
+				switch mc := mc.(type) {
+				case *schema.DropColumn:
+					reasons = append(reasons, fmt.Sprintf("drop column %q of table %q", mc.C.Name, s.T.Name))
+				case *schema.ModifyColumn:
+					t1, ok1 := mc.From.Type.Type.(*schema.StringType)
+					t2, ok2 := mc.To.Type.Type.(*schema.StringType)
+					if mc.Change.Is(schema.ChangeType) && ok1 && ok2 && t2.Size > 0 && (t1.Size == 0 || t1.Size > t2.Size) {
+						reasons = append(reasons, fmt.Sprintf("shrink column %q of table %q from %s to %s", mc.To.Name, s.T.Name, typeName(t1), typeName(t2)))
+					}
+					if !mc.Change.Is(schema.ChangeNull) || !mc.From.Type.Null || mc.To.Type.Null {
+						continue
+					}
+					n, err := a.countNulls(ctx, conn, s.T, mc.From.Name)
+					if err != nil {
+						return nil, err
+					}
+					if n > 0 {
+						reasons = append(reasons, fmt.Sprintf("change column %q of table %q to NOT NULL (%d rows hold NULL values)", mc.To.Name, s.T.Name, n))
+					}
+				}
+			}
+		}
+		for _, r := range reasons {
+			dc := &DestructiveChange{Reason: r, Stmts: []string{c.Cmd}}
+			changes = append(changes, dc)
+			sources[c.Source] = append(sources[c.Source], dc)
+		}
+	}
+	return changes, nil
+}
+
+// typeName returns the name of the string type, including its size.
+func typeName(t *schema.StringType) string {
+	if t.Size == 0 {
+		return t.T
+	}
+	return fmt.Sprintf("%s(%d)", t.T, t.Size)
+}
+
+// checkPrimaryKeys returns a *PrimaryKeyError in case the given changes modify the primary key of an existing
+// table. Type changes within the same family, like widening an integer column, are not considered as such.
+func (a *Atlas) checkPrimaryKeys(changes []schema.Change) error {
+	for _, c := range changes {
+		m, ok := c.(*schema.ModifyTable)
+		if !ok {
+			continue
+		}
+		for _, mc := range m.Changes {
+			switch mc := mc.(type) {
+			case *schema.AddPrimaryKey:
+				return &PrimaryKeyError{Table: m.T.Name, Reason: fmt.Sprintf("add primary key (%s)", strings.Join(partNames(mc.P.Parts), ", "))}
+			case *schema.DropPrimaryKey:
+				return &PrimaryKeyError{Table: m.T.Name, Reason: fmt.Sprintf("drop primary key (%s)", strings.Join(partNames(mc.P.Parts), ", "))}
+			case *schema.ModifyPrimaryKey:
+				return &PrimaryKeyError{
+					Table:  m.T.Name,
+					Reason: fmt.Sprintf("change primary key from (%s) to (%s)", strings.Join(partNames(mc.From.Parts), ", "), strings.Join(partNames(mc.To.Parts), ", ")),
+				}
+			case *schema.ModifyColumn:
+				if !mc.Change.Is(schema.ChangeType) || m.T.PrimaryKey == nil || reflect.TypeOf(mc.From.Type.Type) == reflect.TypeOf(mc.To.Type.Type) {
+					continue
+				}
+				for _, p := range m.T.PrimaryKey.Parts {
+					if p.C != nil && p.C.Name == mc.To.Name {
+						return &PrimaryKeyError{
+							Table:  m.T.Name,
+							Column: mc.To.Name,
+							Reason: fmt.Sprintf("change type of column %q from %s to %s", mc.To.Name, a.formatType(mc.From.Type), a.formatType(mc.To.Type)),
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// partNames returns the column names of the given index parts.
+func partNames(parts []*schema.IndexPart) []string {
+	names := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p.C != nil {
+			names = append(names, p.C.Name)
+		}
+	}
+	return names
+}
+
+// formatType returns the database type of the given column type.
+func (a *Atlas) formatType(t *schema.ColumnType) string {
+	var (
+		s   string
+		err error
+	)
+	switch a.dialect {
+	case dialect.MySQL:
+		s, err = mysql.FormatType(t.Type)
+	case dialect.Postgres:
+		s, err = postgres.FormatType(t.Type)
+	case dialect.SQLite:
+		s, err = sqlite.FormatType(t.Type)
+	}
+	switch {
+	case err == nil && s != "":
+		return s
+	case t.Raw != "":
+		return t.Raw
+	default:
+		return fmt.Sprintf("%T", t.Type)
+	}
+}
+
+// countNulls returns the number of NULL values in the given column.
+func (a *Atlas) countNulls(ctx context.Context, conn dialect.ExecQuerier, t *schema.Table, column string) (int, error) {
+	table := entsql.Table(t.Name)
+	if t.Schema != nil && t.Schema.Name != "" {
+		table.Schema(t.Schema.Name)
+	}
+	query, args := entsql.Dialect(a.dialect).
+		Select(entsql.Count("*")).
+		From(table).
+		Where(entsql.IsNull(column)).
+		Query()
+	rows := &entsql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return 0, fmt.Errorf("count NULL values of column %q: %w", column, err)
+	}
+	defer rows.Close()
+	return entsql.ScanInt(rows)
+}
+
+// planInspect creates the current state by inspecting the connected database, computing the current state of the Ent schema
+// and proceeds to diff the changes to create a migration plan.
+func (a *Atlas) planInspect(ctx context.Context, conn dialect.ExecQuerier, name string, tables []*Table) (*migrate.Plan, error) {
+	st, err := a.inspectState(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := a.changes(st.current, st.desired)
+	if err != nil {
+		return nil, err
+	}
+	return a.planState(ctx, name, st, changes, tables)
+}
+
+// inspectState holds the current state of the database and the desired state of the Ent schema,
+// including the parts of the state that are managed by Ent, and not by Atlas.
+type inspectState struct {
+	current, desired []*schema.Schema
+	newTypes         []string                   // types that their pk ranges were allocated by this migration
+	security         map[string]*rowSecurity    // row-level security state of the tables
+	deferred         map[string]map[string]bool // deferrable foreign keys of the tables
+	extensions       map[string]bool            // installed extensions of the database
+	views            map[string]*viewState      // views that were created by previous migrations
+
+	// checksums of the triggers that were created by previous migrations, keyed by their tables.
+	triggers map[string]map[string]string
+	// options of the existing tables that are configured with table options.
+	options map[string]*tableOptions
+	// identity sequences of the existing tables that are configured with sequence options.
+	sequences map[string]*sequence
+	// invalid indexes of the existing tables, left behind by failed concurrent builds.
+	invalid map[string][]string
+	// auxiliary tables (and their triggers) of the full-text indexes that exist in the database.
+	fullText map[string]bool
+}
+
+// inspectState inspects the connected database and computes the desired state of the given tables.
+func (a *Atlas) inspectState(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (*inspectState, error) {
+	var (
+		schemas []string
+		names   = make(map[string][]string)
+	)
+	for _, t := range tables {
+		s := a.tableSchema(t)
+		if _, ok := names[s]; !ok {
+			schemas = append(schemas, s)
+		}
+		names[s] = append(names[s], t.Name)
+	}
+	if a.dropTables {
+		drop, err := a.dropTableNames(ctx, conn, tables)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := names[a.schema]; !ok && len(drop) > 0 {
+			schemas = append(schemas, a.schema)
+		}
+		names[a.schema] = append(names[a.schema], drop...)
+	}
+	if len(schemas) == 0 {
+		schemas = append(schemas, a.schema)
+	}
+	current := make([]*schema.Schema, len(schemas))
+	for i, s := range schemas {
+		c, err := a.atDriver.InspectSchema(ctx, s, &schema.InspectOptions{
+			Tables: names[s],
+		})
+		if err != nil {
+			return nil, err
+		}
+		current[i] = c
+	}
+	var (
+		err   error
+		types []string
+	)
+	if a.universalID {
+		types, err = a.loadTypes(ctx, conn)
+		if err != nil && !errors.Is(err, errTypeTableNotFound) {
+			return nil, err
+		}
+		a.types = types
+		var exist []*Table
+		for i, s := range schemas {
+			for _, t := range tables {
+				if _, ok := current[i].Table(t.Name); ok && a.tableSchema(t) == s {
+					exist = append(exist, t)
+				}
+			}
+		}
+		if err := a.checkIDs(ctx, conn, types, exist); err != nil {
+			return nil, err
+		}
+	}
+	security, err := a.rowSecurity(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	deferred, err := a.deferrable(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	extensions, err := a.extensions(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	views, err := a.viewState(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	triggers, err := a.triggerState(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	options, err := a.tableOptions(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	sequences, err := a.sequences(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	invalid, err := a.invalidIndexes(ctx, conn, tables, current)
+	if err != nil {
+		return nil, err
+	}
+	fullText, err := a.fullTextTables(ctx, conn, tables)
+	if err != nil {
+		return nil, err
+	}
+	realm, err := a.StateReader(tables...).ReadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	desired := make([]*schema.Schema, len(schemas))
+	for i, s := range schemas {
+		desired[i] = &schema.Schema{}
+		for _, ds := range realm.Schemas {
+			if ds.Name == s {
+				desired[i] = ds
+			}
 		}
-		return err
-	}
-	return tx.Commit()
+		desired[i].Name, desired[i].Attrs = current[i].Name, current[i].Attrs
+	}
+	return &inspectState{
+		current:    current,
+		desired:    desired,
+		newTypes:   a.types[len(types):],
+		security:   security,
+		deferred:   deferred,
+		extensions: extensions,
+		views:      views,
+		triggers:   triggers,
+		options:    options,
+		sequences:  sequences,
+		invalid:    invalid,
+		fullText:   fullText,
+	}, nil
 }
 
-// planInspect creates the current state by inspecting the connected database, computing the current state of the Ent schema
-// and proceeds to diff the changes to create a migration plan.
-func (a *Atlas) planInspect(ctx context.Context, conn dialect.ExecQuerier, name string, tables []*Table) (*migrate.Plan, error) {
-	current, err := a.atDriver.InspectSchema(ctx, "", &schema.InspectOptions{
-		Tables: func() (t []string) {
-			for i := range tables {
-				t = append(t, tables[i].Name)
-			}
-			return t
-		}(),
-	})
+// planState plans the given changes, and adds to the plan the changes that are managed by Ent.
+func (a *Atlas) planState(ctx context.Context, name string, st *inspectState, changes []schema.Change, tables []*Table) (*migrate.Plan, error) {
+	plan, err := a.plan(ctx, name, changes, st.newTypes)
 	if err != nil {
 		return nil, err
 	}
-	var types []string
-	if a.universalID {
-		types, err = a.loadTypes(ctx, conn)
-		if err != nil && !errors.Is(err, errTypeTableNotFound) {
-			return nil, err
-		}
-		a.types = types
+	if err := a.planConvertUsing(plan, tables); err != nil {
+		return nil, err
 	}
-	realm, err := a.StateReader(tables...).ReadState(ctx)
-	if err != nil {
+	planIndexRenames(plan)
+	if err := a.planRowSecurity(plan, tables, st.security); err != nil {
+		return nil, err
+	}
+	a.planDeferrable(plan, tables, st.deferred)
+	a.planTableOptions(plan, tables, st.options)
+	a.planSequences(plan, tables, st.sequences)
+	if err := a.planViews(plan, st.views); err != nil {
 		return nil, err
 	}
-	desired := realm.Schemas[0]
-	desired.Name, desired.Attrs = current.Name, current.Attrs
-	return a.diff(ctx, name, current, desired, a.types[len(types):])
+	a.planTriggers(plan, tables, st.triggers)
+	a.planExtensions(plan, tables, st.extensions)
+	a.planInvalidIndexes(plan, st.invalid)
+	a.planFullText(plan, tables, st.fullText)
+	return plan, nil
 }
 
 func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*migrate.Plan, error) {
+	for _, t := range tables {
+		if t.Schema != "" {
+			return nil, fmt.Errorf("sql/schema: table %q: schemas are not supported in replay mode", t.Name)
+		}
+	}
 	// We consider a database clean if there are no tables in the connected schema.
 	s, err := a.atDriver.InspectSchema(ctx, "", nil)
 	if err != nil {
@@ -762,6 +1985,42 @@ func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*
 		}
 		a.types = types
 	}
+	// The row-level security, deferrable foreign-keys, extensions, views, triggers, table options and sequences states are read before the schema is cleaned.
+	security, err := a.rowSecurity(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	deferred, err := a.deferrable(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	extensions, err := a.extensions(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	views, err := a.viewState(ctx, a.sqlDialect)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	if err := a.dropViews(ctx, views); err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	triggers, err := a.triggerState(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	options, err := a.tableOptions(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	sequences, err := a.sequences(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
+	fullText, err := a.fullTextTables(ctx, a.sqlDialect, tables)
+	if err != nil {
+		return nil, a.cleanSchema(ctx, "", err)
+	}
 	if err := a.cleanSchema(ctx, "", nil); err != nil {
 		return nil, fmt.Errorf("clean schemas after migration replaying: %w", err)
 	}
@@ -787,8 +2046,8 @@ func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*
 			desired[i] = d
 		}
 	}
-	return a.diff(ctx, name, current,
-		&schema.Schema{Name: current.Name, Attrs: current.Attrs, Tables: desired}, a.types[len(types):],
+	plan, err := a.diff(ctx, name, []*schema.Schema{current},
+		[]*schema.Schema{{Name: current.Name, Attrs: current.Attrs, Tables: desired}}, a.types[len(types):],
 		// For BC reason, we omit the schema qualifier from the migration scripts,
 		// but that is currently limiting versioned migration to a single schema.
 		func(opts *migrate.PlanOptions) {
@@ -796,29 +2055,251 @@ func (a *Atlas) planReplay(ctx context.Context, name string, tables []*Table) (*
 			opts.SchemaQualifier = &noQualifier
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.planConvertUsing(plan, tables); err != nil {
+		return nil, err
+	}
+	if err := a.planRowSecurity(plan, tables, security); err != nil {
+		return nil, err
+	}
+	a.planDeferrable(plan, tables, deferred)
+	a.planTableOptions(plan, tables, options)
+	a.planSequences(plan, tables, sequences)
+	if err := a.planViews(plan, views); err != nil {
+		return nil, err
+	}
+	a.planTriggers(plan, tables, triggers)
+	a.planExtensions(plan, tables, extensions)
+	a.planFullText(plan, tables, fullText)
+	return plan, nil
+}
+
+// rowSecurity returns the row-level security state of the given
+// tables, in case the row-level security is supported by the dialect.
+func (a *Atlas) rowSecurity(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*rowSecurity, error) {
+	rs, ok := a.sqlDialect.(rowSecurer)
+	if !ok {
+		return nil, nil
+	}
+	return rs.rowSecurity(ctx, conn, tables)
+}
+
+// planRowSecurity appends the changes that are required for
+// bringing the row-level security of the tables to the plan.
+func (a *Atlas) planRowSecurity(plan *migrate.Plan, tables []*Table, state map[string]*rowSecurity) error {
+	rs, ok := a.sqlDialect.(rowSecurer)
+	if !ok {
+		return nil
+	}
+	changes, err := rs.rowSecurityChanges(tables, state)
+	if err != nil {
+		return err
+	}
+	plan.Changes = append(plan.Changes, changes...)
+	return nil
+}
+
+// deferrable returns the deferrable foreign keys of the given tables,
+// in case deferrable foreign keys are supported by the dialect.
+func (a *Atlas) deferrable(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]map[string]bool, error) {
+	d, ok := a.sqlDialect.(deferrer)
+	if !ok {
+		return nil, nil
+	}
+	return d.deferrable(ctx, conn, tables)
+}
+
+// planConvertUsing adds the conversion expressions of the
+// columns whose types are changed by the plan, if supported.
+func (a *Atlas) planConvertUsing(plan *migrate.Plan, tables []*Table) error {
+	if c, ok := a.sqlDialect.(typeConverter); ok {
+		return c.convertUsing(tables, plan.Changes)
+	}
+	return nil
+}
+
+// planIndexRenames qualifies the index renames of the plan with the schema of their tables, as Atlas
+// plans them without a qualifier, and the schema of the table might not be in the search_path.
+func planIndexRenames(plan *migrate.Plan) {
+	for _, c := range plan.Changes {
+		r, ok := c.Source.(*schema.RenameIndex)
+		if !ok || r.From.Table == nil || r.From.Table.Schema == nil || r.From.Table.Schema.Name == "" {
+			continue
+		}
+		s := r.From.Table.Schema.Name
+		c.Cmd = fmt.Sprintf("ALTER INDEX %q.%q RENAME TO %q", s, r.From.Name, r.To.Name)
+		c.Reverse = fmt.Sprintf("ALTER INDEX %q.%q RENAME TO %q", s, r.To.Name, r.From.Name)
+	}
+}
+
+// planDeferrable appends the changes that are required for
+// making the deferrable foreign keys deferrable to the plan.
+func (a *Atlas) planDeferrable(plan *migrate.Plan, tables []*Table, state map[string]map[string]bool) {
+	if d, ok := a.sqlDialect.(deferrer); ok {
+		plan.Changes = append(plan.Changes, d.deferrableChanges(tables, state, plan.Changes)...)
+	}
+}
+
+// tableOptions returns the options of the existing tables, in case
+// altering the options of tables is supported by the dialect.
+func (a *Atlas) tableOptions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*tableOptions, error) {
+	o, ok := a.sqlDialect.(tableOptioner)
+	if !ok {
+		return nil, nil
+	}
+	return o.tableOptions(ctx, conn, tables)
+}
+
+// planTableOptions appends the changes that are required for
+// altering the options of the existing tables to the plan.
+func (a *Atlas) planTableOptions(plan *migrate.Plan, tables []*Table, state map[string]*tableOptions) {
+	if o, ok := a.sqlDialect.(tableOptioner); ok {
+		plan.Changes = append(plan.Changes, o.tableOptionsChanges(tables, state, plan.Changes)...)
+	}
+}
+
+// sequences returns the identity sequences of the existing tables, in
+// case configuring the sequences of tables is supported by the dialect.
+func (a *Atlas) sequences(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*sequence, error) {
+	s, ok := a.sqlDialect.(sequencer)
+	if !ok {
+		return nil, nil
+	}
+	return s.sequences(ctx, conn, tables)
+}
+
+// planSequences appends the changes that are required for
+// configuring the identity sequences of the tables to the plan.
+func (a *Atlas) planSequences(plan *migrate.Plan, tables []*Table, state map[string]*sequence) {
+	if s, ok := a.sqlDialect.(sequencer); ok {
+		plan.Changes = append(plan.Changes, s.sequenceChanges(tables, state)...)
+	}
+}
+
+// extensions returns the installed extensions of the database, in
+// case extensions that are required by the tables are supported by the dialect.
+func (a *Atlas) extensions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]bool, error) {
+	e, ok := a.sqlDialect.(extensionCreator)
+	if !ok {
+		return nil, nil
+	}
+	return e.extensions(ctx, conn, tables)
+}
+
+// planExtensions prepends to the plan the creation of the missing extensions,
+// as the tables (e.g. their column types or indexes) may depend on them.
+func (a *Atlas) planExtensions(plan *migrate.Plan, tables []*Table, installed map[string]bool) {
+	if e, ok := a.sqlDialect.(extensionCreator); ok {
+		plan.Changes = append(e.extensionChanges(tables, installed), plan.Changes...)
+	}
+}
+
+// fullTextTables returns the auxiliary tables (and their triggers) of the full-text indexes that
+// exist in the database, in case the full-text indexes are maintained in such tables by the dialect.
+func (a *Atlas) fullTextTables(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]bool, error) {
+	f, ok := a.sqlDialect.(fullTextIndexer)
+	if !ok {
+		return nil, nil
+	}
+	return f.fullTextTables(ctx, conn, tables)
+}
+
+// planFullText appends to the plan the creation of the missing auxiliary tables
+// of the full-text indexes, after the tables they are synced with were migrated.
+func (a *Atlas) planFullText(plan *migrate.Plan, tables []*Table, exists map[string]bool) {
+	if f, ok := a.sqlDialect.(fullTextIndexer); ok {
+		plan.Changes = append(plan.Changes, f.fullTextChanges(tables, exists, plan.Changes)...)
+	}
 }
 
-func (a *Atlas) diff(ctx context.Context, name string, current, desired *schema.Schema, newTypes []string, opts ...migrate.PlanOption) (*migrate.Plan, error) {
-	changes, err := (&diffDriver{a.atDriver, a.diffHooks}).SchemaDiff(current, desired, a.diffOptions...)
+// invalidIndexes returns the invalid indexes of the existing tables, in case creating indexes concurrently is
+// enabled and supported by the dialect. Invalid indexes are removed from the current state of their tables,
+// in order to recreate them.
+func (a *Atlas) invalidIndexes(ctx context.Context, conn dialect.ExecQuerier, tables []*Table, current []*schema.Schema) (map[string][]string, error) {
+	c, ok := a.sqlDialect.(concurrentIndexer)
+	if !ok || !a.concurrentIndexes {
+		return nil, nil
+	}
+	invalid, err := c.invalidIndexes(ctx, conn, tables)
 	if err != nil {
 		return nil, err
 	}
-	filtered := make([]schema.Change, 0, len(changes))
-	for _, c := range changes {
-		// Skip any table drops explicitly. The reason we may encounter this, even though specific tables are passed
-		// to Inspect, is if the MySQL system variable 'lower_case_table_names' is set to 1. In such a case, the given
-		// tables will be returned from inspection because MySQL compares case-insensitive, but they won't match when
-		// compare them in code.
-		if _, ok := c.(*schema.DropTable); !ok {
-			filtered = append(filtered, c)
+	for _, s := range current {
+		for _, t := range s.Tables {
+			names := invalid[t.Name]
+			if len(names) == 0 {
+				continue
+			}
+			idx := t.Indexes[:0]
+			for _, i := range t.Indexes {
+				if indexOf(names, i.Name) == -1 {
+					idx = append(idx, i)
+				}
+			}
+			t.Indexes = idx
+		}
+	}
+	return invalid, nil
+}
+
+// planInvalidIndexes adds to the plan the drop of the invalid indexes before they are recreated.
+func (a *Atlas) planInvalidIndexes(plan *migrate.Plan, invalid map[string][]string) {
+	if c, ok := a.sqlDialect.(concurrentIndexer); ok && len(invalid) > 0 {
+		plan.Changes = c.invalidIndexChanges(plan.Changes, invalid)
+	}
+}
+
+// diff computes the changes between the current and desired state of each schema, and plans them.
+func (a *Atlas) diff(ctx context.Context, name string, current, desired []*schema.Schema, newTypes []string, opts ...migrate.PlanOption) (*migrate.Plan, error) {
+	changes, err := a.changes(current, desired)
+	if err != nil {
+		return nil, err
+	}
+	return a.plan(ctx, name, changes, newTypes, opts...)
+}
+
+// changes returns the changes between the current and the desired schemas, after running the diff hooks.
+func (a *Atlas) changes(current, desired []*schema.Schema) ([]schema.Change, error) {
+	var filtered []schema.Change
+	for i := range desired {
+		changes, err := (&diffDriver{a.atDriver, a.diffHooks}).SchemaDiff(current[i], desired[i], a.diffOptions...)
+		if err != nil {
+			return nil, err
+		}
+		if cd, ok := a.sqlDialect.(collationDiffer); ok {
+			changes = cd.collationChanges(current[i], desired[i], changes)
+		}
+		if ci, ok := a.sqlDialect.(concurrentIndexer); ok && a.concurrentIndexes {
+			ci.concurrentIndexes(changes)
+		}
+		for _, c := range changes {
+			// Skip any table drops explicitly, unless the table dropping option is enabled. The reason we may encounter
+			// this, even though specific tables are passed to Inspect, is if the MySQL system variable 'lower_case_table_names'
+			// is set to 1. In such a case, the given tables will be returned from inspection because MySQL compares
+			// case-insensitive, but they won't match when compare them in code.
+			if d, ok := c.(*schema.DropTable); !ok || a.dropTables && !hasTableFold(desired[i], d.T.Name) {
+				filtered = append(filtered, c)
+			}
 		}
 	}
+	return filtered, nil
+}
+
+// plan plans the given changes, and appends the pk ranges allocation of the new types.
+func (a *Atlas) plan(ctx context.Context, name string, changes []schema.Change, newTypes []string, opts ...migrate.PlanOption) (*migrate.Plan, error) {
 	if a.indent != "" {
 		opts = append(opts, func(opts *migrate.PlanOptions) {
 			opts.Indent = a.indent
 		})
 	}
-	plan, err := a.atDriver.PlanChanges(ctx, name, filtered, opts...)
+	if !a.pkChanges {
+		if err := a.checkPrimaryKeys(changes); err != nil {
+			return nil, err
+		}
+	}
+	plan, err := a.atDriver.PlanChanges(ctx, name, changes, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -831,6 +2312,56 @@ func (a *Atlas) diff(ctx context.Context, name string, current, desired *schema.
 	return plan, nil
 }
 
+// dropTableNames returns the names of the tables that exist in the database, but not in the given tables,
+// and are either tracked by the types table (universal ids), or start with one of the configured prefixes.
+func (a *Atlas) dropTableNames(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) ([]string, error) {
+	s, err := a.atDriver.InspectSchema(ctx, a.schema, &schema.InspectOptions{Mode: schema.InspectTables})
+	if err != nil {
+		return nil, err
+	}
+	types, err := a.loadTypes(ctx, conn)
+	if err != nil && !errors.Is(err, errTypeTableNotFound) {
+		return nil, err
+	}
+	tracked := make(map[string]bool, len(types))
+	for _, t := range types {
+		tracked[t] = true
+	}
+	var names []string
+Tables:
+	for _, t := range s.Tables {
+		if t.Name == TypeTable {
+			continue
+		}
+		for _, et := range tables {
+			if strings.EqualFold(et.Name, t.Name) {
+				continue Tables
+			}
+		}
+		if tracked[t.Name] {
+			names = append(names, t.Name)
+			continue
+		}
+		for _, p := range a.dropPrefixes {
+			if strings.HasPrefix(t.Name, p) {
+				names = append(names, t.Name)
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+// hasTableFold reports if the schema has a table with the given name, compared case-insensitive.
+func hasTableFold(s *schema.Schema, name string) bool {
+	for _, t := range s.Tables {
+		if strings.EqualFold(t.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
 var errTypeTableNotFound = errors.New("ent_type table not found")
 
 // loadTypes loads the currently saved range allocations from the TypeTable.
@@ -897,8 +2428,17 @@ func (a *Atlas) tables(tables []*Table) ([]*schema.Table, error) {
 		if err := a.aIndexes(et, at); err != nil {
 			return nil, err
 		}
+		if err := a.sqlDialect.atPartition(et, at); err != nil {
+			return nil, err
+		}
 		ts[i] = at
 	}
+	if a.universalID {
+		// Ensure the ranges of the new and pinned types do not overlap.
+		if _, err := a.idRanges(a.types); err != nil {
+			return nil, err
+		}
+	}
 	for i, t1 := range tables {
 		t2 := ts[i]
 		for _, fk1 := range t1.ForeignKeys {
@@ -947,9 +2487,16 @@ func (a *Atlas) aColumns(et *Table, at *schema.Table) error {
 		if c1.Comment != "" {
 			c2.SetComment(c1.Comment)
 		}
+		if len(c1.OldNames) > 0 {
+			c2.AddAttrs(&oldNames{names: c1.OldNames})
+		}
 		if err := a.sqlDialect.atTypeC(c1, c2); err != nil {
 			return err
 		}
+		// Native enum types are named after their table and column.
+		if e, ok := c2.Type.Type.(*schema.EnumType); ok && e.T == "" {
+			e.T = fmt.Sprintf("%s_%s", et.Name, c1.Name)
+		}
 		if err := a.atDefault(c1, c2); err != nil {
 			return err
 		}
@@ -990,6 +2537,19 @@ func (a *Atlas) atDefault(c1 *Column, c2 *schema.Column) error {
 			if !ok {
 				return fmt.Errorf("invalid default value for JSON column %q: %v", c1.Name, c1.Default)
 			}
+			// JSON arrays that are stored in native array columns (PostgreSQL)
+			// are converted to their array literal. e.g. '["a"]' to '{"a"}'.
+			if _, ok := c2.Type.Type.(*postgres.ArrayType); ok {
+				var v any
+				if err := json.Unmarshal([]byte(s), &v); err != nil {
+					return fmt.Errorf("invalid default value for array column %q: %w", c1.Name, err)
+				}
+				lit, err := entsql.ArrayValue{V: v}.Literal()
+				if err != nil {
+					return err
+				}
+				s = lit
+			}
 			c2.SetDefault(&schema.Literal{V: strings.ReplaceAll(s, "'", "''")})
 		default:
 			// Keep backwards compatibility with the old default value format.
@@ -1025,9 +2585,16 @@ func (a *Atlas) aIndexes(et *Table, at *schema.Table) error {
 		if err := a.sqlDialect.atIndex(idx1, at, idx2); err != nil {
 			return err
 		}
-		desc := descIndexes(idx1)
+		desc, exprs := descIndexes(idx1), exprIndexes(idx1)
 		for _, p := range idx2.Parts {
+			// Parts that were already set as expressions by the dialect.
+			if p.C == nil {
+				continue
+			}
 			p.Desc = desc[p.C.Name]
+			if x, ok := exprs[p.C.Name]; ok {
+				p.C, p.X = nil, &schema.RawExpr{X: x}
+			}
 		}
 		at.AddIndexes(idx2)
 	}
@@ -1085,7 +2652,7 @@ func (a *Atlas) entDialect(ctx context.Context, drv dialect.Driver) (sqlDialect,
 	case dialect.SQLite:
 		d = &SQLite{Driver: drv, WithForeignKeys: a.withForeignKeys}
 	case dialect.Postgres:
-		d = &Postgres{Driver: drv}
+		d = &Postgres{Driver: drv, schema: a.schema, nativeEnums: a.nativeEnums}
 	default:
 		return nil, fmt.Errorf("sql/schema: unsupported dialect %q", a.dialect)
 	}
@@ -1106,7 +2673,81 @@ func (a *Atlas) pkRange(et *Table) (int64, error) {
 		idx = len(a.types)
 		a.types = append(a.types, et.Name)
 	}
-	return int64(idx << 32), nil
+	r, err := a.idRange(et.Name, idx)
+	if err != nil {
+		return 0, err
+	}
+	return r.Start, nil
+}
+
+// idRange returns the id range of the given type by its position in the types table.
+func (a *Atlas) idRange(typ string, idx int) (IDRange, error) {
+	r, ok := a.pinnedRanges[typ]
+	if !ok {
+		alloc := a.allocator
+		if alloc == nil {
+			alloc = DefaultRangeAllocator
+		}
+		var err error
+		if r, err = alloc.Allocate(typ, idx); err != nil {
+			return IDRange{}, fmt.Errorf("allocate id range for type %q: %w", typ, err)
+		}
+	}
+	if r.Start < 0 || r.Start >= r.End {
+		return IDRange{}, fmt.Errorf("invalid id range [%d, %d) for type %q", r.Start, r.End, typ)
+	}
+	return r, nil
+}
+
+// idRanges returns the id ranges of the given types,
+// and fails if the ranges of two types overlap.
+func (a *Atlas) idRanges(types []string) ([]IDRange, error) {
+	ranges := make([]IDRange, len(types))
+	for i, typ := range types {
+		r, err := a.idRange(typ, i)
+		if err != nil {
+			return nil, err
+		}
+		for j, o := range ranges[:i] {
+			if r.Start < o.End && o.Start < r.End {
+				return nil, fmt.Errorf("id range [%d, %d) of type %q overlaps the range [%d, %d) of type %q", r.Start, r.End, typ, o.Start, o.End, types[j])
+			}
+		}
+		ranges[i] = r
+	}
+	return ranges, nil
+}
+
+// checkIDs ensures the ids that exist in the given tables are not in the id ranges of other
+// types. The check is executed only if the id ranges were customized, as otherwise, the range
+// of a type does not change between migrations.
+func (a *Atlas) checkIDs(ctx context.Context, conn dialect.ExecQuerier, types []string, tables []*Table) error {
+	if len(a.pinnedRanges) == 0 && a.allocator == nil {
+		return nil
+	}
+	ranges, err := a.idRanges(types)
+	if err != nil {
+		return err
+	}
+	for _, t := range tables {
+		idx := indexOf(types, t.Name)
+		if idx == -1 || len(t.PrimaryKey) != 1 {
+			continue
+		}
+		minID, maxID, err := a.idBounds(ctx, conn, t)
+		if err != nil {
+			return err
+		}
+		if !minID.Valid {
+			continue
+		}
+		for i, r := range ranges {
+			if i != idx && minID.Int64 < r.End && r.Start <= maxID.Int64 {
+				return fmt.Errorf("ids [%d, %d] of table %q overlap the id range [%d, %d) of type %q", minID.Int64, maxID.Int64, t.Name, r.Start, r.End, types[i])
+			}
+		}
+	}
+	return nil
 }
 
 func setAtChecks(et *Table, at *schema.Table) {
@@ -1147,6 +2788,20 @@ func descIndexes(idx *Index) map[string]bool {
 	return descs
 }
 
+// exprIndexes returns a map holding the expressions that replace the index columns.
+func exprIndexes(idx *Index) map[string]string {
+	if idx.Annotation == nil {
+		return nil
+	}
+	return idx.Annotation.ExprColumns
+}
+
+// fullTextIndex reports if the given index is a full-text index, i.e. a
+// single-column index that is annotated with entsql.FullText.
+func fullTextIndex(idx *Index) bool {
+	return idx.Annotation != nil && idx.Annotation.FullText && len(idx.Columns) == 1
+}
+
 // driver decorates the atlas migrate.Driver and adds "diff hooking" and functionality.
 type diffDriver struct {
 	migrate.Driver
@@ -1170,14 +2825,48 @@ func (r *diffDriver) SchemaDiff(from, to *schema.Schema, opts ...schema.DiffOpti
 	return d.Diff(from, to)
 }
 
+// writeDriver wraps the given driver with a WriteDriver in dry-run mode,
+// and reports its statements to the logger of the migration, if configured.
+func (a *Atlas) writeDriver(drv dialect.Driver) dialect.Driver {
+	if a.logger != nil {
+		drv = &logDriver{Driver: drv, log: a.logger}
+	}
+	if a.dryRun == nil {
+		return drv
+	}
+	return &WriteDriver{Driver: drv, Writer: a.dryRun}
+}
+
 // legacyMigrate returns a configured legacy migration engine (before Atlas) to keep backwards compatibility.
 //
 // Deprecated: Will be removed alongside legacy migration support.
 func (a *Atlas) legacyMigrate() (*Migrate, error) {
+	if a.schema != "" {
+		return nil, errors.New("sql/schema: WithSchemaName is not supported by the legacy migration engine")
+	}
+	if a.online != nil {
+		return nil, errors.New("sql/schema: WithOnlineMigration is not supported by the legacy migration engine")
+	}
+	if len(a.views) > 0 {
+		return nil, errors.New("sql/schema: WithViews is not supported by the legacy migration engine")
+	}
+	if a.stmtTimeout > 0 || a.lockTimeout > 0 {
+		return nil, errors.New("sql/schema: WithStatementTimeout and WithLockTimeout are not supported by the legacy migration engine")
+	}
+	if a.concurrentIndexes {
+		return nil, errors.New("sql/schema: WithConcurrentIndexes is not supported by the legacy migration engine")
+	}
+	if len(a.seeds) > 0 {
+		return nil, errors.New("sql/schema: WithSeed is not supported by the legacy migration engine")
+	}
+	if a.pkChanges {
+		return nil, errors.New("sql/schema: WithPrimaryKeyChanges is not supported by the legacy migration engine")
+	}
 	m := &Migrate{
 		universalID:     a.universalID,
 		dropColumns:     a.dropColumns,
 		dropIndexes:     a.dropIndexes,
+		dropForeignKeys: a.dropForeignKeys,
 		withFixture:     a.withFixture,
 		withForeignKeys: a.withForeignKeys,
 		hooks:           a.hooks,
@@ -1185,11 +2874,14 @@ func (a *Atlas) legacyMigrate() (*Migrate, error) {
 	}
 	switch a.dialect {
 	case dialect.MySQL:
-		m.sqlDialect = &MySQL{Driver: a.driver}
+		m.sqlDialect = &MySQL{Driver: a.writeDriver(a.driver)}
 	case dialect.SQLite:
-		m.sqlDialect = &SQLite{Driver: a.driver, WithForeignKeys: a.withForeignKeys}
+		m.sqlDialect = &SQLite{Driver: a.writeDriver(a.driver), WithForeignKeys: a.withForeignKeys, WithTableRecreate: a.sqliteRecreate}
 	case dialect.Postgres:
-		m.sqlDialect = &Postgres{Driver: a.driver}
+		if a.nativeEnums {
+			return nil, errors.New("sql/schema: WithNativeEnums is not supported by the legacy migration engine")
+		}
+		m.sqlDialect = &Postgres{Driver: a.writeDriver(a.driver)}
 	default:
 		return nil, fmt.Errorf("sql/schema: unsupported dialect %q", a.dialect)
 	}
@@ -0,0 +1,214 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+// ClickHouse is a ClickHouse migration driver. ClickHouse tables are used as append-only
+// read-models, and therefore, the migration only creates missing tables and adds missing
+// columns to existing tables. Foreign keys and indexes are not created, as ClickHouse does
+// not enforce them, and columns are never modified or dropped.
+type ClickHouse struct {
+	dialect.Driver
+}
+
+// create creates the missing tables and columns in the database.
+func (d *ClickHouse) create(ctx context.Context, tables ...*Table) error {
+	for _, t := range tables {
+		exists, err := d.tableExist(ctx, t.Name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			query, args := d.tBuilder(t).Query()
+			if err := d.Exec(ctx, query, args, nil); err != nil {
+				return fmt.Errorf("create table %q: %w", t.Name, err)
+			}
+			continue
+		}
+		columns, err := d.columns(ctx, t.Name)
+		if err != nil {
+			return err
+		}
+		b := sql.Dialect(dialect.ClickHouse).AlterTable(t.Name)
+		for _, c := range t.Columns {
+			if _, ok := columns[c.Name]; !ok {
+				b.AddColumn(d.addColumn(c))
+			}
+		}
+		if len(b.Queries) == 0 {
+			continue
+		}
+		query, args := b.Query()
+		if err := d.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("alter table %q: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+func (d *ClickHouse) tableExist(ctx context.Context, name string) (bool, error) {
+	query, args := sql.Dialect(dialect.ClickHouse).
+		Select(sql.Count("*")).From(sql.Table("tables").Schema("system")).
+		Where(sql.And(
+			sql.ExprP("`database` = currentDatabase()"),
+			sql.EQ("name", name),
+		)).Query()
+	return exist(ctx, d, query, args...)
+}
+
+// columns returns the names of the existing columns of the given table.
+func (d *ClickHouse) columns(ctx context.Context, table string) (map[string]struct{}, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(dialect.ClickHouse).
+		Select("name").From(sql.Table("columns").Schema("system")).
+		Where(sql.And(
+			sql.ExprP("`database` = currentDatabase()"),
+			sql.EQ("table", table),
+		)).Query()
+	if err := d.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("clickhouse: reading table %q columns: %w", table, err)
+	}
+	defer rows.Close()
+	var names []string
+	if err := sql.ScanSlice(rows, &names); err != nil {
+		return nil, fmt.Errorf("clickhouse: scanning table %q columns: %w", table, err)
+	}
+	columns := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		columns[name] = struct{}{}
+	}
+	return columns, nil
+}
+
+// tBuilder returns the ClickHouse DSL query for table creation.
+func (d *ClickHouse) tBuilder(t *Table) *sql.TableBuilder {
+	b := sql.Dialect(dialect.ClickHouse).CreateTable(t.Name).IfNotExists()
+	for _, c := range t.Columns {
+		b.Column(d.addColumn(c))
+	}
+	return b.Options(d.engine(t))
+}
+
+// engine returns the ENGINE clause of the table. Tables are created using the
+// MergeTree engine and sorted by their primary key, unless configured otherwise.
+func (d *ClickHouse) engine(t *Table) string {
+	var (
+		e = "MergeTree"
+		b = sql.Dialect(dialect.ClickHouse).Table(t.Name)
+		o []string
+		p string
+		s map[string]string
+	)
+	if t.Annotation != nil && t.Annotation.Engine != nil {
+		if n := t.Annotation.Engine.Name; n != "" {
+			e = n
+		}
+		o, p, s = t.Annotation.Engine.OrderBy, t.Annotation.Engine.PartitionBy, t.Annotation.Engine.Settings
+	}
+	if len(o) == 0 {
+		for _, c := range t.PrimaryKey {
+			o = append(o, c.Name)
+		}
+	}
+	clause := "ENGINE = " + e
+	if p != "" {
+		clause += " PARTITION BY " + p
+	}
+	sorting := make([]string, len(o))
+	for i, x := range o {
+		sorting[i] = x
+		// Columns are quoted, and expressions are used as-is.
+		if _, ok := t.column(x); ok {
+			sorting[i] = b.Quote(x)
+		}
+	}
+	clause += " ORDER BY (" + strings.Join(sorting, ", ") + ")"
+	if len(s) > 0 {
+		keys := make([]string, 0, len(s))
+		for k := range s {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for i, k := range keys {
+			keys[i] = k + " = " + s[k]
+		}
+		clause += " SETTINGS " + strings.Join(keys, ", ")
+	}
+	return clause
+}
+
+// addColumn returns the DSL query for adding the given column to a table.
+func (d *ClickHouse) addColumn(c *Column) *sql.ColumnBuilder {
+	b := sql.Dialect(dialect.ClickHouse).Column(c.Name).Type(d.cType(c))
+	// Only literal defaults are supported, as database
+	// expressions are different between the dialects.
+	switch v := c.Default.(type) {
+	case bool:
+		b.Attr("DEFAULT " + strconv.FormatBool(v))
+	case string:
+		if c.Type == field.TypeString || c.Type == field.TypeEnum {
+			b.Attr("DEFAULT '" + strings.ReplaceAll(v, "'", "''") + "'")
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		b.Attr(fmt.Sprint("DEFAULT ", v))
+	}
+	return b
+}
+
+// cType returns the ClickHouse string type for the given column.
+func (d *ClickHouse) cType(c *Column) (t string) {
+	// Custom types are used as-is, and they
+	// should include the Nullable modifier.
+	if c.SchemaType != nil && c.SchemaType[dialect.ClickHouse] != "" {
+		return c.SchemaType[dialect.ClickHouse]
+	}
+	switch c.Type {
+	case field.TypeBool:
+		t = "Bool"
+	case field.TypeInt8:
+		t = "Int8"
+	case field.TypeInt16:
+		t = "Int16"
+	case field.TypeInt32:
+		t = "Int32"
+	case field.TypeInt, field.TypeInt64:
+		t = "Int64"
+	case field.TypeUint8:
+		t = "UInt8"
+	case field.TypeUint16:
+		t = "UInt16"
+	case field.TypeUint32:
+		t = "UInt32"
+	case field.TypeUint, field.TypeUint64:
+		t = "UInt64"
+	case field.TypeFloat32:
+		t = "Float32"
+	case field.TypeFloat64:
+		t = "Float64"
+	case field.TypeString, field.TypeEnum, field.TypeJSON, field.TypeBytes:
+		t = "String"
+	case field.TypeTime:
+		t = "DateTime64(6)"
+	case field.TypeUUID:
+		t = "UUID"
+	default:
+		panic(fmt.Sprintf("unsupported type %q for column %q", c.Type.String(), c.Name))
+	}
+	if c.Nullable {
+		t = "Nullable(" + t + ")"
+	}
+	return t
+}
@@ -0,0 +1,122 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickHouse_Create(t *testing.T) {
+	events := func() *Table {
+		id := &Column{Name: "id", Type: field.TypeUUID}
+		return &Table{
+			Name:       "events",
+			PrimaryKey: []*Column{id},
+			Columns: []*Column{
+				id,
+				{Name: "name", Type: field.TypeString, Default: "unknown"},
+				{Name: "count", Type: field.TypeInt, Default: 0},
+				{Name: "payload", Type: field.TypeJSON, Nullable: true},
+				{Name: "created_at", Type: field.TypeTime},
+				{Name: "user_id", Type: field.TypeInt, Nullable: true},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		tables  func() []*Table
+		options []MigrateOption
+		before  func(sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name:   "create new table",
+			tables: func() []*Table { return []*Table{events()} },
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `system`.`tables` WHERE `database` = currentDatabase() AND `name` = ?")).
+					WithArgs("events").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `events`(`id` UUID, `name` String DEFAULT 'unknown', `count` Int64 DEFAULT 0, `payload` Nullable(String), `created_at` DateTime64(6), `user_id` Nullable(Int64)) ENGINE = MergeTree ORDER BY (`id`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "create new table with engine",
+			tables: func() []*Table {
+				t := events()
+				t.Annotation = &entsql.Annotation{
+					Engine: &entsql.Engine{
+						Name:        "ReplacingMergeTree",
+						OrderBy:     []string{"name", "toDate(created_at)"},
+						PartitionBy: "toYYYYMM(created_at)",
+						Settings:    map[string]string{"index_granularity": "8192", "allow_nullable_key": "1"},
+					},
+				}
+				return []*Table{t}
+			},
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `system`.`tables` WHERE `database` = currentDatabase() AND `name` = ?")).
+					WithArgs("events").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `events`(`id` UUID, `name` String DEFAULT 'unknown', `count` Int64 DEFAULT 0, `payload` Nullable(String), `created_at` DateTime64(6), `user_id` Nullable(Int64)) ENGINE = ReplacingMergeTree PARTITION BY toYYYYMM(created_at) ORDER BY (`name`, toDate(created_at)) SETTINGS allow_nullable_key = 1, index_granularity = 8192")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:   "add missing columns",
+			tables: func() []*Table { return []*Table{events()} },
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `system`.`tables` WHERE `database` = currentDatabase() AND `name` = ?")).
+					WithArgs("events").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("SELECT `name` FROM `system`.`columns` WHERE `database` = currentDatabase() AND `table` = ?")).
+					WithArgs("events").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("name").AddRow("count").AddRow("created_at"))
+				mock.ExpectExec(escape("ALTER TABLE `events` ADD COLUMN `payload` Nullable(String), ADD COLUMN `user_id` Nullable(Int64)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:   "no changes",
+			tables: func() []*Table { return []*Table{events()} },
+			before: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(escape("SELECT COUNT(*) FROM `system`.`tables` WHERE `database` = currentDatabase() AND `name` = ?")).
+					WithArgs("events").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(escape("SELECT `name` FROM `system`.`columns` WHERE `database` = currentDatabase() AND `table` = ?")).
+					WithArgs("events").
+					WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("name").AddRow("count").AddRow("payload").AddRow("created_at").AddRow("user_id"))
+			},
+		},
+		{
+			name:    "universal id",
+			tables:  func() []*Table { return []*Table{events()} },
+			options: []MigrateOption{WithGlobalUniqueID(true)},
+			before:  func(sqlmock.Sqlmock) {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.before(mock)
+			migrate, err := NewMigrate(sql.OpenDB(dialect.ClickHouse, db), tt.options...)
+			require.NoError(t, err)
+			err = migrate.Create(context.Background(), tt.tables()...)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -93,3 +93,55 @@ func (i *Inspector) tables(ctx context.Context) ([]string, error) {
 	}
 	return names, nil
 }
+
+// addForeignKey adds the given column of the foreign-key constraint to table t. The inspection queries
+// return a row for each column of the constraint, and fks holds the constraints that were already added.
+func addForeignKey(tables map[string]*Table, fks map[string]*ForeignKey, t *Table, symbol, column, refTable, refColumn string) error {
+	rt := tables[refTable]
+	if rt == nil {
+		return fmt.Errorf("could not find table: %s", refTable)
+	}
+	c1, ok := t.column(column)
+	if !ok {
+		return fmt.Errorf("could not find column: %s on table: %s", column, t.Name)
+	}
+	c2, ok := rt.column(refColumn)
+	if !ok {
+		return fmt.Errorf("could not find ref column: %s on ref table: %s", refTable, refColumn)
+	}
+	if fk, ok := fks[symbol]; ok {
+		if _, ok := fk.column(column); !ok {
+			fk.Columns = append(fk.Columns, c1)
+		}
+		if _, ok := fk.refColumn(refColumn); !ok {
+			fk.RefColumns = append(fk.RefColumns, c2)
+		}
+		return nil
+	}
+	fk := &ForeignKey{
+		Symbol:     symbol,
+		Columns:    []*Column{c1},
+		RefTable:   rt,
+		RefColumns: []*Column{c2},
+	}
+	fks[symbol] = fk
+	t.AddForeignKey(fk)
+	return nil
+}
+
+// scanForeignKeys scans the foreign keys of table t from the given rows. Each row
+// holds the constraint identifier, the column, the referenced table and the
+// referenced column.
+func scanForeignKeys(rows *sql.Rows, tables map[string]*Table, t *Table) error {
+	fks := make(map[string]*ForeignKey)
+	for rows.Next() {
+		var symbol, column, refTable, refColumn string
+		if err := rows.Scan(&symbol, &column, &refTable, &refColumn); err != nil {
+			return fmt.Errorf("scanning foreign key description: %w", err)
+		}
+		if err := addForeignKey(tables, fks, t, symbol, column, refTable, refColumn); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
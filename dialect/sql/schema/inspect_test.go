@@ -35,8 +35,8 @@ func TestInspector_Tables(t *testing.T) {
 						WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}))
 				},
 				dialect.SQLite: func(mock mysqlMock) {
-					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ?")).
-						WithArgs("table").
+					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ? AND (NOT (`name` LIKE ?))")).
+						WithArgs("table", "sqlite_%").
 						WillReturnRows(sqlmock.NewRows([]string{"name"}))
 				},
 				dialect.Postgres: func(mock mysqlMock) {
@@ -100,10 +100,24 @@ func TestInspector_Tables(t *testing.T) {
 					mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`, `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
 						WithArgs("public", "user_groups").
 						WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "sub_part", "non_unique", "seq_in_index"}))
+					fks := map[string]*sqlmock.Rows{
+						"users": sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"}),
+						"pets": sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"}).
+							AddRow("pet_users_pets", "user_pets", "users", "id"),
+						"groups": sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"}),
+						"user_groups": sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME"}).
+							AddRow("user_groups_group_id", "group_id", "groups", "id").
+							AddRow("user_groups_user_id", "user_id", "users", "id"),
+					}
+					for _, name := range []string{"users", "pets", "groups", "user_groups"} {
+						mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME`, `COLUMN_NAME`, `REFERENCED_TABLE_NAME`, `REFERENCED_COLUMN_NAME` FROM `INFORMATION_SCHEMA`.`KEY_COLUMN_USAGE` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` = ? AND `REFERENCED_TABLE_SCHEMA` = ? ORDER BY `CONSTRAINT_NAME`, `ORDINAL_POSITION`")).
+							WithArgs("public", name, "public").
+							WillReturnRows(fks[name])
+					}
 				},
 				dialect.SQLite: func(mock mysqlMock) {
-					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ?")).
-						WithArgs("table").
+					mock.ExpectQuery(escape("SELECT `name` FROM `sqlite_schema` WHERE `type` = ? AND (NOT (`name` LIKE ?))")).
+						WithArgs("table", "sqlite_%").
 						WillReturnRows(sqlmock.NewRows([]string{"name"}).
 							AddRow("users").
 							AddRow("pets").
@@ -142,6 +156,19 @@ func TestInspector_Tables(t *testing.T) {
 							AddRow("group_id", "integer", 1, "NULL", 0))
 					mock.ExpectQuery(escape("SELECT `name`, `unique`, `origin` FROM pragma_index_list('user_groups')")).
 						WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "unique"}))
+					fks := map[string]*sqlmock.Rows{
+						"users": sqlmock.NewRows([]string{"id", "from", "table", "to"}),
+						"pets": sqlmock.NewRows([]string{"id", "from", "table", "to"}).
+							AddRow(0, "user_pets", "users", "id"),
+						"groups": sqlmock.NewRows([]string{"id", "from", "table", "to"}),
+						"user_groups": sqlmock.NewRows([]string{"id", "from", "table", "to"}).
+							AddRow(0, "group_id", "groups", "id").
+							AddRow(1, "user_id", "users", "id"),
+					}
+					for _, name := range []string{"users", "pets", "groups", "user_groups"} {
+						mock.ExpectQuery(escape(fmt.Sprintf("SELECT `id`, `from`, `table`, `to` FROM pragma_foreign_key_list('%s') ORDER BY `id`, `seq`", name))).
+							WillReturnRows(fks[name])
+					}
 				},
 				dialect.Postgres: func(mock mysqlMock) {
 					mock.ExpectQuery(escape(`SELECT "table_name" FROM "information_schema"."tables" WHERE "table_schema" = $1`)).
@@ -253,29 +280,32 @@ func TestInspector_Tables(t *testing.T) {
 					}
 				)
 
-				// Only postgres currently supports foreign key inspection
-				if drv == dialect.Postgres {
-					t2.ForeignKeys = []*ForeignKey{
-						{
-							Symbol:     "pet_users_pets",
-							Columns:    []*Column{c2[2]},
-							RefTable:   t1,
-							RefColumns: []*Column{c1[0]},
-						},
-					}
-					t4.ForeignKeys = []*ForeignKey{
-						{
-							Symbol:     "user_groups_group_id",
-							Columns:    []*Column{c4[1]},
-							RefTable:   t3,
-							RefColumns: []*Column{c3[0]},
-						},
-						{
-							Symbol:     "user_groups_user_id",
-							Columns:    []*Column{c4[0]},
-							RefTable:   t1,
-							RefColumns: []*Column{c1[0]},
-						},
+				t2.ForeignKeys = []*ForeignKey{
+					{
+						Symbol:     "pet_users_pets",
+						Columns:    []*Column{c2[2]},
+						RefTable:   t1,
+						RefColumns: []*Column{c1[0]},
+					},
+				}
+				t4.ForeignKeys = []*ForeignKey{
+					{
+						Symbol:     "user_groups_group_id",
+						Columns:    []*Column{c4[1]},
+						RefTable:   t3,
+						RefColumns: []*Column{c3[0]},
+					},
+					{
+						Symbol:     "user_groups_user_id",
+						Columns:    []*Column{c4[0]},
+						RefTable:   t1,
+						RefColumns: []*Column{c1[0]},
+					},
+				}
+				// SQLite does not report the names of foreign-key constraints.
+				if drv == dialect.SQLite {
+					for _, fk := range append(t2.ForeignKeys, t4.ForeignKeys...) {
+						fk.Symbol = ""
 					}
 				}
 
@@ -321,7 +351,7 @@ func columnsMatch(t *testing.T, drv string, got, expected []*Column) {
 	}
 }
 
-func foreignKeysMatch(t *testing.T, drv string, expected []*ForeignKey, got []*ForeignKey) {
+func foreignKeysMatch(t *testing.T, drv string, got, expected []*ForeignKey) {
 	require.Equal(t, len(expected), len(got))
 	for i := range got {
 		fk1, fk2 := got[i], expected[i]
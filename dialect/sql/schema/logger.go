@@ -0,0 +1,136 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Operation types of log entries.
+const (
+	LogQuery = "query" // introspection query.
+	LogExec  = "exec"  // DDL or DML statement.
+)
+
+// LogEntry describes a statement that was executed by the migration.
+type LogEntry struct {
+	// Op is the operation type. LogQuery or LogExec.
+	Op string
+	// Table is the table affected by the statement. It is extracted from
+	// the statement on a best-effort basis, and might be empty.
+	Table string
+	// Statement and its arguments.
+	Statement string
+	Args      []any
+	// Duration of the statement execution.
+	Duration time.Duration
+	// Err holds the error returned by the database, if any.
+	Err error
+}
+
+// WithLogger configures the migration to report each introspection query and
+// DDL statement to the given function, along with its duration, affected table
+// and operation type. In dry-run mode, only the introspection queries are reported.
+//
+//	err := client.Schema.Create(ctx, schema.WithLogger(func(e schema.LogEntry) {
+//		log.Printf("%s %s (%s): %s", e.Op, e.Table, e.Duration, e.Statement)
+//	}))
+func WithLogger(f func(LogEntry)) MigrateOption {
+	return func(a *Atlas) {
+		a.logger = f
+	}
+}
+
+// logDriver is a driver that reports all its statements to a logger.
+type logDriver struct {
+	dialect.Driver
+	log func(LogEntry)
+}
+
+// Exec reports and calls the underlying driver Exec method.
+func (d *logDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return logStmt(d.log, LogExec, query, args, func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query reports and calls the underlying driver Query method.
+func (d *logDriver) Query(ctx context.Context, query string, args, v any) error {
+	return logStmt(d.log, LogQuery, query, args, func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx wraps the transaction returned by the underlying driver.
+func (d *logDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &logTx{Tx: tx, log: d.log}, nil
+}
+
+// logTx is a transaction that reports all its statements to a logger.
+type logTx struct {
+	dialect.Tx
+	log func(LogEntry)
+}
+
+// Exec reports and calls the underlying transaction Exec method.
+func (tx *logTx) Exec(ctx context.Context, query string, args, v any) error {
+	return logStmt(tx.log, LogExec, query, args, func() error {
+		return tx.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query reports and calls the underlying transaction Query method.
+func (tx *logTx) Query(ctx context.Context, query string, args, v any) error {
+	return logStmt(tx.log, LogQuery, query, args, func() error {
+		return tx.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// logStmt executes the given statement and reports it.
+func logStmt(log func(LogEntry), op, query string, args any, exec func() error) error {
+	start := time.Now()
+	err := exec()
+	e := LogEntry{
+		Op:        op,
+		Statement: query,
+		Duration:  time.Since(start),
+		Err:       err,
+	}
+	if args, ok := args.([]any); ok {
+		e.Args = args
+	}
+	if op == LogExec {
+		e.Table = stmtTable(query)
+	}
+	log(e)
+	return err
+}
+
+// reIdent matches a quoted or unquoted identifier.
+const reIdent = "(?:`[^`]+`|\"[^\"]+\"|\\w+)"
+
+// reStmtTable matches the (optionally schema-qualified) table name of DDL and DML statements.
+var reStmtTable = regexp.MustCompile("(?is)^\\s*(?:" +
+	"(?:CREATE|ALTER|DROP|TRUNCATE|RENAME)\\s+TABLE(?:\\s+IF\\s+(?:NOT\\s+)?EXISTS)?" +
+	"|(?:CREATE|DROP)\\s+(?:UNIQUE\\s+|FULLTEXT\\s+|SPATIAL\\s+)?INDEX(?:\\s+CONCURRENTLY)?(?:\\s+IF\\s+(?:NOT\\s+)?EXISTS)?\\s+" + reIdent + "\\s+ON" +
+	"|INSERT\\s+(?:IGNORE\\s+)?INTO|UPDATE|DELETE\\s+FROM" +
+	")\\s+(?:" + reIdent + "\\.)?(" + reIdent + ")")
+
+// stmtTable returns the table name of the given statement, or an empty string if it cannot be extracted.
+func stmtTable(query string) string {
+	if m := reStmtTable.FindStringSubmatch(query); m != nil {
+		return strings.Trim(m[1], "`\"")
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_WithLogger(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		ctx     = context.Background()
+		entries []LogEntry
+		a       = &Atlas{}
+	)
+	WithLogger(func(e LogEntry) { entries = append(entries, e) })(a)
+	drv := a.writeDriver(sql.OpenDB(dialect.MySQL, db))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_NAME` = ?").
+		WithArgs("users").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}).AddRow("users"))
+	mock.ExpectExec("ALTER TABLE `users` ADD COLUMN `age` bigint NOT NULL").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE INDEX `user_age` ON `users` (`age`)").
+		WillReturnError(errors.New("duplicate index"))
+	mock.ExpectCommit()
+
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	rows := &sql.Rows{}
+	require.NoError(t, tx.Query(ctx, "SELECT `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_NAME` = ?", []any{"users"}, rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, tx.Exec(ctx, "ALTER TABLE `users` ADD COLUMN `age` bigint NOT NULL", []any{}, nil))
+	require.EqualError(t, tx.Exec(ctx, "CREATE INDEX `user_age` ON `users` (`age`)", []any{}, nil), "duplicate index")
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, entries, 3)
+	require.Equal(t, LogQuery, entries[0].Op)
+	require.Empty(t, entries[0].Table)
+	require.Equal(t, []any{"users"}, entries[0].Args)
+	require.Equal(t, LogExec, entries[1].Op)
+	require.Equal(t, "users", entries[1].Table)
+	require.Equal(t, "ALTER TABLE `users` ADD COLUMN `age` bigint NOT NULL", entries[1].Statement)
+	require.NoError(t, entries[1].Err)
+	require.Equal(t, "users", entries[2].Table)
+	require.EqualError(t, entries[2].Err, "duplicate index")
+
+	// Statements are not executed, and therefore not reported, in dry-run mode.
+	entries = nil
+	a.dryRun = io.Discard
+	drv = a.writeDriver(sql.OpenDB(dialect.MySQL, db))
+	require.NoError(t, drv.Exec(ctx, "DROP TABLE `users`", []any{}, nil))
+	require.Empty(t, entries)
+}
+
+func TestStmtTable(t *testing.T) {
+	tests := []struct {
+		stmt, table string
+	}{
+		{"CREATE TABLE `users` (`id` bigint NOT NULL)", "users"},
+		{"CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" bigint NOT NULL)", "users"},
+		{"ALTER TABLE `test`.`users` ADD COLUMN `age` bigint", "users"},
+		{"DROP TABLE IF EXISTS users", "users"},
+		{"CREATE UNIQUE INDEX `user_name` ON `users` (`name`)", "users"},
+		{"CREATE INDEX CONCURRENTLY IF NOT EXISTS \"user_name\" ON \"public\".\"users\" (\"name\")", "users"},
+		{"INSERT INTO `ent_types` (`type`) VALUES (?)", "ent_types"},
+		{"UPDATE \"users\" SET \"age\" = 0 WHERE \"age\" IS NULL", "users"},
+		{"DROP INDEX \"user_name\"", ""},
+		{"SELECT 1", ""},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.table, stmtTable(tt.stmt), tt.stmt)
+	}
+}
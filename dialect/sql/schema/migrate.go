@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -18,9 +19,29 @@ const (
 	// TypeTable defines the table name holding the type information.
 	TypeTable = "ent_types"
 
+	// OutboxTable defines the table name holding the change records
+	// of the transactional outbox (the sql/outbox feature).
+	OutboxTable = "ent_outbox"
+
+	// ViewTable defines the table name holding the checksums
+	// of the views that were created by the migration.
+	ViewTable = "ent_views"
+
+	// TriggerTable defines the table name holding the checksums
+	// of the triggers that were created by the migration.
+	TriggerTable = "ent_triggers"
+
 	// MaxTypes defines the max number of types can be created when
 	// defining universal ids. The left 16-bits are reserved.
 	MaxTypes = math.MaxUint16
+
+	// migrationLockName is the name of the lock that is acquired
+	// by migrations that were configured with WithMigrationLock.
+	migrationLockName = "ent_migrate"
+
+	// migrationLockTimeout is the number of seconds to wait for
+	// acquiring the migration lock in MySQL.
+	migrationLockTimeout = 600
 )
 
 // NewTypesTable returns a new table for holding the global-id information.
@@ -30,6 +51,36 @@ func NewTypesTable() *Table {
 		AddColumn(&Column{Name: "type", Type: field.TypeString, Unique: true})
 }
 
+// NewOutboxTable returns a new table for holding the change records of the transactional outbox.
+func NewOutboxTable() *Table {
+	return NewTable(OutboxTable).
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt64, Increment: true}).
+		AddColumn(&Column{Name: "type", Type: field.TypeString}).
+		AddColumn(&Column{Name: "op", Type: field.TypeString}).
+		AddColumn(&Column{Name: "ids", Type: field.TypeJSON, Nullable: true}).
+		AddColumn(&Column{Name: "fields", Type: field.TypeJSON, Nullable: true}).
+		AddColumn(&Column{Name: "created_at", Type: field.TypeTime})
+}
+
+// NewViewsTable returns a new table for holding the checksums of the views that were created by the migration.
+func NewViewsTable() *Table {
+	return NewTable(ViewTable).
+		AddPrimary(&Column{Name: "id", Type: field.TypeUint, Increment: true}).
+		AddColumn(&Column{Name: "name", Type: field.TypeString, Unique: true}).
+		AddColumn(&Column{Name: "checksum", Type: field.TypeString}).
+		AddColumn(&Column{Name: "materialized", Type: field.TypeBool})
+}
+
+// NewTriggersTable returns a new table for holding the checksums of the triggers that were created by the migration.
+func NewTriggersTable() *Table {
+	return NewTable(TriggerTable).
+		AddPrimary(&Column{Name: "id", Type: field.TypeUint, Increment: true}).
+		AddColumn(&Column{Name: "table_name", Type: field.TypeString}).
+		AddColumn(&Column{Name: "name", Type: field.TypeString}).
+		AddColumn(&Column{Name: "checksum", Type: field.TypeString}).
+		AddIndex("ent_triggers_table_name_name", true, []string{"table_name", "name"})
+}
+
 // MigrateOption allows configuring Atlas using functional arguments.
 type MigrateOption func(*Atlas)
 
@@ -41,6 +92,71 @@ func WithGlobalUniqueID(b bool) MigrateOption {
 	}
 }
 
+// WithIDRange pins the id range of the given type (table) to [start, end) when universal ids
+// are enabled, instead of allocating it using the RangeAllocator. Note that the ranges of the
+// different types must not overlap, and the ids that already exist in the table must not be in
+// the ranges of other types.
+func WithIDRange(typ string, start, end int64) MigrateOption {
+	return func(a *Atlas) {
+		if a.pinnedRanges == nil {
+			a.pinnedRanges = make(map[string]IDRange)
+		}
+		a.pinnedRanges[typ] = IDRange{Start: start, End: end}
+	}
+}
+
+// WithRangeAllocator sets the allocator of the id ranges of the types (tables) when universal
+// ids are enabled. Defaults to DefaultRangeAllocator, which allocates a range of 1<<32 ids for
+// each type.
+func WithRangeAllocator(r RangeAllocator) MigrateOption {
+	return func(a *Atlas) {
+		a.allocator = r
+	}
+}
+
+type (
+	// IDRange describes the range of ids [Start, End) of a type (table)
+	// when universal ids are enabled.
+	IDRange struct {
+		Start int64
+		End   int64
+	}
+
+	// RangeAllocator allocates the id ranges of the types (tables) when universal ids are enabled.
+	// The given index is the position of the type in the types table (ent_types), and it does not
+	// change between migrations. Note that changing the ranges of types that already have rows is
+	// safe only if their existing ids are not in the ranges of other types, which is checked by
+	// the migration.
+	RangeAllocator interface {
+		Allocate(typ string, idx int) (IDRange, error)
+	}
+
+	// The RangeAllocatorFunc type is an adapter to allow the use of ordinary
+	// functions as RangeAllocator.
+	RangeAllocatorFunc func(typ string, idx int) (IDRange, error)
+)
+
+// Allocate calls f(typ, idx).
+func (f RangeAllocatorFunc) Allocate(typ string, idx int) (IDRange, error) {
+	return f(typ, idx)
+}
+
+// DefaultRangeAllocator is the default allocator of id ranges, which allocates
+// a range of 1<<32 ids for each type by its position in the types table.
+var DefaultRangeAllocator = RangeBits(32)
+
+// RangeBits returns a RangeAllocator that allocates a range of 1<<bits ids for each type by its
+// position in the types table. For example, RangeBits(40) allocates wider ranges than the default
+// allocator, but it is limited to 1<<23 types.
+func RangeBits(bits uint) RangeAllocator {
+	return RangeAllocatorFunc(func(_ string, idx int) (IDRange, error) {
+		if bits >= 63 || int64(idx+1) > math.MaxInt64>>bits {
+			return IDRange{}, fmt.Errorf("range %d of %d bits exceeds the max id", idx, bits)
+		}
+		return IDRange{Start: int64(idx) << bits, End: int64(idx+1) << bits}, nil
+	})
+}
+
 // WithIndent sets Atlas to generate SQL statements with indentation.
 // An empty string indicates no indentation.
 func WithIndent(indent string) MigrateOption {
@@ -73,6 +189,37 @@ func WithDropIndex(b bool) MigrateOption {
 	}
 }
 
+// WithDropForeignKey sets the foreign-keys dropping option to the legacy migration. Foreign-key
+// constraints that exist in the database, but no longer correspond to any of the foreign keys
+// of their table (e.g. after an edge was removed from the schema), are dropped. Constraints that
+// their columns were changed (e.g. became composite foreign keys) are re-created. Defaults to false.
+//
+// Note that the Atlas migration engine drops such constraints regardless of this option,
+// and the legacy SQLite migration does not support dropping foreign keys.
+func WithDropForeignKey(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.dropForeignKeys = b
+	}
+}
+
+// WithDropTable sets the tables dropping option to the migration. Tables that exist in the
+// database, but were not passed to the migration, are dropped if they are tracked by the
+// universal ids table (ent_types), or their names start with one of the prefixes that were
+// set by WithDropTablePrefix. Defaults to false.
+func WithDropTable(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.dropTables = b
+	}
+}
+
+// WithDropTablePrefix sets the prefixes of the names of the tables
+// that can be dropped by the migration when WithDropTable is enabled.
+func WithDropTablePrefix(prefixes ...string) MigrateOption {
+	return func(a *Atlas) {
+		a.dropPrefixes = append(a.dropPrefixes, prefixes...)
+	}
+}
+
 // WithFixture sets the foreign-key renaming option to the migration when upgrading
 // sqlDialect from v0.1.0 (issue-#285). Defaults to false.
 //
@@ -91,6 +238,17 @@ func WithForeignKeys(b bool) MigrateOption {
 	}
 }
 
+// WithSQLiteTableRecreate enables modifying and dropping columns in the legacy SQLite migration,
+// by recreating the table using the procedure that is described in the SQLite documentation:
+// create a new table, copy the data, drop the old table and rename the new one. Defaults to false.
+//
+// Note that the Atlas based migration engine recreates SQLite tables on such changes by default.
+func WithSQLiteTableRecreate(b bool) MigrateOption {
+	return func(a *Atlas) {
+		a.sqliteRecreate = b
+	}
+}
+
 // WithHooks adds a list of hooks to the schema migration.
 func WithHooks(hooks ...Hook) MigrateOption {
 	return func(a *Atlas) {
@@ -137,6 +295,7 @@ type Migrate struct {
 	universalID     bool     // global unique ids
 	dropColumns     bool     // drop deleted columns
 	dropIndexes     bool     // drop deleted indexes
+	dropForeignKeys bool     // drop deleted foreign keys
 	withFixture     bool     // with fks rename fixture
 	withForeignKeys bool     // with foreign keys
 	typeRanges      []string // types order by their range
@@ -150,8 +309,8 @@ type Migrate struct {
 // resulting data altering. From example, changing varchar(255) to varchar(120) is invalid, but
 // changing varchar(120) to varchar(255) is valid. For more info, see the convert function below.
 //
-// Note that SQLite dialect does not support (this moment) the "append-only" mode describe above,
-// since it's used only for testing.
+// Note that SQLite dialect does not support modifying columns using ALTER TABLE, and such
+// changes are applied by recreating the table only if WithSQLiteTableRecreate is enabled.
 func (m *Migrate) Create(ctx context.Context, tables ...*Table) error {
 	m.setupTables(tables)
 	var creator Creator = CreateFunc(m.create)
@@ -196,12 +355,20 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 			if err := m.fixture(ctx, tx, curr, t); err != nil {
 				return err
 			}
+			if err := m.dropStaleForeignKeys(ctx, tx, t); err != nil {
+				return err
+			}
 			change, err := m.changeSet(curr, t)
 			if err != nil {
 				return fmt.Errorf("creating changeset for %q: %w", t.Name, err)
 			}
-			if err := m.apply(ctx, tx, t.Name, change); err != nil {
+			switch recreated, err := m.recreate(ctx, tx, curr, t, change); {
+			case err != nil:
 				return err
+			case !recreated:
+				if err := m.apply(ctx, tx, t.Name, change); err != nil {
+					return err
+				}
 			}
 		default: // !exist
 			query, args := m.tBuilder(t).Query()
@@ -246,13 +413,24 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 		if len(fks) == 0 {
 			continue
 		}
-		b := sql.Dialect(m.Dialect()).AlterTable(t.Name)
+		var (
+			split   bool
+			queries []*sql.TableAlter
+		)
+		if ma, ok := m.sqlDialect.(multiAlterer); ok {
+			split = !ma.multiAlter()
+		}
 		for _, fk := range fks {
-			b.AddForeignKey(fk.DSL())
+			if len(queries) == 0 || split {
+				queries = append(queries, sql.Dialect(m.Dialect()).AlterTable(t.Name))
+			}
+			queries[len(queries)-1].AddForeignKey(fk.DSL())
 		}
-		query, args := b.Query()
-		if err := tx.Exec(ctx, query, args, nil); err != nil {
-			return fmt.Errorf("create foreign keys for %q: %w", t.Name, err)
+		for _, q := range queries {
+			query, args := q.Query()
+			if err := tx.Exec(ctx, query, args, nil); err != nil {
+				return fmt.Errorf("create foreign keys for %q: %w", t.Name, err)
+			}
 		}
 	}
 	return nil
@@ -296,6 +474,58 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 	return nil
 }
 
+// dropStaleForeignKeys drops the foreign-key constraints of the given table that no longer correspond
+// to any of its foreign keys, or that their columns were changed, if the option was enabled. Changed
+// constraints (e.g. a single-column foreign key that became a composite one) are re-created later.
+func (m *Migrate) dropStaleForeignKeys(ctx context.Context, tx dialect.Tx, t *Table) error {
+	d, ok := m.sqlDialect.(fkDropper)
+	if !ok || !m.dropForeignKeys {
+		return nil
+	}
+	fks, err := d.fkColumns(ctx, tx, t.Name)
+	if err != nil {
+		return err
+	}
+	symbols := make([]string, 0, len(fks))
+	for symbol := range fks {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		if fk, ok := t.fk(symbol); ok && fk.columnsEqual(fks[symbol]) {
+			continue
+		}
+		query, args := d.dropForeignKey(t.Name, symbol).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("drop foreign key %q of table %q: %w", symbol, t.Name, err)
+		}
+	}
+	return nil
+}
+
+// recreate applies the changes of the given table by recreating it, in case the dialect does not
+// support modifying or dropping columns using ALTER TABLE (e.g. SQLite). It reports if the table
+// was recreated.
+func (m *Migrate) recreate(ctx context.Context, tx dialect.Tx, curr, new *Table, change *changes) (bool, error) {
+	r, ok := m.sqlDialect.(tableRecreator)
+	if !ok || !r.recreateTables() {
+		return false, nil
+	}
+	if len(change.column.modify) == 0 && (!m.dropColumns || len(change.column.drop) == 0) {
+		return false, nil
+	}
+	t := *new
+	// Columns that were removed from the schema are kept,
+	// unless dropping columns was enabled for the migration.
+	if !m.dropColumns {
+		t.Columns = append(t.Columns[:len(t.Columns):len(t.Columns)], change.column.drop...)
+	}
+	if err := r.recreateTable(ctx, tx, curr, &t, !m.dropIndexes); err != nil {
+		return false, fmt.Errorf("recreate table %q: %w", t.Name, err)
+	}
+	return true, nil
+}
+
 // changes to apply on existing table.
 type changes struct {
 	// column changes.
@@ -333,6 +563,15 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		if curr.PrimaryKey[i].Name != new.PrimaryKey[i].Name {
 			return nil, fmt.Errorf("cannot change primary key for table: %q", curr.Name)
 		}
+		// Integer columns can be widened, but changing the type family
+		// of a primary key (e.g. int to uuid) requires a manual migration.
+		if c1, c2 := curr.PrimaryKey[i], new.PrimaryKey[i]; c1.Type.Integer() != c2.Type.Integer() {
+			return nil, &PrimaryKeyError{
+				Table:  curr.Name,
+				Column: c2.Name,
+				Reason: fmt.Sprintf("change type of column %q from %s to %s", c2.Name, c1.Type, c2.Type),
+			}
+		}
 	}
 	// Add or modify columns.
 	for _, c1 := range new.Columns {
@@ -430,6 +669,10 @@ func (m *Migrate) fixture(ctx context.Context, tx dialect.Tx, curr, new *Table)
 	}
 	rename := make(map[string]*Index)
 	for _, fk := range new.ForeignKeys {
+		// Renaming columns of composite foreign keys is not supported.
+		if len(fk.Columns) != 1 {
+			continue
+		}
 		ok, err := m.fkExist(ctx, tx, fk.Symbol)
 		if err != nil {
 			return fmt.Errorf("checking foreign-key existence %q: %w", fk.Symbol, err)
@@ -507,7 +750,11 @@ func (m *Migrate) verify(ctx context.Context, tx dialect.Tx, t *Table) error {
 	if id == -1 {
 		return nil
 	}
-	return vr.verifyRange(ctx, tx, t, int64(id<<32))
+	r, err := m.atlas.idRange(t.Name, id)
+	if err != nil {
+		return err
+	}
+	return vr.verifyRange(ctx, tx, t, r.Start)
 }
 
 // types loads the type list from the type store. It will create the types table, if it does not exist yet.
@@ -556,8 +803,16 @@ func (m *Migrate) pkRange(ctx context.Context, conn dialect.ExecQuerier, t *Tabl
 		}
 		id = len(m.typeRanges)
 		m.typeRanges = append(m.typeRanges, t.Name)
+		// Ensure the range of the new type does not overlap the ranges of other types.
+		if _, err := m.atlas.idRanges(m.typeRanges); err != nil {
+			return 0, err
+		}
 	}
-	return int64(id << 32), nil
+	r, err := m.atlas.idRange(t.Name, id)
+	if err != nil {
+		return 0, err
+	}
+	return r.Start, nil
 }
 
 // fkColumn returns the column name of a foreign-key.
@@ -645,6 +900,19 @@ type preparer interface {
 	prepare(context.Context, dialect.Tx, *changes, string) error
 }
 
+// tableRecreator is implemented by dialects that apply column
+// changes by recreating the table (e.g. SQLite).
+type tableRecreator interface {
+	recreateTables() bool
+	recreateTable(ctx context.Context, tx dialect.Tx, curr, new *Table, keepIndexes bool) error
+}
+
+// multiAlterer is implemented by dialects that may not support
+// multiple changes in a single ALTER TABLE statement (e.g. TiDB).
+type multiAlterer interface {
+	multiAlter() bool
+}
+
 // fkRenamer is used by the fixture migration (to solve #285),
 // and it's implemented by the different dialects for renaming FKs.
 type fkRenamer interface {
@@ -654,6 +922,27 @@ type fkRenamer interface {
 	renameColumn(*Table, *Column, *Column) sql.Querier
 }
 
+// fkDropper is implemented by the dialects that support
+// dropping foreign-key constraints of existing tables.
+type fkDropper interface {
+	fkColumns(ctx context.Context, tx dialect.Tx, table string) (map[string][]string, error)
+	dropForeignKey(table, symbol string) sql.Querier
+}
+
+// scanFKColumns scans the rows of (constraint, column) pairs into a map of foreign-key columns.
+func scanFKColumns(rows *sql.Rows) (map[string][]string, error) {
+	defer rows.Close()
+	fks := make(map[string][]string)
+	for rows.Next() {
+		var symbol, column string
+		if err := rows.Scan(&symbol, &column); err != nil {
+			return nil, err
+		}
+		fks[symbol] = append(fks[symbol], column)
+	}
+	return fks, rows.Err()
+}
+
 // verifyRanger wraps the method for verifying global-id range correctness.
 type verifyRanger interface {
 	verifyRange(context.Context, dialect.ExecQuerier, *Table, int64) error
@@ -6,6 +6,7 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,9 +16,12 @@ import (
 	"time"
 
 	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
 	"ariga.io/atlas/sql/schema"
 	"ariga.io/atlas/sql/sqltool"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
@@ -73,6 +77,717 @@ func TestMigrateHookAddTable(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMigrate_DryRun(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:dryrun?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	tables := []*Table{
+		{
+			Name:       "users",
+			Columns:    []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "name", Type: field.TypeString}},
+			PrimaryKey: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}},
+		},
+	}
+	for _, legacy := range []bool{false, true} {
+		var b strings.Builder
+		m, err := NewMigrate(db, WithDryRun(&b), WithAtlas(!legacy))
+		require.NoError(t, err)
+		require.NoError(t, m.Create(ctx, tables...))
+		require.Contains(t, b.String(), "CREATE TABLE `users`")
+		exists, err := (&SQLite{Driver: db}).tableExist(ctx, db, "users")
+		require.NoError(t, err)
+		require.False(t, exists, "dry-run should not create the table")
+	}
+}
+
+func TestMigrate_RenameColumn(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:rename?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	users := func(c *Column) *Table {
+		id := &Column{Name: "id", Type: field.TypeInt, Increment: true}
+		return &Table{Name: "users", Columns: []*Column{id, c}, PrimaryKey: []*Column{id}}
+	}
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users(&Column{Name: "nickname", Type: field.TypeString})))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`nickname`) VALUES ('a8m')", []any{}, nil))
+
+	var b strings.Builder
+	m, err = NewMigrate(db, WithDryRun(&b))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users(&Column{Name: "nick", Type: field.TypeString, OldNames: []string{"name", "nickname"}})))
+	require.Contains(t, b.String(), "ALTER TABLE `users` RENAME COLUMN `nickname` TO `nick`;")
+
+	m, err = NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users(&Column{Name: "nick", Type: field.TypeString, OldNames: []string{"name", "nickname"}})))
+	rows := &sql.Rows{}
+	require.NoError(t, db.Query(ctx, "SELECT `nick` FROM `users`", []any{}, rows))
+	var nicks []string
+	require.NoError(t, sql.ScanSlice(rows, &nicks))
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"a8m"}, nicks)
+}
+
+func TestMigrate_BeforeAfterApply(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:apply?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	id := &Column{Name: "id", Type: field.TypeInt, Increment: true}
+	name := &Column{Name: "name", Type: field.TypeString}
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, &Table{Name: "users", Columns: []*Column{id, name}, PrimaryKey: []*Column{id}}))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`name`) VALUES ('a8m')", []any{}, nil))
+
+	var calls []string
+	nick := &Column{Name: "nick", Type: field.TypeString, Nullable: true}
+	users := &Table{Name: "users", Columns: []*Column{id, name, nick}, PrimaryKey: []*Column{id}}
+	m, err = NewMigrate(db,
+		WithBeforeApply(func(ctx context.Context, conn dialect.ExecQuerier) error {
+			calls = append(calls, "before")
+			return conn.Exec(ctx, "CREATE TABLE `logs` (`id` integer NOT NULL)", []any{}, nil)
+		}),
+		WithAfterApply(func(ctx context.Context, conn dialect.ExecQuerier) error {
+			calls = append(calls, "after")
+			return conn.Exec(ctx, "UPDATE `users` SET `nick` = `name` WHERE `nick` IS NULL", []any{}, nil)
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+	require.Equal(t, []string{"before", "after"}, calls)
+	rows := &sql.Rows{}
+	require.NoError(t, db.Query(ctx, "SELECT `nick` FROM `users`", []any{}, rows))
+	var nicks []string
+	require.NoError(t, sql.ScanSlice(rows, &nicks))
+	require.NoError(t, rows.Close())
+	require.Equal(t, []string{"a8m"}, nicks)
+
+	// Errors returned by the functions roll back the migration.
+	m, err = NewMigrate(db, WithAfterApply(func(context.Context, dialect.ExecQuerier) error {
+		return errors.New("backfill failed")
+	}))
+	require.NoError(t, err)
+	users.Columns = append(users.Columns, &Column{Name: "age", Type: field.TypeInt, Nullable: true})
+	require.EqualError(t, m.Create(ctx, users), "sql/schema: backfill failed")
+	rows = &sql.Rows{}
+	require.NoError(t, db.Query(ctx, "SELECT COUNT(*) FROM pragma_table_info('users') WHERE `name` = 'age'", []any{}, rows))
+	n, err := sql.ScanInt(rows)
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	require.Zero(t, n)
+}
+
+func TestMigrate_Seed(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:seed?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	id := &Column{Name: "id", Type: field.TypeInt, Increment: true}
+	name := &Column{Name: "name", Type: field.TypeString}
+	roles := &Table{Name: "roles", Columns: []*Column{id, name}, PrimaryKey: []*Column{id}}
+	count := func() int {
+		rows := &sql.Rows{}
+		require.NoError(t, db.Query(ctx, "SELECT COUNT(*) FROM `roles`", []any{}, rows))
+		n, err := sql.ScanInt(rows)
+		require.NoError(t, err)
+		require.NoError(t, rows.Close())
+		return n
+	}
+	var calls int
+	seed := WithSeed("roles", func(ctx context.Context, conn dialect.ExecQuerier) error {
+		calls++
+		return conn.Exec(ctx, "INSERT INTO `roles` (`name`) VALUES ('admin'), ('member')", []any{}, nil)
+	})
+
+	// Errors returned by the seed functions roll back the table creation.
+	m, err := NewMigrate(db, seed, WithSeed("roles", func(context.Context, dialect.ExecQuerier) error {
+		return errors.New("duplicate role")
+	}))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, roles), `sql/schema: seed table "roles": duplicate role`)
+	exists, err := (&SQLite{Driver: db}).tableExist(ctx, db, "roles")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	m, err = NewMigrate(db, seed, WithSeed("users", func(context.Context, dialect.ExecQuerier) error {
+		return errors.New("unexpected seed of users")
+	}))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, roles))
+	require.Equal(t, 2, count())
+
+	// Seeds run only when the table is created.
+	roles.Columns = append(roles.Columns, &Column{Name: "description", Type: field.TypeString, Nullable: true})
+	require.NoError(t, m.Create(ctx, roles))
+	require.Equal(t, 2, count())
+	require.Equal(t, 2, calls)
+}
+
+func TestMigrate_PrimaryKeyChanges(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:pkchanges?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	id := &Column{Name: "id", Type: field.TypeInt, Increment: true}
+	users := &Table{Name: "users", Columns: []*Column{id}, PrimaryKey: []*Column{id}}
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+
+	// Widening integer primary keys is allowed.
+	id = &Column{Name: "id", Type: field.TypeInt64, Increment: true}
+	users = &Table{Name: "users", Columns: []*Column{id}, PrimaryKey: []*Column{id}}
+	require.NoError(t, m.Create(ctx, users))
+
+	id = &Column{Name: "id", Type: field.TypeUUID}
+	users = &Table{Name: "users", Columns: []*Column{id}, PrimaryKey: []*Column{id}}
+	err = m.Create(ctx, users)
+	pe := (*PrimaryKeyError)(nil)
+	require.ErrorAs(t, err, &pe)
+	require.Equal(t, "users", pe.Table)
+	require.Equal(t, "id", pe.Column)
+	require.Equal(t, `change type of column "id" from integer to uuid`, pe.Reason)
+	require.Contains(t, err.Error(), "WithPrimaryKeyChanges")
+
+	uid := &Column{Name: "uid", Type: field.TypeInt}
+	users = &Table{Name: "users", Columns: []*Column{uid}, PrimaryKey: []*Column{uid}}
+	m, err = NewMigrate(db, WithDropColumn(true))
+	require.NoError(t, err)
+	err = m.Create(ctx, users)
+	require.ErrorAs(t, err, &pe)
+	require.Equal(t, "change primary key from (id) to (uid)", pe.Reason)
+
+	// Primary key changes are applied when explicitly allowed.
+	m, err = NewMigrate(db, WithPrimaryKeyChanges(true))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, &Table{Name: "users", Columns: []*Column{id}, PrimaryKey: []*Column{id}}))
+	rows := &sql.Rows{}
+	require.NoError(t, db.Query(ctx, "SELECT `type` FROM pragma_table_info('users') WHERE `name` = 'id'", []any{}, rows))
+	typ, err := sql.ScanString(rows)
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	require.Equal(t, "uuid", typ)
+}
+
+func TestMigrate_Inspect(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:inspect?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var (
+		c1     = []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "name", Type: field.TypeString, Unique: true}}
+		users  = &Table{Name: "users", Columns: c1, PrimaryKey: c1[:1]}
+		c2     = []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "owner_id", Type: field.TypeInt, Nullable: true}}
+		pets   = &Table{Name: "pets", Columns: c2, PrimaryKey: c2[:1]}
+		tables = []*Table{users, pets}
+	)
+	pets.ForeignKeys = []*ForeignKey{{Symbol: "pets_users_pets", Columns: c2[1:], RefTable: users, RefColumns: c1[:1], OnDelete: SetNull}}
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, tables...))
+
+	inspected, err := m.Inspect(ctx)
+	require.NoError(t, err)
+	require.Len(t, inspected, 2)
+	require.Equal(t, "users", inspected[0].Name)
+	require.Len(t, inspected[0].Columns, 2)
+	c, ok := inspected[0].column("name")
+	require.True(t, ok)
+	require.True(t, c.Unique)
+	require.Equal(t, "pets", inspected[1].Name)
+	require.Len(t, inspected[1].ForeignKeys, 1)
+	fk := inspected[1].ForeignKeys[0]
+	require.Equal(t, "users", fk.RefTable.Name)
+	require.Equal(t, "owner_id", fk.Columns[0].Name)
+	require.Equal(t, "id", fk.RefColumns[0].Name)
+}
+
+func TestMigrate_ChangesApply(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:changes?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var (
+		id    = &Column{Name: "id", Type: field.TypeInt, Increment: true}
+		name  = &Column{Name: "name", Type: field.TypeString}
+		age   = &Column{Name: "age", Type: field.TypeInt, Nullable: true}
+		users = &Table{Name: "users", Columns: []*Column{id, name}, PrimaryKey: []*Column{id}}
+	)
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users))
+
+	users.Columns = append(users.Columns, age)
+	users.Indexes = []*Index{{Name: "user_name", Unique: true, Columns: []*Column{name}}}
+	changes, err := m.Changes(ctx, users)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	modify, ok := changes[0].(*schema.ModifyTable)
+	require.True(t, ok)
+	require.Len(t, modify.Changes, 2)
+
+	// Veto the index creation, and apply only the new column.
+	var filtered []schema.Change
+	for _, c := range modify.Changes {
+		if _, ok := c.(*schema.AddIndex); !ok {
+			filtered = append(filtered, c)
+		}
+	}
+	modify.Changes = filtered
+	require.NoError(t, m.Apply(ctx, changes, users))
+
+	changes, err = m.Changes(ctx, users)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	modify, ok = changes[0].(*schema.ModifyTable)
+	require.True(t, ok)
+	require.Len(t, modify.Changes, 1)
+	require.IsType(t, &schema.AddIndex{}, modify.Changes[0])
+
+	m, err = NewMigrate(db, WithAtlas(false))
+	require.NoError(t, err)
+	_, err = m.Changes(ctx, users)
+	require.EqualError(t, err, "sql/schema: Changes is not supported by the legacy migration engine")
+}
+
+func TestMigrate_Strict(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:strict?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	users := func(columns ...*Column) *Table {
+		id := &Column{Name: "id", Type: field.TypeInt, Increment: true}
+		return &Table{Name: "users", Columns: append([]*Column{id}, columns...), PrimaryKey: []*Column{id}}
+	}
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users(
+		&Column{Name: "name", Type: field.TypeString, Size: 255, Nullable: true},
+		&Column{Name: "nick", Type: field.TypeString, Size: 255, Nullable: true},
+		&Column{Name: "age", Type: field.TypeInt, Nullable: true},
+	)))
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`nick`) VALUES ('a8m')", []any{}, nil))
+
+	// Non-destructive changes are applied.
+	m, err = NewMigrate(db, WithStrict(true), WithDropColumn(true))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users(
+		&Column{Name: "name", Type: field.TypeString, Size: 255, Nullable: true},
+		&Column{Name: "nick", Type: field.TypeString, Size: 255},
+		&Column{Name: "age", Type: field.TypeInt, Nullable: true},
+		&Column{Name: "email", Type: field.TypeString, Nullable: true},
+	)))
+
+	err = m.Create(ctx, users(
+		&Column{Name: "name", Type: field.TypeString, Size: 255},
+		&Column{Name: "nick", Type: field.TypeString, Size: 255},
+		&Column{Name: "email", Type: field.TypeString, Nullable: true},
+	))
+	de := (*DestructiveError)(nil)
+	require.ErrorAs(t, err, &de)
+	require.Len(t, de.Changes, 2)
+	var reasons []string
+	for _, c := range de.Changes {
+		reasons = append(reasons, c.Reason)
+		require.NotEmpty(t, c.Stmts)
+	}
+	require.ElementsMatch(t, []string{
+		`drop column "age" of table "users"`,
+		`change column "name" of table "users" to NOT NULL (1 rows hold NULL values)`,
+	}, reasons)
+	require.Equal(t, []string{"DROP TABLE `users`", "ALTER TABLE `new_users` RENAME TO `users`"}, de.Changes[0].Stmts)
+	require.Contains(t, err.Error(), "sql/schema: destructive changes are not allowed in strict mode:\n\t")
+
+	// Nothing was applied.
+	inspected, err := m.Inspect(ctx)
+	require.NoError(t, err)
+	require.Len(t, inspected, 1)
+	require.Len(t, inspected[0].Columns, 5)
+
+	// SQLite ignores the size of string types, and shrinking is checked on a plan directly.
+	column := func(size int) *schema.Column {
+		return schema.NewColumn("name").SetType(&schema.StringType{T: "varchar", Size: size})
+	}
+	changes, err := m.destructiveChanges(ctx, nil, &migrate.Plan{
+		Changes: []*migrate.Change{
+			{
+				Cmd: `ALTER TABLE "users" ALTER COLUMN "name" TYPE character varying(10)`,
+				Source: &schema.ModifyTable{
+					T: schema.NewTable("users"),
+					Changes: []schema.Change{
+						&schema.ModifyColumn{From: column(255), To: column(10), Change: schema.ChangeType},
+					},
+				},
+			},
+			{
+				Cmd: `ALTER TABLE "pets" ALTER COLUMN "name" TYPE character varying(255)`,
+				Source: &schema.ModifyTable{
+					T: schema.NewTable("pets"),
+					Changes: []schema.Change{
+						&schema.ModifyColumn{From: column(10), To: column(255), Change: schema.ChangeType},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, `shrink column "name" of table "users" from varchar(255) to varchar(10)`, changes[0].Reason)
+}
+
+func TestMigrate_DefaultExprs(t *testing.T) {
+	column := func(name string, x schema.Expr) *schema.Column {
+		return schema.NewColumn(name).SetDefault(x)
+	}
+	changes := []schema.Change{
+		&schema.ModifyTable{
+			T: schema.NewTable("users"),
+			Changes: []schema.Change{
+				&schema.ModifyColumn{
+					From:   column("uuid", &schema.RawExpr{X: "gen_random_uuid()"}),
+					To:     column("uuid", &schema.RawExpr{X: "(gen_random_uuid())"}),
+					Change: schema.ChangeDefault,
+				},
+				&schema.ModifyColumn{
+					From:   column("created_at", &schema.RawExpr{X: "CURRENT_TIMESTAMP"}),
+					To:     column("created_at", &schema.RawExpr{X: "(current_timestamp)"}),
+					Change: schema.ChangeDefault | schema.ChangeNull,
+				},
+				&schema.ModifyColumn{
+					From:   column("name", &schema.Literal{V: "'a'"}),
+					To:     column("name", &schema.RawExpr{X: "('A')"}),
+					Change: schema.ChangeDefault,
+				},
+				&schema.ModifyColumn{
+					From:   column("size", &schema.RawExpr{X: "(a) + (b)"}),
+					To:     column("size", &schema.RawExpr{X: "(a + b)"}),
+					Change: schema.ChangeDefault,
+				},
+			},
+		},
+		&schema.ModifyTable{
+			T: schema.NewTable("pets"),
+			Changes: []schema.Change{
+				&schema.ModifyColumn{
+					From:   column("uuid", &schema.RawExpr{X: "uuid_generate_v4()"}),
+					To:     column("uuid", &schema.RawExpr{X: "((uuid_generate_v4()))"}),
+					Change: schema.ChangeDefault,
+				},
+			},
+		},
+	}
+	changes, err := defaultExprs(DiffFunc(func(_, _ *schema.Schema) ([]schema.Change, error) {
+		return changes, nil
+	})).Diff(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	m := changes[0].(*schema.ModifyTable)
+	require.Len(t, m.Changes, 3)
+	require.Equal(t, "created_at", m.Changes[0].(*schema.ModifyColumn).To.Name)
+	require.Equal(t, schema.ChangeNull, m.Changes[0].(*schema.ModifyColumn).Change)
+	require.Equal(t, "(current_timestamp)", m.Changes[0].(*schema.ModifyColumn).To.Default.(*schema.RawExpr).X)
+	require.Equal(t, "name", m.Changes[1].(*schema.ModifyColumn).To.Name)
+	require.Equal(t, "size", m.Changes[2].(*schema.ModifyColumn).To.Name)
+}
+
+func TestMigrate_DropTable(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:droptable?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	table := func(name string) *Table {
+		return NewTable(name).AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+	}
+	var (
+		users  = table("users").AddColumn(&Column{Name: "group_id", Type: field.TypeInt, Nullable: true})
+		groups = table("groups")
+		pets   = table("pets").AddColumn(&Column{Name: "owner_id", Type: field.TypeInt, Nullable: true})
+		logs   = table("logs")
+	)
+	users.AddForeignKey(&ForeignKey{Symbol: "users_groups", Columns: users.Columns[1:], RefTable: groups, RefColumns: groups.Columns[:1], OnDelete: SetNull})
+	pets.AddForeignKey(&ForeignKey{Symbol: "pets_users", Columns: pets.Columns[1:], RefTable: users, RefColumns: users.Columns[:1], OnDelete: SetNull})
+	m, err := NewMigrate(db, WithGlobalUniqueID(true))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users, groups, pets, logs))
+	exists := func(name string) bool {
+		ok, err := (&SQLite{Driver: db}).tableExist(ctx, db, name)
+		require.NoError(t, err)
+		return ok
+	}
+
+	// Tables are not dropped by default.
+	m, err = NewMigrate(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users, groups))
+	require.True(t, exists("pets"))
+
+	// Pets is tracked by the types table, and groups matches the prefix. Logs is tracked
+	// as well, but it is part of the schema, and other is neither tracked nor matched.
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE `other` (`id` integer)", []any{}, nil))
+	m, err = NewMigrate(db, WithDropTable(true), WithDropTablePrefix("gro"))
+	require.NoError(t, err)
+	users = table("users").AddColumn(&Column{Name: "group_id", Type: field.TypeInt, Nullable: true})
+	require.NoError(t, m.Create(ctx, users, logs))
+	require.True(t, exists("users"))
+	require.True(t, exists("logs"))
+	require.True(t, exists("other"))
+	require.True(t, exists(TypeTable))
+	require.False(t, exists("pets"))
+	require.False(t, exists("groups"))
+}
+
+func TestMigrate_IDRange(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:idrange?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	table := func(name string) *Table {
+		return NewTable(name).AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+	}
+	seq := func(name string) int64 {
+		rows := &sql.Rows{}
+		require.NoError(t, db.Query(ctx, "SELECT `seq` FROM `sqlite_sequence` WHERE `name` = ?", []any{name}, rows))
+		defer rows.Close()
+		var v int64
+		require.NoError(t, sql.ScanOne(rows, &v))
+		return v
+	}
+	m, err := NewMigrate(db, WithGlobalUniqueID(true), WithRangeAllocator(RangeBits(40)), WithIDRange("pets", 1<<50, 1<<51))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, table("users"), table("pets"), table("groups")))
+	require.Equal(t, int64(1<<50), seq("pets"))
+	require.Equal(t, int64(2<<40), seq("groups"))
+
+	// Ranges of different types must not overlap.
+	m, err = NewMigrate(db, WithGlobalUniqueID(true), WithRangeAllocator(RangeBits(40)), WithIDRange("cards", 1<<40, 1<<41))
+	require.NoError(t, err)
+	err = m.Create(ctx, table("users"), table("pets"), table("groups"), table("cards"))
+	require.EqualError(t, err, `sql/schema: id range [1099511627776, 2199023255552) of type "cards" overlaps the range [1099511627776, 2199023255552) of type "pets"`)
+
+	// Existing ids must not be in the ranges of other types.
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `pets` DEFAULT VALUES", []any{}, nil))
+	m, err = NewMigrate(db, WithGlobalUniqueID(true), WithIDRange("users", 1<<50, 1<<51), WithIDRange("pets", 1<<52, 1<<53))
+	require.NoError(t, err)
+	err = m.Create(ctx, table("users"), table("pets"), table("groups"))
+	require.EqualError(t, err, `sql/schema: ids [1125899906842625, 1125899906842625] of table "pets" overlap the id range [1125899906842624, 2251799813685248) of type "users"`)
+
+	_, err = RangeBits(48).Allocate("users", 1<<15)
+	require.EqualError(t, err, "range 32768 of 48 bits exceeds the max id")
+}
+
+func TestMigrate_SQLiteTableRecreate(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:recreate?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	users := func(nullable bool) *Table {
+		t := NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "name", Type: field.TypeString, Nullable: nullable}).
+			AddColumn(&Column{Name: "age", Type: field.TypeInt})
+		t.AddIndex("user_age", false, []string{"age"})
+		return t
+	}
+	pets := func(users *Table) *Table {
+		t := NewTable("pets").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "owner_id", Type: field.TypeInt, Nullable: true})
+		t.AddForeignKey(&ForeignKey{Symbol: "pets_users", Columns: t.Columns[1:], RefTable: users, RefColumns: users.Columns[:1], OnDelete: SetNull})
+		return t
+	}
+	u1 := users(false)
+	u1.AddColumn(&Column{Name: "nickname", Type: field.TypeString, Nullable: true})
+	m, err := NewMigrate(db, WithAtlas(false))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, u1, pets(u1)))
+	for _, stmt := range []string{
+		"CREATE INDEX `user_nickname` ON `users` (`nickname`)",
+		"INSERT INTO `users` (`name`, `age`, `nickname`) VALUES ('a8m', 30, 'a'), ('nati', 28, 'n'), ('deleted', 1, 'd')",
+		"DELETE FROM `users` WHERE `name` = 'deleted'",
+		"INSERT INTO `pets` (`owner_id`) VALUES (1)",
+	} {
+		require.NoError(t, db.Exec(ctx, stmt, []any{}, nil))
+	}
+	query := func(query string, v any) {
+		rows := &sql.Rows{}
+		require.NoError(t, db.Query(ctx, query, []any{}, rows))
+		require.NoError(t, sql.ScanSlice(rows, v))
+		require.NoError(t, rows.Close())
+	}
+	notnull := func() (v []bool) {
+		query("SELECT `notnull` FROM pragma_table_info('users') WHERE `name` = 'name'", &v)
+		return v
+	}
+
+	// Column modifications are skipped without recreating the table.
+	u2 := users(true)
+	m, err = NewMigrate(db, WithAtlas(false))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, u2, pets(u2)))
+	require.Equal(t, []bool{true}, notnull())
+
+	u2 = users(true)
+	m, err = NewMigrate(db, WithAtlas(false), WithSQLiteTableRecreate(true))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, u2, pets(u2)))
+	require.Equal(t, []bool{false}, notnull())
+	var columns []string
+	query("SELECT `name` FROM pragma_table_info('users')", &columns)
+	require.Equal(t, []string{"id", "name", "age", "nickname"}, columns, "columns are not dropped by default")
+	var indexes []string
+	query("SELECT `name` FROM `sqlite_master` WHERE `type` = 'index' AND `tbl_name` = 'users' ORDER BY `name`", &indexes)
+	require.Equal(t, []string{"user_age", "user_nickname"}, indexes)
+
+	// Drop columns and indexes that were removed from the schema.
+	u2 = users(true)
+	m, err = NewMigrate(db, WithAtlas(false), WithSQLiteTableRecreate(true), WithDropColumn(true), WithDropIndex(true))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, u2, pets(u2)))
+	columns = nil
+	query("SELECT `name` FROM pragma_table_info('users')", &columns)
+	require.Equal(t, []string{"id", "name", "age"}, columns)
+	indexes = nil
+	query("SELECT `name` FROM `sqlite_master` WHERE `type` = 'index' AND `tbl_name` = 'users' ORDER BY `name`", &indexes)
+	require.Equal(t, []string{"user_age"}, indexes)
+
+	// Data, the AUTOINCREMENT counter and the references to the table are kept.
+	var names []string
+	query("SELECT `name` FROM `users` ORDER BY `id`", &names)
+	require.Equal(t, []string{"a8m", "nati"}, names)
+	var seq []int
+	query("SELECT `seq` FROM `sqlite_sequence` WHERE `name` = 'users'", &seq)
+	require.Equal(t, []int{3}, seq)
+	var refs []string
+	query("SELECT `table` FROM pragma_foreign_key_list('pets')", &refs)
+	require.Equal(t, []string{"users"}, refs)
+	err = db.Exec(ctx, "INSERT INTO `pets` (`owner_id`) VALUES (3)", []any{}, nil)
+	require.Error(t, err, "foreign keys are enforced")
+}
+
+func TestMigrate_PartialIndex(t *testing.T) {
+	ctx := context.Background()
+	for _, legacy := range []bool{false, true} {
+		db, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:partial%t?mode=memory&cache=shared&_fk=1", legacy))
+		require.NoError(t, err)
+		users := NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "email", Type: field.TypeString}).
+			AddColumn(&Column{Name: "deleted_at", Type: field.TypeTime, Nullable: true})
+		users.AddIndex("user_email", true, []string{"email"})
+		users.Indexes[0].Annotation = entsql.IndexWhere("`deleted_at` IS NULL")
+		m, err := NewMigrate(db, WithAtlas(!legacy))
+		require.NoError(t, err)
+		require.NoError(t, m.Create(ctx, users))
+		rows := &sql.Rows{}
+		require.NoError(t, db.Query(ctx, "SELECT `sql` FROM `sqlite_master` WHERE `name` = 'user_email'", []any{}, rows))
+		var stmts []string
+		require.NoError(t, sql.ScanSlice(rows, &stmts))
+		require.NoError(t, rows.Close())
+		require.Len(t, stmts, 1)
+		require.Contains(t, stmts[0], "WHERE `deleted_at` IS NULL")
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestMigrate_ExprIndex(t *testing.T) {
+	ctx := context.Background()
+	for _, legacy := range []bool{false, true} {
+		db, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:expr%t?mode=memory&cache=shared&_fk=1", legacy))
+		require.NoError(t, err)
+		users := func() *Table {
+			t := NewTable("users").
+				AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+				AddColumn(&Column{Name: "email", Type: field.TypeString}).
+				AddColumn(&Column{Name: "name", Type: field.TypeString})
+			t.AddIndex("user_email_name", false, []string{"email", "name"})
+			t.Indexes[0].Annotation = &entsql.IndexAnnotation{
+				ExprColumns: map[string]string{"email": "lower(email)"},
+				DescColumns: map[string]bool{"email": true},
+			}
+			return t
+		}
+		m, err := NewMigrate(db, WithAtlas(!legacy))
+		require.NoError(t, err)
+		require.NoError(t, m.Create(ctx, users()))
+		rows := &sql.Rows{}
+		require.NoError(t, db.Query(ctx, "SELECT `sql` FROM `sqlite_master` WHERE `name` = 'user_email_name'", []any{}, rows))
+		var stmts []string
+		require.NoError(t, sql.ScanSlice(rows, &stmts))
+		require.NoError(t, rows.Close())
+		require.Len(t, stmts, 1)
+		require.Contains(t, stmts[0], "((lower(email)) DESC, `name`)")
+		if !legacy {
+			// The index is not changed by the next migration.
+			var b strings.Builder
+			m, err = NewMigrate(db, WithDryRun(&b), WithDropIndex(true))
+			require.NoError(t, err)
+			require.NoError(t, m.Create(ctx, users()))
+			require.NotContains(t, b.String(), "INDEX")
+		}
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestMigrate_Schema(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:schema?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	tables := func(schema string) []*Table {
+		users := NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+		pets := NewTable("pets").
+			SetSchema(schema).
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "owner_id", Type: field.TypeInt, Nullable: true})
+		pets.AddForeignKey(&ForeignKey{
+			Symbol:     "pets_users_pets",
+			Columns:    pets.Columns[1:],
+			RefTable:   users,
+			RefColumns: users.PrimaryKey,
+		})
+		return []*Table{users, pets}
+	}
+
+	// Tables are grouped by their schema, and tables without one belong to the migrated schema.
+	m, err := NewMigrate(db, WithSchemaName("public"))
+	require.NoError(t, err)
+	realm, err := m.StateReader(tables("tenant_a")...).ReadState(ctx)
+	require.NoError(t, err)
+	require.Len(t, realm.Schemas, 2)
+	require.Equal(t, "public", realm.Schemas[0].Name)
+	require.Equal(t, "tenant_a", realm.Schemas[1].Name)
+	require.Equal(t, "pets", realm.Schemas[1].Tables[0].Name)
+	require.Equal(t, realm.Schemas[0], realm.Schemas[1].Tables[0].ForeignKeys[0].RefTable.Schema)
+
+	// Tables are inspected in their schema, and are not re-created by the next migration.
+	m, err = NewMigrate(db, WithSchemaName("main"))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, tables("main")...))
+	for _, name := range []string{"users", "pets"} {
+		exists, err := (&SQLite{Driver: db}).tableExist(ctx, db, name)
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+	var b strings.Builder
+	m, err = NewMigrate(db, WithSchemaName("main"), WithDryRun(&b))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, tables("main")...))
+	require.NotContains(t, b.String(), "CREATE TABLE")
+
+	m, err = NewMigrate(db, WithSchemaName("main"), WithAtlas(false))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, tables("")...), "sql/schema: WithSchemaName is not supported by the legacy migration engine")
+	m, err = NewMigrate(db, WithAtlas(false))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, tables("main")...), `sql/schema: table "pets": schemas are not supported by the legacy migration engine`)
+}
+
 func TestMigrate_Formatter(t *testing.T) {
 	db, _, err := sqlmock.New()
 	require.NoError(t, err)
@@ -433,3 +1148,62 @@ func TestAtlas_StateReader(t *testing.T) {
 		},
 	)
 }
+
+func TestAtlas_ForeignKeyActions(t *testing.T) {
+	for _, tt := range []struct {
+		d         sqlDialect
+		diff      schema.Differ
+		plan      migrate.PlanApplier
+		wantQuery string
+	}{
+		{
+			d:         &Postgres{},
+			diff:      postgres.DefaultDiff,
+			plan:      postgres.DefaultPlan,
+			wantQuery: `ALTER TABLE "pets" DROP CONSTRAINT "pets_users_owner", ADD CONSTRAINT "pets_users_owner" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE CASCADE ON DELETE SET NULL`,
+		},
+		{
+			d:         &MySQL{version: "8.0.19"},
+			diff:      mysql.DefaultDiff,
+			plan:      mysql.DefaultPlan,
+			wantQuery: "ALTER TABLE `pets` DROP FOREIGN KEY `pets_users_owner`; ALTER TABLE `pets` ADD CONSTRAINT `pets_users_owner` FOREIGN KEY (`owner_id`) REFERENCES `users` (`id`) ON UPDATE CASCADE ON DELETE SET NULL",
+		},
+	} {
+		fkTables := func(onUpdate ReferenceOption) []*schema.Table {
+			users := NewTable("users").AddPrimary(&Column{Name: "id", Type: field.TypeInt})
+			pets := NewTable("pets").
+				AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+				AddColumn(&Column{Name: "owner_id", Type: field.TypeInt, Nullable: true})
+			pets.AddForeignKey(&ForeignKey{
+				Symbol:     "pets_users_owner",
+				Columns:    pets.Columns[1:],
+				RefTable:   users,
+				RefColumns: users.Columns[:1],
+				OnUpdate:   onUpdate,
+				OnDelete:   SetNull,
+			})
+			ts, err := (&Atlas{sqlDialect: tt.d}).tables([]*Table{users, pets})
+			require.NoError(t, err)
+			schema.New("").AddTables(ts...)
+			return ts
+		}
+		// An empty action is identical to the default one (NO ACTION).
+		changes, err := tt.diff.TableDiff(fkTables(NoAction)[1], fkTables("")[1])
+		require.NoError(t, err)
+		require.Empty(t, changes)
+
+		changes, err = tt.diff.TableDiff(fkTables(NoAction)[1], fkTables(Cascade)[1])
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		require.Equal(t, schema.ChangeUpdateAction, changes[0].(*schema.ModifyForeignKey).Change)
+		plan, err := tt.plan.PlanChanges(context.Background(), "", []schema.Change{
+			&schema.ModifyTable{T: fkTables(Cascade)[1], Changes: changes},
+		})
+		require.NoError(t, err)
+		cmds := make([]string, len(plan.Changes))
+		for i, c := range plan.Changes {
+			cmds[i] = c.Cmd
+		}
+		require.Equal(t, tt.wantQuery, strings.Join(cmds, "; "))
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
@@ -27,6 +29,7 @@ type MySQL struct {
 	dialect.Driver
 	schema  string
 	version string
+	tidb    string // TiDB release version, if the database is TiDB.
 }
 
 // init loads the MySQL version from the database for later use in the migration process.
@@ -47,9 +50,41 @@ func (d *MySQL) init(ctx context.Context) error {
 		return fmt.Errorf("mysql: scanning mysql version: %w", err)
 	}
 	d.version = version[1]
+	// TiDB reports a MySQL compatible version (e.g. 5.7.25-TiDB-v6.1.0).
+	if strings.Contains(d.version, "TiDB") {
+		return d.initTiDB(ctx)
+	}
+	return nil
+}
+
+// reTiDBVersion extracts the release version from the tidb_version() output.
+var reTiDBVersion = regexp.MustCompile(`Release Version: v?(\d+\.\d+\.\d+)`)
+
+// initTiDB loads the TiDB release version from the database.
+func (d *MySQL) initTiDB(ctx context.Context) error {
+	rows := &sql.Rows{}
+	if err := d.Query(ctx, "SELECT tidb_version()", []any{}, rows); err != nil {
+		return fmt.Errorf("mysql: querying tidb version %w", err)
+	}
+	defer rows.Close()
+	var info string
+	if err := sql.ScanOne(rows, &info); err != nil {
+		return fmt.Errorf("mysql: scanning tidb version: %w", err)
+	}
+	matches := reTiDBVersion.FindStringSubmatch(info)
+	if len(matches) != 2 {
+		return fmt.Errorf("mysql: unexpected tidb version: %q", info)
+	}
+	d.tidb = matches[1]
 	return nil
 }
 
+// multiAlter reports if the database supports multiple changes in a single
+// ALTER TABLE statement. TiDB supports it only from version 6.2.0.
+func (d *MySQL) multiAlter() bool {
+	return d.tidb == "" || compareVersions(d.tidb, "6.2.0") >= 0
+}
+
 func (d *MySQL) tableExist(ctx context.Context, conn dialect.ExecQuerier, name string) (bool, error) {
 	query, args := sql.Select(sql.Count("*")).From(sql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
 		Where(sql.And(
@@ -69,6 +104,28 @@ func (d *MySQL) fkExist(ctx context.Context, tx dialect.Tx, name string) (bool,
 	return exist(ctx, tx, query, args...)
 }
 
+// fkColumns returns the foreign-key constraints of the given table, mapped to their columns (in order).
+func (d *MySQL) fkColumns(ctx context.Context, tx dialect.Tx, table string) (map[string][]string, error) {
+	query, args := sql.Select("CONSTRAINT_NAME", "COLUMN_NAME").From(sql.Table("KEY_COLUMN_USAGE").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("TABLE_NAME", table),
+			sql.NotNull("POSITION_IN_UNIQUE_CONSTRAINT"),
+		)).
+		OrderBy("CONSTRAINT_NAME", "ORDINAL_POSITION").
+		Query()
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("mysql: reading foreign keys of table %q: %w", table, err)
+	}
+	return scanFKColumns(rows)
+}
+
+// dropForeignKey returns the DSL query for dropping a foreign-key constraint.
+func (d *MySQL) dropForeignKey(table, symbol string) sql.Querier {
+	return sql.Dialect(dialect.MySQL).AlterTable(table).DropForeignKey(symbol)
+}
+
 // table loads the current table description from the database.
 func (d *MySQL) table(ctx context.Context, tx dialect.Tx, name string) (*Table, error) {
 	rows := &sql.Rows{}
@@ -146,6 +203,29 @@ func (d *MySQL) verifyRange(ctx context.Context, tx dialect.ExecQuerier, t *Tabl
 	if expected == 0 {
 		return nil
 	}
+	actual, err := d.autoIncrement(ctx, tx, t)
+	if err != nil {
+		return err
+	}
+	// Table is empty and auto-increment is not configured. This can happen
+	// because MySQL (< 8.0) stores the auto-increment counter in main memory
+	// (not persistent), and the value is reset on restart (if table is empty).
+	// The counter is also raised if the range of the type was moved forward,
+	// but it is never lowered, as the existing ids may exceed the new range.
+	if actual < expected {
+		return d.setRange(ctx, tx, t, expected)
+	}
+	return nil
+}
+
+// autoIncrement returns the auto-increment counter of the given table.
+func (d *MySQL) autoIncrement(ctx context.Context, tx dialect.ExecQuerier, t *Table) (int64, error) {
+	// TiDB reports a false value in INFORMATION_SCHEMA.TABLES for tables that
+	// were altered (see, https://github.com/pingcap/tidb/issues/24702). Hence,
+	// the value is extracted from the CREATE TABLE statement.
+	if d.tidb != "" {
+		return d.tidbAutoIncrement(ctx, tx, t)
+	}
 	rows := &sql.Rows{}
 	query, args := sql.Select("AUTO_INCREMENT").
 		From(sql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
@@ -155,24 +235,47 @@ func (d *MySQL) verifyRange(ctx context.Context, tx dialect.ExecQuerier, t *Tabl
 		)).
 		Query()
 	if err := tx.Query(ctx, query, args, rows); err != nil {
-		return fmt.Errorf("mysql: query auto_increment %w", err)
+		return 0, fmt.Errorf("mysql: query auto_increment %w", err)
 	}
 	// Call Close in cases of failures (Close is idempotent).
 	defer rows.Close()
 	actual := &sql.NullInt64{}
 	if err := sql.ScanOne(rows, actual); err != nil {
-		return fmt.Errorf("mysql: scan auto_increment %w", err)
+		return 0, fmt.Errorf("mysql: scan auto_increment %w", err)
+	}
+	return actual.Int64, rows.Close()
+}
+
+// reAutoIncrement extracts the auto-increment counter from the CREATE TABLE statement.
+var reAutoIncrement = regexp.MustCompile(`(?i)\s+AUTO_INCREMENT\s*=\s*(\d+)(?:\s+|$)`)
+
+// tidbAutoIncrement returns the auto-increment counter of the given table in TiDB.
+func (d *MySQL) tidbAutoIncrement(ctx context.Context, tx dialect.ExecQuerier, t *Table) (int64, error) {
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, fmt.Sprintf("SHOW CREATE TABLE `%s`", t.Name), []any{}, rows); err != nil {
+		return 0, fmt.Errorf("mysql: query create table statement %w", err)
+	}
+	// Call Close in cases of failures (Close is idempotent).
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, fmt.Errorf("mysql: create table statement of %q was not found", t.Name)
+	}
+	var name, stmt string
+	if err := rows.Scan(&name, &stmt); err != nil {
+		return 0, fmt.Errorf("mysql: scan create table statement: %w", err)
 	}
 	if err := rows.Close(); err != nil {
-		return err
+		return 0, err
 	}
-	// Table is empty and auto-increment is not configured. This can happen
-	// because MySQL (< 8.0) stores the auto-increment counter in main memory
-	// (not persistent), and the value is reset on restart (if table is empty).
-	if actual.Int64 <= 1 {
-		return d.setRange(ctx, tx, t, expected)
+	// The table option is omitted in case the counter was not set.
+	matches := reAutoIncrement.FindStringSubmatch(stmt)
+	if len(matches) != 2 {
+		return 0, nil
 	}
-	return nil
+	return strconv.ParseInt(matches[1], 10, 64)
 }
 
 // tBuilder returns the MySQL DSL query for table creation.
@@ -187,15 +290,22 @@ func (d *MySQL) tBuilder(t *Table) *sql.TableBuilder {
 	// Charset and collation config on MySQL table.
 	// These options can be overridden by the entsql annotation.
 	b.Charset("utf8mb4").Collate("utf8mb4_bin")
+	if charset := t.charset(); charset != "" {
+		b.Charset(charset)
+	}
+	if collate := t.collation(); collate != "" {
+		b.Collate(collate)
+	}
 	if t.Annotation != nil {
-		if charset := t.Annotation.Charset; charset != "" {
-			b.Charset(charset)
+		opts := tableOptionsClauses(t.Annotation)
+		if v := t.Annotation.AutoIncrement; v > 0 {
+			opts = append(opts, "AUTO_INCREMENT="+strconv.FormatInt(v, 10))
 		}
-		if collate := t.Annotation.Collation; collate != "" {
-			b.Collate(collate)
+		if o := t.Annotation.Options; o != "" {
+			opts = append(opts, o)
 		}
-		if opts := t.Annotation.Options; opts != "" {
-			b.Options(opts)
+		if len(opts) > 0 {
+			b.Options(strings.Join(opts, " "))
 		}
 		addChecks(b, t.Annotation)
 	}
@@ -243,10 +353,7 @@ func (d *MySQL) cType(c *Column) (t string) {
 			t = "longblob"
 		}
 	case field.TypeJSON:
-		t = "json"
-		if compareVersions(d.version, "5.7.8") == -1 {
-			t = "longblob"
-		}
+		t = d.jsonType()
 	case field.TypeString:
 		size := c.Size
 		if size == 0 {
@@ -291,7 +398,11 @@ func (d *MySQL) cType(c *Column) (t string) {
 // addColumn returns the DSL query for adding the given column to a table.
 // The syntax/order is: datatype [Charset] [Unique|Increment] [Collation] [Nullable].
 func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
-	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	b := sql.Column(c.Name).Type(d.cType(c))
+	if c.Charset != "" {
+		b.Attr("CHARACTER SET " + c.Charset)
+	}
+	b.Attr(c.Attr)
 	c.unique(b)
 	if c.Increment {
 		b.Attr("AUTO_INCREMENT")
@@ -303,8 +414,9 @@ func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
 	}
 	if c.Type == field.TypeJSON {
 		// Manually add a `CHECK` clause for older versions of MariaDB for validating the
-		// JSON documents. This constraint is automatically included from version 10.4.3.
-		if version, ok := d.mariadb(); ok && compareVersions(version, "10.4.3") == -1 {
+		// JSON documents. This constraint is automatically included from version 10.4.3,
+		// and versions that do not support the JSON alias store the documents as-is.
+		if version, ok := d.mariadb(); ok && compareVersions(version, "10.4.3") == -1 && d.jsonType() == mysql.TypeJSON {
 			b.Check(func(b *sql.Builder) {
 				b.WriteString("JSON_VALID(").Ident(c.Name).WriteByte(')')
 			})
@@ -315,19 +427,20 @@ func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
 
 // addIndex returns the querying for adding an index to MySQL.
 func (d *MySQL) addIndex(i *Index, table string) *sql.IndexBuilder {
-	idx := sql.CreateIndex(i.Name).Table(table)
+	idx := sql.Dialect(dialect.MySQL).CreateIndex(i.Name).Table(table)
 	if i.Unique {
 		idx.Unique()
 	}
+	if t, ok := indexType(i, dialect.MySQL); ok {
+		idx.Using(t)
+	}
 	parts := indexParts(i)
-	for _, c := range i.Columns {
-		part, ok := parts[c.Name]
-		if !ok || part == 0 {
-			idx.Column(c.Name)
-		} else {
-			idx.Column(fmt.Sprintf("%s(%d)", idx.Builder.Quote(c.Name), part))
+	i.columnsTo(idx, func(c *Column) (string, bool) {
+		if part := parts[c.Name]; part > 0 {
+			return fmt.Sprintf("%s(%d)", idx.Builder.Quote(c.Name), part), true
 		}
-	}
+		return "", false
+	})
 	return idx
 }
 
@@ -516,7 +629,7 @@ func (d *MySQL) scanIndexes(rows *sql.Rows, t *Table) (Indexes, error) {
 	for rows.Next() {
 		var (
 			name     string
-			column   string
+			column   sql.NullString
 			nonuniq  bool
 			seqindex int
 			subpart  sql.NullInt64
@@ -526,9 +639,9 @@ func (d *MySQL) scanIndexes(rows *sql.Rows, t *Table) (Indexes, error) {
 		}
 		// Skip primary keys.
 		if name == "PRIMARY" {
-			c, ok := t.column(column)
+			c, ok := t.column(column.String)
 			if !ok {
-				return nil, fmt.Errorf("missing primary-key column: %q", column)
+				return nil, fmt.Errorf("missing primary-key column: %q", column.String)
 			}
 			t.PrimaryKey = append(t.PrimaryKey, c)
 			continue
@@ -539,12 +652,16 @@ func (d *MySQL) scanIndexes(rows *sql.Rows, t *Table) (Indexes, error) {
 			i = append(i, idx)
 			names[name] = idx
 		}
-		idx.columns = append(idx.columns, column)
+		// Functional key parts (expressions) have no column name.
+		if !column.Valid {
+			continue
+		}
+		idx.columns = append(idx.columns, column.String)
 		if subpart.Int64 > 0 {
 			if idx.Annotation.PrefixColumns == nil {
 				idx.Annotation.PrefixColumns = make(map[string]uint)
 			}
-			idx.Annotation.PrefixColumns[column] = uint(subpart.Int64)
+			idx.Annotation.PrefixColumns[column.String] = uint(subpart.Int64)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -555,9 +672,9 @@ func (d *MySQL) scanIndexes(rows *sql.Rows, t *Table) (Indexes, error) {
 
 // isImplicitIndex reports if the index was created implicitly for the unique column.
 func (d *MySQL) isImplicitIndex(idx *Index, col *Column) bool {
-	// We execute `CHANGE COLUMN` on older versions of MySQL (<8.0), which
-	// auto create the new index. The old one, will be dropped in `changeSet`.
-	if compareVersions(d.version, "8.0.0") >= 0 {
+	// We execute `CHANGE COLUMN` on older versions of MySQL (<8.0) and MariaDB (<10.5.2),
+	// which auto create the new index. The old one, will be dropped in `changeSet`.
+	if d.versionAtLeast("8.0.0", "10.5.2") {
 		return idx.Name == col.Name && col.Unique
 	}
 	return false
@@ -567,7 +684,7 @@ func (d *MySQL) isImplicitIndex(idx *Index, col *Column) bool {
 // MySQL based on its version.
 func (d *MySQL) renameColumn(t *Table, old, new *Column) sql.Querier {
 	q := sql.AlterTable(t.Name)
-	if compareVersions(d.version, "8.0.0") >= 0 {
+	if d.versionAtLeast("8.0.0", "10.5.2") {
 		return q.RenameColumn(old.Name, new.Name)
 	}
 	return q.ChangeColumn(old.Name, d.addColumn(new))
@@ -576,7 +693,7 @@ func (d *MySQL) renameColumn(t *Table, old, new *Column) sql.Querier {
 // renameIndex returns the statement for renaming an index.
 func (d *MySQL) renameIndex(t *Table, old, new *Index) sql.Querier {
 	q := sql.AlterTable(t.Name)
-	if compareVersions(d.version, "5.7.0") >= 0 {
+	if d.versionAtLeast("5.7.0", "10.5.2") {
 		return q.RenameIndex(old.Name, new.Name)
 	}
 	return q.DropIndex(old.Name).AddIndex(new.Builder(t.Name))
@@ -601,8 +718,42 @@ func (d *MySQL) tables() sql.Querier {
 		Where(d.matchSchema())
 }
 
+// foreignKeys populates the tables foreign keys using the INFORMATION_SCHEMA tables.
+func (d *MySQL) foreignKeys(ctx context.Context, tx dialect.Tx, tables []*Table) error {
+	lookup := make(map[string]*Table, len(tables))
+	for _, t := range tables {
+		lookup[t.Name] = t
+	}
+	for _, t := range tables {
+		rows := &sql.Rows{}
+		query, args := sql.Select("CONSTRAINT_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME").
+			From(sql.Table("KEY_COLUMN_USAGE").Schema("INFORMATION_SCHEMA")).
+			Where(sql.And(
+				d.matchSchema(),
+				sql.EQ("TABLE_NAME", t.Name),
+				d.matchSchema("REFERENCED_TABLE_SCHEMA"),
+			)).
+			OrderBy("CONSTRAINT_NAME", "ORDINAL_POSITION").
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return fmt.Errorf("mysql: reading foreign keys of table %q: %w", t.Name, err)
+		}
+		if err := scanForeignKeys(rows, lookup, t); err != nil {
+			rows.Close()
+			return fmt.Errorf("mysql: %w", err)
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // alterColumns returns the queries for applying the columns change-set.
 func (d *MySQL) alterColumns(table string, add, modify, drop []*Column) sql.Queries {
+	if !d.multiAlter() {
+		return d.splitColumns(table, add, modify, drop)
+	}
 	b := sql.Dialect(dialect.MySQL).AlterTable(table)
 	for _, c := range add {
 		b.AddColumn(d.addColumn(c))
@@ -619,6 +770,23 @@ func (d *MySQL) alterColumns(table string, add, modify, drop []*Column) sql.Quer
 	return sql.Queries{b}
 }
 
+// splitColumns returns an ALTER TABLE statement for each change in the columns
+// change-set, for databases that do not support multiple changes in a single
+// statement (e.g. TiDB < 6.2).
+func (d *MySQL) splitColumns(table string, add, modify, drop []*Column) sql.Queries {
+	var queries sql.Queries
+	for _, c := range add {
+		queries = append(queries, sql.Dialect(dialect.MySQL).AlterTable(table).AddColumn(d.addColumn(c)))
+	}
+	for _, c := range modify {
+		queries = append(queries, sql.Dialect(dialect.MySQL).AlterTable(table).ModifyColumn(d.addColumn(c)))
+	}
+	for _, c := range drop {
+		queries = append(queries, sql.Dialect(dialect.MySQL).AlterTable(table).DropColumn(sql.Dialect(dialect.MySQL).Column(c.Name)))
+	}
+	return queries
+}
+
 // normalizeJSON normalize MariaDB longtext columns to type JSON.
 func (d *MySQL) normalizeJSON(ctx context.Context, tx dialect.Tx, t *Table) error {
 	columns := make(map[string]*Column)
@@ -664,12 +832,36 @@ func (d *MySQL) normalizeJSON(ctx context.Context, tx dialect.Tx, t *Table) erro
 }
 
 // mariadb reports if the migration runs on MariaDB and returns the semver string.
+// Note that the version may be prefixed with "5.5.5-" for compatibility with old
+// MySQL clients (e.g. "5.5.5-10.5.8-MariaDB").
 func (d *MySQL) mariadb() (string, bool) {
 	idx := strings.Index(d.version, "MariaDB")
 	if idx == -1 {
 		return "", false
 	}
-	return d.version[:idx-1], true
+	return strings.TrimPrefix(strings.TrimSuffix(d.version[:idx], "-"), "5.5.5-"), true
+}
+
+// versionAtLeast reports if the database version is at least the given
+// MySQL version, or the given MariaDB version if it runs on MariaDB.
+func (d *MySQL) versionAtLeast(mysqlV, mariaV string) bool {
+	if v, ok := d.mariadb(); ok {
+		return compareVersions(v, mariaV) >= 0
+	}
+	return compareVersions(d.version, mysqlV) >= 0
+}
+
+// jsonType returns the column type of JSON columns. MySQL supports the JSON type from
+// version 5.7.8, and MariaDB supports it as an alias of LONGTEXT from version 10.2.7.
+func (d *MySQL) jsonType() string {
+	switch _, maria := d.mariadb(); {
+	case d.versionAtLeast("5.7.8", "10.2.7"):
+		return mysql.TypeJSON
+	case maria:
+		return mysql.TypeLongText
+	default:
+		return mysql.TypeLongBlob
+	}
 }
 
 // parseColumn returns column parts, size and signed-info from a MySQL type.
@@ -728,13 +920,9 @@ func (d *MySQL) fkNames(ctx context.Context, tx dialect.Tx, table, column string
 // index prefix key limit (767) for older versions of MySQL/MariaDB.
 func (d *MySQL) defaultSize(c *Column) int64 {
 	size := DefaultStringLen
-	version, checked := d.version, "5.7.0"
-	if v, ok := d.mariadb(); ok {
-		version, checked = v, "10.2.2"
-	}
 	switch {
 	// Version is >= 5.7 for MySQL, or >= 10.2.2 for MariaDB.
-	case compareVersions(version, checked) != -1:
+	case d.versionAtLeast("5.7.0", "10.2.2"):
 	// Column is non-unique, or not part of any index (reaching
 	// the error 1071).
 	case !c.Unique && len(c.indexes) == 0 && !c.PrimaryKey():
@@ -790,14 +978,22 @@ func (d *MySQL) atOpen(conn dialect.ExecQuerier) (migrate.Driver, error) {
 
 func (d *MySQL) atTable(t1 *Table, t2 *schema.Table) {
 	t2.SetCharset("utf8mb4").SetCollation("utf8mb4_bin")
+	if charset := t1.charset(); charset != "" {
+		t2.SetCharset(charset)
+	}
+	if collate := t1.collation(); collate != "" {
+		t2.SetCollation(collate)
+	}
 	if t1.Annotation == nil {
 		return
 	}
-	if charset := t1.Annotation.Charset; charset != "" {
-		t2.SetCharset(charset)
+	if opts := tableOptionsClauses(t1.Annotation); len(opts) > 0 {
+		t2.AddAttrs(&mysql.CreateOptions{
+			V: strings.Join(opts, " "),
+		})
 	}
-	if collate := t1.Annotation.Collation; collate != "" {
-		t2.SetCollation(collate)
+	if v := t1.Annotation.AutoIncrement; v > 0 {
+		t2.AddAttrs(&mysql.AutoIncrement{V: v})
 	}
 	if opts := t1.Annotation.Options; opts != "" {
 		t2.AddAttrs(&mysql.CreateOptions{
@@ -806,29 +1002,57 @@ func (d *MySQL) atTable(t1 *Table, t2 *schema.Table) {
 	}
 	// Check if the connected database supports the CHECK clause.
 	// For MySQL, is >= "8.0.16" and for MariaDB it is "10.2.1".
-	v1, v2 := d.version, "8.0.16"
-	if v, ok := d.mariadb(); ok {
-		v1, v2 = v, "10.2.1"
-	}
-	if compareVersions(v1, v2) >= 0 {
+	if d.versionAtLeast("8.0.16", "10.2.1") {
 		setAtChecks(t1, t2)
 	}
 }
 
+func (d *MySQL) atPartition(t1 *Table, t2 *schema.Table) error {
+	p, err := t1.partition("RANGE", "RANGE COLUMNS", "LIST", "LIST COLUMNS", "HASH", "LINEAR HASH", "KEY", "LINEAR KEY")
+	if err != nil || p == nil {
+		return err
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.MySQL)
+	b.WriteString("PARTITION BY ").WriteString(strings.ToUpper(p.Type)).WriteByte(' ')
+	b.Wrap(func(b *sql.Builder) {
+		for i, c := range p.Columns {
+			if i > 0 {
+				b.Comma()
+			}
+			if _, ok := t2.Column(c); ok {
+				b.Ident(c)
+			} else {
+				b.WriteString(c)
+			}
+		}
+	})
+	if p.Definitions != "" {
+		b.WriteByte(' ').Wrap(func(b *sql.Builder) {
+			b.WriteString(p.Definitions)
+		})
+	}
+	// Partitioning is the last clause of the CREATE TABLE statement.
+	t2.AddAttrs(&mysql.CreateOptions{V: b.String()})
+	return nil
+}
+
 func (d *MySQL) supportsDefault(c *Column) bool {
 	_, maria := d.mariadb()
 	switch c.Default.(type) {
 	case Expr, map[string]Expr:
-		if maria {
-			return compareVersions(d.version, "10.2.0") >= 0
-		}
-		return c.supportDefault() && compareVersions(d.version, "8.0.0") >= 0
+		return (c.supportDefault() || maria) && d.versionAtLeast("8.0.0", "10.2.1")
 	default:
-		return c.supportDefault() || maria
+		// MariaDB supports literal defaults for BLOB, TEXT
+		// and JSON columns from version 10.2.1.
+		return c.supportDefault() || maria && d.versionAtLeast("8.0.0", "10.2.1")
 	}
 }
 
 func (d *MySQL) atTypeC(c1 *Column, c2 *schema.Column) error {
+	if c1.Charset != "" {
+		c2.SetCharset(c1.Charset)
+	}
 	if c1.SchemaType != nil && c1.SchemaType[dialect.MySQL] != "" {
 		t, err := mysql.ParseType(strings.ToLower(c1.SchemaType[dialect.MySQL]))
 		if err != nil {
@@ -873,9 +1097,13 @@ func (d *MySQL) atTypeC(c1 *Column, c2 *schema.Column) error {
 			t = &schema.BinaryType{T: mysql.TypeLongBlob}
 		}
 	case field.TypeJSON:
-		t = &schema.JSONType{T: mysql.TypeJSON}
-		if compareVersions(d.version, "5.7.8") == -1 {
-			t = &schema.BinaryType{T: mysql.TypeLongBlob}
+		switch typ := d.jsonType(); typ {
+		case mysql.TypeJSON:
+			t = &schema.JSONType{T: typ}
+		case mysql.TypeLongText:
+			t = &schema.StringType{T: typ}
+		default:
+			t = &schema.BinaryType{T: typ}
 		}
 	case field.TypeString:
 		size := c1.Size
@@ -930,7 +1158,12 @@ func (d *MySQL) atUniqueC(t1 *Table, c1 *Column, t2 *schema.Table, c2 *schema.Co
 			return
 		}
 	}
-	t2.AddIndexes(schema.NewUniqueIndex(c1.Name).AddColumns(c2))
+	part := &schema.IndexPart{C: c2}
+	// Unique TEXT and BLOB columns require a prefix length for their index.
+	if c1.Prefix > 0 {
+		part.AddAttrs(&mysql.SubPart{Len: int(c1.Prefix)})
+	}
+	t2.AddIndexes(schema.NewUniqueIndex(c1.Name).AddParts(part))
 }
 
 func (d *MySQL) atIncrementC(t *schema.Table, c *schema.Column) {
@@ -942,9 +1175,119 @@ func (d *MySQL) atIncrementC(t *schema.Table, c *schema.Column) {
 }
 
 func (d *MySQL) atIncrementT(t *schema.Table, v int64) {
+	// The id range of the table takes precedence over the configured AUTO_INCREMENT.
+	t.Attrs = removeAttr(t.Attrs, reflect.TypeOf(&mysql.AutoIncrement{}))
 	t.AddAttrs(&mysql.AutoIncrement{V: v})
 }
 
+// tableOptions describes the options of an existing table, as reported by INFORMATION_SCHEMA.TABLES
+// in MySQL, or by the pg_class catalog in PostgreSQL.
+type tableOptions struct {
+	engine, rowFormat string
+	create            map[string]string // options that were set explicitly (e.g. row_format and key_block_size).
+	persistence       string            // relpersistence of PostgreSQL tables. 'p' for permanent or 'u' for unlogged.
+}
+
+// tableOptions returns the options of the existing tables that are configured with an engine,
+// a row format or a key block size, keyed by their names.
+func (d *MySQL) tableOptions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*tableOptions, error) {
+	var names []any
+	for _, t := range tables {
+		if len(tableOptionsClauses(t.Annotation)) > 0 {
+			names = append(names, t.Name)
+		}
+	}
+	state := make(map[string]*tableOptions)
+	if len(names) == 0 {
+		return state, nil
+	}
+	query, args := sql.Select("TABLE_NAME", "ENGINE", "ROW_FORMAT", "CREATE_OPTIONS").
+		From(sql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
+		Where(sql.And(d.matchSchema(), sql.In("TABLE_NAME", names...))).
+		Query()
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("mysql: querying table options: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			name                      string
+			engine, format, createOpt sql.NullString
+		)
+		if err := rows.Scan(&name, &engine, &format, &createOpt); err != nil {
+			return nil, fmt.Errorf("mysql: scanning table options: %w", err)
+		}
+		opts := &tableOptions{engine: engine.String, rowFormat: format.String, create: make(map[string]string)}
+		for _, opt := range strings.Fields(createOpt.String) {
+			if k, v, ok := strings.Cut(opt, "="); ok {
+				opts.create[strings.ToLower(k)] = strings.Trim(v, `'"`)
+			}
+		}
+		state[name] = opts
+	}
+	return state, rows.Err()
+}
+
+// tableOptionsChanges returns the changes for altering the engine, the row format
+// and the key block size of the existing tables, in case they were changed.
+func (d *MySQL) tableOptionsChanges(tables []*Table, state map[string]*tableOptions, _ []*migrate.Change) []*migrate.Change {
+	var changes []*migrate.Change
+	for _, t := range tables {
+		opts, ok := state[t.Name]
+		if !ok || t.Annotation == nil {
+			continue
+		}
+		var alter []string
+		if e := t.Annotation.Engine; e != nil && e.Name != "" && !strings.EqualFold(e.Name, opts.engine) {
+			alter = append(alter, "ENGINE="+e.Name)
+		}
+		if f := t.Annotation.RowFormat; f != "" {
+			current, ok := opts.create["row_format"]
+			switch {
+			case !ok && strings.EqualFold(f, "DEFAULT"):
+			case !ok && strings.EqualFold(f, opts.rowFormat):
+			case !strings.EqualFold(f, current):
+				alter = append(alter, "ROW_FORMAT="+strings.ToUpper(f))
+			}
+		}
+		if s := t.Annotation.KeyBlockSize; s > 0 && opts.create["key_block_size"] != strconv.Itoa(s) {
+			alter = append(alter, "KEY_BLOCK_SIZE="+strconv.Itoa(s))
+		}
+		if len(alter) == 0 {
+			continue
+		}
+		b := sql.Dialect(dialect.MySQL).Table(t.Name)
+		table := b.Quote(t.Name)
+		if t.Schema != "" {
+			table = b.Quote(t.Schema) + "." + table
+		}
+		changes = append(changes, &migrate.Change{
+			Cmd:     fmt.Sprintf("ALTER TABLE %s %s", table, strings.Join(alter, " ")),
+			Comment: fmt.Sprintf("modify options of %q table", t.Name),
+		})
+	}
+	return changes
+}
+
+// tableOptionsClauses returns the MySQL table options that are configured by the annotation.
+func tableOptionsClauses(ant *entsql.Annotation) []string {
+	if ant == nil {
+		return nil
+	}
+	var opts []string
+	if e := ant.Engine; e != nil && e.Name != "" {
+		opts = append(opts, "ENGINE="+e.Name)
+	}
+	if f := ant.RowFormat; f != "" {
+		opts = append(opts, "ROW_FORMAT="+strings.ToUpper(f))
+	}
+	if s := ant.KeyBlockSize; s > 0 {
+		opts = append(opts, "KEY_BLOCK_SIZE="+strconv.Itoa(s))
+	}
+	return opts
+}
+
 func (d *MySQL) atImplicitIndexName(idx *Index, c1 *Column) bool {
 	if idx.Name == c1.Name {
 		return true
@@ -986,6 +1329,14 @@ func indexType(idx *Index, d string) (string, bool) {
 	if ant.Type != "" {
 		return ant.Type, true
 	}
+	if ant.FullText {
+		switch d {
+		case dialect.MySQL:
+			return "FULLTEXT", true
+		case dialect.Postgres:
+			return "GIN", true
+		}
+	}
 	return "", false
 }
 
@@ -995,3 +1346,62 @@ func (MySQL) atTypeRangeSQL(ts ...string) string {
 	}
 	return fmt.Sprintf("INSERT INTO `%s` (`type`) VALUES %s", TypeTable, strings.Join(ts, ", "))
 }
+
+// setTimeouts sets the lock_wait_timeout of the session. MySQL does not support limiting the
+// execution time of DDL statements, and therefore, the statement timeout is ignored. Session
+// variables outlive the transaction, and therefore, the previous value is restored explicitly.
+func (d *MySQL) setTimeouts(ctx context.Context, conn dialect.ExecQuerier, _, lock time.Duration) (func(context.Context) error, error) {
+	if lock <= 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, "SELECT @@SESSION.lock_wait_timeout", []any{}, rows); err != nil {
+		return nil, fmt.Errorf("query lock_wait_timeout: %w", err)
+	}
+	var prev int64
+	err := sql.ScanOne(rows, &prev)
+	if cerr := rows.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scan lock_wait_timeout: %w", err)
+	}
+	// The timeout is set in seconds, with a minimum of 1.
+	secs := int64(math.Ceil(lock.Seconds()))
+	if err := conn.Exec(ctx, fmt.Sprintf("SET SESSION lock_wait_timeout = %d", secs), []any{}, nil); err != nil {
+		return nil, fmt.Errorf("set lock_wait_timeout: %w", err)
+	}
+	return func(ctx context.Context) error {
+		if err := conn.Exec(ctx, fmt.Sprintf("SET SESSION lock_wait_timeout = %d", prev), []any{}, nil); err != nil {
+			return fmt.Errorf("restore lock_wait_timeout: %w", err)
+		}
+		return nil
+	}, nil
+}
+
+// migrationLock acquires a named lock. Named locks are bound to the session in MySQL,
+// and therefore, the lock is released explicitly before the connection is returned to the pool.
+func (d *MySQL) migrationLock(ctx context.Context, conn dialect.ExecQuerier) (func(context.Context) error, error) {
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, "SELECT GET_LOCK(?, ?)", []any{migrationLockName, migrationLockTimeout}, rows); err != nil {
+		return nil, fmt.Errorf("acquire migration lock: %w", err)
+	}
+	var locked sql.NullInt64
+	err := sql.ScanOne(rows, &locked)
+	if cerr := rows.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("acquire migration lock: %w", err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return nil, fmt.Errorf("acquire migration lock: timed out after %d seconds", migrationLockTimeout)
+	}
+	return func(ctx context.Context) error {
+		rows := &sql.Rows{}
+		if err := conn.Query(ctx, "SELECT RELEASE_LOCK(?)", []any{migrationLockName}, rows); err != nil {
+			return fmt.Errorf("release migration lock: %w", err)
+		}
+		return rows.Close()
+	}, nil
+}
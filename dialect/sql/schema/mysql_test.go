@@ -10,12 +10,15 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/schema"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
@@ -96,7 +99,7 @@ func TestMySQL_Create(t *testing.T) {
 					Columns: []*Column{
 						{Name: "id", Type: field.TypeInt, Increment: true},
 						{Name: "name", Type: field.TypeString, Nullable: true},
-						{Name: "address", Type: field.TypeString, Nullable: true, Collation: "utf8_unicode_ci"},
+						{Name: "address", Type: field.TypeString, Nullable: true, Charset: "utf8", Collation: "utf8_unicode_ci"},
 						{Name: "age", Type: field.TypeInt},
 						{Name: "doc", Type: field.TypeJSON, Nullable: true},
 						{Name: "enums", Type: field.TypeEnum, Enums: []string{"a", "b"}},
@@ -114,7 +117,7 @@ func TestMySQL_Create(t *testing.T) {
 			before: func(mock mysqlMock) {
 				mock.start("5.7.33")
 				mock.tableExists("users", false)
-				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `name` varchar(255) NULL, `address` varchar(255) NULL COLLATE utf8_unicode_ci, `age` bigint NOT NULL, `doc` json NULL, `enums` enum('a', 'b') NOT NULL, `uuid` char(36) binary NULL, `datetime` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP, `decimal` decimal(6,2) NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8 COLLATE utf8_general_ci ENGINE = INNODB")).
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `name` varchar(255) NULL, `address` varchar(255) CHARACTER SET utf8 NULL COLLATE utf8_unicode_ci, `age` bigint NOT NULL, `doc` json NULL, `enums` enum('a', 'b') NOT NULL, `uuid` char(36) binary NULL, `datetime` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP, `decimal` decimal(6,2) NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8 COLLATE utf8_general_ci ENGINE = INNODB")).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
 			},
@@ -844,6 +847,42 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "add fulltext index",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "text", Type: field.TypeString, Size: math.MaxInt32, Nullable: true},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Indexes: []*Index{
+						{Name: "text_fulltext", Annotation: &entsql.IndexAnnotation{Types: map[string]string{dialect.MySQL: "FULLTEXT", dialect.Postgres: "GIN"}}},
+					},
+				}
+				t.Indexes[0].Columns = t.Columns[1:]
+				return []*Table{t}
+			}(),
+			before: func(mock mysqlMock) {
+				mock.start("5.7.23")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name`, `numeric_precision`, `numeric_scale` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "", nil, nil).
+						AddRow("text", "longtext", "YES", "NO", "NULL", "", "", "", nil, nil))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`,  `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "sub_part", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", nil, "0", "1"))
+				mock.ExpectExec(escape("CREATE FULLTEXT INDEX `text_fulltext` ON `users`(`text`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "ignore foreign keys on index dropping",
 			tables: []*Table{
@@ -972,6 +1011,53 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "drop stale foreign keys",
+			tables: func() []*Table {
+				c1 := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "spouse_id", Type: field.TypeInt, Nullable: true},
+					{Name: "tenant_id", Type: field.TypeInt},
+				}
+				t1 := &Table{Name: "users", Columns: c1, PrimaryKey: c1[0:1]}
+				t1.AddForeignKey(&ForeignKey{Symbol: "users_spouse", Columns: c1[1:2], RefTable: t1, RefColumns: c1[0:1]})
+				t1.AddForeignKey(&ForeignKey{Symbol: "users_tenant", Columns: []*Column{c1[2], c1[1]}, RefTable: t1, RefColumns: []*Column{c1[2], c1[0]}})
+				return []*Table{t1}
+			}(),
+			options: []MigrateOption{WithDropForeignKey(true)},
+			before: func(mock mysqlMock) {
+				mock.start("5.7.23")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name`, `numeric_precision`, `numeric_scale` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "", nil, nil).
+						AddRow("spouse_id", "bigint(20)", "YES", "NULL", "NULL", "", "", "", nil, nil).
+						AddRow("parent_id", "bigint(20)", "YES", "NULL", "NULL", "", "", "", nil, nil).
+						AddRow("tenant_id", "bigint(20)", "NO", "NULL", "NULL", "", "", "", nil, nil))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`,  `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "sub_part", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", nil, "0", "1"))
+				// the parent edge was removed from the schema, but its column is kept.
+				mock.ExpectQuery(escape("SELECT `CONSTRAINT_NAME`, `COLUMN_NAME` FROM `INFORMATION_SCHEMA`.`KEY_COLUMN_USAGE` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? AND `POSITION_IN_UNIQUE_CONSTRAINT` IS NOT NULL ORDER BY `CONSTRAINT_NAME`, `ORDINAL_POSITION`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"CONSTRAINT_NAME", "COLUMN_NAME"}).
+						AddRow("users_parent", "parent_id").
+						AddRow("users_spouse", "spouse_id").
+						AddRow("users_tenant", "tenant_id"))
+				mock.ExpectExec(escape("ALTER TABLE `users` DROP FOREIGN KEY `users_parent`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				// the columns of the foreign key were changed, and it is re-created.
+				mock.ExpectExec(escape("ALTER TABLE `users` DROP FOREIGN KEY `users_tenant`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.fkExists("users_spouse", true)
+				mock.fkExists("users_tenant", false)
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD CONSTRAINT `users_tenant` FOREIGN KEY(`tenant_id`, `spouse_id`) REFERENCES `users`(`tenant_id`, `id`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add edge to table",
 			tables: func() []*Table {
@@ -1322,6 +1408,50 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "mariadb/10.1.48/create table with json",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "json", Type: field.TypeJSON, Nullable: true},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("10.1.48-MariaDB-1~bionic")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `json` longtext NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "mariadb/5.5.5-10.3.13/create table",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "json", Type: field.TypeJSON, Nullable: true},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("5.5.5-10.3.13-MariaDB")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `json` json NULL CHECK (JSON_VALID(`json`)), PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "mariadb/10.1.37/create table",
 			tables: []*Table{
@@ -1408,3 +1538,374 @@ func escape(query string) string {
 	query = strings.Join(rows, " ")
 	return strings.TrimSpace(regexp.QuoteMeta(query)) + "$"
 }
+
+func TestMySQL_MariaDB(t *testing.T) {
+	tests := []struct {
+		version      string
+		json         string
+		renameColumn string
+		renameIndex  string
+		textDefault  bool
+		exprDefault  bool
+	}{
+		{
+			version:      "5.7.26",
+			json:         "json",
+			renameColumn: "ALTER TABLE `users` CHANGE COLUMN `name` `nickname` varchar(255) NOT NULL",
+			renameIndex:  "ALTER TABLE `users` RENAME INDEX `name` TO `nickname`",
+		},
+		{
+			version:      "8.0.19",
+			json:         "json",
+			renameColumn: "ALTER TABLE `users` RENAME COLUMN `name` TO `nickname`",
+			renameIndex:  "ALTER TABLE `users` RENAME INDEX `name` TO `nickname`",
+			exprDefault:  true,
+		},
+		{
+			version:      "10.1.48-MariaDB-1~bionic",
+			json:         "longtext",
+			renameColumn: "ALTER TABLE `users` CHANGE COLUMN `name` `nickname` varchar(255) NOT NULL",
+			renameIndex:  "ALTER TABLE `users` DROP INDEX `name`, ADD INDEX `nickname`(`name`)",
+		},
+		{
+			version:      "10.3.13-MariaDB-1:10.3.13+maria~bionic",
+			json:         "json",
+			renameColumn: "ALTER TABLE `users` CHANGE COLUMN `name` `nickname` varchar(255) NOT NULL",
+			renameIndex:  "ALTER TABLE `users` DROP INDEX `name`, ADD INDEX `nickname`(`name`)",
+			textDefault:  true,
+			exprDefault:  true,
+		},
+		{
+			version:      "5.5.5-10.5.8-MariaDB",
+			json:         "json",
+			renameColumn: "ALTER TABLE `users` RENAME COLUMN `name` TO `nickname`",
+			renameIndex:  "ALTER TABLE `users` RENAME INDEX `name` TO `nickname`",
+			textDefault:  true,
+			exprDefault:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			d := &MySQL{version: tt.version}
+			require.Equal(t, tt.json, d.cType(&Column{Name: "doc", Type: field.TypeJSON}))
+			var (
+				users = &Table{Name: "users"}
+				name  = &Column{Name: "name", Type: field.TypeString}
+			)
+			query, _ := d.renameColumn(users, name, &Column{Name: "nickname", Type: field.TypeString}).Query()
+			require.Equal(t, tt.renameColumn, query)
+			query, _ = d.renameIndex(users, &Index{Name: "name", Columns: []*Column{name}}, &Index{Name: "nickname", Columns: []*Column{name}}).Query()
+			require.Equal(t, tt.renameIndex, query)
+			require.Equal(t, tt.textDefault, d.supportsDefault(&Column{Type: field.TypeString, Size: math.MaxUint32, Default: "text"}))
+			require.Equal(t, tt.exprDefault, d.supportsDefault(&Column{Type: field.TypeTime, Default: Expr("CURRENT_TIMESTAMP")}))
+		})
+	}
+}
+
+func TestMySQL_Partition(t *testing.T) {
+	a := &Atlas{sqlDialect: &MySQL{version: "8.0.19"}}
+	logs := NewTable("logs").
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+		AddColumn(&Column{Name: "created_at", Type: field.TypeTime}).
+		SetAnnotation(&entsql.Annotation{
+			Partition: &entsql.Partition{
+				Type:        entsql.PartitionRange,
+				Columns:     []string{"YEAR(created_at)"},
+				Definitions: "PARTITION p2023 VALUES LESS THAN (2024), PARTITION pmax VALUES LESS THAN MAXVALUE",
+			},
+		})
+	ts, err := a.tables([]*Table{logs})
+	require.NoError(t, err)
+	plan, err := mysql.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `logs` (`id` bigint NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin PARTITION BY RANGE (YEAR(created_at)) (PARTITION p2023 VALUES LESS THAN (2024), PARTITION pmax VALUES LESS THAN MAXVALUE)", plan.Changes[0].Cmd)
+
+	logs.Annotation = entsql.PartitionBy("range columns", "created_at")
+	ts, err = a.tables([]*Table{logs})
+	require.NoError(t, err)
+	plan, err = mysql.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(plan.Changes[0].Cmd, "PARTITION BY RANGE COLUMNS (`created_at`)"), plan.Changes[0].Cmd)
+
+	_, err = (&Atlas{sqlDialect: &SQLite{}}).tables([]*Table{logs})
+	require.EqualError(t, err, `sql/schema: sqlite does not support table partitioning (table "logs")`)
+}
+
+func TestMySQL_Collation(t *testing.T) {
+	a := &Atlas{sqlDialect: &MySQL{version: "8.0.19"}}
+	users := func(collation string) *Table {
+		return NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			AddColumn(&Column{Name: "name", Type: field.TypeString, Charset: "utf8mb4", Collation: collation}).
+			SetCharset("latin1").
+			SetCollation("latin1_swedish_ci").
+			SetAnnotation(&entsql.Annotation{Charset: "utf8", Collation: "utf8_general_ci"})
+	}
+	ts, err := a.tables([]*Table{users("utf8mb4_bin")})
+	require.NoError(t, err)
+	plan, err := mysql.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `users` (`id` bigint NOT NULL, `name` varchar(255) CHARSET utf8mb4 NOT NULL COLLATE utf8mb4_bin, PRIMARY KEY (`id`)) CHARSET latin1 COLLATE latin1_swedish_ci", plan.Changes[0].Cmd)
+
+	current, err := a.tables([]*Table{users("utf8mb4_bin")})
+	require.NoError(t, err)
+	schema.New("").AddTables(current...)
+	desired, err := a.tables([]*Table{users("utf8mb4_unicode_ci")})
+	require.NoError(t, err)
+	schema.New("").AddTables(desired...)
+	changes, err := mysql.DefaultDiff.TableDiff(current[0], desired[0])
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.True(t, changes[0].(*schema.ModifyColumn).Change.Is(schema.ChangeCollate))
+}
+
+func TestMySQL_TableOptions(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		d     = &MySQL{Driver: sql.OpenDB(dialect.MySQL, db), version: "8.0.19"}
+		a     = &Atlas{sqlDialect: d}
+		users = NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			SetAnnotation(&entsql.Annotation{
+				Engine:        &entsql.Engine{Name: "InnoDB"},
+				RowFormat:     "compressed",
+				KeyBlockSize:  8,
+				AutoIncrement: 1000,
+			})
+		pets = NewTable("pets").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			SetAnnotation(entsql.TableEngine("MyISAM"))
+		groups = NewTable("groups").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			SetAnnotation(entsql.RowFormat("DYNAMIC"))
+	)
+	ts, err := a.tables([]*Table{users})
+	require.NoError(t, err)
+	plan, err := mysql.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `users` (`id` bigint NOT NULL AUTO_INCREMENT, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8 AUTO_INCREMENT 1000", plan.Changes[0].Cmd)
+
+	mock.ExpectQuery("SELECT `TABLE_NAME`, `ENGINE`, `ROW_FORMAT`, `CREATE_OPTIONS` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` IN (?, ?, ?)").
+		WithArgs("users", "pets", "groups").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME", "ENGINE", "ROW_FORMAT", "CREATE_OPTIONS"}).
+			AddRow("users", "InnoDB", "Compressed", "row_format=COMPRESSED KEY_BLOCK_SIZE=4").
+			AddRow("pets", "InnoDB", "Dynamic", "").
+			AddRow("groups", "InnoDB", "Dynamic", ""))
+	state, err := d.tableOptions(context.Background(), d, []*Table{users, pets, groups, NewTable("cars")})
+	require.NoError(t, err)
+	changes := d.tableOptionsChanges([]*Table{users, pets, groups}, state, nil)
+	require.Len(t, changes, 2)
+	require.Equal(t, "ALTER TABLE `users` KEY_BLOCK_SIZE=8", changes[0].Cmd)
+	require.Equal(t, "ALTER TABLE `pets` ENGINE=MyISAM", changes[1].Cmd)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Global unique ids take precedence over the configured AUTO_INCREMENT.
+	at := schema.NewTable("users")
+	d.atTable(users, at)
+	d.atIncrementT(at, 1<<32)
+	var incs []int64
+	for _, a := range at.Attrs {
+		if inc, ok := a.(*mysql.AutoIncrement); ok {
+			incs = append(incs, inc.V)
+		}
+	}
+	require.Equal(t, []int64{1 << 32}, incs)
+}
+
+func TestMySQL_UniquePrefix(t *testing.T) {
+	a := &Atlas{sqlDialect: &MySQL{version: "8.0.19"}}
+	users := NewTable("users").
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+		AddColumn(&Column{Name: "description", Type: field.TypeString, Size: math.MaxUint16 + 1, Unique: true, Prefix: 191})
+	ts, err := a.tables([]*Table{users})
+	require.NoError(t, err)
+	plan, err := mysql.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `users` (`id` bigint NOT NULL AUTO_INCREMENT, `description` longtext NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `description` (`description` (191))) CHARSET utf8mb4 COLLATE utf8mb4_bin", plan.Changes[0].Cmd)
+}
+
+func TestMySQL_MigrationLock(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	d := &MySQL{Driver: sql.OpenDB(dialect.MySQL, db)}
+	ctx := context.Background()
+	mock.ExpectQuery("SELECT GET_LOCK(?, ?)").
+		WithArgs("ent_migrate", 600).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	mock.ExpectQuery("SELECT RELEASE_LOCK(?)").
+		WithArgs("ent_migrate").
+		WillReturnRows(sqlmock.NewRows([]string{"released"}).AddRow(1))
+	unlock, err := d.migrationLock(ctx, d)
+	require.NoError(t, err)
+	require.NoError(t, unlock(ctx))
+
+	mock.ExpectQuery("SELECT GET_LOCK(?, ?)").
+		WithArgs("ent_migrate", 600).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(0))
+	_, err = d.migrationLock(ctx, d)
+	require.EqualError(t, err, "acquire migration lock: timed out after 600 seconds")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQL_SetTimeouts(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	d := &MySQL{Driver: sql.OpenDB(dialect.MySQL, db)}
+	ctx := context.Background()
+	mock.ExpectQuery("SELECT @@SESSION.lock_wait_timeout").
+		WillReturnRows(sqlmock.NewRows([]string{"lock_wait_timeout"}).AddRow(31536000))
+	mock.ExpectExec("SET SESSION lock_wait_timeout = 2").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SET SESSION lock_wait_timeout = 31536000").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	restore, err := d.setTimeouts(ctx, d, time.Minute, 1500*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, restore(ctx))
+
+	// Statement timeouts are not supported by MySQL.
+	restore, err = d.setTimeouts(ctx, d, time.Minute, 0)
+	require.NoError(t, err)
+	require.NoError(t, restore(ctx))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQL_TiDB(t *testing.T) {
+	users := func() *Table {
+		return NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "name", Type: field.TypeString, Nullable: true}).
+			AddColumn(&Column{Name: "age", Type: field.TypeInt})
+	}
+	tests := []struct {
+		name    string
+		release string
+		tables  []*Table
+		options []MigrateOption
+		before  func(mysqlMock)
+	}{
+		{
+			name:    "split alter",
+			release: "6.1.0",
+			tables:  []*Table{users()},
+			before: func(mock mysqlMock) {
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name`, `numeric_precision`, `numeric_scale` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "", nil, nil).
+						AddRow("name", "varchar(100)", "YES", "", "NULL", "", "", "", nil, nil))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`,  `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "sub_part", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", nil, "0", "1"))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `age` bigint NOT NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("ALTER TABLE `users` MODIFY COLUMN `name` varchar(255) NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "multi alter",
+			release: "6.5.0",
+			tables:  []*Table{users()},
+			before: func(mock mysqlMock) {
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name`, `numeric_precision`, `numeric_scale` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "", nil, nil).
+						AddRow("name", "varchar(100)", "YES", "", "NULL", "", "", "", nil, nil))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`,  `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "sub_part", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", nil, "0", "1"))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `age` bigint NOT NULL, MODIFY COLUMN `name` varchar(255) NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "split foreign keys",
+			release: "5.4.0",
+			tables: func() []*Table {
+				var (
+					u  = NewTable("users").AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+					c  = []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "owner_id", Type: field.TypeInt, Nullable: true}, {Name: "friend_id", Type: field.TypeInt, Nullable: true}}
+					pe = &Table{Name: "pets", Columns: c, PrimaryKey: c[:1]}
+				)
+				pe.AddForeignKey(&ForeignKey{Symbol: "pets_owner", Columns: c[1:2], RefTable: u, RefColumns: u.PrimaryKey})
+				pe.AddForeignKey(&ForeignKey{Symbol: "pets_friend", Columns: c[2:3], RefTable: u, RefColumns: u.PrimaryKey})
+				return []*Table{u, pe}
+			}(),
+			before: func(mock mysqlMock) {
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.tableExists("pets", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `pets`(`id` bigint AUTO_INCREMENT NOT NULL, `owner_id` bigint NULL, `friend_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.fkExists("pets_owner", false)
+				mock.fkExists("pets_friend", false)
+				mock.ExpectExec(escape("ALTER TABLE `pets` ADD CONSTRAINT `pets_owner` FOREIGN KEY(`owner_id`) REFERENCES `users`(`id`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("ALTER TABLE `pets` ADD CONSTRAINT `pets_friend` FOREIGN KEY(`friend_id`) REFERENCES `users`(`id`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "universal id restart",
+			release: "6.1.0",
+			tables: []*Table{
+				NewTable("users").AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}),
+				NewTable("groups").AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}),
+			},
+			options: []MigrateOption{WithGlobalUniqueID(true)},
+			before: func(mock mysqlMock) {
+				mock.tableExists("ent_types", true)
+				mock.ExpectQuery(escape("SELECT `type` FROM `ent_types` ORDER BY `id` ASC")).
+					WillReturnRows(sqlmock.NewRows([]string{"type"}).AddRow("deleted").AddRow("users").AddRow("groups"))
+				for _, tc := range [][2]string{
+					// The counter of users is set.
+					{"users", "CREATE TABLE `users` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin AUTO_INCREMENT=4295027297"},
+					// The counter of groups is missing, and is restored.
+					{"groups", "CREATE TABLE `groups` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"},
+				} {
+					name := tc[0]
+					mock.tableExists(name, true)
+					mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name`, `numeric_precision`, `numeric_scale` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+						WithArgs(name).
+						WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name", "numeric_precision", "numeric_scale"}).
+							AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "", nil, nil))
+					mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`,  `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+						WithArgs(name).
+						WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "sub_part", "non_unique", "seq_in_index"}).
+							AddRow("PRIMARY", "id", nil, "0", "1"))
+					mock.ExpectQuery(escape("SHOW CREATE TABLE `" + name + "`")).
+						WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow(name, tc[1]))
+				}
+				mock.ExpectExec(escape("ALTER TABLE `groups` AUTO_INCREMENT = 8589934592")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			mock.ExpectQuery(escape("SHOW VARIABLES LIKE 'version'")).
+				WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "5.7.25-TiDB-v"+tt.release))
+			mock.ExpectQuery(escape("SELECT tidb_version()")).
+				WillReturnRows(sqlmock.NewRows([]string{"tidb_version()"}).AddRow("Release Version: v" + tt.release + "\nEdition: Community\nGit Commit Hash: 1a89decdb192cbdce6a7b0020d71128bc964d30f"))
+			mock.ExpectBegin()
+			tt.before(mysqlMock{mock})
+			migrate, err := NewMigrate(sql.OpenDB("mysql", db), append(tt.options, WithAtlas(false))...)
+			require.NoError(t, err)
+			require.NoError(t, migrate.Create(context.Background(), tt.tables...))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/schema"
+)
+
+type (
+	// OnlineAlter describes an ALTER TABLE statement of a MySQL table that
+	// can be executed using an online schema-change tool, like gh-ost or
+	// pt-online-schema-change, instead of a locking ALTER TABLE statement.
+	OnlineAlter struct {
+		// Schema (database) of the table.
+		Schema string
+		// Table to alter.
+		Table string
+		// Alter holds the clauses of the statement, without the ALTER TABLE
+		// prefix. For example, "ADD COLUMN `age` bigint NOT NULL".
+		Alter string
+		// Rows is the estimated number of rows in the table,
+		// as reported by INFORMATION_SCHEMA.TABLES.
+		Rows int64
+	}
+
+	// OnlineExecutor executes ALTER TABLE statements of MySQL tables using an online schema-change tool.
+	OnlineExecutor interface {
+		// Online reports if the given statement should be executed using the online
+		// schema-change tool. For example, only for tables with many rows.
+		Online(*OnlineAlter) bool
+		// Command returns the command that executes the given statement. In dry-run mode,
+		// the command is written to the writer of the migration instead of being executed.
+		Command(context.Context, *OnlineAlter) *exec.Cmd
+	}
+)
+
+// WithOnlineMigration configures the migration to execute the ALTER TABLE statements of MySQL tables
+// using the given online schema-change executor, for example, GhOst or PTOnlineSchemaChange, instead
+// of executing locking ALTER TABLE statements. In dry-run mode, the commands of the executor are
+// written as comments to the writer.
+//
+//	err := client.Schema.Create(ctx, schema.WithOnlineMigration(&schema.GhOst{
+//		Args:    []string{"--host=127.0.0.1", "--user=root", "--password=pass", "--allow-on-master"},
+//		MinRows: 1_000_000,
+//	}))
+//
+// Note that online migrations are supported only by the Atlas migration engine, and the tables are
+// altered outside of the migration transaction.
+func WithOnlineMigration(e OnlineExecutor) MigrateOption {
+	return func(a *Atlas) {
+		a.online = e
+	}
+}
+
+// GhOst is an OnlineExecutor that executes the statements using gh-ost.
+// See https://github.com/github/gh-ost for the supported flags.
+type GhOst struct {
+	// Path of the gh-ost binary. Defaults to "gh-ost".
+	Path string
+	// Args holds additional flags, like the connection
+	// and throttling flags (e.g. --host, --user and --max-load).
+	Args []string
+	// MinRows is the minimum estimated number of rows of a table for altering
+	// it online. Smaller tables are altered using ALTER TABLE statements.
+	MinRows int64
+	// Stdout and Stderr of the command.
+	Stdout, Stderr io.Writer
+}
+
+// Online implements the OnlineExecutor interface.
+func (g *GhOst) Online(a *OnlineAlter) bool {
+	return a.Rows >= g.MinRows
+}
+
+// Command implements the OnlineExecutor interface.
+func (g *GhOst) Command(ctx context.Context, a *OnlineAlter) *exec.Cmd {
+	path := g.Path
+	if path == "" {
+		path = "gh-ost"
+	}
+	args := append(g.Args[:len(g.Args):len(g.Args)], "--database="+a.Schema, "--table="+a.Table, "--alter="+a.Alter, "--execute")
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stdout, cmd.Stderr = g.Stdout, g.Stderr
+	return cmd
+}
+
+// PTOnlineSchemaChange is an OnlineExecutor that executes the statements using pt-online-schema-change.
+// See https://docs.percona.com/percona-toolkit/pt-online-schema-change.html for the supported options.
+type PTOnlineSchemaChange struct {
+	// Path of the pt-online-schema-change binary.
+	// Defaults to "pt-online-schema-change".
+	Path string
+	// DSN holds the connection options of the tool, without the database
+	// and the table. For example, "h=127.0.0.1,P=3306,u=root,p=pass".
+	DSN string
+	// Args holds additional options (e.g. --max-load or --alter-foreign-keys-method).
+	Args []string
+	// MinRows is the minimum estimated number of rows of a table for altering
+	// it online. Smaller tables are altered using ALTER TABLE statements.
+	MinRows int64
+	// Stdout and Stderr of the command.
+	Stdout, Stderr io.Writer
+}
+
+// Online implements the OnlineExecutor interface.
+func (p *PTOnlineSchemaChange) Online(a *OnlineAlter) bool {
+	return a.Rows >= p.MinRows
+}
+
+// Command implements the OnlineExecutor interface.
+func (p *PTOnlineSchemaChange) Command(ctx context.Context, a *OnlineAlter) *exec.Cmd {
+	path := p.Path
+	if path == "" {
+		path = "pt-online-schema-change"
+	}
+	dsn := fmt.Sprintf("D=%s,t=%s", a.Schema, a.Table)
+	if p.DSN != "" {
+		dsn = p.DSN + "," + dsn
+	}
+	args := append(p.Args[:len(p.Args):len(p.Args)], "--alter="+a.Alter, "--execute", dsn)
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stdout, cmd.Stderr = p.Stdout, p.Stderr
+	return cmd
+}
+
+// execOnline executes the given change using the online executor, if the change is an ALTER TABLE
+// statement of a MySQL table that should be executed online. It reports if the change was executed.
+func (a *Atlas) execOnline(ctx context.Context, conn dialect.ExecQuerier, c *migrate.Change) (bool, error) {
+	if a.online == nil || a.dialect != dialect.MySQL {
+		return false, nil
+	}
+	m, ok := c.Source.(*schema.ModifyTable)
+	if !ok || len(c.Args) > 0 {
+		return false, nil
+	}
+	alter := &OnlineAlter{Table: m.T.Name}
+	if m.T.Schema != nil {
+		alter.Schema = m.T.Schema.Name
+	}
+	b := entsql.Dialect(dialect.MySQL).Table(alter.Table)
+	prefix := "ALTER TABLE " + b.Quote(alter.Table)
+	if alter.Schema != "" && !strings.HasPrefix(c.Cmd, prefix) {
+		prefix = "ALTER TABLE " + b.Quote(alter.Schema) + "." + b.Quote(alter.Table)
+	}
+	rest := strings.TrimPrefix(c.Cmd, prefix)
+	if rest == c.Cmd || strings.TrimLeft(rest, " \t\n") == rest {
+		return false, nil
+	}
+	alter.Alter = strings.TrimSpace(rest)
+	rows, err := a.estimateRows(ctx, conn, alter)
+	if err != nil {
+		return false, err
+	}
+	alter.Rows = rows
+	if !a.online.Online(alter) {
+		return false, nil
+	}
+	cmd := a.online.Command(ctx, alter)
+	if a.dryRun != nil {
+		_, err := io.WriteString(a.dryRun, "-- "+commandLine(cmd)+"\n")
+		return true, err
+	}
+	if err := cmd.Run(); err != nil {
+		return true, fmt.Errorf("online alter of table %q: %w", alter.Table, err)
+	}
+	return true, nil
+}
+
+// estimateRows returns the estimated number of rows in the table, and
+// sets the schema of the alter to the current database if it is empty.
+func (a *Atlas) estimateRows(ctx context.Context, conn dialect.ExecQuerier, alter *OnlineAlter) (int64, error) {
+	match := entsql.ExprP("`TABLE_SCHEMA` = (SELECT DATABASE())")
+	if alter.Schema != "" {
+		match = entsql.EQ("TABLE_SCHEMA", alter.Schema)
+	}
+	rows := &entsql.Rows{}
+	query, args := entsql.Select("TABLE_SCHEMA", "TABLE_ROWS").
+		From(entsql.Table("TABLES").Schema("INFORMATION_SCHEMA")).
+		Where(entsql.And(match, entsql.EQ("TABLE_NAME", alter.Table))).
+		Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return 0, fmt.Errorf("mysql: reading rows estimate of table %q: %w", alter.Table, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	var n entsql.NullInt64
+	if err := rows.Scan(&alter.Schema, &n); err != nil {
+		return 0, fmt.Errorf("mysql: scanning rows estimate of table %q: %w", alter.Table, err)
+	}
+	return n.Int64, nil
+}
+
+// commandLine returns the shell representation of the given command.
+func commandLine(cmd *exec.Cmd) string {
+	args := make([]string, len(cmd.Args))
+	for i, arg := range cmd.Args {
+		args[i] = arg
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"`\\$&|;<>()*?!#~") {
+			args[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+	}
+	return strings.Join(args, " ")
+}
@@ -0,0 +1,77 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/schema"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_ExecOnline(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		ctx  = context.Background()
+		drv  = sql.OpenDB(dialect.MySQL, db)
+		b    strings.Builder
+		out  strings.Builder
+		rows = func(schema string, n int64) {
+			mock.ExpectQuery("SELECT `TABLE_SCHEMA`, `TABLE_ROWS` FROM `INFORMATION_SCHEMA`.`TABLES` WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?").
+				WithArgs("users").
+				WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_ROWS"}).AddRow(schema, n))
+		}
+		change = &migrate.Change{
+			Cmd:    "ALTER TABLE `users` ADD COLUMN `age` bigint NOT NULL",
+			Source: &schema.ModifyTable{T: schema.NewTable("users")},
+		}
+	)
+	a := &Atlas{dialect: dialect.MySQL, dryRun: &b, online: &GhOst{Args: []string{"--host=127.0.0.1"}, MinRows: 1000}}
+	rows("test", 10)
+	ok, err := a.execOnline(ctx, drv, change)
+	require.NoError(t, err)
+	require.False(t, ok, "small tables are altered using ALTER TABLE")
+
+	rows("test", 1000)
+	ok, err = a.execOnline(ctx, drv, change)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "-- gh-ost --host=127.0.0.1 --database=test --table=users '--alter=ADD COLUMN `age` bigint NOT NULL' --execute\n", b.String())
+
+	b.Reset()
+	a.online = &PTOnlineSchemaChange{DSN: "h=127.0.0.1,u=root"}
+	rows("test", 0)
+	ok, err = a.execOnline(ctx, drv, change)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "-- pt-online-schema-change '--alter=ADD COLUMN `age` bigint NOT NULL' --execute h=127.0.0.1,u=root,D=test,t=users\n", b.String())
+
+	// The command is executed outside of dry-run mode.
+	a.dryRun = nil
+	a.online = &GhOst{Path: "echo", Stdout: &out}
+	rows("test", 0)
+	ok, err = a.execOnline(ctx, drv, change)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "--database=test --table=users --alter=ADD COLUMN `age` bigint NOT NULL --execute\n", out.String())
+
+	// Non-ALTER statements and other dialects are executed as usual.
+	ok, err = a.execOnline(ctx, drv, &migrate.Change{Cmd: "CREATE TABLE `users` (`id` bigint)", Source: &schema.AddTable{T: schema.NewTable("users")}})
+	require.NoError(t, err)
+	require.False(t, ok)
+	a.dialect = dialect.Postgres
+	ok, err = a.execOnline(ctx, drv, change)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,13 +6,18 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
@@ -24,8 +29,9 @@ import (
 // Postgres is a postgres migration driver.
 type Postgres struct {
 	dialect.Driver
-	schema  string
-	version string
+	schema      string
+	version     string
+	nativeEnums bool
 }
 
 // init loads the Postgres version from the database for later use in the migration process.
@@ -79,6 +85,29 @@ func (d *Postgres) fkExist(ctx context.Context, tx dialect.Tx, name string) (boo
 	return exist(ctx, tx, query, args...)
 }
 
+// fkColumns returns the foreign-key constraints of the given table, mapped to their columns (in order).
+func (d *Postgres) fkColumns(ctx context.Context, tx dialect.Tx, table string) (map[string][]string, error) {
+	query, args := sql.Dialect(dialect.Postgres).
+		Select("constraint_name", "column_name").From(sql.Table("key_column_usage").Schema("information_schema")).
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("table_name", table),
+			sql.NotNull("position_in_unique_constraint"),
+		)).
+		OrderBy("constraint_name", "ordinal_position").
+		Query()
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("postgres: reading foreign keys of table %q: %w", table, err)
+	}
+	return scanFKColumns(rows)
+}
+
+// dropForeignKey returns the DSL query for dropping a foreign-key constraint.
+func (d *Postgres) dropForeignKey(table, symbol string) sql.Querier {
+	return sql.Dialect(dialect.Postgres).AlterTable(table).DropConstraint(symbol)
+}
+
 // setRange sets restart the identity column to the given offset. Used by the universal-id option.
 func (d *Postgres) setRange(ctx context.Context, conn dialect.ExecQuerier, t *Table, value int64) error {
 	if value == 0 {
@@ -324,7 +353,7 @@ func (d *Postgres) tBuilder(t *Table) *sql.TableBuilder {
 	b := sql.Dialect(dialect.Postgres).
 		CreateTable(t.Name).IfNotExists()
 	for _, c := range t.Columns {
-		b.Column(d.addColumn(c))
+		b.Column(d.addColumnSeq(c, tableSequence(t)))
 	}
 	for _, pk := range t.PrimaryKey {
 		b.PrimaryKey(pk.Name)
@@ -380,11 +409,17 @@ func (d *Postgres) cType(c *Column) (t string) {
 
 // addColumn returns the ColumnBuilder for adding the given column to a table.
 func (d *Postgres) addColumn(c *Column) *sql.ColumnBuilder {
+	return d.addColumnSeq(c, nil)
+}
+
+// addColumnSeq returns the ColumnBuilder for adding the given column to a
+// table, using the given sequence options in case it is an identity column.
+func (d *Postgres) addColumnSeq(c *Column, seq *entsql.Sequence) *sql.ColumnBuilder {
 	b := sql.Dialect(dialect.Postgres).
 		Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
 	c.unique(b)
 	if c.Increment {
-		b.Attr("GENERATED BY DEFAULT AS IDENTITY")
+		b.Attr(identityClause(seq))
 	}
 	c.nullable(b)
 	d.writeDefault(b, c, "DEFAULT")
@@ -413,6 +448,33 @@ func (d *Postgres) writeDefault(b *sql.ColumnBuilder, c *Column, clause string)
 	b.Attr(clause + " " + attr)
 }
 
+// identityClause returns the clause that defines an identity column with the given sequence options.
+func identityClause(seq *entsql.Sequence) string {
+	if seq == nil {
+		return "GENERATED BY DEFAULT AS IDENTITY"
+	}
+	gen, opts := "BY DEFAULT", make([]string, 0, 4)
+	if seq.Always {
+		gen = "ALWAYS"
+	}
+	if seq.Name != "" {
+		opts = append(opts, fmt.Sprintf("SEQUENCE NAME %q", seq.Name))
+	}
+	if seq.Start != 0 {
+		opts = append(opts, fmt.Sprintf("START WITH %d", seq.Start))
+	}
+	if seq.Increment != 0 {
+		opts = append(opts, fmt.Sprintf("INCREMENT BY %d", seq.Increment))
+	}
+	if seq.Cache != 0 {
+		opts = append(opts, fmt.Sprintf("CACHE %d", seq.Cache))
+	}
+	if len(opts) == 0 {
+		return fmt.Sprintf("GENERATED %s AS IDENTITY", gen)
+	}
+	return fmt.Sprintf("GENERATED %s AS IDENTITY (%s)", gen, strings.Join(opts, " "))
+}
+
 // alterColumn returns list of ColumnBuilder for applying in order to alter a column.
 func (d *Postgres) alterColumn(c *Column) (ops []*sql.ColumnBuilder) {
 	b := sql.Dialect(dialect.Postgres)
@@ -459,8 +521,13 @@ func (d *Postgres) addIndex(i *Index, table string) *sql.IndexBuilder {
 	if i.Unique {
 		idx.Unique()
 	}
-	for _, c := range i.Columns {
-		idx.Column(c.Name)
+	if t, ok := indexType(i, dialect.Postgres); ok {
+		// Index methods are lowercase identifiers (e.g. gin).
+		idx.Using(strings.ToLower(t))
+	}
+	i.columnsTo(idx, nil)
+	if ant := i.Annotation; ant != nil && ant.Where != "" {
+		idx.Where(sql.ExprP(ant.Where))
 	}
 	return idx
 }
@@ -556,7 +623,56 @@ func (d *Postgres) alterColumns(table string, add, modify, drop []*Column) sql.Q
 // (by table altering) to column "new".
 func (d *Postgres) needsConversion(old, new *Column) bool {
 	oldT, newT := d.cType(old), d.cType(new)
-	return oldT != newT && (oldT != "ARRAY" || !arrayType(newT))
+	if oldT == "ARRAY" && arrayType(newT) {
+		// Compare the element types, in case the array type of the
+		// inspected column (e.g. _int8) is known. See, scanColumn.
+		elem, ok := arrayElemTypes[old.typ]
+		return ok && elem != arrayElemType(newT)
+	}
+	return oldT != newT
+}
+
+// arrayElemTypes maps the 'udt_name' of common array types to their element types.
+var arrayElemTypes = map[string]string{
+	"_bool":        "boolean",
+	"_int2":        "smallint",
+	"_int4":        "integer",
+	"_int8":        "bigint",
+	"_float4":      "real",
+	"_float8":      "double precision",
+	"_text":        "text",
+	"_varchar":     "character varying",
+	"_uuid":        "uuid",
+	"_jsonb":       "jsonb",
+	"_timestamptz": "timestamp with time zone",
+}
+
+// arrayElemType returns the canonical element type of the given array type.
+// For example, "bigint" for "int8[]", and "character varying" for "varchar(255)[]".
+func arrayElemType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t[:strings.IndexByte(t, '[')]))
+	if i := strings.IndexByte(t, '('); i != -1 {
+		t = strings.TrimSpace(t[:i])
+	}
+	switch t {
+	case "bool":
+		return "boolean"
+	case "int2":
+		return "smallint"
+	case "int", "int4":
+		return "integer"
+	case "int8":
+		return "bigint"
+	case "float4":
+		return "real"
+	case "float8":
+		return "double precision"
+	case "varchar":
+		return "character varying"
+	case "timestamptz":
+		return "timestamp with time zone"
+	}
+	return t
 }
 
 // callExpr reports if the given string ~looks like a function call expression.
@@ -613,34 +729,8 @@ func (d *Postgres) foreignKeys(ctx context.Context, tx dialect.Tx, tables []*Tab
 			if err := rows.Scan(&tableSchema, &constraintName, &tableName, &columnName, &refTableSchema, &refTableName, &refColumnName); err != nil {
 				return fmt.Errorf("scanning index description: %w", err)
 			}
-			refTable := tableLookup[refTableName]
-			if refTable == nil {
-				return fmt.Errorf("could not find table: %s", refTableName)
-			}
-			column, ok := t.column(columnName)
-			if !ok {
-				return fmt.Errorf("could not find column: %s on table: %s", columnName, tableName)
-			}
-			refColumn, ok := refTable.column(refColumnName)
-			if !ok {
-				return fmt.Errorf("could not find ref column: %s on ref table: %s", refTableName, refColumnName)
-			}
-			if fk, ok := tableFksLookup[constraintName]; ok {
-				if _, ok := fk.column(columnName); !ok {
-					fk.Columns = append(fk.Columns, column)
-				}
-				if _, ok := fk.refColumn(refColumnName); !ok {
-					fk.RefColumns = append(fk.RefColumns, refColumn)
-				}
-			} else {
-				newFk := &ForeignKey{
-					Symbol:     constraintName,
-					Columns:    []*Column{column},
-					RefTable:   refTable,
-					RefColumns: []*Column{refColumn},
-				}
-				tableFksLookup[constraintName] = newFk
-				t.AddForeignKey(newFk)
+			if err := addForeignKey(tableLookup, tableFksLookup, t, constraintName, columnName, refTableName, refColumnName); err != nil {
+				return err
 			}
 		}
 		if err := rows.Close(); err != nil {
@@ -685,6 +775,30 @@ func (d *Postgres) atTable(t1 *Table, t2 *schema.Table) {
 	if t1.Annotation != nil {
 		setAtChecks(t1, t2)
 	}
+	if seq := tableSequence(t1); seq != nil {
+		id := &postgres.Identity{Sequence: &postgres.Sequence{Start: seq.Start, Increment: seq.Increment}}
+		if seq.Always {
+			id.Generation = "ALWAYS"
+		}
+		t2.AddAttrs(id)
+	}
+}
+
+func (d *Postgres) atPartition(t1 *Table, t2 *schema.Table) error {
+	p, err := t1.partition(postgres.PartitionTypeRange, postgres.PartitionTypeList, postgres.PartitionTypeHash)
+	if err != nil || p == nil {
+		return err
+	}
+	attr := &postgres.Partition{T: strings.ToUpper(p.Type)}
+	for _, c := range p.Columns {
+		part := &postgres.PartitionPart{X: &schema.RawExpr{X: c}}
+		if c2, ok := t2.Column(c); ok {
+			part = &postgres.PartitionPart{C: c2}
+		}
+		attr.Parts = append(attr.Parts, part)
+	}
+	t2.AddAttrs(attr)
+	return nil
 }
 
 func (d *Postgres) supportsDefault(*Column) bool {
@@ -733,8 +847,12 @@ func (d *Postgres) atTypeC(c1 *Column, c2 *schema.Column) error {
 		t = &schema.TimeType{T: c1.scanTypeOr(postgres.TypeTimestampWTZ)}
 	case field.TypeEnum:
 		// Although atlas supports enum types, we keep backwards compatibility
-		// with previous versions of ent and use varchar (see cType).
+		// with previous versions of ent and use varchar (see cType), unless
+		// native enums were enabled. Enum types are named by the caller.
 		t = &schema.StringType{T: postgres.TypeVarChar}
+		if d.nativeEnums {
+			t = &schema.EnumType{Values: c1.Enums}
+		}
 	case field.TypeOther:
 		t = &schema.UnsupportedType{T: c1.typ}
 	default:
@@ -786,9 +904,24 @@ func (d *Postgres) atIncrementC(t *schema.Table, c *schema.Column) {
 }
 
 func (d *Postgres) atIncrementT(t *schema.Table, v int64) {
+	// The range of the table takes precedence over the configured start value.
+	for _, a := range t.Attrs {
+		if a, ok := a.(*postgres.Identity); ok {
+			a.Sequence.Start = v
+			return
+		}
+	}
 	t.AddAttrs(&postgres.Identity{Sequence: &postgres.Sequence{Start: v}})
 }
 
+// tableSequence returns the sequence options of the table identity column, if configured.
+func tableSequence(t *Table) *entsql.Sequence {
+	if t.Annotation == nil || t.Annotation.Sequence == nil || len(t.PrimaryKey) != 1 || !t.PrimaryKey[0].Increment {
+		return nil
+	}
+	return t.Annotation.Sequence
+}
+
 // indexOpClass returns a map holding the operator-class mapping if exists.
 func indexOpClass(idx *Index) map[string]string {
 	opc := make(map[string]string)
@@ -814,6 +947,9 @@ func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) er
 			return fmt.Errorf("unexpected index %q column: %q", idx1.Name, c1.Name)
 		}
 		part := &schema.IndexPart{C: c2}
+		if fullTextIndex(idx1) {
+			part.C, part.X = nil, &schema.RawExpr{X: tsvectorExpr(idx1, c2)}
+		}
 		if v, ok := opc[c1.Name]; ok {
 			var op postgres.IndexOpClass
 			if err := op.UnmarshalText([]byte(v)); err != nil {
@@ -843,9 +979,854 @@ func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) er
 	return nil
 }
 
+// reLowerIdent matches identifiers that are not quoted by PostgreSQL.
+var reLowerIdent = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// tsvectorExpr returns the expression of the full-text index on the given column, in its normal
+// form (i.e. as it is stored in the database), in order to not be recreated on every migration.
+func tsvectorExpr(idx *Index, c *schema.Column) string {
+	config := sql.DefaultTextSearchConfig
+	if idx.Annotation.FullTextConfig != "" {
+		config = idx.Annotation.FullTextConfig
+	}
+	name := c.Name
+	if !reLowerIdent.MatchString(name) {
+		name = strconv.Quote(name)
+	}
+	// Non-text columns (e.g. varchar) are cast to text.
+	if t, ok := c.Type.Type.(*schema.StringType); !ok || t.T != postgres.TypeText {
+		name = fmt.Sprintf("(%s)::text", name)
+	}
+	return fmt.Sprintf("to_tsvector('%s'::regconfig, %s)", config, name)
+}
+
 func (Postgres) atTypeRangeSQL(ts ...string) string {
 	for i := range ts {
 		ts[i] = fmt.Sprintf("('%s')", ts[i])
 	}
 	return fmt.Sprintf(`INSERT INTO "%s" ("type") VALUES %s`, TypeTable, strings.Join(ts, ", "))
 }
+
+// rowSecurity describes the row-level security state of a table.
+type rowSecurity struct {
+	enabled, forced bool
+	policies        map[string]string // policy names and their comments.
+}
+
+// policyComment is the prefix of the comments of the policies that are managed by Ent.
+// The comment holds the checksum of the statement that created the policy, and is used
+// for detecting changed policies, as the expressions are normalized by PostgreSQL.
+const policyComment = "ent:"
+
+// rowSecurity returns the row-level security state of the tables that are configured with it.
+func (d *Postgres) rowSecurity(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*rowSecurity, error) {
+	var (
+		names    []any
+		preds    []*sql.Predicate
+		explicit = make(map[string]bool)
+		c, n, p  = sql.Table("pg_class").As("c"), sql.Table("pg_namespace").As("n"), sql.Table("pg_policy").As("p")
+	)
+	for _, t := range tables {
+		switch {
+		case t.Annotation == nil || t.Annotation.RowSecurity == nil:
+		case t.Schema != "":
+			// Tables with an explicit schema are matched by it, and
+			// not by the schema of the connection or the migration.
+			explicit[rowSecurityKey(t)] = true
+			preds = append(preds, sql.And(sql.EQ(n.C("nspname"), t.Schema), sql.EQ(c.C("relname"), t.Name)))
+		default:
+			names = append(names, t.Name)
+		}
+	}
+	state := make(map[string]*rowSecurity)
+	if len(names) > 0 {
+		preds = append(preds, sql.And(d.matchSchema(n.C("nspname")), sql.In(c.C("relname"), names...)))
+	}
+	if len(preds) == 0 {
+		return state, nil
+	}
+	where := preds[0]
+	if len(preds) > 1 {
+		where = sql.Or(preds...)
+	}
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(c.C("relname"), c.C("relrowsecurity"), c.C("relforcerowsecurity"), p.C("polname"), fmt.Sprintf("obj_description(%s, 'pg_policy')", p.C("oid")), n.C("nspname")).
+		From(c).
+		Join(n).On(n.C("oid"), c.C("relnamespace")).
+		LeftJoin(p).On(p.C("polrelid"), c.C("oid")).
+		Where(where).
+		Query()
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying row-level security: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			name, schema    string
+			policy, comment sql.NullString
+			rs              rowSecurity
+		)
+		if err := rows.Scan(&name, &rs.enabled, &rs.forced, &policy, &comment, &schema); err != nil {
+			return nil, fmt.Errorf("scanning row-level security: %w", err)
+		}
+		if explicit[schema+"."+name] {
+			name = schema + "." + name
+		}
+		if state[name] == nil {
+			rs.policies = make(map[string]string)
+			state[name] = &rs
+		}
+		if policy.Valid {
+			state[name].policies[policy.String] = comment.String
+		}
+	}
+	return state, rows.Err()
+}
+
+// rowSecurityChanges returns the changes for enabling the row-level security of the given tables, for
+// creating their missing tenant policies, and for creating, re-creating and dropping their policies.
+func (d *Postgres) rowSecurityChanges(tables []*Table, state map[string]*rowSecurity) ([]*migrate.Change, error) {
+	var changes []*migrate.Change
+	for _, t := range tables {
+		if t.Annotation == nil || t.Annotation.RowSecurity == nil {
+			continue
+		}
+		r, rs := t.Annotation.RowSecurity, state[rowSecurityKey(t)]
+		if rs == nil {
+			rs = &rowSecurity{}
+		}
+		if !rs.enabled {
+			changes = append(changes, &migrate.Change{
+				Cmd: sql.Dialect(dialect.Postgres).String(func(b *sql.Builder) {
+					policyTable(b.WriteString("ALTER TABLE "), t).WriteString(" ENABLE ROW LEVEL SECURITY")
+				}),
+				Comment: fmt.Sprintf("enable row-level security on %q table", t.Name),
+			})
+		}
+		if r.Force && !rs.forced {
+			changes = append(changes, &migrate.Change{
+				Cmd: sql.Dialect(dialect.Postgres).String(func(b *sql.Builder) {
+					policyTable(b.WriteString("ALTER TABLE "), t).WriteString(" FORCE ROW LEVEL SECURITY")
+				}),
+				Comment: fmt.Sprintf("force row-level security on %q table", t.Name),
+			})
+		}
+		if r.Column != "" {
+			c, ok := t.column(r.Column)
+			if !ok {
+				return nil, fmt.Errorf("row-level security column %q was not found in table %q", r.Column, t.Name)
+			}
+			if name := r.Policy(t.Name); !hasKey(rs.policies, name) {
+				// The setting is read with missing_ok, so connections
+				// without a tenant cannot see or modify any rows.
+				expr := sql.Dialect(dialect.Postgres).String(func(b *sql.Builder) {
+					b.Ident(c.Name).WriteString(" = current_setting(").
+						WriteString(quoteString(r.TenantSetting())).
+						WriteString(", true)::").
+						WriteString(d.cType(c))
+				})
+				changes = append(changes, &migrate.Change{
+					Cmd:     createPolicy(t, &entsql.Policy{Name: name, Using: expr, WithCheck: expr}),
+					Comment: fmt.Sprintf("create %q policy on %q table", name, t.Name),
+				})
+			}
+		}
+		policies, err := d.policyChanges(t, rs)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, policies...)
+	}
+	return changes, nil
+}
+
+// policyChanges returns the changes for creating the policies of the table that do not exist
+// or were changed, and for dropping the policies that were created by previous migrations, but
+// were removed from the table annotation. Changed policies are dropped and re-created.
+func (d *Postgres) policyChanges(t *Table, rs *rowSecurity) ([]*migrate.Change, error) {
+	var (
+		changes []*migrate.Change
+		defined = make(map[string]bool)
+	)
+	for _, p := range t.Annotation.RowSecurity.Policies {
+		if p.Name == "" {
+			return nil, fmt.Errorf("missing name of row-level security policy in table %q", t.Name)
+		}
+		if defined[p.Name] {
+			return nil, fmt.Errorf("duplicate row-level security policy %q in table %q", p.Name, t.Name)
+		}
+		defined[p.Name] = true
+		stmt := createPolicy(t, p)
+		comment := policyComment + stmtChecksum(stmt)
+		current, ok := rs.policies[p.Name]
+		if ok && current == comment {
+			continue
+		}
+		if ok {
+			changes = append(changes, &migrate.Change{
+				Cmd:     dropPolicy(t, p.Name),
+				Comment: fmt.Sprintf("drop %q policy on %q table", p.Name, t.Name),
+			})
+		}
+		changes = append(changes,
+			&migrate.Change{
+				Cmd:     stmt,
+				Comment: fmt.Sprintf("create %q policy on %q table", p.Name, t.Name),
+			},
+			&migrate.Change{
+				Cmd: sql.Dialect(dialect.Postgres).String(func(b *sql.Builder) {
+					policyTable(b.WriteString("COMMENT ON POLICY ").Ident(p.Name).WriteString(" ON "), t).
+						WriteString(" IS " + quoteString(comment))
+				}),
+			},
+		)
+	}
+	removed := make([]string, 0, len(rs.policies))
+	for name, comment := range rs.policies {
+		if !defined[name] && strings.HasPrefix(comment, policyComment) {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, &migrate.Change{
+			Cmd:     dropPolicy(t, name),
+			Comment: fmt.Sprintf("drop %q policy on %q table", name, t.Name),
+		})
+	}
+	return changes, nil
+}
+
+// createPolicy returns the statement for creating the given policy on the table.
+func createPolicy(t *Table, p *entsql.Policy) string {
+	return sql.Dialect(dialect.Postgres).String(func(b *sql.Builder) {
+		policyTable(b.WriteString("CREATE POLICY ").Ident(p.Name).WriteString(" ON "), t)
+		if p.Restrictive {
+			b.WriteString(" AS RESTRICTIVE")
+		}
+		if p.For != "" {
+			b.WriteString(" FOR " + strings.ToUpper(p.For))
+		}
+		if len(p.To) > 0 {
+			b.WriteString(" TO " + strings.Join(p.To, ", "))
+		}
+		if p.Using != "" {
+			b.WriteString(" USING (" + p.Using + ")")
+		}
+		if p.WithCheck != "" {
+			b.WriteString(" WITH CHECK (" + p.WithCheck + ")")
+		}
+	})
+}
+
+// dropPolicy returns the statement for dropping the given policy from the table.
+func dropPolicy(t *Table, name string) string {
+	return sql.Dialect(dialect.Postgres).String(func(b *sql.Builder) {
+		policyTable(b.WriteString("DROP POLICY ").Ident(name).WriteString(" ON "), t)
+	})
+}
+
+// policyTable writes the table name of the row-level security statements, qualified with its schema if it was set.
+func policyTable(b *sql.Builder, t *Table) *sql.Builder {
+	if t.Schema != "" {
+		b.Ident(t.Schema).WriteByte('.')
+	}
+	return b.Ident(t.Name)
+}
+
+// rowSecurityKey returns the key of the table in the row-level security state.
+func rowSecurityKey(t *Table) string {
+	if t.Schema != "" {
+		return t.Schema + "." + t.Name
+	}
+	return t.Name
+}
+
+// hasKey reports if the given key exists in the map.
+func hasKey(m map[string]string, k string) bool {
+	_, ok := m[k]
+	return ok
+}
+
+// deferrable returns the foreign keys of the given tables that are deferrable
+// and initially deferred in the database, grouped by their table names.
+func (d *Postgres) deferrable(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]map[string]bool, error) {
+	var names []any
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			if fk.Deferrable {
+				names = append(names, t.Name)
+				break
+			}
+		}
+	}
+	state := make(map[string]map[string]bool)
+	if len(names) == 0 {
+		return state, nil
+	}
+	c, r, n := sql.Table("pg_constraint").As("c"), sql.Table("pg_class").As("r"), sql.Table("pg_namespace").As("n")
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(r.C("relname"), c.C("conname")).
+		From(c).
+		Join(r).On(r.C("oid"), c.C("conrelid")).
+		Join(n).On(n.C("oid"), r.C("relnamespace")).
+		Where(sql.And(
+			d.matchSchema(n.C("nspname")),
+			sql.In(r.C("relname"), names...),
+			sql.EQ(c.C("contype"), "f"),
+			sql.IsTrue(c.C("condeferrable")),
+			sql.IsTrue(c.C("condeferred")),
+		)).Query()
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying deferrable foreign keys: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table, symbol string
+		if err := rows.Scan(&table, &symbol); err != nil {
+			return nil, fmt.Errorf("scanning deferrable foreign keys: %w", err)
+		}
+		if state[table] == nil {
+			state[table] = make(map[string]bool)
+		}
+		state[table][symbol] = true
+	}
+	return state, rows.Err()
+}
+
+// deferrableChanges returns the changes for making the deferrable foreign keys of the given tables
+// deferrable in the database. Atlas does not support this attribute, and therefore, foreign keys
+// that are (re)created by the planned changes are considered non-deferrable.
+func (d *Postgres) deferrableChanges(tables []*Table, state map[string]map[string]bool, planned []*migrate.Change) []*migrate.Change {
+	created := make(map[string]map[string]bool)
+	for _, c := range planned {
+		m, ok := c.Source.(*schema.ModifyTable)
+		if !ok {
+			continue
+		}
+		for _, mc := range m.Changes {
+			var symbol string
+			switch mc := mc.(type) {
+			case *schema.AddForeignKey:
+				symbol = mc.F.Symbol
+			case *schema.ModifyForeignKey:
+				symbol = mc.To.Symbol
+			default:
+				continue
+			}
+			if created[m.T.Name] == nil {
+				created[m.T.Name] = make(map[string]bool)
+			}
+			created[m.T.Name][symbol] = true
+		}
+	}
+	var changes []*migrate.Change
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			if !fk.Deferrable || state[t.Name][fk.Symbol] && !created[t.Name][fk.Symbol] {
+				continue
+			}
+			changes = append(changes, &migrate.Change{
+				Cmd:     fmt.Sprintf("ALTER TABLE %q ALTER CONSTRAINT %q DEFERRABLE INITIALLY DEFERRED", t.Name, fk.Symbol),
+				Comment: fmt.Sprintf("make %q foreign key on %q table deferrable", fk.Symbol, t.Name),
+			})
+		}
+	}
+	return changes
+}
+
+// sequence describes the sequence of an existing identity column.
+type sequence struct {
+	name  string
+	cache int64
+}
+
+// sequences returns the identity sequences of the given tables that are configured with sequence options,
+// keyed by their table names. The other options of the identity columns are inspected and altered by Atlas.
+func (d *Postgres) sequences(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*sequence, error) {
+	var names []any
+	for _, t := range tables {
+		if tableSequence(t) != nil {
+			names = append(names, t.Name)
+		}
+	}
+	state := make(map[string]*sequence)
+	if len(names) == 0 {
+		return state, nil
+	}
+	var (
+		c, n = sql.Table("pg_class").As("c"), sql.Table("pg_namespace").As("n")
+		p, s = sql.Table("pg_depend").As("p"), sql.Table("pg_class").As("s")
+		q    = sql.Table("pg_sequence").As("q")
+	)
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(c.C("relname"), s.C("relname"), q.C("seqcache")).
+		From(c).
+		Join(n).On(n.C("oid"), c.C("relnamespace")).
+		Join(p).On(p.C("refobjid"), c.C("oid")).
+		Join(s).On(s.C("oid"), p.C("objid")).
+		Join(q).On(q.C("seqrelid"), s.C("oid")).
+		Where(sql.And(
+			d.matchSchema(n.C("nspname")),
+			sql.In(c.C("relname"), names...),
+			// Identity sequences are internally dependent on their tables.
+			sql.EQ(p.C("deptype"), "i"),
+			sql.EQ(s.C("relkind"), "S"),
+		)).Query()
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying identity sequences: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			table string
+			seq   sequence
+		)
+		if err := rows.Scan(&table, &seq.name, &seq.cache); err != nil {
+			return nil, fmt.Errorf("scanning identity sequences: %w", err)
+		}
+		state[table] = &seq
+	}
+	return state, rows.Err()
+}
+
+// sequenceChanges returns the changes for renaming the identity sequences of the given tables and for altering
+// their cache size, as both are not supported by Atlas. Sequences of new tables are created by PostgreSQL with
+// the default name and cache size, and therefore, they are altered after the tables were created.
+func (d *Postgres) sequenceChanges(tables []*Table, state map[string]*sequence) []*migrate.Change {
+	var changes []*migrate.Change
+	for _, t := range tables {
+		seq := tableSequence(t)
+		if seq == nil {
+			continue
+		}
+		pk, current := t.PrimaryKey[0], state[t.Name]
+		if current == nil {
+			current = &sequence{name: fmt.Sprintf("%s_%s_seq", t.Name, pk.Name), cache: 1}
+		}
+		if seq.Name != "" && seq.Name != current.name {
+			changes = append(changes, &migrate.Change{
+				Cmd:     fmt.Sprintf("ALTER SEQUENCE %q RENAME TO %q", current.name, seq.Name),
+				Comment: fmt.Sprintf("rename the identity sequence of %q table", t.Name),
+			})
+		}
+		if seq.Cache > 0 && seq.Cache != current.cache {
+			changes = append(changes, &migrate.Change{
+				Cmd:     fmt.Sprintf("ALTER TABLE %q ALTER COLUMN %q SET CACHE %d", t.Name, pk.Name, seq.Cache),
+				Comment: fmt.Sprintf("set the cache size of the identity sequence of %q table", t.Name),
+			})
+		}
+	}
+	return changes
+}
+
+// tablePersistence returns the persistence of the given table, as configured by its annotation.
+func tablePersistence(t *Table) string {
+	if t.Annotation == nil {
+		return ""
+	}
+	return strings.ToUpper(t.Annotation.Persistence)
+}
+
+// tableOptions returns the persistence of the existing tables that are configured with one, keyed by their names.
+// Temporary tables are not returned, as they are created in a different schema that is not inspected.
+func (d *Postgres) tableOptions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]*tableOptions, error) {
+	var names []any
+	for _, t := range tables {
+		if tablePersistence(t) != "" {
+			names = append(names, t.Name)
+		}
+	}
+	state := make(map[string]*tableOptions)
+	if len(names) == 0 {
+		return state, nil
+	}
+	c, n := sql.Table("pg_class").As("c"), sql.Table("pg_namespace").As("n")
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(c.C("relname"), c.C("relpersistence")).
+		From(c).
+		Join(n).On(n.C("oid"), c.C("relnamespace")).
+		Where(sql.And(
+			d.matchSchema(n.C("nspname")),
+			sql.In(c.C("relname"), names...),
+			sql.EQ(c.C("relkind"), "r"),
+		)).Query()
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying table persistence: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, persistence string
+		if err := rows.Scan(&name, &persistence); err != nil {
+			return nil, fmt.Errorf("scanning table persistence: %w", err)
+		}
+		state[name] = &tableOptions{persistence: persistence}
+	}
+	return state, rows.Err()
+}
+
+// tableOptionsChanges returns the changes for altering the persistence of the existing tables. Atlas does not
+// support unlogged and temporary tables, and therefore, the planned statements of new tables are modified to
+// create them as such. Temporary tables are created in a special schema, and their names are not qualified.
+func (d *Postgres) tableOptionsChanges(tables []*Table, state map[string]*tableOptions, planned []*migrate.Change) []*migrate.Change {
+	var changes []*migrate.Change
+	for _, t := range tables {
+		p := tablePersistence(t)
+		if p == "" {
+			continue
+		}
+		if current, ok := state[t.Name]; ok {
+			switch {
+			case p == entsql.PersistenceUnlogged && current.persistence == "p":
+				changes = append(changes, &migrate.Change{
+					Cmd:     fmt.Sprintf("ALTER TABLE %q SET UNLOGGED", t.Name),
+					Comment: fmt.Sprintf("set %q table as unlogged", t.Name),
+				})
+			case p == entsql.PersistenceLogged && current.persistence == "u":
+				changes = append(changes, &migrate.Change{
+					Cmd:     fmt.Sprintf("ALTER TABLE %q SET LOGGED", t.Name),
+					Comment: fmt.Sprintf("set %q table as logged", t.Name),
+				})
+			}
+			continue
+		}
+		if p != entsql.PersistenceUnlogged && p != entsql.PersistenceTemporary {
+			continue
+		}
+		var at *schema.Table
+		for _, c := range planned {
+			switch add, ok := c.Source.(*schema.AddTable); {
+			case ok:
+				at = add.T
+			// The indexes and the comments of new tables are planned
+			// right after their creation, as changes without a source.
+			case c.Source != nil:
+				at = nil
+			}
+			if at == nil || at.Name != t.Name {
+				continue
+			}
+			if p == entsql.PersistenceTemporary && at.Schema != nil && at.Schema.Name != "" {
+				c.Cmd = strings.ReplaceAll(c.Cmd, fmt.Sprintf("%q.%q", at.Schema.Name, t.Name), strconv.Quote(t.Name))
+			}
+			if strings.HasPrefix(c.Cmd, "CREATE TABLE ") {
+				c.Cmd = "CREATE " + p + " TABLE " + strings.TrimPrefix(c.Cmd, "CREATE TABLE ")
+			}
+		}
+	}
+	return changes
+}
+
+// extensions returns the installed extensions in the database,
+// in case one of the given tables requires an extension.
+func (d *Postgres) extensions(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]bool, error) {
+	installed := make(map[string]bool)
+	if len(requiredExtensions(tables)) == 0 {
+		return installed, nil
+	}
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(dialect.Postgres).Select("extname").From(sql.Table("pg_extension")).Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying extensions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("scanning extensions: %w", err)
+		}
+		installed[name] = true
+	}
+	return installed, rows.Err()
+}
+
+// extensionChanges returns the changes for creating the extensions
+// that are required by the given tables and are not installed.
+func (d *Postgres) extensionChanges(tables []*Table, installed map[string]bool) []*migrate.Change {
+	var changes []*migrate.Change
+	for _, name := range requiredExtensions(tables) {
+		if !installed[name] {
+			changes = append(changes, &migrate.Change{
+				Cmd:     fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %q", name),
+				Comment: fmt.Sprintf("create %q extension", name),
+			})
+		}
+	}
+	return changes
+}
+
+// requiredExtensions returns the sorted names of the extensions that are required by the given tables.
+func requiredExtensions(tables []*Table) []string {
+	var names []string
+	for _, t := range tables {
+		if t.Annotation == nil {
+			continue
+		}
+		for _, name := range t.Annotation.Extensions {
+			if indexOf(names, name) == -1 {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// extensionError returns a descriptive error in case the role of the
+// migration lacks the permission to create the extension of the change.
+func extensionError(c *migrate.Change, err error) error {
+	if !strings.HasPrefix(c.Cmd, "CREATE EXTENSION ") {
+		return err
+	}
+	var (
+		pgx interface{ SQLState() string }
+		pq  interface{ Get(byte) string }
+	)
+	// 42501 is the code of the insufficient_privilege error (e.g. pgconn.PgError or pq.Error).
+	switch {
+	case errors.As(err, &pgx) && pgx.SQLState() == "42501",
+		errors.As(err, &pq) && pq.Get('C') == "42501",
+		strings.Contains(err.Error(), "permission denied"):
+		return fmt.Errorf("the migration role lacks the permission to create extensions, it must be a superuser, "+
+			"or have the CREATE privilege on the database for trusted extensions. Alternatively, create the extension "+
+			"manually before running the migration: %w", err)
+	}
+	return err
+}
+
+// collationChanges adds to the given changes the modification of existing columns whose collation was
+// changed, as it is not detected by Atlas. If the collation was removed, the default one is restored.
+func (d *Postgres) collationChanges(current, desired *schema.Schema, changes []schema.Change) []schema.Change {
+	for _, t2 := range desired.Tables {
+		t1, ok := current.Table(t2.Name)
+		if !ok {
+			continue
+		}
+		var modify *schema.ModifyTable
+		for _, c := range changes {
+			if m, ok := c.(*schema.ModifyTable); ok && m.T.Name == t2.Name {
+				modify = m
+				break
+			}
+		}
+		for _, c2 := range t2.Columns {
+			c1, ok := t1.Column(c2.Name)
+			if _, isString := c2.Type.Type.(*schema.StringType); !ok || !isString || collation(c1) == collation(c2) {
+				continue
+			}
+			if modify == nil {
+				modify = &schema.ModifyTable{T: t2}
+				changes = append(changes, modify)
+			}
+			var change *schema.ModifyColumn
+			for _, c := range modify.Changes {
+				if m, ok := c.(*schema.ModifyColumn); ok && m.To.Name == c2.Name {
+					change = m
+					break
+				}
+			}
+			if change == nil {
+				change = &schema.ModifyColumn{From: c1, To: c2}
+				modify.Changes = append(modify.Changes, change)
+			}
+			// Atlas writes the collation of modified columns as is, and
+			// therefore, it is quoted to preserve its case (e.g. "C").
+			change.Change |= schema.ChangeType
+			change.From, change.To = quoteCollation(change.From), quoteCollation(change.To)
+		}
+	}
+	return changes
+}
+
+// collation returns the collation of the column, if it was defined.
+func collation(c *schema.Column) string {
+	for _, a := range c.Attrs {
+		if v, ok := a.(*schema.Collation); ok {
+			return v.V
+		}
+	}
+	return ""
+}
+
+// quoteCollation returns a copy of the column with a quoted collation.
+func quoteCollation(c *schema.Column) *schema.Column {
+	name := collation(c)
+	if name == "" {
+		name = "default"
+	}
+	qc := *c
+	qc.Attrs = make([]schema.Attr, 0, len(c.Attrs)+1)
+	for _, a := range c.Attrs {
+		if _, ok := a.(*schema.Collation); !ok {
+			qc.Attrs = append(qc.Attrs, a)
+		}
+	}
+	qc.Attrs = append(qc.Attrs, &schema.Collation{V: strconv.Quote(name)})
+	return &qc
+}
+
+// convertUsing adds the conversion expressions of the columns whose types are changed by the planned changes.
+// Atlas does not support the USING clause, and therefore, it is appended to the "ALTER COLUMN TYPE" clause.
+func (d *Postgres) convertUsing(tables []*Table, planned []*migrate.Change) error {
+	for _, c := range planned {
+		m, ok := c.Source.(*schema.ModifyTable)
+		if !ok {
+			continue
+		}
+		var t *Table
+		for i := range tables {
+			if tables[i].Name == m.T.Name {
+				t = tables[i]
+				break
+			}
+		}
+		if t == nil {
+			continue
+		}
+		for _, mc := range m.Changes {
+			mc, ok := mc.(*schema.ModifyColumn)
+			if !ok || !mc.Change.Is(schema.ChangeType) {
+				continue
+			}
+			c1, ok := t.column(mc.To.Name)
+			if !ok || c1.ConvertUsing == "" {
+				continue
+			}
+			f, err := postgres.FormatType(mc.To.Type.Type)
+			if err != nil {
+				return err
+			}
+			clause := fmt.Sprintf("ALTER COLUMN %q TYPE %s", mc.To.Name, f)
+			if v := collation(mc.To); v != "" {
+				clause += " COLLATE " + v
+			}
+			// Type changes of serial and enum columns are planned differently,
+			// and the expression is ignored in case the clause was not found.
+			if strings.Count(c.Cmd, clause) == 1 {
+				c.Cmd = strings.Replace(c.Cmd, clause, clause+" USING "+c1.ConvertUsing, 1)
+			}
+		}
+	}
+	return nil
+}
+
+// invalidIndexes returns the invalid indexes of the given tables, keyed by their table names. Invalid indexes
+// are left behind by concurrent builds that failed or were interrupted, and they are ignored by the planner.
+func (d *Postgres) invalidIndexes(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string][]string, error) {
+	names := make([]any, len(tables))
+	for i, t := range tables {
+		names[i] = t.Name
+	}
+	invalid := make(map[string][]string)
+	if len(names) == 0 {
+		return invalid, nil
+	}
+	var (
+		x    = sql.Table("pg_index").As("x")
+		i, t = sql.Table("pg_class").As("i"), sql.Table("pg_class").As("t")
+		n    = sql.Table("pg_namespace").As("n")
+	)
+	query, args := sql.Dialect(dialect.Postgres).
+		Select(t.C("relname"), i.C("relname")).
+		From(x).
+		Join(i).On(i.C("oid"), x.C("indexrelid")).
+		Join(t).On(t.C("oid"), x.C("indrelid")).
+		Join(n).On(n.C("oid"), t.C("relnamespace")).
+		Where(sql.And(
+			d.matchSchema(n.C("nspname")),
+			sql.In(t.C("relname"), names...),
+			sql.EQ(x.C("indisvalid"), false),
+		)).Query()
+	rows := &sql.Rows{}
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying invalid indexes: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table, index string
+		if err := rows.Scan(&table, &index); err != nil {
+			return nil, fmt.Errorf("scanning invalid indexes: %w", err)
+		}
+		invalid[table] = append(invalid[table], index)
+	}
+	return invalid, rows.Err()
+}
+
+// concurrentIndexes marks the indexes that are added to existing tables to be created concurrently.
+// Indexes of new tables are created in the migration transaction, as these tables are still empty.
+func (d *Postgres) concurrentIndexes(changes []schema.Change) {
+	for _, c := range changes {
+		m, ok := c.(*schema.ModifyTable)
+		if !ok {
+			continue
+		}
+		for _, mc := range m.Changes {
+			if add, ok := mc.(*schema.AddIndex); ok && !hasConcurrently(add.Extra) {
+				add.Extra = append(add.Extra, &postgres.Concurrently{})
+			}
+		}
+	}
+}
+
+func hasConcurrently(attrs []schema.Clause) bool {
+	for _, a := range attrs {
+		if _, ok := a.(*postgres.Concurrently); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// reCreateConcurrently matches the index and the (optionally qualified) table of concurrent index builds.
+var reCreateConcurrently = regexp.MustCompile(`^CREATE (?:UNIQUE )?INDEX CONCURRENTLY "([^"]+)" ON ((?:"[^"]+"\.)?)"([^"]+)"`)
+
+// invalidIndexChanges prepends the drop of the invalid indexes to the changes that recreate them concurrently.
+// Invalid indexes that are not recreated are left as is, as they may be dropped by the drop-index option.
+func (d *Postgres) invalidIndexChanges(changes []*migrate.Change, invalid map[string][]string) []*migrate.Change {
+	planned := make([]*migrate.Change, 0, len(changes))
+	for _, c := range changes {
+		if m := reCreateConcurrently.FindStringSubmatch(c.Cmd); m != nil && indexOf(invalid[m[3]], m[1]) != -1 {
+			planned = append(planned, &migrate.Change{
+				Cmd:     fmt.Sprintf("DROP INDEX CONCURRENTLY IF EXISTS %s%q", m[2], m[1]),
+				Comment: fmt.Sprintf("drop invalid index %q of table %q", m[1], m[3]),
+			})
+		}
+		planned = append(planned, c)
+	}
+	return planned
+}
+
+// setTimeouts sets the statement_timeout and lock_timeout of the migration transaction.
+// The settings are set using SET LOCAL, and are reset automatically when the transaction ends.
+func (d *Postgres) setTimeouts(ctx context.Context, conn dialect.ExecQuerier, stmt, lock time.Duration) (func(context.Context) error, error) {
+	for _, s := range []struct {
+		name string
+		d    time.Duration
+	}{{"statement_timeout", stmt}, {"lock_timeout", lock}} {
+		if s.d <= 0 {
+			continue
+		}
+		// The timeout is set in milliseconds, and 0 disables it.
+		ms := s.d.Milliseconds()
+		if ms == 0 {
+			ms = 1
+		}
+		if err := conn.Exec(ctx, fmt.Sprintf("SET LOCAL %s = %d", s.name, ms), []any{}, nil); err != nil {
+			return nil, fmt.Errorf("set %s: %w", s.name, err)
+		}
+	}
+	return func(context.Context) error { return nil }, nil
+}
+
+// migrationLock acquires a transaction-level advisory lock that is released automatically
+// when the migration transaction is committed or rolled back.
+func (d *Postgres) migrationLock(ctx context.Context, conn dialect.ExecQuerier) (func(context.Context) error, error) {
+	if err := conn.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", []any{migrationLockName}, nil); err != nil {
+		return nil, fmt.Errorf("acquire migration lock: %w", err)
+	}
+	return func(context.Context) error { return nil }, nil
+}
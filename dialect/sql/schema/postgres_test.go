@@ -6,16 +6,21 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
@@ -727,6 +732,40 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "drop stale foreign keys",
+			tables: func() []*Table {
+				c1 := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "spouse_id", Type: field.TypeInt, Nullable: true},
+				}
+				t1 := &Table{Name: "users", Columns: c1, PrimaryKey: c1[0:1]}
+				t1.AddForeignKey(&ForeignKey{Symbol: "users_spouse", Columns: c1[1:], RefTable: t1, RefColumns: c1[0:1]})
+				return []*Table{t1}
+			}(),
+			options: []MigrateOption{WithDropForeignKey(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length"}).
+						AddRow("id", "bigint", "YES", "NULL", "int8", nil, nil, nil).
+						AddRow("spouse_id", "bigint", "YES", "NULL", "int8", nil, nil, nil).
+						AddRow("parent_id", "bigint", "YES", "NULL", "int8", nil, nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				// the parent edge was removed from the schema, but its column is kept.
+				mock.ExpectQuery(escape(`SELECT "constraint_name", "column_name" FROM "information_schema"."key_column_usage" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1 AND "position_in_unique_constraint" IS NOT NULL ORDER BY "constraint_name", "ordinal_position"`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"constraint_name", "column_name"}).AddRow("users_parent", "parent_id").AddRow("users_spouse", "spouse_id"))
+				mock.ExpectExec(escape(`ALTER TABLE "users" DROP CONSTRAINT "users_parent"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.fkExists("users_spouse", true)
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add edge to table",
 			tables: func() []*Table {
@@ -1034,3 +1073,655 @@ func (m pgMock) fkExists(fk string, exists bool) {
 		WithArgs("FOREIGN KEY", fk).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
 }
+
+func TestPostgres_NativeEnums(t *testing.T) {
+	a := &Atlas{sqlDialect: &Postgres{nativeEnums: true}}
+	users := NewTable("users").
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+		AddColumn(&Column{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "blocked"}})
+	ts, err := a.tables([]*Table{users})
+	require.NoError(t, err)
+	c, ok := ts[0].Column("status")
+	require.True(t, ok)
+	require.Equal(t, &schema.EnumType{T: "users_status", Values: []string{"active", "blocked"}}, c.Type.Type)
+
+	diff := func(from schema.Type) ([]schema.Change, error) {
+		return nativeEnums(DiffFunc(func(_, _ *schema.Schema) ([]schema.Change, error) {
+			return []schema.Change{
+				&schema.ModifyTable{
+					T: ts[0],
+					Changes: []schema.Change{
+						&schema.ModifyColumn{
+							From:   &schema.Column{Name: "status", Type: &schema.ColumnType{Type: from}},
+							To:     &schema.Column{Name: "status", Type: &schema.ColumnType{Type: c.Type.Type}},
+							Change: schema.ChangeType,
+						},
+					},
+				},
+			}, nil
+		})).Diff(nil, nil)
+	}
+	// Existing varchar columns are not converted.
+	changes, err := diff(&schema.StringType{T: "character varying"})
+	require.NoError(t, err)
+	require.Empty(t, changes[0].(*schema.ModifyTable).Changes)
+	// Values can be added, but not removed.
+	changes, err = diff(&schema.EnumType{T: "users_status", Values: []string{"active"}})
+	require.NoError(t, err)
+	require.Len(t, changes[0].(*schema.ModifyTable).Changes, 1)
+	_, err = diff(&schema.EnumType{T: "users_status", Values: []string{"active", "deleted"}})
+	require.EqualError(t, err, `sql/schema: value "deleted" cannot be removed from enum type "users_status" of column "users"."status"`)
+}
+
+func TestPostgres_Arrays(t *testing.T) {
+	a := &Atlas{sqlDialect: &Postgres{}}
+	users := NewTable("users").
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+		AddColumn(&Column{Name: "tags", Type: field.TypeJSON, Default: `["a","b"]`, SchemaType: map[string]string{dialect.Postgres: "text[]"}}).
+		AddColumn(&Column{Name: "ints", Type: field.TypeJSON, Default: `[]`, SchemaType: map[string]string{dialect.Postgres: "bigint[]"}})
+	ts, err := a.tables([]*Table{users})
+	require.NoError(t, err)
+	c, ok := ts[0].Column("tags")
+	require.True(t, ok)
+	require.Equal(t, &postgres.ArrayType{T: "text[]", Type: &schema.StringType{T: "text"}}, c.Type.Type)
+	require.Equal(t, &schema.Literal{V: `{"a","b"}`}, c.Default)
+	c, ok = ts[0].Column("ints")
+	require.True(t, ok)
+	require.Equal(t, &schema.Literal{V: `{}`}, c.Default)
+
+	d := &Postgres{}
+	for _, tt := range []struct {
+		udt, typ string
+		want     bool
+	}{
+		{udt: "_text", typ: "text[]"},
+		{udt: "_int8", typ: "int8[]"},
+		{udt: "_int8", typ: "bigint[]"},
+		{udt: "_varchar", typ: "varchar(255)[]"},
+		{udt: "_text", typ: "bigint[]", want: true},
+		{udt: "_int4", typ: "bigint[]", want: true},
+		// Unknown array types are not converted.
+		{udt: "_point", typ: "text[]"},
+	} {
+		old := &Column{Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "ARRAY"}, typ: tt.udt}
+		require.Equal(t, tt.want, d.needsConversion(old, &Column{Type: field.TypeJSON, SchemaType: map[string]string{dialect.Postgres: tt.typ}}), tt.typ)
+	}
+}
+
+func TestPostgres_RowSecurity(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		d      = &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+		tenant = &Column{Name: "tenant_id", Type: field.TypeInt}
+		tables = []*Table{
+			{
+				Name:       "users",
+				Columns:    []*Column{{Name: "id", Type: field.TypeInt}, tenant},
+				Annotation: entsql.TenantColumn("tenant_id"),
+			},
+			{
+				Name:    "pets",
+				Columns: []*Column{{Name: "id", Type: field.TypeInt}, tenant},
+				Annotation: &entsql.Annotation{
+					RowSecurity: &entsql.RowSecurity{Column: "tenant_id", Setting: "app.tenant", Force: true},
+				},
+			},
+			{
+				Name:    "groups",
+				Columns: []*Column{{Name: "id", Type: field.TypeInt}},
+			},
+		}
+	)
+	mock.ExpectQuery(`SELECT "c"."relname", "c"."relrowsecurity", "c"."relforcerowsecurity", "p"."polname", obj_description("p"."oid", 'pg_policy'), "n"."nspname" FROM "pg_class" AS "c" JOIN "pg_namespace" AS "n" ON "n"."oid" = "c"."relnamespace" LEFT JOIN "pg_policy" AS "p" ON "p"."polrelid" = "c"."oid" WHERE "n"."nspname" = CURRENT_SCHEMA() AND "c"."relname" IN ($1, $2)`).
+		WithArgs("users", "pets").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "relrowsecurity", "relforcerowsecurity", "polname", "obj_description", "nspname"}).
+			AddRow("users", true, false, "users_tenant_isolation", nil, "public").
+			AddRow("pets", true, false, nil, nil, "public"))
+	state, err := d.rowSecurity(context.Background(), d, tables)
+	require.NoError(t, err)
+	changes, err := d.rowSecurityChanges(tables, state)
+	require.NoError(t, err)
+	var cmds []string
+	for _, c := range changes {
+		cmds = append(cmds, c.Cmd)
+	}
+	require.Equal(t, []string{
+		`ALTER TABLE "pets" FORCE ROW LEVEL SECURITY`,
+		`CREATE POLICY "pets_tenant_isolation" ON "pets" USING ("tenant_id" = current_setting('app.tenant', true)::bigint) WITH CHECK ("tenant_id" = current_setting('app.tenant', true)::bigint)`,
+	}, cmds)
+
+	// New tables are created with row-level security.
+	changes, err = d.rowSecurityChanges(tables[:1], nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, `ALTER TABLE "users" ENABLE ROW LEVEL SECURITY`, changes[0].Cmd)
+
+	// Tables with an explicit schema are inspected and qualified by it.
+	tables[1].Schema = "pets"
+	mock.ExpectQuery(`SELECT "c"."relname", "c"."relrowsecurity", "c"."relforcerowsecurity", "p"."polname", obj_description("p"."oid", 'pg_policy'), "n"."nspname" FROM "pg_class" AS "c" JOIN "pg_namespace" AS "n" ON "n"."oid" = "c"."relnamespace" LEFT JOIN "pg_policy" AS "p" ON "p"."polrelid" = "c"."oid" WHERE ("n"."nspname" = $1 AND "c"."relname" = $2) OR ("n"."nspname" = CURRENT_SCHEMA() AND "c"."relname" IN ($3))`).
+		WithArgs("pets", "pets", "users").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "relrowsecurity", "relforcerowsecurity", "polname", "obj_description", "nspname"}).
+			AddRow("users", true, false, "users_tenant_isolation", nil, "public").
+			AddRow("pets", true, true, nil, nil, "pets"))
+	state, err = d.rowSecurity(context.Background(), d, tables)
+	require.NoError(t, err)
+	require.Contains(t, state, "pets.pets")
+	changes, err = d.rowSecurityChanges(tables, state)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, `CREATE POLICY "pets_tenant_isolation" ON "pets"."pets" USING ("tenant_id" = current_setting('app.tenant', true)::bigint) WITH CHECK ("tenant_id" = current_setting('app.tenant', true)::bigint)`, changes[0].Cmd)
+	changes, err = d.rowSecurityChanges(tables[1:2], nil)
+	require.NoError(t, err)
+	require.Equal(t, `ALTER TABLE "pets"."pets" ENABLE ROW LEVEL SECURITY`, changes[0].Cmd)
+	require.Equal(t, `ALTER TABLE "pets"."pets" FORCE ROW LEVEL SECURITY`, changes[1].Cmd)
+
+	tables[0].Annotation = entsql.TenantColumn("org_id")
+	_, err = d.rowSecurityChanges(tables[:1], nil)
+	require.EqualError(t, err, `row-level security column "org_id" was not found in table "users"`)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgres_RowSecurityPolicies(t *testing.T) {
+	var (
+		d     = &Postgres{}
+		posts = &Table{
+			Name:    "posts",
+			Columns: []*Column{{Name: "id", Type: field.TypeInt}},
+			Annotation: entsql.Policies(
+				&entsql.Policy{Name: "posts_select", For: "select", Using: "published_at IS NOT NULL"},
+				&entsql.Policy{Name: "posts_owner", Restrictive: true, To: []string{"app_user"}, Using: "author = current_user", WithCheck: "author = current_user"},
+			),
+		}
+		policies = posts.Annotation.RowSecurity.Policies
+		comment  = func(p *entsql.Policy) string { return policyComment + stmtChecksum(createPolicy(posts, p)) }
+	)
+	changes, err := d.rowSecurityChanges([]*Table{posts}, map[string]*rowSecurity{
+		"posts": {
+			enabled: true,
+			policies: map[string]string{
+				"posts_select":  comment(policies[0]),
+				"posts_owner":   "ent:changed",
+				"posts_removed": "ent:removed",
+				"posts_manual":  "",
+			},
+		},
+	})
+	require.NoError(t, err)
+	var cmds []string
+	for _, c := range changes {
+		cmds = append(cmds, c.Cmd)
+	}
+	require.Equal(t, []string{
+		`DROP POLICY "posts_owner" ON "posts"`,
+		`CREATE POLICY "posts_owner" ON "posts" AS RESTRICTIVE TO app_user USING (author = current_user) WITH CHECK (author = current_user)`,
+		`COMMENT ON POLICY "posts_owner" ON "posts" IS '` + comment(policies[1]) + `'`,
+		`DROP POLICY "posts_removed" ON "posts"`,
+	}, cmds)
+
+	// New tables are created with row-level security and their policies.
+	changes, err = d.rowSecurityChanges([]*Table{posts}, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 5)
+	require.Equal(t, `ALTER TABLE "posts" ENABLE ROW LEVEL SECURITY`, changes[0].Cmd)
+	require.Equal(t, `CREATE POLICY "posts_select" ON "posts" FOR SELECT USING (published_at IS NOT NULL)`, changes[1].Cmd)
+
+	posts.Annotation.RowSecurity.Policies = append(policies, &entsql.Policy{Name: "posts_select"})
+	_, err = d.rowSecurityChanges([]*Table{posts}, nil)
+	require.EqualError(t, err, `duplicate row-level security policy "posts_select" in table "posts"`)
+
+	// Policies are merged with the tenant column.
+	ant := entsql.TenantColumn("tenant_id").Merge(entsql.Policies(policies...)).(entsql.Annotation)
+	require.Equal(t, "tenant_id", ant.RowSecurity.Column)
+	require.Equal(t, policies, ant.RowSecurity.Policies)
+}
+
+func TestPostgres_Deferrable(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		d      = &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+		groups = &Table{Name: "groups", Columns: []*Column{{Name: "id", Type: field.TypeInt}}}
+		users  = &Table{Name: "users", Columns: []*Column{{Name: "id", Type: field.TypeInt}, {Name: "group_id", Type: field.TypeInt}, {Name: "owner_id", Type: field.TypeInt}}}
+		pets   = &Table{Name: "pets", Columns: []*Column{{Name: "id", Type: field.TypeInt}, {Name: "owner_id", Type: field.TypeInt}}}
+	)
+	users.ForeignKeys = []*ForeignKey{
+		{Symbol: "users_groups", Columns: users.Columns[1:2], RefTable: groups, RefColumns: groups.Columns, Deferrable: true},
+		{Symbol: "users_users", Columns: users.Columns[2:], RefTable: users, RefColumns: users.Columns[:1], Deferrable: true},
+	}
+	pets.ForeignKeys = []*ForeignKey{
+		{Symbol: "pets_users", Columns: pets.Columns[1:], RefTable: users, RefColumns: users.Columns[:1]},
+	}
+	tables := []*Table{groups, users, pets}
+	mock.ExpectQuery(`SELECT "r"."relname", "c"."conname" FROM "pg_constraint" AS "c" JOIN "pg_class" AS "r" ON "r"."oid" = "c"."conrelid" JOIN "pg_namespace" AS "n" ON "n"."oid" = "r"."relnamespace" WHERE "n"."nspname" = CURRENT_SCHEMA() AND "r"."relname" IN ($1) AND "c"."contype" = $2 AND "c"."condeferrable" AND "c"."condeferred"`).
+		WithArgs("users", "f").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "conname"}).
+			AddRow("users", "users_groups"))
+	state, err := d.deferrable(context.Background(), d, tables)
+	require.NoError(t, err)
+	changes := d.deferrableChanges(tables, state, nil)
+	require.Len(t, changes, 1)
+	require.Equal(t, `ALTER TABLE "users" ALTER CONSTRAINT "users_users" DEFERRABLE INITIALLY DEFERRED`, changes[0].Cmd)
+
+	// Foreign keys that are recreated by the plan lose their deferrability.
+	changes = d.deferrableChanges(tables, state, []*migrate.Change{
+		{
+			Source: &schema.ModifyTable{
+				T: schema.NewTable("users"),
+				Changes: []schema.Change{
+					&schema.DropForeignKey{F: schema.NewForeignKey("users_groups")},
+					&schema.AddForeignKey{F: schema.NewForeignKey("users_groups")},
+				},
+			},
+		},
+	})
+	require.Len(t, changes, 2)
+	require.Equal(t, `ALTER TABLE "users" ALTER CONSTRAINT "users_groups" DEFERRABLE INITIALLY DEFERRED`, changes[0].Cmd)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgres_Partition(t *testing.T) {
+	a := &Atlas{sqlDialect: &Postgres{}}
+	logs := NewTable("logs").
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+		AddColumn(&Column{Name: "created_at", Type: field.TypeTime}).
+		SetAnnotation(entsql.PartitionBy(entsql.PartitionRange, "created_at", "(id % 10)"))
+	ts, err := a.tables([]*Table{logs})
+	require.NoError(t, err)
+	plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE "logs" ("id" bigint NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id")) PARTITION BY RANGE ("created_at", (id % 10))`, plan.Changes[0].Cmd)
+
+	logs.Annotation = entsql.PartitionBy(entsql.PartitionKey, "id")
+	_, err = a.tables([]*Table{logs})
+	require.EqualError(t, err, `sql/schema: unsupported partition type "KEY" for table "logs"`)
+	logs.Annotation = entsql.PartitionBy(entsql.PartitionHash)
+	_, err = a.tables([]*Table{logs})
+	require.EqualError(t, err, `sql/schema: missing partition key for table "logs"`)
+}
+
+func TestPostgres_FullText(t *testing.T) {
+	a := &Atlas{sqlDialect: &Postgres{}}
+	posts := NewTable("posts").
+		AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+		AddColumn(&Column{Name: "title", Type: field.TypeString}).
+		AddColumn(&Column{Name: "Body", Type: field.TypeString, Size: math.MaxInt32}).
+		AddIndex("post_title", false, []string{"title"}).
+		AddIndex("post_body", false, []string{"Body"})
+	posts.Indexes[0].Annotation = entsql.FullText()
+	posts.Indexes[1].Annotation = entsql.FullTextConfig("english")
+	ts, err := a.tables([]*Table{posts})
+	require.NoError(t, err)
+	plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3)
+	require.Equal(t, `CREATE INDEX "post_title" ON "posts" USING GIN ((to_tsvector('simple'::regconfig, (title)::text)))`, plan.Changes[1].Cmd)
+	require.Equal(t, `CREATE INDEX "post_body" ON "posts" USING GIN ((to_tsvector('english'::regconfig, "Body")))`, plan.Changes[2].Cmd)
+}
+
+func TestPostgres_Sequence(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		d     = &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+		a     = &Atlas{sqlDialect: d}
+		users = NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			SetAnnotation(entsql.IdentitySequence(&entsql.Sequence{Name: "users_seq", Always: true, Start: 1000, Increment: 10, Cache: 20}))
+		pets = NewTable("pets").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			SetAnnotation(entsql.IdentitySequence(&entsql.Sequence{Cache: 50}))
+		groups = NewTable("groups").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			SetAnnotation(entsql.IdentitySequence(&entsql.Sequence{Name: "groups_seq"}))
+	)
+	ts, err := a.tables([]*Table{users})
+	require.NoError(t, err)
+	plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: ts[0]}})
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE "users" ("id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY (START WITH 1000 INCREMENT BY 10), PRIMARY KEY ("id"))`, plan.Changes[0].Cmd)
+	query, _ := d.tBuilder(users).Query()
+	require.Equal(t, `CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED ALWAYS AS IDENTITY (SEQUENCE NAME "users_seq" START WITH 1000 INCREMENT BY 10 CACHE 20) NOT NULL, PRIMARY KEY("id"))`, query)
+
+	// Sequences of new tables are renamed and altered after they were created.
+	changes := d.sequenceChanges([]*Table{users}, map[string]*sequence{})
+	require.Len(t, changes, 2)
+	require.Equal(t, `ALTER SEQUENCE "users_id_seq" RENAME TO "users_seq"`, changes[0].Cmd)
+	require.Equal(t, `ALTER TABLE "users" ALTER COLUMN "id" SET CACHE 20`, changes[1].Cmd)
+
+	mock.ExpectQuery(`SELECT "c"."relname", "s"."relname", "q"."seqcache" FROM "pg_class" AS "c" JOIN "pg_namespace" AS "n" ON "n"."oid" = "c"."relnamespace" JOIN "pg_depend" AS "p" ON "p"."refobjid" = "c"."oid" JOIN "pg_class" AS "s" ON "s"."oid" = "p"."objid" JOIN "pg_sequence" AS "q" ON "q"."seqrelid" = "s"."oid" WHERE "n"."nspname" = CURRENT_SCHEMA() AND "c"."relname" IN ($1, $2, $3) AND "p"."deptype" = $4 AND "s"."relkind" = $5`).
+		WithArgs("users", "pets", "groups", "i", "S").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "relname", "seqcache"}).
+			AddRow("users", "users_seq", 20).
+			AddRow("pets", "pets_id_seq", 1).
+			AddRow("groups", "groups_id_seq", 1))
+	state, err := d.sequences(context.Background(), d, []*Table{users, pets, groups, NewTable("cars")})
+	require.NoError(t, err)
+	changes = d.sequenceChanges([]*Table{users, pets, groups}, state)
+	require.Len(t, changes, 2)
+	require.Equal(t, `ALTER TABLE "pets" ALTER COLUMN "id" SET CACHE 50`, changes[0].Cmd)
+	require.Equal(t, `ALTER SEQUENCE "groups_id_seq" RENAME TO "groups_seq"`, changes[1].Cmd)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Global unique ids take precedence over the configured start value.
+	at := schema.NewTable("users")
+	d.atTable(users, at)
+	d.atIncrementT(at, 1<<32)
+	var ids []*postgres.Identity
+	for _, a := range at.Attrs {
+		if id, ok := a.(*postgres.Identity); ok {
+			ids = append(ids, id)
+		}
+	}
+	require.Len(t, ids, 1)
+	require.Equal(t, &postgres.Identity{Generation: "ALWAYS", Sequence: &postgres.Sequence{Start: 1 << 32, Increment: 10}}, ids[0])
+}
+
+func TestPostgres_Persistence(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		d      = &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+		a      = &Atlas{sqlDialect: d}
+		name   = &Column{Name: "name", Type: field.TypeString}
+		caches = NewTable("caches").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			SetAnnotation(entsql.Unlogged())
+		scratch = NewTable("scratch").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			AddColumn(name).
+			AddIndex("scratch_name", false, []string{"name"}).
+			SetAnnotation(entsql.Temporary())
+		users = NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			SetAnnotation(&entsql.Annotation{Persistence: entsql.PersistenceLogged})
+		pets = NewTable("pets").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt})
+	)
+	ts, err := a.tables([]*Table{caches, scratch})
+	require.NoError(t, err)
+	s := schema.New("public").AddTables(ts...)
+	plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", []schema.Change{&schema.AddTable{T: s.Tables[0]}, &schema.AddTable{T: s.Tables[1]}})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3)
+	require.Empty(t, d.tableOptionsChanges([]*Table{caches, scratch}, map[string]*tableOptions{}, plan.Changes))
+	require.Equal(t, `CREATE UNLOGGED TABLE "public"."caches" ("id" bigint NOT NULL, PRIMARY KEY ("id"))`, plan.Changes[0].Cmd)
+	require.Equal(t, `CREATE TEMPORARY TABLE "scratch" ("id" bigint NOT NULL, "name" character varying NOT NULL, PRIMARY KEY ("id"))`, plan.Changes[1].Cmd)
+	require.Equal(t, `CREATE INDEX "scratch_name" ON "scratch" ("name")`, plan.Changes[2].Cmd)
+
+	mock.ExpectQuery(`SELECT "c"."relname", "c"."relpersistence" FROM "pg_class" AS "c" JOIN "pg_namespace" AS "n" ON "n"."oid" = "c"."relnamespace" WHERE "n"."nspname" = CURRENT_SCHEMA() AND "c"."relname" IN ($1, $2, $3) AND "c"."relkind" = $4`).
+		WithArgs("caches", "scratch", "users", "r").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "relpersistence"}).
+			AddRow("caches", "p").
+			AddRow("users", "u"))
+	state, err := d.tableOptions(context.Background(), d, []*Table{caches, scratch, users, pets})
+	require.NoError(t, err)
+	changes := d.tableOptionsChanges([]*Table{caches, scratch, users, pets}, state, nil)
+	require.Len(t, changes, 2)
+	require.Equal(t, `ALTER TABLE "caches" SET UNLOGGED`, changes[0].Cmd)
+	require.Equal(t, `ALTER TABLE "users" SET LOGGED`, changes[1].Cmd)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgres_ConcurrentIndexes(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		ctx   = context.Background()
+		d     = &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+		a     = &Atlas{sqlDialect: d, concurrentIndexes: true}
+		users = schema.NewTable("users").
+			SetSchema(schema.New("public")).
+			AddColumns(schema.NewIntColumn("id", "bigint"), schema.NewStringColumn("name", "varchar"))
+		pets = schema.NewTable("pets").
+			AddColumns(schema.NewIntColumn("id", "bigint"))
+		changes = []schema.Change{
+			&schema.AddTable{T: pets.AddIndexes(schema.NewIndex("pet_id").AddColumns(pets.Columns[0]))},
+			&schema.ModifyTable{T: users, Changes: []schema.Change{
+				&schema.AddIndex{I: schema.NewUniqueIndex("user_name").SetTable(users).AddColumns(users.Columns[1])},
+			}},
+		}
+	)
+	// Only indexes of existing tables are created concurrently.
+	d.concurrentIndexes(changes)
+	plan, err := postgres.DefaultPlan.PlanChanges(ctx, "", changes)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3)
+	require.Equal(t, `CREATE INDEX "pet_id" ON "pets" ("id")`, plan.Changes[1].Cmd)
+	require.Equal(t, `CREATE UNIQUE INDEX CONCURRENTLY "user_name" ON "public"."users" ("name")`, plan.Changes[2].Cmd)
+
+	mock.ExpectQuery(`SELECT "t"."relname", "i"."relname" FROM "pg_index" AS "x" JOIN "pg_class" AS "i" ON "i"."oid" = "x"."indexrelid" JOIN "pg_class" AS "t" ON "t"."oid" = "x"."indrelid" JOIN "pg_namespace" AS "n" ON "n"."oid" = "t"."relnamespace" WHERE "n"."nspname" = CURRENT_SCHEMA() AND "t"."relname" IN ($1, $2) AND NOT "x"."indisvalid"`).
+		WithArgs("users", "pets").
+		WillReturnRows(sqlmock.NewRows([]string{"relname", "relname"}).AddRow("users", "user_name"))
+	current := []*schema.Schema{schema.New("public").AddTables(
+		schema.NewTable("users").AddIndexes(schema.NewIndex("user_id"), schema.NewUniqueIndex("user_name")),
+	)}
+	invalid, err := a.invalidIndexes(ctx, d, []*Table{NewTable("users"), NewTable("pets")}, current)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"users": {"user_name"}}, invalid)
+	require.Len(t, current[0].Tables[0].Indexes, 1, "invalid indexes are removed from the current state")
+	require.Equal(t, "user_id", current[0].Tables[0].Indexes[0].Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Invalid indexes are dropped before they are recreated.
+	a.planInvalidIndexes(plan, invalid)
+	require.Len(t, plan.Changes, 4)
+	require.Equal(t, `DROP INDEX CONCURRENTLY IF EXISTS "public"."user_name"`, plan.Changes[2].Cmd)
+	require.Equal(t, `CREATE UNIQUE INDEX CONCURRENTLY "user_name" ON "public"."users" ("name")`, plan.Changes[3].Cmd)
+	tx, concurrent := splitConcurrent(plan.Changes)
+	require.Len(t, tx, 2)
+	require.Len(t, concurrent, 2)
+
+	// Failed builds are retried after dropping the invalid index.
+	mock.ExpectExec(`DROP INDEX CONCURRENTLY IF EXISTS "public"."user_name"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE UNIQUE INDEX CONCURRENTLY "user_name" ON "public"."users" ("name")`).
+		WillReturnError(errors.New("deadlock detected"))
+	mock.ExpectExec(`DROP INDEX CONCURRENTLY IF EXISTS "public"."user_name"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE UNIQUE INDEX CONCURRENTLY "user_name" ON "public"."users" ("name")`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, a.applyConcurrent(ctx, concurrent))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	for i := 0; i < concurrentIndexAttempts; i++ {
+		mock.ExpectExec(`CREATE UNIQUE INDEX CONCURRENTLY "user_name" ON "public"."users" ("name")`).
+			WillReturnError(errors.New("could not create unique index"))
+		if i < concurrentIndexAttempts-1 {
+			mock.ExpectExec(`DROP INDEX CONCURRENTLY IF EXISTS "public"."user_name"`).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+	}
+	err = a.applyConcurrent(ctx, concurrent[1:])
+	require.EqualError(t, err, `sql/schema: create index "user_name" to table: "users": could not create unique index`)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgres_Collation(t *testing.T) {
+	a := &Atlas{sqlDialect: &Postgres{}}
+	users := func(collation string) *schema.Schema {
+		t1 := NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			AddColumn(&Column{Name: "name", Type: field.TypeString, Collation: collation}).
+			AddColumn(&Column{Name: "age", Type: field.TypeInt})
+		ts, err := a.tables([]*Table{t1})
+		require.NoError(t, err)
+		return schema.New("public").AddTables(ts...)
+	}
+	for _, tt := range []struct {
+		from, to  string
+		wantQuery string
+	}{
+		{from: "", to: "C", wantQuery: `ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE character varying COLLATE "C"`},
+		{from: "C", to: "en_US", wantQuery: `ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE character varying COLLATE "en_US"`},
+		{from: "C", to: "", wantQuery: `ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE character varying COLLATE "default"`},
+		{from: "C", to: "C"},
+	} {
+		current, desired := users(tt.from), users(tt.to)
+		changes, err := postgres.DefaultDiff.SchemaDiff(current, desired)
+		require.NoError(t, err)
+		changes = a.sqlDialect.(*Postgres).collationChanges(current, desired, changes)
+		if tt.wantQuery == "" {
+			require.Empty(t, changes)
+			continue
+		}
+		plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", changes)
+		require.NoError(t, err)
+		require.Len(t, plan.Changes, 1)
+		require.Equal(t, tt.wantQuery, plan.Changes[0].Cmd)
+	}
+}
+
+func TestPostgres_ConvertUsing(t *testing.T) {
+	var (
+		d     = &Postgres{}
+		a     = &Atlas{sqlDialect: d}
+		users = func(c *Column) *Table {
+			return NewTable("users").
+				AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+				AddColumn(c)
+		}
+		plan = func(from, to *Table) *migrate.Plan {
+			current, err := a.tables([]*Table{from})
+			require.NoError(t, err)
+			desired, err := a.tables([]*Table{to})
+			require.NoError(t, err)
+			changes, err := postgres.DefaultDiff.SchemaDiff(schema.New("public").AddTables(current...), schema.New("public").AddTables(desired...))
+			require.NoError(t, err)
+			plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", changes)
+			require.NoError(t, err)
+			require.NoError(t, d.convertUsing([]*Table{to}, plan.Changes))
+			return plan
+		}
+	)
+	to := users(&Column{Name: "code", Type: field.TypeInt, Nullable: true, ConvertUsing: `"code"::bigint`})
+	p := plan(users(&Column{Name: "code", Type: field.TypeString}), to)
+	require.Len(t, p.Changes, 1)
+	require.Equal(t, `ALTER TABLE "public"."users" ALTER COLUMN "code" TYPE bigint USING "code"::bigint, ALTER COLUMN "code" DROP NOT NULL`, p.Changes[0].Cmd)
+
+	// The expression is ignored if the type was not changed.
+	p = plan(users(&Column{Name: "code", Type: field.TypeInt}), to)
+	require.Len(t, p.Changes, 1)
+	require.Equal(t, `ALTER TABLE "public"."users" ALTER COLUMN "code" DROP NOT NULL`, p.Changes[0].Cmd)
+}
+
+func TestPostgres_RenameIndex(t *testing.T) {
+	var (
+		a     = &Atlas{sqlDialect: &Postgres{}}
+		users = func(name string, unique bool, where string) *Table {
+			t := NewTable("users").
+				AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+				AddColumn(&Column{Name: "name", Type: field.TypeString})
+			t.AddIndex(name, unique, []string{"name"})
+			if where != "" {
+				t.Indexes[0].Annotation = &entsql.IndexAnnotation{Where: where}
+			}
+			return t
+		}
+		plan = func(from, to *Table) []string {
+			current, err := a.tables([]*Table{from})
+			require.NoError(t, err)
+			desired, err := a.tables([]*Table{to})
+			require.NoError(t, err)
+			changes, err := postgres.DefaultDiff.SchemaDiff(schema.New("public").AddTables(current...), schema.New("public").AddTables(desired...))
+			require.NoError(t, err)
+			require.Len(t, changes, 1)
+			m := changes[0].(*schema.ModifyTable)
+			m.Changes = renameTableIndexes(postgres.DefaultDiff, m.Changes)
+			plan, err := postgres.DefaultPlan.PlanChanges(context.Background(), "", changes)
+			require.NoError(t, err)
+			planIndexRenames(plan)
+			cmds := make([]string, len(plan.Changes))
+			for i, c := range plan.Changes {
+				cmds[i] = c.Cmd
+			}
+			return cmds
+		}
+	)
+	require.Equal(t, []string{`ALTER INDEX "public"."user_name" RENAME TO "users_name"`}, plan(users("user_name", false, ""), users("users_name", false, "")))
+	require.Equal(t, []string{`ALTER INDEX "public"."user_name" RENAME TO "users_name"`}, plan(users("user_name", true, "name <> ''"), users("users_name", true, "name <> ''")))
+
+	// Indexes with different uniqueness or predicates are re-created.
+	require.Equal(t, []string{`DROP INDEX "public"."user_name"`, `CREATE UNIQUE INDEX "users_name" ON "public"."users" ("name")`}, plan(users("user_name", false, ""), users("users_name", true, "")))
+	require.Equal(t, []string{`DROP INDEX "public"."user_name"`, `CREATE INDEX "users_name" ON "public"."users" ("name") WHERE name <> ''`}, plan(users("user_name", false, ""), users("users_name", false, "name <> ''")))
+}
+
+func TestPostgres_Extensions(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		ctx    = context.Background()
+		d      = &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+		users  = NewTable("users").SetAnnotation(&entsql.Annotation{Extensions: []string{"uuid-ossp", "pg_trgm"}})
+		places = NewTable("places").SetAnnotation(&entsql.Annotation{Extensions: []string{"postgis", "uuid-ossp"}})
+	)
+	installed, err := d.extensions(ctx, d, []*Table{NewTable("pets")})
+	require.NoError(t, err)
+	require.Empty(t, installed, "extensions are not queried if they are not required")
+	mock.ExpectQuery(`SELECT "extname" FROM "pg_extension"`).
+		WillReturnRows(sqlmock.NewRows([]string{"extname"}).AddRow("plpgsql").AddRow("pg_trgm"))
+	installed, err = d.extensions(ctx, d, []*Table{users, places})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"plpgsql": true, "pg_trgm": true}, installed)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	plan := &migrate.Plan{Changes: []*migrate.Change{{Cmd: `CREATE TABLE "places" ("location" geometry NOT NULL)`}}}
+	(&Atlas{sqlDialect: d}).planExtensions(plan, []*Table{users, places}, installed)
+	cmds := make([]string, len(plan.Changes))
+	for i, c := range plan.Changes {
+		cmds[i] = c.Cmd
+	}
+	require.Equal(t, []string{
+		`CREATE EXTENSION IF NOT EXISTS "postgis"`,
+		`CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`,
+		`CREATE TABLE "places" ("location" geometry NOT NULL)`,
+	}, cmds)
+
+	err = extensionError(plan.Changes[0], errors.New(`pq: permission denied to create extension "postgis"`))
+	require.ErrorContains(t, err, "the migration role lacks the permission to create extensions")
+	err = extensionError(plan.Changes[2], errors.New(`pq: permission denied for schema public`))
+	require.EqualError(t, err, `pq: permission denied for schema public`)
+}
+
+func TestPostgres_SetTimeouts(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	d := &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+	mock.ExpectBegin()
+	mock.ExpectExec("SET LOCAL statement_timeout = 60000").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SET LOCAL lock_timeout = 1500").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SET LOCAL lock_timeout = 1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	ctx := context.Background()
+	tx, err := d.Tx(ctx)
+	require.NoError(t, err)
+	restore, err := d.setTimeouts(ctx, tx, time.Minute, 1500*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, restore(ctx))
+	_, err = d.setTimeouts(ctx, tx, 0, time.Microsecond)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgres_MigrationLock(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	d := &Postgres{Driver: sql.OpenDB(dialect.Postgres, db)}
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock(hashtext($1))").
+		WithArgs("ent_migrate").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	ctx := context.Background()
+	tx, err := d.Tx(ctx)
+	require.NoError(t, err)
+	unlock, err := d.migrationLock(ctx, tx)
+	require.NoError(t, err)
+	require.NoError(t, unlock(ctx))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
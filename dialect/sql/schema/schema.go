@@ -30,6 +30,7 @@ const (
 // Table schema definition for SQL dialects.
 type Table struct {
 	Name        string
+	Schema      string // optional, the schema (named-database) of the table
 	Columns     []*Column
 	columns     map[string]*Column
 	Indexes     []*Index
@@ -37,6 +38,9 @@ type Table struct {
 	ForeignKeys []*ForeignKey
 	Annotation  *entsql.Annotation
 	Comment     string
+	Charset     string // optional, the default character-set of the table columns (MySQL).
+	Collation   string // optional, the default collation of the table columns (MySQL).
+	Triggers    []*Trigger
 }
 
 // NewTable returns a new table with the given name.
@@ -53,6 +57,24 @@ func (t *Table) SetComment(c string) *Table {
 	return t
 }
 
+// SetSchema sets the schema (named-database) of the table.
+func (t *Table) SetSchema(s string) *Table {
+	t.Schema = s
+	return t
+}
+
+// SetCharset sets the default character-set of the table columns.
+func (t *Table) SetCharset(c string) *Table {
+	t.Charset = c
+	return t
+}
+
+// SetCollation sets the default collation of the table columns.
+func (t *Table) SetCollation(c string) *Table {
+	t.Collation = c
+	return t
+}
+
 // AddPrimary adds a new primary key to the table.
 func (t *Table) AddPrimary(c *Column) *Table {
 	c.Key = PrimaryKey
@@ -101,6 +123,12 @@ func (t *Table) SetAnnotation(ant *entsql.Annotation) *Table {
 	return t
 }
 
+// AddTrigger adds a trigger to the table.
+func (t *Table) AddTrigger(tr *Trigger) *Table {
+	t.Triggers = append(t.Triggers, tr)
+	return t
+}
+
 // AddIndex creates and adds a new index to the table from the given options.
 func (t *Table) AddIndex(name string, unique bool, columns []string) *Table {
 	return t.addIndex(&Index{
@@ -195,6 +223,42 @@ func (t *Table) fk(symbol string) (*ForeignKey, bool) {
 	return nil, false
 }
 
+// charset returns the default character-set of the table. The one that was
+// set explicitly takes precedence over the one defined in the annotation.
+func (t *Table) charset() string {
+	if t.Charset == "" && t.Annotation != nil {
+		return t.Annotation.Charset
+	}
+	return t.Charset
+}
+
+// collation returns the default collation of the table. The one that was
+// set explicitly takes precedence over the one defined in the annotation.
+func (t *Table) collation() string {
+	if t.Collation == "" && t.Annotation != nil {
+		return t.Annotation.Collation
+	}
+	return t.Collation
+}
+
+// partition returns the partitioning of the table, if it was defined,
+// and validates its type against the given (supported) types.
+func (t *Table) partition(types ...string) (*entsql.Partition, error) {
+	if t.Annotation == nil || t.Annotation.Partition == nil {
+		return nil, nil
+	}
+	p := t.Annotation.Partition
+	if len(p.Columns) == 0 {
+		return nil, fmt.Errorf("sql/schema: missing partition key for table %q", t.Name)
+	}
+	for _, typ := range types {
+		if strings.EqualFold(typ, p.Type) {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("sql/schema: unsupported partition type %q for table %q", p.Type, t.Name)
+}
+
 // CopyTables returns a deep-copy of the given tables. This utility function is
 // useful for copying the generated schema tables (i.e. migrate.Tables) before
 // running schema migration when there is a need for execute multiple migrations
@@ -207,6 +271,8 @@ func CopyTables(tables []*Table) ([]*Table, error) {
 	for i, t := range tables {
 		copyT[i] = &Table{
 			Name:        t.Name,
+			Charset:     t.Charset,
+			Collation:   t.Collation,
 			Columns:     make([]*Column, len(t.Columns)),
 			Indexes:     make([]*Index, len(t.Indexes)),
 			ForeignKeys: make([]*ForeignKey, len(t.ForeignKeys)),
@@ -257,6 +323,7 @@ func CopyTables(tables []*Table) ([]*Table, error) {
 				Symbol:     fk.Symbol,
 				OnUpdate:   fk.OnUpdate,
 				OnDelete:   fk.OnDelete,
+				Deferrable: fk.Deferrable,
 				Columns:    make([]*Column, len(fk.Columns)),
 				RefColumns: make([]*Column, len(fk.RefColumns)),
 			}
@@ -287,22 +354,26 @@ func CopyTables(tables []*Table) ([]*Table, error) {
 
 // Column schema definition for SQL dialects.
 type Column struct {
-	Name       string            // column name.
-	Type       field.Type        // column type.
-	SchemaType map[string]string // optional schema type per dialect.
-	Attr       string            // extra attributes.
-	Size       int64             // max size parameter for string, blob, etc.
-	Key        string            // key definition (PRI, UNI or MUL).
-	Unique     bool              // column with unique constraint.
-	Increment  bool              // auto increment attribute.
-	Nullable   bool              // null or not null attribute.
-	Default    any               // default value.
-	Enums      []string          // enum values.
-	Collation  string            // collation type (utf8mb4_unicode_ci, utf8mb4_general_ci)
-	typ        string            // row column type (used for Rows.Scan).
-	indexes    Indexes           // linked indexes.
-	foreign    *ForeignKey       // linked foreign-key.
-	Comment    string            // optional column comment.
+	Name         string            // column name.
+	Type         field.Type        // column type.
+	SchemaType   map[string]string // optional schema type per dialect.
+	Attr         string            // extra attributes.
+	Size         int64             // max size parameter for string, blob, etc.
+	Key          string            // key definition (PRI, UNI or MUL).
+	Unique       bool              // column with unique constraint.
+	Increment    bool              // auto increment attribute.
+	Nullable     bool              // null or not null attribute.
+	Default      any               // default value.
+	Enums        []string          // enum values.
+	Charset      string            // character-set (utf8mb4, latin1). Supported by MySQL.
+	Collation    string            // collation type (utf8mb4_unicode_ci, utf8mb4_general_ci)
+	typ          string            // row column type (used for Rows.Scan).
+	indexes      Indexes           // linked indexes.
+	foreign      *ForeignKey       // linked foreign-key.
+	Comment      string            // optional column comment.
+	OldNames     []string          // previous names of the column.
+	ConvertUsing string            // optional expression for converting the column values on type change (Postgres).
+	Prefix       uint              // optional prefix length of the unique column index (MySQL).
 }
 
 // Expr represents a raw expression. It is used to distinguish between
@@ -470,6 +541,7 @@ type ForeignKey struct {
 	RefColumns []*Column       // referenced columns.
 	OnUpdate   ReferenceOption // action on update.
 	OnDelete   ReferenceOption // action on delete.
+	Deferrable bool            // deferrable until the end of the transaction.
 }
 
 func (fk ForeignKey) column(name string) (*Column, bool) {
@@ -481,6 +553,19 @@ func (fk ForeignKey) column(name string) (*Column, bool) {
 	return nil, false
 }
 
+// columnsEqual reports if the foreign-key is defined on the given columns, in the same order.
+func (fk ForeignKey) columnsEqual(columns []string) bool {
+	if len(fk.Columns) != len(columns) {
+		return false
+	}
+	for i, c := range fk.Columns {
+		if c.Name != columns[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (fk ForeignKey) refColumn(name string) (*Column, bool) {
 	for _, c := range fk.RefColumns {
 		if c.Name == name {
@@ -509,6 +594,9 @@ func (fk ForeignKey) DSL() *sql.ForeignKeyBuilder {
 	if action := string(fk.OnUpdate); action != "" {
 		dsl.OnUpdate(action)
 	}
+	if fk.Deferrable {
+		dsl.Deferrable()
+	}
 	return dsl
 }
 
@@ -546,12 +634,39 @@ func (i *Index) Builder(table string) *sql.IndexBuilder {
 	if i.Unique {
 		idx.Unique()
 	}
-	for _, c := range i.Columns {
-		idx.Column(c.Name)
+	i.columnsTo(idx, nil)
+	if ant := i.Annotation; ant != nil && ant.Where != "" {
+		idx.Where(sql.ExprP(ant.Where))
 	}
 	return idx
 }
 
+// columnsTo appends the columns of the index to the given builder, with their sort
+// order and expressions. The optional parts function returns the part (e.g. prefix)
+// to use instead of the plain column.
+func (i *Index) columnsTo(idx *sql.IndexBuilder, parts func(*Column) (string, bool)) {
+	desc, exprs := descIndexes(i), exprIndexes(i)
+	for _, c := range i.Columns {
+		x, ok := exprs[c.Name]
+		switch {
+		case ok:
+			x = "(" + x + ")"
+		case parts != nil:
+			x, ok = parts(c)
+		}
+		switch {
+		case desc[c.Name] && !ok:
+			idx.ColumnExpr(idx.Quote(c.Name) + " DESC")
+		case desc[c.Name]:
+			idx.ColumnExpr(x + " DESC")
+		case ok:
+			idx.ColumnExpr(x)
+		default:
+			idx.Column(c.Name)
+		}
+	}
+}
+
 // DropBuilder returns the query builder for the drop index.
 func (i *Index) DropBuilder(table string) *sql.DropIndexBuilder {
 	idx := sql.DropIndex(i.Name).Table(table)
@@ -0,0 +1,453 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/schema"
+)
+
+type (
+	// Snapshot is the serializable representation of the desired tables of a schema. Snapshots are written
+	// using MarshalSnapshot, and read back using UnmarshalSnapshot, in order to detect drifts between the
+	// database and the schema it was expected to be migrated to, without access to the Ent schema.
+	Snapshot struct {
+		Tables []*SnapshotTable `json:"tables"`
+	}
+
+	// SnapshotTable is the serializable representation of a table.
+	SnapshotTable struct {
+		Name        string                `json:"name"`
+		Schema      string                `json:"schema,omitempty"`
+		Comment     string                `json:"comment,omitempty"`
+		Charset     string                `json:"charset,omitempty"`
+		Collation   string                `json:"collation,omitempty"`
+		Columns     []*SnapshotColumn     `json:"columns"`
+		PrimaryKey  []string              `json:"primary_key,omitempty"`
+		Indexes     []*SnapshotIndex      `json:"indexes,omitempty"`
+		ForeignKeys []*SnapshotForeignKey `json:"foreign_keys,omitempty"`
+		Annotation  *entsql.Annotation    `json:"annotation,omitempty"`
+	}
+
+	// SnapshotColumn is the serializable representation of a column.
+	SnapshotColumn struct {
+		Name       string            `json:"name"`
+		Type       string            `json:"type"`
+		SchemaType map[string]string `json:"schema_type,omitempty"`
+		Attr       string            `json:"attr,omitempty"`
+		Size       int64             `json:"size,omitempty"`
+		Unique     bool              `json:"unique,omitempty"`
+		Increment  bool              `json:"increment,omitempty"`
+		Nullable   bool              `json:"nullable,omitempty"`
+		Enums      []string          `json:"enums,omitempty"`
+		Charset    string            `json:"charset,omitempty"`
+		Collation  string            `json:"collation,omitempty"`
+		Comment    string            `json:"comment,omitempty"`
+		Prefix     uint              `json:"prefix,omitempty"`
+		// Default holds a literal default value, and DefaultExpr
+		// holds a default expression, optionally per dialect.
+		Default     any               `json:"default,omitempty"`
+		DefaultExpr map[string]string `json:"default_expr,omitempty"`
+	}
+
+	// SnapshotIndex is the serializable representation of an index.
+	SnapshotIndex struct {
+		Name       string                  `json:"name"`
+		Unique     bool                    `json:"unique,omitempty"`
+		Columns    []string                `json:"columns"`
+		Annotation *entsql.IndexAnnotation `json:"annotation,omitempty"`
+	}
+
+	// SnapshotForeignKey is the serializable representation of a foreign key.
+	SnapshotForeignKey struct {
+		Symbol     string          `json:"symbol"`
+		Columns    []string        `json:"columns"`
+		RefTable   string          `json:"ref_table"`
+		RefColumns []string        `json:"ref_columns"`
+		OnUpdate   ReferenceOption `json:"on_update,omitempty"`
+		OnDelete   ReferenceOption `json:"on_delete,omitempty"`
+		Deferrable bool            `json:"deferrable,omitempty"`
+	}
+)
+
+// MarshalSnapshot returns the canonical JSON snapshot of the given tables.
+// Note that views and triggers are not part of the snapshot.
+//
+//	b, err := schema.MarshalSnapshot(migrate.Tables)
+//	if err != nil {
+//		log.Fatalf("failed creating schema snapshot: %v", err)
+//	}
+//	err = os.WriteFile("schema.json", b, 0644)
+func MarshalSnapshot(tables []*Table) ([]byte, error) {
+	s := &Snapshot{Tables: make([]*SnapshotTable, 0, len(tables))}
+	for _, t := range tables {
+		st := &SnapshotTable{
+			Name:       t.Name,
+			Schema:     t.Schema,
+			Comment:    t.Comment,
+			Charset:    t.Charset,
+			Collation:  t.Collation,
+			Columns:    make([]*SnapshotColumn, len(t.Columns)),
+			PrimaryKey: columnNames(t.PrimaryKey),
+			Annotation: t.Annotation,
+		}
+		for i, c := range t.Columns {
+			sc := &SnapshotColumn{
+				Name:       c.Name,
+				Type:       snapshotType(c.Type),
+				SchemaType: c.SchemaType,
+				Attr:       c.Attr,
+				Size:       c.Size,
+				Unique:     c.Unique,
+				Increment:  c.Increment,
+				Nullable:   c.Nullable,
+				Enums:      c.Enums,
+				Charset:    c.Charset,
+				Collation:  c.Collation,
+				Comment:    c.Comment,
+				Prefix:     c.Prefix,
+			}
+			switch d := c.Default.(type) {
+			case nil:
+			case Expr:
+				sc.DefaultExpr = map[string]string{"": string(d)}
+			case map[string]Expr:
+				sc.DefaultExpr = make(map[string]string, len(d))
+				for k, v := range d {
+					sc.DefaultExpr[k] = string(v)
+				}
+			default:
+				sc.Default = d
+			}
+			st.Columns[i] = sc
+		}
+		for _, idx := range t.Indexes {
+			st.Indexes = append(st.Indexes, &SnapshotIndex{
+				Name:       idx.Name,
+				Unique:     idx.Unique,
+				Columns:    columnNames(idx.Columns),
+				Annotation: idx.Annotation,
+			})
+		}
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable == nil {
+				return nil, fmt.Errorf("sql/schema: missing referenced table of foreign key %q", fk.Symbol)
+			}
+			st.ForeignKeys = append(st.ForeignKeys, &SnapshotForeignKey{
+				Symbol:     fk.Symbol,
+				Columns:    columnNames(fk.Columns),
+				RefTable:   fk.RefTable.Name,
+				RefColumns: columnNames(fk.RefColumns),
+				OnUpdate:   fk.OnUpdate,
+				OnDelete:   fk.OnDelete,
+				Deferrable: fk.Deferrable,
+			})
+		}
+		s.Tables = append(s.Tables, st)
+	}
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// UnmarshalSnapshot reads the tables of the given JSON snapshot, written by MarshalSnapshot.
+func UnmarshalSnapshot(data []byte) ([]*Table, error) {
+	var s Snapshot
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Numbers are decoded by the type of their columns.
+	dec.UseNumber()
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("sql/schema: decoding snapshot: %w", err)
+	}
+	var (
+		tables = make([]*Table, len(s.Tables))
+		byName = make(map[string]*Table, len(s.Tables))
+	)
+	for i, st := range s.Tables {
+		t := NewTable(st.Name).SetSchema(st.Schema).SetComment(st.Comment)
+		t.Charset, t.Collation, t.Annotation = st.Charset, st.Collation, st.Annotation
+		for _, sc := range st.Columns {
+			c, err := sc.column()
+			if err != nil {
+				return nil, fmt.Errorf("sql/schema: table %q: %w", st.Name, err)
+			}
+			t.AddColumn(c)
+		}
+		columns, err := t.snapshotColumns(st.PrimaryKey)
+		if err != nil {
+			return nil, fmt.Errorf("sql/schema: primary key of table %q: %w", st.Name, err)
+		}
+		t.PrimaryKey = columns
+		for _, si := range st.Indexes {
+			columns, err := t.snapshotColumns(si.Columns)
+			if err != nil {
+				return nil, fmt.Errorf("sql/schema: index %q of table %q: %w", si.Name, st.Name, err)
+			}
+			t.Indexes = append(t.Indexes, &Index{Name: si.Name, Unique: si.Unique, Columns: columns, Annotation: si.Annotation})
+		}
+		tables[i], byName[st.Name] = t, t
+	}
+	for i, st := range s.Tables {
+		t := tables[i]
+		for _, sf := range st.ForeignKeys {
+			ref, ok := byName[sf.RefTable]
+			if !ok {
+				return nil, fmt.Errorf("sql/schema: foreign key %q of table %q: missing referenced table %q", sf.Symbol, st.Name, sf.RefTable)
+			}
+			columns, err := t.snapshotColumns(sf.Columns)
+			if err != nil {
+				return nil, fmt.Errorf("sql/schema: foreign key %q of table %q: %w", sf.Symbol, st.Name, err)
+			}
+			refColumns, err := ref.snapshotColumns(sf.RefColumns)
+			if err != nil {
+				return nil, fmt.Errorf("sql/schema: foreign key %q of table %q: %w", sf.Symbol, st.Name, err)
+			}
+			t.AddForeignKey(&ForeignKey{
+				Symbol:     sf.Symbol,
+				Columns:    columns,
+				RefTable:   ref,
+				RefColumns: refColumns,
+				OnUpdate:   sf.OnUpdate,
+				OnDelete:   sf.OnDelete,
+				Deferrable: sf.Deferrable,
+			})
+		}
+	}
+	return tables, nil
+}
+
+// column returns the column of the snapshot column.
+func (sc *SnapshotColumn) column() (*Column, error) {
+	c := &Column{
+		Name:       sc.Name,
+		SchemaType: sc.SchemaType,
+		Attr:       sc.Attr,
+		Size:       sc.Size,
+		Unique:     sc.Unique,
+		Increment:  sc.Increment,
+		Nullable:   sc.Nullable,
+		Enums:      sc.Enums,
+		Charset:    sc.Charset,
+		Collation:  sc.Collation,
+		Comment:    sc.Comment,
+		Prefix:     sc.Prefix,
+	}
+	for t := field.TypeBool; t.Valid(); t++ {
+		if snapshotType(t) == sc.Type {
+			c.Type = t
+		}
+	}
+	if c.Type == field.TypeInvalid {
+		return nil, fmt.Errorf("column %q: unknown type %q", sc.Name, sc.Type)
+	}
+	switch d := sc.Default.(type) {
+	case nil:
+	case json.Number:
+		var err error
+		switch {
+		case c.IntType():
+			c.Default, err = d.Int64()
+		case c.UintType():
+			var v int64
+			v, err = d.Int64()
+			c.Default = uint64(v)
+		default:
+			c.Default, err = d.Float64()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("column %q: invalid default value: %w", sc.Name, err)
+		}
+	default:
+		c.Default = d
+	}
+	if x, ok := sc.DefaultExpr[""]; ok && len(sc.DefaultExpr) == 1 {
+		c.Default = Expr(x)
+	} else if len(sc.DefaultExpr) > 0 {
+		m := make(map[string]Expr, len(sc.DefaultExpr))
+		for k, v := range sc.DefaultExpr {
+			m[k] = Expr(v)
+		}
+		c.Default = m
+	}
+	return c, nil
+}
+
+// snapshotType returns the name of the type in snapshots. e.g. "enum" or "int64".
+func snapshotType(t field.Type) string {
+	return strings.ToLower(strings.TrimPrefix(t.ConstName(), "Type"))
+}
+
+// snapshotColumns returns the columns of the table by their names.
+func (t *Table) snapshotColumns(names []string) ([]*Column, error) {
+	columns := make([]*Column, len(names))
+	for i, name := range names {
+		c, ok := t.Column(name)
+		if !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+		columns[i] = c
+	}
+	return columns, nil
+}
+
+func columnNames(columns []*Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+type (
+	// DriftReport describes the differences between the connected database and the desired tables.
+	DriftReport struct {
+		Drifted bool           `json:"drifted"`
+		Changes []*DriftChange `json:"changes,omitempty"`
+	}
+
+	// DriftChange describes a single difference between the database and the desired tables. The kind
+	// of the change describes the database object from the perspective of the desired tables. For
+	// example, "missing_column" for a column that does not exist in the database.
+	DriftChange struct {
+		Table string `json:"table"`
+		Kind  string `json:"kind"`
+		// Name of the column, index or foreign key.
+		Name string `json:"name,omitempty"`
+		// Details of modified objects. e.g. "type" or "null".
+		Details []string `json:"details,omitempty"`
+	}
+)
+
+// Drift compares the connected database with the given tables, usually read from a snapshot, and reports
+// their differences, without applying any change. Note that drops of columns and indexes are reported only
+// if they are enabled using the WithDropColumn and WithDropIndex options, the same as in migrations, and
+// missing PostgreSQL temporary tables are not reported, as they exist only in the session that created them.
+//
+//	tables, err := schema.UnmarshalSnapshot(b)
+//	if err != nil {
+//		return err
+//	}
+//	report, err := m.Drift(ctx, tables...)
+//	if err != nil {
+//		return err
+//	}
+//	if report.Drifted {
+//		json.NewEncoder(os.Stdout).Encode(report)
+//	}
+func (a *Atlas) Drift(ctx context.Context, tables ...*Table) (*DriftReport, error) {
+	changes, err := a.Changes(ctx, tables...)
+	if err != nil {
+		return nil, err
+	}
+	temporary := make(map[string]bool)
+	for _, t := range tables {
+		if tablePersistence(t) == entsql.PersistenceTemporary {
+			temporary[t.Name] = true
+		}
+	}
+	r := &DriftReport{}
+	for _, c := range changes {
+		switch c := c.(type) {
+		case *schema.AddTable:
+			// Temporary tables are created per session, and
+			// are not expected to exist in the inspected schema.
+			if a.dialect == dialect.Postgres && temporary[c.T.Name] {
+				continue
+			}
+			r.Changes = append(r.Changes, &DriftChange{Table: c.T.Name, Kind: "missing_table"})
+		case *schema.DropTable:
+			r.Changes = append(r.Changes, &DriftChange{Table: c.T.Name, Kind: "extra_table"})
+		case *schema.ModifyTable:
+			for _, mc := range c.Changes {
+				if dc := driftChange(mc); dc != nil {
+					dc.Table = c.T.Name
+					r.Changes = append(r.Changes, dc)
+				}
+			}
+		}
+	}
+	r.Drifted = len(r.Changes) > 0
+	return r, nil
+}
+
+// driftChange returns the drift of the given table change.
+func driftChange(c schema.Change) *DriftChange {
+	switch c := c.(type) {
+	case *schema.AddColumn:
+		return &DriftChange{Kind: "missing_column", Name: c.C.Name}
+	case *schema.DropColumn:
+		return &DriftChange{Kind: "extra_column", Name: c.C.Name}
+	case *schema.ModifyColumn:
+		return &DriftChange{Kind: "changed_column", Name: c.To.Name, Details: changeDetails(c.Change)}
+	case *schema.RenameColumn:
+		return &DriftChange{Kind: "renamed_column", Name: c.To.Name, Details: []string{"from " + c.From.Name}}
+	case *schema.AddIndex:
+		return &DriftChange{Kind: "missing_index", Name: c.I.Name}
+	case *schema.DropIndex:
+		return &DriftChange{Kind: "extra_index", Name: c.I.Name}
+	case *schema.ModifyIndex:
+		return &DriftChange{Kind: "changed_index", Name: c.To.Name, Details: changeDetails(c.Change)}
+	case *schema.RenameIndex:
+		return &DriftChange{Kind: "renamed_index", Name: c.To.Name, Details: []string{"from " + c.From.Name}}
+	case *schema.AddForeignKey:
+		return &DriftChange{Kind: "missing_foreign_key", Name: c.F.Symbol}
+	case *schema.DropForeignKey:
+		return &DriftChange{Kind: "extra_foreign_key", Name: c.F.Symbol}
+	case *schema.ModifyForeignKey:
+		return &DriftChange{Kind: "changed_foreign_key", Name: c.To.Symbol, Details: changeDetails(c.Change)}
+	case *schema.AddPrimaryKey, *schema.DropPrimaryKey, *schema.ModifyPrimaryKey:
+		return &DriftChange{Kind: "changed_primary_key"}
+	case *schema.AddCheck:
+		return &DriftChange{Kind: "missing_check", Name: c.C.Name}
+	case *schema.DropCheck:
+		return &DriftChange{Kind: "extra_check", Name: c.C.Name}
+	case *schema.ModifyCheck:
+		return &DriftChange{Kind: "changed_check", Name: c.To.Name}
+	case *schema.AddAttr, *schema.DropAttr, *schema.ModifyAttr:
+		return &DriftChange{Kind: "changed_table"}
+	default:
+		return nil
+	}
+}
+
+// changeKinds holds the names of the change kinds, in the order they are reported.
+var changeKinds = []struct {
+	kind schema.ChangeKind
+	name string
+}{
+	{schema.ChangeType, "type"},
+	{schema.ChangeNull, "null"},
+	{schema.ChangeDefault, "default"},
+	{schema.ChangeGenerated, "generated"},
+	{schema.ChangeUnique, "unique"},
+	{schema.ChangeParts, "parts"},
+	{schema.ChangeColumn, "columns"},
+	{schema.ChangeRefTable, "ref_table"},
+	{schema.ChangeRefColumn, "ref_columns"},
+	{schema.ChangeUpdateAction, "on_update"},
+	{schema.ChangeDeleteAction, "on_delete"},
+	{schema.ChangeCharset, "charset"},
+	{schema.ChangeCollate, "collation"},
+	{schema.ChangeComment, "comment"},
+	{schema.ChangeAttr, "attr"},
+}
+
+// changeDetails returns the names of the given change kinds.
+func changeDetails(k schema.ChangeKind) []string {
+	var details []string
+	for _, c := range changeKinds {
+		if k.Is(c.kind) {
+			details = append(details, c.name)
+		}
+	}
+	return details
+}
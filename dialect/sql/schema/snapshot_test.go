@@ -0,0 +1,124 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func snapshotTables() []*Table {
+	var (
+		usersColumns = []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "name", Type: field.TypeString, Size: 100, Default: "a8m"},
+			{Name: "age", Type: field.TypeInt, Default: 30},
+			{Name: "active", Type: field.TypeBool, Default: true},
+			{Name: "created_at", Type: field.TypeTime, Default: Expr("CURRENT_TIMESTAMP")},
+			{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user"}, Nullable: true},
+		}
+		users = &Table{
+			Name:       "users",
+			Columns:    usersColumns,
+			PrimaryKey: []*Column{usersColumns[0]},
+			Indexes: []*Index{
+				{Name: "user_name_age", Unique: true, Columns: []*Column{usersColumns[1], usersColumns[2]}},
+			},
+			Annotation: &entsql.Annotation{Table: "users", Charset: "utf8mb4"},
+		}
+		petsColumns = []*Column{
+			{Name: "id", Type: field.TypeInt, Increment: true},
+			{Name: "owner_id", Type: field.TypeInt, Nullable: true},
+		}
+		pets = &Table{
+			Name:       "pets",
+			Columns:    petsColumns,
+			PrimaryKey: []*Column{petsColumns[0]},
+			ForeignKeys: []*ForeignKey{
+				{Symbol: "pets_users_pets", Columns: []*Column{petsColumns[1]}, RefColumns: []*Column{usersColumns[0]}, RefTable: users, OnDelete: SetNull},
+			},
+		}
+	)
+	return []*Table{users, pets}
+}
+
+func TestSnapshot(t *testing.T) {
+	b, err := MarshalSnapshot(snapshotTables())
+	require.NoError(t, err)
+	tables, err := UnmarshalSnapshot(b)
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	users, pets := tables[0], tables[1]
+	require.Equal(t, "users", users.Name)
+	require.Equal(t, "utf8mb4", users.Annotation.Charset)
+	require.Equal(t, []*Column{users.Columns[0]}, users.PrimaryKey)
+	require.Equal(t, "a8m", users.Columns[1].Default)
+	require.Equal(t, int64(30), users.Columns[2].Default)
+	require.Equal(t, true, users.Columns[3].Default)
+	require.Equal(t, Expr("CURRENT_TIMESTAMP"), users.Columns[4].Default)
+	require.Equal(t, field.TypeEnum, users.Columns[5].Type)
+	require.Equal(t, []string{"admin", "user"}, users.Columns[5].Enums)
+	require.Len(t, users.Indexes, 1)
+	require.Equal(t, []*Column{users.Columns[1], users.Columns[2]}, users.Indexes[0].Columns)
+	require.Len(t, pets.ForeignKeys, 1)
+	require.Same(t, users, pets.ForeignKeys[0].RefTable)
+	require.Same(t, users.Columns[0], pets.ForeignKeys[0].RefColumns[0])
+	require.Equal(t, SetNull, pets.ForeignKeys[0].OnDelete)
+
+	// Snapshots are canonical.
+	b2, err := MarshalSnapshot(tables)
+	require.NoError(t, err)
+	require.JSONEq(t, string(b), string(b2))
+	require.Equal(t, string(b), string(b2))
+
+	_, err = UnmarshalSnapshot([]byte(`{"tables":[{"name":"users","columns":[{"name":"id","type":"integer"}]}]}`))
+	require.EqualError(t, err, `sql/schema: table "users": column "id": unknown type "integer"`)
+	_, err = UnmarshalSnapshot([]byte(`{"tables":[{"name":"users","columns":[{"name":"id","type":"int"}],"primary_key":["uid"]}]}`))
+	require.EqualError(t, err, `sql/schema: primary key of table "users": missing column "uid"`)
+}
+
+func TestAtlas_Drift(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:drift?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	b, err := MarshalSnapshot(snapshotTables())
+	require.NoError(t, err)
+	tables, err := UnmarshalSnapshot(b)
+	require.NoError(t, err)
+
+	m, err := NewMigrate(db)
+	require.NoError(t, err)
+	report, err := m.Drift(ctx, tables...)
+	require.NoError(t, err)
+	require.True(t, report.Drifted)
+	require.Equal(t, []*DriftChange{{Table: "users", Kind: "missing_table"}, {Table: "pets", Kind: "missing_table"}}, report.Changes)
+
+	require.NoError(t, m.Create(ctx, tables...))
+	tables, err = UnmarshalSnapshot(b)
+	require.NoError(t, err)
+	report, err = m.Drift(ctx, tables...)
+	require.NoError(t, err)
+	require.False(t, report.Drifted)
+	require.Empty(t, report.Changes)
+
+	require.NoError(t, db.Exec(ctx, "ALTER TABLE `pets` ADD COLUMN `name` text NULL", []any{}, nil))
+	require.NoError(t, db.Exec(ctx, "DROP INDEX `user_name_age`", []any{}, nil))
+	m, err = NewMigrate(db, WithDropColumn(true))
+	require.NoError(t, err)
+	tables, err = UnmarshalSnapshot(b)
+	require.NoError(t, err)
+	report, err = m.Drift(ctx, tables...)
+	require.NoError(t, err)
+	require.True(t, report.Drifted)
+	require.Equal(t, []*DriftChange{{Table: "users", Kind: "missing_index", Name: "user_name_age"}, {Table: "pets", Kind: "extra_column", Name: "name"}}, report.Changes)
+}
@@ -26,6 +26,9 @@ type (
 	SQLite struct {
 		dialect.Driver
 		WithForeignKeys bool
+		// WithTableRecreate enables modifying and dropping
+		// columns by recreating tables. See WithSQLiteTableRecreate.
+		WithTableRecreate bool
 	}
 	// SQLiteTx implements dialect.Tx.
 	SQLiteTx struct {
@@ -370,16 +373,188 @@ func (d *SQLite) alterColumns(table string, add, _, _ []*Column) sql.Queries {
 		}
 		queries = append(queries, sql.Dialect(dialect.SQLite).AlterTable(table).AddColumn(c))
 	}
-	// Modifying and dropping columns is not supported and disabled until we
-	// will support https://www.sqlite.org/lang_altertable.html#otheralter
+	// Modifying and dropping columns is not supported by ALTER TABLE, and the table
+	// is recreated instead when WithTableRecreate is enabled. See recreateTable.
 	return queries
 }
 
+// recreateTables reports if column changes are applied by recreating tables.
+func (d *SQLite) recreateTables() bool {
+	return d.WithTableRecreate
+}
+
+// recreateTable recreates the given table with its new definition, following the procedure that
+// is described in https://www.sqlite.org/lang_altertable.html#otheralter. Note that foreign keys
+// are disabled in the migration transaction, and they are checked before it is committed.
+func (d *SQLite) recreateTable(ctx context.Context, tx dialect.Tx, curr, t *Table, keepIndexes bool) error {
+	indexes, err := d.indexStmts(ctx, tx, t.Name)
+	if err != nil {
+		return err
+	}
+	seq, hasSeq, err := d.sequence(ctx, tx, t.Name)
+	if err != nil {
+		return err
+	}
+	tmp := *t
+	tmp.Name = "new_" + t.Name
+	columns := make([]string, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		if _, ok := curr.column(c.Name); ok {
+			columns = append(columns, c.Name)
+		}
+	}
+	b := sql.Dialect(dialect.SQLite)
+	queries := []sql.Querier{
+		d.tBuilder(&tmp),
+		b.Expr(func(b *sql.Builder) {
+			b.WriteString("INSERT INTO ").Ident(tmp.Name).WriteByte(' ').Wrap(func(b *sql.Builder) {
+				b.IdentComma(columns...)
+			})
+			b.WriteString(" SELECT ").IdentComma(columns...).WriteString(" FROM ").Ident(t.Name)
+		}),
+		b.Expr(func(b *sql.Builder) {
+			b.WriteString("DROP TABLE ").Ident(t.Name)
+		}),
+		b.Expr(func(b *sql.Builder) {
+			b.WriteString("ALTER TABLE ").Ident(tmp.Name).WriteString(" RENAME TO ").Ident(t.Name)
+		}),
+	}
+	for _, q := range queries {
+		query, args := q.Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return err
+		}
+	}
+	// The AUTOINCREMENT counter is reset when the table is dropped.
+	if hasSeq {
+		if err := d.setRange(ctx, tx, t, seq); err != nil {
+			return err
+		}
+	}
+	for _, idx := range t.Indexes {
+		query, args := d.addIndex(idx, t.Name).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("create index %q: %w", idx.Name, err)
+		}
+	}
+	if !keepIndexes {
+		return nil
+	}
+	// Indexes that were removed from the schema are recreated from their
+	// original definition, unless dropping indexes was enabled.
+Indexes:
+	for _, idx := range curr.Indexes {
+		stmt, ok := indexes[idx.Name]
+		if !ok || t.hasIndex(idx.Name) {
+			continue
+		}
+		for _, c := range idx.columns {
+			if _, ok := t.column(c); !ok {
+				continue Indexes
+			}
+		}
+		if err := tx.Exec(ctx, stmt, []any{}, nil); err != nil {
+			return fmt.Errorf("create index %q: %w", idx.Name, err)
+		}
+	}
+	return nil
+}
+
+// indexStmts returns the definitions of the indexes that were created explicitly on the
+// given table (i.e. not by UNIQUE or PRIMARY KEY constraints), mapped by their names.
+func (d *SQLite) indexStmts(ctx context.Context, tx dialect.Tx, name string) (map[string]string, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Select("name", "sql").
+		From(sql.Table("sqlite_master")).
+		Where(sql.And(
+			sql.EQ("type", "index"),
+			sql.EQ("tbl_name", name),
+			sql.NotNull("sql"),
+		)).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("sqlite: reading index definitions of table %q: %w", name, err)
+	}
+	defer rows.Close()
+	stmts := make(map[string]string)
+	for rows.Next() {
+		var idx, stmt string
+		if err := rows.Scan(&idx, &stmt); err != nil {
+			return nil, fmt.Errorf("sqlite: scanning index definition: %w", err)
+		}
+		stmts[idx] = stmt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return stmts, rows.Close()
+}
+
+// sequence returns the AUTOINCREMENT counter of the given table, if it exists.
+func (d *SQLite) sequence(ctx context.Context, tx dialect.Tx, name string) (int64, bool, error) {
+	// The "sqlite_sequence" table is created with the first AUTOINCREMENT column.
+	exists, err := d.tableExist(ctx, tx, "sqlite_sequence")
+	if err != nil || !exists {
+		return 0, false, err
+	}
+	rows := &sql.Rows{}
+	query, args := sql.Select("seq").
+		From(sql.Table("sqlite_sequence")).
+		Where(sql.EQ("name", name)).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, false, fmt.Errorf("sqlite: reading sequence of table %q: %w", name, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return 0, false, rows.Err()
+	}
+	var seq int64
+	if err := rows.Scan(&seq); err != nil {
+		return 0, false, fmt.Errorf("sqlite: scanning sequence of table %q: %w", name, err)
+	}
+	return seq, true, rows.Close()
+}
+
 // tables returns the query for getting the in the schema.
 func (d *SQLite) tables() sql.Querier {
 	return sql.Select("name").
 		From(sql.Table("sqlite_schema")).
-		Where(sql.EQ("type", "table"))
+		Where(sql.And(
+			sql.EQ("type", "table"),
+			// Skip internal tables, like sqlite_sequence.
+			sql.Not(sql.Like("name", "sqlite_%")),
+		))
+}
+
+// foreignKeys populates the tables foreign keys using the foreign_key_list pragma. Note that SQLite
+// does not report the names of the constraints, and therefore, they are identified by their ids.
+func (d *SQLite) foreignKeys(ctx context.Context, tx dialect.Tx, tables []*Table) error {
+	lookup := make(map[string]*Table, len(tables))
+	for _, t := range tables {
+		lookup[t.Name] = t
+	}
+	for _, t := range tables {
+		rows := &sql.Rows{}
+		query, args := sql.Select("id", "from", "table", "to").
+			From(sql.Table(fmt.Sprintf("pragma_foreign_key_list('%s')", t.Name)).Unquote()).
+			OrderBy("id", "seq").
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return fmt.Errorf("sqlite: reading foreign keys of table %q: %w", t.Name, err)
+		}
+		if err := scanForeignKeys(rows, lookup, t); err != nil {
+			rows.Close()
+			return fmt.Errorf("sqlite: %w", err)
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+		for _, fk := range t.ForeignKeys {
+			fk.Symbol = ""
+		}
+	}
+	return nil
 }
 
 // needsConversion reports if column "old" needs to be converted
@@ -401,6 +576,13 @@ func (d *SQLite) atTable(t1 *Table, t2 *schema.Table) {
 	}
 }
 
+func (d *SQLite) atPartition(t1 *Table, _ *schema.Table) error {
+	if t1.Annotation != nil && t1.Annotation.Partition != nil {
+		return fmt.Errorf("sql/schema: sqlite does not support table partitioning (table %q)", t1.Name)
+	}
+	return nil
+}
+
 func (d *SQLite) supportsDefault(*Column) bool {
 	// SQLite supports default values for all standard types.
 	return true
@@ -500,6 +682,96 @@ func (d *SQLite) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) erro
 	return nil
 }
 
+// fullTextTables returns the FTS5 tables of the full-text indexes of the given tables,
+// and the triggers that keep them in sync, that exist in the database.
+func (d *SQLite) fullTextTables(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]bool, error) {
+	var names []any
+	for _, t := range tables {
+		for _, idx := range t.Indexes {
+			if fullTextIndex(idx) {
+				fts := sql.FullTextTable(t.Name, idx.Columns[0].Name)
+				names = append(names, fts, fts+"_ai", fts+"_ad", fts+"_au")
+			}
+		}
+	}
+	exists := make(map[string]bool)
+	if len(names) == 0 {
+		return exists, nil
+	}
+	rows := &sql.Rows{}
+	query, args := sql.Select("name").
+		From(sql.Table("sqlite_master")).
+		Where(sql.And(
+			sql.In("type", "table", "trigger"),
+			sql.In("name", names...),
+		)).
+		Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("sqlite: querying full-text tables: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("sqlite: scanning full-text tables: %w", err)
+		}
+		exists[name] = true
+	}
+	return exists, rows.Err()
+}
+
+// fullTextChanges returns the changes for creating the missing FTS5 tables of the full-text indexes.
+// The tables are defined as external-content tables of their columns, and are kept in sync with them
+// using triggers. The triggers are recreated if they are missing, or if their table is recreated by the
+// planned changes, as SQLite drops them along with the table. Existing rows are indexed by rebuilding
+// the FTS5 table.
+func (d *SQLite) fullTextChanges(tables []*Table, exists map[string]bool, planned []*migrate.Change) []*migrate.Change {
+	var changes []*migrate.Change
+	for _, t := range tables {
+		recreated := false
+		for _, c := range planned {
+			if c.Cmd == fmt.Sprintf("DROP TABLE `%s`", t.Name) {
+				recreated = true
+			}
+		}
+		for _, idx := range t.Indexes {
+			if !fullTextIndex(idx) {
+				continue
+			}
+			var (
+				created []*migrate.Change
+				c, fts  = idx.Columns[0].Name, sql.FullTextTable(t.Name, idx.Columns[0].Name)
+				insert  = fmt.Sprintf("INSERT INTO `%s`(`rowid`, `%s`) VALUES (new.`rowid`, new.`%s`);", fts, c, c)
+				remove  = fmt.Sprintf("INSERT INTO `%s`(`%s`, `rowid`, `%s`) VALUES ('delete', old.`rowid`, old.`%s`);", fts, fts, c, c)
+			)
+			if !exists[fts] {
+				created = append(created, &migrate.Change{
+					Cmd:     fmt.Sprintf("CREATE VIRTUAL TABLE `%s` USING fts5(`%s`, content='%s')", fts, c, t.Name),
+					Comment: fmt.Sprintf("create %q full-text table of index %q", fts, idx.Name),
+				})
+			}
+			for _, tr := range []struct{ name, event, body string }{
+				{fts + "_ai", "INSERT", insert},
+				{fts + "_ad", "DELETE", remove},
+				{fts + "_au", fmt.Sprintf("UPDATE OF `%s`", c), remove + " " + insert},
+			} {
+				if !exists[tr.name] || recreated {
+					created = append(created, &migrate.Change{
+						Cmd: fmt.Sprintf("CREATE TRIGGER `%s` AFTER %s ON `%s` BEGIN %s END", tr.name, tr.event, t.Name, tr.body),
+					})
+				}
+			}
+			if len(created) > 0 {
+				changes = append(append(changes, created...), &migrate.Change{
+					Cmd:     fmt.Sprintf("INSERT INTO `%s`(`%s`) VALUES ('rebuild')", fts, fts),
+					Comment: fmt.Sprintf("index the existing rows of table %q", t.Name),
+				})
+			}
+		}
+	}
+	return changes
+}
+
 func (*SQLite) atTypeRangeSQL(ts ...string) string {
 	for i := range ts {
 		ts[i] = fmt.Sprintf("('%s')", ts[i])
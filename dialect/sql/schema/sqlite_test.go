@@ -11,9 +11,11 @@ import (
 	"testing"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
+	"ariga.io/atlas/sql/migrate"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
@@ -445,6 +447,54 @@ func TestSQLite_Create(t *testing.T) {
 	}
 }
 
+func TestSQLite_FullText(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	var (
+		ctx   = context.Background()
+		d     = &SQLite{Driver: sql.OpenDB(dialect.SQLite, db)}
+		posts = NewTable("posts").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt}).
+			AddColumn(&Column{Name: "title", Type: field.TypeString}).
+			AddIndex("post_title", false, []string{"title"})
+	)
+	exists, err := d.fullTextTables(ctx, d, []*Table{posts})
+	require.NoError(t, err)
+	require.Empty(t, exists, "full-text tables are not queried if there are no full-text indexes")
+
+	posts.Indexes[0].Annotation = entsql.FullText()
+	mock.ExpectQuery("SELECT `name` FROM `sqlite_master` WHERE `type` IN (?, ?) AND `name` IN (?, ?, ?, ?)").
+		WithArgs("table", "trigger", "posts_title_fts", "posts_title_fts_ai", "posts_title_fts_ad", "posts_title_fts_au").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("posts_title_fts").AddRow("posts_title_fts_ai"))
+	exists, err = d.fullTextTables(ctx, d, []*Table{posts})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"posts_title_fts": true, "posts_title_fts_ai": true}, exists)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	cmds := func(changes []*migrate.Change) []string {
+		cmds := make([]string, len(changes))
+		for i, c := range changes {
+			cmds[i] = c.Cmd
+		}
+		return cmds
+	}
+	require.Equal(t, []string{
+		"CREATE TRIGGER `posts_title_fts_ad` AFTER DELETE ON `posts` BEGIN INSERT INTO `posts_title_fts`(`posts_title_fts`, `rowid`, `title`) VALUES ('delete', old.`rowid`, old.`title`); END",
+		"CREATE TRIGGER `posts_title_fts_au` AFTER UPDATE OF `title` ON `posts` BEGIN INSERT INTO `posts_title_fts`(`posts_title_fts`, `rowid`, `title`) VALUES ('delete', old.`rowid`, old.`title`); INSERT INTO `posts_title_fts`(`rowid`, `title`) VALUES (new.`rowid`, new.`title`); END",
+		"INSERT INTO `posts_title_fts`(`posts_title_fts`) VALUES ('rebuild')",
+	}, cmds(d.fullTextChanges([]*Table{posts}, exists, nil)))
+
+	exists["posts_title_fts_ad"], exists["posts_title_fts_au"] = true, true
+	require.Empty(t, d.fullTextChanges([]*Table{posts}, exists, nil))
+	// Triggers are dropped along with their table.
+	changes := d.fullTextChanges([]*Table{posts}, exists, []*migrate.Change{{Cmd: "DROP TABLE `posts`"}})
+	require.Len(t, changes, 4)
+	require.Equal(t, "CREATE TRIGGER `posts_title_fts_ai` AFTER INSERT ON `posts` BEGIN INSERT INTO `posts_title_fts`(`rowid`, `title`) VALUES (new.`rowid`, new.`title`); END", changes[0].Cmd)
+	changes = d.fullTextChanges([]*Table{posts}, nil, nil)
+	require.Len(t, changes, 5)
+	require.Equal(t, "CREATE VIRTUAL TABLE `posts_title_fts` USING fts5(`title`, content='posts')", changes[0].Cmd)
+}
+
 type sqliteMock struct {
 	sqlmock.Sqlmock
 }
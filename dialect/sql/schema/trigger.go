@@ -0,0 +1,179 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// Trigger represents a trigger of a table, that is created by the migration.
+type Trigger struct {
+	Name string
+	// Definition holds the statement that creates the trigger. It may hold additional statements
+	// that the trigger depends on, like the "CREATE OR REPLACE FUNCTION" statement in PostgreSQL.
+	Definition string
+	// Definitions holds dialect-specific statements of the trigger, and it
+	// takes precedence over Definition for the listed dialects.
+	Definitions map[string]string
+}
+
+// NewTrigger returns a new trigger with the given name.
+func NewTrigger(name string) *Trigger {
+	return &Trigger{Name: name}
+}
+
+// SetDefinition sets the statement that creates the trigger.
+func (t *Trigger) SetDefinition(def string) *Trigger {
+	t.Definition = def
+	return t
+}
+
+// SetDefinitionFor sets the statement that creates the trigger for the given dialect.
+func (t *Trigger) SetDefinitionFor(name, def string) *Trigger {
+	if t.Definitions == nil {
+		t.Definitions = make(map[string]string)
+	}
+	t.Definitions[name] = def
+	return t
+}
+
+// definition returns the statement of the trigger for the given dialect, and reports if the trigger
+// is defined for this dialect. The trailing semicolon is trimmed, as it is added by the plan formatter.
+func (t *Trigger) definition(name string) (string, bool) {
+	def, ok := t.Definitions[name]
+	if !ok {
+		def, ok = t.Definition, t.Definition != ""
+	}
+	return strings.TrimSuffix(strings.TrimSpace(def), ";"), ok
+}
+
+// hasTriggers reports if any of the given tables defines triggers.
+func hasTriggers(tables []*Table) bool {
+	for _, t := range tables {
+		if len(t.Triggers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// triggerState returns the checksums of the triggers that were created by previous
+// migrations, keyed by the names of their tables. The state is read only if any of
+// the tables defines triggers.
+func (a *Atlas) triggerState(ctx context.Context, conn dialect.ExecQuerier, tables []*Table) (map[string]map[string]string, error) {
+	if !hasTriggers(tables) {
+		return nil, nil
+	}
+	state := make(map[string]map[string]string)
+	exists, err := a.sqlDialect.tableExist(ctx, conn, TriggerTable)
+	if err != nil || !exists {
+		return state, err
+	}
+	rows := &entsql.Rows{}
+	query, args := entsql.Dialect(a.dialect).
+		Select("table_name", "name", "checksum").From(entsql.Table(TriggerTable)).Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("query triggers table: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table, name, checksum string
+		if err := rows.Scan(&table, &name, &checksum); err != nil {
+			return nil, fmt.Errorf("scan triggers table: %w", err)
+		}
+		if state[table] == nil {
+			state[table] = make(map[string]string)
+		}
+		state[table][name] = checksum
+	}
+	return state, rows.Err()
+}
+
+// planTriggers adds to the plan the triggers that do not exist or were changed, and drops the triggers that were
+// removed from their tables. Changed and removed triggers are dropped at the beginning of the plan, as the changes
+// of the tables may conflict with them, and the new triggers are created after the tables were migrated.
+func (a *Atlas) planTriggers(plan *migrate.Plan, tables []*Table, state map[string]map[string]string) {
+	if state == nil {
+		return
+	}
+	b := &entsql.Builder{}
+	b.SetDialect(a.dialect)
+	forget := func(t *Table, name string) *migrate.Change {
+		return &migrate.Change{
+			Cmd: fmt.Sprintf("DELETE FROM %s WHERE %s = %s AND %s = %s", b.Quote(TriggerTable),
+				b.Quote("table_name"), quoteString(t.Name), b.Quote("name"), quoteString(name)),
+		}
+	}
+	var drop, create []*migrate.Change
+	for _, t := range tables {
+		current, defined := state[t.Name], make(map[string]bool)
+		for _, tr := range t.Triggers {
+			def, ok := tr.definition(a.dialect)
+			if !ok {
+				continue
+			}
+			defined[tr.Name] = true
+			checksum := stmtChecksum(def)
+			prev, ok := current[tr.Name]
+			if ok && prev == checksum {
+				continue
+			}
+			if ok {
+				drop = append(drop, &migrate.Change{
+					Cmd:     a.dropTrigger(t, tr.Name),
+					Comment: fmt.Sprintf("drop %q trigger of table %q", tr.Name, t.Name),
+				})
+			}
+			create = append(create,
+				&migrate.Change{
+					Cmd:     def,
+					Comment: fmt.Sprintf("create %q trigger of table %q", tr.Name, t.Name),
+				},
+				forget(t, tr.Name),
+				&migrate.Change{
+					Cmd: fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (%s, %s, %s)", b.Quote(TriggerTable), b.Quote("table_name"),
+						b.Quote("name"), b.Quote("checksum"), quoteString(t.Name), quoteString(tr.Name), quoteString(checksum)),
+					Comment: fmt.Sprintf("record checksum of %q trigger", tr.Name),
+				},
+			)
+		}
+		removed := make([]string, 0, len(current))
+		for name := range current {
+			if !defined[name] {
+				removed = append(removed, name)
+			}
+		}
+		sort.Strings(removed)
+		for _, name := range removed {
+			drop = append(drop,
+				&migrate.Change{
+					Cmd:     a.dropTrigger(t, name),
+					Comment: fmt.Sprintf("drop %q trigger of table %q", name, t.Name),
+				},
+				forget(t, name),
+			)
+		}
+	}
+	plan.Changes = append(append(drop, plan.Changes...), create...)
+}
+
+// dropTrigger returns the statement for dropping the given trigger of the table.
+func (a *Atlas) dropTrigger(t *Table, name string) string {
+	if a.dialect == dialect.Postgres {
+		b := &entsql.Builder{}
+		b.SetDialect(a.dialect)
+		return fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", b.Quote(name), a.qualifiedName(t.Schema, t.Name))
+	}
+	// In MySQL and SQLite, triggers are defined in the scope of the schema.
+	return fmt.Sprintf("DROP TRIGGER IF EXISTS %s", a.qualifiedName(t.Schema, name))
+}
@@ -0,0 +1,104 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_Triggers(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:triggers?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	var (
+		ctx   = context.Background()
+		users = NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "name", Type: field.TypeString}).
+			AddColumn(&Column{Name: "version", Type: field.TypeInt, Default: 0})
+		trigger = NewTrigger("users_version").
+			SetDefinition("CREATE TRIGGER `users_version` AFTER UPDATE OF `name` ON `users` FOR EACH ROW BEGIN UPDATE `users` SET `version` = `version` + 1 WHERE `id` = NEW.`id`; END").
+			SetDefinitionFor(dialect.Postgres, `CREATE TRIGGER "users_version" BEFORE UPDATE ON "users" FOR EACH ROW EXECUTE FUNCTION bump_version()`)
+		create = func() {
+			m, err := NewMigrate(db)
+			require.NoError(t, err)
+			require.NoError(t, m.Create(ctx, users))
+		}
+		scalar = func(query string) int {
+			rows := &sql.Rows{}
+			require.NoError(t, db.Query(ctx, query, []any{}, rows))
+			n, err := sql.ScanInt(rows)
+			require.NoError(t, err)
+			return n
+		}
+		rename = func(name string) {
+			require.NoError(t, db.Exec(ctx, "UPDATE `users` SET `name` = ?", []any{name}, nil))
+		}
+	)
+	users.AddTrigger(trigger)
+	create()
+	create()
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`name`) VALUES ('a8m')", []any{}, nil))
+	rename("nati")
+	require.Equal(t, 1, scalar("SELECT `version` FROM `users`"))
+
+	// Changed triggers are dropped and re-created.
+	trigger.SetDefinition("CREATE TRIGGER `users_version` AFTER UPDATE OF `name` ON `users` FOR EACH ROW BEGIN UPDATE `users` SET `version` = `version` + 10 WHERE `id` = NEW.`id`; END")
+	create()
+	rename("a8m")
+	require.Equal(t, 11, scalar("SELECT `version` FROM `users`"))
+	require.Equal(t, 1, scalar("SELECT COUNT(*) FROM `ent_triggers`"))
+
+	// Removed triggers are dropped, as long as their table is migrated.
+	users.AddTrigger(NewTrigger("postgres_only").SetDefinitionFor(dialect.Postgres, "CREATE TRIGGER ..."))
+	users.Triggers = users.Triggers[1:]
+	create()
+	rename("nati")
+	require.Equal(t, 11, scalar("SELECT `version` FROM `users`"))
+	require.Equal(t, 0, scalar("SELECT COUNT(*) FROM `sqlite_master` WHERE `type` = 'trigger'"))
+	require.Equal(t, 0, scalar("SELECT COUNT(*) FROM `ent_triggers`"))
+}
+
+func TestAtlas_PlanTriggers(t *testing.T) {
+	var (
+		a     = &Atlas{dialect: dialect.Postgres}
+		users = NewTable("users").SetSchema("public").
+			AddTrigger(NewTrigger("users_updated_at").SetDefinition(`CREATE TRIGGER "users_updated_at" BEFORE UPDATE ON "users" FOR EACH ROW EXECUTE FUNCTION set_updated_at()`)).
+			AddTrigger(NewTrigger("users_audit").SetDefinition(`CREATE TRIGGER "users_audit" AFTER INSERT ON "users" FOR EACH ROW EXECUTE FUNCTION audit()`))
+		plan = &migrate.Plan{Changes: []*migrate.Change{{Cmd: `ALTER TABLE "users" ADD COLUMN "age" bigint NOT NULL`}}}
+	)
+	a.planTriggers(plan, []*Table{users}, map[string]map[string]string{
+		"users": {
+			"users_updated_at": stmtChecksum(users.Triggers[0].Definition),
+			"users_audit":      "changed",
+			"users_removed":    "removed",
+		},
+	})
+	cmds := make([]string, len(plan.Changes))
+	for i, c := range plan.Changes {
+		cmds[i] = c.Cmd
+	}
+	require.Equal(t, []string{
+		`DROP TRIGGER IF EXISTS "users_audit" ON "public"."users"`,
+		`DROP TRIGGER IF EXISTS "users_removed" ON "public"."users"`,
+		`DELETE FROM "ent_triggers" WHERE "table_name" = 'users' AND "name" = 'users_removed'`,
+		`ALTER TABLE "users" ADD COLUMN "age" bigint NOT NULL`,
+		users.Triggers[1].Definition,
+		`DELETE FROM "ent_triggers" WHERE "table_name" = 'users' AND "name" = 'users_audit'`,
+		`INSERT INTO "ent_triggers" ("table_name", "name", "checksum") VALUES ('users', 'users_audit', '` + stmtChecksum(users.Triggers[1].Definition) + `')`,
+	}, cmds)
+
+	// MySQL and SQLite triggers are defined in the scope of the schema.
+	a.dialect = dialect.MySQL
+	require.Equal(t, "DROP TRIGGER IF EXISTS `public`.`users_audit`", a.dropTrigger(users, "users_audit"))
+}
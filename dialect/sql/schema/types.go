@@ -0,0 +1,245 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+// TypeInfo describes an entry of the types table (ent_types), that is
+// used for allocating the id ranges of the types when universal ids are enabled.
+type TypeInfo struct {
+	// Name of the type (table), as stored in the types table.
+	Name string
+	// Index is the position of the type in the types table.
+	Index int
+	// Range is the id range that is allocated for the type.
+	Range IDRange
+	// Exists indicates if the table of the type exists in the database.
+	Exists bool
+	// MinID and MaxID hold the smallest and the largest ids that are stored
+	// in the table, and they are valid only if the table is not Empty.
+	MinID, MaxID int64
+	// Empty indicates if the table of the type does not exist or has no rows.
+	Empty bool
+}
+
+// Types returns the entries of the types table (ent_types), alongside the id ranges that are
+// allocated for them and the ids that are stored in their tables. The given tables are used
+// for resolving the primary keys of the types, and types without a table use the "id" column.
+//
+//	types, err := m.Types(ctx, migrate.Tables)
+//	if err != nil {
+//		log.Fatalf("failed reading types: %v", err)
+//	}
+//	for _, t := range types {
+//		fmt.Println(t.Name, t.Range.Start, t.Range.End)
+//	}
+func (a *Atlas) Types(ctx context.Context, tables []*Table) ([]*TypeInfo, error) {
+	release, err := a.openDialect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	types, err := a.loadTypes(ctx, a.sqlDialect)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: %w", err)
+	}
+	infos, err := a.typeInfos(ctx, a.sqlDialect, types, tables)
+	if err != nil {
+		return nil, fmt.Errorf("sql/schema: %w", err)
+	}
+	return infos, nil
+}
+
+// VerifyTypes verifies that the types table (ent_types) matches the given tables and their rows.
+// An error is returned if a table is not registered in the types table, if the ranges of two types
+// overlap, or if the ids of a table are outside of its range. For example, after restoring a database
+// dump that was taken from another environment.
+//
+// Note that the auto-increment counters are not verified, as RepairTypes re-aligns them.
+func (a *Atlas) VerifyTypes(ctx context.Context, tables []*Table) error {
+	release, err := a.openDialect(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	types, err := a.loadTypes(ctx, a.sqlDialect)
+	if err != nil {
+		return fmt.Errorf("sql/schema: %w", err)
+	}
+	for _, t := range tables {
+		if t.Name != TypeTable && len(t.PrimaryKey) == 1 && indexOf(types, t.Name) == -1 {
+			return fmt.Errorf("sql/schema: table %q is not registered in the types table", t.Name)
+		}
+	}
+	if err := a.verifyTypes(ctx, a.sqlDialect, types, tables); err != nil {
+		return fmt.Errorf("sql/schema: %w", err)
+	}
+	return nil
+}
+
+// RepairTypes repairs the types table (ent_types) and re-aligns the auto-increment counters of the
+// given tables, rather than relying on the implicit restore logic of the migration. It creates the
+// types table if it does not exist, registers the tables that are missing from it, and sets the
+// counter of each table to MAX(id)+1, or to the start of its range if the table is empty.
+//
+//	if err := m.RepairTypes(ctx, migrate.Tables); err != nil {
+//		log.Fatalf("failed repairing types: %v", err)
+//	}
+//
+// Entries that cannot be repaired automatically, like tables with ids outside of their ranges,
+// fail the repair and must be fixed manually.
+func (a *Atlas) RepairTypes(ctx context.Context, tables []*Table) error {
+	release, err := a.openDialect(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	tx, err := a.sqlDialect.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := a.repairTypes(ctx, tx, tables); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (a *Atlas) repairTypes(ctx context.Context, tx dialect.Tx, tables []*Table) error {
+	types, err := a.loadTypes(ctx, tx)
+	if errors.Is(err, errTypeTableNotFound) {
+		query, args := a.sqlDialect.tBuilder(NewTypesTable()).Query()
+		err = tx.Exec(ctx, query, args, nil)
+	}
+	if err != nil {
+		return err
+	}
+	for _, t := range tables {
+		if t.Name == TypeTable || len(t.PrimaryKey) != 1 || indexOf(types, t.Name) != -1 {
+			continue
+		}
+		if len(types) > MaxTypes {
+			return fmt.Errorf("max number of types exceeded: %d", MaxTypes)
+		}
+		query, args := entsql.Dialect(a.dialect).Insert(TypeTable).Columns("type").Values(t.Name).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("insert into ent_types: %w", err)
+		}
+		types = append(types, t.Name)
+	}
+	if err := a.verifyTypes(ctx, tx, types, tables); err != nil {
+		return err
+	}
+	infos, err := a.typeInfos(ctx, tx, types, tables)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		t, ok := tableByName(tables, info.Name)
+		if !ok || !info.Exists {
+			continue
+		}
+		next := info.Range.Start
+		if !info.Empty {
+			next = info.MaxID + 1
+			// SQLite stores the last id that was
+			// used, rather than the next one.
+			if a.dialect == dialect.SQLite {
+				next = info.MaxID
+			}
+		}
+		if err := a.sqlDialect.setRange(ctx, tx, t, next); err != nil {
+			return fmt.Errorf("set range of table %q: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// verifyTypes verifies the ranges of the given types do not
+// overlap, and the ids of their tables are within their ranges.
+func (a *Atlas) verifyTypes(ctx context.Context, conn dialect.ExecQuerier, types []string, tables []*Table) error {
+	if _, err := a.idRanges(types); err != nil {
+		return err
+	}
+	infos, err := a.typeInfos(ctx, conn, types, tables)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if !info.Empty && (info.MinID < info.Range.Start || info.MaxID >= info.Range.End) {
+			return fmt.Errorf("ids [%d, %d] of table %q are outside of its id range [%d, %d)", info.MinID, info.MaxID, info.Name, info.Range.Start, info.Range.End)
+		}
+	}
+	return nil
+}
+
+// typeInfos returns the information of the given types.
+func (a *Atlas) typeInfos(ctx context.Context, conn dialect.ExecQuerier, types []string, tables []*Table) ([]*TypeInfo, error) {
+	infos := make([]*TypeInfo, len(types))
+	for i, typ := range types {
+		r, err := a.idRange(typ, i)
+		if err != nil {
+			return nil, err
+		}
+		info := &TypeInfo{Name: typ, Index: i, Range: r, Empty: true}
+		if info.Exists, err = a.sqlDialect.tableExist(ctx, conn, typ); err != nil {
+			return nil, err
+		}
+		if info.Exists {
+			t, ok := tableByName(tables, typ)
+			if !ok {
+				t = NewTable(typ).AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+			}
+			minID, maxID, err := a.idBounds(ctx, conn, t)
+			if err != nil {
+				return nil, err
+			}
+			info.MinID, info.MaxID, info.Empty = minID.Int64, maxID.Int64, !minID.Valid
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+// idBounds returns the smallest and the largest ids that are stored in the given table.
+func (a *Atlas) idBounds(ctx context.Context, conn dialect.ExecQuerier, t *Table) (minID, maxID sql.NullInt64, err error) {
+	rows := &entsql.Rows{}
+	pk := t.PrimaryKey[0].Name
+	query, args := entsql.Dialect(a.dialect).
+		Select(entsql.Min(pk), entsql.Max(pk)).
+		From(entsql.Table(t.Name).Schema(a.tableSchema(t))).
+		Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return minID, maxID, fmt.Errorf("query id range of table %q: %w", t.Name, err)
+	}
+	if rows.Next() {
+		err = rows.Scan(&minID, &maxID)
+	}
+	if cerr := rows.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return minID, maxID, fmt.Errorf("scan id range of table %q: %w", t.Name, err)
+	}
+	return minID, maxID, nil
+}
+
+// tableByName returns the table with the given name.
+func tableByName(tables []*Table, name string) (*Table, bool) {
+	for _, t := range tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return nil, false
+}
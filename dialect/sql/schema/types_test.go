@@ -0,0 +1,76 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_Types(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:types?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	table := func(name string) *Table {
+		return NewTable(name).AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true})
+	}
+	exec := func(stmt string) {
+		require.NoError(t, db.Exec(ctx, stmt, []any{}, nil))
+	}
+	seq := func(name string) int64 {
+		rows := &sql.Rows{}
+		require.NoError(t, db.Query(ctx, "SELECT `seq` FROM `sqlite_sequence` WHERE `name` = ?", []any{name}, rows))
+		defer rows.Close()
+		var v int64
+		require.NoError(t, sql.ScanOne(rows, &v))
+		return v
+	}
+	users, pets, groups := table("users"), table("pets"), table("groups")
+	m, err := NewMigrate(db, WithGlobalUniqueID(true))
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, users, pets))
+	exec("INSERT INTO `users` DEFAULT VALUES")
+	exec("INSERT INTO `pets` DEFAULT VALUES")
+
+	types, err := m.Types(ctx, []*Table{users, pets})
+	require.NoError(t, err)
+	require.Equal(t, []*TypeInfo{
+		{Name: "users", Index: 0, Range: IDRange{Start: 0, End: 1 << 32}, Exists: true, MinID: 1, MaxID: 1},
+		{Name: "pets", Index: 1, Range: IDRange{Start: 1 << 32, End: 2 << 32}, Exists: true, MinID: 1<<32 + 1, MaxID: 1<<32 + 1},
+	}, types)
+	require.NoError(t, m.VerifyTypes(ctx, []*Table{users, pets}))
+	err = m.VerifyTypes(ctx, []*Table{users, pets, groups})
+	require.EqualError(t, err, `sql/schema: table "groups" is not registered in the types table`)
+
+	// Simulate a restore of a dump that lost the types table and the sequences.
+	exec("CREATE TABLE `groups` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT)")
+	exec("DROP TABLE `ent_types`")
+	exec("DELETE FROM `sqlite_sequence`")
+	_, err = m.Types(ctx, nil)
+	require.EqualError(t, err, "sql/schema: ent_type table not found")
+	require.NoError(t, m.RepairTypes(ctx, []*Table{users, pets, groups}))
+	require.NoError(t, m.VerifyTypes(ctx, []*Table{users, pets, groups}))
+	require.Equal(t, int64(1), seq("users"))
+	require.Equal(t, int64(1<<32+1), seq("pets"))
+	require.Equal(t, int64(2<<32), seq("groups"))
+	exec("INSERT INTO `pets` DEFAULT VALUES")
+	types, err = m.Types(ctx, []*Table{pets})
+	require.NoError(t, err)
+	require.Equal(t, int64(1<<32+2), types[1].MaxID)
+
+	// Ids outside of the range of their type cannot be repaired.
+	exec("INSERT INTO `groups` (`id`) VALUES (1)")
+	err = m.VerifyTypes(ctx, []*Table{users, pets, groups})
+	require.EqualError(t, err, `sql/schema: ids [1, 1] of table "groups" are outside of its id range [8589934592, 12884901888)`)
+	err = m.RepairTypes(ctx, []*Table{users, pets, groups})
+	require.EqualError(t, err, `sql/schema: ids [1, 1] of table "groups" are outside of its id range [8589934592, 12884901888)`)
+}
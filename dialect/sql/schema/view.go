@@ -0,0 +1,254 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// View represents a database view (or a materialized view), that is created by the migration.
+type View struct {
+	Name   string
+	Schema string
+	// Definition holds the query (SELECT statement) of the view.
+	Definition string
+	// Definitions holds dialect-specific queries of the view, and it
+	// takes precedence over Definition for the listed dialects.
+	Definitions map[string]string
+	// Query builds the query of the view using the dialect of the migration,
+	// in case no definition was set for the dialect.
+	Query func(*entsql.Selector)
+	// Materialized defines the view as a materialized view.
+	// This option is supported by PostgreSQL.
+	Materialized bool
+}
+
+// NewView returns a new view with the given name.
+func NewView(name string) *View {
+	return &View{Name: name}
+}
+
+// SetSchema sets the schema (named-database) of the view.
+func (v *View) SetSchema(s string) *View {
+	v.Schema = s
+	return v
+}
+
+// SetDefinition sets the query (SELECT statement) of the view.
+func (v *View) SetDefinition(def string) *View {
+	v.Definition = def
+	return v
+}
+
+// SetDefinitionFor sets the query (SELECT statement) of the view for the given dialect.
+func (v *View) SetDefinitionFor(name, def string) *View {
+	if v.Definitions == nil {
+		v.Definitions = make(map[string]string)
+	}
+	v.Definitions[name] = def
+	return v
+}
+
+// SetQuery sets the builder of the query of the view. For example:
+//
+//	schema.NewView("active_users").
+//		SetQuery(func(s *sql.Selector) {
+//			t := sql.Table("users")
+//			s.Select(t.C("id"), t.C("name")).
+//				From(t).
+//				Where(sql.ExprP("active"))
+//		})
+func (v *View) SetQuery(f func(*entsql.Selector)) *View {
+	v.Query = f
+	return v
+}
+
+// SetMaterialized defines the view as a materialized view.
+func (v *View) SetMaterialized() *View {
+	v.Materialized = true
+	return v
+}
+
+// definition returns the query of the view for the given dialect.
+func (v *View) definition(name string) (string, error) {
+	if def, ok := v.Definitions[name]; ok {
+		return def, nil
+	}
+	if v.Query != nil {
+		s := entsql.Dialect(name).Select()
+		v.Query(s)
+		query, args := s.Query()
+		if err := s.Err(); err != nil {
+			return "", fmt.Errorf("build query of view %q: %w", v.Name, err)
+		}
+		if len(args) > 0 {
+			return "", fmt.Errorf("query of view %q cannot contain arguments, got %d", v.Name, len(args))
+		}
+		return query, nil
+	}
+	if v.Definition == "" {
+		return "", fmt.Errorf("missing definition of view %q", v.Name)
+	}
+	return v.Definition, nil
+}
+
+// WithViews sets the views that are created by the migration. A view is created if it was not
+// created by a previous migration, and it is re-created (dropped and created) if its definition
+// was changed. The checksums of the created views are stored in the ent_views table.
+//
+//	err := client.Schema.Create(ctx, schema.WithViews(
+//		schema.NewView("active_users").SetDefinition("SELECT id, name FROM users WHERE active"),
+//	))
+//
+// Note that views are created after the tables are migrated, and views that were removed from
+// the schema are not dropped. Views are supported only by the Atlas migration engine.
+func WithViews(views ...*View) MigrateOption {
+	return func(a *Atlas) {
+		a.views = append(a.views, views...)
+	}
+}
+
+// viewState describes a view that was created by a previous migration.
+type viewState struct {
+	checksum     string
+	materialized bool
+}
+
+// viewState returns the views that were created by previous migrations, keyed by their names.
+func (a *Atlas) viewState(ctx context.Context, conn dialect.ExecQuerier) (map[string]*viewState, error) {
+	if len(a.views) == 0 {
+		return nil, nil
+	}
+	state := make(map[string]*viewState)
+	exists, err := a.sqlDialect.tableExist(ctx, conn, ViewTable)
+	if err != nil || !exists {
+		return state, err
+	}
+	rows := &entsql.Rows{}
+	query, args := entsql.Dialect(a.dialect).
+		Select("name", "checksum", "materialized").From(entsql.Table(ViewTable)).Query()
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("query views table: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			name string
+			vs   viewState
+		)
+		if err := rows.Scan(&name, &vs.checksum, &vs.materialized); err != nil {
+			return nil, fmt.Errorf("scan views table: %w", err)
+		}
+		state[name] = &vs
+	}
+	return state, rows.Err()
+}
+
+// planViews adds to the plan the creation of the views that do not exist or were changed. Changed
+// views are dropped at the beginning of the plan, as the changes of their tables may depend on it.
+func (a *Atlas) planViews(plan *migrate.Plan, state map[string]*viewState) error {
+	var drop, create []*migrate.Change
+	for _, v := range a.views {
+		stmt, err := a.createView(v)
+		if err != nil {
+			return err
+		}
+		checksum := stmtChecksum(stmt)
+		vs, ok := state[v.Name]
+		if ok && vs.checksum == checksum {
+			continue
+		}
+		if ok {
+			drop = append(drop, &migrate.Change{
+				Cmd:     a.dropView(v, vs.materialized),
+				Comment: fmt.Sprintf("drop %q view", v.Name),
+			})
+		}
+		b := &entsql.Builder{}
+		b.SetDialect(a.dialect)
+		create = append(create,
+			&migrate.Change{
+				Cmd:     stmt,
+				Comment: fmt.Sprintf("create %q view", v.Name),
+			},
+			&migrate.Change{
+				Cmd: fmt.Sprintf("DELETE FROM %s WHERE %s = %s", b.Quote(ViewTable), b.Quote("name"), quoteString(v.Name)),
+			},
+			&migrate.Change{
+				Cmd: fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (%s, %s, %t)", b.Quote(ViewTable), b.Quote("name"), b.Quote("checksum"),
+					b.Quote("materialized"), quoteString(v.Name), quoteString(checksum), v.Materialized),
+				Comment: fmt.Sprintf("record checksum of %q view", v.Name),
+			},
+		)
+	}
+	plan.Changes = append(append(drop, plan.Changes...), create...)
+	return nil
+}
+
+// createView returns the statement for creating the given view.
+func (a *Atlas) createView(v *View) (string, error) {
+	if v.Materialized && a.dialect != dialect.Postgres {
+		return "", fmt.Errorf("view %q: materialized views are not supported by the %s dialect", v.Name, a.dialect)
+	}
+	def, err := v.definition(a.dialect)
+	if err != nil {
+		return "", err
+	}
+	kind := "VIEW"
+	if v.Materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+	return fmt.Sprintf("CREATE %s %s AS %s", kind, a.qualifiedName(v.Schema, v.Name), strings.TrimSpace(def)), nil
+}
+
+// stmtChecksum returns the checksum of the statement that creates a view or a trigger.
+func stmtChecksum(stmt string) string {
+	sum := sha256.Sum256([]byte(stmt))
+	return hex.EncodeToString(sum[:])
+}
+
+// dropView returns the statement for dropping the given view.
+func (a *Atlas) dropView(v *View, materialized bool) string {
+	kind := "VIEW"
+	if materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+	return fmt.Sprintf("DROP %s IF EXISTS %s", kind, a.qualifiedName(v.Schema, v.Name))
+}
+
+// qualifiedName returns the quoted (and qualified) name of a schema object.
+func (a *Atlas) qualifiedName(schema, name string) string {
+	b := &entsql.Builder{}
+	b.SetDialect(a.dialect)
+	if schema == "" {
+		return b.Quote(name)
+	}
+	return b.Quote(schema) + "." + b.Quote(name)
+}
+
+// dropViews drops the views that were created by the migration directory from the dev database,
+// before it is cleaned, as views may prevent dropping the tables that they depend on.
+func (a *Atlas) dropViews(ctx context.Context, state map[string]*viewState) error {
+	for name, vs := range state {
+		if err := a.sqlDialect.Exec(ctx, a.dropView(&View{Name: name}, vs.materialized), []any{}, nil); err != nil {
+			return fmt.Errorf("drop view %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// quoteString returns the given string as an SQL string literal.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtlas_Views(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:views?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	var (
+		ctx   = context.Background()
+		users = NewTable("users").
+			AddPrimary(&Column{Name: "id", Type: field.TypeInt, Increment: true}).
+			AddColumn(&Column{Name: "name", Type: field.TypeString}).
+			AddColumn(&Column{Name: "active", Type: field.TypeBool})
+		active = NewView("active_users").SetDefinition("SELECT `id`, `name` FROM `users` WHERE `active`")
+		names  = NewView("user_names").SetQuery(func(s *sql.Selector) {
+			t := sql.Table("users")
+			s.Select(t.C("id"), t.C("name")).From(t)
+		})
+		create = func(views ...*View) string {
+			var b strings.Builder
+			m, err := NewMigrate(db, WithViews(views...))
+			require.NoError(t, err)
+			require.NoError(t, m.Create(ctx, users))
+			m, err = NewMigrate(db, WithViews(views...), WithDryRun(&b))
+			require.NoError(t, err)
+			require.NoError(t, m.Create(ctx, users))
+			return b.String()
+		}
+		query = func(view string) (ids []int) {
+			rows := &sql.Rows{}
+			require.NoError(t, db.Query(ctx, "SELECT `id` FROM `"+view+"` ORDER BY `id`", []any{}, rows))
+			defer rows.Close()
+			require.NoError(t, sql.ScanSlice(rows, &ids))
+			return ids
+		}
+	)
+	require.NotContains(t, create(active, names), "VIEW", "views are not re-created if they were not changed")
+	require.NoError(t, db.Exec(ctx, "INSERT INTO `users` (`name`, `active`) VALUES ('a8m', true), ('nati', false)", []any{}, nil))
+	require.Equal(t, []int{1}, query("active_users"))
+	require.Equal(t, []int{1, 2}, query("user_names"))
+
+	// Changed views are dropped and re-created.
+	active.SetDefinition("SELECT `id`, `name` FROM `users` WHERE NOT `active`")
+	require.NotContains(t, create(active, names), "VIEW")
+	require.Equal(t, []int{2}, query("active_users"))
+	rows := &sql.Rows{}
+	require.NoError(t, db.Query(ctx, "SELECT COUNT(*) FROM `ent_views`", []any{}, rows))
+	n, err := sql.ScanInt(rows)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// Materialized views and views without a definition.
+	m, err := NewMigrate(db, WithViews(NewView("mv").SetDefinition("SELECT 1").SetMaterialized()))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, users), `sql/schema: view "mv": materialized views are not supported by the sqlite3 dialect`)
+	m, err = NewMigrate(db, WithViews(NewView("v")))
+	require.NoError(t, err)
+	require.EqualError(t, m.Create(ctx, users), `sql/schema: missing definition of view "v"`)
+}
+
+func TestAtlas_PlanViews(t *testing.T) {
+	a := &Atlas{dialect: dialect.Postgres, views: []*View{
+		NewView("active_users").SetDefinition(`SELECT "id" FROM "users" WHERE "active"`),
+		NewView("stats").SetSchema("reports").SetDefinitionFor(dialect.Postgres, `SELECT COUNT(*) FROM "users"`).SetMaterialized(),
+	}}
+	plan := &migrate.Plan{Changes: []*migrate.Change{{Cmd: `ALTER TABLE "users" ADD COLUMN "age" bigint NOT NULL`}}}
+	require.NoError(t, a.planViews(plan, map[string]*viewState{
+		"stats": {checksum: "changed", materialized: true},
+	}))
+	cmds := make([]string, len(plan.Changes))
+	for i, c := range plan.Changes {
+		cmds[i] = c.Cmd
+	}
+	require.Equal(t, []string{
+		`DROP MATERIALIZED VIEW IF EXISTS "reports"."stats"`,
+		`ALTER TABLE "users" ADD COLUMN "age" bigint NOT NULL`,
+		`CREATE VIEW "active_users" AS SELECT "id" FROM "users" WHERE "active"`,
+		`DELETE FROM "ent_views" WHERE "name" = 'active_users'`,
+		`INSERT INTO "ent_views" ("name", "checksum", "materialized") VALUES ('active_users', '` + stmtChecksum(cmds[2]) + `', false)`,
+		`CREATE MATERIALIZED VIEW "reports"."stats" AS SELECT COUNT(*) FROM "users"`,
+		`DELETE FROM "ent_views" WHERE "name" = 'stats'`,
+		`INSERT INTO "ent_views" ("name", "checksum", "materialized") VALUES ('stats', '` + stmtChecksum(cmds[5]) + `', true)`,
+	}, cmds)
+}
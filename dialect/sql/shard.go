@@ -0,0 +1,287 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// ErrNoShardKey is returned by the ShardDriver when the shard key of a statement
+// cannot be resolved, and the driver is not configured with a default shard.
+var ErrNoShardKey = errors.New("dialect/sql: shard key was not found")
+
+// ShardFunc returns the name of the shard that stores the given shard key.
+type ShardFunc func(key any) (string, error)
+
+// ShardDriver is a driver that routes the statements it executes to one of its shards (physical databases)
+// based on a shard key. The shard key is taken from the context, or from the arguments of the statements
+// that filter or insert the configured shard column. Transactions are started on a single shard and all
+// their statements are executed on it, and therefore, their context must hold the shard key.
+//
+//	drv := sql.NewShardDriver(
+//		map[string]dialect.Driver{"s0": s0, "s1": s1},
+//		sql.WithShardColumn("tenant_id"),
+//		sql.WithShardFunc(sql.ShardByHash("s0", "s1")),
+//	)
+//	client := ent.NewClient(ent.Driver(drv))
+//	users, err := client.User.Query().All(sql.WithShardKey(ctx, tenantID))
+type ShardDriver struct {
+	shards  map[string]dialect.Driver
+	dialect string
+	column  string    // optional shard column.
+	def     string    // optional default shard.
+	shardOf ShardFunc // defaults to the key as shard name.
+}
+
+// ShardOption configures the ShardDriver.
+type ShardOption func(*ShardDriver)
+
+// WithShardColumn sets the column that holds the shard key. Statements that are executed
+// without a shard key in their context are routed by the argument that is compared to this
+// column (e.g. "tenant_id" = ?), or the argument that is inserted into it.
+func WithShardColumn(name string) ShardOption {
+	return func(d *ShardDriver) {
+		d.column = name
+	}
+}
+
+// WithShardFunc sets the function that maps shard keys to shard names.
+// By default, the string representation of the key is used as the shard name.
+func WithShardFunc(fn ShardFunc) ShardOption {
+	return func(d *ShardDriver) {
+		d.shardOf = fn
+	}
+}
+
+// WithDefaultShard sets the shard that executes the statements and
+// transactions whose shard key cannot be resolved.
+func WithDefaultShard(name string) ShardOption {
+	return func(d *ShardDriver) {
+		d.def = name
+	}
+}
+
+// ShardByHash returns a ShardFunc that distributes the shard keys
+// between the given shards by the FNV-1a hash of their string form.
+func ShardByHash(names ...string) ShardFunc {
+	return func(key any) (string, error) {
+		if len(names) == 0 {
+			return "", fmt.Errorf("dialect/sql: no shards to hash key %v", key)
+		}
+		h := fnv.New32a()
+		h.Write([]byte(fmt.Sprint(key)))
+		return names[h.Sum32()%uint32(len(names))], nil
+	}
+}
+
+// NewShardDriver returns a new ShardDriver for the given shards. All shards must use the same dialect.
+func NewShardDriver(shards map[string]dialect.Driver, opts ...ShardOption) *ShardDriver {
+	d := &ShardDriver{
+		shards: shards,
+		shardOf: func(key any) (string, error) {
+			return fmt.Sprint(key), nil
+		},
+	}
+	for _, drv := range shards {
+		d.dialect = drv.Dialect()
+		break
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// shardKey is the context key for the shard key.
+type shardKey struct{}
+
+// WithShardKey returns a new context with the given shard key.
+func WithShardKey(ctx context.Context, key any) context.Context {
+	return context.WithValue(ctx, shardKey{}, key)
+}
+
+// ShardKeyFromContext returns the shard key stored in the context, if any.
+func ShardKeyFromContext(ctx context.Context) (any, bool) {
+	key := ctx.Value(shardKey{})
+	return key, key != nil
+}
+
+// Shard returns the driver of the shard with the given name.
+func (d *ShardDriver) Shard(name string) (dialect.Driver, bool) {
+	drv, ok := d.shards[name]
+	return drv, ok
+}
+
+// Exec executes the statement on the shard of its shard key.
+func (d *ShardDriver) Exec(ctx context.Context, query string, args, v any) error {
+	drv, err := d.route(ctx, query, args)
+	if err != nil {
+		return err
+	}
+	return drv.Exec(ctx, query, args, v)
+}
+
+// Query executes the query on the shard of its shard key.
+func (d *ShardDriver) Query(ctx context.Context, query string, args, v any) error {
+	drv, err := d.route(ctx, query, args)
+	if err != nil {
+		return err
+	}
+	return drv.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction on the shard of the shard key in the context.
+func (d *ShardDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with options on the shard of the shard key in the context.
+func (d *ShardDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, err := d.route(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if drv, ok := drv.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	}); ok {
+		return drv.BeginTx(ctx, opts)
+	}
+	if opts != nil {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported")
+	}
+	return drv.Tx(ctx)
+}
+
+// Close closes all shards.
+func (d *ShardDriver) Close() error {
+	names := make([]string, 0, len(d.shards))
+	for name := range d.shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []string
+	for _, name := range names {
+		if err := d.shards[name].Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("shard %q: %v", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("dialect/sql: closing shards: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Dialect returns the dialect of the shards.
+func (d *ShardDriver) Dialect() string {
+	return d.dialect
+}
+
+// route returns the shard driver of the given statement.
+func (d *ShardDriver) route(ctx context.Context, query string, args any) (dialect.Driver, error) {
+	key, ok := ShardKeyFromContext(ctx)
+	if !ok {
+		key, ok = d.keyOf(query, args)
+	}
+	name := d.def
+	if ok {
+		var err error
+		if name, err = d.shardOf(key); err != nil {
+			return nil, err
+		}
+	} else if name == "" {
+		return nil, ErrNoShardKey
+	}
+	drv, ok := d.shards[name]
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: unknown shard %q", name)
+	}
+	return drv, nil
+}
+
+// keyOf extracts the shard key from the arguments of the given statement. The key is the
+// argument of the first equality predicate on the shard column, or the argument that is
+// inserted into the shard column.
+func (d *ShardDriver) keyOf(query string, args any) (any, bool) {
+	argv, ok := args.([]any)
+	if !ok || d.column == "" {
+		return nil, false
+	}
+	column := `"` + d.column + `"`
+	if d.dialect == dialect.MySQL {
+		column = "`" + d.column + "`"
+	}
+	i := 0
+	// Skip the SET clause of updates, as it holds the new values of the columns.
+	if strings.HasPrefix(query, "UPDATE ") {
+		if i = strings.Index(query, " WHERE "); i == -1 {
+			i = len(query)
+		}
+	}
+	for {
+		j := strings.Index(query[i:], column+" = ")
+		if j == -1 {
+			break
+		}
+		i += j + len(column) + 3
+		if idx, ok := argIndex(query, i); ok && idx < len(argv) {
+			return argv[idx], true
+		}
+	}
+	// Inserts are generated as: INSERT INTO t (c1, c2) VALUES (v1, v2), ...
+	if !strings.HasPrefix(query, "INSERT INTO ") {
+		return nil, false
+	}
+	start, end := strings.IndexByte(query, '('), strings.IndexByte(query, ')')
+	if start == -1 || end < start || !strings.HasPrefix(query[end+1:], " VALUES (") {
+		return nil, false
+	}
+	for idx, c := range strings.Split(query[start+1:end], ", ") {
+		if c != column {
+			continue
+		}
+		// Values are not necessarily arguments, and therefore, the placeholder is
+		// resolved by its position in the first row.
+		values := query[end+len(" VALUES (")+1:]
+		for ; idx > 0; idx-- {
+			k := strings.Index(values, ", ")
+			if k == -1 {
+				return nil, false
+			}
+			values = values[k+2:]
+		}
+		if i, ok := argIndex(query, len(query)-len(values)); ok && i < len(argv) {
+			return argv[i], true
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+// argIndex returns the index of the argument whose placeholder starts at the given position.
+func argIndex(query string, pos int) (int, bool) {
+	switch s := query[pos:]; {
+	case strings.HasPrefix(s, "$"):
+		end := 1
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+		n, err := strconv.Atoi(s[1:end])
+		return n - 1, err == nil && n > 0
+	case strings.HasPrefix(s, "?"):
+		return strings.Count(query[:pos], "?"), true
+	default:
+		return 0, false
+	}
+}
+
+var _ dialect.Driver = (*ShardDriver)(nil)
@@ -0,0 +1,148 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardDriver(t *testing.T) {
+	db1, mock1, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	db2, mock2, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := NewShardDriver(
+		map[string]dialect.Driver{
+			"s1": OpenDB(dialect.Postgres, db1),
+			"s2": OpenDB(dialect.Postgres, db2),
+		},
+		WithShardColumn("tenant_id"),
+		WithShardFunc(func(key any) (string, error) {
+			return map[int]string{1: "s1", 2: "s2"}[key.(int)], nil
+		}),
+	)
+	require.Equal(t, dialect.Postgres, drv.Dialect())
+	ctx := context.Background()
+
+	// Shard key from the context.
+	mock2.ExpectExec("DELETE FROM users").WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(WithShardKey(ctx, 2), "DELETE FROM users", []any{}, nil))
+
+	// Shard key from the predicates.
+	mock1.ExpectQuery(`SELECT "name" FROM "users" WHERE "id" = $1 AND "users"."tenant_id" = $2`).
+		WithArgs(10, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, `SELECT "name" FROM "users" WHERE "id" = $1 AND "users"."tenant_id" = $2`, []any{10, 1}, rows))
+	var names []string
+	require.NoError(t, ScanSlice(rows, &names))
+	require.Equal(t, []string{"a8m"}, names)
+	require.NoError(t, rows.Close())
+
+	// Shard key from the inserted values.
+	mock2.ExpectExec(`INSERT INTO "users" ("name", "tenant_id") VALUES ($1, $2)`).
+		WithArgs("a8m", 2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, drv.Exec(ctx, `INSERT INTO "users" ("name", "tenant_id") VALUES ($1, $2)`, []any{"a8m", 2}, nil))
+
+	// The SET clause of updates is not used for routing.
+	mock1.ExpectExec(`UPDATE "users" SET "tenant_id" = $1 WHERE "tenant_id" = $2`).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, `UPDATE "users" SET "tenant_id" = $1 WHERE "tenant_id" = $2`, []any{2, 1}, nil))
+
+	// Transactions are started on the shard of the context.
+	mock1.ExpectBegin()
+	mock1.ExpectExec("DELETE FROM users").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock1.ExpectCommit()
+	tx, err := drv.Tx(WithShardKey(ctx, 1))
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM users", []any{}, nil))
+	require.NoError(t, tx.Commit())
+
+	// Unresolved shard keys.
+	require.ErrorIs(t, drv.Exec(ctx, "DELETE FROM users", []any{}, nil), ErrNoShardKey)
+	_, err = drv.Tx(ctx)
+	require.ErrorIs(t, err, ErrNoShardKey)
+	require.EqualError(t, drv.Exec(WithShardKey(ctx, 3), "DELETE FROM users", []any{}, nil), `dialect/sql: unknown shard ""`)
+
+	mock1.ExpectClose()
+	mock2.ExpectClose()
+	require.NoError(t, drv.Close())
+	require.NoError(t, mock1.ExpectationsWereMet())
+	require.NoError(t, mock2.ExpectationsWereMet())
+}
+
+func TestShardDriver_KeyOf(t *testing.T) {
+	tests := []struct {
+		dialect string
+		query   string
+		args    []any
+		key     any
+		ok      bool
+	}{
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT * FROM `users` WHERE `name` = ? AND `users`.`tenant_id` = ?",
+			args:    []any{"a8m", 1},
+			key:     1,
+			ok:      true,
+		},
+		{
+			dialect: dialect.SQLite,
+			query:   `INSERT INTO "users" ("tenant_id", "name") VALUES (?, ?), (?, ?)`,
+			args:    []any{1, "a8m", 2, "nati"},
+			key:     1,
+			ok:      true,
+		},
+		{
+			dialect: dialect.Postgres,
+			query:   `INSERT INTO "users" ("name", "tenant_id") VALUES ($1, $2) RETURNING "id"`,
+			args:    []any{"a8m", 1},
+			key:     1,
+			ok:      true,
+		},
+		{
+			dialect: dialect.Postgres,
+			query:   `SELECT * FROM "users" WHERE "users"."tenant_id" IN ($1, $2)`,
+			args:    []any{1, 2},
+		},
+		{
+			dialect: dialect.Postgres,
+			query:   `SELECT * FROM "users" WHERE "other_tenant_id" = $1`,
+			args:    []any{1},
+		},
+		{
+			dialect: dialect.Postgres,
+			query:   `UPDATE "users" SET "tenant_id" = $1`,
+			args:    []any{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			drv := &ShardDriver{dialect: tt.dialect, column: "tenant_id"}
+			key, ok := drv.keyOf(tt.query, tt.args)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.key, key)
+		})
+	}
+}
+
+func TestShardByHash(t *testing.T) {
+	fn := ShardByHash("s0", "s1", "s2")
+	name, err := fn(42)
+	require.NoError(t, err)
+	again, err := fn("42")
+	require.NoError(t, err)
+	require.Equal(t, name, again, "keys are hashed by their string form")
+	_, err = ShardByHash()(42)
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 )
@@ -78,6 +79,14 @@ func FieldGTE(name string, v any) func(*Selector) {
 	}
 }
 
+// FieldWithinLast returns a raw predicate to check if the time value of the field is within
+// the given duration before the current time (e.g. created in the last 24 hours).
+func FieldWithinLast(name string, d time.Duration) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(GTE(s.C(name), time.Now().Add(-d)))
+	}
+}
+
 // FieldsGTE returns a raw predicate to check if field1 is greater than or equal field2.
 func FieldsGTE(field1, field2 string) func(*Selector) {
 	return func(s *Selector) {
@@ -170,6 +179,29 @@ func FieldContainsFold(name string, substr string) func(*Selector) {
 	}
 }
 
+// FieldHasPrefixFold returns a raw predicate to check if the field has the given prefix with case-folding.
+func FieldHasPrefixFold(name string, prefix string) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(HasPrefixFold(s.C(name), prefix))
+	}
+}
+
+// FieldArrayContains returns a raw predicate to check if the array field contains the given value.
+func FieldArrayContains(name string, v any) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(ArrayContains(s.C(name), v))
+	}
+}
+
+// FieldTextSearch returns a raw predicate to check if the given field matches the given full-text
+// search query. In SQLite, the query is matched against the FTS5 table of the field (see FullTextTable).
+func FieldTextSearch(name, query string, opts ...TextSearchOption) func(*Selector) {
+	return func(s *Selector) {
+		opts := append([]TextSearchOption{WithTextSearchTable(FullTextTable(s.TableName(), name), s.C("rowid"))}, opts...)
+		s.Where(TextSearch(s.C(name), query, opts...))
+	}
+}
+
 // ColumnCheck is a function that verifies whether the
 // specified column exists within the given table.
 type ColumnCheck func(table, column string) error
@@ -212,6 +244,7 @@ type (
 		Selected   bool   // Whether the term should be selected.
 		NullsFirst bool   // Whether to sort nulls first.
 		NullsLast  bool   // Whether to sort nulls last.
+		Fold       bool   // Whether to sort case-insensitively.
 	}
 	// OrderTermOption is an option for ordering by a term.
 	OrderTermOption func(*OrderTermOptions)
@@ -267,6 +300,34 @@ func OrderNullsLast() OrderTermOption {
 	}
 }
 
+// OrderFold returns an option to sort the field case-insensitively. See OrderFoldC
+// for the way the column is compared in each dialect. Note that, in PostgreSQL, the
+// folded column is not part of the selected columns, and therefore, it cannot be used
+// in queries that select DISTINCT rows.
+func OrderFold() OrderTermOption {
+	return func(o *OrderTermOptions) {
+		o.Fold = true
+	}
+}
+
+// OrderFoldC returns the expression for ordering the given (formatted) column case-insensitively:
+//
+//	PostgreSQL: LOWER("name")
+//	MySQL:      `name` COLLATE utf8mb4_general_ci
+//	SQLite:     `name` COLLATE NOCASE
+func OrderFoldC(d, c string) string {
+	switch d {
+	case dialect.Postgres:
+		return "LOWER(" + c + ")"
+	case dialect.MySQL:
+		// We assume the CHARACTER SET is configured to utf8mb4,
+		// because this how it is defined in dialect/sql/schema.
+		return c + " COLLATE utf8mb4_general_ci"
+	default: // SQLite.
+		return c + " COLLATE NOCASE"
+	}
+}
+
 // NewOrderTermOptions returns a new OrderTermOptions from the given options.
 func NewOrderTermOptions(opts ...OrderTermOption) *OrderTermOptions {
 	o := &OrderTermOptions{}
@@ -281,6 +342,19 @@ func OrderByField(field string, opts ...OrderTermOption) *OrderFieldTerm {
 	return &OrderFieldTerm{Field: field, OrderTermOptions: *NewOrderTermOptions(opts...)}
 }
 
+// OrderByExpr returns an ordering by the given expression. For example:
+//
+//	OrderByExpr(Expr("LENGTH(name)"), OrderDesc())
+//	OrderByExpr(ExprFunc(func(b *Builder) { ... }), OrderSelectAs("rank"))
+func OrderByExpr(x Querier, opts ...OrderTermOption) *OrderExprTerm {
+	return &OrderExprTerm{
+		OrderTermOptions: *NewOrderTermOptions(opts...),
+		Expr: func(*Selector) Querier {
+			return x
+		},
+	}
+}
+
 // OrderBySum returns an ordering by the sum of the given field.
 func OrderBySum(field string, opts ...OrderTermOption) *OrderExprTerm {
 	return orderByAgg("SUM", field, opts...)
@@ -333,18 +407,49 @@ func OrderByRand() func(*Selector) {
 func (f *OrderFieldTerm) ToFunc() func(*Selector) {
 	return func(s *Selector) {
 		s.OrderExprFunc(func(b *Builder) {
-			b.WriteString(s.C(f.Field))
-			if f.Desc {
-				b.WriteString(" DESC")
-			}
-			if f.NullsFirst {
-				b.WriteString(" NULLS FIRST")
-			} else if f.NullsLast {
-				b.WriteString(" NULLS LAST")
+			c := s.C(f.Field)
+			if f.Fold {
+				c = OrderFoldC(b.Dialect(), c)
 			}
+			b.WriteString(c)
+			f.writeDirection(b)
 		})
 	}
 }
 
+// ToFunc returns a function that sets the ordering on the given selector.
+// If the term is selected, the expression is appended to the selected
+// columns, and the ordering is done by its alias.
+func (f *OrderExprTerm) ToFunc() func(*Selector) {
+	return func(s *Selector) {
+		x := f.Expr(s)
+		byAlias := f.Selected && f.As != ""
+		if byAlias {
+			s.AppendSelectExprAs(x, f.As)
+		}
+		// Unlike OrderExprFunc, ExprFunc keeps the arguments of the expression.
+		s.OrderExpr(ExprFunc(func(b *Builder) {
+			if byAlias {
+				b.Ident(f.As)
+			} else {
+				b.Join(x)
+			}
+			f.writeDirection(b)
+		}))
+	}
+}
+
+// writeDirection writes the direction and the NULLS ordering of the term.
+func (o *OrderTermOptions) writeDirection(b *Builder) {
+	if o.Desc {
+		b.WriteString(" DESC")
+	}
+	if o.NullsFirst {
+		b.WriteString(" NULLS FIRST")
+	} else if o.NullsLast {
+		b.WriteString(" NULLS LAST")
+	}
+}
+
 func (OrderFieldTerm) term() {}
 func (OrderExprTerm) term()  {}
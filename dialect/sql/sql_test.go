@@ -6,6 +6,7 @@ package sql
 
 import (
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 
@@ -389,3 +390,93 @@ func TestFieldContainsFold(t *testing.T) {
 		require.Equal(t, []any{"%a8m%"}, args)
 	})
 }
+
+func TestFieldHasPrefixFold(t *testing.T) {
+	p := FieldHasPrefixFold("name", "A8m")
+	t.Run("MySQL", func(t *testing.T) {
+		s := Dialect(dialect.MySQL).Select("*").From(Table("users"))
+		p(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `users` WHERE `users`.`name` COLLATE utf8mb4_general_ci LIKE ?", query)
+		require.Equal(t, []any{"a8m%"}, args)
+	})
+	t.Run("PostgreSQL", func(t *testing.T) {
+		s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+		p(s)
+		query, args := s.Query()
+		require.Equal(t, `SELECT * FROM "users" WHERE "users"."name" ILIKE $1`, query)
+		require.Equal(t, []any{"a8m%"}, args)
+	})
+}
+
+func TestOrderFold(t *testing.T) {
+	for d, want := range map[string]string{
+		dialect.Postgres: `SELECT * FROM "users" ORDER BY LOWER("users"."name") DESC`,
+		dialect.MySQL:    "SELECT * FROM `users` ORDER BY `users`.`name` COLLATE utf8mb4_general_ci DESC",
+		dialect.SQLite:   "SELECT * FROM `users` ORDER BY `users`.`name` COLLATE NOCASE DESC",
+	} {
+		s := Dialect(d).Select("*").From(Table("users"))
+		OrderByField("name", OrderFold(), OrderDesc()).ToFunc()(s)
+		query, _ := s.Query()
+		require.Equal(t, want, query)
+	}
+}
+
+func TestOrderExprTerm(t *testing.T) {
+	t.Run("Expr", func(t *testing.T) {
+		s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+		OrderByExpr(Expr("LENGTH(name) - $1", 2), OrderDesc(), OrderNullsLast()).ToFunc()(s)
+		query, args := s.Query()
+		require.Equal(t, `SELECT * FROM "users" ORDER BY LENGTH(name) - $1 DESC NULLS LAST`, query)
+		require.Equal(t, []any{2}, args)
+	})
+	t.Run("Count", func(t *testing.T) {
+		s := Select("owner_id").From(Table("pets")).GroupBy("owner_id")
+		OrderByCount("*", OrderDesc()).ToFunc()(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT `owner_id` FROM `pets` GROUP BY `owner_id` ORDER BY COUNT(*) DESC", query)
+		require.Empty(t, args)
+	})
+	t.Run("Selected", func(t *testing.T) {
+		s := Select("owner_id").From(Table("pets")).GroupBy("owner_id")
+		OrderBySum("age", OrderSelected()).ToFunc()(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT `owner_id`, SUM(`pets`.`age`) AS `sum_age` FROM `pets` GROUP BY `owner_id` ORDER BY `sum_age`", query)
+		require.Empty(t, args)
+	})
+}
+
+func TestFieldTextSearch(t *testing.T) {
+	p := FieldTextSearch("title", "ent & go")
+	t.Run("MySQL", func(t *testing.T) {
+		s := Dialect(dialect.MySQL).Select("*").From(Table("posts"))
+		p(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `posts` WHERE MATCH (`posts`.`title`) AGAINST (?)", query)
+		require.Equal(t, []any{"ent & go"}, args)
+	})
+	t.Run("PostgreSQL", func(t *testing.T) {
+		s := Dialect(dialect.Postgres).Select("*").From(Table("posts"))
+		FieldTextSearch("title", "ent & go", WithTextSearchConfig("english"))(s)
+		query, args := s.Query()
+		require.Equal(t, `SELECT * FROM "posts" WHERE to_tsvector('english', "posts"."title") @@ to_tsquery('english', $1)`, query)
+		require.Equal(t, []any{"ent & go"}, args)
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		s := Dialect(dialect.SQLite).Select("*").From(Table("posts").As("t1"))
+		p(s)
+		query, args := s.Query()
+		require.Equal(t, "SELECT * FROM `posts` AS `t1` WHERE `t1`.`rowid` IN (SELECT `rowid` FROM `posts_title_fts` WHERE `posts_title_fts` MATCH ?)", query)
+		require.Equal(t, []any{"ent & go"}, args)
+	})
+}
+
+func TestFieldWithinLast(t *testing.T) {
+	p := FieldWithinLast("created_at", time.Hour)
+	s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+	p(s)
+	query, args := s.Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "users"."created_at" >= $1`, query)
+	require.Len(t, args, 1)
+	require.WithinDuration(t, time.Now().Add(-time.Hour), args[0].(time.Time), time.Minute)
+}
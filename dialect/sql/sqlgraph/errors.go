@@ -6,6 +6,7 @@ package sqlgraph
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -18,6 +19,9 @@ func IsConstraintError(err error) bool {
 // IsUniqueConstraintError reports if the error resulted from a DB uniqueness constraint violation.
 // e.g. duplicate value in unique index.
 func IsUniqueConstraintError(err error) bool {
+	if SQLState(err) == "23505" {
+		return true
+	}
 	for _, s := range []string{
 		"Error 1062",                 // MySQL
 		"violates unique constraint", // Postgres
@@ -33,6 +37,9 @@ func IsUniqueConstraintError(err error) bool {
 // IsForeignKeyConstraintError reports if the error resulted from a database foreign-key constraint violation.
 // e.g. parent row does not exist.
 func IsForeignKeyConstraintError(err error) bool {
+	if SQLState(err) == "23503" {
+		return true
+	}
 	for _, s := range []string{
 		"Error 1451",                      // MySQL (Cannot delete or update a parent row).
 		"Error 1452",                      // MySQL (Cannot add or update a child row).
@@ -45,3 +52,109 @@ func IsForeignKeyConstraintError(err error) bool {
 	}
 	return false
 }
+
+// ConstraintKind is the kind of a violated database constraint.
+type ConstraintKind string
+
+// Kinds of violated database constraints.
+const (
+	UniqueConstraint     ConstraintKind = "unique"
+	ForeignKeyConstraint ConstraintKind = "foreign_key"
+)
+
+// ConstraintViolation describes a database constraint violation that was parsed from a driver error.
+type ConstraintViolation struct {
+	Kind       ConstraintKind
+	Constraint string   // Constraint or index name, if reported by the database.
+	Columns    []string // Offending columns, if reported by the database.
+}
+
+var (
+	// Postgres, e.g. `duplicate key value violates unique constraint "users_email_key"`.
+	pgConstraint = regexp.MustCompile(`violates (?:unique|foreign key) constraint "([^"]+)"`)
+	// Postgres detail, e.g. `Key (email)=(a8m@entgo.io) already exists.`.
+	pgDetailKey = regexp.MustCompile(`Key \((.+?)\)=\(`)
+	// MySQL 1062, e.g. "Duplicate entry 'a8m@entgo.io' for key 'users.email'".
+	mysqlUnique = regexp.MustCompile(`Duplicate entry '.*' for key '([^']+)'`)
+	// MySQL 1451 and 1452, e.g. "CONSTRAINT `pets_users_pets` FOREIGN KEY (`user_pets`)".
+	mysqlForeignKey = regexp.MustCompile("CONSTRAINT `([^`]+)` FOREIGN KEY \\(([^)]+)\\)")
+	// SQLite, e.g. "UNIQUE constraint failed: users.email, users.name".
+	sqliteUnique = regexp.MustCompile(`UNIQUE constraint failed: ([^\s,]+\.[^\s,]+(?:, [^\s,]+\.[^\s,]+)*)`)
+	// SQLite unique expression indexes, e.g. "UNIQUE constraint failed: index 'user_lower_email'".
+	sqliteUniqueIndex = regexp.MustCompile(`UNIQUE constraint failed: index '([^']+)'`)
+	// MySQL SQLSTATE, e.g. "Error 1062 (23000): Duplicate entry".
+	mysqlState = regexp.MustCompile(`Error \d+ \(([0-9A-Z]{5})\): `)
+)
+
+// ParseConstraintError parses a unique or a foreign-key constraint violation from the given error.
+// The name of the constraint and the offending columns are set only if they are reported by the
+// database. For example, SQLite does not report the constraint names, and MySQL does not report
+// the columns of unique indexes.
+func ParseConstraintError(err error) (*ConstraintViolation, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var e *ConstraintError
+	if errors.As(err, &e) {
+		return &ConstraintViolation{Kind: UniqueConstraint, Columns: e.columns}, true
+	}
+	v := &ConstraintViolation{}
+	switch {
+	case IsUniqueConstraintError(err):
+		v.Kind = UniqueConstraint
+	case IsForeignKeyConstraintError(err):
+		v.Kind = ForeignKeyConstraint
+	default:
+		return nil, false
+	}
+	msg := err.Error()
+	switch {
+	case pgConstraint.MatchString(msg):
+		v.Constraint = pgConstraint.FindStringSubmatch(msg)[1]
+		var pe interface{ Get(byte) string }
+		// Postgres reports the columns in the error detail (e.g. pq.Error).
+		if errors.As(err, &pe) {
+			if m := pgDetailKey.FindStringSubmatch(pe.Get('D')); m != nil {
+				v.Columns = strings.Split(m[1], ", ")
+			}
+		}
+	case mysqlUnique.MatchString(msg):
+		// MySQL 8 prefixes the index name with the table name.
+		name := mysqlUnique.FindStringSubmatch(msg)[1]
+		if i := strings.IndexByte(name, '.'); i != -1 {
+			name = name[i+1:]
+		}
+		v.Constraint = name
+	case mysqlForeignKey.MatchString(msg):
+		m := mysqlForeignKey.FindStringSubmatch(msg)
+		v.Constraint = m[1]
+		for _, c := range strings.Split(m[2], ",") {
+			v.Columns = append(v.Columns, strings.Trim(c, "` "))
+		}
+	case sqliteUniqueIndex.MatchString(msg):
+		v.Constraint = sqliteUniqueIndex.FindStringSubmatch(msg)[1]
+	case sqliteUnique.MatchString(msg):
+		for _, c := range strings.Split(sqliteUnique.FindStringSubmatch(msg)[1], ", ") {
+			v.Columns = append(v.Columns, c[strings.IndexByte(c, '.')+1:])
+		}
+	}
+	return v, true
+}
+
+// SQLState returns the SQLSTATE code of the given database error, or an empty string if it
+// is not reported by the driver. Postgres drivers (e.g. pq.Error or pgconn.PgError) report it
+// as a field of their errors, and MySQL reports it in the error message.
+func SQLState(err error) string {
+	var pgx interface{ SQLState() string }
+	if errors.As(err, &pgx) {
+		return pgx.SQLState()
+	}
+	var pq interface{ Get(byte) string }
+	if errors.As(err, &pq) {
+		return pq.Get('C')
+	}
+	if m := mysqlState.FindStringSubmatch(err.Error()); m != nil {
+		return m[1]
+	}
+	return ""
+}
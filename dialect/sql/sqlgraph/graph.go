@@ -50,7 +50,8 @@ func (r Rel) String() (s string) {
 
 // A ConstraintError represents an error from mutation that violates a specific constraint.
 type ConstraintError struct {
-	msg string
+	msg     string
+	columns []string
 }
 
 func (e ConstraintError) Error() string { return e.msg }
@@ -235,6 +236,73 @@ func SetNeighbors(dialect string, s *Step) (q *sql.Selector) {
 	return q
 }
 
+// RecursiveNeighbors returns a Selector for evaluating the path-step
+// recursively, and getting all vertices that are reachable from the
+// given vertex (or set of vertices) by following the edge one or more
+// times. The step is expected to be defined on a self-referential edge
+// (e.g. parent/children), and the traversal is evaluated by the database
+// using a recursive common table expression. Cycles are handled by the
+// UNION operator that eliminates already visited vertices.
+func RecursiveNeighbors(dialect string, s *Step) (q *sql.Selector) {
+	builder := sql.Dialect(dialect)
+	match := func(column string) *sql.Predicate {
+		if set, ok := s.From.V.(*sql.Selector); ok {
+			set.Select(set.C(s.From.Column))
+			return sql.In(column, set)
+		}
+		return sql.EQ(column, s.From.V)
+	}
+	const name = "neighbors"
+	var anchor, recurse *sql.Selector
+	switch {
+	case s.ThroughEdgeTable():
+		pk1, pk2 := s.Edge.Columns[1], s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		t1 := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		anchor = builder.Select(t1.C(pk1)).
+			From(t1).
+			Where(match(t1.C(pk2)))
+		t2, cte := builder.Table(s.Edge.Table).Schema(s.Edge.Schema), builder.Table(name)
+		recurse = builder.Select(t2.C(pk1)).
+			From(t2).
+			Join(cte).
+			On(t2.C(pk2), cte.C(s.To.Column))
+	case s.FromEdgeOwner():
+		t1 := builder.Table(s.Edge.Table).Schema(s.Edge.Schema)
+		anchor = builder.Select(t1.C(s.Edge.Columns[0])).
+			From(t1).
+			Where(sql.And(match(t1.C(s.From.Column)), sql.NotNull(t1.C(s.Edge.Columns[0]))))
+		t2, cte := builder.Table(s.Edge.Table).Schema(s.Edge.Schema), builder.Table(name)
+		recurse = builder.Select(t2.C(s.Edge.Columns[0])).
+			From(t2).
+			Join(cte).
+			On(t2.C(s.From.Column), cte.C(s.To.Column)).
+			Where(sql.NotNull(t2.C(s.Edge.Columns[0])))
+	case s.ToEdgeOwner():
+		t1 := builder.Table(s.To.Table).Schema(s.To.Schema)
+		anchor = builder.Select(t1.C(s.To.Column)).
+			From(t1).
+			Where(match(t1.C(s.Edge.Columns[0])))
+		t2, cte := builder.Table(s.To.Table).Schema(s.To.Schema), builder.Table(name)
+		recurse = builder.Select(t2.C(s.To.Column)).
+			From(t2).
+			Join(cte).
+			On(t2.C(s.Edge.Columns[0]), cte.C(s.To.Column))
+	}
+	cte := builder.Table(name)
+	ids := builder.Select(cte.C(s.To.Column)).
+		From(cte).
+		Prefix(sql.WithRecursive(name, s.To.Column).As(anchor.Union(recurse)))
+	to := builder.Table(s.To.Table).Schema(s.To.Schema)
+	q = builder.Select().
+		From(to).
+		Join(ids).
+		On(to.C(s.To.Column), ids.C(s.To.Column))
+	return q
+}
+
 // HasNeighbors applies on the given Selector a neighbors check.
 func HasNeighbors(q *sql.Selector, s *Step) {
 	builder := sql.Dialect(q.Dialect())
@@ -313,6 +381,56 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 	}
 }
 
+// HasNeighborsWithExists is like HasNeighborsWith, but applies the neighbors
+// check using correlated EXISTS subqueries instead of IN subqueries. Unlike
+// JOINs, it never multiplies the rows of the outer query, and database engines
+// can stop scanning the subquery on its first match.
+func HasNeighborsWithExists(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
+	builder := sql.Dialect(q.Dialect())
+	switch {
+	case s.ThroughEdgeTable():
+		pk1, pk2 := s.Edge.Columns[1], s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		to := correlatedTable(q, builder.Table(s.To.Table).Schema(s.To.Schema), s.To.Table)
+		edge := correlatedTable(q, builder.Table(s.Edge.Table).Schema(s.Edge.Schema), s.Edge.Table)
+		matches := builder.Select().From(to)
+		matches.WithContext(q.Context())
+		pred(matches)
+		matches.Join(edge).
+			On(edge.C(pk1), to.C(s.To.Column)).
+			Where(sql.ColumnsEQ(edge.C(pk2), q.C(s.From.Column)))
+		q.Where(sql.Exists(matches.Select(to.C(s.To.Column))))
+	case s.FromEdgeOwner():
+		to := correlatedTable(q, builder.Table(s.To.Table).Schema(s.To.Schema), s.To.Table)
+		matches := builder.Select(to.C(s.To.Column)).
+			From(to)
+		matches.WithContext(q.Context())
+		pred(matches)
+		matches.Where(sql.ColumnsEQ(to.C(s.To.Column), q.C(s.Edge.Columns[0])))
+		q.Where(sql.Exists(matches))
+	case s.ToEdgeOwner():
+		to := correlatedTable(q, builder.Table(s.Edge.Table).Schema(s.Edge.Schema), s.Edge.Table)
+		matches := builder.Select(to.C(s.Edge.Columns[0])).
+			From(to)
+		matches.WithContext(q.Context())
+		pred(matches)
+		matches.Where(sql.ColumnsEQ(to.C(s.Edge.Columns[0]), q.C(s.From.Column)))
+		q.Where(sql.Exists(matches))
+	}
+}
+
+// correlatedTable gives the table of a correlated subquery an alias
+// in case its qualifier is identical to the qualifier of the outer
+// query, as the inner table would shadow the outer one otherwise.
+func correlatedTable(q *sql.Selector, t *sql.SelectTable, name string) *sql.SelectTable {
+	for i := 1; t.C("_") == q.C("_"); i++ {
+		t.As(fmt.Sprintf("%s_%d", name, i))
+	}
+	return t
+}
+
 // countAlias returns the alias to use for the count column.
 func countAlias(q *sql.Selector, s *Step, opt *sql.OrderTermOptions) string {
 	if opt.As != "" {
@@ -404,7 +522,7 @@ func orderTerms(q, join *sql.Selector, ts []sql.OrderTerm) {
 			orderC string
 			orderX func(*sql.Selector) sql.Querier
 			// Order by options.
-			desc, nullsfirst, nullslast bool
+			desc, nullsfirst, nullslast, fold bool
 		)
 		switch t := t.(type) {
 		case *sql.OrderFieldTerm:
@@ -419,6 +537,7 @@ func orderTerms(q, join *sql.Selector, ts []sql.OrderTerm) {
 			desc = t.Desc
 			nullsfirst = t.NullsFirst
 			nullslast = t.NullsLast
+			fold = t.Fold
 		case *sql.OrderExprTerm:
 			if t.As != "" {
 				orderC = join.C(t.As)
@@ -437,6 +556,8 @@ func orderTerms(q, join *sql.Selector, ts []sql.OrderTerm) {
 		q.OrderExprFunc(func(b *sql.Builder) {
 			// Write the ORDER BY term.
 			switch {
+			case orderC != "" && fold:
+				b.WriteString(sql.OrderFoldC(b.Dialect(), orderC))
 			case orderC != "":
 				b.WriteString(orderC)
 			case orderX != nil:
@@ -774,6 +895,7 @@ func (e *NotFoundError) Error() string {
 type DeleteSpec struct {
 	Node      *NodeSpec
 	Predicate func(*sql.Selector)
+	Modifiers []func(*sql.DeleteBuilder)
 }
 
 // NewDeleteSpec creates a new node deletion spec.
@@ -781,6 +903,11 @@ func NewDeleteSpec(table string, id *FieldSpec) *DeleteSpec {
 	return &DeleteSpec{Node: &NodeSpec{Table: table, ID: id}}
 }
 
+// AddModifiers adds a list of statement modifiers to the spec.
+func (d *DeleteSpec) AddModifiers(m ...func(*sql.DeleteBuilder)) {
+	d.Modifiers = append(d.Modifiers, m...)
+}
+
 // DeleteNodes applies the DeleteSpec on the graph.
 func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int, error) {
 	var (
@@ -793,7 +920,14 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	if pred := spec.Predicate; pred != nil {
 		pred(selector)
 	}
-	query, args := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector).Query()
+	del := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector)
+	for _, m := range spec.Modifiers {
+		m(del)
+	}
+	if err := del.Err(); err != nil {
+		return 0, err
+	}
+	query, args := del.Query()
 	if err := drv.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
 	}
@@ -839,6 +973,24 @@ func QueryNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) error
 	return qr.nodes(ctx, drv)
 }
 
+// MaxInValues returns the maximum number of values that are passed to a single IN predicate by the
+// generated code when eager-loading edges for the given dialect. Larger lists are split into chunks
+// that are executed in separate queries, to stay below the limit of bind parameters in a statement
+// (65535 in PostgreSQL and MySQL, and 999 in SQLite). Zero means there is no limit.
+//
+// Note that IN predicates that are built by users (e.g. user.IDIn or sql.InValues) are not split,
+// and large lists should be batched by the caller, for example, using chunks of MaxInValues.
+func MaxInValues(name string) int {
+	switch name {
+	case dialect.Postgres, dialect.MySQL:
+		return 65000
+	case dialect.SQLite:
+		return 900
+	default:
+		return 0
+	}
+}
+
 // CountNodes counts the nodes in the given graph query.
 func CountNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int, error) {
 	builder := sql.Dialect(drv.Dialect())
@@ -936,23 +1088,31 @@ func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
 	}
 	// Remove any ORDER BY clauses present in the COUNT query as
 	// they are not allowed in some databases, such as PostgreSQL.
-	if q.Order != nil {
+	// DISTINCT ON queries keep them, as they determine the rows.
+	if q.Order != nil && !selector.HasDistinctOn() {
 		selector.ClearOrder()
 	}
-	// If no columns were selected in count,
-	// the default selection is by node ids.
-	columns := q.Node.Columns
-	if len(columns) == 0 && q.Node.ID != nil {
-		columns = append(columns, q.Node.ID.Column)
-	}
-	for i, c := range columns {
-		columns[i] = selector.C(c)
-	}
-	if q.Unique {
-		selector.SetDistinct(false)
-		selector.Count(sql.Distinct(columns...))
-	} else {
-		selector.Count(columns...)
+	switch {
+	// Set operations and DISTINCT ON apply to the selected rows,
+	// and therefore, their result is counted using a subquery.
+	case selector.HasSetOps() || selector.HasDistinctOn():
+		selector = q.builder.Select().Count().From(selector.As("t1"))
+	default:
+		// If no columns were selected in count,
+		// the default selection is by node ids.
+		columns := q.Node.Columns
+		if len(columns) == 0 && q.Node.ID != nil {
+			columns = append(columns, q.Node.ID.Column)
+		}
+		for i, c := range columns {
+			columns[i] = selector.C(c)
+		}
+		if q.Unique {
+			selector.SetDistinct(false)
+			selector.Count(sql.Distinct(columns...))
+		} else {
+			selector.Count(columns...)
+		}
 	}
 	query, args := selector.Query()
 	if err := drv.Query(ctx, query, args, rows); err != nil {
@@ -1040,7 +1200,20 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	if err := update.Err(); err != nil {
 		return err
 	}
-	if !update.Empty() {
+	// In PostgreSQL, the updated row is returned by the UPDATE
+	// statement, instead of querying it in a separate statement.
+	returning := u.ScanValues != nil && len(u.Node.Columns) > 0 && !update.Empty() && update.Dialect() == dialect.Postgres
+	switch {
+	case returning:
+		rows := &sql.Rows{}
+		query, args := update.Returning(u.Node.Columns...).Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+		if err := u.scan(rows); err != nil {
+			return err
+		}
+	case !update.Empty():
 		var res sql.Result
 		query, args := update.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
@@ -1065,8 +1238,8 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 		}
 	}
 	// Ignore querying the database when there's nothing
-	// to scan into it.
-	if u.ScanValues == nil {
+	// to scan into it, or the row was already returned.
+	if u.ScanValues == nil || returning {
 		return nil
 	}
 	selector := u.builder.Select(u.Node.Columns...).
@@ -1371,9 +1544,18 @@ func (c *creator) insert(ctx context.Context, insert *sql.InsertBuilder) error {
 			return c.tx.Exec(ctx, query, args, nil)
 		}
 	}
+	if insert.Dialect() == dialect.ClickHouse {
+		return errClickHouseID(c.ID.Column)
+	}
 	return c.insertLastID(ctx, insert.Returning(c.ID.Column))
 }
 
+// errClickHouseID is returned when inserting a node without an id to ClickHouse,
+// as ClickHouse does not generate ids (e.g. using AUTO_INCREMENT columns).
+func errClickHouseID(column string) error {
+	return fmt.Errorf("sql/sqlgraph: ClickHouse does not generate ids, the %q column must be set", column)
+}
+
 // ensureConflict ensures the ON CONFLICT is added to the insert statement.
 func (c *creator) ensureConflict(insert *sql.InsertBuilder) {
 	if opts := c.CreateSpec.OnConflict; len(opts) > 0 {
@@ -1499,6 +1681,16 @@ func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.T
 // batchInsert inserts a batch of nodes to their table and sets their ID if it was not provided by the user.
 func (c *batchCreator) batchInsert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
 	c.ensureConflict(insert)
+	if insert.Dialect() == dialect.ClickHouse {
+		if c.Nodes[0].ID.Value == nil {
+			return errClickHouseID(c.Nodes[0].ID.Column)
+		}
+		query, args, err := insert.QueryErr()
+		if err != nil {
+			return err
+		}
+		return tx.Exec(ctx, query, args, nil)
+	}
 	return c.insertLastIDs(ctx, tx, insert.Returning(c.Nodes[0].ID.Column))
 }
 
@@ -1743,7 +1935,7 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 		// Setting the FK value of the "other" table without clearing it before, is not allowed.
 		// Including no-op (same id), because we rely on "affected" to determine if the FK set.
 		if ids := edge.Target.Nodes; int(affected) < len(ids) {
-			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0])}
+			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0]), columns: edge.Columns[:1]}
 		}
 	}
 	return nil
@@ -1776,7 +1968,8 @@ func isExternalEdge(e *EdgeSpec) bool {
 func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) (err error) {
 	for _, fi := range fields {
 		value := fi.Value
-		if fi.Type == field.TypeJSON {
+		// Array values are encoded by the builder, according to the dialect.
+		if _, ok := value.(sql.ArrayValue); !ok && fi.Type == field.TypeJSON {
 			buf, err := json.Marshal(value)
 			if err != nil {
 				return fmt.Errorf("marshal value for column %s: %w", fi.Column, err)
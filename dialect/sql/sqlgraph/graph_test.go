@@ -442,6 +442,97 @@ JOIN
 	}
 }
 
+func TestRecursiveNeighbors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *Step
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name: "O2M/1type",
+			input: NewStep(
+				From("users", "id", 1),
+				To("users", "id"),
+				Edge(O2M, false, "users", "parent_id"),
+			),
+			wantQuery: `
+SELECT *
+FROM "users"
+JOIN
+  (WITH RECURSIVE "neighbors"("id") AS
+     (SELECT "users"."id" FROM "users" WHERE "users"."parent_id" = $1
+      UNION
+      SELECT "users"."id" FROM "users" JOIN "neighbors" AS "t1" ON "users"."parent_id" = "t1"."id")
+   SELECT "neighbors"."id" FROM "neighbors") AS "t1" ON "users"."id" = "t1"."id"`,
+			wantArgs: []any{1},
+		},
+		{
+			name: "M2O/1type",
+			input: NewStep(
+				From("users", "id", 1),
+				To("users", "id"),
+				Edge(M2O, true, "users", "parent_id"),
+			),
+			wantQuery: `
+SELECT *
+FROM "users"
+JOIN
+  (WITH RECURSIVE "neighbors"("id") AS
+     (SELECT "users"."parent_id" FROM "users" WHERE "users"."id" = $1 AND "users"."parent_id" IS NOT NULL
+      UNION
+      SELECT "users"."parent_id" FROM "users" JOIN "neighbors" AS "t1" ON "users"."id" = "t1"."id" WHERE "users"."parent_id" IS NOT NULL)
+   SELECT "neighbors"."id" FROM "neighbors") AS "t1" ON "users"."id" = "t1"."id"`,
+			wantArgs: []any{1},
+		},
+		{
+			name: "M2M/1type",
+			input: NewStep(
+				From("users", "id", 1),
+				To("users", "id"),
+				Edge(M2M, false, "user_following", "follower_id", "user_id"),
+			),
+			wantQuery: `
+SELECT *
+FROM "users"
+JOIN
+  (WITH RECURSIVE "neighbors"("id") AS
+     (SELECT "user_following"."user_id" FROM "user_following" WHERE "user_following"."follower_id" = $1
+      UNION
+      SELECT "user_following"."user_id" FROM "user_following" JOIN "neighbors" AS "t1" ON "user_following"."follower_id" = "t1"."id")
+   SELECT "neighbors"."id" FROM "neighbors") AS "t1" ON "users"."id" = "t1"."id"`,
+			wantArgs: []any{1},
+		},
+		{
+			name: "O2M/1type/set",
+			input: NewStep(
+				From("users", "id", sql.Select().From(sql.Table("users")).Where(sql.EQ("name", "a8m"))),
+				To("users", "id"),
+				Edge(O2M, false, "users", "parent_id"),
+			),
+			wantQuery: `
+SELECT *
+FROM "users"
+JOIN
+  (WITH RECURSIVE "neighbors"("id") AS
+     (SELECT "users"."id" FROM "users" WHERE "users"."parent_id" IN (SELECT "users"."id" FROM "users" WHERE "name" = $1)
+      UNION
+      SELECT "users"."id" FROM "users" JOIN "neighbors" AS "t1" ON "users"."parent_id" = "t1"."id")
+   SELECT "neighbors"."id" FROM "neighbors") AS "t1" ON "users"."id" = "t1"."id"`,
+			wantArgs: []any{"a8m"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := RecursiveNeighbors("postgres", tt.input)
+			query, args := selector.Query()
+			tt.wantQuery = strings.Join(strings.Fields(tt.wantQuery), " ")
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
 func TestHasNeighbors(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -911,6 +1002,116 @@ func TestHasNeighborsWithContext(t *testing.T) {
 	}
 }
 
+func TestHasNeighborsWithExists(t *testing.T) {
+	tests := []struct {
+		name      string
+		step      *Step
+		selector  *sql.Selector
+		predicate func(*sql.Selector)
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name: "O2M",
+			step: NewStep(
+				From("users", "id"),
+				To("pets", "id"),
+				Edge(O2M, false, "pets", "owner_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").
+				From(sql.Table("users")).
+				Where(sql.EQ("last_name", "mashraki")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("name"), "pedro"))
+			},
+			wantQuery: `SELECT * FROM "users" WHERE "last_name" = $1 AND EXISTS (SELECT "pets"."owner_id" FROM "pets" WHERE "pets"."name" = $2 AND "pets"."owner_id" = "users"."id")`,
+			wantArgs:  []any{"mashraki", "pedro"},
+		},
+		{
+			name: "M2O",
+			step: NewStep(
+				From("pets", "id"),
+				To("users", "id"),
+				Edge(M2O, true, "pets", "owner_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").
+				From(sql.Table("pets")).
+				Where(sql.EQ("name", "pedro")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("last_name"), "mashraki"))
+			},
+			wantQuery: `SELECT * FROM "pets" WHERE "name" = $1 AND EXISTS (SELECT "users"."id" FROM "users" WHERE "users"."last_name" = $2 AND "users"."id" = "pets"."owner_id")`,
+			wantArgs:  []any{"pedro", "mashraki"},
+		},
+		{
+			name: "M2M",
+			step: NewStep(
+				From("users", "id"),
+				To("groups", "id"),
+				Edge(M2M, false, "user_groups", "user_id", "group_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").From(sql.Table("users")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("name"), "GitHub"))
+			},
+			wantQuery: `SELECT * FROM "users" WHERE EXISTS (SELECT "groups"."id" FROM "groups" JOIN "user_groups" AS "t1" ON "t1"."group_id" = "groups"."id" WHERE "groups"."name" = $1 AND "t1"."user_id" = "users"."id")`,
+			wantArgs:  []any{"GitHub"},
+		},
+		{
+			name: "O2M/self",
+			step: NewStep(
+				From("nodes", "id"),
+				To("nodes", "id"),
+				Edge(O2M, false, "nodes", "parent_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").From(sql.Table("nodes")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("value"), 2))
+			},
+			wantQuery: `SELECT * FROM "nodes" WHERE EXISTS (SELECT "nodes_1"."parent_id" FROM "nodes" AS "nodes_1" WHERE "nodes_1"."value" = $1 AND "nodes_1"."parent_id" = "nodes"."id")`,
+			wantArgs:  []any{2},
+		},
+		{
+			name: "M2M/self",
+			step: NewStep(
+				From("users", "id"),
+				To("users", "id"),
+				Edge(M2M, false, "user_friends", "user_id", "friend_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").From(sql.Table("users")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("name"), "a8m"))
+			},
+			wantQuery: `SELECT * FROM "users" WHERE EXISTS (SELECT "users_1"."id" FROM "users" AS "users_1" JOIN "user_friends" AS "t1" ON "t1"."friend_id" = "users_1"."id" WHERE "users_1"."name" = $1 AND "t1"."user_id" = "users"."id")`,
+			wantArgs:  []any{"a8m"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			HasNeighborsWithExists(tt.selector, tt.step, tt.predicate)
+			query, args := tt.selector.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+	t.Run("Nested", func(t *testing.T) {
+		step := NewStep(
+			From("nodes", "id"),
+			To("nodes", "id"),
+			Edge(O2M, false, "nodes", "parent_id"),
+		)
+		s := sql.Dialect("postgres").Select("*").From(sql.Table("nodes"))
+		HasNeighborsWithExists(s, step, func(s *sql.Selector) {
+			HasNeighborsWithExists(s, step, func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("value"), 2))
+			})
+		})
+		query, args := s.Query()
+		require.Equal(t, `SELECT * FROM "nodes" WHERE EXISTS (SELECT "nodes_1"."parent_id" FROM "nodes" AS "nodes_1" WHERE EXISTS (SELECT "nodes"."parent_id" FROM "nodes" WHERE "nodes"."value" = $1 AND "nodes"."parent_id" = "nodes_1"."id") AND "nodes_1"."parent_id" = "nodes"."id")`, query)
+		require.Equal(t, []any{2}, args)
+	})
+}
+
 func TestOrderByNeighborsCount(t *testing.T) {
 	build := sql.Dialect(dialect.Postgres)
 	t1 := build.Table("users")
@@ -1040,6 +1241,24 @@ func TestOrderByNeighborTerms(t *testing.T) {
 		require.Empty(t, args)
 		require.Equal(t, `SELECT "users"."name" FROM "users" LEFT JOIN (SELECT "workplace"."id", "workplace"."name" FROM "workplace") AS "t1" ON "users"."workplace_id" = "t1"."id" ORDER BY "t1"."name" NULLS LAST`, query)
 	})
+	t.Run("M2O/Fold", func(t *testing.T) {
+		s := s.Clone()
+		OrderByNeighborTerms(s,
+			NewStep(
+				From("users", "id"),
+				To("workplace", "id"),
+				Edge(M2O, true, "users", "workplace_id"),
+			),
+			sql.OrderByField(
+				"name",
+				sql.OrderFold(),
+				sql.OrderDesc(),
+			),
+		)
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT "users"."name" FROM "users" LEFT JOIN (SELECT "workplace"."id", "workplace"."name" FROM "workplace") AS "t1" ON "users"."workplace_id" = "t1"."id" ORDER BY LOWER("t1"."name") DESC NULLS LAST`, query)
+	})
 	t.Run("O2M", func(t *testing.T) {
 		s := s.Clone()
 		OrderByNeighborTerms(s,
@@ -1670,6 +1889,48 @@ func TestBatchCreate(t *testing.T) {
 	}
 }
 
+func TestCreateNode_ClickHouse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := sql.OpenDB(dialect.ClickHouse, db)
+	ctx := context.Background()
+	mock.ExpectExec(escape("INSERT INTO `events` (`name`, `id`) VALUES (?, ?)")).
+		WithArgs("login", "a7f6e1b2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err = CreateNode(ctx, drv, &CreateSpec{
+		Table:  "events",
+		ID:     &FieldSpec{Column: "id", Type: field.TypeString, Value: "a7f6e1b2"},
+		Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "login"}},
+	})
+	require.NoError(t, err)
+
+	err = CreateNode(ctx, drv, &CreateSpec{
+		Table:  "events",
+		ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+		Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "login"}},
+	})
+	require.EqualError(t, err, `sql/sqlgraph: ClickHouse does not generate ids, the "id" column must be set`)
+
+	mock.ExpectExec(escape("INSERT INTO `events` (`id`, `name`) VALUES (?, ?), (?, ?)")).
+		WithArgs(1, "login", 2, "logout").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	err = BatchCreate(ctx, drv, &BatchCreateSpec{
+		Nodes: []*CreateSpec{
+			{Table: "events", ID: &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1}, Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "login"}}},
+			{Table: "events", ID: &FieldSpec{Column: "id", Type: field.TypeInt, Value: 2}, Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "logout"}}},
+		},
+	})
+	require.NoError(t, err)
+
+	err = BatchCreate(ctx, drv, &BatchCreateSpec{
+		Nodes: []*CreateSpec{
+			{Table: "events", ID: &FieldSpec{Column: "id", Type: field.TypeInt}, Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "login"}}},
+		},
+	})
+	require.EqualError(t, err, `insert nodes to table "events": sql/sqlgraph: ClickHouse does not generate ids, the "id" column must be set`)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 type user struct {
 	id    int
 	age   int
@@ -2040,6 +2301,49 @@ func TestUpdateNode(t *testing.T) {
 	}
 }
 
+func TestUpdateNode_Returning(t *testing.T) {
+	spec := func() *UpdateSpec {
+		return &UpdateSpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "name", "age"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+			},
+			Fields: FieldMut{
+				Set: []*FieldSpec{
+					{Column: "age", Type: field.TypeInt, Value: 30},
+				},
+			},
+		}
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape(`UPDATE "users" SET "age" = $1 WHERE "id" = $2 RETURNING "id", "name", "age"`)).
+		WithArgs(30, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}).
+			AddRow(1, "Ariel", 30))
+	mock.ExpectCommit()
+	usr := &user{}
+	s := spec()
+	s.Assign, s.ScanValues = usr.assign, usr.values
+	require.NoError(t, UpdateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), s))
+	require.Equal(t, &user{name: "Ariel", age: 30, id: 1}, usr)
+
+	// Rows that were not returned by the statement were not found.
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape(`UPDATE "users" SET "age" = $1 WHERE "id" = $2 RETURNING "id", "name", "age"`)).
+		WithArgs(30, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "age"}))
+	mock.ExpectRollback()
+	s = spec()
+	s.Assign, s.ScanValues = usr.assign, usr.values
+	err = UpdateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), s)
+	nf := &NotFoundError{}
+	require.ErrorAs(t, err, &nf)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestExecUpdateNode(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2372,6 +2676,30 @@ func TestDeleteNodesSchema(t *testing.T) {
 	require.Equal(t, 2, affected)
 }
 
+func TestDeleteNodesModifiers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectExec(escape("DELETE FROM `users` WHERE `name` = ? AND `age` > ?")).
+		WithArgs("a8m", 30).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	spec := NewDeleteSpec("users", NewFieldSpec("id", field.TypeInt))
+	spec.Predicate = func(s *sql.Selector) {
+		s.Where(sql.EQ("name", "a8m"))
+	}
+	spec.AddModifiers(func(d *sql.DeleteBuilder) {
+		d.Where(sql.GT("age", 30))
+	})
+	affected, err := DeleteNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+
+	spec.AddModifiers(func(d *sql.DeleteBuilder) {
+		d.AddError(errors.New("invalid modifier"))
+	})
+	_, err = DeleteNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.EqualError(t, err, "invalid modifier")
+}
+
 func TestQueryNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2441,6 +2769,61 @@ func TestQueryNodes(t *testing.T) {
 	require.Equal(t, 3, n)
 }
 
+func TestCountNodes_SetOps(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT COUNT(*) FROM (SELECT * FROM `users` WHERE `age` < ? UNION SELECT * FROM `users` WHERE `name` = ?) AS `t1`")).
+		WithArgs(40, "a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(5))
+	n, err := CountNodes(context.Background(), sql.OpenDB("", db), &QuerySpec{
+		Node: &NodeSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Unique: true,
+		Order: func(s *sql.Selector) {
+			s.OrderBy("id")
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.LT("age", 40))
+		},
+		Modifiers: []func(*sql.Selector){
+			func(s *sql.Selector) {
+				s.SetDistinct(false).Union(sql.Select().From(sql.Table("users")).Where(sql.EQ("name", "a8m")))
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountNodes_DistinctOn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape(`SELECT COUNT(*) FROM (SELECT DISTINCT ON ("user_id") * FROM "events" ORDER BY "user_id") AS "t1"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(2))
+	n, err := CountNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), &QuerySpec{
+		Node: &NodeSpec{
+			Table: "events",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Order: func(s *sql.Selector) {
+			s.OrderBy("user_id")
+		},
+		Modifiers: []func(*sql.Selector){
+			func(s *sql.Selector) {
+				s.DistinctOn("user_id")
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestQueryNodesSchema(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2656,3 +3039,103 @@ func escape(query string) string {
 	query = strings.Join(rows, " ")
 	return strings.TrimSpace(regexp.QuoteMeta(query)) + "$"
 }
+
+// pqError mimics the pq.Error for testing.
+type pqError map[byte]string
+
+func (e pqError) Error() string     { return "pq: " + e['M'] }
+func (e pqError) Get(k byte) string { return e[k] }
+
+func TestParseConstraintError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected *ConstraintViolation
+	}{
+		{
+			name: "MySQL FK",
+			err: errors.New(`insert node to table "pets": Error 1452: Cannot add or update a child row: a foreign key` +
+				" constraint fails (`test`.`pets`, CONSTRAINT `pets_users_pets` FOREIGN KEY (`user_pets`) REFERENCES " +
+				"`users` (`id`) ON DELETE SET NULL)"),
+			expected: &ConstraintViolation{Kind: ForeignKeyConstraint, Constraint: "pets_users_pets", Columns: []string{"user_pets"}},
+		},
+		{
+			name:     "MySQL Unique",
+			err:      errors.New(`insert node to table "users": Error 1062 (23000): Duplicate entry 'a8m' for key 'users.nickname'`),
+			expected: &ConstraintViolation{Kind: UniqueConstraint, Constraint: "nickname"},
+		},
+		{
+			name:     "MySQL 5.7 Unique",
+			err:      errors.New(`Error 1062: Duplicate entry 'a8m' for key 'nickname'`),
+			expected: &ConstraintViolation{Kind: UniqueConstraint, Constraint: "nickname"},
+		},
+		{
+			name:     "SQLite FK",
+			err:      errors.New(`insert node to table "pets": FOREIGN KEY constraint failed`),
+			expected: &ConstraintViolation{Kind: ForeignKeyConstraint},
+		},
+		{
+			name:     "SQLite Unique",
+			err:      errors.New(`insert node to table "users": UNIQUE constraint failed: users.first_name, users.last_name`),
+			expected: &ConstraintViolation{Kind: UniqueConstraint, Columns: []string{"first_name", "last_name"}},
+		},
+		{
+			name:     "SQLite Unique Index",
+			err:      errors.New(`insert node to table "users": UNIQUE constraint failed: index 'user_lower_email'`),
+			expected: &ConstraintViolation{Kind: UniqueConstraint, Constraint: "user_lower_email"},
+		},
+		{
+			name:     "Postgres FK",
+			err:      errors.New(`pq: update or delete on table "group_infos" violates foreign key constraint "groups_group_infos_info" on table "groups"`),
+			expected: &ConstraintViolation{Kind: ForeignKeyConstraint, Constraint: "groups_group_infos_info"},
+		},
+		{
+			name: "Postgres Unique",
+			err: fmt.Errorf("insert node to table %q: %w", "users", pqError{
+				'C': "23505",
+				'M': `duplicate key value violates unique constraint "users_email_key"`,
+				'D': "Key (email)=(a8m@entgo.io) already exists.",
+			}),
+			expected: &ConstraintViolation{Kind: UniqueConstraint, Constraint: "users_email_key", Columns: []string{"email"}},
+		},
+		{
+			name: "Postgres Composite FK",
+			err: pqError{
+				'C': "23503",
+				'M': `insert or update on table "pets" violates foreign key constraint "pets_owners"`,
+				'D': `Key (owner_first, owner_last)=(a, m) is not present in table "owners".`,
+			},
+			expected: &ConstraintViolation{Kind: ForeignKeyConstraint, Constraint: "pets_owners", Columns: []string{"owner_first", "owner_last"}},
+		},
+		{
+			name:     "Edge Already Connected",
+			err:      fmt.Errorf("add edge: %w", &ConstraintError{msg: "one of [1] is already connected to a different owner_id", columns: []string{"owner_id"}}),
+			expected: &ConstraintViolation{Kind: UniqueConstraint, Columns: []string{"owner_id"}},
+		},
+		{
+			name: "Not a Constraint Error",
+			err:  errors.New("connection refused"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := ParseConstraintError(tt.err)
+			require.Equal(t, tt.expected != nil, ok)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestSQLState(t *testing.T) {
+	require.Equal(t, "23505", SQLState(fmt.Errorf("insert node to table %q: %w", "users", pqError{'C': "23505"})))
+	require.Equal(t, "23000", SQLState(errors.New(`insert node to table "users": Error 1062 (23000): Duplicate entry 'a8m' for key 'users.nickname'`)))
+	require.Empty(t, SQLState(errors.New(`Error 1062: Duplicate entry 'a8m' for key 'nickname'`)))
+	require.Empty(t, SQLState(errors.New("UNIQUE constraint failed: users.name")))
+}
+
+func TestMaxInValues(t *testing.T) {
+	require.Equal(t, 900, MaxInValues(dialect.SQLite))
+	require.Equal(t, 65000, MaxInValues(dialect.Postgres))
+	require.Equal(t, 65000, MaxInValues(dialect.MySQL))
+	require.Zero(t, MaxInValues(dialect.Gremlin))
+}
@@ -405,6 +405,27 @@ func Append[T any](u *sql.UpdateBuilder, column string, elems []T, opts ...Optio
 	drv.Append(u, column, vs, opts...)
 }
 
+// AppendArray is like Append, but for array fields that are stored in native array
+// columns in PostgreSQL (e.g. text[]). In other dialects, it is equivalent to Append.
+//
+//	AppendArray(u, column, []string{"a", "b"})
+//	UPDATE "t" SET "c" = array_cat("c", $1)
+func AppendArray[T any](u *sql.UpdateBuilder, column string, elems []T) {
+	if u.Dialect() != dialect.Postgres {
+		Append(u, column, elems)
+		return
+	}
+	if len(elems) == 0 {
+		u.AddError(fmt.Errorf("sqljson: cannot append an empty array to column %q", column))
+		return
+	}
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("array_cat").Wrap(func(b *sql.Builder) {
+			b.Ident(column).Comma().Arg(sql.ArrayValue{V: elems})
+		})
+	}))
+}
+
 // Option allows for calling database JSON paths with functional options.
 type Option func(*PathOptions)
 
@@ -0,0 +1,291 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"entgo.io/ent/dialect"
+)
+
+// DefaultStmtCacheSize is the default number of statements cached by the StmtCacheDriver.
+const DefaultStmtCacheSize = 256
+
+// StmtCacheDriver is a driver that prepares the statements it executes, and caches them by their
+// query text in an LRU cache, so executing the same (generated) queries again does not require
+// the database to parse and plan them again. Statements are prepared on the database pool, and
+// database/sql prepares them lazily on each connection they are executed on. In transactions,
+// the cached statements are bound to the connection of the transaction.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	cache := sql.NewStmtCacheDriver(drv, sql.WithStmtCacheSize(512))
+//	client := ent.NewClient(ent.Driver(cache))
+//	// ...
+//	fmt.Println(cache.Stats().Hits)
+type StmtCacheDriver struct {
+	*Driver
+	cache *stmtCache
+}
+
+// StmtCacheOption configures the StmtCacheDriver.
+type StmtCacheOption func(*StmtCacheDriver)
+
+// WithStmtCacheSize sets the maximum number of statements that are kept in the cache.
+// The least recently used statement is closed when a new statement exceeds this size.
+func WithStmtCacheSize(n int) StmtCacheOption {
+	return func(d *StmtCacheDriver) {
+		if n > 0 {
+			d.cache.size = n
+		}
+	}
+}
+
+// NewStmtCacheDriver returns a new StmtCacheDriver that wraps the given driver.
+func NewStmtCacheDriver(drv *Driver, opts ...StmtCacheOption) *StmtCacheDriver {
+	d := &StmtCacheDriver{
+		Driver: drv,
+		cache: &stmtCache{
+			size:  DefaultStmtCacheSize,
+			items: make(map[string]*list.Element),
+			lru:   list.New(),
+		},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// StmtCacheStats holds the metrics of the statement cache.
+type StmtCacheStats struct {
+	Hits      uint64 // Executions that used a cached statement.
+	Misses    uint64 // Executions that prepared a new statement.
+	Evictions uint64 // Statements that were evicted from the cache.
+	Size      int    // Number of statements in the cache.
+}
+
+// Stats returns the metrics of the statement cache.
+func (d *StmtCacheDriver) Stats() StmtCacheStats {
+	return d.cache.stats()
+}
+
+// Exec executes the statement using its cached prepared statement.
+func (d *StmtCacheDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.cache.exec(ctx, d.DB(), nil, query, args, v)
+}
+
+// Query executes the query using its cached prepared statement.
+func (d *StmtCacheDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.cache.query(ctx, d.DB(), nil, query, args, v)
+}
+
+// Tx starts and returns a transaction that uses the cached prepared statements.
+func (d *StmtCacheDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with options that uses the cached prepared statements.
+func (d *StmtCacheDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	tx, err := beginTx(ctx, d.DB(), d.Dialect(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return &stmtCacheTx{
+		Tx:    &Tx{Conn: Conn{tx}, Tx: tx},
+		tx:    tx,
+		db:    d.DB(),
+		cache: d.cache,
+	}, nil
+}
+
+// Close closes the cached statements and the underlying connection.
+func (d *StmtCacheDriver) Close() error {
+	d.cache.purge()
+	return d.Driver.Close()
+}
+
+// stmtCacheTx is a transaction that binds the cached statements to its connection.
+type stmtCacheTx struct {
+	*Tx
+	tx    *sql.Tx
+	db    *sql.DB
+	cache *stmtCache
+}
+
+// Exec executes the statement in the transaction using its cached prepared statement.
+func (t *stmtCacheTx) Exec(ctx context.Context, query string, args, v any) error {
+	return t.cache.exec(ctx, t.db, t.tx, query, args, v)
+}
+
+// Query executes the query in the transaction using its cached prepared statement.
+func (t *stmtCacheTx) Query(ctx context.Context, query string, args, v any) error {
+	return t.cache.query(ctx, t.db, t.tx, query, args, v)
+}
+
+type (
+	// stmtCache is an LRU cache of prepared statements.
+	stmtCache struct {
+		mu    sync.Mutex
+		size  int
+		items map[string]*list.Element
+		lru   *list.List
+		// Metrics.
+		hits, misses, evictions uint64
+	}
+	// stmtEntry is a cached statement. The statement is closed after it
+	// was evicted from the cache, and it is not used by any execution.
+	stmtEntry struct {
+		query   string
+		stmt    *sql.Stmt
+		refs    int
+		evicted bool
+	}
+)
+
+// exec executes the statement on the database, or in the given transaction.
+func (c *stmtCache) exec(ctx context.Context, db *sql.DB, tx *sql.Tx, query string, args, v any) error {
+	argv, ok := args.([]any)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect []any for args", args)
+	}
+	res, ok := v.(*sql.Result)
+	if !ok && v != nil {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Result", v)
+	}
+	e, err := c.acquire(ctx, db, query)
+	if err != nil {
+		return err
+	}
+	defer c.release(e)
+	stmt := e.stmt
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+		defer stmt.Close()
+	}
+	r, err := stmt.ExecContext(ctx, argv...)
+	if err != nil {
+		return err
+	}
+	if res != nil {
+		*res = r
+	}
+	return nil
+}
+
+// query executes the query on the database, or in the given transaction.
+func (c *stmtCache) query(ctx context.Context, db *sql.DB, tx *sql.Tx, query string, args, v any) error {
+	vr, ok := v.(*Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Rows", v)
+	}
+	argv, ok := args.([]any)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect []any for args", args)
+	}
+	e, err := c.acquire(ctx, db, query)
+	if err != nil {
+		return err
+	}
+	// Open rows keep their statement open until they are closed,
+	// even if the statement was closed after it was evicted.
+	defer c.release(e)
+	stmt := e.stmt
+	if tx != nil {
+		// Transaction statements are closed when the transaction
+		// ends, after the rows that were read in the transaction.
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+	rows, err := stmt.QueryContext(ctx, argv...)
+	if err != nil {
+		return err
+	}
+	*vr = Rows{rows}
+	return nil
+}
+
+// acquire returns the cached statement of the query, or prepares and caches a new one.
+func (c *stmtCache) acquire(ctx context.Context, db *sql.DB, query string) (*stmtEntry, error) {
+	c.mu.Lock()
+	if el, ok := c.items[query]; ok {
+		c.hits++
+		c.lru.MoveToFront(el)
+		e := el.Value.(*stmtEntry)
+		e.refs++
+		c.mu.Unlock()
+		return e, nil
+	}
+	c.misses++
+	c.mu.Unlock()
+	// Statements are prepared outside the lock, as it requires a roundtrip
+	// to the database. Concurrent executions of the same query may prepare
+	// it more than once, in which case, only the first one is cached.
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[query]; ok {
+		stmt.Close()
+		e := el.Value.(*stmtEntry)
+		e.refs++
+		return e, nil
+	}
+	e := &stmtEntry{query: query, stmt: stmt, refs: 1}
+	c.items[query] = c.lru.PushFront(e)
+	for c.lru.Len() > c.size {
+		c.evict(c.lru.Back())
+	}
+	return e, nil
+}
+
+// release releases the given statement, and closes it if it was evicted.
+func (c *stmtCache) release(e *stmtEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e.refs--; e.refs == 0 && e.evicted {
+		e.stmt.Close()
+	}
+}
+
+// evict removes the given element from the cache. The lock must be held by the caller.
+func (c *stmtCache) evict(el *list.Element) {
+	e := c.lru.Remove(el).(*stmtEntry)
+	delete(c.items, e.query)
+	c.evictions++
+	if e.evicted = true; e.refs == 0 {
+		e.stmt.Close()
+	}
+}
+
+// purge evicts all statements from the cache.
+func (c *stmtCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+}
+
+// stats returns the metrics of the cache.
+func (c *stmtCache) stats() StmtCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return StmtCacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.lru.Len(),
+	}
+}
+
+var _ dialect.Driver = (*StmtCacheDriver)(nil)
@@ -0,0 +1,84 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmtCacheDriver(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := NewStmtCacheDriver(OpenDB(dialect.Postgres, db), WithStmtCacheSize(2))
+	ctx := context.Background()
+
+	// The statement is prepared once, and executed twice.
+	prep := mock.ExpectPrepare("DELETE FROM users WHERE id = $1")
+	prep.ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	var res Result
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users WHERE id = $1", []any{1}, &res))
+	affected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, affected)
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users WHERE id = $1", []any{2}, nil))
+	require.Equal(t, StmtCacheStats{Hits: 1, Misses: 1, Size: 1}, drv.Stats())
+
+	// Queries share the same cache.
+	prep = mock.ExpectPrepare("SELECT name FROM users")
+	prep.ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT name FROM users", []any{}, rows))
+	var names []string
+	require.NoError(t, ScanSlice(rows, &names))
+	require.Equal(t, []string{"a8m"}, names)
+	require.NoError(t, rows.Close())
+	require.Equal(t, StmtCacheStats{Hits: 1, Misses: 2, Size: 2}, drv.Stats())
+
+	// The least recently used statement (DELETE) is evicted and closed.
+	prep.WillBeClosed()
+	mock.ExpectPrepare("UPDATE users SET name = $1").
+		ExpectExec().
+		WithArgs("a8m").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "UPDATE users SET name = $1", []any{"a8m"}, nil))
+	require.Equal(t, StmtCacheStats{Hits: 1, Misses: 3, Evictions: 1, Size: 2}, drv.Stats())
+
+	// Transactions use the cached statements.
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE users SET name = $1").WithArgs("nati").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "UPDATE users SET name = $1", []any{"nati"}, nil))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, StmtCacheStats{Hits: 2, Misses: 3, Evictions: 1, Size: 2}, drv.Stats())
+
+	// Closing the driver closes the cached statements.
+	mock.ExpectClose()
+	require.NoError(t, drv.Close())
+	require.Equal(t, StmtCacheStats{Hits: 2, Misses: 3, Evictions: 3}, drv.Stats())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStmtCacheDriver_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := NewStmtCacheDriver(OpenDB(dialect.SQLite, db))
+	ctx := context.Background()
+
+	mock.ExpectPrepare("SELECT * FROM unknown").WillReturnError(context.DeadlineExceeded)
+	require.ErrorIs(t, drv.Query(ctx, "SELECT * FROM unknown", []any{}, &Rows{}), context.DeadlineExceeded)
+	require.Equal(t, StmtCacheStats{Misses: 1}, drv.Stats(), "failed statements are not cached")
+	require.EqualError(t, drv.Exec(ctx, "DELETE FROM users", 1, nil), "dialect/sql: invalid type int. expect []any for args")
+	require.EqualError(t, drv.Query(ctx, "SELECT * FROM users", []any{}, nil), "dialect/sql: invalid type <nil>. expect *sql.Rows")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,144 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+)
+
+// TenantDriver is a driver that sets the tenant of the context as a transaction-local runtime
+// parameter (GUC) of the statements it executes. Statements that are executed outside a transaction
+// are wrapped with one. It is used along with the PostgreSQL row-level security policies that are
+// configured by the entsql.RowSecurity annotation, for isolating the rows of different tenants.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := ent.NewClient(ent.Driver(sql.NewTenantDriver(drv, entsql.DefaultTenantSetting)))
+//	users, err := client.User.Query().All(sql.WithTenant(ctx, "42"))
+type TenantDriver struct {
+	dialect.Driver
+	setting string
+}
+
+// NewTenantDriver returns a new TenantDriver that sets the given runtime parameter.
+func NewTenantDriver(drv dialect.Driver, setting string) *TenantDriver {
+	return &TenantDriver{Driver: drv, setting: setting}
+}
+
+// tenantKey is the context key for the tenant identifier.
+type tenantKey struct{}
+
+// WithTenant returns a new context with the given tenant identifier.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant identifier stored in the context, if any.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// Exec executes the statement in a transaction that is set with the tenant of the context.
+func (d *TenantDriver) Exec(ctx context.Context, query string, args, v any) error {
+	if _, ok := TenantFromContext(ctx); !ok {
+		return d.Driver.Exec(ctx, query, args, v)
+	}
+	tx, err := d.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, query, args, v); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// Query executes the query in a transaction that is set with the tenant of the context.
+// The transaction is committed when the returned rows are closed.
+func (d *TenantDriver) Query(ctx context.Context, query string, args, v any) error {
+	if _, ok := TenantFromContext(ctx); !ok {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	rows, ok := v.(*Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Rows", v)
+	}
+	tx, err := d.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return rollback(tx, err)
+	}
+	rows.ColumnScanner = &tenantRows{ColumnScanner: rows.ColumnScanner, tx: tx}
+	return nil
+}
+
+// Tx starts a transaction that is set with the tenant of the context.
+func (d *TenantDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with options that is set with the tenant of the context.
+func (d *TenantDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = d.Driver.Tx(ctx)
+	} else {
+		err = fmt.Errorf("dialect/sql: Driver.BeginTx is not supported")
+	}
+	if err != nil {
+		return nil, err
+	}
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return tx, nil
+	}
+	// The third argument of set_config makes the setting local to the transaction.
+	if err := tx.Exec(ctx, "SELECT set_config($1, $2, true)", []any{d.setting, tenant}, nil); err != nil {
+		return nil, rollback(tx, fmt.Errorf("dialect/sql: setting tenant: %w", err))
+	}
+	return tx, nil
+}
+
+// tenantRows commits the transaction of the query when the rows are closed.
+type tenantRows struct {
+	ColumnScanner
+	tx   dialect.Tx
+	done bool
+}
+
+// Close closes the rows and ends their transaction.
+func (r *tenantRows) Close() error {
+	if r.done {
+		return nil
+	}
+	r.done = true
+	if err := r.ColumnScanner.Close(); err != nil {
+		return rollback(r.tx, err)
+	}
+	return r.tx.Commit()
+}
+
+// rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
+func rollback(tx dialect.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
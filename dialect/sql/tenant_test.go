@@ -0,0 +1,57 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantDriver(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := NewTenantDriver(OpenDB(dialect.Postgres, db), "app.tenant")
+	ctx := context.Background()
+
+	// Statements without a tenant are executed as is.
+	mock.ExpectExec("DELETE FROM users").WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users", []any{}, nil))
+
+	ctx = WithTenant(ctx, "42")
+	tenant, ok := TenantFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, "42", tenant)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant", "42").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM users").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users", []any{}, nil))
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant", "42").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT name FROM users").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	mock.ExpectCommit()
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT name FROM users", []any{}, rows))
+	var names []string
+	require.NoError(t, ScanSlice(rows, &names))
+	require.Equal(t, []string{"a8m"}, names)
+	require.NoError(t, rows.Close())
+	require.NoError(t, rows.Close(), "closing rows twice should not commit twice")
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant", "42").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,277 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// TimeoutError is returned by the TimeoutDriver when an operation fails because its deadline was exceeded.
+type TimeoutError struct {
+	Op      dialect.Operation // The operation that timed out.
+	Timeout time.Duration     // The timeout of the operation. Zero if the deadline was set by the caller.
+	Err     error             // The error returned by the underlying driver.
+}
+
+// Error implements the error interface.
+func (e *TimeoutError) Error() string {
+	if e.Timeout > 0 {
+		return fmt.Sprintf("dialect/sql: %s timed out after %s: %v", e.Op, e.Timeout, e.Err)
+	}
+	return fmt.Sprintf("dialect/sql: %s deadline exceeded: %v", e.Op, e.Err)
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports if the target error is context.DeadlineExceeded, as drivers do
+// not necessarily wrap the context error when their operations are canceled.
+func (e *TimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// TimeoutDriver is a driver that applies default timeouts to the operations it executes, and returns a
+// *TimeoutError for operations that fail because their deadline was exceeded, whether it was set by the
+// driver or by the caller. The timeout of queries lasts until their rows are closed, and the timeout of
+// transactions (dialect.OpTx) lasts until they are committed or rolled back. Statements that are executed
+// in transactions are bounded by both the timeout of their operation and the timeout of their transaction.
+//
+//	drv, err := sql.Open(dialect.Postgres, dsn)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := ent.NewClient(ent.Driver(sql.NewTimeoutDriver(drv,
+//		sql.WithTimeout(dialect.OpQuery, 5*time.Second),
+//		sql.WithTimeout(dialect.OpExec, time.Second),
+//	)))
+type TimeoutDriver struct {
+	dialect.Driver
+	timeouts map[dialect.Operation]time.Duration
+}
+
+// TimeoutOption configures the TimeoutDriver.
+type TimeoutOption func(*TimeoutDriver)
+
+// WithTimeout sets the default timeout of the given operation. Contexts
+// with an earlier deadline are not extended by the default timeout.
+func WithTimeout(op dialect.Operation, d time.Duration) TimeoutOption {
+	return func(drv *TimeoutDriver) {
+		if d > 0 {
+			drv.timeouts[op] = d
+		}
+	}
+}
+
+// NewTimeoutDriver returns a new TimeoutDriver that wraps the given driver.
+func NewTimeoutDriver(drv dialect.Driver, opts ...TimeoutOption) *TimeoutDriver {
+	d := &TimeoutDriver{Driver: drv, timeouts: make(map[dialect.Operation]time.Duration)}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Exec executes the statement with the timeout of dialect.OpExec.
+func (d *TimeoutDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.exec(ctx, d.Driver, query, args, v)
+}
+
+// Query executes the query with the timeout of dialect.OpQuery.
+// The timeout is canceled when the returned rows are closed.
+func (d *TimeoutDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.query(ctx, d.Driver, query, args, v)
+}
+
+// ExecContext executes the statement with the timeout of dialect.OpExec,
+// if the ExecContext method is supported by the underlying driver.
+func (d *TimeoutDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return d.execContext(ctx, d.Driver, query, args...)
+}
+
+// QueryContext executes the query with the timeout of dialect.OpQuery, if the QueryContext method
+// is supported by the underlying driver. Unlike Query, the timeout is not canceled when the returned
+// rows are closed, as they cannot be wrapped, and it is released only when it expires.
+func (d *TimeoutDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return d.queryContext(ctx, d.Driver, query, args...)
+}
+
+// Tx starts a transaction with the timeout of dialect.OpTx.
+func (d *TimeoutDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with options and the timeout of dialect.OpTx.
+func (d *TimeoutDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	ctx, cancel, wrap := d.timeout(ctx, dialect.OpTx)
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = d.Driver.Tx(ctx)
+	} else {
+		err = fmt.Errorf("dialect/sql: Driver.BeginTx is not supported")
+	}
+	if err != nil {
+		cancel()
+		return nil, wrap(err)
+	}
+	return &timeoutTx{Tx: tx, drv: d, cancel: cancel, wrap: wrap}, nil
+}
+
+// exec executes the statement on the given connection with the timeout of dialect.OpExec.
+func (d *TimeoutDriver) exec(ctx context.Context, conn dialect.ExecQuerier, query string, args, v any) error {
+	ctx, cancel, wrap := d.timeout(ctx, dialect.OpExec)
+	defer cancel()
+	return wrap(conn.Exec(ctx, query, args, v))
+}
+
+// query executes the query on the given connection with the timeout of dialect.OpQuery.
+func (d *TimeoutDriver) query(ctx context.Context, conn dialect.ExecQuerier, query string, args, v any) error {
+	rows, ok := v.(*Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Rows", v)
+	}
+	ctx, cancel, wrap := d.timeout(ctx, dialect.OpQuery)
+	if err := conn.Query(ctx, query, args, rows); err != nil {
+		cancel()
+		return wrap(err)
+	}
+	// Canceling the context closes the rows, and therefore, it is
+	// deferred until the rows are closed by the caller.
+	rows.ColumnScanner = &timeoutRows{ColumnScanner: rows.ColumnScanner, cancel: cancel, wrap: wrap}
+	return nil
+}
+
+// execContext executes the statement on the given connection with the timeout of dialect.OpExec.
+func (d *TimeoutDriver) execContext(ctx context.Context, conn any, query string, args ...any) (sql.Result, error) {
+	c, ok := conn.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: %T.ExecContext is not supported", conn)
+	}
+	ctx, cancel, wrap := d.timeout(ctx, dialect.OpExec)
+	defer cancel()
+	res, err := c.ExecContext(ctx, query, args...)
+	return res, wrap(err)
+}
+
+// queryContext executes the query on the given connection with the timeout of dialect.OpQuery.
+func (d *TimeoutDriver) queryContext(ctx context.Context, conn any, query string, args ...any) (*sql.Rows, error) {
+	c, ok := conn.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: %T.QueryContext is not supported", conn)
+	}
+	// The timeout is not canceled on success, as canceling
+	// the context of the rows closes them (see QueryContext).
+	ctx, cancel, wrap := d.timeout(ctx, dialect.OpQuery)
+	rows, err := c.QueryContext(ctx, query, args...)
+	if err != nil {
+		cancel()
+		return nil, wrap(err)
+	}
+	return rows, nil
+}
+
+// timeout returns the context of the given operation with its timeout, and a function
+// that converts the errors that are caused by the deadline of this context to *TimeoutError.
+func (d *TimeoutDriver) timeout(parent context.Context, op dialect.Operation) (context.Context, context.CancelFunc, func(error) error) {
+	ctx, cancel := parent, context.CancelFunc(func() {})
+	t, ok := d.timeouts[op]
+	if ok {
+		ctx, cancel = context.WithTimeout(parent, t)
+	}
+	return ctx, cancel, func(err error) error {
+		var e *TimeoutError
+		switch {
+		case err == nil || errors.As(err, &e):
+			return err
+		case !errors.Is(err, context.DeadlineExceeded) && ctx.Err() != context.DeadlineExceeded:
+			return err
+		}
+		e = &TimeoutError{Op: op, Err: err}
+		if ok && parent.Err() == nil {
+			e.Timeout = t
+		}
+		return e
+	}
+}
+
+// timeoutTx is a transaction that applies the timeouts of the driver on its statements.
+type timeoutTx struct {
+	dialect.Tx
+	drv    *TimeoutDriver
+	cancel context.CancelFunc
+	wrap   func(error) error
+}
+
+// Exec executes the statement in the transaction with the timeout of dialect.OpExec.
+func (t *timeoutTx) Exec(ctx context.Context, query string, args, v any) error {
+	return t.wrap(t.drv.exec(ctx, t.Tx, query, args, v))
+}
+
+// Query executes the query in the transaction with the timeout of dialect.OpQuery.
+func (t *timeoutTx) Query(ctx context.Context, query string, args, v any) error {
+	return t.wrap(t.drv.query(ctx, t.Tx, query, args, v))
+}
+
+// ExecContext executes the statement in the transaction with the timeout of dialect.OpExec.
+func (t *timeoutTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	res, err := t.drv.execContext(ctx, t.Tx, query, args...)
+	return res, t.wrap(err)
+}
+
+// QueryContext executes the query in the transaction with the timeout of dialect.OpQuery.
+func (t *timeoutTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	rows, err := t.drv.queryContext(ctx, t.Tx, query, args...)
+	return rows, t.wrap(err)
+}
+
+// Commit commits the transaction and cancels its timeout.
+func (t *timeoutTx) Commit() error {
+	defer t.cancel()
+	return t.wrap(t.Tx.Commit())
+}
+
+// Rollback rolls back the transaction and cancels its timeout.
+func (t *timeoutTx) Rollback() error {
+	defer t.cancel()
+	return t.wrap(t.Tx.Rollback())
+}
+
+// timeoutRows cancels the timeout of the query when the rows are closed.
+type timeoutRows struct {
+	ColumnScanner
+	cancel context.CancelFunc
+	wrap   func(error) error
+}
+
+// Err returns the error of the rows, if any.
+func (r *timeoutRows) Err() error {
+	return r.wrap(r.ColumnScanner.Err())
+}
+
+// Close closes the rows and cancels the timeout of the query.
+func (r *timeoutRows) Close() error {
+	defer r.cancel()
+	return r.ColumnScanner.Close()
+}
+
+var _ dialect.Driver = (*TimeoutDriver)(nil)
@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutDriver(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := NewTimeoutDriver(OpenDB(dialect.Postgres, db),
+		WithTimeout(dialect.OpExec, 10*time.Millisecond),
+		WithTimeout(dialect.OpQuery, time.Second),
+	)
+	ctx := context.Background()
+
+	// Operations that end before their timeout.
+	mock.ExpectExec("DELETE FROM users").WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "DELETE FROM users", []any{}, nil))
+	mock.ExpectQuery("SELECT name FROM users").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT name FROM users", []any{}, rows))
+	var names []string
+	require.NoError(t, ScanSlice(rows, &names), "rows are readable until they are closed")
+	require.Equal(t, []string{"a8m"}, names)
+	require.NoError(t, rows.Close())
+
+	// Operations that exceed their timeout.
+	mock.ExpectExec("DELETE FROM users").WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 1))
+	err = drv.Exec(ctx, "DELETE FROM users", []any{}, nil)
+	var terr *TimeoutError
+	require.True(t, errors.As(err, &terr))
+	require.Equal(t, dialect.OpExec, terr.Op)
+	require.Equal(t, 10*time.Millisecond, terr.Timeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.EqualError(t, err, "dialect/sql: exec timed out after 10ms: canceling query due to user request")
+
+	// Deadlines that are set by the caller.
+	mock.ExpectQuery("SELECT name FROM users").WillDelayFor(time.Second).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = drv.Query(cctx, "SELECT name FROM users", []any{}, &Rows{})
+	require.True(t, errors.As(err, &terr))
+	require.Equal(t, dialect.OpQuery, terr.Op)
+	require.Zero(t, terr.Timeout)
+
+	// Statements in transactions.
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM users").WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "DELETE FROM users", []any{}, nil)
+	require.True(t, errors.As(err, &terr))
+	require.Equal(t, dialect.OpExec, terr.Op)
+	require.NoError(t, tx.Rollback())
+
+	// Statements that are executed with ExecContext and QueryContext.
+	mock.ExpectExec("DELETE FROM users").WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 1))
+	_, err = drv.ExecContext(ctx, "DELETE FROM users")
+	require.True(t, errors.As(err, &terr))
+	require.Equal(t, dialect.OpExec, terr.Op)
+	require.Equal(t, 10*time.Millisecond, terr.Timeout)
+	mock.ExpectQuery("SELECT name FROM users").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	qrows, err := drv.QueryContext(ctx, "SELECT name FROM users")
+	require.NoError(t, err)
+	names = nil
+	require.NoError(t, ScanSlice(qrows, &names))
+	require.Equal(t, []string{"a8m"}, names)
+	require.NoError(t, qrows.Close())
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM users").WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT name FROM users").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a8m"))
+	mock.ExpectCommit()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tx.(ExecQuerier).ExecContext(ctx, "DELETE FROM users")
+	require.True(t, errors.As(err, &terr))
+	require.Equal(t, dialect.OpExec, terr.Op)
+	qrows, err = tx.(ExecQuerier).QueryContext(ctx, "SELECT name FROM users")
+	require.NoError(t, err)
+	require.NoError(t, qrows.Close())
+	require.NoError(t, tx.Commit())
+
+	// Other errors are returned as is.
+	mock.ExpectExec("DELETE FROM users").WillReturnError(context.Canceled)
+	require.Equal(t, context.Canceled, drv.Exec(ctx, "DELETE FROM users", []any{}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -209,6 +209,24 @@ type (
 	Schema struct {
 		Interface
 	}
+
+	// View is the default implementation for the schema Interface of types that are
+	// backed by database views, rather than tables. The generated clients of views are
+	// read-only, and their definition is set using the entsql.View annotation:
+	//
+	//	type T struct {
+	//		ent.View
+	//	}
+	//
+	//	func (T) Annotations() []schema.Annotation {
+	//		return []schema.Annotation{
+	//			entsql.View("SELECT id, name FROM users WHERE active"),
+	//		}
+	//	}
+	//
+	View struct {
+		Schema
+	}
 )
 
 // Fields of the schema.
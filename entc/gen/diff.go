@@ -0,0 +1,228 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/schema/field"
+)
+
+// SchemaChange describes a change of a type, a field or an edge
+// between two versions of a graph.
+type SchemaChange struct {
+	// Type is the name of the changed type.
+	Type string
+	// Field or Edge is set if the change is on a field or on an edge of the type.
+	Field, Edge string
+	// Desc describes the change.
+	Desc string
+	// Breaking indicates if the change may break existing clients or data.
+	// For example, removed fields, narrowed types or new required fields.
+	Breaking bool
+}
+
+// String implements the fmt.Stringer interface.
+func (c SchemaChange) String() string {
+	var b strings.Builder
+	if c.Breaking {
+		b.WriteString("BREAKING: ")
+	}
+	b.WriteString(c.Type)
+	switch {
+	case c.Field != "":
+		b.WriteString(".field." + c.Field)
+	case c.Edge != "":
+		b.WriteString(".edge." + c.Edge)
+	}
+	b.WriteString(": " + c.Desc)
+	return b.String()
+}
+
+// DiffGraphs returns the changes between two versions of a graph. For example,
+// the schema package in the main branch and the one in the working tree.
+//
+//	changes := gen.DiffGraphs(base, graph)
+//	for _, c := range changes {
+//		if c.Breaking {
+//			log.Fatalln(c)
+//		}
+//	}
+func DiffGraphs(from, to *Graph) []*SchemaChange {
+	var changes []*SchemaChange
+	for _, n := range from.Nodes {
+		if _, ok := to.typ(n.Name); !ok {
+			changes = append(changes, &SchemaChange{Type: n.Name, Desc: "type was removed", Breaking: true})
+		}
+	}
+	for _, n := range to.Nodes {
+		prev, ok := from.typ(n.Name)
+		if !ok {
+			changes = append(changes, &SchemaChange{Type: n.Name, Desc: "type was added"})
+			continue
+		}
+		changes = append(changes, diffType(prev, n)...)
+	}
+	return changes
+}
+
+// diffType returns the changes between two versions of a type.
+func diffType(from, to *Type) []*SchemaChange {
+	var changes []*SchemaChange
+	add := func(c *SchemaChange) {
+		c.Type = to.Name
+		changes = append(changes, c)
+	}
+	if from.HasOneFieldID() && to.HasOneFieldID() {
+		for _, c := range diffField(from.ID, to.ID) {
+			c.Field = to.ID.Name
+			add(c)
+		}
+	}
+	fromFields, toFields := make(map[string]*Field), make(map[string]*Field)
+	for _, f := range from.Fields {
+		fromFields[f.Name] = f
+	}
+	for _, f := range to.Fields {
+		toFields[f.Name] = f
+	}
+	for _, f := range from.Fields {
+		if _, ok := toFields[f.Name]; !ok {
+			add(&SchemaChange{Field: f.Name, Desc: "field was removed", Breaking: true})
+		}
+	}
+	for _, f := range to.Fields {
+		prev, ok := fromFields[f.Name]
+		switch {
+		case !ok && !f.Optional && !f.Default:
+			add(&SchemaChange{Field: f.Name, Desc: "required field was added", Breaking: true})
+		case !ok:
+			add(&SchemaChange{Field: f.Name, Desc: "field was added"})
+		default:
+			for _, c := range diffField(prev, f) {
+				c.Field = f.Name
+				add(c)
+			}
+		}
+	}
+	fromEdges, toEdges := make(map[string]*Edge), make(map[string]*Edge)
+	for _, e := range from.Edges {
+		fromEdges[e.Name] = e
+	}
+	for _, e := range to.Edges {
+		toEdges[e.Name] = e
+	}
+	for _, e := range from.Edges {
+		if _, ok := toEdges[e.Name]; !ok {
+			add(&SchemaChange{Edge: e.Name, Desc: "edge was removed", Breaking: true})
+		}
+	}
+	for _, e := range to.Edges {
+		prev, ok := fromEdges[e.Name]
+		switch {
+		case !ok && !e.Optional:
+			add(&SchemaChange{Edge: e.Name, Desc: "required edge was added", Breaking: true})
+		case !ok:
+			add(&SchemaChange{Edge: e.Name, Desc: "edge was added"})
+		default:
+			for _, c := range diffEdge(prev, e) {
+				c.Edge = e.Name
+				add(c)
+			}
+		}
+	}
+	return changes
+}
+
+// diffField returns the changes between two versions of a field.
+func diffField(from, to *Field) []*SchemaChange {
+	var changes []*SchemaChange
+	add := func(breaking bool, format string, args ...any) {
+		changes = append(changes, &SchemaChange{Desc: fmt.Sprintf(format, args...), Breaking: breaking})
+	}
+	if ft, tt := from.Type.String(), to.Type.String(); ft != tt {
+		add(!widened(from.Type.Type, to.Type.Type), "type was changed from %s to %s", ft, tt)
+	}
+	if fs, ts := from.size(), to.size(); ts > 0 && (fs == 0 || ts < fs) {
+		add(true, "size was narrowed to %d", ts)
+	}
+	if from.IsEnum() && to.IsEnum() {
+		values := make(map[string]bool)
+		for _, v := range to.EnumValues() {
+			values[v] = true
+		}
+		var removed []string
+		for _, v := range from.EnumValues() {
+			if !values[v] {
+				removed = append(removed, v)
+			}
+		}
+		if len(removed) > 0 {
+			add(true, "enum values were removed: %s", strings.Join(removed, ", "))
+		}
+		if len(to.EnumValues()) > len(from.EnumValues())-len(removed) {
+			add(false, "enum values were added")
+		}
+	}
+	switch {
+	case from.Optional && !to.Optional && !to.Default:
+		add(true, "field became required")
+	case !from.Optional && to.Optional:
+		add(false, "field became optional")
+	}
+	switch {
+	case from.Nillable && !to.Nillable:
+		add(true, "field is no longer nillable")
+	case !from.Nillable && to.Nillable:
+		add(true, "field became nillable")
+	}
+	if !from.Unique && to.Unique {
+		add(true, "field became unique")
+	}
+	if !from.Immutable && to.Immutable {
+		add(true, "field became immutable")
+	}
+	return changes
+}
+
+// diffEdge returns the changes between two versions of an edge.
+func diffEdge(from, to *Edge) []*SchemaChange {
+	var changes []*SchemaChange
+	add := func(breaking bool, format string, args ...any) {
+		changes = append(changes, &SchemaChange{Desc: fmt.Sprintf(format, args...), Breaking: breaking})
+	}
+	if from.Type.Name != to.Type.Name {
+		add(true, "edge type was changed from %s to %s", from.Type.Name, to.Type.Name)
+	}
+	if from.Rel.Type != to.Rel.Type {
+		add(true, "relation was changed from %s to %s", from.Rel.Type, to.Rel.Type)
+	}
+	if from.Optional && !to.Optional {
+		add(true, "edge became required")
+	}
+	if !from.Immutable && to.Immutable {
+		add(true, "edge became immutable")
+	}
+	return changes
+}
+
+// widened reports if changing a field type from one type to
+// another can hold all values of the previous type.
+func widened(from, to field.Type) bool {
+	ranks := [...]map[field.Type]int{
+		{field.TypeInt8: 1, field.TypeInt16: 2, field.TypeInt32: 3, field.TypeInt: 4, field.TypeInt64: 4},
+		{field.TypeUint8: 1, field.TypeUint16: 2, field.TypeUint32: 3, field.TypeUint: 4, field.TypeUint64: 4},
+		{field.TypeFloat32: 1, field.TypeFloat64: 2},
+	}
+	for _, r := range ranks {
+		fr, ok1 := r[from]
+		tr, ok2 := r[to]
+		if ok1 && ok2 {
+			return fr <= tr
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGraphs(t *testing.T) {
+	size := int64(100)
+	from, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt32}},
+				{Name: "score", Info: &field.TypeInfo{Type: field.TypeInt64}},
+				{Name: "nick", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+				{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{V: "admin"}, {V: "user"}}},
+				{Name: "address", Info: &field.TypeInfo{Type: field.TypeString}},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+			},
+		},
+		&load.Schema{Name: "Pet"},
+		&load.Schema{Name: "Group"},
+	)
+	require.NoError(t, err)
+	to, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Size: &size},
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt64}},
+				{Name: "score", Info: &field.TypeInfo{Type: field.TypeInt32}},
+				{Name: "nick", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{V: "admin"}, {V: "guest"}}},
+				{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "bio", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+				{Name: "groups", Type: "Group"},
+			},
+		},
+		&load.Schema{Name: "Pet"},
+		&load.Schema{Name: "Group"},
+		&load.Schema{Name: "Tag"},
+	)
+	require.NoError(t, err)
+
+	var changes []string
+	for _, c := range DiffGraphs(from, to) {
+		changes = append(changes, c.String())
+	}
+	require.Equal(t, []string{
+		"BREAKING: User.field.address: field was removed",
+		"BREAKING: User.field.name: size was narrowed to 100",
+		"User.field.age: type was changed from int32 to int64",
+		"BREAKING: User.field.score: type was changed from int64 to int32",
+		"BREAKING: User.field.nick: field became required",
+		"BREAKING: User.field.role: enum values were removed: user",
+		"User.field.role: enum values were added",
+		"BREAKING: User.field.email: required field was added",
+		"User.field.bio: field was added",
+		"User.edge.groups: edge was added",
+		"Tag: type was added",
+	}, changes)
+}
@@ -95,6 +95,14 @@ var (
 		Description: "Allows users to use row-level locking in SQL using the 'FOR {UPDATE|SHARE}' clauses",
 	}
 
+	// FeatureRecursive provides a feature-flag for traversing self-referential edges using recursive queries.
+	FeatureRecursive = Feature{
+		Name:        "sql/recursive",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to traverse self-referential edges (e.g. trees) recursively using the 'WITH RECURSIVE' clause",
+	}
+
 	// FeatureModifier provides a feature-flag for adding query modifiers.
 	FeatureModifier = Feature{
 		Name:        "sql/modifier",
@@ -119,6 +127,132 @@ var (
 		Description: "Allows users to configure the `ON CONFLICT`/`ON DUPLICATE KEY` clause for `INSERT` statements",
 	}
 
+	// FeatureExport provides a feature-flag for generating helpers for exporting and importing entities.
+	FeatureExport = Feature{
+		Name:        "export",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Export generates helpers for streaming entities to and from JSON and CSV",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "export.go"))
+		},
+	}
+
+	// FeatureFixture provides a feature-flag for generating a loader of YAML fixtures for tests.
+	FeatureFixture = Feature{
+		Name:        "fixture",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Fixture generates a loader for creating entities from YAML files that reference each other by symbolic names",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "fixture.go"))
+		},
+	}
+
+	// FeatureBench provides a feature-flag for generating benchmarks for the entity builders.
+	FeatureBench = Feature{
+		Name:        "bench",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Bench generates benchmarks for the Create, Query and Update paths of each entity, runnable with go test -bench",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "bench_test.go"))
+		},
+	}
+
+	// FeatureEntviz provides a feature-flag for generating an HTTP handler that visualizes the schema graph.
+	FeatureEntviz = Feature{
+		Name:        "entviz",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Entviz generates an HTTP handler that serves an interactive view of the schema graph and its row counts",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "entviz"))
+		},
+	}
+
+	// FeatureOutbox provides a feature-flag for writing the changes of mutations to an outbox table in the same
+	// transaction, and relaying them to external systems (the transactional outbox pattern).
+	FeatureOutbox = Feature{
+		Name:        "sql/outbox",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Outbox generates a hook for recording mutations in an outbox table within their transaction, and an API for relaying them",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "dialect/sql/outbox",
+				Format: "outbox.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "outbox.go"))
+		},
+	}
+
+	// FeatureLocalize provides a feature-flag for resolving the values of localized fields
+	// (see mixin.Localized) by the locale of the context.
+	FeatureLocalize = Feature{
+		Name:        "localize",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Localize generates helpers for resolving the values of localized fields by the locale of the context",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "locale.go"))
+		},
+	}
+
+	// FeatureQuickGen provides a feature-flag for generating random entities in property-based tests.
+	FeatureQuickGen = Feature{
+		Name:        "quickgen",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "QuickGen generates quick.Generator implementations that produce valid random entities for property-based tests",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "quickgen.go"))
+		},
+	}
+
+	// FeatureFeed provides a feature-flag for subscribing to the events of committed mutations (e.g. in GraphQL subscriptions).
+	FeatureFeed = Feature{
+		Name:        "feed",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Feed generates an API for subscribing to typed events of committed mutations, filtered by predicates",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "feed.go"))
+		},
+	}
+
+	// FeatureFilter provides a feature-flag for generating typed filter inputs, that allow
+	// API layers (e.g. REST and GraphQL) to expose filtering that is mapped to predicates.
+	FeatureFilter = Feature{
+		Name:        "filter",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Filter generates typed filter inputs with JSON tags that are mapped to the predicates of each type",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "filter.go"))
+		},
+	}
+
+	// FeatureAppendOnly provides a feature-flag for generating append-only clients, that are restricted to the Create
+	// and Query APIs. It is used for read-models that are stored in append-only databases, such as ClickHouse.
+	FeatureAppendOnly = Feature{
+		Name:        "sql/appendonly",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "AppendOnly restricts the generated clients to the Create and Query APIs, and skips the update and delete builders",
+	}
+
+	// FeatureExistsPredicates provides a feature-flag for generating the HasEdgeWith predicates
+	// as correlated EXISTS subqueries, instead of IN subqueries.
+	FeatureExistsPredicates = Feature{
+		Name:        "sql/exists",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Exists generates the HasEdgeWith predicates as correlated EXISTS subqueries instead of IN subqueries",
+	}
+
 	FeatureVersionedMigration = Feature{
 		Name:        "sql/versioned-migration",
 		Stage:       Experimental,
@@ -135,10 +269,22 @@ var (
 		FeatureSnapshot,
 		FeatureSchemaConfig,
 		FeatureLock,
+		FeatureRecursive,
 		FeatureModifier,
 		FeatureExecQuery,
 		FeatureUpsert,
 		FeatureVersionedMigration,
+		FeatureExport,
+		FeatureFixture,
+		FeatureBench,
+		FeatureEntviz,
+		FeatureOutbox,
+		FeatureLocalize,
+		FeatureQuickGen,
+		FeatureFeed,
+		FeatureFilter,
+		FeatureAppendOnly,
+		FeatureExistsPredicates,
 	}
 )
 
@@ -108,7 +108,8 @@ func quote(v any) any {
 func fieldOps(f *Field) (ops []Op) {
 	switch t := f.Type.Type; {
 	case f.HasGoType() && !f.ConvertedToBasic() && !f.Type.Valuer():
-	case t == field.TypeJSON:
+	// Encrypted values cannot be compared in the database.
+	case t == field.TypeJSON || f.Encrypted():
 	case t == field.TypeBool:
 		ops = boolOps
 	case t == field.TypeString && strings.ToLower(f.Name) != "id":
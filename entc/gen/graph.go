@@ -221,11 +221,16 @@ func generate(g *Graph) error {
 	var (
 		assets   assets
 		external []GraphTemplate
+		skipped  []string
 	)
 	templates, external = g.templates()
 	for _, n := range g.Nodes {
 		assets.addDir(filepath.Join(g.Config.Target, n.PackageDir()))
 		for _, tmpl := range Templates {
+			if tmpl.Skip != nil && tmpl.Skip(n) {
+				skipped = append(skipped, tmpl.Format(n))
+				continue
+			}
 			b := bytes.NewBuffer(nil)
 			if err := templates.ExecuteTemplate(b, tmpl.Name, n); err != nil {
 				return fmt.Errorf("execute template %q: %w", tmpl.Name, err)
@@ -262,7 +267,7 @@ func generate(g *Graph) error {
 	// Cleanup nodes' assets and old template
 	// files that are not needed anymore.
 	cleanOldNodes(assets, g.Config.Target)
-	for _, n := range deletedTemplates {
+	for _, n := range append(deletedTemplates, skipped...) {
 		if err := os.Remove(filepath.Join(g.Target, n)); err != nil && !os.IsNotExist(err) {
 			log.Printf("remove old file %s: %s\n", filepath.Join(g.Target, n), err)
 		}
@@ -277,12 +282,14 @@ func generate(g *Graph) error {
 func (g *Graph) addNode(schema *load.Schema) {
 	t, err := NewType(g.Config, schema)
 	check(err, "create type %s", schema.Name)
+	expect(!t.IsView() || g.Storage == nil || g.Storage.Name == "sql", "view %q is not supported by the %s storage", t.Name, g.Storage)
 	g.Nodes = append(g.Nodes, t)
 }
 
 // addIndexes adds the indexes for the schema type.
 func (g *Graph) addIndexes(schema *load.Schema) {
 	typ, _ := g.typ(schema.Name)
+	expect(!typ.IsView() || len(schema.Indexes) == 0, "view %q cannot contain indexes", schema.Name)
 	for _, idx := range schema.Indexes {
 		check(typ.AddIndex(idx), "invalid index for schema %q", schema.Name)
 	}
@@ -295,6 +302,7 @@ func (g *Graph) addEdges(schema *load.Schema) {
 	for _, e := range schema.Edges {
 		typ, ok := g.typ(e.Type)
 		expect(ok, "type %q does not exist for edge", e.Type)
+		expect(!t.IsView() && !typ.IsView(), "edge %s.%s: edges are not supported by views", schema.Name, e.Name)
 		_, ok = t.fields[e.Name]
 		expect(!ok, "%s schema cannot contain field and edge with the same name %q", schema.Name, e.Name)
 		_, ok = seen[e.Name]
@@ -611,13 +619,24 @@ func (g *Graph) edgeSchemas() error {
 // Tables returns the schema definitions of SQL tables for the graph.
 func (g *Graph) Tables() (all []*schema.Table, err error) {
 	tables := make(map[string]*schema.Table)
-	for _, n := range g.Nodes {
+	nodes := g.MutableNodes()
+	for _, n := range nodes {
 		table := schema.NewTable(n.Table()).
 			SetComment(n.sqlComment())
 		if n.HasOneFieldID() {
 			table.AddPrimary(n.ID.PK())
 		}
 		table.SetAnnotation(n.EntSQL())
+		if ant := n.EntSQL(); ant != nil && ant.Schema != "" {
+			table.SetSchema(ant.Schema)
+		}
+		if ant := n.EntSQL(); ant != nil {
+			for _, t := range ant.Triggers {
+				tr := schema.NewTrigger(t.Name).SetDefinition(t.Definition)
+				tr.Definitions = t.Definitions
+				table.AddTrigger(tr)
+			}
+		}
 		for _, f := range n.Fields {
 			if !f.IsEdgeField() {
 				table.AddColumn(f.Column())
@@ -626,7 +645,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 		tables[table.Name] = table
 		all = append(all, table)
 	}
-	for _, n := range g.Nodes {
+	for _, n := range nodes {
 		// Foreign key and its reference, or a join table.
 		for _, e := range n.Edges {
 			if e.IsInverse() {
@@ -644,11 +663,17 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 					column.Nullable = false
 				}
 				mayAddColumn(owner, column)
+				columns, refColumns, err := fkColumns(e, owner, ref, column)
+				if err != nil {
+					return nil, err
+				}
 				owner.AddForeignKey(&schema.ForeignKey{
 					RefTable:   ref,
+					OnUpdate:   updateAction(e),
 					OnDelete:   deleteAction(e, column),
-					Columns:    []*schema.Column{column},
-					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
+					Deferrable: deferrable(e),
+					Columns:    columns,
+					RefColumns: refColumns,
 					Symbol:     fkSymbol(e, owner, ref),
 				})
 			case M2O:
@@ -660,11 +685,17 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 					column.Nullable = false
 				}
 				mayAddColumn(owner, column)
+				columns, refColumns, err := fkColumns(e, owner, ref, column)
+				if err != nil {
+					return nil, err
+				}
 				owner.AddForeignKey(&schema.ForeignKey{
 					RefTable:   ref,
+					OnUpdate:   updateAction(e),
 					OnDelete:   deleteAction(e, column),
-					Columns:    []*schema.Column{column},
-					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
+					Deferrable: deferrable(e),
+					Columns:    columns,
+					RefColumns: refColumns,
 					Symbol:     fkSymbol(e, owner, ref),
 				})
 			case M2M:
@@ -686,6 +717,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 				s1, s2 := fkSymbols(e, c1, c2)
 				all = append(all, &schema.Table{
 					Name:       e.Rel.Table,
+					Schema:     t1.Schema,
 					Columns:    []*schema.Column{c1, c2},
 					PrimaryKey: []*schema.Column{c1, c2},
 					ForeignKeys: []*schema.ForeignKey{
@@ -714,7 +746,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 		}
 	}
 	// Append indexes to tables after all columns were added (including relation columns).
-	for _, n := range g.Nodes {
+	for _, n := range nodes {
 		table := tables[n.Table()]
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
@@ -723,12 +755,63 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 			index.Annotation = sqlIndexAnnotate(idx.Annotations)
 		}
 	}
+	ensureRefIndexes(all)
 	if err := ensureUniqueFKs(tables); err != nil {
 		return nil, err
 	}
+	if g.featureEnabled(FeatureOutbox) {
+		all = append(all, schema.NewOutboxTable())
+	}
 	return
 }
 
+// Views returns the schema views of the types that are backed by database views. Views without
+// a query (i.e. the entsql.View annotation) are managed outside of Ent, and they are skipped.
+func (g *Graph) Views() (views []*schema.View) {
+	for _, n := range g.Nodes {
+		ant := n.EntSQL()
+		if !n.IsView() || ant == nil || ant.ViewAs == "" && len(ant.ViewFor) == 0 {
+			continue
+		}
+		v := schema.NewView(n.Table()).SetSchema(ant.Schema)
+		v.Definition, v.Definitions, v.Materialized = ant.ViewAs, ant.ViewFor, ant.Materialized
+		views = append(views, v)
+	}
+	return views
+}
+
+// MutableNodes returns the nodes of the graph that are backed by tables,
+// and can be mutated. i.e. the nodes that are not backed by views.
+func (g *Graph) MutableNodes() []*Type {
+	nodes := make([]*Type, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		if !n.IsView() {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// HasSoftDelete reports if any of the graph nodes is soft-deleted.
+func (g *Graph) HasSoftDelete() bool {
+	for _, n := range g.Nodes {
+		if n.SoftDeleteField() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasVersion reports if any of the graph nodes is versioned.
+func (g *Graph) HasVersion() bool {
+	for _, n := range g.Nodes {
+		if n.VersionField() != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // mayAddColumn adds the given column if it does not already exist in the table.
 func mayAddColumn(t *schema.Table, c *schema.Column) {
 	if !t.HasColumn(c.Name) {
@@ -746,11 +829,78 @@ func fkColumn(e *Edge, owner *schema.Table, refPK *schema.Column) *schema.Column
 	// Foreign key was defined as an edge field.
 	if e.Rel.fk != nil && e.Rel.fk.Field != nil {
 		fc := e.Rel.fk.Field.Column()
-		column.Comment, column.Default = fc.Comment, fc.Default
+		column.Comment, column.Default, column.OldNames = fc.Comment, fc.Default, fc.OldNames
 	}
 	return column
 }
 
+// fkColumns returns the columns of the foreign-key constraint of the given edge and their referenced columns.
+// The additional columns of composite foreign keys (see entsql.ForeignKey) are prepended to the edge column.
+func fkColumns(e *Edge, owner, ref *schema.Table, column *schema.Column) ([]*schema.Column, []*schema.Column, error) {
+	ant := e.EntSQL()
+	if ant == nil || ant.ForeignKey == nil || len(ant.ForeignKey.Columns) == 0 {
+		return []*schema.Column{column}, []*schema.Column{ref.PrimaryKey[0]}, nil
+	}
+	names, refNames := ant.ForeignKey.Columns, ant.ForeignKey.RefColumns
+	if len(refNames) == 0 {
+		refNames = names
+	}
+	if len(names) != len(refNames) {
+		return nil, nil, fmt.Errorf("edge %q: foreign-key columns %q do not match the referenced columns %q", e.Name, names, refNames)
+	}
+	columns := make([]*schema.Column, 0, len(names)+1)
+	refColumns := make([]*schema.Column, 0, len(names)+1)
+	for i := range names {
+		c, ok := owner.Column(names[i])
+		if !ok {
+			return nil, nil, fmt.Errorf("edge %q: missing foreign-key column %q in table %q", e.Name, names[i], owner.Name)
+		}
+		r, ok := ref.Column(refNames[i])
+		if !ok {
+			return nil, nil, fmt.Errorf("edge %q: missing referenced column %q in table %q", e.Name, refNames[i], ref.Name)
+		}
+		columns, refColumns = append(columns, c), append(refColumns, r)
+	}
+	return append(columns, column), append(refColumns, ref.PrimaryKey[0]), nil
+}
+
+// ensureRefIndexes adds a unique index to the tables that are referenced by composite foreign keys,
+// in case their referenced columns are not covered by one, as it is required by the database.
+func ensureRefIndexes(tables []*schema.Table) {
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			if len(fk.RefColumns) < 2 || equalColumns(fk.RefTable.PrimaryKey, fk.RefColumns) {
+				continue
+			}
+			covered := false
+			for _, idx := range fk.RefTable.Indexes {
+				covered = covered || idx.Unique && equalColumns(idx.Columns, fk.RefColumns)
+			}
+			if covered {
+				continue
+			}
+			names := make([]string, len(fk.RefColumns))
+			for i, c := range fk.RefColumns {
+				names[i] = c.Name
+			}
+			fk.RefTable.AddIndex(fmt.Sprintf("%s_%s", fk.RefTable.Name, strings.Join(names, "_")), true, names)
+		}
+	}
+}
+
+// equalColumns reports if the given columns are equal (by name), in the same order.
+func equalColumns(c1, c2 []*schema.Column) bool {
+	if len(c1) != len(c2) {
+		return false
+	}
+	for i := range c1 {
+		if c1[i].Name != c2[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
 func addCompositePK(t *schema.Table, n *Type) error {
 	columns := make([]*schema.Column, 0, len(n.EdgeSchema.ID))
 	for _, id := range n.EdgeSchema.ID {
@@ -819,15 +969,33 @@ func ensureUniqueFKs(tables map[string]*schema.Table) error {
 // deleteAction returns the referential action for DELETE operations of the given edge.
 func deleteAction(e *Edge, c *schema.Column) schema.ReferenceOption {
 	action := schema.NoAction
-	if c.Nullable {
+	ant := e.EntSQL()
+	// Composite foreign keys are not set to NULL by default, as it sets all
+	// their columns to NULL, including the additional (usually required) ones.
+	if c.Nullable && (ant == nil || ant.ForeignKey == nil) {
 		action = schema.SetNull
 	}
-	if ant := e.EntSQL(); ant != nil && ant.OnDelete != "" {
+	if ant != nil && ant.OnDelete != "" {
 		action = schema.ReferenceOption(ant.OnDelete)
 	}
 	return action
 }
 
+// updateAction returns the referential action for UPDATE operations of the given edge.
+// If no action was defined, the clause is omitted, and the database default is used.
+func updateAction(e *Edge) schema.ReferenceOption {
+	if ant := e.EntSQL(); ant != nil {
+		return schema.ReferenceOption(ant.OnUpdate)
+	}
+	return ""
+}
+
+// deferrable reports if the foreign-key constraint of the given edge is deferrable.
+func deferrable(e *Edge) bool {
+	ant := e.EntSQL()
+	return ant != nil && ant.Deferrable
+}
+
 // SupportMigrate reports if the codegen supports schema migration.
 func (g *Graph) SupportMigrate() bool {
 	return g.Storage.SchemaMode.Support(Migrate)
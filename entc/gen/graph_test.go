@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -405,6 +406,91 @@ func TestEnsureCorrectFK(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestForeignKeyActions(t *testing.T) {
+	var (
+		user = &load.Schema{
+			Name: "User",
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet", Annotations: map[string]any{"EntSQL": map[string]any{"on_update": "CASCADE", "deferrable": true}}},
+				{Name: "cars", Type: "Car"},
+			},
+		}
+		pet = &load.Schema{Name: "Pet"}
+		car = &load.Schema{Name: "Car"}
+	)
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet, car)
+	require.NoError(t, err)
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	require.Equal(t, schema.Cascade, tables[1].ForeignKeys[0].OnUpdate)
+	require.Equal(t, schema.SetNull, tables[1].ForeignKeys[0].OnDelete)
+	require.True(t, tables[1].ForeignKeys[0].Deferrable)
+	// No action is defined by default.
+	require.Empty(t, tables[2].ForeignKeys[0].OnUpdate)
+	require.False(t, tables[2].ForeignKeys[0].Deferrable)
+}
+
+func TestGraph_Triggers(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Annotations: map[string]any{"EntSQL": map[string]any{
+			"triggers": []any{
+				map[string]any{"name": "users_audit", "definition": "CREATE TRIGGER users_audit AFTER INSERT ON users"},
+				map[string]any{"name": "users_updated_at", "definitions": map[string]string{"postgres": "CREATE TRIGGER users_updated_at BEFORE UPDATE ON users"}},
+			},
+		}},
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user)
+	require.NoError(t, err)
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	require.Equal(t, []*schema.Trigger{
+		{Name: "users_audit", Definition: "CREATE TRIGGER users_audit AFTER INSERT ON users"},
+		{Name: "users_updated_at", Definitions: map[string]string{"postgres": "CREATE TRIGGER users_updated_at BEFORE UPDATE ON users"}},
+	}, tables[0].Triggers)
+}
+
+func TestGraph_CompositeForeignKey(t *testing.T) {
+	tenant := &load.Field{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}}
+	user := &load.Schema{
+		Name:   "User",
+		Fields: []*load.Field{tenant},
+		Edges: []*load.Edge{
+			{Name: "pets", Type: "Pet", Annotations: map[string]any{"EntSQL": map[string]any{
+				"foreign_key": map[string]any{"columns": []string{"tenant_id"}},
+			}}},
+		},
+	}
+	pet := &load.Schema{
+		Name:   "Pet",
+		Fields: []*load.Field{tenant},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "pets", Unique: true, Inverse: true},
+		},
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.NoError(t, err)
+	tables, err := g.Tables()
+	require.NoError(t, err)
+	users, pets := tables[0], tables[1]
+	require.Len(t, pets.ForeignKeys, 1)
+	fk := pets.ForeignKeys[0]
+	require.Equal(t, []*schema.Column{pets.Columns[1], pets.Columns[2]}, fk.Columns)
+	require.Equal(t, []*schema.Column{users.Columns[1], users.Columns[0]}, fk.RefColumns)
+	require.Equal(t, schema.NoAction, fk.OnDelete, "composite foreign keys are not set to NULL")
+	// The referenced columns are covered by a unique index.
+	require.Len(t, users.Indexes, 1)
+	require.Equal(t, "users_tenant_id_id", users.Indexes[0].Name)
+	require.True(t, users.Indexes[0].Unique)
+	require.Equal(t, []*schema.Column{users.Columns[1], users.Columns[0]}, users.Indexes[0].Columns)
+
+	user.Edges[0].Annotations["EntSQL"] = map[string]any{"foreign_key": map[string]any{"columns": []string{"org_id"}}}
+	g, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, pet)
+	require.NoError(t, err)
+	_, err = g.Tables()
+	require.EqualError(t, err, `edge "pets": missing foreign-key column "org_id" in table "pets"`)
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(os.TempDir(), "ent")
@@ -445,12 +531,17 @@ func TestGraph_Gen(t *testing.T) {
 		require.NoError(err)
 	}
 	// Ensure entity files were generated.
-	for _, format := range []string{"%s", "%s_create", "%s_update", "%s_delete", "%s_query"} {
+	for _, format := range []string{"%s", "%s_create", "%s_query"} {
 		_, err := os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "t1"))
 		require.NoError(err)
 		_, err = os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "t2"))
 		require.NoError(err)
 	}
+	// Update and delete builders are skipped by the "sql/appendonly" feature.
+	for _, format := range []string{"%s_update", "%s_delete"} {
+		_, err := os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "t1"))
+		require.True(os.IsNotExist(err))
+	}
 	_, err = os.Stat(filepath.Join(target, "external.go"))
 	require.NoError(err)
 	_, err = os.Stat(filepath.Join(target, "skipped.go"))
@@ -461,6 +552,27 @@ func TestGraph_Gen(t *testing.T) {
 	require.NoError(err)
 	_, err = os.Stat(filepath.Join(target, "internal", "schemaconfig.go"))
 	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "export.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "fixture.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "bench_test.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "entviz", "entviz.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "outbox.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "locale.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "quickgen.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "feed.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "filter.go"))
+	require.NoError(err)
+	tables, err := graph.Tables()
+	require.NoError(err)
+	require.Equal(schema.OutboxTable, tables[len(tables)-1].Name)
 	// Rerun codegen with only one feature-flag.
 	graph.Features = []Feature{FeatureSnapshot}
 	require.NoError(graph.Gen())
@@ -469,6 +581,28 @@ func TestGraph_Gen(t *testing.T) {
 	require.NoError(err)
 	_, err = os.Stat(filepath.Join(target, "internal", "schemaconfig.go"))
 	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "export.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "fixture.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "bench_test.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "entviz"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "outbox.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "locale.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "quickgen.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "feed.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "filter.go"))
+	require.True(os.IsNotExist(err))
+	for _, format := range []string{"%s_update", "%s_delete"} {
+		_, err := os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "t1"))
+		require.NoError(err)
+	}
 	// Rerun codegen without any feature-flags.
 	graph.Features = nil
 	require.NoError(graph.Gen())
@@ -490,7 +624,7 @@ func TestGraph_Gen(t *testing.T) {
 	// Ensure entity files were generated.
 	for _, format := range []string{"%s", "%s_create", "%s_update", "%s_delete", "%s_query"} {
 		_, err := os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "t1"))
-		require.NoError(err)
+		require.Equal(format == "%s_update" || format == "%s_delete", os.IsNotExist(err))
 		_, err = os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "t2"))
 		require.Error(err)
 	}
@@ -578,3 +712,42 @@ func TestDependencyAnnotation_Build(t *testing.T) {
 		require.Equal(t, tt.field, d.Field)
 	}
 }
+
+func TestGraph_Views(t *testing.T) {
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	}
+	active := &load.Schema{
+		Name: "ActiveUser",
+		View: true,
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Annotations: map[string]any{"EntSQL": map[string]any{
+			"view_as":      "SELECT id, name FROM users WHERE active",
+			"view_for":     map[string]string{"postgres": `SELECT "id", "name" FROM "users" WHERE "active"`},
+			"materialized": true,
+		}},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, active)
+	require.NoError(t, err)
+	require.True(t, graph.Nodes[1].IsView())
+	require.Equal(t, []*Type{graph.Nodes[0]}, graph.MutableNodes())
+	tables, err := graph.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables, 1, "views are not migrated as tables")
+	require.Equal(t, "users", tables[0].Name)
+	views := graph.Views()
+	require.Len(t, views, 1)
+	require.Equal(t, "active_users", views[0].Name)
+	require.Equal(t, "SELECT id, name FROM users WHERE active", views[0].Definition)
+	require.Equal(t, map[string]string{"postgres": `SELECT "id", "name" FROM "users" WHERE "active"`}, views[0].Definitions)
+	require.True(t, views[0].Materialized)
+
+	user.Edges = []*load.Edge{{Name: "active", Type: "ActiveUser"}}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, active)
+	require.Error(t, err, "edges to views are not supported")
+}
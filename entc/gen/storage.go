@@ -61,7 +61,7 @@ var drivers = []*Storage{
 		SchemaMode: Unique | Indexes | Cascade | Migrate,
 		Ops: func(f *Field) []Op {
 			if f.IsString() && f.ConvertedToBasic() {
-				return []Op{EqualFold, ContainsFold}
+				return []Op{EqualFold, ContainsFold, HasPrefixFold}
 			}
 			return nil
 		},
@@ -29,6 +29,7 @@ type (
 	// each Type object of the graph.
 	TypeTemplate struct {
 		Name           string             // template name.
+		Skip           func(*Type) bool   // skip condition (gated by a feature-flag).
 		Format         func(*Type) string // file name format.
 		ExtendPatterns []string           // extend patterns.
 	}
@@ -47,6 +48,7 @@ var (
 	Templates = []TypeTemplate{
 		{
 			Name:   "create",
+			Skip:   readOnly,
 			Format: pkgf("%s_create.go"),
 			ExtendPatterns: []string{
 				"dialect/*/create/fields/additional/*",
@@ -55,10 +57,12 @@ var (
 		},
 		{
 			Name:   "update",
+			Skip:   func(t *Type) bool { return readOnly(t) || appendOnly(t) },
 			Format: pkgf("%s_update.go"),
 		},
 		{
 			Name:   "delete",
+			Skip:   func(t *Type) bool { return readOnly(t) || appendOnly(t) },
 			Format: pkgf("%s_delete.go"),
 		},
 		{
@@ -150,6 +154,62 @@ var (
 				return !g.featureEnabled(FeatureEntQL)
 			},
 		},
+		{
+			Name:   "export",
+			Format: "export.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureExport)
+			},
+		},
+		{
+			Name:   "fixture",
+			Format: "fixture.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureFixture)
+			},
+		},
+		{
+			Name:   "bench",
+			Format: "bench_test.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureBench)
+			},
+		},
+		{
+			Name:   "entviz",
+			Format: "entviz/entviz.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureEntviz)
+			},
+		},
+		{
+			Name:   "locale",
+			Format: "locale.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureLocalize)
+			},
+		},
+		{
+			Name:   "quickgen",
+			Format: "quickgen.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureQuickGen)
+			},
+		},
+		{
+			Name:   "feed",
+			Format: "feed.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureFeed)
+			},
+		},
+		{
+			Name:   "filter",
+			Format: "filter.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureFilter)
+			},
+		},
 		{
 			Name:   "runtime/ent",
 			Format: "runtime.go",
@@ -434,6 +494,16 @@ func pkgf(s string) func(t *Type) string {
 	return func(t *Type) string { return fmt.Sprintf(s, t.PackageDir()) }
 }
 
+// appendOnly reports if the update and delete builders should be skipped for the type.
+func appendOnly(t *Type) bool {
+	return t.featureEnabled(FeatureAppendOnly)
+}
+
+// readOnly reports if the create, update and delete builders should be skipped for the type.
+func readOnly(t *Type) bool {
+	return t.IsView()
+}
+
 // match reports if the given name matches the extended pattern.
 func match(patterns []string, name string) bool {
 	for _, pat := range patterns {
@@ -279,6 +279,12 @@ func (t Type) IsEdgeSchema() bool {
 	return t.EdgeSchema.To != nil || t.EdgeSchema.From != nil
 }
 
+// IsView indicates if the type (schema) is backed by a database view (i.e. embeds ent.View).
+// The generated clients of views are read-only, and they provide only the Query API.
+func (t Type) IsView() bool {
+	return t.schema != nil && t.schema.View
+}
+
 // HasCompositeID indicates if the type has a composite ID field.
 func (t Type) HasCompositeID() bool {
 	return t.IsEdgeSchema() && len(t.EdgeSchema.ID) > 1
@@ -310,6 +316,20 @@ func (t Type) EntSQL() *entsql.Annotation {
 	return sqlAnnotate(t.Annotations)
 }
 
+// FullTextIndex returns the entsql.IndexAnnotation of the full-text index
+// that is defined on the given field, or nil if there is no such index.
+func (t Type) FullTextIndex(f *Field) *entsql.IndexAnnotation {
+	for _, idx := range t.Indexes {
+		if len(idx.Columns) != 1 || idx.Columns[0] != f.StorageKey() {
+			continue
+		}
+		if ant := sqlIndexAnnotate(idx.Annotations); ant != nil && ant.FullText {
+			return ant
+		}
+	}
+	return nil
+}
+
 // Package returns the package name of this node.
 func (t Type) Package() string {
 	if name := t.PackageAlias(); name != "" {
@@ -416,6 +436,28 @@ func (t Type) HasNumeric() bool {
 	return false
 }
 
+// SoftDeleteField returns the soft-delete field of the type,
+// or nil if the type is not soft-deleted.
+func (t Type) SoftDeleteField() *Field {
+	for _, f := range t.Fields {
+		if f.SoftDelete() {
+			return f
+		}
+	}
+	return nil
+}
+
+// VersionField returns the version field of the type,
+// or nil if the type is not versioned.
+func (t Type) VersionField() *Field {
+	for _, f := range t.Fields {
+		if f.Version() {
+			return f
+		}
+	}
+	return nil
+}
+
 // HasUpdateCheckers reports if this type has any checkers to run on update(one).
 func (t Type) HasUpdateCheckers() bool {
 	for _, f := range t.Fields {
@@ -652,6 +694,8 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		return fmt.Errorf("entsql.Prefix is used in a multicolumn index %q. Use entsql.PrefixColumn instead", index.Name)
 	case len(ant.PrefixColumns) > len(idx.Fields)+len(idx.Fields):
 		return fmt.Errorf("index %q has more entsql.PrefixColumn than column in its definitions", index.Name)
+	case ant.FullText && (len(idx.Fields) != 1 || len(idx.Edges) != 0):
+		return fmt.Errorf("entsql.FullText is used in index %q that is not defined on a single field", index.Name)
 	}
 	for _, name := range idx.Fields {
 		var f *Field
@@ -660,6 +704,9 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		} else if f = t.fields[name]; f == nil {
 			return fmt.Errorf("unknown index field %q", name)
 		}
+		if ant := sqlIndexAnnotate(idx.Annotations); ant != nil && ant.FullText && f.Type.Type != field.TypeString {
+			return fmt.Errorf("entsql.FullText is used in index %q on non-string field %q", index.Name, name)
+		}
 		index.Columns = append(index.Columns, f.StorageKey())
 	}
 	for _, name := range idx.Edges {
@@ -877,6 +924,20 @@ func (t Type) UpdateOneReceiver() string {
 	return r
 }
 
+// UpdateManyName returns the struct name denoting the update-many-builder for this type.
+func (t Type) UpdateManyName() string {
+	return pascal(t.Name) + "UpdateMany"
+}
+
+// UpdateManyReceiver returns the receiver name of the update-many-builder for this type.
+func (t Type) UpdateManyReceiver() string {
+	r := receiver(t.UpdateManyName())
+	if t.Package() == r {
+		return "_" + r
+	}
+	return r
+}
+
 // DeleteName returns the struct name denoting the delete-builder for this type.
 func (t Type) DeleteName() string {
 	return pascal(t.Name) + "Delete"
@@ -1045,6 +1106,14 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		err = fmt.Errorf("field %q cannot have both default value and default expression annotations", f.Name)
 	case tf.HasValueScanner() && tf.IsJSON():
 		err = fmt.Errorf("json field %q cannot have an external ValueScanner", f.Name)
+	case tf.SoftDelete() && (f.Info.Type != field.TypeTime || !f.Optional || !f.Nillable):
+		err = fmt.Errorf("soft-delete field %q must be an optional and nillable time field", f.Name)
+	case tf.SoftDelete() && t.SoftDeleteField() != nil:
+		err = fmt.Errorf("type %q cannot have more than one soft-delete field", t.Name)
+	case tf.Version() && (!f.Info.Type.Integer() || f.Optional || f.Nillable):
+		err = fmt.Errorf("version field %q must be a required integer field", f.Name)
+	case tf.Version() && t.VersionField() != nil:
+		err = fmt.Errorf("type %q cannot have more than one version field", t.Name)
 	}
 	return err
 }
@@ -1375,6 +1444,33 @@ func (f Field) Edge() (*Edge, error) {
 // Sensitive returns true if the field is a sensitive field.
 func (f Field) Sensitive() bool { return f.def != nil && f.def.Sensitive }
 
+// Encrypted returns true if the field values are encrypted at rest.
+func (f Field) Encrypted() bool { return f.def != nil && f.def.Encrypted }
+
+// Localized returns true if the field is a localized string field that
+// holds its values keyed by locale. See mixin.Localized for more info.
+func (f Field) Localized() bool {
+	if !f.IsJSON() || f.Type.Ident != "map[string]string" {
+		return false
+	}
+	ant := fieldAnnotate(f.Annotations)
+	return ant != nil && ant.Localized
+}
+
+// SoftDelete returns true if the field is the soft-delete marker of
+// its type. See mixin.SoftDelete for more info.
+func (f Field) SoftDelete() bool {
+	ant := fieldAnnotate(f.Annotations)
+	return ant != nil && ant.SoftDelete
+}
+
+// Version returns true if the field is the version of its type, used
+// for optimistic concurrency control. See mixin.Version for more info.
+func (f Field) Version() bool {
+	ant := fieldAnnotate(f.Annotations)
+	return ant != nil && ant.Version
+}
+
 // Comment returns the comment of the field,
 func (f Field) Comment() string {
 	if f.def != nil {
@@ -1556,6 +1652,11 @@ func (f Field) Column() *schema.Column {
 			c.Default = s
 		}
 	}
+	// Encrypted values are stored as bytes, and their
+	// defaults are set by the generated code instead.
+	if f.Encrypted() {
+		c.Type, c.Size, c.Default = field.TypeBytes, 0, nil
+	}
 	// Override the default-value defined in the
 	// schema if it was provided by an annotation.
 	switch ant := f.EntSQL(); {
@@ -1576,6 +1677,17 @@ func (f Field) Column() *schema.Column {
 	if ant := f.EntSQL(); ant != nil && ant.Collation != "" {
 		c.Collation = ant.Collation
 	}
+	if ant := f.EntSQL(); ant != nil && ant.Charset != "" {
+		c.Charset = ant.Charset
+	}
+	if ant := f.EntSQL(); ant != nil {
+		c.OldNames = ant.OldNames
+		c.ConvertUsing = ant.ConvertUsing
+	}
+	// The prefix length of the unique index can be defined using the index annotation.
+	if ant := sqlIndexAnnotate(f.Annotations); ant != nil && f.Unique {
+		c.Prefix = ant.Prefix
+	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
 	}
@@ -1746,6 +1858,17 @@ func (f Field) SupportsMutationAppend() bool {
 	return f.IsJSON() && f.Type.RType != nil && f.Type.RType.Kind == reflect.Slice
 }
 
+// IsPostgresArray reports if the field is a JSON slice field that is stored in
+// a native array column in PostgreSQL. e.g. SchemaType(postgres: "text[]").
+func (f Field) IsPostgresArray() bool {
+	return f.SupportsMutationAppend() && f.def != nil && strings.HasSuffix(f.def.SchemaType[dialect.Postgres], "]")
+}
+
+// ArrayElemType returns the Go type of the array elements. e.g. "string" for []string.
+func (f Field) ArrayElemType() string {
+	return strings.TrimPrefix(f.Type.String(), "[]")
+}
+
 var (
 	nullBoolType    = reflect.TypeOf(sql.NullBool{})
 	nullBoolPType   = reflect.TypeOf((*sql.NullBool)(nil))
@@ -1853,7 +1976,7 @@ func (f Field) enums(lf *load.Field) ([]Enum, error) {
 // Ops returns all predicate operations of the field.
 func (f *Field) Ops() []Op {
 	ops := fieldOps(f)
-	if (f.Name != "id" || !f.HasGoType()) && f.cfg != nil && f.cfg.Storage.Ops != nil {
+	if (f.Name != "id" || !f.HasGoType()) && !f.Encrypted() && f.cfg != nil && f.cfg.Storage.Ops != nil {
 		ops = append(ops, f.cfg.Storage.Ops(f)...)
 	}
 	return ops
@@ -2249,6 +2372,12 @@ var (
 		"Mean",
 		"Min",
 		"Sum",
+		"ArrayAgg",
+		"BoolAnd",
+		"BoolOr",
+		"GroupConcat",
+		"StdDev",
+		"Variance",
 		"Policy",
 		"Query",
 		"Value",
@@ -5,8 +5,11 @@
 package gen
 
 import (
+	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
 
@@ -247,6 +250,17 @@ func TestType_AddIndex(t *testing.T) {
 
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name"}, Edges: []string{"owner"}})
 	require.NoError(t, err, "valid index on M2O relation and field")
+
+	fullText := map[string]any{"EntSQLIndexes": entsql.FullTextConfig("english")}
+	err = typ.AddIndex(&load.Index{Fields: []string{"name", "text"}, Annotations: fullText})
+	require.EqualError(t, err, `entsql.FullText is used in index "" that is not defined on a single field`)
+	err = typ.AddIndex(&load.Index{Fields: []string{"id"}, Annotations: fullText})
+	require.EqualError(t, err, `entsql.FullText is used in index "" on non-string field "id"`)
+	require.Nil(t, typ.FullTextIndex(typ.Fields[1]))
+	err = typ.AddIndex(&load.Index{Fields: []string{"text"}, Annotations: fullText})
+	require.NoError(t, err, "valid full-text index")
+	require.Nil(t, typ.FullTextIndex(typ.Fields[0]))
+	require.Equal(t, "english", typ.FullTextIndex(typ.Fields[1]).FullTextConfig)
 }
 
 func TestField_Constant(t *testing.T) {
@@ -296,6 +310,96 @@ func TestField_incremental(t *testing.T) {
 	}
 }
 
+func TestField_ColumnPrefix(t *testing.T) {
+	f := &Field{typ: &Type{}, Name: "description", Type: &field.TypeInfo{Type: field.TypeString}, Unique: true, Annotations: dict("EntSQLIndexes", dict("prefix", 191))}
+	require.Equal(t, uint(191), f.Column().Prefix)
+	f.Unique = false
+	require.Zero(t, f.Column().Prefix, "prefix is used only by the unique index of the column")
+}
+
+func TestField_IsPostgresArray(t *testing.T) {
+	slice := &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string", RType: &field.RType{Kind: reflect.Slice}}
+	f := &Field{Name: "tags", Type: slice, def: &load.Field{SchemaType: map[string]string{dialect.Postgres: "text[]"}}}
+	require.True(t, f.IsPostgresArray())
+	require.Equal(t, "string", f.ArrayElemType())
+	f = &Field{Name: "tags", Type: slice, def: &load.Field{}}
+	require.False(t, f.IsPostgresArray())
+	f = &Field{Name: "doc", Type: &field.TypeInfo{Type: field.TypeJSON, RType: &field.RType{Kind: reflect.Map}}, def: &load.Field{SchemaType: map[string]string{dialect.Postgres: "text[]"}}}
+	require.False(t, f.IsPostgresArray())
+}
+
+func TestType_SoftDeleteField(t *testing.T) {
+	ant := map[string]any{"Fields": map[string]any{"SoftDelete": true}}
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "deleted_at", Optional: true, Nillable: true, Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: ant},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, typ.SoftDeleteField())
+	require.Equal(t, "deleted_at", typ.SoftDeleteField().Name)
+	require.False(t, typ.Fields[0].SoftDelete())
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: ant},
+		},
+	})
+	require.EqualError(t, err, `soft-delete field "deleted_at" must be an optional and nillable time field`)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "deleted_at", Optional: true, Nillable: true, Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: ant},
+			{Name: "removed_at", Optional: true, Nillable: true, Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: ant},
+		},
+	})
+	require.EqualError(t, err, `type "T" cannot have more than one soft-delete field`)
+}
+
+func TestType_VersionField(t *testing.T) {
+	ant := map[string]any{"Fields": map[string]any{"Version": true}}
+	typ, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "version", Default: true, Immutable: true, Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: ant},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, typ.VersionField())
+	require.Equal(t, "version", typ.VersionField().Name)
+	require.False(t, typ.Fields[0].Version())
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "version", Optional: true, Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: ant},
+		},
+	})
+	require.EqualError(t, err, `version field "version" must be a required integer field`)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "version", Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: ant},
+		},
+	})
+	require.EqualError(t, err, `version field "version" must be a required integer field`)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "version", Default: true, Immutable: true, Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: ant},
+			{Name: "revision", Default: true, Immutable: true, Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: ant},
+		},
+	})
+	require.EqualError(t, err, `type "T" cannot have more than one version field`)
+}
+
 func TestBuilderField(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,612 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package importer generates Ent schemas from the structure of an existing database.
+// The database is inspected using the schema.Inspector, and a schema file is generated
+// for each table, including its fields, edges and indexes.
+package importer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+)
+
+type (
+	// Option allows configuring the import using functional options.
+	Option func(*config)
+
+	config struct {
+		schema string   // schema (named-database) to import.
+		tables []string // tables to import, all if empty.
+		pkg    string   // package name of the generated files.
+	}
+)
+
+// WithSchema sets the schema (named-database) to import, instead of the current schema of the connection.
+func WithSchema(name string) Option {
+	return func(c *config) {
+		c.schema = name
+	}
+}
+
+// WithTables limits the import to the given tables. Join tables of many-to-many relations are
+// imported as edges, in case the tables of both sides are imported.
+func WithTables(names ...string) Option {
+	return func(c *config) {
+		c.tables = append(c.tables, names...)
+	}
+}
+
+// WithPackage sets the package name of the generated files. Defaults to "schema".
+func WithPackage(name string) Option {
+	return func(c *config) {
+		c.pkg = name
+	}
+}
+
+// Import inspects the database of the given driver, and writes the generated schemas to the
+// given directory. Existing files are not overwritten, and an error is returned instead.
+//
+//	drv, err := sql.Open(dialect.Postgres, "postgres://localhost:5432/test")
+//	if err != nil {
+//		log.Fatalf("failed opening connection: %v", err)
+//	}
+//	if err := importer.Import(ctx, drv, "./ent/schema"); err != nil {
+//		log.Fatalf("failed importing schema: %v", err)
+//	}
+func Import(ctx context.Context, drv dialect.Driver, dir string, opts ...Option) error {
+	files, err := Schemas(ctx, drv, opts...)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return fmt.Errorf("importer: schema file %q already exists", name)
+		}
+		names = append(names, name)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("importer: create schema directory: %w", err)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0644); err != nil {
+			return fmt.Errorf("importer: write schema file %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Schemas inspects the database of the given driver, and returns the generated schema files keyed
+// by their names. Tables without a single-column primary key that are not join tables of many-to-many
+// relations are not supported, and skipped.
+func Schemas(ctx context.Context, drv dialect.Driver, opts ...Option) (map[string][]byte, error) {
+	cfg := &config{pkg: "schema"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	var iopts []schema.InspectOption
+	if cfg.schema != "" {
+		iopts = append(iopts, schema.WithSchema(cfg.schema))
+	}
+	i, err := schema.NewInspect(drv, iopts...)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := i.Tables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("importer: inspect tables: %w", err)
+	}
+	g := &graph{dialect: drv.Dialect(), nodes: make(map[string]*node)}
+	for _, t := range tables {
+		if cfg.include(t.Name) {
+			if err := g.addNode(t); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, t := range tables {
+		if cfg.include(t.Name) {
+			g.addJoin(t)
+		}
+	}
+	for _, n := range g.list {
+		if err := g.addFields(n); err != nil {
+			return nil, err
+		}
+	}
+	for _, n := range g.list {
+		g.addEdges(n)
+	}
+	for _, j := range g.joins {
+		g.addM2M(j)
+	}
+	for _, n := range g.list {
+		g.addIndexes(n)
+	}
+	files := make(map[string][]byte, len(g.list))
+	for _, n := range g.list {
+		b := &bytes.Buffer{}
+		if err := fileTemplate.Execute(b, struct {
+			*node
+			Package string
+		}{n, cfg.pkg}); err != nil {
+			return nil, fmt.Errorf("importer: execute template for %q: %w", n.Name, err)
+		}
+		src, err := format.Source(b.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("importer: format schema %q: %w", n.Name, err)
+		}
+		files[strings.ToLower(n.Name)+".go"] = src
+	}
+	return files, nil
+}
+
+// include reports if the given table should be imported.
+func (c *config) include(name string) bool {
+	if name == schema.TypeTable || name == schema.OutboxTable {
+		return false
+	}
+	if len(c.tables) == 0 {
+		return true
+	}
+	for _, t := range c.tables {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	// graph holds the nodes (schemas) that are generated from the inspected tables.
+	graph struct {
+		dialect string
+		list    []*node
+		nodes   map[string]*node // by table name.
+		joins   []*schema.Table  // join tables of M2M relations.
+	}
+
+	// node holds the information of a generated schema.
+	node struct {
+		Name        string
+		Table       string // table name, in case it is different from the default.
+		Fields      []string
+		Edges       []string
+		Indexes     []string
+		Imports     []string
+		t           *schema.Table
+		id          string            // builder of the id field (e.g. Int, String), used by edge fields.
+		names       map[string]bool   // names of fields and edges.
+		fields      map[string]string // field names by column names.
+		edgeColumns map[string]*schema.ForeignKey
+	}
+)
+
+// addNode adds the node of the given table to the graph, in case the table has a single-column primary key.
+func (g *graph) addNode(t *schema.Table) error {
+	if len(t.PrimaryKey) != 1 {
+		return nil
+	}
+	n := &node{
+		Name:        pascal(singular(t.Name)),
+		t:           t,
+		names:       make(map[string]bool),
+		fields:      make(map[string]string),
+		edgeColumns: make(map[string]*schema.ForeignKey),
+	}
+	if err := gen.ValidSchemaName(n.Name); err != nil {
+		return fmt.Errorf("importer: table %q: %w", t.Name, err)
+	}
+	for _, n2 := range g.list {
+		if n2.Name == n.Name {
+			return fmt.Errorf("importer: tables %q and %q are mapped to the same schema %q", n2.t.Name, t.Name, n.Name)
+		}
+	}
+	if snake(plural(n.Name)) != t.Name {
+		n.Table = t.Name
+	}
+	g.list = append(g.list, n)
+	g.nodes[t.Name] = n
+	return nil
+}
+
+// addJoin adds the given table as a join table, in case it only holds the foreign keys of an M2M relation.
+func (g *graph) addJoin(t *schema.Table) {
+	if _, ok := g.nodes[t.Name]; ok && len(t.PrimaryKey) == 1 || len(t.Columns) != 2 || len(t.ForeignKeys) != 2 {
+		return
+	}
+	for i, fk := range t.ForeignKeys {
+		if !g.references(fk) || fk.Columns[0].Name != t.Columns[i].Name && fk.Columns[0].Name != t.Columns[1-i].Name {
+			return
+		}
+	}
+	if t.ForeignKeys[0].Columns[0].Name == t.ForeignKeys[1].Columns[0].Name {
+		return
+	}
+	g.joins = append(g.joins, t)
+}
+
+// references reports if the given foreign key references the primary key of an imported node.
+func (g *graph) references(fk *schema.ForeignKey) bool {
+	if len(fk.Columns) != 1 || len(fk.RefColumns) != 1 || fk.RefTable == nil {
+		return false
+	}
+	ref, ok := g.nodes[fk.RefTable.Name]
+	return ok && ref.t.PrimaryKey[0].Name == fk.RefColumns[0].Name
+}
+
+// addFields adds the fields of the node, including the id field and the edge fields.
+func (g *graph) addFields(n *node) error {
+	for _, fk := range n.t.ForeignKeys {
+		if g.references(fk) {
+			n.edgeColumns[fk.Columns[0].Name] = fk
+		}
+	}
+	pk := n.t.PrimaryKey[0]
+	n.id = builder(pk)
+	n.names["id"] = true
+	n.fields[pk.Name] = "id"
+	// The default id field is an auto-incremented bigint.
+	if pk.Name != "id" || n.id != "Int" && n.id != "Int64" {
+		f, err := g.field(n, "id", pk)
+		if err != nil {
+			return err
+		}
+		if pk.Name != "id" {
+			f += fmt.Sprintf(".\nStorageKey(%q)", pk.Name)
+		}
+		n.Fields = append(n.Fields, f)
+	} else {
+		n.id = "Int"
+	}
+	for _, c := range n.t.Columns {
+		if c == pk || c.Name == pk.Name {
+			continue
+		}
+		name := snake(c.Name)
+		if n.names[name] {
+			return fmt.Errorf("importer: table %q: column %q conflicts with another column", n.t.Name, c.Name)
+		}
+		f, err := g.field(n, name, c)
+		if err != nil {
+			return err
+		}
+		if name != c.Name {
+			f += fmt.Sprintf(".\nStorageKey(%q)", c.Name)
+		}
+		n.names[name] = true
+		n.fields[c.Name] = name
+		n.Fields = append(n.Fields, f)
+	}
+	return nil
+}
+
+// field returns the field definition of the given column.
+func (g *graph) field(n *node, name string, c *schema.Column) (string, error) {
+	b := builder(c)
+	// Edge fields must have the same type as the id field of the referenced node.
+	if fk, ok := n.edgeColumns[c.Name]; ok {
+		b = g.nodes[fk.RefTable.Name].id
+	}
+	var f string
+	switch b {
+	case "UUID":
+		n.use("github.com/google/uuid")
+		f = fmt.Sprintf("field.UUID(%q, uuid.UUID{})", name)
+	case "JSON":
+		n.use("encoding/json")
+		f = fmt.Sprintf("field.JSON(%q, json.RawMessage{})", name)
+	case "Enum":
+		if len(c.Enums) == 0 {
+			return "", fmt.Errorf("importer: table %q: missing values for enum column %q", n.t.Name, c.Name)
+		}
+		values := make([]string, len(c.Enums))
+		for i := range c.Enums {
+			values[i] = strconv.Quote(c.Enums[i])
+		}
+		f = fmt.Sprintf("field.Enum(%q).\nValues(%s)", name, strings.Join(values, ", "))
+	case "String":
+		f = fmt.Sprintf("field.String(%q)", name)
+		if c.Size > 0 && c.Size != schema.DefaultStringLen {
+			f += fmt.Sprintf(".\nMaxLen(%d)", c.Size)
+		}
+	default:
+		f = fmt.Sprintf("field.%s(%q)", b, name)
+	}
+	if t := c.SchemaType[g.dialect]; t != "" {
+		n.use("entgo.io/ent/dialect")
+		f += fmt.Sprintf(".\nSchemaType(map[string]string{\n%s: %q,\n})", dialectConst(g.dialect), t)
+	}
+	if c.Unique && name != "id" {
+		f += ".\nUnique()"
+	}
+	if c.Nullable {
+		f += ".\nOptional()"
+	}
+	// Only literal defaults of numeric and boolean columns are imported,
+	// as string defaults are returned differently by each database.
+	switch v := c.Default.(type) {
+	case bool:
+		f += fmt.Sprintf(".\nDefault(%t)", v)
+	case int64, uint64, float64:
+		if _, ok := n.edgeColumns[c.Name]; !ok {
+			f += fmt.Sprintf(".\nDefault(%v)", v)
+		}
+	}
+	n.use("entgo.io/ent/schema/field")
+	return f, nil
+}
+
+// addEdges adds the edges of the foreign keys that are defined on the node table.
+// The edge is defined as an inverse edge with an edge field on the node, and as
+// an association edge on the referenced node.
+func (g *graph) addEdges(n *node) {
+	for _, fk := range n.t.ForeignKeys {
+		c := fk.Columns[0]
+		if n.edgeColumns[c.Name] != fk {
+			continue
+		}
+		ref := g.nodes[fk.RefTable.Name]
+		from := strings.TrimSuffix(c.Name, "_id")
+		if from == c.Name || from == "" {
+			from = snake(ref.Name)
+		}
+		from = n.name(from, from+"_"+snake(c.Name))
+		to := snake(plural(n.Name))
+		if c.Unique {
+			to = snake(n.Name)
+		}
+		to = ref.name(to, to+"_"+from)
+		assoc := fmt.Sprintf("edge.To(%q, %s.Type)", to, n.Name)
+		if c.Unique {
+			assoc += ".\nUnique()"
+		}
+		if fk.Symbol != "" {
+			assoc += fmt.Sprintf(".\nStorageKey(edge.Symbol(%q))", fk.Symbol)
+		}
+		inverse := fmt.Sprintf("edge.From(%q, %s.Type).\nRef(%q).\nField(%q).\nUnique()", from, ref.Name, to, n.fields[c.Name])
+		if !c.Nullable {
+			inverse += ".\nRequired()"
+		}
+		ref.Edges = append(ref.Edges, assoc)
+		ref.use("entgo.io/ent/schema/edge")
+		n.Edges = append(n.Edges, inverse)
+		n.use("entgo.io/ent/schema/edge")
+	}
+}
+
+// addM2M adds the edges of the M2M relation that is stored in the given join table.
+func (g *graph) addM2M(t *schema.Table) {
+	fk1, fk2 := t.ForeignKeys[0], t.ForeignKeys[1]
+	// The first column of the table is the owner of the relation.
+	if fk1.Columns[0].Name != t.Columns[0].Name {
+		fk1, fk2 = fk2, fk1
+	}
+	n1, n2 := g.nodes[fk1.RefTable.Name], g.nodes[fk2.RefTable.Name]
+	to := strings.TrimPrefix(t.Name, snake(n1.Name)+"_")
+	if to == t.Name || to == "" {
+		to = snake(plural(n2.Name))
+	}
+	to = n1.name(to, t.Name)
+	key := fmt.Sprintf("edge.Table(%q), edge.Columns(%q, %q)", t.Name, fk1.Columns[0].Name, fk2.Columns[0].Name)
+	if fk1.Symbol != "" && fk2.Symbol != "" {
+		key += fmt.Sprintf(", edge.Symbols(%q, %q)", fk1.Symbol, fk2.Symbol)
+	}
+	n1.Edges = append(n1.Edges, fmt.Sprintf("edge.To(%q, %s.Type).\nStorageKey(%s)", to, n2.Name, key))
+	n1.use("entgo.io/ent/schema/edge")
+	// Relations of the same type are bidirectional.
+	if n1 == n2 {
+		return
+	}
+	from := n2.name(snake(plural(n1.Name)), snake(plural(n1.Name))+"_"+to)
+	n2.Edges = append(n2.Edges, fmt.Sprintf("edge.From(%q, %s.Type).\nRef(%q)", from, n1.Name, to))
+	n2.use("entgo.io/ent/schema/edge")
+}
+
+// addIndexes adds the indexes of the node, except the ones that are defined by the fields.
+func (g *graph) addIndexes(n *node) {
+	for _, idx := range n.t.Indexes {
+		if len(idx.Columns) == 0 || len(idx.Columns) == 1 && (idx.Unique && idx.Columns[0].Unique || idx.Columns[0].PrimaryKey()) {
+			continue
+		}
+		// Indexes that were created implicitly for foreign keys (MySQL).
+		if fk, ok := n.edgeColumns[idx.Columns[0].Name]; ok && len(idx.Columns) == 1 && !idx.Unique && fk.Symbol == idx.Name {
+			continue
+		}
+		fields := make([]string, len(idx.Columns))
+		for i, c := range idx.Columns {
+			fields[i] = strconv.Quote(n.fields[c.Name])
+		}
+		i := fmt.Sprintf("index.Fields(%s)", strings.Join(fields, ", "))
+		if idx.Unique {
+			i += ".\nUnique()"
+		}
+		if idx.Name != "" {
+			i += fmt.Sprintf(".\nStorageKey(%q)", idx.Name)
+		}
+		n.Indexes = append(n.Indexes, i)
+		n.use("entgo.io/ent/schema/index")
+	}
+}
+
+// name returns a unique name for a field or an edge of the node. The alternative
+// name is used in case the name is taken, and then, a numeric suffix is added.
+func (n *node) name(name, alt string) string {
+	if !n.names[name] {
+		n.names[name] = true
+		return name
+	}
+	name = alt
+	for i := 2; n.names[name]; i++ {
+		name = fmt.Sprintf("%s_%d", alt, i)
+	}
+	n.names[name] = true
+	return name
+}
+
+// use adds the given package to the imports of the node.
+func (n *node) use(pkg string) {
+	for _, p := range n.Imports {
+		if p == pkg {
+			return
+		}
+	}
+	n.Imports = append(n.Imports, pkg)
+	sort.Strings(n.Imports)
+}
+
+// builder returns the name of the field builder of the given column.
+func builder(c *schema.Column) string {
+	switch c.Type {
+	case field.TypeBool:
+		return "Bool"
+	case field.TypeTime:
+		return "Time"
+	case field.TypeJSON:
+		return "JSON"
+	case field.TypeUUID:
+		return "UUID"
+	case field.TypeBytes:
+		return "Bytes"
+	case field.TypeEnum:
+		return "Enum"
+	case field.TypeString:
+		if c.Size <= 0 || c.Size >= 1<<16 {
+			return "Text"
+		}
+		return "String"
+	case field.TypeInt8:
+		return "Int8"
+	case field.TypeInt16:
+		return "Int16"
+	case field.TypeInt32:
+		return "Int32"
+	case field.TypeInt:
+		return "Int"
+	case field.TypeInt64:
+		return "Int64"
+	case field.TypeUint8:
+		return "Uint8"
+	case field.TypeUint16:
+		return "Uint16"
+	case field.TypeUint32:
+		return "Uint32"
+	case field.TypeUint:
+		return "Uint"
+	case field.TypeUint64:
+		return "Uint64"
+	case field.TypeFloat32:
+		return "Float32"
+	case field.TypeFloat64:
+		return "Float64"
+	default:
+		// Types that are not supported by the inspection (e.g. TypeOther)
+		// are imported as strings, with their database type.
+		return "String"
+	}
+}
+
+// dialectConst returns the name of the dialect constant.
+func dialectConst(name string) string {
+	switch name {
+	case dialect.MySQL:
+		return "dialect.MySQL"
+	case dialect.Postgres:
+		return "dialect.Postgres"
+	default:
+		return "dialect.SQLite"
+	}
+}
+
+// Naming functions that are shared with the code generation.
+var (
+	pascal   = gen.Funcs["pascal"].(func(string) string)
+	snake    = gen.Funcs["snake"].(func(string) string)
+	plural   = gen.Funcs["plural"].(func(string) string)
+	singular = gen.Funcs["singular"].(func(string) string)
+)
+
+var fileTemplate = template.Must(template.New("schema").Parse(`package {{ $.Package }}
+
+import (
+	{{- range $.Imports }}
+		{{- if not (eq . "github.com/google/uuid") }}
+			"{{ . }}"
+		{{- end }}
+	{{- end }}
+	"entgo.io/ent"
+	{{- with $.Table }}
+		"entgo.io/ent/dialect/entsql"
+		"entgo.io/ent/schema"
+	{{- end }}
+	{{- range $.Imports }}
+		{{- if eq . "github.com/google/uuid" }}
+
+			"{{ . }}"
+		{{- end }}
+	{{- end }}
+)
+
+// {{ $.Name }} holds the schema definition for the {{ $.Name }} entity.
+type {{ $.Name }} struct {
+	ent.Schema
+}
+{{ with $.Table }}
+// Annotations of the {{ $.Name }}.
+func ({{ $.Name }}) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "{{ . }}"},
+	}
+}
+{{ end }}
+// Fields of the {{ $.Name }}.
+func ({{ $.Name }}) Fields() []ent.Field {
+	{{- if $.Fields }}
+		return []ent.Field{
+			{{- range $.Fields }}
+				{{ . }},
+			{{- end }}
+		}
+	{{- else }}
+		return nil
+	{{- end }}
+}
+
+// Edges of the {{ $.Name }}.
+func ({{ $.Name }}) Edges() []ent.Edge {
+	{{- if $.Edges }}
+		return []ent.Edge{
+			{{- range $.Edges }}
+				{{ . }},
+			{{- end }}
+		}
+	{{- else }}
+		return nil
+	{{- end }}
+}
+{{ with $.Indexes }}
+// Indexes of the {{ $.Name }}.
+func ({{ $.Name }}) Indexes() []ent.Index {
+	return []ent.Index{
+		{{- range . }}
+			{{ . }},
+		{{- end }}
+	}
+}
+{{ end }}`))
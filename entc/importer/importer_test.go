@@ -0,0 +1,137 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package importer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemas(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:importer?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	for _, stmt := range []string{
+		"CREATE TABLE `users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` varchar(255) NOT NULL, `nickname` varchar(100) NULL, `age` integer NOT NULL DEFAULT 18, `active` bool NOT NULL DEFAULT true)",
+		"CREATE UNIQUE INDEX `users_nickname` ON `users` (`nickname`)",
+		"CREATE TABLE `groups` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL)",
+		"CREATE TABLE `pets` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` varchar(255) NOT NULL, `owner_id` integer NULL, FOREIGN KEY(`owner_id`) REFERENCES `users`(`id`) ON DELETE SET NULL)",
+		"CREATE UNIQUE INDEX `pet_name_owner_id` ON `pets` (`name`, `owner_id`)",
+		"CREATE TABLE `user_groups` (`user_id` integer NOT NULL, `group_id` integer NOT NULL, PRIMARY KEY (`user_id`, `group_id`), FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE, FOREIGN KEY(`group_id`) REFERENCES `groups`(`id`) ON DELETE CASCADE)",
+		"CREATE TABLE `cards` (`number` varchar(20) NOT NULL PRIMARY KEY, `owner_id` integer NOT NULL REFERENCES `users`(`id`))",
+		"CREATE TABLE `logs` (`message` text NULL)",
+	} {
+		require.NoError(t, db.Exec(ctx, stmt, []any{}, nil))
+	}
+
+	files, err := Schemas(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, files, 4, "tables without a primary key and join tables are not imported as schemas")
+	require.Equal(t, `package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Pet holds the schema definition for the Pet entity.
+type Pet struct {
+	ent.Schema
+}
+
+// Fields of the Pet.
+func (Pet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("owner_id").
+			Optional(),
+	}
+}
+
+// Edges of the Pet.
+func (Pet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("pets").
+			Field("owner_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Pet.
+func (Pet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "owner_id").
+			Unique().
+			StorageKey("pet_name_owner_id"),
+	}
+}
+`, string(files["pet.go"]))
+
+	user := string(files["user.go"])
+	require.Contains(t, user, `field.String("nickname").
+			MaxLen(100).
+			Unique().
+			Optional(),`)
+	require.Contains(t, user, `field.Int("age").
+			Default(18),`)
+	require.Contains(t, user, `field.Bool("active").
+			Default(true),`)
+	require.Contains(t, user, `edge.To("pets", Pet.Type),`)
+	require.Contains(t, user, `edge.To("groups", Group.Type).
+			StorageKey(edge.Table("user_groups"), edge.Columns("user_id", "group_id")),`)
+	require.NotContains(t, user, `field.Int("id")`, "default id field should not be generated")
+
+	group := string(files["group.go"])
+	require.Contains(t, group, `field.Text("name"),`)
+	require.Contains(t, group, `edge.From("users", User.Type).
+			Ref("groups"),`)
+
+	card := string(files["card.go"])
+	require.Contains(t, card, `field.String("id").
+			MaxLen(20).
+			StorageKey("number"),`)
+	require.Contains(t, card, `edge.From("owner", User.Type).
+			Ref("cards").
+			Field("owner_id").
+			Unique().
+			Required(),`)
+
+	files, err = Schemas(ctx, db, WithTables("users", "pets"), WithPackage("entschema"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Contains(t, string(files["pet.go"]), "package entschema")
+	require.NotContains(t, string(files["user.go"]), "groups", "edges to tables that are not imported should be skipped")
+}
+
+func TestImport(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, "file:import?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	require.NoError(t, db.Exec(ctx, "CREATE TABLE `accounts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `email` varchar(255) NOT NULL UNIQUE)", []any{}, nil))
+
+	dir := filepath.Join(t.TempDir(), "schema")
+	require.NoError(t, Import(ctx, db, dir))
+	b, err := os.ReadFile(filepath.Join(dir, "account.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(b), "type Account struct")
+	require.Contains(t, string(b), `field.String("email").
+			Unique(),`)
+
+	err = Import(ctx, db, dir)
+	require.EqualError(t, err, `importer: schema file "account.go" already exists`)
+}
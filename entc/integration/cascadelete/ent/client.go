@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/cascadelete/ent/migrate"
@@ -138,14 +139,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}, nil
 }
 
-// BeginTx returns a transactional client with specified options.
+// BeginTx returns a transactional client with specified options. For example,
+// starting a read-only transaction with the REPEATABLE READ isolation level:
+//
+//	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(interface {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = c.driver.Tx(ctx)
+	} else {
+		err = errors.New("driver does not support transaction options")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
@@ -245,6 +259,21 @@ func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBul
 	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Comment.
 func (c *CommentClient) Update() *CommentUpdate {
 	mutation := newCommentMutation(c.config, OpUpdate)
@@ -263,6 +292,17 @@ func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
 	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *CommentClient) UpdateMany(ids []int, setFunc func(*CommentUpdateOne)) *CommentUpdateMany {
+	builders := make([]*CommentUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &CommentUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Comment.
 func (c *CommentClient) Delete() *CommentDelete {
 	mutation := newCommentMutation(c.config, OpDelete)
@@ -379,6 +419,21 @@ func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
 	return &PostCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Post.
 func (c *PostClient) Update() *PostUpdate {
 	mutation := newPostMutation(c.config, OpUpdate)
@@ -397,6 +452,17 @@ func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
 	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *PostClient) UpdateMany(ids []int, setFunc func(*PostUpdateOne)) *PostUpdateMany {
+	builders := make([]*PostUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &PostUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Post.
 func (c *PostClient) Delete() *PostDelete {
 	mutation := newPostMutation(c.config, OpDelete)
@@ -529,6 +595,21 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -547,6 +628,17 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserClient) UpdateMany(ids []int, setFunc func(*UserUpdateOne)) *UserUpdateMany {
+	builders := make([]*UserUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -132,6 +132,11 @@ func TextContainsFold(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // PostIDEQ applies the EQ predicate on the "post_id" field.
 func PostIDEQ(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldPostID, v))
@@ -75,16 +75,17 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CommentCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Comment.text"`)}
+		errs = append(errs, &ValidationError{Name: "text", Entity: "Comment", Field: "text", Reason: "missing required field", err: errors.New(`ent: missing required field "Comment.text"`)})
 	}
 	if _, ok := cc.mutation.PostID(); !ok {
-		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Comment.post_id"`)}
+		errs = append(errs, &ValidationError{Name: "post_id", Entity: "Comment", Field: "post_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Comment.post_id"`)})
 	}
 	if _, ok := cc.mutation.PostID(); !ok {
-		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "Comment.post"`)}
+		errs = append(errs, &ValidationError{Name: "post", Entity: "Comment", Field: "post", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Comment.post"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
@@ -94,7 +95,7 @@ func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -137,11 +138,15 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 // CommentCreateBulk is the builder for creating many Comment entities in bulk.
 type CommentCreateBulk struct {
 	config
+	err      error
 	builders []*CommentCreate
 }
 
 // Save creates the Comment entities in the database.
 func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Comment, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -166,7 +171,7 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -54,7 +54,7 @@ func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	cd.mutation.done = true
 	return affected, err
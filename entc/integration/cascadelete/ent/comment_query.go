@@ -356,7 +356,7 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range cq.ctx.Fields {
 		if !comment.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Comment", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if cq.path != nil {
@@ -414,23 +414,30 @@ func (cq *CommentQuery) loadPost(ctx context.Context, query *PostQuery, nodes []
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(post.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], post.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -521,7 +528,8 @@ func (cq *CommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // CommentGroupBy is the group-by builder for Comment entities.
 type CommentGroupBy struct {
 	selector
-	build *CommentQuery
+	build  *CommentQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -539,6 +547,14 @@ func (cgb *CommentGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*CommentQuery, *CommentGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (cgb *CommentGroupBy) Having(ps ...*sql.Predicate) *CommentGroupBy {
+	cgb.having = append(cgb.having, ps...)
+	return cgb
+}
+
 func (cgb *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(cgb.fns))
@@ -554,6 +570,9 @@ func (cgb *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if len(cgb.having) > 0 {
+		selector.Having(sql.And(cgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
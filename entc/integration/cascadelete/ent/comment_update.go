@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -89,10 +90,11 @@ func (cu *CommentUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cu *CommentUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := cu.mutation.PostID(); cu.mutation.PostCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
+		errs = append(errs, &ValidationError{Name: "post", Entity: "Comment", Field: "post", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Comment.post"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -143,7 +145,7 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -229,10 +231,11 @@ func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cuo *CommentUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := cuo.mutation.PostID(); cuo.mutation.PostCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
+		errs = append(errs, &ValidationError{Name: "post", Entity: "Comment", Field: "post", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Comment.post"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
@@ -242,7 +245,7 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
 	id, ok := cuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Comment", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Comment.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := cuo.fields; len(fields) > 0 {
@@ -250,7 +253,7 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
 		for _, f := range fields {
 			if !comment.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Comment", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != comment.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -303,10 +306,69 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	cuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (cuo *CommentUpdateOne) sameUpdate(other *CommentUpdateOne) bool {
+	m1, m2 := *cuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// CommentUpdateMany is the builder for updating many Comment entities by their ids.
+type CommentUpdateMany struct {
+	config
+	builders []*CommentUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (cum *CommentUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*CommentUpdateOne
+Builders:
+	for _, b := range cum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*CommentUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(comment.IDIn(ids...))
+		if err := (&CommentUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cum *CommentUpdateMany) ExecX(ctx context.Context) {
+	if err := cum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -132,6 +132,11 @@ func TextContainsFold(v string) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // AuthorIDEQ applies the EQ predicate on the "author_id" field.
 func AuthorIDEQ(v int) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldAuthorID, v))
@@ -116,10 +116,11 @@ func (pc *PostCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PostCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := pc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Post.text"`)}
+		errs = append(errs, &ValidationError{Name: "text", Entity: "Post", Field: "text", Reason: "missing required field", err: errors.New(`ent: missing required field "Post.text"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PostCreate) sqlSave(ctx context.Context) (*Post, error) {
@@ -129,7 +130,7 @@ func (pc *PostCreate) sqlSave(ctx context.Context) (*Post, error) {
 	_node, _spec := pc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -188,11 +189,15 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 // PostCreateBulk is the builder for creating many Post entities in bulk.
 type PostCreateBulk struct {
 	config
+	err      error
 	builders []*PostCreate
 }
 
 // Save creates the Post entities in the database.
 func (pcb *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Post, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
@@ -218,7 +223,7 @@ func (pcb *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
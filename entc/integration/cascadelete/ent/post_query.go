@@ -393,7 +393,7 @@ func (pq *PostQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range pq.ctx.Fields {
 		if !post.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Post", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if pq.path != nil {
@@ -459,26 +459,49 @@ func (pq *PostQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(user.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "author_id" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "author_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (pq *PostQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Post, init func(*Post), assign func(*Post, *Comment)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := pq.loadComments(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Post)
 	for i := range nodes {
@@ -596,7 +619,8 @@ func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // PostGroupBy is the group-by builder for Post entities.
 type PostGroupBy struct {
 	selector
-	build *PostQuery
+	build  *PostQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -614,6 +638,14 @@ func (pgb *PostGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*PostQuery, *PostGroupBy](ctx, pgb.build, pgb, pgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (pgb *PostGroupBy) Having(ps ...*sql.Predicate) *PostGroupBy {
+	pgb.having = append(pgb.having, ps...)
+	return pgb
+}
+
 func (pgb *PostGroupBy) sqlScan(ctx context.Context, root *PostQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(pgb.fns))
@@ -629,6 +661,9 @@ func (pgb *PostGroupBy) sqlScan(ctx context.Context, root *PostQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*pgb.flds...)...)
+	if len(pgb.having) > 0 {
+		selector.Having(sql.And(pgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
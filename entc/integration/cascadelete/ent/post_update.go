@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -236,7 +237,7 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -382,7 +383,7 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	_spec := sqlgraph.NewUpdateSpec(post.Table, post.Columns, sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt))
 	id, ok := puo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Post.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Post", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Post.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := puo.fields; len(fields) > 0 {
@@ -390,7 +391,7 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		_spec.Node.Columns = append(_spec.Node.Columns, post.FieldID)
 		for _, f := range fields {
 			if !post.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Post", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != post.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -488,10 +489,69 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	puo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (puo *PostUpdateOne) sameUpdate(other *PostUpdateOne) bool {
+	m1, m2 := *puo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// PostUpdateMany is the builder for updating many Post entities by their ids.
+type PostUpdateMany struct {
+	config
+	builders []*PostUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (pum *PostUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*PostUpdateOne
+Builders:
+	for _, b := range pum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*PostUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(post.IDIn(ids...))
+		if err := (&PostUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pum *PostUpdateMany) ExecX(ctx context.Context) {
+	if err := pum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
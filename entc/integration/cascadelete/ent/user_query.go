@@ -357,7 +357,7 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range uq.ctx.Fields {
 		if !user.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "User", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if uq.path != nil {
@@ -407,6 +407,22 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 }
 
 func (uq *UserQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*User, init func(*User), assign func(*User, *Post)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := uq.loadPosts(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -521,7 +537,8 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
-	build *UserQuery
+	build  *UserQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -539,6 +556,14 @@ func (ugb *UserGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*UserQuery, *UserGroupBy](ctx, ugb.build, ugb, ugb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ugb *UserGroupBy) Having(ps ...*sql.Predicate) *UserGroupBy {
+	ugb.having = append(ugb.having, ps...)
+	return ugb
+}
+
 func (ugb *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ugb.fns))
@@ -554,6 +579,9 @@ func (ugb *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ugb.flds...)...)
+	if len(ugb.having) > 0 {
+		selector.Having(sql.And(ugb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
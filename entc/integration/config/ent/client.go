@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/config/ent/migrate"
@@ -127,14 +128,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}, nil
 }
 
-// BeginTx returns a transactional client with specified options.
+// BeginTx returns a transactional client with specified options. For example,
+// starting a read-only transaction with the REPEATABLE READ isolation level:
+//
+//	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(interface {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = c.driver.Tx(ctx)
+	} else {
+		err = errors.New("driver does not support transaction options")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
@@ -224,6 +238,21 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -242,6 +271,17 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserClient) UpdateMany(ids []int, setFunc func(*UserUpdateOne)) *UserUpdateMany {
+	builders := make([]*UserUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
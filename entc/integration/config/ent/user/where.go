@@ -136,6 +136,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // LabelEQ applies the EQ predicate on the "label" field.
 func LabelEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLabel, v))
@@ -211,6 +216,11 @@ func LabelContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldLabel, v))
 }
 
+// LabelHasPrefixFold applies the HasPrefixFold predicate on the "label" field.
+func LabelHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldLabel, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -128,7 +129,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -233,7 +234,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	id, ok := uuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "User", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "User.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := uuo.fields; len(fields) > 0 {
@@ -241,7 +242,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
 		for _, f := range fields {
 			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "User", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != user.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -274,10 +275,69 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	uuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (uuo *UserUpdateOne) sameUpdate(other *UserUpdateOne) bool {
+	m1, m2 := *uuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// UserUpdateMany is the builder for updating many User entities by their ids.
+type UserUpdateMany struct {
+	config
+	builders []*UserUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (uum *UserUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*UserUpdateOne
+Builders:
+	for _, b := range uum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*UserUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(user.IDIn(ids...))
+		if err := (&UserUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uum *UserUpdateMany) ExecX(ctx context.Context) {
+	if err := uum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
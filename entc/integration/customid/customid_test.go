@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/blob"
 	"entgo.io/ent/entc/integration/customid/ent/doc"
 	"entgo.io/ent/entc/integration/customid/ent/intsid"
+	"entgo.io/ent/entc/integration/customid/ent/note"
 	"entgo.io/ent/entc/integration/customid/ent/pet"
 	"entgo.io/ent/entc/integration/customid/ent/token"
 	"entgo.io/ent/entc/integration/customid/ent/user"
@@ -54,6 +55,7 @@ func TestMySQL(t *testing.T) {
 			err = client.Schema.Create(context.Background(), schema.WithHooks(clearDefault, skipBytesID))
 			require.NoError(t, err)
 			CustomID(t, client)
+			Outbox(t, client)
 		})
 	}
 }
@@ -79,6 +81,7 @@ func TestPostgres(t *testing.T) {
 			require.NoError(t, err)
 			CustomID(t, client)
 			BytesID(t, client)
+			Outbox(t, client)
 		})
 	}
 }
@@ -90,6 +93,7 @@ func TestSQLite(t *testing.T) {
 	require.NoError(t, client.Schema.Create(context.Background(), schema.WithHooks(clearDefault)))
 	CustomID(t, client)
 	BytesID(t, client)
+	Outbox(t, client)
 }
 
 func CustomID(t *testing.T, client *ent.Client) {
@@ -269,6 +273,46 @@ func BytesID(t *testing.T, client *ent.Client) {
 	require.Equal(t, s.ID, d.Edges.Sessions[0].ID)
 }
 
+// Outbox registers the outbox hook on the client, and therefore, it should run last.
+func Outbox(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	client.Use(ent.OutboxHook(ent.OutboxRequireTx()))
+	poll := func() []*ent.OutboxRecord {
+		var records []*ent.OutboxRecord
+		_, err := client.PollOutbox(ctx, 10, func(_ context.Context, rs []*ent.OutboxRecord) error {
+			records = append(records, rs...)
+			return nil
+		})
+		require.NoError(t, err)
+		return records
+	}
+	_, err := client.Note.Create().SetText("no tx").Save(ctx)
+	require.Error(t, err, "mutations outside a transaction are rejected")
+
+	// Records of rolled back transactions are discarded with their mutations.
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.Note.Create().SetText("rollback").ExecX(ctx)
+	require.NoError(t, tx.Rollback())
+	require.Empty(t, poll())
+	require.Zero(t, client.Note.Query().Where(note.Text("rollback")).CountX(ctx))
+
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	n := tx.Note.Create().SetText("commit").SaveX(ctx)
+	tx.Note.UpdateOne(n).SetText("updated").ExecX(ctx)
+	require.NoError(t, tx.Commit())
+	records := poll()
+	require.Len(t, records, 2)
+	require.Equal(t, "Note", records[0].Type)
+	require.Equal(t, "OpCreate", records[0].Op)
+	require.JSONEq(t, fmt.Sprintf("[%q]", n.ID), string(records[0].IDs))
+	require.JSONEq(t, `{"text":"commit"}`, string(records[0].Fields))
+	require.Equal(t, "OpUpdateOne", records[1].Op)
+	require.JSONEq(t, `{"text":"updated"}`, string(records[1].Fields))
+	require.Empty(t, poll(), "relayed records are deleted")
+}
+
 // clearDefault clears the id's default for non-postgres dialects.
 func clearDefault(c schema.Creator) schema.Creator {
 	return schema.CreateFunc(func(ctx context.Context, tables ...*schema.Table) error {
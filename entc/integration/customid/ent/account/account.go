@@ -7,6 +7,8 @@
 package account
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/sid"
@@ -81,6 +83,76 @@ func ByToken(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTokenStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "token_count") that Account queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Account queries can be ordered by.
+const (
+	OrderFieldID         OrderField = "id"
+	OrderFieldEmail      OrderField = "email"
+	OrderFieldTokenCount OrderField = "token_count"
+)
+
+// OrderFields holds all fields and edge counts that Account queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldEmail,
+	OrderFieldTokenCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Account queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("account: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := account.OrderByField(account.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Account.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldEmail:
+		return ByEmail(opts...), nil
+	case OrderFieldTokenCount:
+		return ByTokenCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newTokenStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -128,6 +128,11 @@ func EmailContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// EmailHasPrefixFold applies the HasPrefixFold predicate on the "email" field.
+func EmailHasPrefixFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefixFold(FieldEmail, v))
+}
+
 // HasToken applies the HasEdge predicate on the "token" edge.
 func HasToken() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
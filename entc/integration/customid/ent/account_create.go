@@ -106,15 +106,16 @@ func (ac *AccountCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ac *AccountCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ac.mutation.Email(); !ok {
-		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Account.email"`)}
+		errs = append(errs, &ValidationError{Name: "email", Entity: "Account", Field: "email", Reason: "missing required field", err: errors.New(`ent: missing required field "Account.email"`)})
 	}
 	if v, ok := ac.mutation.Email(); ok {
 		if err := account.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "email", Entity: "Account", Field: "email", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ac *AccountCreate) sqlSave(ctx context.Context) (*Account, error) {
@@ -124,7 +125,7 @@ func (ac *AccountCreate) sqlSave(ctx context.Context) (*Account, error) {
 	_node, _spec := ac.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -234,6 +235,12 @@ func (u *AccountUpsert) UpdateEmail() *AccountUpsert {
 	return u
 }
 
+// IgnoreEmail keeps the current value of the "email" field in case of conflict.
+func (u *AccountUpsert) IgnoreEmail() *AccountUpsert {
+	u.SetIgnore(account.FieldEmail)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -296,6 +303,14 @@ func (u *AccountUpsertOne) UpdateEmail() *AccountUpsertOne {
 	})
 }
 
+// IgnoreEmail keeps the current value of the "email" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AccountUpsertOne) IgnoreEmail() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.IgnoreEmail()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -337,12 +352,16 @@ func (u *AccountUpsertOne) IDX(ctx context.Context) sid.ID {
 // AccountCreateBulk is the builder for creating many Account entities in bulk.
 type AccountCreateBulk struct {
 	config
+	err      error
 	builders []*AccountCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Account entities in the database.
 func (acb *AccountCreateBulk) Save(ctx context.Context) ([]*Account, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
 	nodes := make([]*Account, len(acb.builders))
 	mutators := make([]Mutator, len(acb.builders))
@@ -369,7 +388,7 @@ func (acb *AccountCreateBulk) Save(ctx context.Context) ([]*Account, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -521,6 +540,14 @@ func (u *AccountUpsertBulk) UpdateEmail() *AccountUpsertBulk {
 	})
 }
 
+// IgnoreEmail keeps the current value of the "email" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AccountUpsertBulk) IgnoreEmail() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.IgnoreEmail()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
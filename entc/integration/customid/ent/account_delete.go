@@ -54,7 +54,7 @@ func (ad *AccountDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, ad.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	ad.mutation.done = true
 	return affected, err
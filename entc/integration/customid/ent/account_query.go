@@ -358,7 +358,7 @@ func (aq *AccountQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range aq.ctx.Fields {
 		if !account.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Account", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if aq.path != nil {
@@ -408,6 +408,22 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 }
 
 func (aq *AccountQuery) loadToken(ctx context.Context, query *TokenQuery, nodes []*Account, init func(*Account), assign func(*Account, *Token)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := aq.loadToken(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[sid.ID]*Account)
 	for i := range nodes {
@@ -523,7 +539,8 @@ func (aq *AccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // AccountGroupBy is the group-by builder for Account entities.
 type AccountGroupBy struct {
 	selector
-	build *AccountQuery
+	build  *AccountQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -541,6 +558,14 @@ func (agb *AccountGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*AccountQuery, *AccountGroupBy](ctx, agb.build, agb, agb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (agb *AccountGroupBy) Having(ps ...*sql.Predicate) *AccountGroupBy {
+	agb.having = append(agb.having, ps...)
+	return agb
+}
+
 func (agb *AccountGroupBy) sqlScan(ctx context.Context, root *AccountQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(agb.fns))
@@ -556,6 +581,9 @@ func (agb *AccountGroupBy) sqlScan(ctx context.Context, root *AccountQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*agb.flds...)...)
+	if len(agb.having) > 0 {
+		selector.Having(sql.And(agb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -109,12 +110,13 @@ func (au *AccountUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (au *AccountUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := au.mutation.Email(); ok {
 		if err := account.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "email", Entity: "Account", Field: "email", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -181,7 +183,7 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{account.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -286,12 +288,13 @@ func (auo *AccountUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (auo *AccountUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := auo.mutation.Email(); ok {
 		if err := account.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "email", Entity: "Account", Field: "email", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Account.email": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
@@ -301,7 +304,7 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeOther))
 	id, ok := auo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Account", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Account.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := auo.fields; len(fields) > 0 {
@@ -309,7 +312,7 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
 		for _, f := range fields {
 			if !account.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Account", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != account.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -378,10 +381,69 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{account.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	auo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (auo *AccountUpdateOne) sameUpdate(other *AccountUpdateOne) bool {
+	m1, m2 := *auo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// AccountUpdateMany is the builder for updating many Account entities by their ids.
+type AccountUpdateMany struct {
+	config
+	builders []*AccountUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (aum *AccountUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*AccountUpdateOne
+Builders:
+	for _, b := range aum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*AccountUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]sid.ID, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(account.IDIn(ids...))
+		if err := (&AccountUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aum *AccountUpdateMany) ExecX(ctx context.Context) {
+	if err := aum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,8 @@
 package blob
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -139,6 +141,84 @@ func ByBlobLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newBlobLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "links_count") that Blob queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Blob queries can be ordered by.
+const (
+	OrderFieldID             OrderField = "id"
+	OrderFieldUUID           OrderField = "uuid"
+	OrderFieldCount          OrderField = "count"
+	OrderFieldLinksCount     OrderField = "links_count"
+	OrderFieldBlobLinksCount OrderField = "blob_links_count"
+)
+
+// OrderFields holds all fields and edge counts that Blob queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldUUID,
+	OrderFieldCount,
+	OrderFieldLinksCount,
+	OrderFieldBlobLinksCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Blob queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("blob: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := blob.OrderByField(blob.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Blob.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldUUID:
+		return ByUUID(opts...), nil
+	case OrderFieldCount:
+		return ByCount(opts...), nil
+	case OrderFieldLinksCount:
+		return ByLinksCount(opts...), nil
+	case OrderFieldBlobLinksCount:
+		return ByBlobLinksCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
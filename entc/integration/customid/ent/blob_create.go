@@ -154,13 +154,14 @@ func (bc *BlobCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (bc *BlobCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := bc.mutation.UUID(); !ok {
-		return &ValidationError{Name: "uuid", err: errors.New(`ent: missing required field "Blob.uuid"`)}
+		errs = append(errs, &ValidationError{Name: "uuid", Entity: "Blob", Field: "uuid", Reason: "missing required field", err: errors.New(`ent: missing required field "Blob.uuid"`)})
 	}
 	if _, ok := bc.mutation.Count(); !ok {
-		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "Blob.count"`)}
+		errs = append(errs, &ValidationError{Name: "count", Entity: "Blob", Field: "count", Reason: "missing required field", err: errors.New(`ent: missing required field "Blob.count"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
@@ -170,7 +171,7 @@ func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
 	_node, _spec := bc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -305,6 +306,12 @@ func (u *BlobUpsert) UpdateUUID() *BlobUpsert {
 	return u
 }
 
+// IgnoreUUID keeps the current value of the "uuid" field in case of conflict.
+func (u *BlobUpsert) IgnoreUUID() *BlobUpsert {
+	u.SetIgnore(blob.FieldUUID)
+	return u
+}
+
 // SetCount sets the "count" field.
 func (u *BlobUpsert) SetCount(v int) *BlobUpsert {
 	u.Set(blob.FieldCount, v)
@@ -317,6 +324,12 @@ func (u *BlobUpsert) UpdateCount() *BlobUpsert {
 	return u
 }
 
+// IgnoreCount keeps the current value of the "count" field in case of conflict.
+func (u *BlobUpsert) IgnoreCount() *BlobUpsert {
+	u.SetIgnore(blob.FieldCount)
+	return u
+}
+
 // AddCount adds v to the "count" field.
 func (u *BlobUpsert) AddCount(v int) *BlobUpsert {
 	u.Add(blob.FieldCount, v)
@@ -385,6 +398,14 @@ func (u *BlobUpsertOne) UpdateUUID() *BlobUpsertOne {
 	})
 }
 
+// IgnoreUUID keeps the current value of the "uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobUpsertOne) IgnoreUUID() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.IgnoreUUID()
+	})
+}
+
 // SetCount sets the "count" field.
 func (u *BlobUpsertOne) SetCount(v int) *BlobUpsertOne {
 	return u.Update(func(s *BlobUpsert) {
@@ -406,6 +427,14 @@ func (u *BlobUpsertOne) UpdateCount() *BlobUpsertOne {
 	})
 }
 
+// IgnoreCount keeps the current value of the "count" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobUpsertOne) IgnoreCount() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.IgnoreCount()
+	})
+}
+
 // Exec executes the query.
 func (u *BlobUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -447,12 +476,16 @@ func (u *BlobUpsertOne) IDX(ctx context.Context) uuid.UUID {
 // BlobCreateBulk is the builder for creating many Blob entities in bulk.
 type BlobCreateBulk struct {
 	config
+	err      error
 	builders []*BlobCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Blob entities in the database.
 func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
 	nodes := make([]*Blob, len(bcb.builders))
 	mutators := make([]Mutator, len(bcb.builders))
@@ -479,7 +512,7 @@ func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -631,6 +664,14 @@ func (u *BlobUpsertBulk) UpdateUUID() *BlobUpsertBulk {
 	})
 }
 
+// IgnoreUUID keeps the current value of the "uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobUpsertBulk) IgnoreUUID() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.IgnoreUUID()
+	})
+}
+
 // SetCount sets the "count" field.
 func (u *BlobUpsertBulk) SetCount(v int) *BlobUpsertBulk {
 	return u.Update(func(s *BlobUpsert) {
@@ -652,6 +693,14 @@ func (u *BlobUpsertBulk) UpdateCount() *BlobUpsertBulk {
 	})
 }
 
+// IgnoreCount keeps the current value of the "count" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobUpsertBulk) IgnoreCount() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.IgnoreCount()
+	})
+}
+
 // Exec executes the query.
 func (u *BlobUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -54,7 +54,7 @@ func (bd *BlobDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, bd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	bd.mutation.done = true
 	return affected, err
@@ -429,7 +429,7 @@ func (bq *BlobQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range bq.ctx.Fields {
 		if !blob.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Blob", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if bq.path != nil {
@@ -513,26 +513,49 @@ func (bq *BlobQuery) loadParent(ctx context.Context, query *BlobQuery, nodes []*
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(blob.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "blob_parent" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], blob.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "blob_parent" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (bq *BlobQuery) loadLinks(ctx context.Context, query *BlobQuery, nodes []*Blob, init func(*Blob), assign func(*Blob, *Blob)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := bq.loadLinks(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*Blob)
 	nids := make(map[uuid.UUID]map[*Blob]struct{})
@@ -594,6 +617,22 @@ func (bq *BlobQuery) loadLinks(ctx context.Context, query *BlobQuery, nodes []*B
 	return nil
 }
 func (bq *BlobQuery) loadBlobLinks(ctx context.Context, query *BlobLinkQuery, nodes []*Blob, init func(*Blob), assign func(*Blob, *BlobLink)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := bq.loadBlobLinks(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Blob)
 	for i := range nodes {
@@ -708,7 +747,8 @@ func (bq *BlobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // BlobGroupBy is the group-by builder for Blob entities.
 type BlobGroupBy struct {
 	selector
-	build *BlobQuery
+	build  *BlobQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -726,6 +766,14 @@ func (bgb *BlobGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*BlobQuery, *BlobGroupBy](ctx, bgb.build, bgb, bgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (bgb *BlobGroupBy) Having(ps ...*sql.Predicate) *BlobGroupBy {
+	bgb.having = append(bgb.having, ps...)
+	return bgb
+}
+
 func (bgb *BlobGroupBy) sqlScan(ctx context.Context, root *BlobQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(bgb.fns))
@@ -741,6 +789,9 @@ func (bgb *BlobGroupBy) sqlScan(ctx context.Context, root *BlobQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*bgb.flds...)...)
+	if len(bgb.having) > 0 {
+		selector.Having(sql.And(bgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -110,6 +110,12 @@ func LinkIDNotIn(vs ...uuid.UUID) predicate.BlobLink {
 	return predicate.BlobLink(sql.FieldNotIn(FieldLinkID, vs...))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.BlobLink {
+	return predicate.BlobLink(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
 // HasBlob applies the HasEdge predicate on the "blob" edge.
 func HasBlob() predicate.BlobLink {
 	return predicate.BlobLink(func(s *sql.Selector) {
@@ -107,22 +107,23 @@ func (blc *BlobLinkCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (blc *BlobLinkCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := blc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlobLink.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "BlobLink", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "BlobLink.created_at"`)})
 	}
 	if _, ok := blc.mutation.BlobID(); !ok {
-		return &ValidationError{Name: "blob_id", err: errors.New(`ent: missing required field "BlobLink.blob_id"`)}
+		errs = append(errs, &ValidationError{Name: "blob_id", Entity: "BlobLink", Field: "blob_id", Reason: "missing required field", err: errors.New(`ent: missing required field "BlobLink.blob_id"`)})
 	}
 	if _, ok := blc.mutation.LinkID(); !ok {
-		return &ValidationError{Name: "link_id", err: errors.New(`ent: missing required field "BlobLink.link_id"`)}
+		errs = append(errs, &ValidationError{Name: "link_id", Entity: "BlobLink", Field: "link_id", Reason: "missing required field", err: errors.New(`ent: missing required field "BlobLink.link_id"`)})
 	}
 	if _, ok := blc.mutation.BlobID(); !ok {
-		return &ValidationError{Name: "blob", err: errors.New(`ent: missing required edge "BlobLink.blob"`)}
+		errs = append(errs, &ValidationError{Name: "blob", Entity: "BlobLink", Field: "blob", Reason: "missing required edge", err: errors.New(`ent: missing required edge "BlobLink.blob"`)})
 	}
 	if _, ok := blc.mutation.LinkID(); !ok {
-		return &ValidationError{Name: "link", err: errors.New(`ent: missing required edge "BlobLink.link"`)}
+		errs = append(errs, &ValidationError{Name: "link", Entity: "BlobLink", Field: "link", Reason: "missing required edge", err: errors.New(`ent: missing required edge "BlobLink.link"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (blc *BlobLinkCreate) sqlSave(ctx context.Context) (*BlobLink, error) {
@@ -132,7 +133,7 @@ func (blc *BlobLinkCreate) sqlSave(ctx context.Context) (*BlobLink, error) {
 	_node, _spec := blc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, blc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -247,6 +248,12 @@ func (u *BlobLinkUpsert) UpdateCreatedAt() *BlobLinkUpsert {
 	return u
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+func (u *BlobLinkUpsert) IgnoreCreatedAt() *BlobLinkUpsert {
+	u.SetIgnore(bloblink.FieldCreatedAt)
+	return u
+}
+
 // SetBlobID sets the "blob_id" field.
 func (u *BlobLinkUpsert) SetBlobID(v uuid.UUID) *BlobLinkUpsert {
 	u.Set(bloblink.FieldBlobID, v)
@@ -259,6 +266,12 @@ func (u *BlobLinkUpsert) UpdateBlobID() *BlobLinkUpsert {
 	return u
 }
 
+// IgnoreBlobID keeps the current value of the "blob_id" field in case of conflict.
+func (u *BlobLinkUpsert) IgnoreBlobID() *BlobLinkUpsert {
+	u.SetIgnore(bloblink.FieldBlobID)
+	return u
+}
+
 // SetLinkID sets the "link_id" field.
 func (u *BlobLinkUpsert) SetLinkID(v uuid.UUID) *BlobLinkUpsert {
 	u.Set(bloblink.FieldLinkID, v)
@@ -271,6 +284,12 @@ func (u *BlobLinkUpsert) UpdateLinkID() *BlobLinkUpsert {
 	return u
 }
 
+// IgnoreLinkID keeps the current value of the "link_id" field in case of conflict.
+func (u *BlobLinkUpsert) IgnoreLinkID() *BlobLinkUpsert {
+	u.SetIgnore(bloblink.FieldLinkID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -325,6 +344,14 @@ func (u *BlobLinkUpsertOne) UpdateCreatedAt() *BlobLinkUpsertOne {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobLinkUpsertOne) IgnoreCreatedAt() *BlobLinkUpsertOne {
+	return u.Update(func(s *BlobLinkUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // SetBlobID sets the "blob_id" field.
 func (u *BlobLinkUpsertOne) SetBlobID(v uuid.UUID) *BlobLinkUpsertOne {
 	return u.Update(func(s *BlobLinkUpsert) {
@@ -339,6 +366,14 @@ func (u *BlobLinkUpsertOne) UpdateBlobID() *BlobLinkUpsertOne {
 	})
 }
 
+// IgnoreBlobID keeps the current value of the "blob_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobLinkUpsertOne) IgnoreBlobID() *BlobLinkUpsertOne {
+	return u.Update(func(s *BlobLinkUpsert) {
+		s.IgnoreBlobID()
+	})
+}
+
 // SetLinkID sets the "link_id" field.
 func (u *BlobLinkUpsertOne) SetLinkID(v uuid.UUID) *BlobLinkUpsertOne {
 	return u.Update(func(s *BlobLinkUpsert) {
@@ -353,6 +388,14 @@ func (u *BlobLinkUpsertOne) UpdateLinkID() *BlobLinkUpsertOne {
 	})
 }
 
+// IgnoreLinkID keeps the current value of the "link_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobLinkUpsertOne) IgnoreLinkID() *BlobLinkUpsertOne {
+	return u.Update(func(s *BlobLinkUpsert) {
+		s.IgnoreLinkID()
+	})
+}
+
 // Exec executes the query.
 func (u *BlobLinkUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -371,12 +414,16 @@ func (u *BlobLinkUpsertOne) ExecX(ctx context.Context) {
 // BlobLinkCreateBulk is the builder for creating many BlobLink entities in bulk.
 type BlobLinkCreateBulk struct {
 	config
+	err      error
 	builders []*BlobLinkCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the BlobLink entities in the database.
 func (blcb *BlobLinkCreateBulk) Save(ctx context.Context) ([]*BlobLink, error) {
+	if blcb.err != nil {
+		return nil, blcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(blcb.builders))
 	nodes := make([]*BlobLink, len(blcb.builders))
 	mutators := make([]Mutator, len(blcb.builders))
@@ -403,7 +450,7 @@ func (blcb *BlobLinkCreateBulk) Save(ctx context.Context) ([]*BlobLink, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, blcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -544,6 +591,14 @@ func (u *BlobLinkUpsertBulk) UpdateCreatedAt() *BlobLinkUpsertBulk {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobLinkUpsertBulk) IgnoreCreatedAt() *BlobLinkUpsertBulk {
+	return u.Update(func(s *BlobLinkUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // SetBlobID sets the "blob_id" field.
 func (u *BlobLinkUpsertBulk) SetBlobID(v uuid.UUID) *BlobLinkUpsertBulk {
 	return u.Update(func(s *BlobLinkUpsert) {
@@ -558,6 +613,14 @@ func (u *BlobLinkUpsertBulk) UpdateBlobID() *BlobLinkUpsertBulk {
 	})
 }
 
+// IgnoreBlobID keeps the current value of the "blob_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobLinkUpsertBulk) IgnoreBlobID() *BlobLinkUpsertBulk {
+	return u.Update(func(s *BlobLinkUpsert) {
+		s.IgnoreBlobID()
+	})
+}
+
 // SetLinkID sets the "link_id" field.
 func (u *BlobLinkUpsertBulk) SetLinkID(v uuid.UUID) *BlobLinkUpsertBulk {
 	return u.Update(func(s *BlobLinkUpsert) {
@@ -572,6 +635,14 @@ func (u *BlobLinkUpsertBulk) UpdateLinkID() *BlobLinkUpsertBulk {
 	})
 }
 
+// IgnoreLinkID keeps the current value of the "link_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *BlobLinkUpsertBulk) IgnoreLinkID() *BlobLinkUpsertBulk {
+	return u.Update(func(s *BlobLinkUpsert) {
+		s.IgnoreLinkID()
+	})
+}
+
 // Exec executes the query.
 func (u *BlobLinkUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -53,7 +53,7 @@ func (bld *BlobLinkDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, bld.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	bld.mutation.done = true
 	return affected, err
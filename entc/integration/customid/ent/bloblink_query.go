@@ -319,7 +319,7 @@ func (blq *BlobLinkQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range blq.ctx.Fields {
 		if !bloblink.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "BlobLink", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if blq.path != nil {
@@ -384,23 +384,30 @@ func (blq *BlobLinkQuery) loadBlob(ctx context.Context, query *BlobQuery, nodes
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(blob.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "blob_id" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], blob.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "blob_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (blq *BlobLinkQuery) loadLink(ctx context.Context, query *BlobQuery, nodes []*BlobLink, init func(*BlobLink), assign func(*BlobLink, *Blob)) error {
@@ -413,23 +420,30 @@ func (blq *BlobLinkQuery) loadLink(ctx context.Context, query *BlobQuery, nodes
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(blob.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "link_id" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], blob.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "link_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -518,7 +532,8 @@ func (blq *BlobLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // BlobLinkGroupBy is the group-by builder for BlobLink entities.
 type BlobLinkGroupBy struct {
 	selector
-	build *BlobLinkQuery
+	build  *BlobLinkQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -536,6 +551,14 @@ func (blgb *BlobLinkGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*BlobLinkQuery, *BlobLinkGroupBy](ctx, blgb.build, blgb, blgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (blgb *BlobLinkGroupBy) Having(ps ...*sql.Predicate) *BlobLinkGroupBy {
+	blgb.having = append(blgb.having, ps...)
+	return blgb
+}
+
 func (blgb *BlobLinkGroupBy) sqlScan(ctx context.Context, root *BlobLinkQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(blgb.fns))
@@ -551,6 +574,9 @@ func (blgb *BlobLinkGroupBy) sqlScan(ctx context.Context, root *BlobLinkQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*blgb.flds...)...)
+	if len(blgb.having) > 0 {
+		selector.Having(sql.And(blgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -116,13 +116,14 @@ func (blu *BlobLinkUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (blu *BlobLinkUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := blu.mutation.BlobID(); blu.mutation.BlobCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "BlobLink.blob"`)
+		errs = append(errs, &ValidationError{Name: "blob", Entity: "BlobLink", Field: "blob", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "BlobLink.blob"`)})
 	}
 	if _, ok := blu.mutation.LinkID(); blu.mutation.LinkCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "BlobLink.link"`)
+		errs = append(errs, &ValidationError{Name: "link", Entity: "BlobLink", Field: "link", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "BlobLink.link"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (blu *BlobLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -202,7 +203,7 @@ func (blu *BlobLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{bloblink.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -313,13 +314,14 @@ func (bluo *BlobLinkUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (bluo *BlobLinkUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := bluo.mutation.BlobID(); bluo.mutation.BlobCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "BlobLink.blob"`)
+		errs = append(errs, &ValidationError{Name: "blob", Entity: "BlobLink", Field: "blob", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "BlobLink.blob"`)})
 	}
 	if _, ok := bluo.mutation.LinkID(); bluo.mutation.LinkCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "BlobLink.link"`)
+		errs = append(errs, &ValidationError{Name: "link", Entity: "BlobLink", Field: "link", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "BlobLink.link"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (bluo *BlobLinkUpdateOne) sqlSave(ctx context.Context) (_node *BlobLink, err error) {
@@ -328,12 +330,12 @@ func (bluo *BlobLinkUpdateOne) sqlSave(ctx context.Context) (_node *BlobLink, er
 	}
 	_spec := sqlgraph.NewUpdateSpec(bloblink.Table, bloblink.Columns, sqlgraph.NewFieldSpec(bloblink.FieldBlobID, field.TypeUUID), sqlgraph.NewFieldSpec(bloblink.FieldLinkID, field.TypeUUID))
 	if id, ok := bluo.mutation.BlobID(); !ok {
-		return nil, &ValidationError{Name: "blob_id", err: errors.New(`ent: missing "BlobLink.blob_id" for update`)}
+		return nil, &ValidationError{Name: "blob_id", Entity: "BlobLink", Field: "blob_id", Reason: "missing id for update", err: errors.New(`ent: missing "BlobLink.blob_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[0].Value = id
 	}
 	if id, ok := bluo.mutation.LinkID(); !ok {
-		return nil, &ValidationError{Name: "link_id", err: errors.New(`ent: missing "BlobLink.link_id" for update`)}
+		return nil, &ValidationError{Name: "link_id", Entity: "BlobLink", Field: "link_id", Reason: "missing id for update", err: errors.New(`ent: missing "BlobLink.link_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[1].Value = id
 	}
@@ -341,7 +343,7 @@ func (bluo *BlobLinkUpdateOne) sqlSave(ctx context.Context) (_node *BlobLink, er
 		_spec.Node.Columns = make([]string, len(fields))
 		for i, f := range fields {
 			if !bloblink.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "BlobLink", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			_spec.Node.Columns[i] = f
 		}
@@ -421,7 +423,7 @@ func (bluo *BlobLinkUpdateOne) sqlSave(ctx context.Context) (_node *BlobLink, er
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{bloblink.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
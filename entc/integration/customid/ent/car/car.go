@@ -7,6 +7,8 @@
 package car
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -102,6 +104,80 @@ func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that Car queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Car queries can be ordered by.
+const (
+	OrderFieldID       OrderField = "id"
+	OrderFieldBeforeID OrderField = "before_id"
+	OrderFieldAfterID  OrderField = "after_id"
+	OrderFieldModel    OrderField = "model"
+)
+
+// OrderFields holds all fields and edge counts that Car queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldBeforeID,
+	OrderFieldAfterID,
+	OrderFieldModel,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Car queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("car: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := car.OrderByField(car.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Car.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldBeforeID:
+		return ByBeforeID(opts...), nil
+	case OrderFieldAfterID:
+		return ByAfterID(opts...), nil
+	case OrderFieldModel:
+		return ByModel(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
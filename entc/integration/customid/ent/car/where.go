@@ -237,6 +237,11 @@ func ModelContainsFold(v string) predicate.Car {
 	return predicate.Car(sql.FieldContainsFold(FieldModel, v))
 }
 
+// ModelHasPrefixFold applies the HasPrefixFold predicate on the "model" field.
+func ModelHasPrefixFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefixFold(FieldModel, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
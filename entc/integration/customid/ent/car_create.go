@@ -119,25 +119,26 @@ func (cc *CarCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CarCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := cc.mutation.BeforeID(); ok {
 		if err := car.BeforeIDValidator(v); err != nil {
-			return &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "before_id", Entity: "Car", Field: "before_id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)})
 		}
 	}
 	if v, ok := cc.mutation.AfterID(); ok {
 		if err := car.AfterIDValidator(v); err != nil {
-			return &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "after_id", Entity: "Car", Field: "after_id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)})
 		}
 	}
 	if _, ok := cc.mutation.Model(); !ok {
-		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "Car.model"`)}
+		errs = append(errs, &ValidationError{Name: "model", Entity: "Car", Field: "model", Reason: "missing required field", err: errors.New(`ent: missing required field "Car.model"`)})
 	}
 	if v, ok := cc.mutation.ID(); ok {
 		if err := car.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Car.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Car", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
@@ -147,7 +148,7 @@ func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -263,6 +264,12 @@ func (u *CarUpsert) UpdateBeforeID() *CarUpsert {
 	return u
 }
 
+// IgnoreBeforeID keeps the current value of the "before_id" field in case of conflict.
+func (u *CarUpsert) IgnoreBeforeID() *CarUpsert {
+	u.SetIgnore(car.FieldBeforeID)
+	return u
+}
+
 // AddBeforeID adds v to the "before_id" field.
 func (u *CarUpsert) AddBeforeID(v float64) *CarUpsert {
 	u.Add(car.FieldBeforeID, v)
@@ -287,6 +294,12 @@ func (u *CarUpsert) UpdateAfterID() *CarUpsert {
 	return u
 }
 
+// IgnoreAfterID keeps the current value of the "after_id" field in case of conflict.
+func (u *CarUpsert) IgnoreAfterID() *CarUpsert {
+	u.SetIgnore(car.FieldAfterID)
+	return u
+}
+
 // AddAfterID adds v to the "after_id" field.
 func (u *CarUpsert) AddAfterID(v float64) *CarUpsert {
 	u.Add(car.FieldAfterID, v)
@@ -311,6 +324,12 @@ func (u *CarUpsert) UpdateModel() *CarUpsert {
 	return u
 }
 
+// IgnoreModel keeps the current value of the "model" field in case of conflict.
+func (u *CarUpsert) IgnoreModel() *CarUpsert {
+	u.SetIgnore(car.FieldModel)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -380,6 +399,14 @@ func (u *CarUpsertOne) UpdateBeforeID() *CarUpsertOne {
 	})
 }
 
+// IgnoreBeforeID keeps the current value of the "before_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CarUpsertOne) IgnoreBeforeID() *CarUpsertOne {
+	return u.Update(func(s *CarUpsert) {
+		s.IgnoreBeforeID()
+	})
+}
+
 // ClearBeforeID clears the value of the "before_id" field.
 func (u *CarUpsertOne) ClearBeforeID() *CarUpsertOne {
 	return u.Update(func(s *CarUpsert) {
@@ -408,6 +435,14 @@ func (u *CarUpsertOne) UpdateAfterID() *CarUpsertOne {
 	})
 }
 
+// IgnoreAfterID keeps the current value of the "after_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CarUpsertOne) IgnoreAfterID() *CarUpsertOne {
+	return u.Update(func(s *CarUpsert) {
+		s.IgnoreAfterID()
+	})
+}
+
 // ClearAfterID clears the value of the "after_id" field.
 func (u *CarUpsertOne) ClearAfterID() *CarUpsertOne {
 	return u.Update(func(s *CarUpsert) {
@@ -429,6 +464,14 @@ func (u *CarUpsertOne) UpdateModel() *CarUpsertOne {
 	})
 }
 
+// IgnoreModel keeps the current value of the "model" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CarUpsertOne) IgnoreModel() *CarUpsertOne {
+	return u.Update(func(s *CarUpsert) {
+		s.IgnoreModel()
+	})
+}
+
 // Exec executes the query.
 func (u *CarUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -465,12 +508,16 @@ func (u *CarUpsertOne) IDX(ctx context.Context) int {
 // CarCreateBulk is the builder for creating many Car entities in bulk.
 type CarCreateBulk struct {
 	config
+	err      error
 	builders []*CarCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Car entities in the database.
 func (ccb *CarCreateBulk) Save(ctx context.Context) ([]*Car, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Car, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -496,7 +543,7 @@ func (ccb *CarCreateBulk) Save(ctx context.Context) ([]*Car, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -659,6 +706,14 @@ func (u *CarUpsertBulk) UpdateBeforeID() *CarUpsertBulk {
 	})
 }
 
+// IgnoreBeforeID keeps the current value of the "before_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CarUpsertBulk) IgnoreBeforeID() *CarUpsertBulk {
+	return u.Update(func(s *CarUpsert) {
+		s.IgnoreBeforeID()
+	})
+}
+
 // ClearBeforeID clears the value of the "before_id" field.
 func (u *CarUpsertBulk) ClearBeforeID() *CarUpsertBulk {
 	return u.Update(func(s *CarUpsert) {
@@ -687,6 +742,14 @@ func (u *CarUpsertBulk) UpdateAfterID() *CarUpsertBulk {
 	})
 }
 
+// IgnoreAfterID keeps the current value of the "after_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CarUpsertBulk) IgnoreAfterID() *CarUpsertBulk {
+	return u.Update(func(s *CarUpsert) {
+		s.IgnoreAfterID()
+	})
+}
+
 // ClearAfterID clears the value of the "after_id" field.
 func (u *CarUpsertBulk) ClearAfterID() *CarUpsertBulk {
 	return u.Update(func(s *CarUpsert) {
@@ -708,6 +771,14 @@ func (u *CarUpsertBulk) UpdateModel() *CarUpsertBulk {
 	})
 }
 
+// IgnoreModel keeps the current value of the "model" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CarUpsertBulk) IgnoreModel() *CarUpsertBulk {
+	return u.Update(func(s *CarUpsert) {
+		s.IgnoreModel()
+	})
+}
+
 // Exec executes the query.
 func (u *CarUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
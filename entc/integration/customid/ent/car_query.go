@@ -357,7 +357,7 @@ func (cq *CarQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range cq.ctx.Fields {
 		if !car.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Car", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if cq.path != nil {
@@ -425,23 +425,30 @@ func (cq *CarQuery) loadOwner(ctx context.Context, query *PetQuery, nodes []*Car
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(pet.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "pet_cars" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], pet.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "pet_cars" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -529,7 +536,8 @@ func (cq *CarQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // CarGroupBy is the group-by builder for Car entities.
 type CarGroupBy struct {
 	selector
-	build *CarQuery
+	build  *CarQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -547,6 +555,14 @@ func (cgb *CarGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*CarQuery, *CarGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (cgb *CarGroupBy) Having(ps ...*sql.Predicate) *CarGroupBy {
+	cgb.having = append(cgb.having, ps...)
+	return cgb
+}
+
 func (cgb *CarGroupBy) sqlScan(ctx context.Context, root *CarQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(cgb.fns))
@@ -562,6 +578,9 @@ func (cgb *CarGroupBy) sqlScan(ctx context.Context, root *CarQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if len(cgb.having) > 0 {
+		selector.Having(sql.And(cgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
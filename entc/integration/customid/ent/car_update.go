@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -151,17 +152,18 @@ func (cu *CarUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cu *CarUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := cu.mutation.BeforeID(); ok {
 		if err := car.BeforeIDValidator(v); err != nil {
-			return &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "before_id", Entity: "Car", Field: "before_id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)})
 		}
 	}
 	if v, ok := cu.mutation.AfterID(); ok {
 		if err := car.AfterIDValidator(v); err != nil {
-			return &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "after_id", Entity: "Car", Field: "after_id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -230,7 +232,7 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{car.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -378,17 +380,18 @@ func (cuo *CarUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cuo *CarUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := cuo.mutation.BeforeID(); ok {
 		if err := car.BeforeIDValidator(v); err != nil {
-			return &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "before_id", Entity: "Car", Field: "before_id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)})
 		}
 	}
 	if v, ok := cuo.mutation.AfterID(); ok {
 		if err := car.AfterIDValidator(v); err != nil {
-			return &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "after_id", Entity: "Car", Field: "after_id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
@@ -398,7 +401,7 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 	_spec := sqlgraph.NewUpdateSpec(car.Table, car.Columns, sqlgraph.NewFieldSpec(car.FieldID, field.TypeInt))
 	id, ok := cuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Car.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Car", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Car.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := cuo.fields; len(fields) > 0 {
@@ -406,7 +409,7 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 		_spec.Node.Columns = append(_spec.Node.Columns, car.FieldID)
 		for _, f := range fields {
 			if !car.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Car", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != car.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -477,10 +480,69 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{car.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	cuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (cuo *CarUpdateOne) sameUpdate(other *CarUpdateOne) bool {
+	m1, m2 := *cuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// CarUpdateMany is the builder for updating many Car entities by their ids.
+type CarUpdateMany struct {
+	config
+	builders []*CarUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (cum *CarUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*CarUpdateOne
+Builders:
+	for _, b := range cum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*CarUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(car.IDIn(ids...))
+		if err := (&CarUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cum *CarUpdateMany) ExecX(ctx context.Context) {
+	if err := cum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
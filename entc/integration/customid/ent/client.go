@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/customid/ent/migrate"
@@ -212,14 +213,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}, nil
 }
 
-// BeginTx returns a transactional client with specified options.
+// BeginTx returns a transactional client with specified options. For example,
+// starting a read-only transaction with the REPEATABLE READ isolation level:
+//
+//	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(interface {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = c.driver.Tx(ctx)
+	} else {
+		err = errors.New("driver does not support transaction options")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
@@ -369,6 +383,21 @@ func (c *AccountClient) CreateBulk(builders ...*AccountCreate) *AccountCreateBul
 	return &AccountCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountClient) MapCreateBulk(slice any, setFunc func(*AccountCreate, int)) *AccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountCreateBulk{err: fmt.Errorf("calling to AccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Account.
 func (c *AccountClient) Update() *AccountUpdate {
 	mutation := newAccountMutation(c.config, OpUpdate)
@@ -387,6 +416,17 @@ func (c *AccountClient) UpdateOneID(id sid.ID) *AccountUpdateOne {
 	return &AccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *AccountClient) UpdateMany(ids []sid.ID, setFunc func(*AccountUpdateOne)) *AccountUpdateMany {
+	builders := make([]*AccountUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &AccountUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Account.
 func (c *AccountClient) Delete() *AccountDelete {
 	mutation := newAccountMutation(c.config, OpDelete)
@@ -503,6 +543,21 @@ func (c *BlobClient) CreateBulk(builders ...*BlobCreate) *BlobCreateBulk {
 	return &BlobCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlobClient) MapCreateBulk(slice any, setFunc func(*BlobCreate, int)) *BlobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlobCreateBulk{err: fmt.Errorf("calling to BlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Blob.
 func (c *BlobClient) Update() *BlobUpdate {
 	mutation := newBlobMutation(c.config, OpUpdate)
@@ -521,6 +576,17 @@ func (c *BlobClient) UpdateOneID(id uuid.UUID) *BlobUpdateOne {
 	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *BlobClient) UpdateMany(ids []uuid.UUID, setFunc func(*BlobUpdateOne)) *BlobUpdateMany {
+	builders := make([]*BlobUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &BlobUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Blob.
 func (c *BlobClient) Delete() *BlobDelete {
 	mutation := newBlobMutation(c.config, OpDelete)
@@ -669,6 +735,21 @@ func (c *BlobLinkClient) CreateBulk(builders ...*BlobLinkCreate) *BlobLinkCreate
 	return &BlobLinkCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlobLinkClient) MapCreateBulk(slice any, setFunc func(*BlobLinkCreate, int)) *BlobLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlobLinkCreateBulk{err: fmt.Errorf("calling to BlobLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlobLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlobLinkCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for BlobLink.
 func (c *BlobLinkClient) Update() *BlobLinkUpdate {
 	mutation := newBlobLinkMutation(c.config, OpUpdate)
@@ -770,6 +851,21 @@ func (c *CarClient) CreateBulk(builders ...*CarCreate) *CarCreateBulk {
 	return &CarCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CarClient) MapCreateBulk(slice any, setFunc func(*CarCreate, int)) *CarCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CarCreateBulk{err: fmt.Errorf("calling to CarClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CarCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CarCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Car.
 func (c *CarClient) Update() *CarUpdate {
 	mutation := newCarMutation(c.config, OpUpdate)
@@ -788,6 +884,17 @@ func (c *CarClient) UpdateOneID(id int) *CarUpdateOne {
 	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *CarClient) UpdateMany(ids []int, setFunc func(*CarUpdateOne)) *CarUpdateMany {
+	builders := make([]*CarUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &CarUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Car.
 func (c *CarClient) Delete() *CarDelete {
 	mutation := newCarMutation(c.config, OpDelete)
@@ -904,6 +1011,21 @@ func (c *DeviceClient) CreateBulk(builders ...*DeviceCreate) *DeviceCreateBulk {
 	return &DeviceCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceClient) MapCreateBulk(slice any, setFunc func(*DeviceCreate, int)) *DeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceCreateBulk{err: fmt.Errorf("calling to DeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Device.
 func (c *DeviceClient) Update() *DeviceUpdate {
 	mutation := newDeviceMutation(c.config, OpUpdate)
@@ -922,6 +1044,17 @@ func (c *DeviceClient) UpdateOneID(id schema.ID) *DeviceUpdateOne {
 	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *DeviceClient) UpdateMany(ids []schema.ID, setFunc func(*DeviceUpdateOne)) *DeviceUpdateMany {
+	builders := make([]*DeviceUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &DeviceUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Device.
 func (c *DeviceClient) Delete() *DeviceDelete {
 	mutation := newDeviceMutation(c.config, OpDelete)
@@ -1054,6 +1187,21 @@ func (c *DocClient) CreateBulk(builders ...*DocCreate) *DocCreateBulk {
 	return &DocCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocClient) MapCreateBulk(slice any, setFunc func(*DocCreate, int)) *DocCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocCreateBulk{err: fmt.Errorf("calling to DocClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Doc.
 func (c *DocClient) Update() *DocUpdate {
 	mutation := newDocMutation(c.config, OpUpdate)
@@ -1072,6 +1220,17 @@ func (c *DocClient) UpdateOneID(id schema.DocID) *DocUpdateOne {
 	return &DocUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *DocClient) UpdateMany(ids []schema.DocID, setFunc func(*DocUpdateOne)) *DocUpdateMany {
+	builders := make([]*DocUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &DocUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Doc.
 func (c *DocClient) Delete() *DocDelete {
 	mutation := newDocMutation(c.config, OpDelete)
@@ -1220,6 +1379,21 @@ func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
 	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Group.
 func (c *GroupClient) Update() *GroupUpdate {
 	mutation := newGroupMutation(c.config, OpUpdate)
@@ -1238,6 +1412,17 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *GroupClient) UpdateMany(ids []int, setFunc func(*GroupUpdateOne)) *GroupUpdateMany {
+	builders := make([]*GroupUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &GroupUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -1354,6 +1539,21 @@ func (c *IntSIDClient) CreateBulk(builders ...*IntSIDCreate) *IntSIDCreateBulk {
 	return &IntSIDCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IntSIDClient) MapCreateBulk(slice any, setFunc func(*IntSIDCreate, int)) *IntSIDCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IntSIDCreateBulk{err: fmt.Errorf("calling to IntSIDClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IntSIDCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IntSIDCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for IntSID.
 func (c *IntSIDClient) Update() *IntSIDUpdate {
 	mutation := newIntSIDMutation(c.config, OpUpdate)
@@ -1372,6 +1572,17 @@ func (c *IntSIDClient) UpdateOneID(id sid.ID) *IntSIDUpdateOne {
 	return &IntSIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *IntSIDClient) UpdateMany(ids []sid.ID, setFunc func(*IntSIDUpdateOne)) *IntSIDUpdateMany {
+	builders := make([]*IntSIDUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &IntSIDUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for IntSID.
 func (c *IntSIDClient) Delete() *IntSIDDelete {
 	mutation := newIntSIDMutation(c.config, OpDelete)
@@ -1504,6 +1715,21 @@ func (c *LinkClient) CreateBulk(builders ...*LinkCreate) *LinkCreateBulk {
 	return &LinkCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkClient) MapCreateBulk(slice any, setFunc func(*LinkCreate, int)) *LinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkCreateBulk{err: fmt.Errorf("calling to LinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Link.
 func (c *LinkClient) Update() *LinkUpdate {
 	mutation := newLinkMutation(c.config, OpUpdate)
@@ -1522,6 +1748,17 @@ func (c *LinkClient) UpdateOneID(id uuidc.UUIDC) *LinkUpdateOne {
 	return &LinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *LinkClient) UpdateMany(ids []uuidc.UUIDC, setFunc func(*LinkUpdateOne)) *LinkUpdateMany {
+	builders := make([]*LinkUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &LinkUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Link.
 func (c *LinkClient) Delete() *LinkDelete {
 	mutation := newLinkMutation(c.config, OpDelete)
@@ -1622,6 +1859,21 @@ func (c *MixinIDClient) CreateBulk(builders ...*MixinIDCreate) *MixinIDCreateBul
 	return &MixinIDCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MixinIDClient) MapCreateBulk(slice any, setFunc func(*MixinIDCreate, int)) *MixinIDCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MixinIDCreateBulk{err: fmt.Errorf("calling to MixinIDClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MixinIDCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MixinIDCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for MixinID.
 func (c *MixinIDClient) Update() *MixinIDUpdate {
 	mutation := newMixinIDMutation(c.config, OpUpdate)
@@ -1640,6 +1892,17 @@ func (c *MixinIDClient) UpdateOneID(id uuid.UUID) *MixinIDUpdateOne {
 	return &MixinIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *MixinIDClient) UpdateMany(ids []uuid.UUID, setFunc func(*MixinIDUpdateOne)) *MixinIDUpdateMany {
+	builders := make([]*MixinIDUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &MixinIDUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for MixinID.
 func (c *MixinIDClient) Delete() *MixinIDDelete {
 	mutation := newMixinIDMutation(c.config, OpDelete)
@@ -1740,6 +2003,21 @@ func (c *NoteClient) CreateBulk(builders ...*NoteCreate) *NoteCreateBulk {
 	return &NoteCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteClient) MapCreateBulk(slice any, setFunc func(*NoteCreate, int)) *NoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteCreateBulk{err: fmt.Errorf("calling to NoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Note.
 func (c *NoteClient) Update() *NoteUpdate {
 	mutation := newNoteMutation(c.config, OpUpdate)
@@ -1758,6 +2036,17 @@ func (c *NoteClient) UpdateOneID(id schema.NoteID) *NoteUpdateOne {
 	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *NoteClient) UpdateMany(ids []schema.NoteID, setFunc func(*NoteUpdateOne)) *NoteUpdateMany {
+	builders := make([]*NoteUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &NoteUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Note.
 func (c *NoteClient) Delete() *NoteDelete {
 	mutation := newNoteMutation(c.config, OpDelete)
@@ -1890,6 +2179,21 @@ func (c *OtherClient) CreateBulk(builders ...*OtherCreate) *OtherCreateBulk {
 	return &OtherCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OtherClient) MapCreateBulk(slice any, setFunc func(*OtherCreate, int)) *OtherCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OtherCreateBulk{err: fmt.Errorf("calling to OtherClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OtherCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OtherCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Other.
 func (c *OtherClient) Update() *OtherUpdate {
 	mutation := newOtherMutation(c.config, OpUpdate)
@@ -1908,6 +2212,17 @@ func (c *OtherClient) UpdateOneID(id sid.ID) *OtherUpdateOne {
 	return &OtherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *OtherClient) UpdateMany(ids []sid.ID, setFunc func(*OtherUpdateOne)) *OtherUpdateMany {
+	builders := make([]*OtherUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &OtherUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Other.
 func (c *OtherClient) Delete() *OtherDelete {
 	mutation := newOtherMutation(c.config, OpDelete)
@@ -2008,6 +2323,21 @@ func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
 	return &PetCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PetClient) MapCreateBulk(slice any, setFunc func(*PetCreate, int)) *PetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PetCreateBulk{err: fmt.Errorf("calling to PetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Pet.
 func (c *PetClient) Update() *PetUpdate {
 	mutation := newPetMutation(c.config, OpUpdate)
@@ -2026,6 +2356,17 @@ func (c *PetClient) UpdateOneID(id string) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *PetClient) UpdateMany(ids []string, setFunc func(*PetUpdateOne)) *PetUpdateMany {
+	builders := make([]*PetUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &PetUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -2190,6 +2531,21 @@ func (c *RevisionClient) CreateBulk(builders ...*RevisionCreate) *RevisionCreate
 	return &RevisionCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevisionClient) MapCreateBulk(slice any, setFunc func(*RevisionCreate, int)) *RevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevisionCreateBulk{err: fmt.Errorf("calling to RevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevisionCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Revision.
 func (c *RevisionClient) Update() *RevisionUpdate {
 	mutation := newRevisionMutation(c.config, OpUpdate)
@@ -2208,6 +2564,17 @@ func (c *RevisionClient) UpdateOneID(id string) *RevisionUpdateOne {
 	return &RevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *RevisionClient) UpdateMany(ids []string, setFunc func(*RevisionUpdateOne)) *RevisionUpdateMany {
+	builders := make([]*RevisionUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &RevisionUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Revision.
 func (c *RevisionClient) Delete() *RevisionDelete {
 	mutation := newRevisionMutation(c.config, OpDelete)
@@ -2308,6 +2675,21 @@ func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBul
 	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Session.
 func (c *SessionClient) Update() *SessionUpdate {
 	mutation := newSessionMutation(c.config, OpUpdate)
@@ -2326,6 +2708,17 @@ func (c *SessionClient) UpdateOneID(id schema.ID) *SessionUpdateOne {
 	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *SessionClient) UpdateMany(ids []schema.ID, setFunc func(*SessionUpdateOne)) *SessionUpdateMany {
+	builders := make([]*SessionUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &SessionUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Session.
 func (c *SessionClient) Delete() *SessionDelete {
 	mutation := newSessionMutation(c.config, OpDelete)
@@ -2442,6 +2835,21 @@ func (c *TokenClient) CreateBulk(builders ...*TokenCreate) *TokenCreateBulk {
 	return &TokenCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenClient) MapCreateBulk(slice any, setFunc func(*TokenCreate, int)) *TokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenCreateBulk{err: fmt.Errorf("calling to TokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Token.
 func (c *TokenClient) Update() *TokenUpdate {
 	mutation := newTokenMutation(c.config, OpUpdate)
@@ -2460,6 +2868,17 @@ func (c *TokenClient) UpdateOneID(id sid.ID) *TokenUpdateOne {
 	return &TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *TokenClient) UpdateMany(ids []sid.ID, setFunc func(*TokenUpdateOne)) *TokenUpdateMany {
+	builders := make([]*TokenUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &TokenUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Token.
 func (c *TokenClient) Delete() *TokenDelete {
 	mutation := newTokenMutation(c.config, OpDelete)
@@ -2576,6 +2995,21 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -2594,6 +3028,17 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserClient) UpdateMany(ids []int, setFunc func(*UserUpdateOne)) *UserUpdateMany {
+	builders := make([]*UserUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
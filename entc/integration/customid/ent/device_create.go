@@ -119,12 +119,13 @@ func (dc *DeviceCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (dc *DeviceCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := dc.mutation.ID(); ok {
 		if err := device.IDValidator(v[:]); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Device.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Device", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Device.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
@@ -134,7 +135,7 @@ func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
 	_node, _spec := dc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -328,12 +329,16 @@ func (u *DeviceUpsertOne) IDX(ctx context.Context) schema.ID {
 // DeviceCreateBulk is the builder for creating many Device entities in bulk.
 type DeviceCreateBulk struct {
 	config
+	err      error
 	builders []*DeviceCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Device entities in the database.
 func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
 	nodes := make([]*Device, len(dcb.builders))
 	mutators := make([]Mutator, len(dcb.builders))
@@ -360,7 +365,7 @@ func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
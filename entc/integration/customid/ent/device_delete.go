@@ -54,7 +54,7 @@ func (dd *DeviceDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, dd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	dd.mutation.done = true
 	return affected, err
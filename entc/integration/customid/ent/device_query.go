@@ -372,7 +372,7 @@ func (dq *DeviceQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range dq.ctx.Fields {
 		if !device.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Device", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if dq.path != nil {
@@ -448,26 +448,49 @@ func (dq *DeviceQuery) loadActiveSession(ctx context.Context, query *SessionQuer
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(session.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "device_active_session" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], session.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "device_active_session" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (dq *DeviceQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Device, init func(*Device), assign func(*Device, *Session)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := dq.loadSessions(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[schema.ID]*Device)
 	for i := range nodes {
@@ -583,7 +606,8 @@ func (dq *DeviceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // DeviceGroupBy is the group-by builder for Device entities.
 type DeviceGroupBy struct {
 	selector
-	build *DeviceQuery
+	build  *DeviceQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -601,6 +625,14 @@ func (dgb *DeviceGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*DeviceQuery, *DeviceGroupBy](ctx, dgb.build, dgb, dgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (dgb *DeviceGroupBy) Having(ps ...*sql.Predicate) *DeviceGroupBy {
+	dgb.having = append(dgb.having, ps...)
+	return dgb
+}
+
 func (dgb *DeviceGroupBy) sqlScan(ctx context.Context, root *DeviceQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(dgb.fns))
@@ -616,6 +648,9 @@ func (dgb *DeviceGroupBy) sqlScan(ctx context.Context, root *DeviceQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*dgb.flds...)...)
+	if len(dgb.having) > 0 {
+		selector.Having(sql.And(dgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -138,6 +138,11 @@ func TextContainsFold(v string) predicate.Doc {
 	return predicate.Doc(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Doc {
+	return predicate.Doc(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Doc {
 	return predicate.Doc(func(s *sql.Selector) {
@@ -147,12 +147,13 @@ func (dc *DocCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (dc *DocCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := dc.mutation.ID(); ok {
 		if err := doc.IDValidator(string(v)); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Doc.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Doc", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Doc.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (dc *DocCreate) sqlSave(ctx context.Context) (*Doc, error) {
@@ -162,7 +163,7 @@ func (dc *DocCreate) sqlSave(ctx context.Context) (*Doc, error) {
 	_node, _spec := dc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -305,6 +306,12 @@ func (u *DocUpsert) UpdateText() *DocUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *DocUpsert) IgnoreText() *DocUpsert {
+	u.SetIgnore(doc.FieldText)
+	return u
+}
+
 // ClearText clears the value of the "text" field.
 func (u *DocUpsert) ClearText() *DocUpsert {
 	u.SetNull(doc.FieldText)
@@ -373,6 +380,14 @@ func (u *DocUpsertOne) UpdateText() *DocUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *DocUpsertOne) IgnoreText() *DocUpsertOne {
+	return u.Update(func(s *DocUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *DocUpsertOne) ClearText() *DocUpsertOne {
 	return u.Update(func(s *DocUpsert) {
@@ -421,12 +436,16 @@ func (u *DocUpsertOne) IDX(ctx context.Context) schema.DocID {
 // DocCreateBulk is the builder for creating many Doc entities in bulk.
 type DocCreateBulk struct {
 	config
+	err      error
 	builders []*DocCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Doc entities in the database.
 func (dcb *DocCreateBulk) Save(ctx context.Context) ([]*Doc, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
 	nodes := make([]*Doc, len(dcb.builders))
 	mutators := make([]Mutator, len(dcb.builders))
@@ -453,7 +472,7 @@ func (dcb *DocCreateBulk) Save(ctx context.Context) ([]*Doc, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -605,6 +624,14 @@ func (u *DocUpsertBulk) UpdateText() *DocUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *DocUpsertBulk) IgnoreText() *DocUpsertBulk {
+	return u.Update(func(s *DocUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *DocUpsertBulk) ClearText() *DocUpsertBulk {
 	return u.Update(func(s *DocUpsert) {
@@ -428,7 +428,7 @@ func (dq *DocQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range dq.ctx.Fields {
 		if !doc.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Doc", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if dq.path != nil {
@@ -512,26 +512,49 @@ func (dq *DocQuery) loadParent(ctx context.Context, query *DocQuery, nodes []*Do
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(doc.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "doc_children" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], doc.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "doc_children" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (dq *DocQuery) loadChildren(ctx context.Context, query *DocQuery, nodes []*Doc, init func(*Doc), assign func(*Doc, *Doc)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := dq.loadChildren(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[schema.DocID]*Doc)
 	for i := range nodes {
@@ -563,6 +586,22 @@ func (dq *DocQuery) loadChildren(ctx context.Context, query *DocQuery, nodes []*
 	return nil
 }
 func (dq *DocQuery) loadRelated(ctx context.Context, query *DocQuery, nodes []*Doc, init func(*Doc), assign func(*Doc, *Doc)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := dq.loadRelated(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[schema.DocID]*Doc)
 	nids := make(map[schema.DocID]map[*Doc]struct{})
@@ -708,7 +747,8 @@ func (dq *DocQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // DocGroupBy is the group-by builder for Doc entities.
 type DocGroupBy struct {
 	selector
-	build *DocQuery
+	build  *DocQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -726,6 +766,14 @@ func (dgb *DocGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*DocQuery, *DocGroupBy](ctx, dgb.build, dgb, dgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (dgb *DocGroupBy) Having(ps ...*sql.Predicate) *DocGroupBy {
+	dgb.having = append(dgb.having, ps...)
+	return dgb
+}
+
 func (dgb *DocGroupBy) sqlScan(ctx context.Context, root *DocQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(dgb.fns))
@@ -741,6 +789,9 @@ func (dgb *DocGroupBy) sqlScan(ctx context.Context, root *DocQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*dgb.flds...)...)
+	if len(dgb.having) > 0 {
+		selector.Having(sql.And(dgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -100,7 +100,8 @@ func (isc *IntSIDCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (isc *IntSIDCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (isc *IntSIDCreate) sqlSave(ctx context.Context) (*IntSID, error) {
@@ -110,7 +111,7 @@ func (isc *IntSIDCreate) sqlSave(ctx context.Context) (*IntSID, error) {
 	_node, _spec := isc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, isc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -299,12 +300,16 @@ func (u *IntSIDUpsertOne) IDX(ctx context.Context) sid.ID {
 // IntSIDCreateBulk is the builder for creating many IntSID entities in bulk.
 type IntSIDCreateBulk struct {
 	config
+	err      error
 	builders []*IntSIDCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the IntSID entities in the database.
 func (iscb *IntSIDCreateBulk) Save(ctx context.Context) ([]*IntSID, error) {
+	if iscb.err != nil {
+		return nil, iscb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(iscb.builders))
 	nodes := make([]*IntSID, len(iscb.builders))
 	mutators := make([]Mutator, len(iscb.builders))
@@ -330,7 +335,7 @@ func (iscb *IntSIDCreateBulk) Save(ctx context.Context) ([]*IntSID, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, iscb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
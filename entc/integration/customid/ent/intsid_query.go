@@ -371,7 +371,7 @@ func (isq *IntSIDQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range isq.ctx.Fields {
 		if !intsid.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "IntSID", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if isq.path != nil {
@@ -447,26 +447,49 @@ func (isq *IntSIDQuery) loadParent(ctx context.Context, query *IntSIDQuery, node
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(intsid.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "int_sid_parent" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], intsid.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "int_sid_parent" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (isq *IntSIDQuery) loadChildren(ctx context.Context, query *IntSIDQuery, nodes []*IntSID, init func(*IntSID), assign func(*IntSID, *IntSID)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := isq.loadChildren(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[sid.ID]*IntSID)
 	for i := range nodes {
@@ -582,7 +605,8 @@ func (isq *IntSIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // IntSIDGroupBy is the group-by builder for IntSID entities.
 type IntSIDGroupBy struct {
 	selector
-	build *IntSIDQuery
+	build  *IntSIDQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -600,6 +624,14 @@ func (isgb *IntSIDGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*IntSIDQuery, *IntSIDGroupBy](ctx, isgb.build, isgb, isgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (isgb *IntSIDGroupBy) Having(ps ...*sql.Predicate) *IntSIDGroupBy {
+	isgb.having = append(isgb.having, ps...)
+	return isgb
+}
+
 func (isgb *IntSIDGroupBy) sqlScan(ctx context.Context, root *IntSIDQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(isgb.fns))
@@ -615,6 +647,9 @@ func (isgb *IntSIDGroupBy) sqlScan(ctx context.Context, root *IntSIDQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*isgb.flds...)...)
+	if len(isgb.having) > 0 {
+		selector.Having(sql.And(isgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
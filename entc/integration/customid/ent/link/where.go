@@ -8,6 +8,7 @@ package link
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/customid/ent/predicate"
 	uuidc "entgo.io/ent/entc/integration/customid/uuidcompatible"
 )
@@ -57,6 +58,30 @@ func IDLTE(id uuidc.UUIDC) predicate.Link {
 	return predicate.Link(sql.FieldLTE(FieldID, id))
 }
 
+// LinkInformationHasKey applies the HasKey predicate on the "link_information" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func LinkInformationHasKey(path ...string) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldLinkInformation), sqljson.Path(path...)))
+	})
+}
+
+// LinkInformationValueEQ checks that the value at the given path of the
+// "link_information" JSON field is equal to v.
+func LinkInformationValueEQ(v any, path ...string) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldLinkInformation), v, sqljson.Path(path...)))
+	})
+}
+
+// LinkInformationContains checks that the value at the given path of the "link_information"
+// JSON field contains v. e.g. an array that contains the element v.
+func LinkInformationContains(v any, path ...string) predicate.Link {
+	return predicate.Link(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldLinkInformation), v, sqljson.Path(path...)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Link) predicate.Link {
 	return predicate.Link(func(s *sql.Selector) {
@@ -95,10 +95,11 @@ func (lc *LinkCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (lc *LinkCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := lc.mutation.LinkInformation(); !ok {
-		return &ValidationError{Name: "link_information", err: errors.New(`ent: missing required field "Link.link_information"`)}
+		errs = append(errs, &ValidationError{Name: "link_information", Entity: "Link", Field: "link_information", Reason: "missing required field", err: errors.New(`ent: missing required field "Link.link_information"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (lc *LinkCreate) sqlSave(ctx context.Context) (*Link, error) {
@@ -108,7 +109,7 @@ func (lc *LinkCreate) sqlSave(ctx context.Context) (*Link, error) {
 	_node, _spec := lc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -202,6 +203,12 @@ func (u *LinkUpsert) UpdateLinkInformation() *LinkUpsert {
 	return u
 }
 
+// IgnoreLinkInformation keeps the current value of the "link_information" field in case of conflict.
+func (u *LinkUpsert) IgnoreLinkInformation() *LinkUpsert {
+	u.SetIgnore(link.FieldLinkInformation)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -264,6 +271,14 @@ func (u *LinkUpsertOne) UpdateLinkInformation() *LinkUpsertOne {
 	})
 }
 
+// IgnoreLinkInformation keeps the current value of the "link_information" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *LinkUpsertOne) IgnoreLinkInformation() *LinkUpsertOne {
+	return u.Update(func(s *LinkUpsert) {
+		s.IgnoreLinkInformation()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -305,12 +320,16 @@ func (u *LinkUpsertOne) IDX(ctx context.Context) uuidc.UUIDC {
 // LinkCreateBulk is the builder for creating many Link entities in bulk.
 type LinkCreateBulk struct {
 	config
+	err      error
 	builders []*LinkCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Link entities in the database.
 func (lcb *LinkCreateBulk) Save(ctx context.Context) ([]*Link, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
 	nodes := make([]*Link, len(lcb.builders))
 	mutators := make([]Mutator, len(lcb.builders))
@@ -337,7 +356,7 @@ func (lcb *LinkCreateBulk) Save(ctx context.Context) ([]*Link, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -489,6 +508,14 @@ func (u *LinkUpsertBulk) UpdateLinkInformation() *LinkUpsertBulk {
 	})
 }
 
+// IgnoreLinkInformation keeps the current value of the "link_information" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *LinkUpsertBulk) IgnoreLinkInformation() *LinkUpsertBulk {
+	return u.Update(func(s *LinkUpsert) {
+		s.IgnoreLinkInformation()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
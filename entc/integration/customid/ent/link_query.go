@@ -321,7 +321,7 @@ func (lq *LinkQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range lq.ctx.Fields {
 		if !link.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Link", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if lq.path != nil {
@@ -443,7 +443,8 @@ func (lq *LinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // LinkGroupBy is the group-by builder for Link entities.
 type LinkGroupBy struct {
 	selector
-	build *LinkQuery
+	build  *LinkQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -461,6 +462,14 @@ func (lgb *LinkGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*LinkQuery, *LinkGroupBy](ctx, lgb.build, lgb, lgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (lgb *LinkGroupBy) Having(ps ...*sql.Predicate) *LinkGroupBy {
+	lgb.having = append(lgb.having, ps...)
+	return lgb
+}
+
 func (lgb *LinkGroupBy) sqlScan(ctx context.Context, root *LinkQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(lgb.fns))
@@ -476,6 +485,9 @@ func (lgb *LinkGroupBy) sqlScan(ctx context.Context, root *LinkQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*lgb.flds...)...)
+	if len(lgb.having) > 0 {
+		selector.Having(sql.And(lgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
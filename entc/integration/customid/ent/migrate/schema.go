@@ -404,6 +404,21 @@ var (
 			},
 		},
 	}
+	// EntOutboxColumns holds the columns for the "ent_outbox" table.
+	EntOutboxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "op", Type: field.TypeString},
+		{Name: "ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EntOutboxTable holds the schema information for the "ent_outbox" table.
+	EntOutboxTable = &schema.Table{
+		Name:       "ent_outbox",
+		Columns:    EntOutboxColumns,
+		PrimaryKey: []*schema.Column{EntOutboxColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
@@ -426,6 +441,7 @@ var (
 		DocRelatedTable,
 		GroupUsersTable,
 		PetFriendsTable,
+		EntOutboxTable,
 	}
 )
 
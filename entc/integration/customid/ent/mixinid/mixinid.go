@@ -7,6 +7,8 @@
 package mixinid
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
@@ -63,3 +65,72 @@ func BySomeField(opts ...sql.OrderTermOption) OrderOption {
 func ByMixinField(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMixinField, opts...).ToFunc()
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that MixinID queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that MixinID queries can be ordered by.
+const (
+	OrderFieldID         OrderField = "id"
+	OrderFieldSomeField  OrderField = "some_field"
+	OrderFieldMixinField OrderField = "mixin_field"
+)
+
+// OrderFields holds all fields and edge counts that MixinID queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldSomeField,
+	OrderFieldMixinField,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if MixinID queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("mixinid: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := mixinid.OrderByField(mixinid.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.MixinID.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldSomeField:
+		return BySomeField(opts...), nil
+	case OrderFieldMixinField:
+		return ByMixinField(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
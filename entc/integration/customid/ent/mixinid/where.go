@@ -132,6 +132,11 @@ func SomeFieldContainsFold(v string) predicate.MixinID {
 	return predicate.MixinID(sql.FieldContainsFold(FieldSomeField, v))
 }
 
+// SomeFieldHasPrefixFold applies the HasPrefixFold predicate on the "some_field" field.
+func SomeFieldHasPrefixFold(v string) predicate.MixinID {
+	return predicate.MixinID(sql.FieldHasPrefixFold(FieldSomeField, v))
+}
+
 // MixinFieldEQ applies the EQ predicate on the "mixin_field" field.
 func MixinFieldEQ(v string) predicate.MixinID {
 	return predicate.MixinID(sql.FieldEQ(FieldMixinField, v))
@@ -197,6 +202,11 @@ func MixinFieldContainsFold(v string) predicate.MixinID {
 	return predicate.MixinID(sql.FieldContainsFold(FieldMixinField, v))
 }
 
+// MixinFieldHasPrefixFold applies the HasPrefixFold predicate on the "mixin_field" field.
+func MixinFieldHasPrefixFold(v string) predicate.MixinID {
+	return predicate.MixinID(sql.FieldHasPrefixFold(FieldMixinField, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.MixinID) predicate.MixinID {
 	return predicate.MixinID(func(s *sql.Selector) {
@@ -96,13 +96,14 @@ func (mic *MixinIDCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (mic *MixinIDCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := mic.mutation.SomeField(); !ok {
-		return &ValidationError{Name: "some_field", err: errors.New(`ent: missing required field "MixinID.some_field"`)}
+		errs = append(errs, &ValidationError{Name: "some_field", Entity: "MixinID", Field: "some_field", Reason: "missing required field", err: errors.New(`ent: missing required field "MixinID.some_field"`)})
 	}
 	if _, ok := mic.mutation.MixinField(); !ok {
-		return &ValidationError{Name: "mixin_field", err: errors.New(`ent: missing required field "MixinID.mixin_field"`)}
+		errs = append(errs, &ValidationError{Name: "mixin_field", Entity: "MixinID", Field: "mixin_field", Reason: "missing required field", err: errors.New(`ent: missing required field "MixinID.mixin_field"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (mic *MixinIDCreate) sqlSave(ctx context.Context) (*MixinID, error) {
@@ -112,7 +113,7 @@ func (mic *MixinIDCreate) sqlSave(ctx context.Context) (*MixinID, error) {
 	_node, _spec := mic.createSpec()
 	if err := sqlgraph.CreateNode(ctx, mic.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -210,6 +211,12 @@ func (u *MixinIDUpsert) UpdateSomeField() *MixinIDUpsert {
 	return u
 }
 
+// IgnoreSomeField keeps the current value of the "some_field" field in case of conflict.
+func (u *MixinIDUpsert) IgnoreSomeField() *MixinIDUpsert {
+	u.SetIgnore(mixinid.FieldSomeField)
+	return u
+}
+
 // SetMixinField sets the "mixin_field" field.
 func (u *MixinIDUpsert) SetMixinField(v string) *MixinIDUpsert {
 	u.Set(mixinid.FieldMixinField, v)
@@ -222,6 +229,12 @@ func (u *MixinIDUpsert) UpdateMixinField() *MixinIDUpsert {
 	return u
 }
 
+// IgnoreMixinField keeps the current value of the "mixin_field" field in case of conflict.
+func (u *MixinIDUpsert) IgnoreMixinField() *MixinIDUpsert {
+	u.SetIgnore(mixinid.FieldMixinField)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -284,6 +297,14 @@ func (u *MixinIDUpsertOne) UpdateSomeField() *MixinIDUpsertOne {
 	})
 }
 
+// IgnoreSomeField keeps the current value of the "some_field" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *MixinIDUpsertOne) IgnoreSomeField() *MixinIDUpsertOne {
+	return u.Update(func(s *MixinIDUpsert) {
+		s.IgnoreSomeField()
+	})
+}
+
 // SetMixinField sets the "mixin_field" field.
 func (u *MixinIDUpsertOne) SetMixinField(v string) *MixinIDUpsertOne {
 	return u.Update(func(s *MixinIDUpsert) {
@@ -298,6 +319,14 @@ func (u *MixinIDUpsertOne) UpdateMixinField() *MixinIDUpsertOne {
 	})
 }
 
+// IgnoreMixinField keeps the current value of the "mixin_field" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *MixinIDUpsertOne) IgnoreMixinField() *MixinIDUpsertOne {
+	return u.Update(func(s *MixinIDUpsert) {
+		s.IgnoreMixinField()
+	})
+}
+
 // Exec executes the query.
 func (u *MixinIDUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -339,12 +368,16 @@ func (u *MixinIDUpsertOne) IDX(ctx context.Context) uuid.UUID {
 // MixinIDCreateBulk is the builder for creating many MixinID entities in bulk.
 type MixinIDCreateBulk struct {
 	config
+	err      error
 	builders []*MixinIDCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the MixinID entities in the database.
 func (micb *MixinIDCreateBulk) Save(ctx context.Context) ([]*MixinID, error) {
+	if micb.err != nil {
+		return nil, micb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(micb.builders))
 	nodes := make([]*MixinID, len(micb.builders))
 	mutators := make([]Mutator, len(micb.builders))
@@ -371,7 +404,7 @@ func (micb *MixinIDCreateBulk) Save(ctx context.Context) ([]*MixinID, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, micb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -523,6 +556,14 @@ func (u *MixinIDUpsertBulk) UpdateSomeField() *MixinIDUpsertBulk {
 	})
 }
 
+// IgnoreSomeField keeps the current value of the "some_field" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *MixinIDUpsertBulk) IgnoreSomeField() *MixinIDUpsertBulk {
+	return u.Update(func(s *MixinIDUpsert) {
+		s.IgnoreSomeField()
+	})
+}
+
 // SetMixinField sets the "mixin_field" field.
 func (u *MixinIDUpsertBulk) SetMixinField(v string) *MixinIDUpsertBulk {
 	return u.Update(func(s *MixinIDUpsert) {
@@ -537,6 +578,14 @@ func (u *MixinIDUpsertBulk) UpdateMixinField() *MixinIDUpsertBulk {
 	})
 }
 
+// IgnoreMixinField keeps the current value of the "mixin_field" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *MixinIDUpsertBulk) IgnoreMixinField() *MixinIDUpsertBulk {
+	return u.Update(func(s *MixinIDUpsert) {
+		s.IgnoreMixinField()
+	})
+}
+
 // Exec executes the query.
 func (u *MixinIDUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -321,7 +321,7 @@ func (miq *MixinIDQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range miq.ctx.Fields {
 		if !mixinid.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "MixinID", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if miq.path != nil {
@@ -443,7 +443,8 @@ func (miq *MixinIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // MixinIDGroupBy is the group-by builder for MixinID entities.
 type MixinIDGroupBy struct {
 	selector
-	build *MixinIDQuery
+	build  *MixinIDQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -461,6 +462,14 @@ func (migb *MixinIDGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*MixinIDQuery, *MixinIDGroupBy](ctx, migb.build, migb, migb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (migb *MixinIDGroupBy) Having(ps ...*sql.Predicate) *MixinIDGroupBy {
+	migb.having = append(migb.having, ps...)
+	return migb
+}
+
 func (migb *MixinIDGroupBy) sqlScan(ctx context.Context, root *MixinIDQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(migb.fns))
@@ -476,6 +485,9 @@ func (migb *MixinIDGroupBy) sqlScan(ctx context.Context, root *MixinIDQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*migb.flds...)...)
+	if len(migb.having) > 0 {
+		selector.Having(sql.And(migb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -138,6 +138,11 @@ func TextContainsFold(v string) predicate.Note {
 	return predicate.Note(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Note {
+	return predicate.Note(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Note {
 	return predicate.Note(func(s *sql.Selector) {
@@ -132,12 +132,13 @@ func (nc *NoteCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (nc *NoteCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := nc.mutation.ID(); ok {
 		if err := note.IDValidator(string(v)); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Note.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Note", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Note.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (nc *NoteCreate) sqlSave(ctx context.Context) (*Note, error) {
@@ -147,7 +148,7 @@ func (nc *NoteCreate) sqlSave(ctx context.Context) (*Note, error) {
 	_node, _spec := nc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -274,6 +275,12 @@ func (u *NoteUpsert) UpdateText() *NoteUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *NoteUpsert) IgnoreText() *NoteUpsert {
+	u.SetIgnore(note.FieldText)
+	return u
+}
+
 // ClearText clears the value of the "text" field.
 func (u *NoteUpsert) ClearText() *NoteUpsert {
 	u.SetNull(note.FieldText)
@@ -342,6 +349,14 @@ func (u *NoteUpsertOne) UpdateText() *NoteUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *NoteUpsertOne) IgnoreText() *NoteUpsertOne {
+	return u.Update(func(s *NoteUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *NoteUpsertOne) ClearText() *NoteUpsertOne {
 	return u.Update(func(s *NoteUpsert) {
@@ -390,12 +405,16 @@ func (u *NoteUpsertOne) IDX(ctx context.Context) schema.NoteID {
 // NoteCreateBulk is the builder for creating many Note entities in bulk.
 type NoteCreateBulk struct {
 	config
+	err      error
 	builders []*NoteCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Note entities in the database.
 func (ncb *NoteCreateBulk) Save(ctx context.Context) ([]*Note, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
 	nodes := make([]*Note, len(ncb.builders))
 	mutators := make([]Mutator, len(ncb.builders))
@@ -422,7 +441,7 @@ func (ncb *NoteCreateBulk) Save(ctx context.Context) ([]*Note, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -574,6 +593,14 @@ func (u *NoteUpsertBulk) UpdateText() *NoteUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *NoteUpsertBulk) IgnoreText() *NoteUpsertBulk {
+	return u.Update(func(s *NoteUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *NoteUpsertBulk) ClearText() *NoteUpsertBulk {
 	return u.Update(func(s *NoteUpsert) {
@@ -393,7 +393,7 @@ func (nq *NoteQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range nq.ctx.Fields {
 		if !note.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Note", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if nq.path != nil {
@@ -469,26 +469,49 @@ func (nq *NoteQuery) loadParent(ctx context.Context, query *NoteQuery, nodes []*
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(note.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "note_children" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], note.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "note_children" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (nq *NoteQuery) loadChildren(ctx context.Context, query *NoteQuery, nodes []*Note, init func(*Note), assign func(*Note, *Note)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := nq.loadChildren(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[schema.NoteID]*Note)
 	for i := range nodes {
@@ -604,7 +627,8 @@ func (nq *NoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // NoteGroupBy is the group-by builder for Note entities.
 type NoteGroupBy struct {
 	selector
-	build *NoteQuery
+	build  *NoteQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -622,6 +646,14 @@ func (ngb *NoteGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*NoteQuery, *NoteGroupBy](ctx, ngb.build, ngb, ngb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ngb *NoteGroupBy) Having(ps ...*sql.Predicate) *NoteGroupBy {
+	ngb.having = append(ngb.having, ps...)
+	return ngb
+}
+
 func (ngb *NoteGroupBy) sqlScan(ctx context.Context, root *NoteQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ngb.fns))
@@ -637,6 +669,9 @@ func (ngb *NoteGroupBy) sqlScan(ctx context.Context, root *NoteQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ngb.flds...)...)
+	if len(ngb.having) > 0 {
+		selector.Having(sql.And(ngb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -84,7 +84,8 @@ func (oc *OtherCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (oc *OtherCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (oc *OtherCreate) sqlSave(ctx context.Context) (*Other, error) {
@@ -94,7 +95,7 @@ func (oc *OtherCreate) sqlSave(ctx context.Context) (*Other, error) {
 	_node, _spec := oc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -255,12 +256,16 @@ func (u *OtherUpsertOne) IDX(ctx context.Context) sid.ID {
 // OtherCreateBulk is the builder for creating many Other entities in bulk.
 type OtherCreateBulk struct {
 	config
+	err      error
 	builders []*OtherCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Other entities in the database.
 func (ocb *OtherCreateBulk) Save(ctx context.Context) ([]*Other, error) {
+	if ocb.err != nil {
+		return nil, ocb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
 	nodes := make([]*Other, len(ocb.builders))
 	mutators := make([]Mutator, len(ocb.builders))
@@ -287,7 +292,7 @@ func (ocb *OtherCreateBulk) Save(ctx context.Context) ([]*Other, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -299,7 +299,7 @@ func (oq *OtherQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range oq.ctx.Fields {
 		if !other.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Other", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if oq.path != nil {
@@ -421,7 +421,8 @@ func (oq *OtherQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // OtherGroupBy is the group-by builder for Other entities.
 type OtherGroupBy struct {
 	selector
-	build *OtherQuery
+	build  *OtherQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -439,6 +440,14 @@ func (ogb *OtherGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*OtherQuery, *OtherGroupBy](ctx, ogb.build, ogb, ogb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ogb *OtherGroupBy) Having(ps ...*sql.Predicate) *OtherGroupBy {
+	ogb.having = append(ogb.having, ps...)
+	return ogb
+}
+
 func (ogb *OtherGroupBy) sqlScan(ctx context.Context, root *OtherQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ogb.fns))
@@ -454,6 +463,9 @@ func (ogb *OtherGroupBy) sqlScan(ctx context.Context, root *OtherQuery, v any) e
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ogb.flds...)...)
+	if len(ogb.having) > 0 {
+		selector.Having(sql.And(ogb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
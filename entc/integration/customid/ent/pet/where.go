@@ -67,6 +67,11 @@ func IDContainsFold(id string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldID, id))
 }
 
+// IDHasPrefixFold applies the HasPrefixFold predicate on the ID field.
+func IDHasPrefixFold(id string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefixFold(FieldID, id))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
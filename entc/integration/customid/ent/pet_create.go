@@ -153,12 +153,13 @@ func (pc *PetCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PetCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := pc.mutation.ID(); ok {
 		if err := pet.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Pet.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Pet", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Pet.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
@@ -168,7 +169,7 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 	_node, _spec := pc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -395,12 +396,16 @@ func (u *PetUpsertOne) IDX(ctx context.Context) string {
 // PetCreateBulk is the builder for creating many Pet entities in bulk.
 type PetCreateBulk struct {
 	config
+	err      error
 	builders []*PetCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Pet entities in the database.
 func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Pet, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
@@ -427,7 +432,7 @@ func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -66,6 +66,11 @@ func IDContainsFold(id string) predicate.Revision {
 	return predicate.Revision(sql.FieldContainsFold(FieldID, id))
 }
 
+// IDHasPrefixFold applies the HasPrefixFold predicate on the ID field.
+func IDHasPrefixFold(id string) predicate.Revision {
+	return predicate.Revision(sql.FieldHasPrefixFold(FieldID, id))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Revision) predicate.Revision {
 	return predicate.Revision(func(s *sql.Selector) {
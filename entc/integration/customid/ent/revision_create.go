@@ -66,7 +66,8 @@ func (rc *RevisionCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (rc *RevisionCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (rc *RevisionCreate) sqlSave(ctx context.Context) (*Revision, error) {
@@ -76,7 +77,7 @@ func (rc *RevisionCreate) sqlSave(ctx context.Context) (*Revision, error) {
 	_node, _spec := rc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -237,12 +238,16 @@ func (u *RevisionUpsertOne) IDX(ctx context.Context) string {
 // RevisionCreateBulk is the builder for creating many Revision entities in bulk.
 type RevisionCreateBulk struct {
 	config
+	err      error
 	builders []*RevisionCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Revision entities in the database.
 func (rcb *RevisionCreateBulk) Save(ctx context.Context) ([]*Revision, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
 	nodes := make([]*Revision, len(rcb.builders))
 	mutators := make([]Mutator, len(rcb.builders))
@@ -268,7 +273,7 @@ func (rcb *RevisionCreateBulk) Save(ctx context.Context) ([]*Revision, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -298,7 +298,7 @@ func (rq *RevisionQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range rq.ctx.Fields {
 		if !revision.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Revision", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if rq.path != nil {
@@ -420,7 +420,8 @@ func (rq *RevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // RevisionGroupBy is the group-by builder for Revision entities.
 type RevisionGroupBy struct {
 	selector
-	build *RevisionQuery
+	build  *RevisionQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -438,6 +439,14 @@ func (rgb *RevisionGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*RevisionQuery, *RevisionGroupBy](ctx, rgb.build, rgb, rgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (rgb *RevisionGroupBy) Having(ps ...*sql.Predicate) *RevisionGroupBy {
+	rgb.having = append(rgb.having, ps...)
+	return rgb
+}
+
 func (rgb *RevisionGroupBy) sqlScan(ctx context.Context, root *RevisionQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(rgb.fns))
@@ -453,6 +462,9 @@ func (rgb *RevisionGroupBy) sqlScan(ctx context.Context, root *RevisionQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*rgb.flds...)...)
+	if len(rgb.having) > 0 {
+		selector.Having(sql.And(rgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -104,12 +104,13 @@ func (sc *SessionCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (sc *SessionCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := sc.mutation.ID(); ok {
 		if err := session.IDValidator(v[:]); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Session.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Session", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Session.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (sc *SessionCreate) sqlSave(ctx context.Context) (*Session, error) {
@@ -119,7 +120,7 @@ func (sc *SessionCreate) sqlSave(ctx context.Context) (*Session, error) {
 	_node, _spec := sc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -297,12 +298,16 @@ func (u *SessionUpsertOne) IDX(ctx context.Context) schema.ID {
 // SessionCreateBulk is the builder for creating many Session entities in bulk.
 type SessionCreateBulk struct {
 	config
+	err      error
 	builders []*SessionCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Session entities in the database.
 func (scb *SessionCreateBulk) Save(ctx context.Context) ([]*Session, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
 	nodes := make([]*Session, len(scb.builders))
 	mutators := make([]Mutator, len(scb.builders))
@@ -329,7 +334,7 @@ func (scb *SessionCreateBulk) Save(ctx context.Context) ([]*Session, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -336,7 +336,7 @@ func (sq *SessionQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range sq.ctx.Fields {
 		if !session.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Session", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if sq.path != nil {
@@ -404,23 +404,30 @@ func (sq *SessionQuery) loadDevice(ctx context.Context, query *DeviceQuery, node
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(device.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "device_sessions" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], device.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "device_sessions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -508,7 +515,8 @@ func (sq *SessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // SessionGroupBy is the group-by builder for Session entities.
 type SessionGroupBy struct {
 	selector
-	build *SessionQuery
+	build  *SessionQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -526,6 +534,14 @@ func (sgb *SessionGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*SessionQuery, *SessionGroupBy](ctx, sgb.build, sgb, sgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (sgb *SessionGroupBy) Having(ps ...*sql.Predicate) *SessionGroupBy {
+	sgb.having = append(sgb.having, ps...)
+	return sgb
+}
+
 func (sgb *SessionGroupBy) sqlScan(ctx context.Context, root *SessionQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(sgb.fns))
@@ -541,6 +557,9 @@ func (sgb *SessionGroupBy) sqlScan(ctx context.Context, root *SessionQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*sgb.flds...)...)
+	if len(sgb.having) > 0 {
+		selector.Having(sql.And(sgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
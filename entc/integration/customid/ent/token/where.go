@@ -128,6 +128,11 @@ func BodyContainsFold(v string) predicate.Token {
 	return predicate.Token(sql.FieldContainsFold(FieldBody, v))
 }
 
+// BodyHasPrefixFold applies the HasPrefixFold predicate on the "body" field.
+func BodyHasPrefixFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasPrefixFold(FieldBody, v))
+}
+
 // HasAccount applies the HasEdge predicate on the "account" edge.
 func HasAccount() predicate.Token {
 	return predicate.Token(func(s *sql.Selector) {
@@ -102,18 +102,19 @@ func (tc *TokenCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TokenCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Body(); !ok {
-		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Token.body"`)}
+		errs = append(errs, &ValidationError{Name: "body", Entity: "Token", Field: "body", Reason: "missing required field", err: errors.New(`ent: missing required field "Token.body"`)})
 	}
 	if v, ok := tc.mutation.Body(); ok {
 		if err := token.BodyValidator(v); err != nil {
-			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "body", Entity: "Token", Field: "body", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)})
 		}
 	}
 	if _, ok := tc.mutation.AccountID(); !ok {
-		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Token.account"`)}
+		errs = append(errs, &ValidationError{Name: "account", Entity: "Token", Field: "account", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Token.account"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TokenCreate) sqlSave(ctx context.Context) (*Token, error) {
@@ -123,7 +124,7 @@ func (tc *TokenCreate) sqlSave(ctx context.Context) (*Token, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -234,6 +235,12 @@ func (u *TokenUpsert) UpdateBody() *TokenUpsert {
 	return u
 }
 
+// IgnoreBody keeps the current value of the "body" field in case of conflict.
+func (u *TokenUpsert) IgnoreBody() *TokenUpsert {
+	u.SetIgnore(token.FieldBody)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -296,6 +303,14 @@ func (u *TokenUpsertOne) UpdateBody() *TokenUpsertOne {
 	})
 }
 
+// IgnoreBody keeps the current value of the "body" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TokenUpsertOne) IgnoreBody() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.IgnoreBody()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -337,12 +352,16 @@ func (u *TokenUpsertOne) IDX(ctx context.Context) sid.ID {
 // TokenCreateBulk is the builder for creating many Token entities in bulk.
 type TokenCreateBulk struct {
 	config
+	err      error
 	builders []*TokenCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Token entities in the database.
 func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Token, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -369,7 +388,7 @@ func (tcb *TokenCreateBulk) Save(ctx context.Context) ([]*Token, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -521,6 +540,14 @@ func (u *TokenUpsertBulk) UpdateBody() *TokenUpsertBulk {
 	})
 }
 
+// IgnoreBody keeps the current value of the "body" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TokenUpsertBulk) IgnoreBody() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.IgnoreBody()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -358,7 +358,7 @@ func (tq *TokenQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range tq.ctx.Fields {
 		if !token.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Token", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if tq.path != nil {
@@ -426,23 +426,30 @@ func (tq *TokenQuery) loadAccount(ctx context.Context, query *AccountQuery, node
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(account.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "account_token" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], account.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "account_token" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -530,7 +537,8 @@ func (tq *TokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // TokenGroupBy is the group-by builder for Token entities.
 type TokenGroupBy struct {
 	selector
-	build *TokenQuery
+	build  *TokenQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -548,6 +556,14 @@ func (tgb *TokenGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*TokenQuery, *TokenGroupBy](ctx, tgb.build, tgb, tgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (tgb *TokenGroupBy) Having(ps ...*sql.Predicate) *TokenGroupBy {
+	tgb.having = append(tgb.having, ps...)
+	return tgb
+}
+
 func (tgb *TokenGroupBy) sqlScan(ctx context.Context, root *TokenQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(tgb.fns))
@@ -563,6 +579,9 @@ func (tgb *TokenGroupBy) sqlScan(ctx context.Context, root *TokenQuery, v any) e
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*tgb.flds...)...)
+	if len(tgb.having) > 0 {
+		selector.Having(sql.And(tgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
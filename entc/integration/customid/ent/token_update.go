@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -90,15 +91,16 @@ func (tu *TokenUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tu *TokenUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := tu.mutation.Body(); ok {
 		if err := token.BodyValidator(v); err != nil {
-			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "body", Entity: "Token", Field: "body", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)})
 		}
 	}
 	if _, ok := tu.mutation.AccountID(); tu.mutation.AccountCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Token.account"`)
+		errs = append(errs, &ValidationError{Name: "account", Entity: "Token", Field: "account", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Token.account"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -149,7 +151,7 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -235,15 +237,16 @@ func (tuo *TokenUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tuo *TokenUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := tuo.mutation.Body(); ok {
 		if err := token.BodyValidator(v); err != nil {
-			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "body", Entity: "Token", Field: "body", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Token.body": %w`, err)})
 		}
 	}
 	if _, ok := tuo.mutation.AccountID(); tuo.mutation.AccountCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Token.account"`)
+		errs = append(errs, &ValidationError{Name: "account", Entity: "Token", Field: "account", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Token.account"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error) {
@@ -253,7 +256,7 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 	_spec := sqlgraph.NewUpdateSpec(token.Table, token.Columns, sqlgraph.NewFieldSpec(token.FieldID, field.TypeOther))
 	id, ok := tuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Token.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Token", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Token.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := tuo.fields; len(fields) > 0 {
@@ -261,7 +264,7 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		_spec.Node.Columns = append(_spec.Node.Columns, token.FieldID)
 		for _, f := range fields {
 			if !token.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Token", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != token.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -314,10 +317,69 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	tuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (tuo *TokenUpdateOne) sameUpdate(other *TokenUpdateOne) bool {
+	m1, m2 := *tuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// TokenUpdateMany is the builder for updating many Token entities by their ids.
+type TokenUpdateMany struct {
+	config
+	builders []*TokenUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (tum *TokenUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*TokenUpdateOne
+Builders:
+	for _, b := range tum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*TokenUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]sid.ID, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(token.IDIn(ids...))
+		if err := (&TokenUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tum *TokenUpdateMany) ExecX(ctx context.Context) {
+	if err := tum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -138,6 +138,11 @@ func NumberContainsFold(v string) predicate.Car {
 	return predicate.Car(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefixFold(FieldNumber, v))
+}
+
 // HasRentals applies the HasEdge predicate on the "rentals" edge.
 func HasRentals() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -359,7 +359,7 @@ func (cq *CarQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range cq.ctx.Fields {
 		if !car.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Car", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if cq.path != nil {
@@ -416,6 +416,22 @@ func (cq *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 }
 
 func (cq *CarQuery) loadRentals(ctx context.Context, query *RentalQuery, nodes []*Car, init func(*Car), assign func(*Car, *Rental)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := cq.loadRentals(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Car)
 	for i := range nodes {
@@ -544,7 +560,8 @@ func (cq *CarQuery) WithNamedRentals(name string, opts ...func(*RentalQuery)) *C
 // CarGroupBy is the group-by builder for Car entities.
 type CarGroupBy struct {
 	selector
-	build *CarQuery
+	build  *CarQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -562,6 +579,14 @@ func (cgb *CarGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*CarQuery, *CarGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (cgb *CarGroupBy) Having(ps ...*sql.Predicate) *CarGroupBy {
+	cgb.having = append(cgb.having, ps...)
+	return cgb
+}
+
 func (cgb *CarGroupBy) sqlScan(ctx context.Context, root *CarQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(cgb.fns))
@@ -577,6 +602,9 @@ func (cgb *CarGroupBy) sqlScan(ctx context.Context, root *CarQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if len(cgb.having) > 0 {
+		selector.Having(sql.And(cgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
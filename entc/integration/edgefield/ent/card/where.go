@@ -142,6 +142,11 @@ func NumberContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldNumber, v))
+}
+
 // OwnerIDEQ applies the EQ predicate on the "owner_id" field.
 func OwnerIDEQ(v int) predicate.Card {
 	return predicate.Card(sql.FieldEQ(FieldOwnerID, v))
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/edgefield/ent/migrate"
@@ -169,14 +170,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}, nil
 }
 
-// BeginTx returns a transactional client with specified options.
+// BeginTx returns a transactional client with specified options. For example,
+// starting a read-only transaction with the REPEATABLE READ isolation level:
+//
+//	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(interface {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = c.driver.Tx(ctx)
+	} else {
+		err = errors.New("driver does not support transaction options")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
@@ -298,6 +312,21 @@ func (c *CarClient) CreateBulk(builders ...*CarCreate) *CarCreateBulk {
 	return &CarCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CarClient) MapCreateBulk(slice any, setFunc func(*CarCreate, int)) *CarCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CarCreateBulk{err: fmt.Errorf("calling to CarClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CarCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CarCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Car.
 func (c *CarClient) Update() *CarUpdate {
 	mutation := newCarMutation(c.config, OpUpdate)
@@ -316,6 +345,17 @@ func (c *CarClient) UpdateOneID(id uuid.UUID) *CarUpdateOne {
 	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *CarClient) UpdateMany(ids []uuid.UUID, setFunc func(*CarUpdateOne)) *CarUpdateMany {
+	builders := make([]*CarUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &CarUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Car.
 func (c *CarClient) Delete() *CarDelete {
 	mutation := newCarMutation(c.config, OpDelete)
@@ -432,6 +472,21 @@ func (c *CardClient) CreateBulk(builders ...*CardCreate) *CardCreateBulk {
 	return &CardCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CardClient) MapCreateBulk(slice any, setFunc func(*CardCreate, int)) *CardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CardCreateBulk{err: fmt.Errorf("calling to CardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CardCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Card.
 func (c *CardClient) Update() *CardUpdate {
 	mutation := newCardMutation(c.config, OpUpdate)
@@ -450,6 +505,17 @@ func (c *CardClient) UpdateOneID(id int) *CardUpdateOne {
 	return &CardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *CardClient) UpdateMany(ids []int, setFunc func(*CardUpdateOne)) *CardUpdateMany {
+	builders := make([]*CardUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &CardUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Card.
 func (c *CardClient) Delete() *CardDelete {
 	mutation := newCardMutation(c.config, OpDelete)
@@ -566,6 +632,21 @@ func (c *InfoClient) CreateBulk(builders ...*InfoCreate) *InfoCreateBulk {
 	return &InfoCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InfoClient) MapCreateBulk(slice any, setFunc func(*InfoCreate, int)) *InfoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InfoCreateBulk{err: fmt.Errorf("calling to InfoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InfoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InfoCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Info.
 func (c *InfoClient) Update() *InfoUpdate {
 	mutation := newInfoMutation(c.config, OpUpdate)
@@ -584,6 +665,17 @@ func (c *InfoClient) UpdateOneID(id int) *InfoUpdateOne {
 	return &InfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *InfoClient) UpdateMany(ids []int, setFunc func(*InfoUpdateOne)) *InfoUpdateMany {
+	builders := make([]*InfoUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &InfoUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Info.
 func (c *InfoClient) Delete() *InfoDelete {
 	mutation := newInfoMutation(c.config, OpDelete)
@@ -700,6 +792,21 @@ func (c *MetadataClient) CreateBulk(builders ...*MetadataCreate) *MetadataCreate
 	return &MetadataCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MetadataClient) MapCreateBulk(slice any, setFunc func(*MetadataCreate, int)) *MetadataCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MetadataCreateBulk{err: fmt.Errorf("calling to MetadataClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MetadataCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MetadataCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Metadata.
 func (c *MetadataClient) Update() *MetadataUpdate {
 	mutation := newMetadataMutation(c.config, OpUpdate)
@@ -718,6 +825,17 @@ func (c *MetadataClient) UpdateOneID(id int) *MetadataUpdateOne {
 	return &MetadataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *MetadataClient) UpdateMany(ids []int, setFunc func(*MetadataUpdateOne)) *MetadataUpdateMany {
+	builders := make([]*MetadataUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &MetadataUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Metadata.
 func (c *MetadataClient) Delete() *MetadataDelete {
 	mutation := newMetadataMutation(c.config, OpDelete)
@@ -866,6 +984,21 @@ func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
 	return &NodeCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Node.
 func (c *NodeClient) Update() *NodeUpdate {
 	mutation := newNodeMutation(c.config, OpUpdate)
@@ -884,6 +1017,17 @@ func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
 	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *NodeClient) UpdateMany(ids []int, setFunc func(*NodeUpdateOne)) *NodeUpdateMany {
+	builders := make([]*NodeUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &NodeUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Node.
 func (c *NodeClient) Delete() *NodeDelete {
 	mutation := newNodeMutation(c.config, OpDelete)
@@ -1016,6 +1160,21 @@ func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
 	return &PetCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PetClient) MapCreateBulk(slice any, setFunc func(*PetCreate, int)) *PetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PetCreateBulk{err: fmt.Errorf("calling to PetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Pet.
 func (c *PetClient) Update() *PetUpdate {
 	mutation := newPetMutation(c.config, OpUpdate)
@@ -1034,6 +1193,17 @@ func (c *PetClient) UpdateOneID(id int) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *PetClient) UpdateMany(ids []int, setFunc func(*PetUpdateOne)) *PetUpdateMany {
+	builders := make([]*PetUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &PetUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -1150,6 +1320,21 @@ func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
 	return &PostCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Post.
 func (c *PostClient) Update() *PostUpdate {
 	mutation := newPostMutation(c.config, OpUpdate)
@@ -1168,6 +1353,17 @@ func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
 	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *PostClient) UpdateMany(ids []int, setFunc func(*PostUpdateOne)) *PostUpdateMany {
+	builders := make([]*PostUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &PostUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Post.
 func (c *PostClient) Delete() *PostDelete {
 	mutation := newPostMutation(c.config, OpDelete)
@@ -1284,6 +1480,21 @@ func (c *RentalClient) CreateBulk(builders ...*RentalCreate) *RentalCreateBulk {
 	return &RentalCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RentalClient) MapCreateBulk(slice any, setFunc func(*RentalCreate, int)) *RentalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RentalCreateBulk{err: fmt.Errorf("calling to RentalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RentalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RentalCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Rental.
 func (c *RentalClient) Update() *RentalUpdate {
 	mutation := newRentalMutation(c.config, OpUpdate)
@@ -1302,6 +1513,17 @@ func (c *RentalClient) UpdateOneID(id int) *RentalUpdateOne {
 	return &RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *RentalClient) UpdateMany(ids []int, setFunc func(*RentalUpdateOne)) *RentalUpdateMany {
+	builders := make([]*RentalUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &RentalUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Rental.
 func (c *RentalClient) Delete() *RentalDelete {
 	mutation := newRentalMutation(c.config, OpDelete)
@@ -1434,6 +1656,21 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -1452,6 +1689,17 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserClient) UpdateMany(ids []int, setFunc func(*UserUpdateOne)) *UserUpdateMany {
+	builders := make([]*UserUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 
 	"entgo.io/ent"
@@ -99,12 +100,34 @@ func checkColumn(table, column string) error {
 	return columnCheck(table, column)
 }
 
+// AscExpr applies the given SQL expressions in ASC order. For example:
+//
+//	client.User.Query().
+//		Order(ent.AscExpr(sql.Expr("LENGTH(name)"))).
+//		All(ctx)
+func AscExpr(exprs ...sql.Querier) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		for _, x := range exprs {
+			sql.OrderByExpr(x).ToFunc()(s)
+		}
+	}
+}
+
+// DescExpr applies the given SQL expressions in DESC order.
+func DescExpr(exprs ...sql.Querier) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		for _, x := range exprs {
+			sql.OrderByExpr(x, sql.OrderDesc()).ToFunc()(s)
+		}
+	}
+}
+
 // Asc applies the given fields in ASC order.
 func Asc(fields ...string) func(*sql.Selector) {
 	return func(s *sql.Selector) {
 		for _, f := range fields {
 			if err := checkColumn(s.TableName(), f); err != nil {
-				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+				s.AddError(&ValidationError{Name: f, Field: f, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
 			}
 			s.OrderBy(sql.Asc(s.C(f)))
 		}
@@ -116,7 +139,7 @@ func Desc(fields ...string) func(*sql.Selector) {
 	return func(s *sql.Selector) {
 		for _, f := range fields {
 			if err := checkColumn(s.TableName(), f); err != nil {
-				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+				s.AddError(&ValidationError{Name: f, Field: f, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
 			}
 			s.OrderBy(sql.Desc(s.C(f)))
 		}
@@ -148,7 +171,7 @@ func Count() AggregateFunc {
 func Max(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		if err := checkColumn(s.TableName(), field); err != nil {
-			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
 		return sql.Max(s.C(field))
@@ -159,7 +182,7 @@ func Max(field string) AggregateFunc {
 func Mean(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		if err := checkColumn(s.TableName(), field); err != nil {
-			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
 		return sql.Avg(s.C(field))
@@ -170,7 +193,7 @@ func Mean(field string) AggregateFunc {
 func Min(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		if err := checkColumn(s.TableName(), field); err != nil {
-			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
 		return sql.Min(s.C(field))
@@ -181,17 +204,107 @@ func Min(field string) AggregateFunc {
 func Sum(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		if err := checkColumn(s.TableName(), field); err != nil {
-			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
 		return sql.Sum(s.C(field))
 	}
 }
 
-// ValidationError returns when validating a field or edge fails.
+// ArrayAgg applies the JSON array aggregation function on the given field of each group.
+// The values are collected into a JSON array that can be scanned into a Go slice.
+func ArrayAgg(field string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		f := &sql.Func{}
+		f.SetDialect(s.Dialect())
+		f.ArrayAgg(s.C(field))
+		return f.String()
+	}
+}
+
+// BoolAnd applies the logical AND aggregation function on the given field of each group.
+func BoolAnd(field string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		f := &sql.Func{}
+		f.SetDialect(s.Dialect())
+		f.BoolAnd(s.C(field))
+		return f.String()
+	}
+}
+
+// BoolOr applies the logical OR aggregation function on the given field of each group.
+func BoolOr(field string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		f := &sql.Func{}
+		f.SetDialect(s.Dialect())
+		f.BoolOr(s.C(field))
+		return f.String()
+	}
+}
+
+// StdDev applies the population standard deviation aggregation function on the given field of each group.
+func StdDev(field string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		f := &sql.Func{}
+		f.SetDialect(s.Dialect())
+		f.StdDev(s.C(field))
+		return f.String()
+	}
+}
+
+// Variance applies the population variance aggregation function on the given field of each group.
+func Variance(field string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		f := &sql.Func{}
+		f.SetDialect(s.Dialect())
+		f.Variance(s.C(field))
+		return f.String()
+	}
+}
+
+// GroupConcat applies the string concatenation aggregation function on the given field
+// of each group. The values are separated by the given separator.
+func GroupConcat(field, sep string) AggregateFunc {
+	return func(s *sql.Selector) string {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			s.AddError(&ValidationError{Name: field, Field: field, Reason: err.Error(), err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		f := &sql.Func{}
+		f.SetDialect(s.Dialect())
+		f.GroupConcat(s.C(field), sep)
+		return f.String()
+	}
+}
+
+// ValidationError returns when validating a field or edge fails. Its exported fields
+// allow mapping validation failures to API errors without parsing error messages.
 type ValidationError struct {
-	Name string // Field or edge name.
-	err  error
+	Name   string // Field or edge name. Same as Field, kept for compatibility.
+	Entity string // Type name. For example, "User". Empty for query options.
+	Field  string // Field or edge name.
+	Reason string // Failure reason. For example, "missing required field".
+	err    error
 }
 
 // Error implements the error interface.
@@ -204,6 +317,40 @@ func (e *ValidationError) Unwrap() error {
 	return e.err
 }
 
+// ValidationErrors returns when validating multiple fields or edges of a builder fails.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the validation errors, and allows errors.As
+// to extract the first *ValidationError from the aggregate.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i := range e {
+		errs[i] = e[i]
+	}
+	return errs
+}
+
+// err returns nil if there are no errors, the error itself if there is one, or the aggregate otherwise.
+func (e ValidationErrors) err() error {
+	switch len(e) {
+	case 0:
+		return nil
+	case 1:
+		return e[0]
+	default:
+		return e
+	}
+}
+
 // IsValidationError returns a boolean indicating whether the error is a validation error.
 func IsValidationError(err error) bool {
 	if err == nil {
@@ -213,6 +360,25 @@ func IsValidationError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// AsValidationErrors returns all validation errors in the error tree, or nil if there are none.
+func AsValidationErrors(err error) ValidationErrors {
+	switch err := err.(type) {
+	case *ValidationError:
+		return ValidationErrors{err}
+	case ValidationErrors:
+		return err
+	case interface{ Unwrap() []error }:
+		var errs ValidationErrors
+		for _, err := range err.Unwrap() {
+			errs = append(errs, AsValidationErrors(err)...)
+		}
+		return errs
+	case interface{ Unwrap() error }:
+		return AsValidationErrors(err.Unwrap())
+	}
+	return nil
+}
+
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
@@ -278,12 +444,24 @@ func IsNotLoaded(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ConstraintKind is the kind of a violated constraint.
+type ConstraintKind string
+
+// Kinds of violated constraints.
+const (
+	UniqueConstraint     ConstraintKind = "unique"
+	ForeignKeyConstraint ConstraintKind = "foreign_key"
+)
+
 // ConstraintError returns when trying to create/update one or more entities and
 // one or more of their constraints failed. For example, violation of edge or
 // field uniqueness.
 type ConstraintError struct {
-	msg  string
-	wrap error
+	Kind       ConstraintKind // Constraint kind. Empty if it is unknown.
+	Constraint string         // Constraint or index name, if reported by the database.
+	Columns    []string       // Offending columns, if reported by the database.
+	msg        string
+	wrap       error
 }
 
 // Error implements the error interface.
@@ -305,6 +483,20 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// IsUniqueConstraintError returns a boolean indicating whether the error is a uniqueness constraint failure.
+// For example, creating an entity with a value that already exists in a unique field.
+func IsUniqueConstraintError(err error) bool {
+	var e *ConstraintError
+	return errors.As(err, &e) && e.Kind == UniqueConstraint
+}
+
+// IsForeignKeyConstraintError returns a boolean indicating whether the error is a foreign-key constraint failure.
+// For example, deleting an entity that is referenced by other entities.
+func IsForeignKeyConstraintError(err error) bool {
+	var e *ConstraintError
+	return errors.As(err, &e) && e.Kind == ForeignKeyConstraint
+}
+
 // selector embedded by the different Select/GroupBy builders.
 type selector struct {
 	label string
@@ -624,5 +816,26 @@ func scanWithInterceptors[Q1 ent.Query, Q2 interface {
 	return nil
 }
 
+// newConstraintError returns a ConstraintError that wraps the given database error, with the kind,
+// the name and the columns of the violated constraint, if they can be parsed from the error.
+func newConstraintError(err error) *ConstraintError {
+	e := &ConstraintError{msg: err.Error(), wrap: err}
+	if v, ok := sqlgraph.ParseConstraintError(err); ok {
+		e.Kind, e.Constraint, e.Columns = ConstraintKind(v.Kind), v.Constraint, v.Columns
+	}
+	return e
+}
+
+// TimeoutError is returned when an operation that was executed by the sql.TimeoutDriver
+// fails because its deadline was exceeded. See IsTimeoutError for more details.
+type TimeoutError = sql.TimeoutError
+
+// IsTimeoutError returns a boolean indicating whether the error is a timeout error.
+// It allows distinguishing slow queries from other failures.
+func IsTimeoutError(err error) bool {
+	var e *TimeoutError
+	return errors.As(err, &e)
+}
+
 // queryHook describes an internal hook for the different sqlAll methods.
 type queryHook func(context.Context, *sqlgraph.QuerySpec)
@@ -9,6 +9,7 @@ package info
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/edgefield/ent/predicate"
 )
 
@@ -57,6 +58,30 @@ func IDLTE(id int) predicate.Info {
 	return predicate.Info(sql.FieldLTE(FieldID, id))
 }
 
+// ContentHasKey applies the HasKey predicate on the "content" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func ContentHasKey(path ...string) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldContent), sqljson.Path(path...)))
+	})
+}
+
+// ContentValueEQ checks that the value at the given path of the
+// "content" JSON field is equal to v.
+func ContentValueEQ(v any, path ...string) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldContent), v, sqljson.Path(path...)))
+	})
+}
+
+// ContentContains checks that the value at the given path of the "content"
+// JSON field contains v. e.g. an array that contains the element v.
+func ContentContains(v any, path ...string) predicate.Info {
+	return predicate.Info(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldContent), v, sqljson.Path(path...)))
+	})
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Info {
 	return predicate.Info(func(s *sql.Selector) {
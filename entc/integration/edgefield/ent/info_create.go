@@ -90,10 +90,11 @@ func (ic *InfoCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ic *InfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ic.mutation.Content(); !ok {
-		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Info.content"`)}
+		errs = append(errs, &ValidationError{Name: "content", Entity: "Info", Field: "content", Reason: "missing required field", err: errors.New(`ent: missing required field "Info.content"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ic *InfoCreate) sqlSave(ctx context.Context) (*Info, error) {
@@ -103,7 +104,7 @@ func (ic *InfoCreate) sqlSave(ctx context.Context) (*Info, error) {
 	_node, _spec := ic.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -152,11 +153,15 @@ func (ic *InfoCreate) createSpec() (*Info, *sqlgraph.CreateSpec) {
 // InfoCreateBulk is the builder for creating many Info entities in bulk.
 type InfoCreateBulk struct {
 	config
+	err      error
 	builders []*InfoCreate
 }
 
 // Save creates the Info entities in the database.
 func (icb *InfoCreateBulk) Save(ctx context.Context) ([]*Info, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
 	nodes := make([]*Info, len(icb.builders))
 	mutators := make([]Mutator, len(icb.builders))
@@ -181,7 +186,7 @@ func (icb *InfoCreateBulk) Save(ctx context.Context) ([]*Info, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -356,7 +356,7 @@ func (iq *InfoQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range iq.ctx.Fields {
 		if !info.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Info", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if iq.path != nil {
@@ -414,23 +414,30 @@ func (iq *InfoQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*In
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(user.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "id" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -518,7 +525,8 @@ func (iq *InfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // InfoGroupBy is the group-by builder for Info entities.
 type InfoGroupBy struct {
 	selector
-	build *InfoQuery
+	build  *InfoQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -536,6 +544,14 @@ func (igb *InfoGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*InfoQuery, *InfoGroupBy](ctx, igb.build, igb, igb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (igb *InfoGroupBy) Having(ps ...*sql.Predicate) *InfoGroupBy {
+	igb.having = append(igb.having, ps...)
+	return igb
+}
+
 func (igb *InfoGroupBy) sqlScan(ctx context.Context, root *InfoQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(igb.fns))
@@ -551,6 +567,9 @@ func (igb *InfoGroupBy) sqlScan(ctx context.Context, root *InfoQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*igb.flds...)...)
+	if len(igb.having) > 0 {
+		selector.Having(sql.And(igb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -140,10 +140,11 @@ func (mc *MetadataCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (mc *MetadataCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := mc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "Metadata.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "Metadata", Field: "age", Reason: "missing required field", err: errors.New(`ent: missing required field "Metadata.age"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (mc *MetadataCreate) sqlSave(ctx context.Context) (*Metadata, error) {
@@ -153,7 +154,7 @@ func (mc *MetadataCreate) sqlSave(ctx context.Context) (*Metadata, error) {
 	_node, _spec := mc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -235,11 +236,15 @@ func (mc *MetadataCreate) createSpec() (*Metadata, *sqlgraph.CreateSpec) {
 // MetadataCreateBulk is the builder for creating many Metadata entities in bulk.
 type MetadataCreateBulk struct {
 	config
+	err      error
 	builders []*MetadataCreate
 }
 
 // Save creates the Metadata entities in the database.
 func (mcb *MetadataCreateBulk) Save(ctx context.Context) ([]*Metadata, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
 	nodes := make([]*Metadata, len(mcb.builders))
 	mutators := make([]Mutator, len(mcb.builders))
@@ -265,7 +270,7 @@ func (mcb *MetadataCreateBulk) Save(ctx context.Context) ([]*Metadata, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
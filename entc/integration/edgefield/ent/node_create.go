@@ -118,10 +118,11 @@ func (nc *NodeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (nc *NodeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := nc.mutation.Value(); !ok {
-		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Node.value"`)}
+		errs = append(errs, &ValidationError{Name: "value", Entity: "Node", Field: "value", Reason: "missing required field", err: errors.New(`ent: missing required field "Node.value"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
@@ -131,7 +132,7 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 	_node, _spec := nc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -190,11 +191,15 @@ func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
 // NodeCreateBulk is the builder for creating many Node entities in bulk.
 type NodeCreateBulk struct {
 	config
+	err      error
 	builders []*NodeCreate
 }
 
 // Save creates the Node entities in the database.
 func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
 	nodes := make([]*Node, len(ncb.builders))
 	mutators := make([]Mutator, len(ncb.builders))
@@ -220,7 +225,7 @@ func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
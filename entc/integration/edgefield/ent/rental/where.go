@@ -155,6 +155,12 @@ func CarIDNotIn(vs ...uuid.UUID) predicate.Rental {
 	return predicate.Rental(sql.FieldNotIn(FieldCarID, vs...))
 }
 
+// DateWithinLast applies the WithinLast predicate on the "date" field.
+// It matches values that are within the given duration before the current time.
+func DateWithinLast(d time.Duration) predicate.Rental {
+	return predicate.Rental(sql.FieldWithinLast(FieldDate, d))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Rental {
 	return predicate.Rental(func(s *sql.Selector) {
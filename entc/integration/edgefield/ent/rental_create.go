@@ -106,22 +106,23 @@ func (rc *RentalCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (rc *RentalCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := rc.mutation.Date(); !ok {
-		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Rental.date"`)}
+		errs = append(errs, &ValidationError{Name: "date", Entity: "Rental", Field: "date", Reason: "missing required field", err: errors.New(`ent: missing required field "Rental.date"`)})
 	}
 	if _, ok := rc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Rental.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "Rental", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Rental.user_id"`)})
 	}
 	if _, ok := rc.mutation.CarID(); !ok {
-		return &ValidationError{Name: "car_id", err: errors.New(`ent: missing required field "Rental.car_id"`)}
+		errs = append(errs, &ValidationError{Name: "car_id", Entity: "Rental", Field: "car_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Rental.car_id"`)})
 	}
 	if _, ok := rc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Rental.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Rental", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Rental.user"`)})
 	}
 	if _, ok := rc.mutation.CarID(); !ok {
-		return &ValidationError{Name: "car", err: errors.New(`ent: missing required edge "Rental.car"`)}
+		errs = append(errs, &ValidationError{Name: "car", Entity: "Rental", Field: "car", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Rental.car"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (rc *RentalCreate) sqlSave(ctx context.Context) (*Rental, error) {
@@ -131,7 +132,7 @@ func (rc *RentalCreate) sqlSave(ctx context.Context) (*Rental, error) {
 	_node, _spec := rc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -191,11 +192,15 @@ func (rc *RentalCreate) createSpec() (*Rental, *sqlgraph.CreateSpec) {
 // RentalCreateBulk is the builder for creating many Rental entities in bulk.
 type RentalCreateBulk struct {
 	config
+	err      error
 	builders []*RentalCreate
 }
 
 // Save creates the Rental entities in the database.
 func (rcb *RentalCreateBulk) Save(ctx context.Context) ([]*Rental, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
 	nodes := make([]*Rental, len(rcb.builders))
 	mutators := make([]Mutator, len(rcb.builders))
@@ -221,7 +226,7 @@ func (rcb *RentalCreateBulk) Save(ctx context.Context) ([]*Rental, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
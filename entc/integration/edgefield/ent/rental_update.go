@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -80,13 +81,14 @@ func (ru *RentalUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ru *RentalUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := ru.mutation.UserID(); ru.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Rental", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Rental.user"`)})
 	}
 	if _, ok := ru.mutation.CarID(); ru.mutation.CarCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.car"`)
+		errs = append(errs, &ValidationError{Name: "car", Entity: "Rental", Field: "car", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Rental.car"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ru *RentalUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -108,7 +110,7 @@ func (ru *RentalUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{rental.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -185,13 +187,14 @@ func (ruo *RentalUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruo *RentalUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := ruo.mutation.UserID(); ruo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Rental", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Rental.user"`)})
 	}
 	if _, ok := ruo.mutation.CarID(); ruo.mutation.CarCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.car"`)
+		errs = append(errs, &ValidationError{Name: "car", Entity: "Rental", Field: "car", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Rental.car"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err error) {
@@ -201,7 +204,7 @@ func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err err
 	_spec := sqlgraph.NewUpdateSpec(rental.Table, rental.Columns, sqlgraph.NewFieldSpec(rental.FieldID, field.TypeInt))
 	id, ok := ruo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rental.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Rental", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Rental.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := ruo.fields; len(fields) > 0 {
@@ -209,7 +212,7 @@ func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err err
 		_spec.Node.Columns = append(_spec.Node.Columns, rental.FieldID)
 		for _, f := range fields {
 			if !rental.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Rental", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != rental.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -233,10 +236,69 @@ func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err err
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{rental.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	ruo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (ruo *RentalUpdateOne) sameUpdate(other *RentalUpdateOne) bool {
+	m1, m2 := *ruo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// RentalUpdateMany is the builder for updating many Rental entities by their ids.
+type RentalUpdateMany struct {
+	config
+	builders []*RentalUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (rum *RentalUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*RentalUpdateOne
+Builders:
+	for _, b := range rum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*RentalUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(rental.IDIn(ids...))
+		if err := (&RentalUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rum *RentalUpdateMany) ExecX(ctx context.Context) {
+	if err := rum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,8 @@
 package user
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -205,6 +207,92 @@ func ByRentals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRentalsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "pets_count") that User queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that User queries can be ordered by.
+const (
+	OrderFieldID            OrderField = "id"
+	OrderFieldParentID      OrderField = "parent_id"
+	OrderFieldSpouseID      OrderField = "spouse_id"
+	OrderFieldPetsCount     OrderField = "pets_count"
+	OrderFieldChildrenCount OrderField = "children_count"
+	OrderFieldInfoCount     OrderField = "info_count"
+	OrderFieldRentalsCount  OrderField = "rentals_count"
+)
+
+// OrderFields holds all fields and edge counts that User queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldParentID,
+	OrderFieldSpouseID,
+	OrderFieldPetsCount,
+	OrderFieldChildrenCount,
+	OrderFieldInfoCount,
+	OrderFieldRentalsCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if User queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("user: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := user.OrderByField(user.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.User.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldParentID:
+		return ByParentID(opts...), nil
+	case OrderFieldSpouseID:
+		return BySpouseID(opts...), nil
+	case OrderFieldPetsCount:
+		return ByPetsCount(opts...), nil
+	case OrderFieldChildrenCount:
+		return ByChildrenCount(opts...), nil
+	case OrderFieldInfoCount:
+		return ByInfoCount(opts...), nil
+	case OrderFieldRentalsCount:
+		return ByRentalsCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newPetsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
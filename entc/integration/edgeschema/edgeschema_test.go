@@ -219,6 +219,11 @@ func TestEdgeSchemaBidiWithID(t *testing.T) {
 		require.Equal(t, nat.ID, f1.FriendID)
 	}
 	require.Equal(t, 2, client.Friendship.Query().CountX(ctx), "bidirectional edges create 2 records in the join table")
+	// Edge predicates are generated as correlated EXISTS subqueries (sql/exists),
+	// and the table of the subquery is aliased in self-referential edges.
+	require.Equal(t, a8m.ID, client.User.Query().Where(user.HasFriendsWith(user.Name(nat.Name))).OnlyIDX(ctx))
+	require.Equal(t, nat.ID, client.User.Query().Where(user.HasFriendsWith(user.HasFriendsWith(user.Name(nat.Name)))).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.HasFriendsWith(user.HasFriendsWith(user.Name("unknown")))).CountX(ctx))
 }
 
 func TestEdgeSchemaBidiCompositeID(t *testing.T) {
@@ -7,6 +7,7 @@
 package attachedfile
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -105,6 +106,80 @@ func ByProcField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProcStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that AttachedFile queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that AttachedFile queries can be ordered by.
+const (
+	OrderFieldID         OrderField = "id"
+	OrderFieldAttachTime OrderField = "attach_time"
+	OrderFieldFID        OrderField = "f_id"
+	OrderFieldProcID     OrderField = "proc_id"
+)
+
+// OrderFields holds all fields and edge counts that AttachedFile queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldAttachTime,
+	OrderFieldFID,
+	OrderFieldProcID,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if AttachedFile queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("attachedfile: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := attachedfile.OrderByField(attachedfile.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.AttachedFile.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldAttachTime:
+		return ByAttachTime(opts...), nil
+	case OrderFieldFID:
+		return ByFID(opts...), nil
+	case OrderFieldProcID:
+		return ByProcID(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newFiStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
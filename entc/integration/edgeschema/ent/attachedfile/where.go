@@ -154,6 +154,12 @@ func ProcIDNotIn(vs ...int) predicate.AttachedFile {
 	return predicate.AttachedFile(sql.FieldNotIn(FieldProcID, vs...))
 }
 
+// AttachTimeWithinLast applies the WithinLast predicate on the "attach_time" field.
+// It matches values that are within the given duration before the current time.
+func AttachTimeWithinLast(d time.Duration) predicate.AttachedFile {
+	return predicate.AttachedFile(sql.FieldWithinLast(FieldAttachTime, d))
+}
+
 // HasFi applies the HasEdge predicate on the "fi" edge.
 func HasFi() predicate.AttachedFile {
 	return predicate.AttachedFile(func(s *sql.Selector) {
@@ -169,7 +175,7 @@ func HasFi() predicate.AttachedFile {
 func HasFiWith(preds ...predicate.File) predicate.AttachedFile {
 	return predicate.AttachedFile(func(s *sql.Selector) {
 		step := newFiStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -192,7 +198,7 @@ func HasProc() predicate.AttachedFile {
 func HasProcWith(preds ...predicate.Process) predicate.AttachedFile {
 	return predicate.AttachedFile(func(s *sql.Selector) {
 		step := newProcStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -113,22 +113,23 @@ func (afc *AttachedFileCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (afc *AttachedFileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := afc.mutation.AttachTime(); !ok {
-		return &ValidationError{Name: "attach_time", err: errors.New(`ent: missing required field "AttachedFile.attach_time"`)}
+		errs = append(errs, &ValidationError{Name: "attach_time", Entity: "AttachedFile", Field: "attach_time", Reason: "missing required field", err: errors.New(`ent: missing required field "AttachedFile.attach_time"`)})
 	}
 	if _, ok := afc.mutation.FID(); !ok {
-		return &ValidationError{Name: "f_id", err: errors.New(`ent: missing required field "AttachedFile.f_id"`)}
+		errs = append(errs, &ValidationError{Name: "f_id", Entity: "AttachedFile", Field: "f_id", Reason: "missing required field", err: errors.New(`ent: missing required field "AttachedFile.f_id"`)})
 	}
 	if _, ok := afc.mutation.ProcID(); !ok {
-		return &ValidationError{Name: "proc_id", err: errors.New(`ent: missing required field "AttachedFile.proc_id"`)}
+		errs = append(errs, &ValidationError{Name: "proc_id", Entity: "AttachedFile", Field: "proc_id", Reason: "missing required field", err: errors.New(`ent: missing required field "AttachedFile.proc_id"`)})
 	}
 	if _, ok := afc.mutation.FiID(); !ok {
-		return &ValidationError{Name: "fi", err: errors.New(`ent: missing required edge "AttachedFile.fi"`)}
+		errs = append(errs, &ValidationError{Name: "fi", Entity: "AttachedFile", Field: "fi", Reason: "missing required edge", err: errors.New(`ent: missing required edge "AttachedFile.fi"`)})
 	}
 	if _, ok := afc.mutation.ProcID(); !ok {
-		return &ValidationError{Name: "proc", err: errors.New(`ent: missing required edge "AttachedFile.proc"`)}
+		errs = append(errs, &ValidationError{Name: "proc", Entity: "AttachedFile", Field: "proc", Reason: "missing required edge", err: errors.New(`ent: missing required edge "AttachedFile.proc"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (afc *AttachedFileCreate) sqlSave(ctx context.Context) (*AttachedFile, error) {
@@ -138,7 +139,7 @@ func (afc *AttachedFileCreate) sqlSave(ctx context.Context) (*AttachedFile, erro
 	_node, _spec := afc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, afc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -257,6 +258,12 @@ func (u *AttachedFileUpsert) UpdateAttachTime() *AttachedFileUpsert {
 	return u
 }
 
+// IgnoreAttachTime keeps the current value of the "attach_time" field in case of conflict.
+func (u *AttachedFileUpsert) IgnoreAttachTime() *AttachedFileUpsert {
+	u.SetIgnore(attachedfile.FieldAttachTime)
+	return u
+}
+
 // SetFID sets the "f_id" field.
 func (u *AttachedFileUpsert) SetFID(v int) *AttachedFileUpsert {
 	u.Set(attachedfile.FieldFID, v)
@@ -269,6 +276,12 @@ func (u *AttachedFileUpsert) UpdateFID() *AttachedFileUpsert {
 	return u
 }
 
+// IgnoreFID keeps the current value of the "f_id" field in case of conflict.
+func (u *AttachedFileUpsert) IgnoreFID() *AttachedFileUpsert {
+	u.SetIgnore(attachedfile.FieldFID)
+	return u
+}
+
 // SetProcID sets the "proc_id" field.
 func (u *AttachedFileUpsert) SetProcID(v int) *AttachedFileUpsert {
 	u.Set(attachedfile.FieldProcID, v)
@@ -281,6 +294,12 @@ func (u *AttachedFileUpsert) UpdateProcID() *AttachedFileUpsert {
 	return u
 }
 
+// IgnoreProcID keeps the current value of the "proc_id" field in case of conflict.
+func (u *AttachedFileUpsert) IgnoreProcID() *AttachedFileUpsert {
+	u.SetIgnore(attachedfile.FieldProcID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -335,6 +354,14 @@ func (u *AttachedFileUpsertOne) UpdateAttachTime() *AttachedFileUpsertOne {
 	})
 }
 
+// IgnoreAttachTime keeps the current value of the "attach_time" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AttachedFileUpsertOne) IgnoreAttachTime() *AttachedFileUpsertOne {
+	return u.Update(func(s *AttachedFileUpsert) {
+		s.IgnoreAttachTime()
+	})
+}
+
 // SetFID sets the "f_id" field.
 func (u *AttachedFileUpsertOne) SetFID(v int) *AttachedFileUpsertOne {
 	return u.Update(func(s *AttachedFileUpsert) {
@@ -349,6 +376,14 @@ func (u *AttachedFileUpsertOne) UpdateFID() *AttachedFileUpsertOne {
 	})
 }
 
+// IgnoreFID keeps the current value of the "f_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AttachedFileUpsertOne) IgnoreFID() *AttachedFileUpsertOne {
+	return u.Update(func(s *AttachedFileUpsert) {
+		s.IgnoreFID()
+	})
+}
+
 // SetProcID sets the "proc_id" field.
 func (u *AttachedFileUpsertOne) SetProcID(v int) *AttachedFileUpsertOne {
 	return u.Update(func(s *AttachedFileUpsert) {
@@ -363,6 +398,14 @@ func (u *AttachedFileUpsertOne) UpdateProcID() *AttachedFileUpsertOne {
 	})
 }
 
+// IgnoreProcID keeps the current value of the "proc_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AttachedFileUpsertOne) IgnoreProcID() *AttachedFileUpsertOne {
+	return u.Update(func(s *AttachedFileUpsert) {
+		s.IgnoreProcID()
+	})
+}
+
 // Exec executes the query.
 func (u *AttachedFileUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -399,12 +442,16 @@ func (u *AttachedFileUpsertOne) IDX(ctx context.Context) int {
 // AttachedFileCreateBulk is the builder for creating many AttachedFile entities in bulk.
 type AttachedFileCreateBulk struct {
 	config
+	err      error
 	builders []*AttachedFileCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the AttachedFile entities in the database.
 func (afcb *AttachedFileCreateBulk) Save(ctx context.Context) ([]*AttachedFile, error) {
+	if afcb.err != nil {
+		return nil, afcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(afcb.builders))
 	nodes := make([]*AttachedFile, len(afcb.builders))
 	mutators := make([]Mutator, len(afcb.builders))
@@ -431,7 +478,7 @@ func (afcb *AttachedFileCreateBulk) Save(ctx context.Context) ([]*AttachedFile,
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, afcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -577,6 +624,14 @@ func (u *AttachedFileUpsertBulk) UpdateAttachTime() *AttachedFileUpsertBulk {
 	})
 }
 
+// IgnoreAttachTime keeps the current value of the "attach_time" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AttachedFileUpsertBulk) IgnoreAttachTime() *AttachedFileUpsertBulk {
+	return u.Update(func(s *AttachedFileUpsert) {
+		s.IgnoreAttachTime()
+	})
+}
+
 // SetFID sets the "f_id" field.
 func (u *AttachedFileUpsertBulk) SetFID(v int) *AttachedFileUpsertBulk {
 	return u.Update(func(s *AttachedFileUpsert) {
@@ -591,6 +646,14 @@ func (u *AttachedFileUpsertBulk) UpdateFID() *AttachedFileUpsertBulk {
 	})
 }
 
+// IgnoreFID keeps the current value of the "f_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AttachedFileUpsertBulk) IgnoreFID() *AttachedFileUpsertBulk {
+	return u.Update(func(s *AttachedFileUpsert) {
+		s.IgnoreFID()
+	})
+}
+
 // SetProcID sets the "proc_id" field.
 func (u *AttachedFileUpsertBulk) SetProcID(v int) *AttachedFileUpsertBulk {
 	return u.Update(func(s *AttachedFileUpsert) {
@@ -605,6 +668,14 @@ func (u *AttachedFileUpsertBulk) UpdateProcID() *AttachedFileUpsertBulk {
 	})
 }
 
+// IgnoreProcID keeps the current value of the "proc_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *AttachedFileUpsertBulk) IgnoreProcID() *AttachedFileUpsertBulk {
+	return u.Update(func(s *AttachedFileUpsert) {
+		s.IgnoreProcID()
+	})
+}
+
 // Exec executes the query.
 func (u *AttachedFileUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -122,13 +123,14 @@ func (afu *AttachedFileUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (afu *AttachedFileUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := afu.mutation.FiID(); afu.mutation.FiCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "AttachedFile.fi"`)
+		errs = append(errs, &ValidationError{Name: "fi", Entity: "AttachedFile", Field: "fi", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "AttachedFile.fi"`)})
 	}
 	if _, ok := afu.mutation.ProcID(); afu.mutation.ProcCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "AttachedFile.proc"`)
+		errs = append(errs, &ValidationError{Name: "proc", Entity: "AttachedFile", Field: "proc", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "AttachedFile.proc"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (afu *AttachedFileUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -208,7 +210,7 @@ func (afu *AttachedFileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{attachedfile.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -325,13 +327,14 @@ func (afuo *AttachedFileUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (afuo *AttachedFileUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := afuo.mutation.FiID(); afuo.mutation.FiCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "AttachedFile.fi"`)
+		errs = append(errs, &ValidationError{Name: "fi", Entity: "AttachedFile", Field: "fi", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "AttachedFile.fi"`)})
 	}
 	if _, ok := afuo.mutation.ProcID(); afuo.mutation.ProcCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "AttachedFile.proc"`)
+		errs = append(errs, &ValidationError{Name: "proc", Entity: "AttachedFile", Field: "proc", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "AttachedFile.proc"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (afuo *AttachedFileUpdateOne) sqlSave(ctx context.Context) (_node *AttachedFile, err error) {
@@ -341,7 +344,7 @@ func (afuo *AttachedFileUpdateOne) sqlSave(ctx context.Context) (_node *Attached
 	_spec := sqlgraph.NewUpdateSpec(attachedfile.Table, attachedfile.Columns, sqlgraph.NewFieldSpec(attachedfile.FieldID, field.TypeInt))
 	id, ok := afuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttachedFile.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "AttachedFile", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "AttachedFile.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := afuo.fields; len(fields) > 0 {
@@ -349,7 +352,7 @@ func (afuo *AttachedFileUpdateOne) sqlSave(ctx context.Context) (_node *Attached
 		_spec.Node.Columns = append(_spec.Node.Columns, attachedfile.FieldID)
 		for _, f := range fields {
 			if !attachedfile.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "AttachedFile", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != attachedfile.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -431,10 +434,69 @@ func (afuo *AttachedFileUpdateOne) sqlSave(ctx context.Context) (_node *Attached
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{attachedfile.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	afuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (afuo *AttachedFileUpdateOne) sameUpdate(other *AttachedFileUpdateOne) bool {
+	m1, m2 := *afuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// AttachedFileUpdateMany is the builder for updating many AttachedFile entities by their ids.
+type AttachedFileUpdateMany struct {
+	config
+	builders []*AttachedFileUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (afum *AttachedFileUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*AttachedFileUpdateOne
+Builders:
+	for _, b := range afum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*AttachedFileUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(attachedfile.IDIn(ids...))
+		if err := (&AttachedFileUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afum *AttachedFileUpdateMany) ExecX(ctx context.Context) {
+	if err := afum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/edgeschema/ent/migrate"
@@ -209,14 +210,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}, nil
 }
 
-// BeginTx returns a transactional client with specified options.
+// BeginTx returns a transactional client with specified options. For example,
+// starting a read-only transaction with the REPEATABLE READ isolation level:
+//
+//	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(interface {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = c.driver.Tx(ctx)
+	} else {
+		err = errors.New("driver does not support transaction options")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
@@ -366,6 +380,21 @@ func (c *AttachedFileClient) CreateBulk(builders ...*AttachedFileCreate) *Attach
 	return &AttachedFileCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AttachedFileClient) MapCreateBulk(slice any, setFunc func(*AttachedFileCreate, int)) *AttachedFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AttachedFileCreateBulk{err: fmt.Errorf("calling to AttachedFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AttachedFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AttachedFileCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for AttachedFile.
 func (c *AttachedFileClient) Update() *AttachedFileUpdate {
 	mutation := newAttachedFileMutation(c.config, OpUpdate)
@@ -384,6 +413,17 @@ func (c *AttachedFileClient) UpdateOneID(id int) *AttachedFileUpdateOne {
 	return &AttachedFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *AttachedFileClient) UpdateMany(ids []int, setFunc func(*AttachedFileUpdateOne)) *AttachedFileUpdateMany {
+	builders := make([]*AttachedFileUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &AttachedFileUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for AttachedFile.
 func (c *AttachedFileClient) Delete() *AttachedFileDelete {
 	mutation := newAttachedFileMutation(c.config, OpDelete)
@@ -516,6 +556,21 @@ func (c *FileClient) CreateBulk(builders ...*FileCreate) *FileCreateBulk {
 	return &FileCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileClient) MapCreateBulk(slice any, setFunc func(*FileCreate, int)) *FileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileCreateBulk{err: fmt.Errorf("calling to FileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for File.
 func (c *FileClient) Update() *FileUpdate {
 	mutation := newFileMutation(c.config, OpUpdate)
@@ -534,6 +589,17 @@ func (c *FileClient) UpdateOneID(id int) *FileUpdateOne {
 	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *FileClient) UpdateMany(ids []int, setFunc func(*FileUpdateOne)) *FileUpdateMany {
+	builders := make([]*FileUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &FileUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for File.
 func (c *FileClient) Delete() *FileDelete {
 	mutation := newFileMutation(c.config, OpDelete)
@@ -650,6 +716,21 @@ func (c *FriendshipClient) CreateBulk(builders ...*FriendshipCreate) *Friendship
 	return &FriendshipCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendshipClient) MapCreateBulk(slice any, setFunc func(*FriendshipCreate, int)) *FriendshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendshipCreateBulk{err: fmt.Errorf("calling to FriendshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendshipCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Friendship.
 func (c *FriendshipClient) Update() *FriendshipUpdate {
 	mutation := newFriendshipMutation(c.config, OpUpdate)
@@ -668,6 +749,17 @@ func (c *FriendshipClient) UpdateOneID(id int) *FriendshipUpdateOne {
 	return &FriendshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *FriendshipClient) UpdateMany(ids []int, setFunc func(*FriendshipUpdateOne)) *FriendshipUpdateMany {
+	builders := make([]*FriendshipUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &FriendshipUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Friendship.
 func (c *FriendshipClient) Delete() *FriendshipDelete {
 	mutation := newFriendshipMutation(c.config, OpDelete)
@@ -800,6 +892,21 @@ func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
 	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Group.
 func (c *GroupClient) Update() *GroupUpdate {
 	mutation := newGroupMutation(c.config, OpUpdate)
@@ -818,6 +925,17 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *GroupClient) UpdateMany(ids []int, setFunc func(*GroupUpdateOne)) *GroupUpdateMany {
+	builders := make([]*GroupUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &GroupUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -982,6 +1100,21 @@ func (c *GroupTagClient) CreateBulk(builders ...*GroupTagCreate) *GroupTagCreate
 	return &GroupTagCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupTagClient) MapCreateBulk(slice any, setFunc func(*GroupTagCreate, int)) *GroupTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupTagCreateBulk{err: fmt.Errorf("calling to GroupTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupTagCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for GroupTag.
 func (c *GroupTagClient) Update() *GroupTagUpdate {
 	mutation := newGroupTagMutation(c.config, OpUpdate)
@@ -1000,6 +1133,17 @@ func (c *GroupTagClient) UpdateOneID(id int) *GroupTagUpdateOne {
 	return &GroupTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *GroupTagClient) UpdateMany(ids []int, setFunc func(*GroupTagUpdateOne)) *GroupTagUpdateMany {
+	builders := make([]*GroupTagUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &GroupTagUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for GroupTag.
 func (c *GroupTagClient) Delete() *GroupTagDelete {
 	mutation := newGroupTagMutation(c.config, OpDelete)
@@ -1132,6 +1276,21 @@ func (c *ProcessClient) CreateBulk(builders ...*ProcessCreate) *ProcessCreateBul
 	return &ProcessCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProcessClient) MapCreateBulk(slice any, setFunc func(*ProcessCreate, int)) *ProcessCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProcessCreateBulk{err: fmt.Errorf("calling to ProcessClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProcessCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProcessCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Process.
 func (c *ProcessClient) Update() *ProcessUpdate {
 	mutation := newProcessMutation(c.config, OpUpdate)
@@ -1150,6 +1309,17 @@ func (c *ProcessClient) UpdateOneID(id int) *ProcessUpdateOne {
 	return &ProcessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *ProcessClient) UpdateMany(ids []int, setFunc func(*ProcessUpdateOne)) *ProcessUpdateMany {
+	builders := make([]*ProcessUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &ProcessUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Process.
 func (c *ProcessClient) Delete() *ProcessDelete {
 	mutation := newProcessMutation(c.config, OpDelete)
@@ -1282,6 +1452,21 @@ func (c *RelationshipClient) CreateBulk(builders ...*RelationshipCreate) *Relati
 	return &RelationshipCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RelationshipClient) MapCreateBulk(slice any, setFunc func(*RelationshipCreate, int)) *RelationshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RelationshipCreateBulk{err: fmt.Errorf("calling to RelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RelationshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RelationshipCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Relationship.
 func (c *RelationshipClient) Update() *RelationshipUpdate {
 	mutation := newRelationshipMutation(c.config, OpUpdate)
@@ -1391,6 +1576,21 @@ func (c *RelationshipInfoClient) CreateBulk(builders ...*RelationshipInfoCreate)
 	return &RelationshipInfoCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RelationshipInfoClient) MapCreateBulk(slice any, setFunc func(*RelationshipInfoCreate, int)) *RelationshipInfoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RelationshipInfoCreateBulk{err: fmt.Errorf("calling to RelationshipInfoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RelationshipInfoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RelationshipInfoCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for RelationshipInfo.
 func (c *RelationshipInfoClient) Update() *RelationshipInfoUpdate {
 	mutation := newRelationshipInfoMutation(c.config, OpUpdate)
@@ -1409,6 +1609,17 @@ func (c *RelationshipInfoClient) UpdateOneID(id int) *RelationshipInfoUpdateOne
 	return &RelationshipInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *RelationshipInfoClient) UpdateMany(ids []int, setFunc func(*RelationshipInfoUpdateOne)) *RelationshipInfoUpdateMany {
+	builders := make([]*RelationshipInfoUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &RelationshipInfoUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for RelationshipInfo.
 func (c *RelationshipInfoClient) Delete() *RelationshipInfoDelete {
 	mutation := newRelationshipInfoMutation(c.config, OpDelete)
@@ -1509,6 +1720,21 @@ func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
 	return &RoleCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Role.
 func (c *RoleClient) Update() *RoleUpdate {
 	mutation := newRoleMutation(c.config, OpUpdate)
@@ -1527,6 +1753,17 @@ func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
 	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *RoleClient) UpdateMany(ids []int, setFunc func(*RoleUpdateOne)) *RoleUpdateMany {
+	builders := make([]*RoleUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &RoleUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Role.
 func (c *RoleClient) Delete() *RoleDelete {
 	mutation := newRoleMutation(c.config, OpDelete)
@@ -1659,6 +1896,21 @@ func (c *RoleUserClient) CreateBulk(builders ...*RoleUserCreate) *RoleUserCreate
 	return &RoleUserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleUserClient) MapCreateBulk(slice any, setFunc func(*RoleUserCreate, int)) *RoleUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleUserCreateBulk{err: fmt.Errorf("calling to RoleUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleUserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for RoleUser.
 func (c *RoleUserClient) Update() *RoleUserUpdate {
 	mutation := newRoleUserMutation(c.config, OpUpdate)
@@ -1760,6 +2012,21 @@ func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
 	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Tag.
 func (c *TagClient) Update() *TagUpdate {
 	mutation := newTagMutation(c.config, OpUpdate)
@@ -1778,6 +2045,17 @@ func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
 	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *TagClient) UpdateMany(ids []int, setFunc func(*TagUpdateOne)) *TagUpdateMany {
+	builders := make([]*TagUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &TagUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Tag.
 func (c *TagClient) Delete() *TagDelete {
 	mutation := newTagMutation(c.config, OpDelete)
@@ -1942,6 +2220,21 @@ func (c *TweetClient) CreateBulk(builders ...*TweetCreate) *TweetCreateBulk {
 	return &TweetCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TweetClient) MapCreateBulk(slice any, setFunc func(*TweetCreate, int)) *TweetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TweetCreateBulk{err: fmt.Errorf("calling to TweetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TweetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TweetCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Tweet.
 func (c *TweetClient) Update() *TweetUpdate {
 	mutation := newTweetMutation(c.config, OpUpdate)
@@ -1960,6 +2253,17 @@ func (c *TweetClient) UpdateOneID(id int) *TweetUpdateOne {
 	return &TweetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *TweetClient) UpdateMany(ids []int, setFunc func(*TweetUpdateOne)) *TweetUpdateMany {
+	builders := make([]*TweetUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &TweetUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Tweet.
 func (c *TweetClient) Delete() *TweetDelete {
 	mutation := newTweetMutation(c.config, OpDelete)
@@ -2156,6 +2460,21 @@ func (c *TweetLikeClient) CreateBulk(builders ...*TweetLikeCreate) *TweetLikeCre
 	return &TweetLikeCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TweetLikeClient) MapCreateBulk(slice any, setFunc func(*TweetLikeCreate, int)) *TweetLikeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TweetLikeCreateBulk{err: fmt.Errorf("calling to TweetLikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TweetLikeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TweetLikeCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for TweetLike.
 func (c *TweetLikeClient) Update() *TweetLikeUpdate {
 	mutation := newTweetLikeMutation(c.config, OpUpdate)
@@ -2258,6 +2577,21 @@ func (c *TweetTagClient) CreateBulk(builders ...*TweetTagCreate) *TweetTagCreate
 	return &TweetTagCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TweetTagClient) MapCreateBulk(slice any, setFunc func(*TweetTagCreate, int)) *TweetTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TweetTagCreateBulk{err: fmt.Errorf("calling to TweetTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TweetTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TweetTagCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for TweetTag.
 func (c *TweetTagClient) Update() *TweetTagUpdate {
 	mutation := newTweetTagMutation(c.config, OpUpdate)
@@ -2276,6 +2610,17 @@ func (c *TweetTagClient) UpdateOneID(id uuid.UUID) *TweetTagUpdateOne {
 	return &TweetTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *TweetTagClient) UpdateMany(ids []uuid.UUID, setFunc func(*TweetTagUpdateOne)) *TweetTagUpdateMany {
+	builders := make([]*TweetTagUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &TweetTagUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for TweetTag.
 func (c *TweetTagClient) Delete() *TweetTagDelete {
 	mutation := newTweetTagMutation(c.config, OpDelete)
@@ -2408,6 +2753,21 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -2426,6 +2786,17 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserClient) UpdateMany(ids []int, setFunc func(*UserUpdateOne)) *UserUpdateMany {
+	builders := make([]*UserUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -2719,6 +3090,21 @@ func (c *UserGroupClient) CreateBulk(builders ...*UserGroupCreate) *UserGroupCre
 	return &UserGroupCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserGroupClient) MapCreateBulk(slice any, setFunc func(*UserGroupCreate, int)) *UserGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserGroupCreateBulk{err: fmt.Errorf("calling to UserGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserGroupCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for UserGroup.
 func (c *UserGroupClient) Update() *UserGroupUpdate {
 	mutation := newUserGroupMutation(c.config, OpUpdate)
@@ -2737,6 +3123,17 @@ func (c *UserGroupClient) UpdateOneID(id int) *UserGroupUpdateOne {
 	return &UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserGroupClient) UpdateMany(ids []int, setFunc func(*UserGroupUpdateOne)) *UserGroupUpdateMany {
+	builders := make([]*UserGroupUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserGroupUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for UserGroup.
 func (c *UserGroupClient) Delete() *UserGroupDelete {
 	mutation := newUserGroupMutation(c.config, OpDelete)
@@ -2869,6 +3266,21 @@ func (c *UserTweetClient) CreateBulk(builders ...*UserTweetCreate) *UserTweetCre
 	return &UserTweetCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserTweetClient) MapCreateBulk(slice any, setFunc func(*UserTweetCreate, int)) *UserTweetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserTweetCreateBulk{err: fmt.Errorf("calling to UserTweetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserTweetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserTweetCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for UserTweet.
 func (c *UserTweetClient) Update() *UserTweetUpdate {
 	mutation := newUserTweetMutation(c.config, OpUpdate)
@@ -2887,6 +3299,17 @@ func (c *UserTweetClient) UpdateOneID(id int) *UserTweetUpdateOne {
 	return &UserTweetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserTweetClient) UpdateMany(ids []int, setFunc func(*UserTweetUpdateOne)) *UserTweetUpdateMany {
+	builders := make([]*UserTweetUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserTweetUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for UserTweet.
 func (c *UserTweetClient) Delete() *UserTweetDelete {
 	mutation := newUserTweetMutation(c.config, OpDelete)
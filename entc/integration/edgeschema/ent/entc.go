@@ -28,6 +28,7 @@ func main() {
 			gen.FeatureUpsert,
 			gen.FeaturePrivacy,
 			gen.FeatureSnapshot,
+			gen.FeatureExistsPredicates,
 		},
 	})
 	if err != nil {
@@ -8,11 +8,16 @@ package enttest
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"entgo.io/ent/entc/integration/edgeschema/ent"
 	// required by schema hooks.
 	_ "entgo.io/ent/entc/integration/edgeschema/ent/runtime"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/integration/edgeschema/ent/migrate"
 )
@@ -30,7 +35,10 @@ type (
 
 	options struct {
 		opts        []ent.Option
+		cleanup     bool
+		failOnError bool
 		migrateOpts []schema.MigrateOption
+		drv         *sql.Driver
 	}
 )
 
@@ -48,6 +56,24 @@ func WithMigrateOptions(opts ...schema.MigrateOption) Option {
 	}
 }
 
+// WithCleanup registers a cleanup function that closes the client when the test and all its subtests
+// complete. Note that TestingT must implement the Cleanup method (e.g. testing.T and testing.B).
+// For clients that were created by Open, it also deletes all rows from the schema tables, so
+// tests can share the same database without interfering with each other.
+func WithCleanup() Option {
+	return func(o *options) {
+		o.cleanup = true
+	}
+}
+
+// WithFailOnError fails the test on unexpected errors returned by queries and mutations. Errors
+// that tests may expect and assert, such as not-found, constraint and validation errors, are ignored.
+func WithFailOnError() Option {
+	return func(o *options) {
+		o.failOnError = true
+	}
+}
+
 func newOptions(opts []Option) *options {
 	o := &options{}
 	for _, opt := range opts {
@@ -59,12 +85,13 @@ func newOptions(opts []Option) *options {
 // Open calls ent.Open and auto-run migration.
 func Open(t TestingT, driverName, dataSourceName string, opts ...Option) *ent.Client {
 	o := newOptions(opts)
-	c, err := ent.Open(driverName, dataSourceName, o.opts...)
+	c, err := open(driverName, dataSourceName, o)
 	if err != nil {
 		t.Error(err)
 		t.FailNow()
 	}
 	migrateSchema(t, c, o)
+	setup(t, c, o)
 	return c
 }
 
@@ -73,8 +100,76 @@ func NewClient(t TestingT, opts ...Option) *ent.Client {
 	o := newOptions(opts)
 	c := ent.NewClient(o.opts...)
 	migrateSchema(t, c, o)
+	setup(t, c, o)
 	return c
 }
+
+// open opens the client with the given options.
+// The driver is opened by enttest in case the tables should be truncated on cleanup.
+func open(driverName, dataSourceName string, o *options) (*ent.Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		if !o.cleanup {
+			break
+		}
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		o.drv = drv
+		return ent.NewClient(append(o.opts, ent.Driver(drv))...), nil
+	}
+	return ent.Open(driverName, dataSourceName, o.opts...)
+}
+
+// setup registers the cleanup and the error checks of the client.
+func setup(t TestingT, c *ent.Client, o *options) {
+	if o.cleanup {
+		ct, ok := t.(interface{ Cleanup(func()) })
+		if !ok {
+			t.Error(fmt.Errorf("enttest: %T does not support cleanup functions", t))
+			t.FailNow()
+		}
+		ct.Cleanup(func() {
+			if o.drv != nil {
+				if err := truncate(context.Background(), o.drv); err != nil {
+					t.Error(err)
+				}
+			}
+			if err := c.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	if o.failOnError {
+		check := func(err error) {
+			if err != nil && !expectedError(err) {
+				t.Error(fmt.Errorf("enttest: unexpected error: %w", err))
+			}
+		}
+		c.Use(func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				v, err := next.Mutate(ctx, m)
+				check(err)
+				return v, err
+			})
+		})
+		c.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				v, err := next.Query(ctx, q)
+				check(err)
+				return v, err
+			})
+		}))
+	}
+}
+
+// expectedError reports if the error is expected to be asserted by tests.
+func expectedError(err error) bool {
+	return ent.IsNotFound(err) || ent.IsNotSingular(err) || ent.IsNotLoaded(err) ||
+		ent.IsConstraintError(err) || ent.IsValidationError(err) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
 func migrateSchema(t TestingT, c *ent.Client, o *options) {
 	tables, err := schema.CopyTables(migrate.Tables)
 	if err != nil {
@@ -86,3 +181,46 @@ func migrateSchema(t TestingT, c *ent.Client, o *options) {
 		t.FailNow()
 	}
 }
+
+// truncate deletes all rows from the schema tables. Foreign-key checks
+// are deferred or disabled, so tables can be truncated in any order.
+func truncate(ctx context.Context, drv *sql.Driver) error {
+	var stmts []string
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		names := make([]string, 0, len(migrate.Tables))
+		for _, t := range migrate.Tables {
+			names = append(names, fmt.Sprintf("%q", t.Name))
+		}
+		if len(names) > 0 {
+			stmts = append(stmts, fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", strings.Join(names, ", ")))
+		}
+	case dialect.MySQL:
+		stmts = append(stmts, "SET FOREIGN_KEY_CHECKS = 0")
+		for _, t := range migrate.Tables {
+			query, _ := sql.Dialect(dialect.MySQL).Delete(t.Name).Query()
+			stmts = append(stmts, query)
+		}
+		stmts = append(stmts, "SET FOREIGN_KEY_CHECKS = 1")
+	default:
+		stmts = append(stmts, "PRAGMA defer_foreign_keys = ON")
+		for _, t := range migrate.Tables {
+			query, _ := sql.Dialect(drv.Dialect()).Delete(t.Name).Query()
+			stmts = append(stmts, query)
+		}
+	}
+	// A transaction is used for executing all statements on the same connection.
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if err := tx.Exec(ctx, stmt, []any{}, nil); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: %v", err, rerr)
+			}
+			return fmt.Errorf("enttest: truncating tables: %w", err)
+		}
+	}
+	return tx.Commit()
+}
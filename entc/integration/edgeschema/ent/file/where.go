@@ -127,6 +127,11 @@ func NameContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasProcesses applies the HasEdge predicate on the "processes" edge.
 func HasProcesses() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -142,7 +147,7 @@ func HasProcesses() predicate.File {
 func HasProcessesWith(preds ...predicate.Process) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
 		step := newProcessesStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -81,10 +81,11 @@ func (fc *FileCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fc *FileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "File.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "File", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "File.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
@@ -94,7 +95,7 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 	_node, _spec := fc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -195,6 +196,12 @@ func (u *FileUpsert) UpdateName() *FileUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *FileUpsert) IgnoreName() *FileUpsert {
+	u.SetIgnore(file.FieldName)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -249,6 +256,14 @@ func (u *FileUpsertOne) UpdateName() *FileUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *FileUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -285,12 +300,16 @@ func (u *FileUpsertOne) IDX(ctx context.Context) int {
 // FileCreateBulk is the builder for creating many File entities in bulk.
 type FileCreateBulk struct {
 	config
+	err      error
 	builders []*FileCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the File entities in the database.
 func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
 	nodes := make([]*File, len(fcb.builders))
 	mutators := make([]Mutator, len(fcb.builders))
@@ -316,7 +335,7 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -462,6 +481,14 @@ func (u *FileUpsertBulk) UpdateName() *FileUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *FileUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -357,7 +357,7 @@ func (fq *FileQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range fq.ctx.Fields {
 		if !file.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "File", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if fq.path != nil {
@@ -407,6 +407,22 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 }
 
 func (fq *FileQuery) loadProcesses(ctx context.Context, query *ProcessQuery, nodes []*File, init func(*File), assign func(*File, *Process)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := fq.loadProcesses(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*File)
 	nids := make(map[int]map[*File]struct{})
@@ -552,7 +568,8 @@ func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	selector
-	build *FileQuery
+	build  *FileQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -570,6 +587,14 @@ func (fgb *FileGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*FileQuery, *FileGroupBy](ctx, fgb.build, fgb, fgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (fgb *FileGroupBy) Having(ps ...*sql.Predicate) *FileGroupBy {
+	fgb.having = append(fgb.having, ps...)
+	return fgb
+}
+
 func (fgb *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(fgb.fns))
@@ -585,6 +610,9 @@ func (fgb *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*fgb.flds...)...)
+	if len(fgb.having) > 0 {
+		selector.Having(sql.And(fgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
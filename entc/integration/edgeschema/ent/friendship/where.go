@@ -199,6 +199,12 @@ func FriendIDNotIn(vs ...int) predicate.Friendship {
 	return predicate.Friendship(sql.FieldNotIn(FieldFriendID, vs...))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.Friendship {
+	return predicate.Friendship(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
@@ -214,7 +220,7 @@ func HasUser() predicate.Friendship {
 func HasUserWith(preds ...predicate.User) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -237,7 +243,7 @@ func HasFriend() predicate.Friendship {
 func HasFriendWith(preds ...predicate.User) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		step := newFriendStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -124,25 +124,26 @@ func (fc *FriendshipCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (fc *FriendshipCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Weight(); !ok {
-		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Friendship.weight"`)}
+		errs = append(errs, &ValidationError{Name: "weight", Entity: "Friendship", Field: "weight", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.weight"`)})
 	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Friendship.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "Friendship", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.created_at"`)})
 	}
 	if _, ok := fc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Friendship.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "Friendship", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.user_id"`)})
 	}
 	if _, ok := fc.mutation.FriendID(); !ok {
-		return &ValidationError{Name: "friend_id", err: errors.New(`ent: missing required field "Friendship.friend_id"`)}
+		errs = append(errs, &ValidationError{Name: "friend_id", Entity: "Friendship", Field: "friend_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.friend_id"`)})
 	}
 	if _, ok := fc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Friendship.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Friendship", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Friendship.user"`)})
 	}
 	if _, ok := fc.mutation.FriendID(); !ok {
-		return &ValidationError{Name: "friend", err: errors.New(`ent: missing required edge "Friendship.friend"`)}
+		errs = append(errs, &ValidationError{Name: "friend", Entity: "Friendship", Field: "friend", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Friendship.friend"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FriendshipCreate) sqlSave(ctx context.Context) (*Friendship, error) {
@@ -152,7 +153,7 @@ func (fc *FriendshipCreate) sqlSave(ctx context.Context) (*Friendship, error) {
 	_node, _spec := fc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -275,6 +276,12 @@ func (u *FriendshipUpsert) UpdateWeight() *FriendshipUpsert {
 	return u
 }
 
+// IgnoreWeight keeps the current value of the "weight" field in case of conflict.
+func (u *FriendshipUpsert) IgnoreWeight() *FriendshipUpsert {
+	u.SetIgnore(friendship.FieldWeight)
+	return u
+}
+
 // AddWeight adds v to the "weight" field.
 func (u *FriendshipUpsert) AddWeight(v int) *FriendshipUpsert {
 	u.Add(friendship.FieldWeight, v)
@@ -293,6 +300,12 @@ func (u *FriendshipUpsert) UpdateCreatedAt() *FriendshipUpsert {
 	return u
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+func (u *FriendshipUpsert) IgnoreCreatedAt() *FriendshipUpsert {
+	u.SetIgnore(friendship.FieldCreatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -362,6 +375,14 @@ func (u *FriendshipUpsertOne) UpdateWeight() *FriendshipUpsertOne {
 	})
 }
 
+// IgnoreWeight keeps the current value of the "weight" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FriendshipUpsertOne) IgnoreWeight() *FriendshipUpsertOne {
+	return u.Update(func(s *FriendshipUpsert) {
+		s.IgnoreWeight()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FriendshipUpsertOne) SetCreatedAt(v time.Time) *FriendshipUpsertOne {
 	return u.Update(func(s *FriendshipUpsert) {
@@ -376,6 +397,14 @@ func (u *FriendshipUpsertOne) UpdateCreatedAt() *FriendshipUpsertOne {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FriendshipUpsertOne) IgnoreCreatedAt() *FriendshipUpsertOne {
+	return u.Update(func(s *FriendshipUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *FriendshipUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -412,12 +441,16 @@ func (u *FriendshipUpsertOne) IDX(ctx context.Context) int {
 // FriendshipCreateBulk is the builder for creating many Friendship entities in bulk.
 type FriendshipCreateBulk struct {
 	config
+	err      error
 	builders []*FriendshipCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Friendship entities in the database.
 func (fcb *FriendshipCreateBulk) Save(ctx context.Context) ([]*Friendship, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
 	nodes := make([]*Friendship, len(fcb.builders))
 	mutators := make([]Mutator, len(fcb.builders))
@@ -444,7 +477,7 @@ func (fcb *FriendshipCreateBulk) Save(ctx context.Context) ([]*Friendship, error
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -607,6 +640,14 @@ func (u *FriendshipUpsertBulk) UpdateWeight() *FriendshipUpsertBulk {
 	})
 }
 
+// IgnoreWeight keeps the current value of the "weight" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FriendshipUpsertBulk) IgnoreWeight() *FriendshipUpsertBulk {
+	return u.Update(func(s *FriendshipUpsert) {
+		s.IgnoreWeight()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FriendshipUpsertBulk) SetCreatedAt(v time.Time) *FriendshipUpsertBulk {
 	return u.Update(func(s *FriendshipUpsert) {
@@ -621,6 +662,14 @@ func (u *FriendshipUpsertBulk) UpdateCreatedAt() *FriendshipUpsertBulk {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FriendshipUpsertBulk) IgnoreCreatedAt() *FriendshipUpsertBulk {
+	return u.Update(func(s *FriendshipUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *FriendshipUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -101,13 +102,14 @@ func (fu *FriendshipUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fu *FriendshipUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := fu.mutation.UserID(); fu.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Friendship.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Friendship", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Friendship.user"`)})
 	}
 	if _, ok := fu.mutation.FriendID(); fu.mutation.FriendCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Friendship.friend"`)
+		errs = append(errs, &ValidationError{Name: "friend", Entity: "Friendship", Field: "friend", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Friendship.friend"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fu *FriendshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -135,7 +137,7 @@ func (fu *FriendshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{friendship.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -233,13 +235,14 @@ func (fuo *FriendshipUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fuo *FriendshipUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := fuo.mutation.UserID(); fuo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Friendship.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Friendship", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Friendship.user"`)})
 	}
 	if _, ok := fuo.mutation.FriendID(); fuo.mutation.FriendCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Friendship.friend"`)
+		errs = append(errs, &ValidationError{Name: "friend", Entity: "Friendship", Field: "friend", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Friendship.friend"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship, err error) {
@@ -249,7 +252,7 @@ func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship,
 	_spec := sqlgraph.NewUpdateSpec(friendship.Table, friendship.Columns, sqlgraph.NewFieldSpec(friendship.FieldID, field.TypeInt))
 	id, ok := fuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Friendship.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Friendship", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Friendship.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := fuo.fields; len(fields) > 0 {
@@ -257,7 +260,7 @@ func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship,
 		_spec.Node.Columns = append(_spec.Node.Columns, friendship.FieldID)
 		for _, f := range fields {
 			if !friendship.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Friendship", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != friendship.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -287,10 +290,69 @@ func (fuo *FriendshipUpdateOne) sqlSave(ctx context.Context) (_node *Friendship,
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{friendship.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	fuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (fuo *FriendshipUpdateOne) sameUpdate(other *FriendshipUpdateOne) bool {
+	m1, m2 := *fuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// FriendshipUpdateMany is the builder for updating many Friendship entities by their ids.
+type FriendshipUpdateMany struct {
+	config
+	builders []*FriendshipUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (fum *FriendshipUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*FriendshipUpdateOne
+Builders:
+	for _, b := range fum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*FriendshipUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(friendship.IDIn(ids...))
+		if err := (&FriendshipUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fum *FriendshipUpdateMany) ExecX(ctx context.Context) {
+	if err := fum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,8 @@
 package group
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -152,6 +154,88 @@ func ByGroupTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newGroupTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "users_count") that Group queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Group queries can be ordered by.
+const (
+	OrderFieldID               OrderField = "id"
+	OrderFieldName             OrderField = "name"
+	OrderFieldUsersCount       OrderField = "users_count"
+	OrderFieldTagsCount        OrderField = "tags_count"
+	OrderFieldJoinedUsersCount OrderField = "joined_users_count"
+	OrderFieldGroupTagsCount   OrderField = "group_tags_count"
+)
+
+// OrderFields holds all fields and edge counts that Group queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldName,
+	OrderFieldUsersCount,
+	OrderFieldTagsCount,
+	OrderFieldJoinedUsersCount,
+	OrderFieldGroupTagsCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Group queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("group: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := group.OrderByField(group.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Group.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldUsersCount:
+		return ByUsersCount(opts...), nil
+	case OrderFieldTagsCount:
+		return ByTagsCount(opts...), nil
+	case OrderFieldJoinedUsersCount:
+		return ByJoinedUsersCount(opts...), nil
+	case OrderFieldGroupTagsCount:
+		return ByGroupTagsCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
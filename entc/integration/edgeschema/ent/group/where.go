@@ -127,6 +127,11 @@ func NameContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -142,7 +147,7 @@ func HasUsers() predicate.Group {
 func HasUsersWith(preds ...predicate.User) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
 		step := newUsersStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -165,7 +170,7 @@ func HasTags() predicate.Group {
 func HasTagsWith(preds ...predicate.Tag) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
 		step := newTagsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -188,7 +193,7 @@ func HasJoinedUsers() predicate.Group {
 func HasJoinedUsersWith(preds ...predicate.UserGroup) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
 		step := newJoinedUsersStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -211,7 +216,7 @@ func HasGroupTags() predicate.Group {
 func HasGroupTagsWith(preds ...predicate.GroupTag) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
 		step := newGroupTagsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
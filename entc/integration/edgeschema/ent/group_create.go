@@ -146,10 +146,11 @@ func (gc *GroupCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (gc *GroupCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Group.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
@@ -159,7 +160,7 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 	_node, _spec := gc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -312,6 +313,12 @@ func (u *GroupUpsert) UpdateName() *GroupUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *GroupUpsert) IgnoreName() *GroupUpsert {
+	u.SetIgnore(group.FieldName)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -366,6 +373,14 @@ func (u *GroupUpsertOne) UpdateName() *GroupUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertOne) IgnoreName() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -402,12 +417,16 @@ func (u *GroupUpsertOne) IDX(ctx context.Context) int {
 // GroupCreateBulk is the builder for creating many Group entities in bulk.
 type GroupCreateBulk struct {
 	config
+	err      error
 	builders []*GroupCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Group entities in the database.
 func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
 	nodes := make([]*Group, len(gcb.builders))
 	mutators := make([]Mutator, len(gcb.builders))
@@ -434,7 +453,7 @@ func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -580,6 +599,14 @@ func (u *GroupUpsertBulk) UpdateName() *GroupUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertBulk) IgnoreName() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
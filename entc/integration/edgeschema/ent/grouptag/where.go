@@ -122,7 +122,7 @@ func HasTag() predicate.GroupTag {
 func HasTagWith(preds ...predicate.Tag) predicate.GroupTag {
 	return predicate.GroupTag(func(s *sql.Selector) {
 		step := newTagStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -145,7 +145,7 @@ func HasGroup() predicate.GroupTag {
 func HasGroupWith(preds ...predicate.Group) predicate.GroupTag {
 	return predicate.GroupTag(func(s *sql.Selector) {
 		step := newGroupStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -83,19 +83,20 @@ func (gtc *GroupTagCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gtc *GroupTagCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gtc.mutation.TagID(); !ok {
-		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "GroupTag.tag_id"`)}
+		errs = append(errs, &ValidationError{Name: "tag_id", Entity: "GroupTag", Field: "tag_id", Reason: "missing required field", err: errors.New(`ent: missing required field "GroupTag.tag_id"`)})
 	}
 	if _, ok := gtc.mutation.GroupID(); !ok {
-		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "GroupTag.group_id"`)}
+		errs = append(errs, &ValidationError{Name: "group_id", Entity: "GroupTag", Field: "group_id", Reason: "missing required field", err: errors.New(`ent: missing required field "GroupTag.group_id"`)})
 	}
 	if _, ok := gtc.mutation.TagID(); !ok {
-		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "GroupTag.tag"`)}
+		errs = append(errs, &ValidationError{Name: "tag", Entity: "GroupTag", Field: "tag", Reason: "missing required edge", err: errors.New(`ent: missing required edge "GroupTag.tag"`)})
 	}
 	if _, ok := gtc.mutation.GroupID(); !ok {
-		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupTag.group"`)}
+		errs = append(errs, &ValidationError{Name: "group", Entity: "GroupTag", Field: "group", Reason: "missing required edge", err: errors.New(`ent: missing required edge "GroupTag.group"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gtc *GroupTagCreate) sqlSave(ctx context.Context) (*GroupTag, error) {
@@ -105,7 +106,7 @@ func (gtc *GroupTagCreate) sqlSave(ctx context.Context) (*GroupTag, error) {
 	_node, _spec := gtc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, gtc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -220,6 +221,12 @@ func (u *GroupTagUpsert) UpdateTagID() *GroupTagUpsert {
 	return u
 }
 
+// IgnoreTagID keeps the current value of the "tag_id" field in case of conflict.
+func (u *GroupTagUpsert) IgnoreTagID() *GroupTagUpsert {
+	u.SetIgnore(grouptag.FieldTagID)
+	return u
+}
+
 // SetGroupID sets the "group_id" field.
 func (u *GroupTagUpsert) SetGroupID(v int) *GroupTagUpsert {
 	u.Set(grouptag.FieldGroupID, v)
@@ -232,6 +239,12 @@ func (u *GroupTagUpsert) UpdateGroupID() *GroupTagUpsert {
 	return u
 }
 
+// IgnoreGroupID keeps the current value of the "group_id" field in case of conflict.
+func (u *GroupTagUpsert) IgnoreGroupID() *GroupTagUpsert {
+	u.SetIgnore(grouptag.FieldGroupID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -286,6 +299,14 @@ func (u *GroupTagUpsertOne) UpdateTagID() *GroupTagUpsertOne {
 	})
 }
 
+// IgnoreTagID keeps the current value of the "tag_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupTagUpsertOne) IgnoreTagID() *GroupTagUpsertOne {
+	return u.Update(func(s *GroupTagUpsert) {
+		s.IgnoreTagID()
+	})
+}
+
 // SetGroupID sets the "group_id" field.
 func (u *GroupTagUpsertOne) SetGroupID(v int) *GroupTagUpsertOne {
 	return u.Update(func(s *GroupTagUpsert) {
@@ -300,6 +321,14 @@ func (u *GroupTagUpsertOne) UpdateGroupID() *GroupTagUpsertOne {
 	})
 }
 
+// IgnoreGroupID keeps the current value of the "group_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupTagUpsertOne) IgnoreGroupID() *GroupTagUpsertOne {
+	return u.Update(func(s *GroupTagUpsert) {
+		s.IgnoreGroupID()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupTagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -336,12 +365,16 @@ func (u *GroupTagUpsertOne) IDX(ctx context.Context) int {
 // GroupTagCreateBulk is the builder for creating many GroupTag entities in bulk.
 type GroupTagCreateBulk struct {
 	config
+	err      error
 	builders []*GroupTagCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the GroupTag entities in the database.
 func (gtcb *GroupTagCreateBulk) Save(ctx context.Context) ([]*GroupTag, error) {
+	if gtcb.err != nil {
+		return nil, gtcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(gtcb.builders))
 	nodes := make([]*GroupTag, len(gtcb.builders))
 	mutators := make([]Mutator, len(gtcb.builders))
@@ -367,7 +400,7 @@ func (gtcb *GroupTagCreateBulk) Save(ctx context.Context) ([]*GroupTag, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, gtcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -513,6 +546,14 @@ func (u *GroupTagUpsertBulk) UpdateTagID() *GroupTagUpsertBulk {
 	})
 }
 
+// IgnoreTagID keeps the current value of the "tag_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupTagUpsertBulk) IgnoreTagID() *GroupTagUpsertBulk {
+	return u.Update(func(s *GroupTagUpsert) {
+		s.IgnoreTagID()
+	})
+}
+
 // SetGroupID sets the "group_id" field.
 func (u *GroupTagUpsertBulk) SetGroupID(v int) *GroupTagUpsertBulk {
 	return u.Update(func(s *GroupTagUpsert) {
@@ -527,6 +568,14 @@ func (u *GroupTagUpsertBulk) UpdateGroupID() *GroupTagUpsertBulk {
 	})
 }
 
+// IgnoreGroupID keeps the current value of the "group_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupTagUpsertBulk) IgnoreGroupID() *GroupTagUpsertBulk {
+	return u.Update(func(s *GroupTagUpsert) {
+		s.IgnoreGroupID()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupTagUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -101,13 +102,14 @@ func (gtu *GroupTagUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gtu *GroupTagUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := gtu.mutation.TagID(); gtu.mutation.TagCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "GroupTag.tag"`)
+		errs = append(errs, &ValidationError{Name: "tag", Entity: "GroupTag", Field: "tag", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "GroupTag.tag"`)})
 	}
 	if _, ok := gtu.mutation.GroupID(); gtu.mutation.GroupCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "GroupTag.group"`)
+		errs = append(errs, &ValidationError{Name: "group", Entity: "GroupTag", Field: "group", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "GroupTag.group"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gtu *GroupTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -184,7 +186,7 @@ func (gtu *GroupTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{grouptag.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -281,13 +283,14 @@ func (gtuo *GroupTagUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gtuo *GroupTagUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := gtuo.mutation.TagID(); gtuo.mutation.TagCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "GroupTag.tag"`)
+		errs = append(errs, &ValidationError{Name: "tag", Entity: "GroupTag", Field: "tag", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "GroupTag.tag"`)})
 	}
 	if _, ok := gtuo.mutation.GroupID(); gtuo.mutation.GroupCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "GroupTag.group"`)
+		errs = append(errs, &ValidationError{Name: "group", Entity: "GroupTag", Field: "group", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "GroupTag.group"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gtuo *GroupTagUpdateOne) sqlSave(ctx context.Context) (_node *GroupTag, err error) {
@@ -297,7 +300,7 @@ func (gtuo *GroupTagUpdateOne) sqlSave(ctx context.Context) (_node *GroupTag, er
 	_spec := sqlgraph.NewUpdateSpec(grouptag.Table, grouptag.Columns, sqlgraph.NewFieldSpec(grouptag.FieldID, field.TypeInt))
 	id, ok := gtuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupTag.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "GroupTag", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "GroupTag.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := gtuo.fields; len(fields) > 0 {
@@ -305,7 +308,7 @@ func (gtuo *GroupTagUpdateOne) sqlSave(ctx context.Context) (_node *GroupTag, er
 		_spec.Node.Columns = append(_spec.Node.Columns, grouptag.FieldID)
 		for _, f := range fields {
 			if !grouptag.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "GroupTag", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != grouptag.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -384,10 +387,69 @@ func (gtuo *GroupTagUpdateOne) sqlSave(ctx context.Context) (_node *GroupTag, er
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{grouptag.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	gtuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (gtuo *GroupTagUpdateOne) sameUpdate(other *GroupTagUpdateOne) bool {
+	m1, m2 := *gtuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// GroupTagUpdateMany is the builder for updating many GroupTag entities by their ids.
+type GroupTagUpdateMany struct {
+	config
+	builders []*GroupTagUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (gtum *GroupTagUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*GroupTagUpdateOne
+Builders:
+	for _, b := range gtum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*GroupTagUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(grouptag.IDIn(ids...))
+		if err := (&GroupTagUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtum *GroupTagUpdateMany) ExecX(ctx context.Context) {
+	if err := gtum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -72,7 +72,7 @@ func HasFiles() predicate.Process {
 func HasFilesWith(preds ...predicate.File) predicate.Process {
 	return predicate.Process(func(s *sql.Selector) {
 		step := newFilesStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -95,7 +95,7 @@ func HasAttachedFiles() predicate.Process {
 func HasAttachedFilesWith(preds ...predicate.AttachedFile) predicate.Process {
 	return predicate.Process(func(s *sql.Selector) {
 		step := newAttachedFilesStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -91,7 +91,8 @@ func (pc *ProcessCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *ProcessCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (pc *ProcessCreate) sqlSave(ctx context.Context) (*Process, error) {
@@ -101,7 +102,7 @@ func (pc *ProcessCreate) sqlSave(ctx context.Context) (*Process, error) {
 	_node, _spec := pc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -276,12 +277,16 @@ func (u *ProcessUpsertOne) IDX(ctx context.Context) int {
 // ProcessCreateBulk is the builder for creating many Process entities in bulk.
 type ProcessCreateBulk struct {
 	config
+	err      error
 	builders []*ProcessCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Process entities in the database.
 func (pcb *ProcessCreateBulk) Save(ctx context.Context) ([]*Process, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Process, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
@@ -307,7 +312,7 @@ func (pcb *ProcessCreateBulk) Save(ctx context.Context) ([]*Process, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
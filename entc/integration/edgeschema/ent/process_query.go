@@ -371,7 +371,7 @@ func (pq *ProcessQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range pq.ctx.Fields {
 		if !process.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Process", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if pq.path != nil {
@@ -429,6 +429,22 @@ func (pq *ProcessQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proc
 }
 
 func (pq *ProcessQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*Process, init func(*Process), assign func(*Process, *File)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := pq.loadFiles(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Process)
 	nids := make(map[int]map[*Process]struct{})
@@ -490,6 +506,22 @@ func (pq *ProcessQuery) loadFiles(ctx context.Context, query *FileQuery, nodes [
 	return nil
 }
 func (pq *ProcessQuery) loadAttachedFiles(ctx context.Context, query *AttachedFileQuery, nodes []*Process, init func(*Process), assign func(*Process, *AttachedFile)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := pq.loadAttachedFiles(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Process)
 	for i := range nodes {
@@ -604,7 +636,8 @@ func (pq *ProcessQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // ProcessGroupBy is the group-by builder for Process entities.
 type ProcessGroupBy struct {
 	selector
-	build *ProcessQuery
+	build  *ProcessQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -622,6 +655,14 @@ func (pgb *ProcessGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*ProcessQuery, *ProcessGroupBy](ctx, pgb.build, pgb, pgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (pgb *ProcessGroupBy) Having(ps ...*sql.Predicate) *ProcessGroupBy {
+	pgb.having = append(pgb.having, ps...)
+	return pgb
+}
+
 func (pgb *ProcessGroupBy) sqlScan(ctx context.Context, root *ProcessQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(pgb.fns))
@@ -637,6 +678,9 @@ func (pgb *ProcessGroupBy) sqlScan(ctx context.Context, root *ProcessQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*pgb.flds...)...)
+	if len(pgb.having) > 0 {
+		selector.Having(sql.And(pgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -7,6 +7,8 @@
 package relationship
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -131,6 +133,80 @@ func ByInfoField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInfoStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that Relationship queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Relationship queries can be ordered by.
+const (
+	OrderFieldWeight     OrderField = "weight"
+	OrderFieldUserID     OrderField = "user_id"
+	OrderFieldRelativeID OrderField = "relative_id"
+	OrderFieldInfoID     OrderField = "info_id"
+)
+
+// OrderFields holds all fields and edge counts that Relationship queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldWeight,
+	OrderFieldUserID,
+	OrderFieldRelativeID,
+	OrderFieldInfoID,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Relationship queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("relationship: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := relationship.OrderByField(relationship.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Relationship.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldWeight:
+		return ByWeight(opts...), nil
+	case OrderFieldUserID:
+		return ByUserID(opts...), nil
+	case OrderFieldRelativeID:
+		return ByRelativeID(opts...), nil
+	case OrderFieldInfoID:
+		return ByInfoID(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, UserColumn),
@@ -157,7 +157,7 @@ func HasUser() predicate.Relationship {
 func HasUserWith(preds ...predicate.User) predicate.Relationship {
 	return predicate.Relationship(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -180,7 +180,7 @@ func HasRelative() predicate.Relationship {
 func HasRelativeWith(preds ...predicate.User) predicate.Relationship {
 	return predicate.Relationship(func(s *sql.Selector) {
 		step := newRelativeStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -203,7 +203,7 @@ func HasInfo() predicate.Relationship {
 func HasInfoWith(preds ...predicate.RelationshipInfo) predicate.Relationship {
 	return predicate.Relationship(func(s *sql.Selector) {
 		step := newInfoStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -128,22 +128,23 @@ func (rc *RelationshipCreate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (rc *RelationshipCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := rc.mutation.Weight(); !ok {
-		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Relationship.weight"`)}
+		errs = append(errs, &ValidationError{Name: "weight", Entity: "Relationship", Field: "weight", Reason: "missing required field", err: errors.New(`ent: missing required field "Relationship.weight"`)})
 	}
 	if _, ok := rc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Relationship.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "Relationship", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Relationship.user_id"`)})
 	}
 	if _, ok := rc.mutation.RelativeID(); !ok {
-		return &ValidationError{Name: "relative_id", err: errors.New(`ent: missing required field "Relationship.relative_id"`)}
+		errs = append(errs, &ValidationError{Name: "relative_id", Entity: "Relationship", Field: "relative_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Relationship.relative_id"`)})
 	}
 	if _, ok := rc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Relationship.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Relationship", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Relationship.user"`)})
 	}
 	if _, ok := rc.mutation.RelativeID(); !ok {
-		return &ValidationError{Name: "relative", err: errors.New(`ent: missing required edge "Relationship.relative"`)}
+		errs = append(errs, &ValidationError{Name: "relative", Entity: "Relationship", Field: "relative", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Relationship.relative"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (rc *RelationshipCreate) sqlSave(ctx context.Context) (*Relationship, error) {
@@ -153,7 +154,7 @@ func (rc *RelationshipCreate) sqlSave(ctx context.Context) (*Relationship, error
 	_node, _spec := rc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -285,6 +286,12 @@ func (u *RelationshipUpsert) UpdateWeight() *RelationshipUpsert {
 	return u
 }
 
+// IgnoreWeight keeps the current value of the "weight" field in case of conflict.
+func (u *RelationshipUpsert) IgnoreWeight() *RelationshipUpsert {
+	u.SetIgnore(relationship.FieldWeight)
+	return u
+}
+
 // AddWeight adds v to the "weight" field.
 func (u *RelationshipUpsert) AddWeight(v int) *RelationshipUpsert {
 	u.Add(relationship.FieldWeight, v)
@@ -303,6 +310,12 @@ func (u *RelationshipUpsert) UpdateUserID() *RelationshipUpsert {
 	return u
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+func (u *RelationshipUpsert) IgnoreUserID() *RelationshipUpsert {
+	u.SetIgnore(relationship.FieldUserID)
+	return u
+}
+
 // SetRelativeID sets the "relative_id" field.
 func (u *RelationshipUpsert) SetRelativeID(v int) *RelationshipUpsert {
 	u.Set(relationship.FieldRelativeID, v)
@@ -315,6 +328,12 @@ func (u *RelationshipUpsert) UpdateRelativeID() *RelationshipUpsert {
 	return u
 }
 
+// IgnoreRelativeID keeps the current value of the "relative_id" field in case of conflict.
+func (u *RelationshipUpsert) IgnoreRelativeID() *RelationshipUpsert {
+	u.SetIgnore(relationship.FieldRelativeID)
+	return u
+}
+
 // SetInfoID sets the "info_id" field.
 func (u *RelationshipUpsert) SetInfoID(v int) *RelationshipUpsert {
 	u.Set(relationship.FieldInfoID, v)
@@ -327,6 +346,12 @@ func (u *RelationshipUpsert) UpdateInfoID() *RelationshipUpsert {
 	return u
 }
 
+// IgnoreInfoID keeps the current value of the "info_id" field in case of conflict.
+func (u *RelationshipUpsert) IgnoreInfoID() *RelationshipUpsert {
+	u.SetIgnore(relationship.FieldInfoID)
+	return u
+}
+
 // ClearInfoID clears the value of the "info_id" field.
 func (u *RelationshipUpsert) ClearInfoID() *RelationshipUpsert {
 	u.SetNull(relationship.FieldInfoID)
@@ -394,6 +419,14 @@ func (u *RelationshipUpsertOne) UpdateWeight() *RelationshipUpsertOne {
 	})
 }
 
+// IgnoreWeight keeps the current value of the "weight" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertOne) IgnoreWeight() *RelationshipUpsertOne {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreWeight()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RelationshipUpsertOne) SetUserID(v int) *RelationshipUpsertOne {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -408,6 +441,14 @@ func (u *RelationshipUpsertOne) UpdateUserID() *RelationshipUpsertOne {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertOne) IgnoreUserID() *RelationshipUpsertOne {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetRelativeID sets the "relative_id" field.
 func (u *RelationshipUpsertOne) SetRelativeID(v int) *RelationshipUpsertOne {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -422,6 +463,14 @@ func (u *RelationshipUpsertOne) UpdateRelativeID() *RelationshipUpsertOne {
 	})
 }
 
+// IgnoreRelativeID keeps the current value of the "relative_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertOne) IgnoreRelativeID() *RelationshipUpsertOne {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreRelativeID()
+	})
+}
+
 // SetInfoID sets the "info_id" field.
 func (u *RelationshipUpsertOne) SetInfoID(v int) *RelationshipUpsertOne {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -436,6 +485,14 @@ func (u *RelationshipUpsertOne) UpdateInfoID() *RelationshipUpsertOne {
 	})
 }
 
+// IgnoreInfoID keeps the current value of the "info_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertOne) IgnoreInfoID() *RelationshipUpsertOne {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreInfoID()
+	})
+}
+
 // ClearInfoID clears the value of the "info_id" field.
 func (u *RelationshipUpsertOne) ClearInfoID() *RelationshipUpsertOne {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -461,12 +518,16 @@ func (u *RelationshipUpsertOne) ExecX(ctx context.Context) {
 // RelationshipCreateBulk is the builder for creating many Relationship entities in bulk.
 type RelationshipCreateBulk struct {
 	config
+	err      error
 	builders []*RelationshipCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Relationship entities in the database.
 func (rcb *RelationshipCreateBulk) Save(ctx context.Context) ([]*Relationship, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
 	nodes := make([]*Relationship, len(rcb.builders))
 	mutators := make([]Mutator, len(rcb.builders))
@@ -493,7 +554,7 @@ func (rcb *RelationshipCreateBulk) Save(ctx context.Context) ([]*Relationship, e
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -641,6 +702,14 @@ func (u *RelationshipUpsertBulk) UpdateWeight() *RelationshipUpsertBulk {
 	})
 }
 
+// IgnoreWeight keeps the current value of the "weight" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertBulk) IgnoreWeight() *RelationshipUpsertBulk {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreWeight()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RelationshipUpsertBulk) SetUserID(v int) *RelationshipUpsertBulk {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -655,6 +724,14 @@ func (u *RelationshipUpsertBulk) UpdateUserID() *RelationshipUpsertBulk {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertBulk) IgnoreUserID() *RelationshipUpsertBulk {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetRelativeID sets the "relative_id" field.
 func (u *RelationshipUpsertBulk) SetRelativeID(v int) *RelationshipUpsertBulk {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -669,6 +746,14 @@ func (u *RelationshipUpsertBulk) UpdateRelativeID() *RelationshipUpsertBulk {
 	})
 }
 
+// IgnoreRelativeID keeps the current value of the "relative_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertBulk) IgnoreRelativeID() *RelationshipUpsertBulk {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreRelativeID()
+	})
+}
+
 // SetInfoID sets the "info_id" field.
 func (u *RelationshipUpsertBulk) SetInfoID(v int) *RelationshipUpsertBulk {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -683,6 +768,14 @@ func (u *RelationshipUpsertBulk) UpdateInfoID() *RelationshipUpsertBulk {
 	})
 }
 
+// IgnoreInfoID keeps the current value of the "info_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipUpsertBulk) IgnoreInfoID() *RelationshipUpsertBulk {
+	return u.Update(func(s *RelationshipUpsert) {
+		s.IgnoreInfoID()
+	})
+}
+
 // ClearInfoID clears the value of the "info_id" field.
 func (u *RelationshipUpsertBulk) ClearInfoID() *RelationshipUpsertBulk {
 	return u.Update(func(s *RelationshipUpsert) {
@@ -153,13 +153,14 @@ func (ru *RelationshipUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ru *RelationshipUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := ru.mutation.UserID(); ru.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Relationship.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Relationship", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Relationship.user"`)})
 	}
 	if _, ok := ru.mutation.RelativeID(); ru.mutation.RelativeCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Relationship.relative"`)
+		errs = append(errs, &ValidationError{Name: "relative", Entity: "Relationship", Field: "relative", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Relationship.relative"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ru *RelationshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -271,7 +272,7 @@ func (ru *RelationshipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{relationship.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -420,13 +421,14 @@ func (ruo *RelationshipUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruo *RelationshipUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := ruo.mutation.UserID(); ruo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Relationship.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Relationship", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Relationship.user"`)})
 	}
 	if _, ok := ruo.mutation.RelativeID(); ruo.mutation.RelativeCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Relationship.relative"`)
+		errs = append(errs, &ValidationError{Name: "relative", Entity: "Relationship", Field: "relative", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Relationship.relative"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relationship, err error) {
@@ -435,12 +437,12 @@ func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relations
 	}
 	_spec := sqlgraph.NewUpdateSpec(relationship.Table, relationship.Columns, sqlgraph.NewFieldSpec(relationship.FieldUserID, field.TypeInt), sqlgraph.NewFieldSpec(relationship.FieldRelativeID, field.TypeInt))
 	if id, ok := ruo.mutation.UserID(); !ok {
-		return nil, &ValidationError{Name: "user_id", err: errors.New(`ent: missing "Relationship.user_id" for update`)}
+		return nil, &ValidationError{Name: "user_id", Entity: "Relationship", Field: "user_id", Reason: "missing id for update", err: errors.New(`ent: missing "Relationship.user_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[0].Value = id
 	}
 	if id, ok := ruo.mutation.RelativeID(); !ok {
-		return nil, &ValidationError{Name: "relative_id", err: errors.New(`ent: missing "Relationship.relative_id" for update`)}
+		return nil, &ValidationError{Name: "relative_id", Entity: "Relationship", Field: "relative_id", Reason: "missing id for update", err: errors.New(`ent: missing "Relationship.relative_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[1].Value = id
 	}
@@ -448,7 +450,7 @@ func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relations
 		_spec.Node.Columns = make([]string, len(fields))
 		for i, f := range fields {
 			if !relationship.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Relationship", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			_spec.Node.Columns[i] = f
 		}
@@ -560,7 +562,7 @@ func (ruo *RelationshipUpdateOne) sqlSave(ctx context.Context) (_node *Relations
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{relationship.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -126,6 +126,11 @@ func TextContainsFold(v string) predicate.RelationshipInfo {
 	return predicate.RelationshipInfo(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.RelationshipInfo {
+	return predicate.RelationshipInfo(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.RelationshipInfo) predicate.RelationshipInfo {
 	return predicate.RelationshipInfo(func(s *sql.Selector) {
@@ -65,10 +65,11 @@ func (ric *RelationshipInfoCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ric *RelationshipInfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ric.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "RelationshipInfo.text"`)}
+		errs = append(errs, &ValidationError{Name: "text", Entity: "RelationshipInfo", Field: "text", Reason: "missing required field", err: errors.New(`ent: missing required field "RelationshipInfo.text"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ric *RelationshipInfoCreate) sqlSave(ctx context.Context) (*RelationshipInfo, error) {
@@ -78,7 +79,7 @@ func (ric *RelationshipInfoCreate) sqlSave(ctx context.Context) (*RelationshipIn
 	_node, _spec := ric.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ric.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -163,6 +164,12 @@ func (u *RelationshipInfoUpsert) UpdateText() *RelationshipInfoUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *RelationshipInfoUpsert) IgnoreText() *RelationshipInfoUpsert {
+	u.SetIgnore(relationshipinfo.FieldText)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -217,6 +224,14 @@ func (u *RelationshipInfoUpsertOne) UpdateText() *RelationshipInfoUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipInfoUpsertOne) IgnoreText() *RelationshipInfoUpsertOne {
+	return u.Update(func(s *RelationshipInfoUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // Exec executes the query.
 func (u *RelationshipInfoUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -253,12 +268,16 @@ func (u *RelationshipInfoUpsertOne) IDX(ctx context.Context) int {
 // RelationshipInfoCreateBulk is the builder for creating many RelationshipInfo entities in bulk.
 type RelationshipInfoCreateBulk struct {
 	config
+	err      error
 	builders []*RelationshipInfoCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the RelationshipInfo entities in the database.
 func (ricb *RelationshipInfoCreateBulk) Save(ctx context.Context) ([]*RelationshipInfo, error) {
+	if ricb.err != nil {
+		return nil, ricb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ricb.builders))
 	nodes := make([]*RelationshipInfo, len(ricb.builders))
 	mutators := make([]Mutator, len(ricb.builders))
@@ -284,7 +303,7 @@ func (ricb *RelationshipInfoCreateBulk) Save(ctx context.Context) ([]*Relationsh
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ricb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -430,6 +449,14 @@ func (u *RelationshipInfoUpsertBulk) UpdateText() *RelationshipInfoUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RelationshipInfoUpsertBulk) IgnoreText() *RelationshipInfoUpsertBulk {
+	return u.Update(func(s *RelationshipInfoUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // Exec executes the query.
 func (u *RelationshipInfoUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
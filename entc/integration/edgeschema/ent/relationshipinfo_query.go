@@ -320,7 +320,7 @@ func (riq *RelationshipInfoQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range riq.ctx.Fields {
 		if !relationshipinfo.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "RelationshipInfo", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if riq.path != nil {
@@ -442,7 +442,8 @@ func (riq *RelationshipInfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // RelationshipInfoGroupBy is the group-by builder for RelationshipInfo entities.
 type RelationshipInfoGroupBy struct {
 	selector
-	build *RelationshipInfoQuery
+	build  *RelationshipInfoQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -460,6 +461,14 @@ func (rigb *RelationshipInfoGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*RelationshipInfoQuery, *RelationshipInfoGroupBy](ctx, rigb.build, rigb, rigb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (rigb *RelationshipInfoGroupBy) Having(ps ...*sql.Predicate) *RelationshipInfoGroupBy {
+	rigb.having = append(rigb.having, ps...)
+	return rigb
+}
+
 func (rigb *RelationshipInfoGroupBy) sqlScan(ctx context.Context, root *RelationshipInfoQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(rigb.fns))
@@ -475,6 +484,9 @@ func (rigb *RelationshipInfoGroupBy) sqlScan(ctx context.Context, root *Relation
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*rigb.flds...)...)
+	if len(rigb.having) > 0 {
+		selector.Having(sql.And(rigb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@
 package role
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -115,6 +116,84 @@ func ByRolesUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRolesUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "user_count") that Role queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Role queries can be ordered by.
+const (
+	OrderFieldID              OrderField = "id"
+	OrderFieldName            OrderField = "name"
+	OrderFieldCreatedAt       OrderField = "created_at"
+	OrderFieldUserCount       OrderField = "user_count"
+	OrderFieldRolesUsersCount OrderField = "roles_users_count"
+)
+
+// OrderFields holds all fields and edge counts that Role queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldName,
+	OrderFieldCreatedAt,
+	OrderFieldUserCount,
+	OrderFieldRolesUsersCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Role queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("role: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := role.OrderByField(role.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Role.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldCreatedAt:
+		return ByCreatedAt(opts...), nil
+	case OrderFieldUserCount:
+		return ByUserCount(opts...), nil
+	case OrderFieldRolesUsersCount:
+		return ByRolesUsersCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
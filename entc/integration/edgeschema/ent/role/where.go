@@ -134,6 +134,11 @@ func NameContainsFold(v string) predicate.Role {
 	return predicate.Role(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Role {
+	return predicate.Role(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldCreatedAt, v))
@@ -174,6 +179,12 @@ func CreatedAtLTE(v time.Time) predicate.Role {
 	return predicate.Role(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.Role {
+	return predicate.Role(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -189,7 +200,7 @@ func HasUser() predicate.Role {
 func HasUserWith(preds ...predicate.User) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -212,7 +223,7 @@ func HasRolesUsers() predicate.Role {
 func HasRolesUsersWith(preds ...predicate.RoleUser) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
 		step := newRolesUsersStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -105,13 +105,14 @@ func (rc *RoleCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (rc *RoleCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := rc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Role.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Role", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Role.name"`)})
 	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Role.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "Role", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "Role.created_at"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (rc *RoleCreate) sqlSave(ctx context.Context) (*Role, error) {
@@ -121,7 +122,7 @@ func (rc *RoleCreate) sqlSave(ctx context.Context) (*Role, error) {
 	_node, _spec := rc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -230,6 +231,12 @@ func (u *RoleUpsert) UpdateName() *RoleUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *RoleUpsert) IgnoreName() *RoleUpsert {
+	u.SetIgnore(role.FieldName)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *RoleUpsert) SetCreatedAt(v time.Time) *RoleUpsert {
 	u.Set(role.FieldCreatedAt, v)
@@ -242,6 +249,12 @@ func (u *RoleUpsert) UpdateCreatedAt() *RoleUpsert {
 	return u
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+func (u *RoleUpsert) IgnoreCreatedAt() *RoleUpsert {
+	u.SetIgnore(role.FieldCreatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -296,6 +309,14 @@ func (u *RoleUpsertOne) UpdateName() *RoleUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUpsertOne) IgnoreName() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *RoleUpsertOne) SetCreatedAt(v time.Time) *RoleUpsertOne {
 	return u.Update(func(s *RoleUpsert) {
@@ -310,6 +331,14 @@ func (u *RoleUpsertOne) UpdateCreatedAt() *RoleUpsertOne {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUpsertOne) IgnoreCreatedAt() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -346,12 +375,16 @@ func (u *RoleUpsertOne) IDX(ctx context.Context) int {
 // RoleCreateBulk is the builder for creating many Role entities in bulk.
 type RoleCreateBulk struct {
 	config
+	err      error
 	builders []*RoleCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Role entities in the database.
 func (rcb *RoleCreateBulk) Save(ctx context.Context) ([]*Role, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
 	nodes := make([]*Role, len(rcb.builders))
 	mutators := make([]Mutator, len(rcb.builders))
@@ -378,7 +411,7 @@ func (rcb *RoleCreateBulk) Save(ctx context.Context) ([]*Role, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -524,6 +557,14 @@ func (u *RoleUpsertBulk) UpdateName() *RoleUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUpsertBulk) IgnoreName() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *RoleUpsertBulk) SetCreatedAt(v time.Time) *RoleUpsertBulk {
 	return u.Update(func(s *RoleUpsert) {
@@ -538,6 +579,14 @@ func (u *RoleUpsertBulk) UpdateCreatedAt() *RoleUpsertBulk {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUpsertBulk) IgnoreCreatedAt() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -393,7 +393,7 @@ func (rq *RoleQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range rq.ctx.Fields {
 		if !role.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Role", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if rq.path != nil {
@@ -451,6 +451,22 @@ func (rq *RoleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Role, e
 }
 
 func (rq *RoleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Role, init func(*Role), assign func(*Role, *User)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := rq.loadUser(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Role)
 	nids := make(map[int]map[*Role]struct{})
@@ -512,6 +528,22 @@ func (rq *RoleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Ro
 	return nil
 }
 func (rq *RoleQuery) loadRolesUsers(ctx context.Context, query *RoleUserQuery, nodes []*Role, init func(*Role), assign func(*Role, *RoleUser)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := rq.loadRolesUsers(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Role)
 	for i := range nodes {
@@ -626,7 +658,8 @@ func (rq *RoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // RoleGroupBy is the group-by builder for Role entities.
 type RoleGroupBy struct {
 	selector
-	build *RoleQuery
+	build  *RoleQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -644,6 +677,14 @@ func (rgb *RoleGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*RoleQuery, *RoleGroupBy](ctx, rgb.build, rgb, rgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (rgb *RoleGroupBy) Having(ps ...*sql.Predicate) *RoleGroupBy {
+	rgb.having = append(rgb.having, ps...)
+	return rgb
+}
+
 func (rgb *RoleGroupBy) sqlScan(ctx context.Context, root *RoleQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(rgb.fns))
@@ -659,6 +700,9 @@ func (rgb *RoleGroupBy) sqlScan(ctx context.Context, root *RoleQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*rgb.flds...)...)
+	if len(rgb.having) > 0 {
+		selector.Having(sql.And(rgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -109,6 +109,12 @@ func UserIDNotIn(vs ...int) predicate.RoleUser {
 	return predicate.RoleUser(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.RoleUser {
+	return predicate.RoleUser(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
 // HasRole applies the HasEdge predicate on the "role" edge.
 func HasRole() predicate.RoleUser {
 	return predicate.RoleUser(func(s *sql.Selector) {
@@ -124,7 +130,7 @@ func HasRole() predicate.RoleUser {
 func HasRoleWith(preds ...predicate.Role) predicate.RoleUser {
 	return predicate.RoleUser(func(s *sql.Selector) {
 		step := newRoleStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -147,7 +153,7 @@ func HasUser() predicate.RoleUser {
 func HasUserWith(preds ...predicate.User) predicate.RoleUser {
 	return predicate.RoleUser(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -107,22 +107,23 @@ func (ruc *RoleUserCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruc *RoleUserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ruc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleUser.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "RoleUser", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "RoleUser.created_at"`)})
 	}
 	if _, ok := ruc.mutation.RoleID(); !ok {
-		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleUser.role_id"`)}
+		errs = append(errs, &ValidationError{Name: "role_id", Entity: "RoleUser", Field: "role_id", Reason: "missing required field", err: errors.New(`ent: missing required field "RoleUser.role_id"`)})
 	}
 	if _, ok := ruc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoleUser.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "RoleUser", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "RoleUser.user_id"`)})
 	}
 	if _, ok := ruc.mutation.RoleID(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleUser.role"`)}
+		errs = append(errs, &ValidationError{Name: "role", Entity: "RoleUser", Field: "role", Reason: "missing required edge", err: errors.New(`ent: missing required edge "RoleUser.role"`)})
 	}
 	if _, ok := ruc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RoleUser.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "RoleUser", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "RoleUser.user"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ruc *RoleUserCreate) sqlSave(ctx context.Context) (*RoleUser, error) {
@@ -132,7 +133,7 @@ func (ruc *RoleUserCreate) sqlSave(ctx context.Context) (*RoleUser, error) {
 	_node, _spec := ruc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ruc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -247,6 +248,12 @@ func (u *RoleUserUpsert) UpdateCreatedAt() *RoleUserUpsert {
 	return u
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+func (u *RoleUserUpsert) IgnoreCreatedAt() *RoleUserUpsert {
+	u.SetIgnore(roleuser.FieldCreatedAt)
+	return u
+}
+
 // SetRoleID sets the "role_id" field.
 func (u *RoleUserUpsert) SetRoleID(v int) *RoleUserUpsert {
 	u.Set(roleuser.FieldRoleID, v)
@@ -259,6 +266,12 @@ func (u *RoleUserUpsert) UpdateRoleID() *RoleUserUpsert {
 	return u
 }
 
+// IgnoreRoleID keeps the current value of the "role_id" field in case of conflict.
+func (u *RoleUserUpsert) IgnoreRoleID() *RoleUserUpsert {
+	u.SetIgnore(roleuser.FieldRoleID)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RoleUserUpsert) SetUserID(v int) *RoleUserUpsert {
 	u.Set(roleuser.FieldUserID, v)
@@ -271,6 +284,12 @@ func (u *RoleUserUpsert) UpdateUserID() *RoleUserUpsert {
 	return u
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+func (u *RoleUserUpsert) IgnoreUserID() *RoleUserUpsert {
+	u.SetIgnore(roleuser.FieldUserID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -325,6 +344,14 @@ func (u *RoleUserUpsertOne) UpdateCreatedAt() *RoleUserUpsertOne {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUserUpsertOne) IgnoreCreatedAt() *RoleUserUpsertOne {
+	return u.Update(func(s *RoleUserUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // SetRoleID sets the "role_id" field.
 func (u *RoleUserUpsertOne) SetRoleID(v int) *RoleUserUpsertOne {
 	return u.Update(func(s *RoleUserUpsert) {
@@ -339,6 +366,14 @@ func (u *RoleUserUpsertOne) UpdateRoleID() *RoleUserUpsertOne {
 	})
 }
 
+// IgnoreRoleID keeps the current value of the "role_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUserUpsertOne) IgnoreRoleID() *RoleUserUpsertOne {
+	return u.Update(func(s *RoleUserUpsert) {
+		s.IgnoreRoleID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RoleUserUpsertOne) SetUserID(v int) *RoleUserUpsertOne {
 	return u.Update(func(s *RoleUserUpsert) {
@@ -353,6 +388,14 @@ func (u *RoleUserUpsertOne) UpdateUserID() *RoleUserUpsertOne {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUserUpsertOne) IgnoreUserID() *RoleUserUpsertOne {
+	return u.Update(func(s *RoleUserUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -371,12 +414,16 @@ func (u *RoleUserUpsertOne) ExecX(ctx context.Context) {
 // RoleUserCreateBulk is the builder for creating many RoleUser entities in bulk.
 type RoleUserCreateBulk struct {
 	config
+	err      error
 	builders []*RoleUserCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the RoleUser entities in the database.
 func (rucb *RoleUserCreateBulk) Save(ctx context.Context) ([]*RoleUser, error) {
+	if rucb.err != nil {
+		return nil, rucb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(rucb.builders))
 	nodes := make([]*RoleUser, len(rucb.builders))
 	mutators := make([]Mutator, len(rucb.builders))
@@ -403,7 +450,7 @@ func (rucb *RoleUserCreateBulk) Save(ctx context.Context) ([]*RoleUser, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -544,6 +591,14 @@ func (u *RoleUserUpsertBulk) UpdateCreatedAt() *RoleUserUpsertBulk {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUserUpsertBulk) IgnoreCreatedAt() *RoleUserUpsertBulk {
+	return u.Update(func(s *RoleUserUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // SetRoleID sets the "role_id" field.
 func (u *RoleUserUpsertBulk) SetRoleID(v int) *RoleUserUpsertBulk {
 	return u.Update(func(s *RoleUserUpsert) {
@@ -558,6 +613,14 @@ func (u *RoleUserUpsertBulk) UpdateRoleID() *RoleUserUpsertBulk {
 	})
 }
 
+// IgnoreRoleID keeps the current value of the "role_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUserUpsertBulk) IgnoreRoleID() *RoleUserUpsertBulk {
+	return u.Update(func(s *RoleUserUpsert) {
+		s.IgnoreRoleID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *RoleUserUpsertBulk) SetUserID(v int) *RoleUserUpsertBulk {
 	return u.Update(func(s *RoleUserUpsert) {
@@ -572,6 +635,14 @@ func (u *RoleUserUpsertBulk) UpdateUserID() *RoleUserUpsertBulk {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *RoleUserUpsertBulk) IgnoreUserID() *RoleUserUpsertBulk {
+	return u.Update(func(s *RoleUserUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUserUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -116,13 +116,14 @@ func (ruu *RoleUserUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruu *RoleUserUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := ruu.mutation.RoleID(); ruu.mutation.RoleCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "RoleUser.role"`)
+		errs = append(errs, &ValidationError{Name: "role", Entity: "RoleUser", Field: "role", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "RoleUser.role"`)})
 	}
 	if _, ok := ruu.mutation.UserID(); ruu.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "RoleUser.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "RoleUser", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "RoleUser.user"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ruu *RoleUserUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -202,7 +203,7 @@ func (ruu *RoleUserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{roleuser.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -313,13 +314,14 @@ func (ruuo *RoleUserUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruuo *RoleUserUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := ruuo.mutation.RoleID(); ruuo.mutation.RoleCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "RoleUser.role"`)
+		errs = append(errs, &ValidationError{Name: "role", Entity: "RoleUser", Field: "role", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "RoleUser.role"`)})
 	}
 	if _, ok := ruuo.mutation.UserID(); ruuo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "RoleUser.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "RoleUser", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "RoleUser.user"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ruuo *RoleUserUpdateOne) sqlSave(ctx context.Context) (_node *RoleUser, err error) {
@@ -328,12 +330,12 @@ func (ruuo *RoleUserUpdateOne) sqlSave(ctx context.Context) (_node *RoleUser, er
 	}
 	_spec := sqlgraph.NewUpdateSpec(roleuser.Table, roleuser.Columns, sqlgraph.NewFieldSpec(roleuser.FieldUserID, field.TypeInt), sqlgraph.NewFieldSpec(roleuser.FieldRoleID, field.TypeInt))
 	if id, ok := ruuo.mutation.UserID(); !ok {
-		return nil, &ValidationError{Name: "user_id", err: errors.New(`ent: missing "RoleUser.user_id" for update`)}
+		return nil, &ValidationError{Name: "user_id", Entity: "RoleUser", Field: "user_id", Reason: "missing id for update", err: errors.New(`ent: missing "RoleUser.user_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[0].Value = id
 	}
 	if id, ok := ruuo.mutation.RoleID(); !ok {
-		return nil, &ValidationError{Name: "role_id", err: errors.New(`ent: missing "RoleUser.role_id" for update`)}
+		return nil, &ValidationError{Name: "role_id", Entity: "RoleUser", Field: "role_id", Reason: "missing id for update", err: errors.New(`ent: missing "RoleUser.role_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[1].Value = id
 	}
@@ -341,7 +343,7 @@ func (ruuo *RoleUserUpdateOne) sqlSave(ctx context.Context) (_node *RoleUser, er
 		_spec.Node.Columns = make([]string, len(fields))
 		for i, f := range fields {
 			if !roleuser.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "RoleUser", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			_spec.Node.Columns[i] = f
 		}
@@ -421,7 +423,7 @@ func (ruuo *RoleUserUpdateOne) sqlSave(ctx context.Context) (_node *RoleUser, er
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{roleuser.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -127,6 +127,11 @@ func ValueContainsFold(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldContainsFold(FieldValue, v))
 }
 
+// ValueHasPrefixFold applies the HasPrefixFold predicate on the "value" field.
+func ValueHasPrefixFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasPrefixFold(FieldValue, v))
+}
+
 // HasTweets applies the HasEdge predicate on the "tweets" edge.
 func HasTweets() predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
@@ -142,7 +147,7 @@ func HasTweets() predicate.Tag {
 func HasTweetsWith(preds ...predicate.Tweet) predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
 		step := newTweetsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -165,7 +170,7 @@ func HasGroups() predicate.Tag {
 func HasGroupsWith(preds ...predicate.Group) predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
 		step := newGroupsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -188,7 +193,7 @@ func HasTweetTags() predicate.Tag {
 func HasTweetTagsWith(preds ...predicate.TweetTag) predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
 		step := newTweetTagsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -211,7 +216,7 @@ func HasGroupTags() predicate.Tag {
 func HasGroupTagsWith(preds ...predicate.GroupTag) predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
 		step := newGroupTagsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
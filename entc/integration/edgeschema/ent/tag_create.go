@@ -130,10 +130,11 @@ func (tc *TagCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TagCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Value(); !ok {
-		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Tag.value"`)}
+		errs = append(errs, &ValidationError{Name: "value", Entity: "Tag", Field: "value", Reason: "missing required field", err: errors.New(`ent: missing required field "Tag.value"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TagCreate) sqlSave(ctx context.Context) (*Tag, error) {
@@ -143,7 +144,7 @@ func (tc *TagCreate) sqlSave(ctx context.Context) (*Tag, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -299,6 +300,12 @@ func (u *TagUpsert) UpdateValue() *TagUpsert {
 	return u
 }
 
+// IgnoreValue keeps the current value of the "value" field in case of conflict.
+func (u *TagUpsert) IgnoreValue() *TagUpsert {
+	u.SetIgnore(tag.FieldValue)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -353,6 +360,14 @@ func (u *TagUpsertOne) UpdateValue() *TagUpsertOne {
 	})
 }
 
+// IgnoreValue keeps the current value of the "value" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TagUpsertOne) IgnoreValue() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.IgnoreValue()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -389,12 +404,16 @@ func (u *TagUpsertOne) IDX(ctx context.Context) int {
 // TagCreateBulk is the builder for creating many Tag entities in bulk.
 type TagCreateBulk struct {
 	config
+	err      error
 	builders []*TagCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Tag entities in the database.
 func (tcb *TagCreateBulk) Save(ctx context.Context) ([]*Tag, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Tag, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -420,7 +439,7 @@ func (tcb *TagCreateBulk) Save(ctx context.Context) ([]*Tag, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -566,6 +585,14 @@ func (u *TagUpsertBulk) UpdateValue() *TagUpsertBulk {
 	})
 }
 
+// IgnoreValue keeps the current value of the "value" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TagUpsertBulk) IgnoreValue() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.IgnoreValue()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -7,6 +7,8 @@
 package tweet
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -194,6 +196,96 @@ func ByTweetTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTweetTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "liked_users_count") that Tweet queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Tweet queries can be ordered by.
+const (
+	OrderFieldID              OrderField = "id"
+	OrderFieldText            OrderField = "text"
+	OrderFieldLikedUsersCount OrderField = "liked_users_count"
+	OrderFieldUserCount       OrderField = "user_count"
+	OrderFieldTagsCount       OrderField = "tags_count"
+	OrderFieldLikesCount      OrderField = "likes_count"
+	OrderFieldTweetUserCount  OrderField = "tweet_user_count"
+	OrderFieldTweetTagsCount  OrderField = "tweet_tags_count"
+)
+
+// OrderFields holds all fields and edge counts that Tweet queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldText,
+	OrderFieldLikedUsersCount,
+	OrderFieldUserCount,
+	OrderFieldTagsCount,
+	OrderFieldLikesCount,
+	OrderFieldTweetUserCount,
+	OrderFieldTweetTagsCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Tweet queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("tweet: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := tweet.OrderByField(tweet.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Tweet.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldText:
+		return ByText(opts...), nil
+	case OrderFieldLikedUsersCount:
+		return ByLikedUsersCount(opts...), nil
+	case OrderFieldUserCount:
+		return ByUserCount(opts...), nil
+	case OrderFieldTagsCount:
+		return ByTagsCount(opts...), nil
+	case OrderFieldLikesCount:
+		return ByLikesCount(opts...), nil
+	case OrderFieldTweetUserCount:
+		return ByTweetUserCount(opts...), nil
+	case OrderFieldTweetTagsCount:
+		return ByTweetTagsCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newLikedUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
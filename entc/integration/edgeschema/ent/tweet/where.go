@@ -127,6 +127,11 @@ func TextContainsFold(v string) predicate.Tweet {
 	return predicate.Tweet(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Tweet {
+	return predicate.Tweet(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // HasLikedUsers applies the HasEdge predicate on the "liked_users" edge.
 func HasLikedUsers() predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
@@ -142,7 +147,7 @@ func HasLikedUsers() predicate.Tweet {
 func HasLikedUsersWith(preds ...predicate.User) predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
 		step := newLikedUsersStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -165,7 +170,7 @@ func HasUser() predicate.Tweet {
 func HasUserWith(preds ...predicate.User) predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -188,7 +193,7 @@ func HasTags() predicate.Tweet {
 func HasTagsWith(preds ...predicate.Tag) predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
 		step := newTagsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -211,7 +216,7 @@ func HasLikes() predicate.Tweet {
 func HasLikesWith(preds ...predicate.TweetLike) predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
 		step := newLikesStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -234,7 +239,7 @@ func HasTweetUser() predicate.Tweet {
 func HasTweetUserWith(preds ...predicate.UserTweet) predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
 		step := newTweetUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -257,7 +262,7 @@ func HasTweetTags() predicate.Tweet {
 func HasTweetTagsWith(preds ...predicate.TweetTag) predicate.Tweet {
 	return predicate.Tweet(func(s *sql.Selector) {
 		step := newTweetTagsStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -145,10 +145,11 @@ func (tc *TweetCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TweetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Tweet.text"`)}
+		errs = append(errs, &ValidationError{Name: "text", Entity: "Tweet", Field: "text", Reason: "missing required field", err: errors.New(`ent: missing required field "Tweet.text"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TweetCreate) sqlSave(ctx context.Context) (*Tweet, error) {
@@ -158,7 +159,7 @@ func (tc *TweetCreate) sqlSave(ctx context.Context) (*Tweet, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -338,6 +339,12 @@ func (u *TweetUpsert) UpdateText() *TweetUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *TweetUpsert) IgnoreText() *TweetUpsert {
+	u.SetIgnore(tweet.FieldText)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -392,6 +399,14 @@ func (u *TweetUpsertOne) UpdateText() *TweetUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetUpsertOne) IgnoreText() *TweetUpsertOne {
+	return u.Update(func(s *TweetUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // Exec executes the query.
 func (u *TweetUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -428,12 +443,16 @@ func (u *TweetUpsertOne) IDX(ctx context.Context) int {
 // TweetCreateBulk is the builder for creating many Tweet entities in bulk.
 type TweetCreateBulk struct {
 	config
+	err      error
 	builders []*TweetCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Tweet entities in the database.
 func (tcb *TweetCreateBulk) Save(ctx context.Context) ([]*Tweet, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Tweet, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -459,7 +478,7 @@ func (tcb *TweetCreateBulk) Save(ctx context.Context) ([]*Tweet, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -605,6 +624,14 @@ func (u *TweetUpsertBulk) UpdateText() *TweetUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetUpsertBulk) IgnoreText() *TweetUpsertBulk {
+	return u.Update(func(s *TweetUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // Exec executes the query.
 func (u *TweetUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -7,6 +7,7 @@
 package tweetlike
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent"
@@ -109,6 +110,76 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that TweetLike queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that TweetLike queries can be ordered by.
+const (
+	OrderFieldLikedAt OrderField = "liked_at"
+	OrderFieldUserID  OrderField = "user_id"
+	OrderFieldTweetID OrderField = "tweet_id"
+)
+
+// OrderFields holds all fields and edge counts that TweetLike queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldLikedAt,
+	OrderFieldUserID,
+	OrderFieldTweetID,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if TweetLike queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("tweetlike: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := tweetlike.OrderByField(tweetlike.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.TweetLike.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldLikedAt:
+		return ByLikedAt(opts...), nil
+	case OrderFieldUserID:
+		return ByUserID(opts...), nil
+	case OrderFieldTweetID:
+		return ByTweetID(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newTweetStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, TweetColumn),
@@ -109,6 +109,12 @@ func TweetIDNotIn(vs ...int) predicate.TweetLike {
 	return predicate.TweetLike(sql.FieldNotIn(FieldTweetID, vs...))
 }
 
+// LikedAtWithinLast applies the WithinLast predicate on the "liked_at" field.
+// It matches values that are within the given duration before the current time.
+func LikedAtWithinLast(d time.Duration) predicate.TweetLike {
+	return predicate.TweetLike(sql.FieldWithinLast(FieldLikedAt, d))
+}
+
 // HasTweet applies the HasEdge predicate on the "tweet" edge.
 func HasTweet() predicate.TweetLike {
 	return predicate.TweetLike(func(s *sql.Selector) {
@@ -124,7 +130,7 @@ func HasTweet() predicate.TweetLike {
 func HasTweetWith(preds ...predicate.Tweet) predicate.TweetLike {
 	return predicate.TweetLike(func(s *sql.Selector) {
 		step := newTweetStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -147,7 +153,7 @@ func HasUser() predicate.TweetLike {
 func HasUserWith(preds ...predicate.User) predicate.TweetLike {
 	return predicate.TweetLike(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
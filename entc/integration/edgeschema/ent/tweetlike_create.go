@@ -113,22 +113,23 @@ func (tlc *TweetLikeCreate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (tlc *TweetLikeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tlc.mutation.LikedAt(); !ok {
-		return &ValidationError{Name: "liked_at", err: errors.New(`ent: missing required field "TweetLike.liked_at"`)}
+		errs = append(errs, &ValidationError{Name: "liked_at", Entity: "TweetLike", Field: "liked_at", Reason: "missing required field", err: errors.New(`ent: missing required field "TweetLike.liked_at"`)})
 	}
 	if _, ok := tlc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TweetLike.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "TweetLike", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "TweetLike.user_id"`)})
 	}
 	if _, ok := tlc.mutation.TweetID(); !ok {
-		return &ValidationError{Name: "tweet_id", err: errors.New(`ent: missing required field "TweetLike.tweet_id"`)}
+		errs = append(errs, &ValidationError{Name: "tweet_id", Entity: "TweetLike", Field: "tweet_id", Reason: "missing required field", err: errors.New(`ent: missing required field "TweetLike.tweet_id"`)})
 	}
 	if _, ok := tlc.mutation.TweetID(); !ok {
-		return &ValidationError{Name: "tweet", err: errors.New(`ent: missing required edge "TweetLike.tweet"`)}
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "TweetLike", Field: "tweet", Reason: "missing required edge", err: errors.New(`ent: missing required edge "TweetLike.tweet"`)})
 	}
 	if _, ok := tlc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TweetLike.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "TweetLike", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "TweetLike.user"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tlc *TweetLikeCreate) sqlSave(ctx context.Context) (*TweetLike, error) {
@@ -138,7 +139,7 @@ func (tlc *TweetLikeCreate) sqlSave(ctx context.Context) (*TweetLike, error) {
 	_node, _spec := tlc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tlc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -253,6 +254,12 @@ func (u *TweetLikeUpsert) UpdateLikedAt() *TweetLikeUpsert {
 	return u
 }
 
+// IgnoreLikedAt keeps the current value of the "liked_at" field in case of conflict.
+func (u *TweetLikeUpsert) IgnoreLikedAt() *TweetLikeUpsert {
+	u.SetIgnore(tweetlike.FieldLikedAt)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *TweetLikeUpsert) SetUserID(v int) *TweetLikeUpsert {
 	u.Set(tweetlike.FieldUserID, v)
@@ -265,6 +272,12 @@ func (u *TweetLikeUpsert) UpdateUserID() *TweetLikeUpsert {
 	return u
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+func (u *TweetLikeUpsert) IgnoreUserID() *TweetLikeUpsert {
+	u.SetIgnore(tweetlike.FieldUserID)
+	return u
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *TweetLikeUpsert) SetTweetID(v int) *TweetLikeUpsert {
 	u.Set(tweetlike.FieldTweetID, v)
@@ -277,6 +290,12 @@ func (u *TweetLikeUpsert) UpdateTweetID() *TweetLikeUpsert {
 	return u
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+func (u *TweetLikeUpsert) IgnoreTweetID() *TweetLikeUpsert {
+	u.SetIgnore(tweetlike.FieldTweetID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -331,6 +350,14 @@ func (u *TweetLikeUpsertOne) UpdateLikedAt() *TweetLikeUpsertOne {
 	})
 }
 
+// IgnoreLikedAt keeps the current value of the "liked_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetLikeUpsertOne) IgnoreLikedAt() *TweetLikeUpsertOne {
+	return u.Update(func(s *TweetLikeUpsert) {
+		s.IgnoreLikedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *TweetLikeUpsertOne) SetUserID(v int) *TweetLikeUpsertOne {
 	return u.Update(func(s *TweetLikeUpsert) {
@@ -345,6 +372,14 @@ func (u *TweetLikeUpsertOne) UpdateUserID() *TweetLikeUpsertOne {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetLikeUpsertOne) IgnoreUserID() *TweetLikeUpsertOne {
+	return u.Update(func(s *TweetLikeUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *TweetLikeUpsertOne) SetTweetID(v int) *TweetLikeUpsertOne {
 	return u.Update(func(s *TweetLikeUpsert) {
@@ -359,6 +394,14 @@ func (u *TweetLikeUpsertOne) UpdateTweetID() *TweetLikeUpsertOne {
 	})
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetLikeUpsertOne) IgnoreTweetID() *TweetLikeUpsertOne {
+	return u.Update(func(s *TweetLikeUpsert) {
+		s.IgnoreTweetID()
+	})
+}
+
 // Exec executes the query.
 func (u *TweetLikeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -377,12 +420,16 @@ func (u *TweetLikeUpsertOne) ExecX(ctx context.Context) {
 // TweetLikeCreateBulk is the builder for creating many TweetLike entities in bulk.
 type TweetLikeCreateBulk struct {
 	config
+	err      error
 	builders []*TweetLikeCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the TweetLike entities in the database.
 func (tlcb *TweetLikeCreateBulk) Save(ctx context.Context) ([]*TweetLike, error) {
+	if tlcb.err != nil {
+		return nil, tlcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tlcb.builders))
 	nodes := make([]*TweetLike, len(tlcb.builders))
 	mutators := make([]Mutator, len(tlcb.builders))
@@ -409,7 +456,7 @@ func (tlcb *TweetLikeCreateBulk) Save(ctx context.Context) ([]*TweetLike, error)
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tlcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -550,6 +597,14 @@ func (u *TweetLikeUpsertBulk) UpdateLikedAt() *TweetLikeUpsertBulk {
 	})
 }
 
+// IgnoreLikedAt keeps the current value of the "liked_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetLikeUpsertBulk) IgnoreLikedAt() *TweetLikeUpsertBulk {
+	return u.Update(func(s *TweetLikeUpsert) {
+		s.IgnoreLikedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *TweetLikeUpsertBulk) SetUserID(v int) *TweetLikeUpsertBulk {
 	return u.Update(func(s *TweetLikeUpsert) {
@@ -564,6 +619,14 @@ func (u *TweetLikeUpsertBulk) UpdateUserID() *TweetLikeUpsertBulk {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetLikeUpsertBulk) IgnoreUserID() *TweetLikeUpsertBulk {
+	return u.Update(func(s *TweetLikeUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *TweetLikeUpsertBulk) SetTweetID(v int) *TweetLikeUpsertBulk {
 	return u.Update(func(s *TweetLikeUpsert) {
@@ -578,6 +641,14 @@ func (u *TweetLikeUpsertBulk) UpdateTweetID() *TweetLikeUpsertBulk {
 	})
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetLikeUpsertBulk) IgnoreTweetID() *TweetLikeUpsertBulk {
+	return u.Update(func(s *TweetLikeUpsert) {
+		s.IgnoreTweetID()
+	})
+}
+
 // Exec executes the query.
 func (u *TweetLikeUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -116,13 +116,14 @@ func (tlu *TweetLikeUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tlu *TweetLikeUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := tlu.mutation.TweetID(); tlu.mutation.TweetCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetLike.tweet"`)
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "TweetLike", Field: "tweet", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetLike.tweet"`)})
 	}
 	if _, ok := tlu.mutation.UserID(); tlu.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetLike.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "TweetLike", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetLike.user"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tlu *TweetLikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -202,7 +203,7 @@ func (tlu *TweetLikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tweetlike.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -313,13 +314,14 @@ func (tluo *TweetLikeUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tluo *TweetLikeUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := tluo.mutation.TweetID(); tluo.mutation.TweetCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetLike.tweet"`)
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "TweetLike", Field: "tweet", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetLike.tweet"`)})
 	}
 	if _, ok := tluo.mutation.UserID(); tluo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetLike.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "TweetLike", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetLike.user"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (tluo *TweetLikeUpdateOne) sqlSave(ctx context.Context) (_node *TweetLike, err error) {
@@ -328,12 +330,12 @@ func (tluo *TweetLikeUpdateOne) sqlSave(ctx context.Context) (_node *TweetLike,
 	}
 	_spec := sqlgraph.NewUpdateSpec(tweetlike.Table, tweetlike.Columns, sqlgraph.NewFieldSpec(tweetlike.FieldUserID, field.TypeInt), sqlgraph.NewFieldSpec(tweetlike.FieldTweetID, field.TypeInt))
 	if id, ok := tluo.mutation.UserID(); !ok {
-		return nil, &ValidationError{Name: "user_id", err: errors.New(`ent: missing "TweetLike.user_id" for update`)}
+		return nil, &ValidationError{Name: "user_id", Entity: "TweetLike", Field: "user_id", Reason: "missing id for update", err: errors.New(`ent: missing "TweetLike.user_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[0].Value = id
 	}
 	if id, ok := tluo.mutation.TweetID(); !ok {
-		return nil, &ValidationError{Name: "tweet_id", err: errors.New(`ent: missing "TweetLike.tweet_id" for update`)}
+		return nil, &ValidationError{Name: "tweet_id", Entity: "TweetLike", Field: "tweet_id", Reason: "missing id for update", err: errors.New(`ent: missing "TweetLike.tweet_id" for update`)}
 	} else {
 		_spec.Node.CompositeID[1].Value = id
 	}
@@ -341,7 +343,7 @@ func (tluo *TweetLikeUpdateOne) sqlSave(ctx context.Context) (_node *TweetLike,
 		_spec.Node.Columns = make([]string, len(fields))
 		for i, f := range fields {
 			if !tweetlike.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "TweetLike", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			_spec.Node.Columns[i] = f
 		}
@@ -421,7 +423,7 @@ func (tluo *TweetLikeUpdateOne) sqlSave(ctx context.Context) (_node *TweetLike,
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tweetlike.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -155,6 +155,12 @@ func TweetIDNotIn(vs ...int) predicate.TweetTag {
 	return predicate.TweetTag(sql.FieldNotIn(FieldTweetID, vs...))
 }
 
+// AddedAtWithinLast applies the WithinLast predicate on the "added_at" field.
+// It matches values that are within the given duration before the current time.
+func AddedAtWithinLast(d time.Duration) predicate.TweetTag {
+	return predicate.TweetTag(sql.FieldWithinLast(FieldAddedAt, d))
+}
+
 // HasTag applies the HasEdge predicate on the "tag" edge.
 func HasTag() predicate.TweetTag {
 	return predicate.TweetTag(func(s *sql.Selector) {
@@ -170,7 +176,7 @@ func HasTag() predicate.TweetTag {
 func HasTagWith(preds ...predicate.Tag) predicate.TweetTag {
 	return predicate.TweetTag(func(s *sql.Selector) {
 		step := newTagStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -193,7 +199,7 @@ func HasTweet() predicate.TweetTag {
 func HasTweetWith(preds ...predicate.Tweet) predicate.TweetTag {
 	return predicate.TweetTag(func(s *sql.Selector) {
 		step := newTweetStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
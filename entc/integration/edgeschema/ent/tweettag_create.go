@@ -127,22 +127,23 @@ func (ttc *TweetTagCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ttc *TweetTagCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ttc.mutation.AddedAt(); !ok {
-		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "TweetTag.added_at"`)}
+		errs = append(errs, &ValidationError{Name: "added_at", Entity: "TweetTag", Field: "added_at", Reason: "missing required field", err: errors.New(`ent: missing required field "TweetTag.added_at"`)})
 	}
 	if _, ok := ttc.mutation.TagID(); !ok {
-		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TweetTag.tag_id"`)}
+		errs = append(errs, &ValidationError{Name: "tag_id", Entity: "TweetTag", Field: "tag_id", Reason: "missing required field", err: errors.New(`ent: missing required field "TweetTag.tag_id"`)})
 	}
 	if _, ok := ttc.mutation.TweetID(); !ok {
-		return &ValidationError{Name: "tweet_id", err: errors.New(`ent: missing required field "TweetTag.tweet_id"`)}
+		errs = append(errs, &ValidationError{Name: "tweet_id", Entity: "TweetTag", Field: "tweet_id", Reason: "missing required field", err: errors.New(`ent: missing required field "TweetTag.tweet_id"`)})
 	}
 	if _, ok := ttc.mutation.TagID(); !ok {
-		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TweetTag.tag"`)}
+		errs = append(errs, &ValidationError{Name: "tag", Entity: "TweetTag", Field: "tag", Reason: "missing required edge", err: errors.New(`ent: missing required edge "TweetTag.tag"`)})
 	}
 	if _, ok := ttc.mutation.TweetID(); !ok {
-		return &ValidationError{Name: "tweet", err: errors.New(`ent: missing required edge "TweetTag.tweet"`)}
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "TweetTag", Field: "tweet", Reason: "missing required edge", err: errors.New(`ent: missing required edge "TweetTag.tweet"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ttc *TweetTagCreate) sqlSave(ctx context.Context) (*TweetTag, error) {
@@ -152,7 +153,7 @@ func (ttc *TweetTagCreate) sqlSave(ctx context.Context) (*TweetTag, error) {
 	_node, _spec := ttc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -280,6 +281,12 @@ func (u *TweetTagUpsert) UpdateAddedAt() *TweetTagUpsert {
 	return u
 }
 
+// IgnoreAddedAt keeps the current value of the "added_at" field in case of conflict.
+func (u *TweetTagUpsert) IgnoreAddedAt() *TweetTagUpsert {
+	u.SetIgnore(tweettag.FieldAddedAt)
+	return u
+}
+
 // SetTagID sets the "tag_id" field.
 func (u *TweetTagUpsert) SetTagID(v int) *TweetTagUpsert {
 	u.Set(tweettag.FieldTagID, v)
@@ -292,6 +299,12 @@ func (u *TweetTagUpsert) UpdateTagID() *TweetTagUpsert {
 	return u
 }
 
+// IgnoreTagID keeps the current value of the "tag_id" field in case of conflict.
+func (u *TweetTagUpsert) IgnoreTagID() *TweetTagUpsert {
+	u.SetIgnore(tweettag.FieldTagID)
+	return u
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *TweetTagUpsert) SetTweetID(v int) *TweetTagUpsert {
 	u.Set(tweettag.FieldTweetID, v)
@@ -304,6 +317,12 @@ func (u *TweetTagUpsert) UpdateTweetID() *TweetTagUpsert {
 	return u
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+func (u *TweetTagUpsert) IgnoreTweetID() *TweetTagUpsert {
+	u.SetIgnore(tweettag.FieldTweetID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -366,6 +385,14 @@ func (u *TweetTagUpsertOne) UpdateAddedAt() *TweetTagUpsertOne {
 	})
 }
 
+// IgnoreAddedAt keeps the current value of the "added_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetTagUpsertOne) IgnoreAddedAt() *TweetTagUpsertOne {
+	return u.Update(func(s *TweetTagUpsert) {
+		s.IgnoreAddedAt()
+	})
+}
+
 // SetTagID sets the "tag_id" field.
 func (u *TweetTagUpsertOne) SetTagID(v int) *TweetTagUpsertOne {
 	return u.Update(func(s *TweetTagUpsert) {
@@ -380,6 +407,14 @@ func (u *TweetTagUpsertOne) UpdateTagID() *TweetTagUpsertOne {
 	})
 }
 
+// IgnoreTagID keeps the current value of the "tag_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetTagUpsertOne) IgnoreTagID() *TweetTagUpsertOne {
+	return u.Update(func(s *TweetTagUpsert) {
+		s.IgnoreTagID()
+	})
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *TweetTagUpsertOne) SetTweetID(v int) *TweetTagUpsertOne {
 	return u.Update(func(s *TweetTagUpsert) {
@@ -394,6 +429,14 @@ func (u *TweetTagUpsertOne) UpdateTweetID() *TweetTagUpsertOne {
 	})
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetTagUpsertOne) IgnoreTweetID() *TweetTagUpsertOne {
+	return u.Update(func(s *TweetTagUpsert) {
+		s.IgnoreTweetID()
+	})
+}
+
 // Exec executes the query.
 func (u *TweetTagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -435,12 +478,16 @@ func (u *TweetTagUpsertOne) IDX(ctx context.Context) uuid.UUID {
 // TweetTagCreateBulk is the builder for creating many TweetTag entities in bulk.
 type TweetTagCreateBulk struct {
 	config
+	err      error
 	builders []*TweetTagCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the TweetTag entities in the database.
 func (ttcb *TweetTagCreateBulk) Save(ctx context.Context) ([]*TweetTag, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
 	nodes := make([]*TweetTag, len(ttcb.builders))
 	mutators := make([]Mutator, len(ttcb.builders))
@@ -467,7 +514,7 @@ func (ttcb *TweetTagCreateBulk) Save(ctx context.Context) ([]*TweetTag, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -619,6 +666,14 @@ func (u *TweetTagUpsertBulk) UpdateAddedAt() *TweetTagUpsertBulk {
 	})
 }
 
+// IgnoreAddedAt keeps the current value of the "added_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetTagUpsertBulk) IgnoreAddedAt() *TweetTagUpsertBulk {
+	return u.Update(func(s *TweetTagUpsert) {
+		s.IgnoreAddedAt()
+	})
+}
+
 // SetTagID sets the "tag_id" field.
 func (u *TweetTagUpsertBulk) SetTagID(v int) *TweetTagUpsertBulk {
 	return u.Update(func(s *TweetTagUpsert) {
@@ -633,6 +688,14 @@ func (u *TweetTagUpsertBulk) UpdateTagID() *TweetTagUpsertBulk {
 	})
 }
 
+// IgnoreTagID keeps the current value of the "tag_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetTagUpsertBulk) IgnoreTagID() *TweetTagUpsertBulk {
+	return u.Update(func(s *TweetTagUpsert) {
+		s.IgnoreTagID()
+	})
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *TweetTagUpsertBulk) SetTweetID(v int) *TweetTagUpsertBulk {
 	return u.Update(func(s *TweetTagUpsert) {
@@ -647,6 +710,14 @@ func (u *TweetTagUpsertBulk) UpdateTweetID() *TweetTagUpsertBulk {
 	})
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TweetTagUpsertBulk) IgnoreTweetID() *TweetTagUpsertBulk {
+	return u.Update(func(s *TweetTagUpsert) {
+		s.IgnoreTweetID()
+	})
+}
+
 // Exec executes the query.
 func (u *TweetTagUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,6 +20,7 @@ import (
 	"entgo.io/ent/entc/integration/edgeschema/ent/tweet"
 	"entgo.io/ent/entc/integration/edgeschema/ent/tweettag"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // TweetTagUpdate is the builder for updating TweetTag entities.
@@ -116,13 +118,14 @@ func (ttu *TweetTagUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ttu *TweetTagUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := ttu.mutation.TagID(); ttu.mutation.TagCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetTag.tag"`)
+		errs = append(errs, &ValidationError{Name: "tag", Entity: "TweetTag", Field: "tag", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetTag.tag"`)})
 	}
 	if _, ok := ttu.mutation.TweetID(); ttu.mutation.TweetCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetTag.tweet"`)
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "TweetTag", Field: "tweet", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetTag.tweet"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ttu *TweetTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -202,7 +205,7 @@ func (ttu *TweetTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tweettag.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -313,13 +316,14 @@ func (ttuo *TweetTagUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ttuo *TweetTagUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := ttuo.mutation.TagID(); ttuo.mutation.TagCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetTag.tag"`)
+		errs = append(errs, &ValidationError{Name: "tag", Entity: "TweetTag", Field: "tag", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetTag.tag"`)})
 	}
 	if _, ok := ttuo.mutation.TweetID(); ttuo.mutation.TweetCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "TweetTag.tweet"`)
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "TweetTag", Field: "tweet", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "TweetTag.tweet"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ttuo *TweetTagUpdateOne) sqlSave(ctx context.Context) (_node *TweetTag, err error) {
@@ -329,7 +333,7 @@ func (ttuo *TweetTagUpdateOne) sqlSave(ctx context.Context) (_node *TweetTag, er
 	_spec := sqlgraph.NewUpdateSpec(tweettag.Table, tweettag.Columns, sqlgraph.NewFieldSpec(tweettag.FieldID, field.TypeUUID))
 	id, ok := ttuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TweetTag.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "TweetTag", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "TweetTag.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := ttuo.fields; len(fields) > 0 {
@@ -337,7 +341,7 @@ func (ttuo *TweetTagUpdateOne) sqlSave(ctx context.Context) (_node *TweetTag, er
 		_spec.Node.Columns = append(_spec.Node.Columns, tweettag.FieldID)
 		for _, f := range fields {
 			if !tweettag.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "TweetTag", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != tweettag.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -419,10 +423,69 @@ func (ttuo *TweetTagUpdateOne) sqlSave(ctx context.Context) (_node *TweetTag, er
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tweettag.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	ttuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (ttuo *TweetTagUpdateOne) sameUpdate(other *TweetTagUpdateOne) bool {
+	m1, m2 := *ttuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// TweetTagUpdateMany is the builder for updating many TweetTag entities by their ids.
+type TweetTagUpdateMany struct {
+	config
+	builders []*TweetTagUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (ttum *TweetTagUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*TweetTagUpdateOne
+Builders:
+	for _, b := range ttum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*TweetTagUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]uuid.UUID, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(tweettag.IDIn(ids...))
+		if err := (&TweetTagUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttum *TweetTagUpdateMany) ExecX(ctx context.Context) {
+	if err := ttum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
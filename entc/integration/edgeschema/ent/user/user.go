@@ -7,6 +7,8 @@
 package user
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -342,6 +344,120 @@ func ByRolesUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRolesUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "groups_count") that User queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that User queries can be ordered by.
+const (
+	OrderFieldID                OrderField = "id"
+	OrderFieldName              OrderField = "name"
+	OrderFieldGroupsCount       OrderField = "groups_count"
+	OrderFieldFriendsCount      OrderField = "friends_count"
+	OrderFieldRelativesCount    OrderField = "relatives_count"
+	OrderFieldLikedTweetsCount  OrderField = "liked_tweets_count"
+	OrderFieldTweetsCount       OrderField = "tweets_count"
+	OrderFieldRolesCount        OrderField = "roles_count"
+	OrderFieldJoinedGroupsCount OrderField = "joined_groups_count"
+	OrderFieldFriendshipsCount  OrderField = "friendships_count"
+	OrderFieldRelationshipCount OrderField = "relationship_count"
+	OrderFieldLikesCount        OrderField = "likes_count"
+	OrderFieldUserTweetsCount   OrderField = "user_tweets_count"
+	OrderFieldRolesUsersCount   OrderField = "roles_users_count"
+)
+
+// OrderFields holds all fields and edge counts that User queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldName,
+	OrderFieldGroupsCount,
+	OrderFieldFriendsCount,
+	OrderFieldRelativesCount,
+	OrderFieldLikedTweetsCount,
+	OrderFieldTweetsCount,
+	OrderFieldRolesCount,
+	OrderFieldJoinedGroupsCount,
+	OrderFieldFriendshipsCount,
+	OrderFieldRelationshipCount,
+	OrderFieldLikesCount,
+	OrderFieldUserTweetsCount,
+	OrderFieldRolesUsersCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if User queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("user: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := user.OrderByField(user.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.User.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldGroupsCount:
+		return ByGroupsCount(opts...), nil
+	case OrderFieldFriendsCount:
+		return ByFriendsCount(opts...), nil
+	case OrderFieldRelativesCount:
+		return ByRelativesCount(opts...), nil
+	case OrderFieldLikedTweetsCount:
+		return ByLikedTweetsCount(opts...), nil
+	case OrderFieldTweetsCount:
+		return ByTweetsCount(opts...), nil
+	case OrderFieldRolesCount:
+		return ByRolesCount(opts...), nil
+	case OrderFieldJoinedGroupsCount:
+		return ByJoinedGroupsCount(opts...), nil
+	case OrderFieldFriendshipsCount:
+		return ByFriendshipsCount(opts...), nil
+	case OrderFieldRelationshipCount:
+		return ByRelationshipCount(opts...), nil
+	case OrderFieldLikesCount:
+		return ByLikesCount(opts...), nil
+	case OrderFieldUserTweetsCount:
+		return ByUserTweetsCount(opts...), nil
+	case OrderFieldRolesUsersCount:
+		return ByRolesUsersCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newGroupsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
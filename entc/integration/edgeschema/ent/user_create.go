@@ -226,10 +226,11 @@ func (uc *UserCreate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "User", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "User.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -239,7 +240,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	_node, _spec := uc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -492,6 +493,12 @@ func (u *UserUpsert) UpdateName() *UserUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *UserUpsert) IgnoreName() *UserUpsert {
+	u.SetIgnore(user.FieldName)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -546,6 +553,14 @@ func (u *UserUpsertOne) UpdateName() *UserUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -582,12 +597,16 @@ func (u *UserUpsertOne) IDX(ctx context.Context) int {
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
+	err      error
 	builders []*UserCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
 func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
@@ -614,7 +633,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -760,6 +779,14 @@ func (u *UserUpsertBulk) UpdateName() *UserUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -154,6 +154,12 @@ func GroupIDNotIn(vs ...int) predicate.UserGroup {
 	return predicate.UserGroup(sql.FieldNotIn(FieldGroupID, vs...))
 }
 
+// JoinedAtWithinLast applies the WithinLast predicate on the "joined_at" field.
+// It matches values that are within the given duration before the current time.
+func JoinedAtWithinLast(d time.Duration) predicate.UserGroup {
+	return predicate.UserGroup(sql.FieldWithinLast(FieldJoinedAt, d))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserGroup {
 	return predicate.UserGroup(func(s *sql.Selector) {
@@ -169,7 +175,7 @@ func HasUser() predicate.UserGroup {
 func HasUserWith(preds ...predicate.User) predicate.UserGroup {
 	return predicate.UserGroup(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -192,7 +198,7 @@ func HasGroup() predicate.UserGroup {
 func HasGroupWith(preds ...predicate.Group) predicate.UserGroup {
 	return predicate.UserGroup(func(s *sql.Selector) {
 		step := newGroupStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
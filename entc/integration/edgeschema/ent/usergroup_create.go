@@ -107,22 +107,23 @@ func (ugc *UserGroupCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ugc *UserGroupCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ugc.mutation.JoinedAt(); !ok {
-		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "UserGroup.joined_at"`)}
+		errs = append(errs, &ValidationError{Name: "joined_at", Entity: "UserGroup", Field: "joined_at", Reason: "missing required field", err: errors.New(`ent: missing required field "UserGroup.joined_at"`)})
 	}
 	if _, ok := ugc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserGroup.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "UserGroup", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "UserGroup.user_id"`)})
 	}
 	if _, ok := ugc.mutation.GroupID(); !ok {
-		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "UserGroup.group_id"`)}
+		errs = append(errs, &ValidationError{Name: "group_id", Entity: "UserGroup", Field: "group_id", Reason: "missing required field", err: errors.New(`ent: missing required field "UserGroup.group_id"`)})
 	}
 	if _, ok := ugc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserGroup.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "UserGroup", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "UserGroup.user"`)})
 	}
 	if _, ok := ugc.mutation.GroupID(); !ok {
-		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "UserGroup.group"`)}
+		errs = append(errs, &ValidationError{Name: "group", Entity: "UserGroup", Field: "group", Reason: "missing required edge", err: errors.New(`ent: missing required edge "UserGroup.group"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ugc *UserGroupCreate) sqlSave(ctx context.Context) (*UserGroup, error) {
@@ -132,7 +133,7 @@ func (ugc *UserGroupCreate) sqlSave(ctx context.Context) (*UserGroup, error) {
 	_node, _spec := ugc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ugc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -251,6 +252,12 @@ func (u *UserGroupUpsert) UpdateJoinedAt() *UserGroupUpsert {
 	return u
 }
 
+// IgnoreJoinedAt keeps the current value of the "joined_at" field in case of conflict.
+func (u *UserGroupUpsert) IgnoreJoinedAt() *UserGroupUpsert {
+	u.SetIgnore(usergroup.FieldJoinedAt)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *UserGroupUpsert) SetUserID(v int) *UserGroupUpsert {
 	u.Set(usergroup.FieldUserID, v)
@@ -263,6 +270,12 @@ func (u *UserGroupUpsert) UpdateUserID() *UserGroupUpsert {
 	return u
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+func (u *UserGroupUpsert) IgnoreUserID() *UserGroupUpsert {
+	u.SetIgnore(usergroup.FieldUserID)
+	return u
+}
+
 // SetGroupID sets the "group_id" field.
 func (u *UserGroupUpsert) SetGroupID(v int) *UserGroupUpsert {
 	u.Set(usergroup.FieldGroupID, v)
@@ -275,6 +288,12 @@ func (u *UserGroupUpsert) UpdateGroupID() *UserGroupUpsert {
 	return u
 }
 
+// IgnoreGroupID keeps the current value of the "group_id" field in case of conflict.
+func (u *UserGroupUpsert) IgnoreGroupID() *UserGroupUpsert {
+	u.SetIgnore(usergroup.FieldGroupID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -329,6 +348,14 @@ func (u *UserGroupUpsertOne) UpdateJoinedAt() *UserGroupUpsertOne {
 	})
 }
 
+// IgnoreJoinedAt keeps the current value of the "joined_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserGroupUpsertOne) IgnoreJoinedAt() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.IgnoreJoinedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *UserGroupUpsertOne) SetUserID(v int) *UserGroupUpsertOne {
 	return u.Update(func(s *UserGroupUpsert) {
@@ -343,6 +370,14 @@ func (u *UserGroupUpsertOne) UpdateUserID() *UserGroupUpsertOne {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserGroupUpsertOne) IgnoreUserID() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetGroupID sets the "group_id" field.
 func (u *UserGroupUpsertOne) SetGroupID(v int) *UserGroupUpsertOne {
 	return u.Update(func(s *UserGroupUpsert) {
@@ -357,6 +392,14 @@ func (u *UserGroupUpsertOne) UpdateGroupID() *UserGroupUpsertOne {
 	})
 }
 
+// IgnoreGroupID keeps the current value of the "group_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserGroupUpsertOne) IgnoreGroupID() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.IgnoreGroupID()
+	})
+}
+
 // Exec executes the query.
 func (u *UserGroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -393,12 +436,16 @@ func (u *UserGroupUpsertOne) IDX(ctx context.Context) int {
 // UserGroupCreateBulk is the builder for creating many UserGroup entities in bulk.
 type UserGroupCreateBulk struct {
 	config
+	err      error
 	builders []*UserGroupCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the UserGroup entities in the database.
 func (ugcb *UserGroupCreateBulk) Save(ctx context.Context) ([]*UserGroup, error) {
+	if ugcb.err != nil {
+		return nil, ugcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ugcb.builders))
 	nodes := make([]*UserGroup, len(ugcb.builders))
 	mutators := make([]Mutator, len(ugcb.builders))
@@ -425,7 +472,7 @@ func (ugcb *UserGroupCreateBulk) Save(ctx context.Context) ([]*UserGroup, error)
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ugcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -571,6 +618,14 @@ func (u *UserGroupUpsertBulk) UpdateJoinedAt() *UserGroupUpsertBulk {
 	})
 }
 
+// IgnoreJoinedAt keeps the current value of the "joined_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserGroupUpsertBulk) IgnoreJoinedAt() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.IgnoreJoinedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *UserGroupUpsertBulk) SetUserID(v int) *UserGroupUpsertBulk {
 	return u.Update(func(s *UserGroupUpsert) {
@@ -585,6 +640,14 @@ func (u *UserGroupUpsertBulk) UpdateUserID() *UserGroupUpsertBulk {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserGroupUpsertBulk) IgnoreUserID() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetGroupID sets the "group_id" field.
 func (u *UserGroupUpsertBulk) SetGroupID(v int) *UserGroupUpsertBulk {
 	return u.Update(func(s *UserGroupUpsert) {
@@ -599,6 +662,14 @@ func (u *UserGroupUpsertBulk) UpdateGroupID() *UserGroupUpsertBulk {
 	})
 }
 
+// IgnoreGroupID keeps the current value of the "group_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserGroupUpsertBulk) IgnoreGroupID() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.IgnoreGroupID()
+	})
+}
+
 // Exec executes the query.
 func (u *UserGroupUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -116,13 +117,14 @@ func (ugu *UserGroupUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ugu *UserGroupUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := ugu.mutation.UserID(); ugu.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserGroup.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "UserGroup", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserGroup.user"`)})
 	}
 	if _, ok := ugu.mutation.GroupID(); ugu.mutation.GroupCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserGroup.group"`)
+		errs = append(errs, &ValidationError{Name: "group", Entity: "UserGroup", Field: "group", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserGroup.group"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ugu *UserGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -202,7 +204,7 @@ func (ugu *UserGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{usergroup.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -313,13 +315,14 @@ func (uguo *UserGroupUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (uguo *UserGroupUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := uguo.mutation.UserID(); uguo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserGroup.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "UserGroup", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserGroup.user"`)})
 	}
 	if _, ok := uguo.mutation.GroupID(); uguo.mutation.GroupCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserGroup.group"`)
+		errs = append(errs, &ValidationError{Name: "group", Entity: "UserGroup", Field: "group", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserGroup.group"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup, err error) {
@@ -329,7 +332,7 @@ func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup,
 	_spec := sqlgraph.NewUpdateSpec(usergroup.Table, usergroup.Columns, sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeInt))
 	id, ok := uguo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserGroup.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "UserGroup", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "UserGroup.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := uguo.fields; len(fields) > 0 {
@@ -337,7 +340,7 @@ func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup,
 		_spec.Node.Columns = append(_spec.Node.Columns, usergroup.FieldID)
 		for _, f := range fields {
 			if !usergroup.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "UserGroup", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != usergroup.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -419,10 +422,69 @@ func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup,
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{usergroup.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	uguo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (uguo *UserGroupUpdateOne) sameUpdate(other *UserGroupUpdateOne) bool {
+	m1, m2 := *uguo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// UserGroupUpdateMany is the builder for updating many UserGroup entities by their ids.
+type UserGroupUpdateMany struct {
+	config
+	builders []*UserGroupUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (ugum *UserGroupUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*UserGroupUpdateOne
+Builders:
+	for _, b := range ugum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*UserGroupUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(usergroup.IDIn(ids...))
+		if err := (&UserGroupUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ugum *UserGroupUpdateMany) ExecX(ctx context.Context) {
+	if err := ugum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
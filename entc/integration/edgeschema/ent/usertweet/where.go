@@ -154,6 +154,12 @@ func TweetIDNotIn(vs ...int) predicate.UserTweet {
 	return predicate.UserTweet(sql.FieldNotIn(FieldTweetID, vs...))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.UserTweet {
+	return predicate.UserTweet(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserTweet {
 	return predicate.UserTweet(func(s *sql.Selector) {
@@ -169,7 +175,7 @@ func HasUser() predicate.UserTweet {
 func HasUserWith(preds ...predicate.User) predicate.UserTweet {
 	return predicate.UserTweet(func(s *sql.Selector) {
 		step := newUserStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -192,7 +198,7 @@ func HasTweet() predicate.UserTweet {
 func HasTweetWith(preds ...predicate.Tweet) predicate.UserTweet {
 	return predicate.UserTweet(func(s *sql.Selector) {
 		step := newTweetStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+		sqlgraph.HasNeighborsWithExists(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
 			}
@@ -107,22 +107,23 @@ func (utc *UserTweetCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (utc *UserTweetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := utc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserTweet.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "UserTweet", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "UserTweet.created_at"`)})
 	}
 	if _, ok := utc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserTweet.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "UserTweet", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "UserTweet.user_id"`)})
 	}
 	if _, ok := utc.mutation.TweetID(); !ok {
-		return &ValidationError{Name: "tweet_id", err: errors.New(`ent: missing required field "UserTweet.tweet_id"`)}
+		errs = append(errs, &ValidationError{Name: "tweet_id", Entity: "UserTweet", Field: "tweet_id", Reason: "missing required field", err: errors.New(`ent: missing required field "UserTweet.tweet_id"`)})
 	}
 	if _, ok := utc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserTweet.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "UserTweet", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "UserTweet.user"`)})
 	}
 	if _, ok := utc.mutation.TweetID(); !ok {
-		return &ValidationError{Name: "tweet", err: errors.New(`ent: missing required edge "UserTweet.tweet"`)}
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "UserTweet", Field: "tweet", Reason: "missing required edge", err: errors.New(`ent: missing required edge "UserTweet.tweet"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (utc *UserTweetCreate) sqlSave(ctx context.Context) (*UserTweet, error) {
@@ -132,7 +133,7 @@ func (utc *UserTweetCreate) sqlSave(ctx context.Context) (*UserTweet, error) {
 	_node, _spec := utc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, utc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -251,6 +252,12 @@ func (u *UserTweetUpsert) UpdateCreatedAt() *UserTweetUpsert {
 	return u
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+func (u *UserTweetUpsert) IgnoreCreatedAt() *UserTweetUpsert {
+	u.SetIgnore(usertweet.FieldCreatedAt)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *UserTweetUpsert) SetUserID(v int) *UserTweetUpsert {
 	u.Set(usertweet.FieldUserID, v)
@@ -263,6 +270,12 @@ func (u *UserTweetUpsert) UpdateUserID() *UserTweetUpsert {
 	return u
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+func (u *UserTweetUpsert) IgnoreUserID() *UserTweetUpsert {
+	u.SetIgnore(usertweet.FieldUserID)
+	return u
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *UserTweetUpsert) SetTweetID(v int) *UserTweetUpsert {
 	u.Set(usertweet.FieldTweetID, v)
@@ -275,6 +288,12 @@ func (u *UserTweetUpsert) UpdateTweetID() *UserTweetUpsert {
 	return u
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+func (u *UserTweetUpsert) IgnoreTweetID() *UserTweetUpsert {
+	u.SetIgnore(usertweet.FieldTweetID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -329,6 +348,14 @@ func (u *UserTweetUpsertOne) UpdateCreatedAt() *UserTweetUpsertOne {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserTweetUpsertOne) IgnoreCreatedAt() *UserTweetUpsertOne {
+	return u.Update(func(s *UserTweetUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *UserTweetUpsertOne) SetUserID(v int) *UserTweetUpsertOne {
 	return u.Update(func(s *UserTweetUpsert) {
@@ -343,6 +370,14 @@ func (u *UserTweetUpsertOne) UpdateUserID() *UserTweetUpsertOne {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserTweetUpsertOne) IgnoreUserID() *UserTweetUpsertOne {
+	return u.Update(func(s *UserTweetUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *UserTweetUpsertOne) SetTweetID(v int) *UserTweetUpsertOne {
 	return u.Update(func(s *UserTweetUpsert) {
@@ -357,6 +392,14 @@ func (u *UserTweetUpsertOne) UpdateTweetID() *UserTweetUpsertOne {
 	})
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserTweetUpsertOne) IgnoreTweetID() *UserTweetUpsertOne {
+	return u.Update(func(s *UserTweetUpsert) {
+		s.IgnoreTweetID()
+	})
+}
+
 // Exec executes the query.
 func (u *UserTweetUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -393,12 +436,16 @@ func (u *UserTweetUpsertOne) IDX(ctx context.Context) int {
 // UserTweetCreateBulk is the builder for creating many UserTweet entities in bulk.
 type UserTweetCreateBulk struct {
 	config
+	err      error
 	builders []*UserTweetCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the UserTweet entities in the database.
 func (utcb *UserTweetCreateBulk) Save(ctx context.Context) ([]*UserTweet, error) {
+	if utcb.err != nil {
+		return nil, utcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(utcb.builders))
 	nodes := make([]*UserTweet, len(utcb.builders))
 	mutators := make([]Mutator, len(utcb.builders))
@@ -425,7 +472,7 @@ func (utcb *UserTweetCreateBulk) Save(ctx context.Context) ([]*UserTweet, error)
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, utcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -571,6 +618,14 @@ func (u *UserTweetUpsertBulk) UpdateCreatedAt() *UserTweetUpsertBulk {
 	})
 }
 
+// IgnoreCreatedAt keeps the current value of the "created_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserTweetUpsertBulk) IgnoreCreatedAt() *UserTweetUpsertBulk {
+	return u.Update(func(s *UserTweetUpsert) {
+		s.IgnoreCreatedAt()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *UserTweetUpsertBulk) SetUserID(v int) *UserTweetUpsertBulk {
 	return u.Update(func(s *UserTweetUpsert) {
@@ -585,6 +640,14 @@ func (u *UserTweetUpsertBulk) UpdateUserID() *UserTweetUpsertBulk {
 	})
 }
 
+// IgnoreUserID keeps the current value of the "user_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserTweetUpsertBulk) IgnoreUserID() *UserTweetUpsertBulk {
+	return u.Update(func(s *UserTweetUpsert) {
+		s.IgnoreUserID()
+	})
+}
+
 // SetTweetID sets the "tweet_id" field.
 func (u *UserTweetUpsertBulk) SetTweetID(v int) *UserTweetUpsertBulk {
 	return u.Update(func(s *UserTweetUpsert) {
@@ -599,6 +662,14 @@ func (u *UserTweetUpsertBulk) UpdateTweetID() *UserTweetUpsertBulk {
 	})
 }
 
+// IgnoreTweetID keeps the current value of the "tweet_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserTweetUpsertBulk) IgnoreTweetID() *UserTweetUpsertBulk {
+	return u.Update(func(s *UserTweetUpsert) {
+		s.IgnoreTweetID()
+	})
+}
+
 // Exec executes the query.
 func (u *UserTweetUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -116,13 +117,14 @@ func (utu *UserTweetUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (utu *UserTweetUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := utu.mutation.UserID(); utu.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserTweet.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "UserTweet", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserTweet.user"`)})
 	}
 	if _, ok := utu.mutation.TweetID(); utu.mutation.TweetCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserTweet.tweet"`)
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "UserTweet", Field: "tweet", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserTweet.tweet"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (utu *UserTweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -202,7 +204,7 @@ func (utu *UserTweetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{usertweet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -313,13 +315,14 @@ func (utuo *UserTweetUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (utuo *UserTweetUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := utuo.mutation.UserID(); utuo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserTweet.user"`)
+		errs = append(errs, &ValidationError{Name: "user", Entity: "UserTweet", Field: "user", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserTweet.user"`)})
 	}
 	if _, ok := utuo.mutation.TweetID(); utuo.mutation.TweetCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "UserTweet.tweet"`)
+		errs = append(errs, &ValidationError{Name: "tweet", Entity: "UserTweet", Field: "tweet", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "UserTweet.tweet"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (utuo *UserTweetUpdateOne) sqlSave(ctx context.Context) (_node *UserTweet, err error) {
@@ -329,7 +332,7 @@ func (utuo *UserTweetUpdateOne) sqlSave(ctx context.Context) (_node *UserTweet,
 	_spec := sqlgraph.NewUpdateSpec(usertweet.Table, usertweet.Columns, sqlgraph.NewFieldSpec(usertweet.FieldID, field.TypeInt))
 	id, ok := utuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserTweet.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "UserTweet", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "UserTweet.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := utuo.fields; len(fields) > 0 {
@@ -337,7 +340,7 @@ func (utuo *UserTweetUpdateOne) sqlSave(ctx context.Context) (_node *UserTweet,
 		_spec.Node.Columns = append(_spec.Node.Columns, usertweet.FieldID)
 		for _, f := range fields {
 			if !usertweet.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "UserTweet", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != usertweet.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -419,10 +422,69 @@ func (utuo *UserTweetUpdateOne) sqlSave(ctx context.Context) (_node *UserTweet,
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{usertweet.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	utuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (utuo *UserTweetUpdateOne) sameUpdate(other *UserTweetUpdateOne) bool {
+	m1, m2 := *utuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// UserTweetUpdateMany is the builder for updating many UserTweet entities by their ids.
+type UserTweetUpdateMany struct {
+	config
+	builders []*UserTweetUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (utum *UserTweetUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*UserTweetUpdateOne
+Builders:
+	for _, b := range utum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*UserTweetUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(usertweet.IDIn(ids...))
+		if err := (&UserTweetUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utum *UserTweetUpdateMany) ExecX(ctx context.Context) {
+	if err := utum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -59,7 +59,8 @@ func (ac *APICreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ac *APICreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (ac *APICreate) sqlSave(ctx context.Context) (*Api, error) {
@@ -69,7 +70,7 @@ func (ac *APICreate) sqlSave(ctx context.Context) (*Api, error) {
 	_node, _spec := ac.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -208,12 +209,16 @@ func (u *ApiUpsertOne) IDX(ctx context.Context) int {
 // APICreateBulk is the builder for creating many Api entities in bulk.
 type APICreateBulk struct {
 	config
+	err      error
 	builders []*APICreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Api entities in the database.
 func (acb *APICreateBulk) Save(ctx context.Context) ([]*Api, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
 	nodes := make([]*Api, len(acb.builders))
 	mutators := make([]Mutator, len(acb.builders))
@@ -239,7 +244,7 @@ func (acb *APICreateBulk) Save(ctx context.Context) ([]*Api, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -19,8 +19,9 @@ import (
 // APIDelete is the builder for deleting a Api entity.
 type APIDelete struct {
 	config
-	hooks    []Hook
-	mutation *APIMutation
+	hooks     []Hook
+	mutation  *APIMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the APIDelete builder.
@@ -43,8 +44,21 @@ func (ad *APIDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ad *APIDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *APIDelete {
+	ad.modifiers = append(ad.modifiers, modifiers...)
+	return ad
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ado *APIDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *APIDeleteOne {
+	ado.ad.Modify(modifiers...)
+	return ado
+}
+
 func (ad *APIDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(api.Table, sqlgraph.NewFieldSpec(api.FieldID, field.TypeInt))
+	_spec.AddModifiers(ad.modifiers...)
 	if ps := ad.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (ad *APIDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, ad.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	ad.mutation.done = true
 	return affected, err
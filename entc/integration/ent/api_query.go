@@ -300,7 +300,7 @@ func (aq *APIQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range aq.ctx.Fields {
 		if !api.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Api", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if aq.path != nil {
@@ -460,10 +460,53 @@ func (aq *APIQuery) Modify(modifiers ...func(s *sql.Selector)) *APISelect {
 	return aq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (aq *APIQuery) Union(queries ...*APIQuery) *APIQuery {
+	return aq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (aq *APIQuery) UnionAll(queries ...*APIQuery) *APIQuery {
+	return aq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (aq *APIQuery) Intersect(queries ...*APIQuery) *APIQuery {
+	return aq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (aq *APIQuery) Except(queries ...*APIQuery) *APIQuery {
+	return aq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (aq *APIQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*APIQuery) *APIQuery {
+	aq.modifiers = append(aq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return aq
+}
+
 // APIGroupBy is the group-by builder for Api entities.
 type APIGroupBy struct {
 	selector
-	build *APIQuery
+	build  *APIQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -481,6 +524,14 @@ func (agb *APIGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*APIQuery, *APIGroupBy](ctx, agb.build, agb, agb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (agb *APIGroupBy) Having(ps ...*sql.Predicate) *APIGroupBy {
+	agb.having = append(agb.having, ps...)
+	return agb
+}
+
 func (agb *APIGroupBy) sqlScan(ctx context.Context, root *APIQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(agb.fns))
@@ -496,6 +547,9 @@ func (agb *APIGroupBy) sqlScan(ctx context.Context, root *APIQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*agb.flds...)...)
+	if len(agb.having) > 0 {
+		selector.Having(sql.And(agb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -59,7 +59,8 @@ func (bc *BuilderCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (bc *BuilderCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (bc *BuilderCreate) sqlSave(ctx context.Context) (*Builder, error) {
@@ -69,7 +70,7 @@ func (bc *BuilderCreate) sqlSave(ctx context.Context) (*Builder, error) {
 	_node, _spec := bc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -208,12 +209,16 @@ func (u *BuilderUpsertOne) IDX(ctx context.Context) int {
 // BuilderCreateBulk is the builder for creating many Builder entities in bulk.
 type BuilderCreateBulk struct {
 	config
+	err      error
 	builders []*BuilderCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Builder entities in the database.
 func (bcb *BuilderCreateBulk) Save(ctx context.Context) ([]*Builder, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
 	nodes := make([]*Builder, len(bcb.builders))
 	mutators := make([]Mutator, len(bcb.builders))
@@ -239,7 +244,7 @@ func (bcb *BuilderCreateBulk) Save(ctx context.Context) ([]*Builder, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -19,8 +19,9 @@ import (
 // BuilderDelete is the builder for deleting a Builder entity.
 type BuilderDelete struct {
 	config
-	hooks    []Hook
-	mutation *BuilderMutation
+	hooks     []Hook
+	mutation  *BuilderMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the BuilderDelete builder.
@@ -43,8 +44,21 @@ func (bd *BuilderDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (bd *BuilderDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *BuilderDelete {
+	bd.modifiers = append(bd.modifiers, modifiers...)
+	return bd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (bdo *BuilderDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *BuilderDeleteOne {
+	bdo.bd.Modify(modifiers...)
+	return bdo
+}
+
 func (bd *BuilderDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(builder.Table, sqlgraph.NewFieldSpec(builder.FieldID, field.TypeInt))
+	_spec.AddModifiers(bd.modifiers...)
 	if ps := bd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (bd *BuilderDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, bd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	bd.mutation.done = true
 	return affected, err
@@ -300,7 +300,7 @@ func (bq *BuilderQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range bq.ctx.Fields {
 		if !builder.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Builder", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if bq.path != nil {
@@ -460,10 +460,53 @@ func (bq *BuilderQuery) Modify(modifiers ...func(s *sql.Selector)) *BuilderSelec
 	return bq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (bq *BuilderQuery) Union(queries ...*BuilderQuery) *BuilderQuery {
+	return bq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (bq *BuilderQuery) UnionAll(queries ...*BuilderQuery) *BuilderQuery {
+	return bq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (bq *BuilderQuery) Intersect(queries ...*BuilderQuery) *BuilderQuery {
+	return bq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (bq *BuilderQuery) Except(queries ...*BuilderQuery) *BuilderQuery {
+	return bq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (bq *BuilderQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*BuilderQuery) *BuilderQuery {
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return bq
+}
+
 // BuilderGroupBy is the group-by builder for Builder entities.
 type BuilderGroupBy struct {
 	selector
-	build *BuilderQuery
+	build  *BuilderQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -481,6 +524,14 @@ func (bgb *BuilderGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*BuilderQuery, *BuilderGroupBy](ctx, bgb.build, bgb, bgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (bgb *BuilderGroupBy) Having(ps ...*sql.Predicate) *BuilderGroupBy {
+	bgb.having = append(bgb.having, ps...)
+	return bgb
+}
+
 func (bgb *BuilderGroupBy) sqlScan(ctx context.Context, root *BuilderQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(bgb.fns))
@@ -496,6 +547,9 @@ func (bgb *BuilderGroupBy) sqlScan(ctx context.Context, root *BuilderQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*bgb.flds...)...)
+	if len(bgb.having) > 0 {
+		selector.Having(sql.And(bgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
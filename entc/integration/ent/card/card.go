@@ -7,6 +7,7 @@
 package card
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -153,6 +154,92 @@ func BySpec(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSpecStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "spec_count") that Card queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Card queries can be ordered by.
+const (
+	OrderFieldID         OrderField = "id"
+	OrderFieldCreateTime OrderField = "create_time"
+	OrderFieldUpdateTime OrderField = "update_time"
+	OrderFieldBalance    OrderField = "balance"
+	OrderFieldNumber     OrderField = "number"
+	OrderFieldName       OrderField = "name"
+	OrderFieldSpecCount  OrderField = "spec_count"
+)
+
+// OrderFields holds all fields and edge counts that Card queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldCreateTime,
+	OrderFieldUpdateTime,
+	OrderFieldBalance,
+	OrderFieldNumber,
+	OrderFieldName,
+	OrderFieldSpecCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Card queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("card: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := card.OrderByField(card.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Card.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldCreateTime:
+		return ByCreateTime(opts...), nil
+	case OrderFieldUpdateTime:
+		return ByUpdateTime(opts...), nil
+	case OrderFieldBalance:
+		return ByBalance(opts...), nil
+	case OrderFieldNumber:
+		return ByNumber(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldSpecCount:
+		return BySpecCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -269,6 +269,11 @@ func NumberContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldNumber, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Card {
 	return predicate.Card(sql.FieldEQ(FieldName, v))
@@ -344,6 +349,23 @@ func NameContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldName, v))
+}
+
+// CreateTimeWithinLast applies the WithinLast predicate on the "create_time" field.
+// It matches values that are within the given duration before the current time.
+func CreateTimeWithinLast(d time.Duration) predicate.Card {
+	return predicate.Card(sql.FieldWithinLast(FieldCreateTime, d))
+}
+
+// UpdateTimeWithinLast applies the WithinLast predicate on the "update_time" field.
+// It matches values that are within the given duration before the current time.
+func UpdateTimeWithinLast(d time.Duration) predicate.Card {
+	return predicate.Card(sql.FieldWithinLast(FieldUpdateTime, d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
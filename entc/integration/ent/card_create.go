@@ -175,29 +175,30 @@ func (cc *CardCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CardCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.CreateTime(); !ok {
-		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Card.create_time"`)}
+		errs = append(errs, &ValidationError{Name: "create_time", Entity: "Card", Field: "create_time", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.create_time"`)})
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
-		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Card.update_time"`)}
+		errs = append(errs, &ValidationError{Name: "update_time", Entity: "Card", Field: "update_time", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.update_time"`)})
 	}
 	if _, ok := cc.mutation.Balance(); !ok {
-		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Card.balance"`)}
+		errs = append(errs, &ValidationError{Name: "balance", Entity: "Card", Field: "balance", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.balance"`)})
 	}
 	if _, ok := cc.mutation.Number(); !ok {
-		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Card.number"`)}
+		errs = append(errs, &ValidationError{Name: "number", Entity: "Card", Field: "number", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.number"`)})
 	}
 	if v, ok := cc.mutation.Number(); ok {
 		if err := card.NumberValidator(v); err != nil {
-			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "number", Entity: "Card", Field: "number", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)})
 		}
 	}
 	if v, ok := cc.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Card", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
@@ -207,7 +208,7 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -341,6 +342,12 @@ func (u *CardUpsert) UpdateUpdateTime() *CardUpsert {
 	return u
 }
 
+// IgnoreUpdateTime keeps the current value of the "update_time" field in case of conflict.
+func (u *CardUpsert) IgnoreUpdateTime() *CardUpsert {
+	u.SetIgnore(card.FieldUpdateTime)
+	return u
+}
+
 // SetBalance sets the "balance" field.
 func (u *CardUpsert) SetBalance(v float64) *CardUpsert {
 	u.Set(card.FieldBalance, v)
@@ -353,6 +360,12 @@ func (u *CardUpsert) UpdateBalance() *CardUpsert {
 	return u
 }
 
+// IgnoreBalance keeps the current value of the "balance" field in case of conflict.
+func (u *CardUpsert) IgnoreBalance() *CardUpsert {
+	u.SetIgnore(card.FieldBalance)
+	return u
+}
+
 // AddBalance adds v to the "balance" field.
 func (u *CardUpsert) AddBalance(v float64) *CardUpsert {
 	u.Add(card.FieldBalance, v)
@@ -371,6 +384,12 @@ func (u *CardUpsert) UpdateName() *CardUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *CardUpsert) IgnoreName() *CardUpsert {
+	u.SetIgnore(card.FieldName)
+	return u
+}
+
 // ClearName clears the value of the "name" field.
 func (u *CardUpsert) ClearName() *CardUpsert {
 	u.SetNull(card.FieldName)
@@ -439,6 +458,14 @@ func (u *CardUpsertOne) UpdateUpdateTime() *CardUpsertOne {
 	})
 }
 
+// IgnoreUpdateTime keeps the current value of the "update_time" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CardUpsertOne) IgnoreUpdateTime() *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.IgnoreUpdateTime()
+	})
+}
+
 // SetBalance sets the "balance" field.
 func (u *CardUpsertOne) SetBalance(v float64) *CardUpsertOne {
 	return u.Update(func(s *CardUpsert) {
@@ -460,6 +487,14 @@ func (u *CardUpsertOne) UpdateBalance() *CardUpsertOne {
 	})
 }
 
+// IgnoreBalance keeps the current value of the "balance" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CardUpsertOne) IgnoreBalance() *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.IgnoreBalance()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *CardUpsertOne) SetName(v string) *CardUpsertOne {
 	return u.Update(func(s *CardUpsert) {
@@ -474,6 +509,14 @@ func (u *CardUpsertOne) UpdateName() *CardUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CardUpsertOne) IgnoreName() *CardUpsertOne {
+	return u.Update(func(s *CardUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // ClearName clears the value of the "name" field.
 func (u *CardUpsertOne) ClearName() *CardUpsertOne {
 	return u.Update(func(s *CardUpsert) {
@@ -517,12 +560,16 @@ func (u *CardUpsertOne) IDX(ctx context.Context) int {
 // CardCreateBulk is the builder for creating many Card entities in bulk.
 type CardCreateBulk struct {
 	config
+	err      error
 	builders []*CardCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Card entities in the database.
 func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Card, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -549,7 +596,7 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -705,6 +752,14 @@ func (u *CardUpsertBulk) UpdateUpdateTime() *CardUpsertBulk {
 	})
 }
 
+// IgnoreUpdateTime keeps the current value of the "update_time" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CardUpsertBulk) IgnoreUpdateTime() *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.IgnoreUpdateTime()
+	})
+}
+
 // SetBalance sets the "balance" field.
 func (u *CardUpsertBulk) SetBalance(v float64) *CardUpsertBulk {
 	return u.Update(func(s *CardUpsert) {
@@ -726,6 +781,14 @@ func (u *CardUpsertBulk) UpdateBalance() *CardUpsertBulk {
 	})
 }
 
+// IgnoreBalance keeps the current value of the "balance" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CardUpsertBulk) IgnoreBalance() *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.IgnoreBalance()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *CardUpsertBulk) SetName(v string) *CardUpsertBulk {
 	return u.Update(func(s *CardUpsert) {
@@ -740,6 +803,14 @@ func (u *CardUpsertBulk) UpdateName() *CardUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CardUpsertBulk) IgnoreName() *CardUpsertBulk {
+	return u.Update(func(s *CardUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // ClearName clears the value of the "name" field.
 func (u *CardUpsertBulk) ClearName() *CardUpsertBulk {
 	return u.Update(func(s *CardUpsert) {
@@ -19,8 +19,9 @@ import (
 // CardDelete is the builder for deleting a Card entity.
 type CardDelete struct {
 	config
-	hooks    []Hook
-	mutation *CardMutation
+	hooks     []Hook
+	mutation  *CardMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the CardDelete builder.
@@ -43,8 +44,21 @@ func (cd *CardDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (cd *CardDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *CardDelete {
+	cd.modifiers = append(cd.modifiers, modifiers...)
+	return cd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (cdo *CardDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *CardDeleteOne {
+	cdo.cd.Modify(modifiers...)
+	return cdo
+}
+
 func (cd *CardDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(card.Table, sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt))
+	_spec.AddModifiers(cd.modifiers...)
 	if ps := cd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (cd *CardDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	cd.mutation.done = true
 	return affected, err
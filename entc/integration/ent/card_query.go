@@ -397,7 +397,7 @@ func (cq *CardQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range cq.ctx.Fields {
 		if !card.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Card", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if cq.path != nil {
@@ -483,26 +483,49 @@ func (cq *CardQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*C
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(user.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_card" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "user_card" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (cq *CardQuery) loadSpec(ctx context.Context, query *SpecQuery, nodes []*Card, init func(*Card), assign func(*Card, *Spec)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := cq.loadSpec(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Card)
 	nids := make(map[int]map[*Card]struct{})
@@ -697,10 +720,53 @@ func (cq *CardQuery) WithNamedSpec(name string, opts ...func(*SpecQuery)) *CardQ
 	return cq
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (cq *CardQuery) Union(queries ...*CardQuery) *CardQuery {
+	return cq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (cq *CardQuery) UnionAll(queries ...*CardQuery) *CardQuery {
+	return cq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (cq *CardQuery) Intersect(queries ...*CardQuery) *CardQuery {
+	return cq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (cq *CardQuery) Except(queries ...*CardQuery) *CardQuery {
+	return cq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (cq *CardQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*CardQuery) *CardQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return cq
+}
+
 // CardGroupBy is the group-by builder for Card entities.
 type CardGroupBy struct {
 	selector
-	build *CardQuery
+	build  *CardQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -718,6 +784,14 @@ func (cgb *CardGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*CardQuery, *CardGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (cgb *CardGroupBy) Having(ps ...*sql.Predicate) *CardGroupBy {
+	cgb.having = append(cgb.having, ps...)
+	return cgb
+}
+
 func (cgb *CardGroupBy) sqlScan(ctx context.Context, root *CardQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(cgb.fns))
@@ -733,6 +807,9 @@ func (cgb *CardGroupBy) sqlScan(ctx context.Context, root *CardQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if len(cgb.having) > 0 {
+		selector.Having(sql.And(cgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -186,12 +187,13 @@ func (cu *CardUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cu *CardUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := cu.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Card", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -306,7 +308,7 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -487,12 +489,13 @@ func (cuo *CardUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cuo *CardUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := cuo.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Card", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -508,7 +511,7 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 	_spec := sqlgraph.NewUpdateSpec(card.Table, card.Columns, sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt))
 	id, ok := cuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Card.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Card", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Card.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := cuo.fields; len(fields) > 0 {
@@ -516,7 +519,7 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 		_spec.Node.Columns = append(_spec.Node.Columns, card.FieldID)
 		for _, f := range fields {
 			if !card.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Card", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != card.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -627,10 +630,72 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	cuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (cuo *CardUpdateOne) sameUpdate(other *CardUpdateOne) bool {
+	if len(cuo.modifiers) > 0 || len(other.modifiers) > 0 {
+		return false
+	}
+	m1, m2 := *cuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// CardUpdateMany is the builder for updating many Card entities by their ids.
+type CardUpdateMany struct {
+	config
+	builders []*CardUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (cum *CardUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*CardUpdateOne
+Builders:
+	for _, b := range cum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*CardUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(card.IDIn(ids...))
+		if err := (&CardUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cum *CardUpdateMany) ExecX(ctx context.Context) {
+	if err := cum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 
 	"entgo.io/ent"
 	"entgo.io/ent/entc/integration/ent/migrate"
@@ -143,7 +144,7 @@ func (c *config) options(opts ...Option) {
 		opt(c)
 	}
 	if c.debug {
-		c.driver = dialect.Debug(c.driver, c.log)
+		c.driver = dialect.DebugWithRedactor(c.driver, sql.RedactColumns("password", "password_other", "sensitive"), c.log)
 	}
 }
 
@@ -221,14 +222,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}, nil
 }
 
-// BeginTx returns a transactional client with specified options.
+// BeginTx returns a transactional client with specified options. For example,
+// starting a read-only transaction with the REPEATABLE READ isolation level:
+//
+//	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
 func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, errors.New("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(interface {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if drv, ok := c.driver.(interface {
 		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
+	}); ok {
+		tx, err = drv.BeginTx(ctx, opts)
+	} else if opts == nil {
+		tx, err = c.driver.Tx(ctx)
+	} else {
+		err = errors.New("driver does not support transaction options")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
@@ -270,7 +284,7 @@ func (c *Client) Debug() *Client {
 		return c
 	}
 	cfg := c.config
-	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.driver = dialect.DebugWithRedactor(c.driver, sql.RedactColumns("password", "password_other", "sensitive"), c.log)
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -315,6 +329,690 @@ func (c *Client) Driver() dialect.Driver {
 	return c.driver
 }
 
+// QueryRaw executes the given raw SQL query and scans the returned rows into Api entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Api.QueryRaw(ctx, "SELECT * FROM apis LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *APIClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Api, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Api
+	for rows.Next() {
+		values, err := (*Api).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Api{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Builder entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Builder.QueryRaw(ctx, "SELECT * FROM builders LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *BuilderClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Builder, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Builder
+	for rows.Next() {
+		values, err := (*Builder).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Builder{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Card entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Card.QueryRaw(ctx, "SELECT * FROM cards LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *CardClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Card, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Card
+	for rows.Next() {
+		values, err := (*Card).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Card{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Comment entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Comment.QueryRaw(ctx, "SELECT * FROM comments LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *CommentClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Comment, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Comment
+	for rows.Next() {
+		values, err := (*Comment).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Comment{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into ExValueScan entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.ExValueScan.QueryRaw(ctx, "SELECT * FROM ex_value_scans LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *ExValueScanClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*ExValueScan, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*ExValueScan
+	for rows.Next() {
+		values, err := (*ExValueScan).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &ExValueScan{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into FieldType entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.FieldType.QueryRaw(ctx, "SELECT * FROM field_types LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *FieldTypeClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*FieldType, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*FieldType
+	for rows.Next() {
+		values, err := (*FieldType).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &FieldType{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into File entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.File.QueryRaw(ctx, "SELECT * FROM files LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *FileClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*File, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*File
+	for rows.Next() {
+		values, err := (*File).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &File{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into FileType entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.FileType.QueryRaw(ctx, "SELECT * FROM file_types LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *FileTypeClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*FileType, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*FileType
+	for rows.Next() {
+		values, err := (*FileType).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &FileType{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Goods entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Goods.QueryRaw(ctx, "SELECT * FROM goods LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *GoodsClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Goods, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Goods
+	for rows.Next() {
+		values, err := (*Goods).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Goods{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Group entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Group.QueryRaw(ctx, "SELECT * FROM groups LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *GroupClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Group, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Group
+	for rows.Next() {
+		values, err := (*Group).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Group{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into GroupInfo entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.GroupInfo.QueryRaw(ctx, "SELECT * FROM group_infos LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *GroupInfoClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*GroupInfo, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*GroupInfo
+	for rows.Next() {
+		values, err := (*GroupInfo).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &GroupInfo{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Item entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Item.QueryRaw(ctx, "SELECT * FROM items LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *ItemClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Item, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Item
+	for rows.Next() {
+		values, err := (*Item).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Item{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into License entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.License.QueryRaw(ctx, "SELECT * FROM licenses LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *LicenseClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*License, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*License
+	for rows.Next() {
+		values, err := (*License).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &License{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Node entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Node.QueryRaw(ctx, "SELECT * FROM nodes LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *NodeClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Node, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Node
+	for rows.Next() {
+		values, err := (*Node).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Node{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into PC entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.PC.QueryRaw(ctx, "SELECT * FROM pcs LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *PCClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*PC, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*PC
+	for rows.Next() {
+		values, err := (*PC).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &PC{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Pet entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Pet.QueryRaw(ctx, "SELECT * FROM pet LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *PetClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Pet, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Pet
+	for rows.Next() {
+		values, err := (*Pet).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Pet{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Spec entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Spec.QueryRaw(ctx, "SELECT * FROM specs LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *SpecClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Spec, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Spec
+	for rows.Next() {
+		values, err := (*Spec).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Spec{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into Task entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.Task.QueryRaw(ctx, "SELECT * FROM tasks LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *TaskClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*Task, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Task
+	for rows.Next() {
+		values, err := (*Task).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &Task{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
+// QueryRaw executes the given raw SQL query and scans the returned rows into User entities.
+// Columns are matched with the entity fields by their names, and unknown columns (e.g. aliased
+// expressions) can be retrieved using the Value method of the returned entities. For example:
+//
+//	nodes, err := client.User.QueryRaw(ctx, "SELECT * FROM users LIMIT 10")
+//
+// Note that hooks, interceptors and privacy policies are not applied to raw queries. The returned
+// entities are bound to the client, and therefore, their edges can be queried or loaded afterwards.
+func (c *UserClient) QueryRaw(ctx context.Context, query string, args ...any) ([]*User, error) {
+	rows := &sql.Rows{}
+	if err := c.driver.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*User
+	for rows.Next() {
+		values, err := (*User).scanValues(nil, columns)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		node := &User{config: c.config}
+		if err := node.assignValues(columns, values); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, rows.Err()
+}
+
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
@@ -394,6 +1092,21 @@ func (c *APIClient) CreateBulk(builders ...*APICreate) *APICreateBulk {
 	return &APICreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIClient) MapCreateBulk(slice any, setFunc func(*APICreate, int)) *APICreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APICreateBulk{err: fmt.Errorf("calling to APIClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APICreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APICreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Api.
 func (c *APIClient) Update() *APIUpdate {
 	mutation := newAPIMutation(c.config, OpUpdate)
@@ -412,6 +1125,17 @@ func (c *APIClient) UpdateOneID(id int) *APIUpdateOne {
 	return &APIUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *APIClient) UpdateMany(ids []int, setFunc func(*APIUpdateOne)) *APIUpdateMany {
+	builders := make([]*APIUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &APIUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Api.
 func (c *APIClient) Delete() *APIDelete {
 	mutation := newAPIMutation(c.config, OpDelete)
@@ -512,6 +1236,21 @@ func (c *BuilderClient) CreateBulk(builders ...*BuilderCreate) *BuilderCreateBul
 	return &BuilderCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BuilderClient) MapCreateBulk(slice any, setFunc func(*BuilderCreate, int)) *BuilderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BuilderCreateBulk{err: fmt.Errorf("calling to BuilderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BuilderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BuilderCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Builder.
 func (c *BuilderClient) Update() *BuilderUpdate {
 	mutation := newBuilderMutation(c.config, OpUpdate)
@@ -530,6 +1269,17 @@ func (c *BuilderClient) UpdateOneID(id int) *BuilderUpdateOne {
 	return &BuilderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *BuilderClient) UpdateMany(ids []int, setFunc func(*BuilderUpdateOne)) *BuilderUpdateMany {
+	builders := make([]*BuilderUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &BuilderUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Builder.
 func (c *BuilderClient) Delete() *BuilderDelete {
 	mutation := newBuilderMutation(c.config, OpDelete)
@@ -630,6 +1380,21 @@ func (c *CardClient) CreateBulk(builders ...*CardCreate) *CardCreateBulk {
 	return &CardCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CardClient) MapCreateBulk(slice any, setFunc func(*CardCreate, int)) *CardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CardCreateBulk{err: fmt.Errorf("calling to CardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CardCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Card.
 func (c *CardClient) Update() *CardUpdate {
 	mutation := newCardMutation(c.config, OpUpdate)
@@ -648,6 +1413,17 @@ func (c *CardClient) UpdateOneID(id int) *CardUpdateOne {
 	return &CardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *CardClient) UpdateMany(ids []int, setFunc func(*CardUpdateOne)) *CardUpdateMany {
+	builders := make([]*CardUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &CardUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Card.
 func (c *CardClient) Delete() *CardDelete {
 	mutation := newCardMutation(c.config, OpDelete)
@@ -780,6 +1556,21 @@ func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBul
 	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Comment.
 func (c *CommentClient) Update() *CommentUpdate {
 	mutation := newCommentMutation(c.config, OpUpdate)
@@ -798,6 +1589,17 @@ func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
 	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *CommentClient) UpdateMany(ids []int, setFunc func(*CommentUpdateOne)) *CommentUpdateMany {
+	builders := make([]*CommentUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &CommentUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Comment.
 func (c *CommentClient) Delete() *CommentDelete {
 	mutation := newCommentMutation(c.config, OpDelete)
@@ -898,6 +1700,21 @@ func (c *ExValueScanClient) CreateBulk(builders ...*ExValueScanCreate) *ExValueS
 	return &ExValueScanCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExValueScanClient) MapCreateBulk(slice any, setFunc func(*ExValueScanCreate, int)) *ExValueScanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExValueScanCreateBulk{err: fmt.Errorf("calling to ExValueScanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExValueScanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExValueScanCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for ExValueScan.
 func (c *ExValueScanClient) Update() *ExValueScanUpdate {
 	mutation := newExValueScanMutation(c.config, OpUpdate)
@@ -916,6 +1733,17 @@ func (c *ExValueScanClient) UpdateOneID(id int) *ExValueScanUpdateOne {
 	return &ExValueScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *ExValueScanClient) UpdateMany(ids []int, setFunc func(*ExValueScanUpdateOne)) *ExValueScanUpdateMany {
+	builders := make([]*ExValueScanUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &ExValueScanUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for ExValueScan.
 func (c *ExValueScanClient) Delete() *ExValueScanDelete {
 	mutation := newExValueScanMutation(c.config, OpDelete)
@@ -1016,6 +1844,21 @@ func (c *FieldTypeClient) CreateBulk(builders ...*FieldTypeCreate) *FieldTypeCre
 	return &FieldTypeCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FieldTypeClient) MapCreateBulk(slice any, setFunc func(*FieldTypeCreate, int)) *FieldTypeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FieldTypeCreateBulk{err: fmt.Errorf("calling to FieldTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FieldTypeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FieldTypeCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for FieldType.
 func (c *FieldTypeClient) Update() *FieldTypeUpdate {
 	mutation := newFieldTypeMutation(c.config, OpUpdate)
@@ -1034,6 +1877,17 @@ func (c *FieldTypeClient) UpdateOneID(id int) *FieldTypeUpdateOne {
 	return &FieldTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *FieldTypeClient) UpdateMany(ids []int, setFunc func(*FieldTypeUpdateOne)) *FieldTypeUpdateMany {
+	builders := make([]*FieldTypeUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &FieldTypeUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for FieldType.
 func (c *FieldTypeClient) Delete() *FieldTypeDelete {
 	mutation := newFieldTypeMutation(c.config, OpDelete)
@@ -1134,6 +1988,21 @@ func (c *FileClient) CreateBulk(builders ...*FileCreate) *FileCreateBulk {
 	return &FileCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileClient) MapCreateBulk(slice any, setFunc func(*FileCreate, int)) *FileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileCreateBulk{err: fmt.Errorf("calling to FileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for File.
 func (c *FileClient) Update() *FileUpdate {
 	mutation := newFileMutation(c.config, OpUpdate)
@@ -1152,6 +2021,17 @@ func (c *FileClient) UpdateOneID(id int) *FileUpdateOne {
 	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *FileClient) UpdateMany(ids []int, setFunc func(*FileUpdateOne)) *FileUpdateMany {
+	builders := make([]*FileUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &FileUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for File.
 func (c *FileClient) Delete() *FileDelete {
 	mutation := newFileMutation(c.config, OpDelete)
@@ -1300,6 +2180,21 @@ func (c *FileTypeClient) CreateBulk(builders ...*FileTypeCreate) *FileTypeCreate
 	return &FileTypeCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileTypeClient) MapCreateBulk(slice any, setFunc func(*FileTypeCreate, int)) *FileTypeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileTypeCreateBulk{err: fmt.Errorf("calling to FileTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileTypeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileTypeCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for FileType.
 func (c *FileTypeClient) Update() *FileTypeUpdate {
 	mutation := newFileTypeMutation(c.config, OpUpdate)
@@ -1318,6 +2213,17 @@ func (c *FileTypeClient) UpdateOneID(id int) *FileTypeUpdateOne {
 	return &FileTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *FileTypeClient) UpdateMany(ids []int, setFunc func(*FileTypeUpdateOne)) *FileTypeUpdateMany {
+	builders := make([]*FileTypeUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &FileTypeUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for FileType.
 func (c *FileTypeClient) Delete() *FileTypeDelete {
 	mutation := newFileTypeMutation(c.config, OpDelete)
@@ -1434,6 +2340,21 @@ func (c *GoodsClient) CreateBulk(builders ...*GoodsCreate) *GoodsCreateBulk {
 	return &GoodsCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoodsClient) MapCreateBulk(slice any, setFunc func(*GoodsCreate, int)) *GoodsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoodsCreateBulk{err: fmt.Errorf("calling to GoodsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoodsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoodsCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Goods.
 func (c *GoodsClient) Update() *GoodsUpdate {
 	mutation := newGoodsMutation(c.config, OpUpdate)
@@ -1452,6 +2373,17 @@ func (c *GoodsClient) UpdateOneID(id int) *GoodsUpdateOne {
 	return &GoodsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *GoodsClient) UpdateMany(ids []int, setFunc func(*GoodsUpdateOne)) *GoodsUpdateMany {
+	builders := make([]*GoodsUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &GoodsUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Goods.
 func (c *GoodsClient) Delete() *GoodsDelete {
 	mutation := newGoodsMutation(c.config, OpDelete)
@@ -1552,6 +2484,21 @@ func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
 	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Group.
 func (c *GroupClient) Update() *GroupUpdate {
 	mutation := newGroupMutation(c.config, OpUpdate)
@@ -1570,6 +2517,17 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *GroupClient) UpdateMany(ids []int, setFunc func(*GroupUpdateOne)) *GroupUpdateMany {
+	builders := make([]*GroupUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &GroupUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -1734,6 +2692,21 @@ func (c *GroupInfoClient) CreateBulk(builders ...*GroupInfoCreate) *GroupInfoCre
 	return &GroupInfoCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupInfoClient) MapCreateBulk(slice any, setFunc func(*GroupInfoCreate, int)) *GroupInfoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupInfoCreateBulk{err: fmt.Errorf("calling to GroupInfoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupInfoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupInfoCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for GroupInfo.
 func (c *GroupInfoClient) Update() *GroupInfoUpdate {
 	mutation := newGroupInfoMutation(c.config, OpUpdate)
@@ -1752,6 +2725,17 @@ func (c *GroupInfoClient) UpdateOneID(id int) *GroupInfoUpdateOne {
 	return &GroupInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *GroupInfoClient) UpdateMany(ids []int, setFunc func(*GroupInfoUpdateOne)) *GroupInfoUpdateMany {
+	builders := make([]*GroupInfoUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &GroupInfoUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for GroupInfo.
 func (c *GroupInfoClient) Delete() *GroupInfoDelete {
 	mutation := newGroupInfoMutation(c.config, OpDelete)
@@ -1868,6 +2852,21 @@ func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
 	return &ItemCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Item.
 func (c *ItemClient) Update() *ItemUpdate {
 	mutation := newItemMutation(c.config, OpUpdate)
@@ -1886,6 +2885,17 @@ func (c *ItemClient) UpdateOneID(id string) *ItemUpdateOne {
 	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *ItemClient) UpdateMany(ids []string, setFunc func(*ItemUpdateOne)) *ItemUpdateMany {
+	builders := make([]*ItemUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &ItemUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Item.
 func (c *ItemClient) Delete() *ItemDelete {
 	mutation := newItemMutation(c.config, OpDelete)
@@ -1986,6 +2996,21 @@ func (c *LicenseClient) CreateBulk(builders ...*LicenseCreate) *LicenseCreateBul
 	return &LicenseCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LicenseClient) MapCreateBulk(slice any, setFunc func(*LicenseCreate, int)) *LicenseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LicenseCreateBulk{err: fmt.Errorf("calling to LicenseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LicenseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LicenseCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for License.
 func (c *LicenseClient) Update() *LicenseUpdate {
 	mutation := newLicenseMutation(c.config, OpUpdate)
@@ -2004,6 +3029,17 @@ func (c *LicenseClient) UpdateOneID(id int) *LicenseUpdateOne {
 	return &LicenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *LicenseClient) UpdateMany(ids []int, setFunc func(*LicenseUpdateOne)) *LicenseUpdateMany {
+	builders := make([]*LicenseUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &LicenseUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for License.
 func (c *LicenseClient) Delete() *LicenseDelete {
 	mutation := newLicenseMutation(c.config, OpDelete)
@@ -2104,6 +3140,21 @@ func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
 	return &NodeCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Node.
 func (c *NodeClient) Update() *NodeUpdate {
 	mutation := newNodeMutation(c.config, OpUpdate)
@@ -2122,6 +3173,17 @@ func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
 	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *NodeClient) UpdateMany(ids []int, setFunc func(*NodeUpdateOne)) *NodeUpdateMany {
+	builders := make([]*NodeUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &NodeUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Node.
 func (c *NodeClient) Delete() *NodeDelete {
 	mutation := newNodeMutation(c.config, OpDelete)
@@ -2254,6 +3316,21 @@ func (c *PCClient) CreateBulk(builders ...*PCCreate) *PCCreateBulk {
 	return &PCCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PCClient) MapCreateBulk(slice any, setFunc func(*PCCreate, int)) *PCCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PCCreateBulk{err: fmt.Errorf("calling to PCClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PCCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PCCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for PC.
 func (c *PCClient) Update() *PCUpdate {
 	mutation := newPCMutation(c.config, OpUpdate)
@@ -2272,6 +3349,17 @@ func (c *PCClient) UpdateOneID(id int) *PCUpdateOne {
 	return &PCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *PCClient) UpdateMany(ids []int, setFunc func(*PCUpdateOne)) *PCUpdateMany {
+	builders := make([]*PCUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &PCUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for PC.
 func (c *PCClient) Delete() *PCDelete {
 	mutation := newPCMutation(c.config, OpDelete)
@@ -2372,6 +3460,21 @@ func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
 	return &PetCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PetClient) MapCreateBulk(slice any, setFunc func(*PetCreate, int)) *PetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PetCreateBulk{err: fmt.Errorf("calling to PetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Pet.
 func (c *PetClient) Update() *PetUpdate {
 	mutation := newPetMutation(c.config, OpUpdate)
@@ -2390,6 +3493,17 @@ func (c *PetClient) UpdateOneID(id int) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *PetClient) UpdateMany(ids []int, setFunc func(*PetUpdateOne)) *PetUpdateMany {
+	builders := make([]*PetUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &PetUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -2522,6 +3636,21 @@ func (c *SpecClient) CreateBulk(builders ...*SpecCreate) *SpecCreateBulk {
 	return &SpecCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpecClient) MapCreateBulk(slice any, setFunc func(*SpecCreate, int)) *SpecCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpecCreateBulk{err: fmt.Errorf("calling to SpecClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpecCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpecCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Spec.
 func (c *SpecClient) Update() *SpecUpdate {
 	mutation := newSpecMutation(c.config, OpUpdate)
@@ -2540,6 +3669,17 @@ func (c *SpecClient) UpdateOneID(id int) *SpecUpdateOne {
 	return &SpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *SpecClient) UpdateMany(ids []int, setFunc func(*SpecUpdateOne)) *SpecUpdateMany {
+	builders := make([]*SpecUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &SpecUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Spec.
 func (c *SpecClient) Delete() *SpecDelete {
 	mutation := newSpecMutation(c.config, OpDelete)
@@ -2656,6 +3796,21 @@ func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
 	return &TaskCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for Task.
 func (c *TaskClient) Update() *TaskUpdate {
 	mutation := newTaskMutation(c.config, OpUpdate)
@@ -2674,6 +3829,17 @@ func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
 	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *TaskClient) UpdateMany(ids []int, setFunc func(*TaskUpdateOne)) *TaskUpdateMany {
+	builders := make([]*TaskUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &TaskUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for Task.
 func (c *TaskClient) Delete() *TaskDelete {
 	mutation := newTaskMutation(c.config, OpDelete)
@@ -2774,6 +3940,21 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -2792,6 +3973,17 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateMany returns a builder for updating the entities with the given ids. The setFunc is called
+// with the update builder of each entity, and updates that apply the same changes are batched.
+func (c *UserClient) UpdateMany(ids []int, setFunc func(*UserUpdateOne)) *UserUpdateMany {
+	builders := make([]*UserUpdateOne, len(ids))
+	for i := range ids {
+		builders[i] = c.UpdateOneID(ids[i])
+		setFunc(builders[i])
+	}
+	return &UserUpdateMany{config: c.config, builders: builders}
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
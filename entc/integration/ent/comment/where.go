@@ -8,6 +8,7 @@ package comment
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/predicate"
 )
 
@@ -281,6 +282,11 @@ func TableContainsFold(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldContainsFold(FieldTable, v))
 }
 
+// TableHasPrefixFold applies the HasPrefixFold predicate on the "table" field.
+func TableHasPrefixFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefixFold(FieldTable, v))
+}
+
 // DirIsNil applies the IsNil predicate on the "dir" field.
 func DirIsNil() predicate.Comment {
 	return predicate.Comment(sql.FieldIsNull(FieldDir))
@@ -366,6 +372,35 @@ func ClientContainsFold(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldContainsFold(FieldClient, v))
 }
 
+// ClientHasPrefixFold applies the HasPrefixFold predicate on the "client" field.
+func ClientHasPrefixFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefixFold(FieldClient, v))
+}
+
+// DirHasKey applies the HasKey predicate on the "dir" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func DirHasKey(path ...string) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldDir), sqljson.Path(path...)))
+	})
+}
+
+// DirValueEQ checks that the value at the given path of the
+// "dir" JSON field is equal to v.
+func DirValueEQ(v any, path ...string) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldDir), v, sqljson.Path(path...)))
+	})
+}
+
+// DirContains checks that the value at the given path of the "dir"
+// JSON field contains v. e.g. an array that contains the element v.
+func DirContains(v any, path ...string) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldDir), v, sqljson.Path(path...)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Comment) predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
@@ -128,13 +128,14 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CommentCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.UniqueInt(); !ok {
-		return &ValidationError{Name: "unique_int", err: errors.New(`ent: missing required field "Comment.unique_int"`)}
+		errs = append(errs, &ValidationError{Name: "unique_int", Entity: "Comment", Field: "unique_int", Reason: "missing required field", err: errors.New(`ent: missing required field "Comment.unique_int"`)})
 	}
 	if _, ok := cc.mutation.UniqueFloat(); !ok {
-		return &ValidationError{Name: "unique_float", err: errors.New(`ent: missing required field "Comment.unique_float"`)}
+		errs = append(errs, &ValidationError{Name: "unique_float", Entity: "Comment", Field: "unique_float", Reason: "missing required field", err: errors.New(`ent: missing required field "Comment.unique_float"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
@@ -144,7 +145,7 @@ func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -249,6 +250,12 @@ func (u *CommentUpsert) UpdateUniqueInt() *CommentUpsert {
 	return u
 }
 
+// IgnoreUniqueInt keeps the current value of the "unique_int" field in case of conflict.
+func (u *CommentUpsert) IgnoreUniqueInt() *CommentUpsert {
+	u.SetIgnore(comment.FieldUniqueInt)
+	return u
+}
+
 // AddUniqueInt adds v to the "unique_int" field.
 func (u *CommentUpsert) AddUniqueInt(v int) *CommentUpsert {
 	u.Add(comment.FieldUniqueInt, v)
@@ -267,6 +274,12 @@ func (u *CommentUpsert) UpdateUniqueFloat() *CommentUpsert {
 	return u
 }
 
+// IgnoreUniqueFloat keeps the current value of the "unique_float" field in case of conflict.
+func (u *CommentUpsert) IgnoreUniqueFloat() *CommentUpsert {
+	u.SetIgnore(comment.FieldUniqueFloat)
+	return u
+}
+
 // AddUniqueFloat adds v to the "unique_float" field.
 func (u *CommentUpsert) AddUniqueFloat(v float64) *CommentUpsert {
 	u.Add(comment.FieldUniqueFloat, v)
@@ -285,6 +298,12 @@ func (u *CommentUpsert) UpdateNillableInt() *CommentUpsert {
 	return u
 }
 
+// IgnoreNillableInt keeps the current value of the "nillable_int" field in case of conflict.
+func (u *CommentUpsert) IgnoreNillableInt() *CommentUpsert {
+	u.SetIgnore(comment.FieldNillableInt)
+	return u
+}
+
 // AddNillableInt adds v to the "nillable_int" field.
 func (u *CommentUpsert) AddNillableInt(v int) *CommentUpsert {
 	u.Add(comment.FieldNillableInt, v)
@@ -309,6 +328,12 @@ func (u *CommentUpsert) UpdateTable() *CommentUpsert {
 	return u
 }
 
+// IgnoreTable keeps the current value of the "table" field in case of conflict.
+func (u *CommentUpsert) IgnoreTable() *CommentUpsert {
+	u.SetIgnore(comment.FieldTable)
+	return u
+}
+
 // ClearTable clears the value of the "table" field.
 func (u *CommentUpsert) ClearTable() *CommentUpsert {
 	u.SetNull(comment.FieldTable)
@@ -327,6 +352,12 @@ func (u *CommentUpsert) UpdateDir() *CommentUpsert {
 	return u
 }
 
+// IgnoreDir keeps the current value of the "dir" field in case of conflict.
+func (u *CommentUpsert) IgnoreDir() *CommentUpsert {
+	u.SetIgnore(comment.FieldDir)
+	return u
+}
+
 // ClearDir clears the value of the "dir" field.
 func (u *CommentUpsert) ClearDir() *CommentUpsert {
 	u.SetNull(comment.FieldDir)
@@ -345,6 +376,12 @@ func (u *CommentUpsert) UpdateClient() *CommentUpsert {
 	return u
 }
 
+// IgnoreClient keeps the current value of the "client" field in case of conflict.
+func (u *CommentUpsert) IgnoreClient() *CommentUpsert {
+	u.SetIgnore(comment.FieldClient)
+	return u
+}
+
 // ClearClient clears the value of the "client" field.
 func (u *CommentUpsert) ClearClient() *CommentUpsert {
 	u.SetNull(comment.FieldClient)
@@ -412,6 +449,14 @@ func (u *CommentUpsertOne) UpdateUniqueInt() *CommentUpsertOne {
 	})
 }
 
+// IgnoreUniqueInt keeps the current value of the "unique_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertOne) IgnoreUniqueInt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreUniqueInt()
+	})
+}
+
 // SetUniqueFloat sets the "unique_float" field.
 func (u *CommentUpsertOne) SetUniqueFloat(v float64) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -433,6 +478,14 @@ func (u *CommentUpsertOne) UpdateUniqueFloat() *CommentUpsertOne {
 	})
 }
 
+// IgnoreUniqueFloat keeps the current value of the "unique_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertOne) IgnoreUniqueFloat() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreUniqueFloat()
+	})
+}
+
 // SetNillableInt sets the "nillable_int" field.
 func (u *CommentUpsertOne) SetNillableInt(v int) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -454,6 +507,14 @@ func (u *CommentUpsertOne) UpdateNillableInt() *CommentUpsertOne {
 	})
 }
 
+// IgnoreNillableInt keeps the current value of the "nillable_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertOne) IgnoreNillableInt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreNillableInt()
+	})
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (u *CommentUpsertOne) ClearNillableInt() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -475,6 +536,14 @@ func (u *CommentUpsertOne) UpdateTable() *CommentUpsertOne {
 	})
 }
 
+// IgnoreTable keeps the current value of the "table" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertOne) IgnoreTable() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreTable()
+	})
+}
+
 // ClearTable clears the value of the "table" field.
 func (u *CommentUpsertOne) ClearTable() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -496,6 +565,14 @@ func (u *CommentUpsertOne) UpdateDir() *CommentUpsertOne {
 	})
 }
 
+// IgnoreDir keeps the current value of the "dir" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertOne) IgnoreDir() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreDir()
+	})
+}
+
 // ClearDir clears the value of the "dir" field.
 func (u *CommentUpsertOne) ClearDir() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -517,6 +594,14 @@ func (u *CommentUpsertOne) UpdateClient() *CommentUpsertOne {
 	})
 }
 
+// IgnoreClient keeps the current value of the "client" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertOne) IgnoreClient() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreClient()
+	})
+}
+
 // ClearClient clears the value of the "client" field.
 func (u *CommentUpsertOne) ClearClient() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -560,12 +645,16 @@ func (u *CommentUpsertOne) IDX(ctx context.Context) int {
 // CommentCreateBulk is the builder for creating many Comment entities in bulk.
 type CommentCreateBulk struct {
 	config
+	err      error
 	builders []*CommentCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Comment entities in the database.
 func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Comment, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -591,7 +680,7 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -744,6 +833,14 @@ func (u *CommentUpsertBulk) UpdateUniqueInt() *CommentUpsertBulk {
 	})
 }
 
+// IgnoreUniqueInt keeps the current value of the "unique_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertBulk) IgnoreUniqueInt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreUniqueInt()
+	})
+}
+
 // SetUniqueFloat sets the "unique_float" field.
 func (u *CommentUpsertBulk) SetUniqueFloat(v float64) *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -765,6 +862,14 @@ func (u *CommentUpsertBulk) UpdateUniqueFloat() *CommentUpsertBulk {
 	})
 }
 
+// IgnoreUniqueFloat keeps the current value of the "unique_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertBulk) IgnoreUniqueFloat() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreUniqueFloat()
+	})
+}
+
 // SetNillableInt sets the "nillable_int" field.
 func (u *CommentUpsertBulk) SetNillableInt(v int) *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -786,6 +891,14 @@ func (u *CommentUpsertBulk) UpdateNillableInt() *CommentUpsertBulk {
 	})
 }
 
+// IgnoreNillableInt keeps the current value of the "nillable_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertBulk) IgnoreNillableInt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreNillableInt()
+	})
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (u *CommentUpsertBulk) ClearNillableInt() *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -807,6 +920,14 @@ func (u *CommentUpsertBulk) UpdateTable() *CommentUpsertBulk {
 	})
 }
 
+// IgnoreTable keeps the current value of the "table" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertBulk) IgnoreTable() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreTable()
+	})
+}
+
 // ClearTable clears the value of the "table" field.
 func (u *CommentUpsertBulk) ClearTable() *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -828,6 +949,14 @@ func (u *CommentUpsertBulk) UpdateDir() *CommentUpsertBulk {
 	})
 }
 
+// IgnoreDir keeps the current value of the "dir" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertBulk) IgnoreDir() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreDir()
+	})
+}
+
 // ClearDir clears the value of the "dir" field.
 func (u *CommentUpsertBulk) ClearDir() *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -849,6 +978,14 @@ func (u *CommentUpsertBulk) UpdateClient() *CommentUpsertBulk {
 	})
 }
 
+// IgnoreClient keeps the current value of the "client" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *CommentUpsertBulk) IgnoreClient() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.IgnoreClient()
+	})
+}
+
 // ClearClient clears the value of the "client" field.
 func (u *CommentUpsertBulk) ClearClient() *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -19,8 +19,9 @@ import (
 // CommentDelete is the builder for deleting a Comment entity.
 type CommentDelete struct {
 	config
-	hooks    []Hook
-	mutation *CommentMutation
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the CommentDelete builder.
@@ -43,8 +44,21 @@ func (cd *CommentDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (cd *CommentDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *CommentDelete {
+	cd.modifiers = append(cd.modifiers, modifiers...)
+	return cd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (cdo *CommentDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *CommentDeleteOne {
+	cdo.cd.Modify(modifiers...)
+	return cdo
+}
+
 func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(comment.Table, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
+	_spec.AddModifiers(cd.modifiers...)
 	if ps := cd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	cd.mutation.done = true
 	return affected, err
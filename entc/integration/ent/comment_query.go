@@ -322,7 +322,7 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range cq.ctx.Fields {
 		if !comment.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Comment", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if cq.path != nil {
@@ -482,10 +482,53 @@ func (cq *CommentQuery) Modify(modifiers ...func(s *sql.Selector)) *CommentSelec
 	return cq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (cq *CommentQuery) Union(queries ...*CommentQuery) *CommentQuery {
+	return cq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (cq *CommentQuery) UnionAll(queries ...*CommentQuery) *CommentQuery {
+	return cq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (cq *CommentQuery) Intersect(queries ...*CommentQuery) *CommentQuery {
+	return cq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (cq *CommentQuery) Except(queries ...*CommentQuery) *CommentQuery {
+	return cq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (cq *CommentQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*CommentQuery) *CommentQuery {
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return cq
+}
+
 // CommentGroupBy is the group-by builder for Comment entities.
 type CommentGroupBy struct {
 	selector
-	build *CommentQuery
+	build  *CommentQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,6 +546,14 @@ func (cgb *CommentGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*CommentQuery, *CommentGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (cgb *CommentGroupBy) Having(ps ...*sql.Predicate) *CommentGroupBy {
+	cgb.having = append(cgb.having, ps...)
+	return cgb
+}
+
 func (cgb *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(cgb.fns))
@@ -518,6 +569,9 @@ func (cgb *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if len(cgb.having) > 0 {
+		selector.Having(sql.And(cgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
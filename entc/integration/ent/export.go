@@ -0,0 +1,6260 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"entgo.io/ent/entc/integration/ent/api"
+	"entgo.io/ent/entc/integration/ent/builder"
+	"entgo.io/ent/entc/integration/ent/card"
+	"entgo.io/ent/entc/integration/ent/comment"
+	"entgo.io/ent/entc/integration/ent/exvaluescan"
+	"entgo.io/ent/entc/integration/ent/fieldtype"
+	"entgo.io/ent/entc/integration/ent/file"
+	"entgo.io/ent/entc/integration/ent/filetype"
+	"entgo.io/ent/entc/integration/ent/goods"
+	"entgo.io/ent/entc/integration/ent/group"
+	"entgo.io/ent/entc/integration/ent/groupinfo"
+	"entgo.io/ent/entc/integration/ent/item"
+	"entgo.io/ent/entc/integration/ent/license"
+	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/pc"
+	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/schema"
+	"entgo.io/ent/entc/integration/ent/spec"
+	enttask "entgo.io/ent/entc/integration/ent/task"
+	"entgo.io/ent/entc/integration/ent/user"
+	"github.com/google/uuid"
+)
+
+// exportBatchSize is the number of entities that are loaded
+// or created by a single statement during export and import.
+const exportBatchSize = 1000
+
+type (
+	// Anonymizer returns the anonymized value of an entity field. The field is identified by the
+	// type and the field names (e.g. "User" and "email"), and the returned value must have the
+	// same type as the given one.
+	Anonymizer func(typ, field string, v any) any
+
+	// ExportOption configures the export and cloning of entities.
+	ExportOption func(*exportOptions)
+
+	exportOptions struct {
+		anonymizer Anonymizer
+		fields     map[string]bool
+	}
+)
+
+// ExportAnonymized includes the sensitive fields in the export, and replaces their values, and the values of the
+// additional fields given in the "<Type>.<field>" format (e.g. "User.name"), using the given anonymizer. A nil
+// anonymizer defaults to DefaultAnonymizer.
+//
+//	client.User.ExportJSON(ctx, w, nil, ent.ExportAnonymized(nil, "User.name"))
+func ExportAnonymized(fn Anonymizer, fields ...string) ExportOption {
+	return func(o *exportOptions) {
+		if fn == nil {
+			fn = DefaultAnonymizer
+		}
+		o.anonymizer = fn
+		for _, f := range fields {
+			o.fields[f] = true
+		}
+	}
+}
+
+func newExportOptions(opts []ExportOption) *exportOptions {
+	o := &exportOptions{fields: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// anonymizeKey is a random key used by DefaultAnonymizer. Equal values are anonymized
+// to equal values during the lifetime of the process, but cannot be reversed.
+var anonymizeKey = func() []byte {
+	b := make([]byte, sha256.Size)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}()
+
+// DefaultAnonymizer replaces strings and bytes with a keyed hash of their value (e.g. "anon-2c6ee24b5a9e1f07"),
+// and keeps the format of email addresses (e.g. "anon-2c6ee24b5a9e1f07@example.com"). Values of other types are
+// replaced with their zero value.
+func DefaultAnonymizer(typ, field string, v any) any {
+	switch v := v.(type) {
+	case string:
+		return anonymizeString(v)
+	case []byte:
+		return []byte(anonymizeString(string(v)))
+	}
+	switch rv := reflect.ValueOf(v); {
+	case !rv.IsValid() || rv.IsZero():
+		return v
+	case rv.Kind() == reflect.Ptr:
+		p := reflect.New(rv.Elem().Type())
+		p.Elem().Set(reflect.ValueOf(DefaultAnonymizer(typ, field, rv.Elem().Interface())))
+		return p.Interface()
+	default:
+		return reflect.Zero(rv.Type()).Interface()
+	}
+}
+
+// anonymizeString returns a keyed hash of s, or an email address on the "example.com" domain if s is an email address.
+func anonymizeString(s string) string {
+	if s == "" {
+		return s
+	}
+	h := hmac.New(sha256.New, anonymizeKey)
+	h.Write([]byte(s))
+	anon := "anon-" + hex.EncodeToString(h.Sum(nil)[:8])
+	if i := strings.LastIndexByte(s, '@'); i > 0 && i < len(s)-1 {
+		anon += "@example.com"
+	}
+	return anon
+}
+
+// anonymizeValue anonymizes the value of an entity field using the anonymizer of the options.
+func anonymizeValue[T any](o *exportOptions, typ, field string, v T) (T, error) {
+	av := o.anonymizer(typ, field, v)
+	t, ok := av.(T)
+	if !ok {
+		return t, fmt.Errorf("ent: anonymizer returned %T for %s.%s, expect %T", av, typ, field, v)
+	}
+	return t, nil
+}
+
+// ExportJSON streams the Api entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *APIClient) ExportJSON(ctx context.Context, w io.Writer, q *APIQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Api) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Api entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *APIClient) ExportCSV(ctx context.Context, w io.Writer, q *APIQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{api.FieldID}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Api) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Api entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *APIClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Api, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Api entities", t)
+	}
+	var nodes, batch []*Api
+	for dec.More() {
+		_node := &Api{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Api records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *APIClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Api, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Api
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Api{}
+		for idx, v := range record {
+			switch header[idx] {
+			case api.FieldID:
+				err = csvDecode(v, &_node.ID)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Api column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Api entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *APIClient) CloneTo(ctx context.Context, dst *Client, q *APIQuery, opts ...ExportOption) ([]*Api, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Api
+	)
+	err := c.export(ctx, q, func(_node *Api) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Api.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Api.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Api entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *APIClient) exportValue(_node *Api, o *exportOptions) (_ *Api, err error) {
+	v := *_node
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *APIClient) export(ctx context.Context, q *APIQuery, fn func(*Api) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(api.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Api entities using a single bulk operation.
+func (c *APIClient) importBatch(ctx context.Context, nodes []*Api) ([]*Api, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*APICreate, len(nodes))
+	for idx := range nodes {
+		builders[idx] = c.Create()
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Builder entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *BuilderClient) ExportJSON(ctx context.Context, w io.Writer, q *BuilderQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Builder) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Builder entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *BuilderClient) ExportCSV(ctx context.Context, w io.Writer, q *BuilderQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{builder.FieldID}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Builder) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Builder entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *BuilderClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Builder, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Builder entities", t)
+	}
+	var nodes, batch []*Builder
+	for dec.More() {
+		_node := &Builder{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Builder records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *BuilderClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Builder, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Builder
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Builder{}
+		for idx, v := range record {
+			switch header[idx] {
+			case builder.FieldID:
+				err = csvDecode(v, &_node.ID)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Builder column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Builder entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *BuilderClient) CloneTo(ctx context.Context, dst *Client, q *BuilderQuery, opts ...ExportOption) ([]*Builder, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Builder
+	)
+	err := c.export(ctx, q, func(_node *Builder) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Builder.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Builder.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Builder entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *BuilderClient) exportValue(_node *Builder, o *exportOptions) (_ *Builder, err error) {
+	v := *_node
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *BuilderClient) export(ctx context.Context, q *BuilderQuery, fn func(*Builder) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(builder.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Builder entities using a single bulk operation.
+func (c *BuilderClient) importBatch(ctx context.Context, nodes []*Builder) ([]*Builder, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*BuilderCreate, len(nodes))
+	for idx := range nodes {
+		builders[idx] = c.Create()
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Card entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *CardClient) ExportJSON(ctx context.Context, w io.Writer, q *CardQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Card) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Card entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *CardClient) ExportCSV(ctx context.Context, w io.Writer, q *CardQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{card.FieldID, card.FieldCreateTime, card.FieldUpdateTime, card.FieldBalance, card.FieldNumber, card.FieldName}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Card) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.CreateTime); err != nil {
+			return fmt.Errorf("ent: encoding Card.CreateTime: %w", err)
+		}
+		if record[2], err = csvEncode(_node.UpdateTime); err != nil {
+			return fmt.Errorf("ent: encoding Card.UpdateTime: %w", err)
+		}
+		if record[3], err = csvEncode(_node.Balance); err != nil {
+			return fmt.Errorf("ent: encoding Card.Balance: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Number); err != nil {
+			return fmt.Errorf("ent: encoding Card.Number: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding Card.Name: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Card entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *CardClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Card, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Card entities", t)
+	}
+	var nodes, batch []*Card
+	for dec.More() {
+		_node := &Card{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Card records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *CardClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Card, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Card
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Card{}
+		for idx, v := range record {
+			switch header[idx] {
+			case card.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case card.FieldCreateTime:
+				err = csvDecode(v, &_node.CreateTime)
+			case card.FieldUpdateTime:
+				err = csvDecode(v, &_node.UpdateTime)
+			case card.FieldBalance:
+				err = csvDecode(v, &_node.Balance)
+			case card.FieldNumber:
+				err = csvDecode(v, &_node.Number)
+			case card.FieldName:
+				err = csvDecode(v, &_node.Name)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Card column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Card entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *CardClient) CloneTo(ctx context.Context, dst *Client, q *CardQuery, opts ...ExportOption) ([]*Card, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Card
+	)
+	err := c.export(ctx, q, func(_node *Card) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Card.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Card.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Card entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *CardClient) exportValue(_node *Card, o *exportOptions) (_ *Card, err error) {
+	v := *_node
+	if o.fields["Card.create_time"] {
+		if v.CreateTime, err = anonymizeValue(o, "Card", card.FieldCreateTime, v.CreateTime); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Card.update_time"] {
+		if v.UpdateTime, err = anonymizeValue(o, "Card", card.FieldUpdateTime, v.UpdateTime); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Card.balance"] {
+		if v.Balance, err = anonymizeValue(o, "Card", card.FieldBalance, v.Balance); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Card.number"] {
+		if v.Number, err = anonymizeValue(o, "Card", card.FieldNumber, v.Number); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Card.name"] {
+		if v.Name, err = anonymizeValue(o, "Card", card.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *CardClient) export(ctx context.Context, q *CardQuery, fn func(*Card) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(card.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Card entities using a single bulk operation.
+func (c *CardClient) importBatch(ctx context.Context, nodes []*Card) ([]*Card, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*CardCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetCreateTime(_node.CreateTime)
+		builders[idx].SetUpdateTime(_node.UpdateTime)
+		builders[idx].SetBalance(_node.Balance)
+		builders[idx].SetNumber(_node.Number)
+		if !exportIsZero(_node.Name) {
+			builders[idx].SetName(_node.Name)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Comment entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *CommentClient) ExportJSON(ctx context.Context, w io.Writer, q *CommentQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Comment) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Comment entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *CommentClient) ExportCSV(ctx context.Context, w io.Writer, q *CommentQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{comment.FieldID, comment.FieldUniqueInt, comment.FieldUniqueFloat, comment.FieldNillableInt, comment.FieldTable, comment.FieldDir, comment.FieldClient}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Comment) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.UniqueInt); err != nil {
+			return fmt.Errorf("ent: encoding Comment.UniqueInt: %w", err)
+		}
+		if record[2], err = csvEncode(_node.UniqueFloat); err != nil {
+			return fmt.Errorf("ent: encoding Comment.UniqueFloat: %w", err)
+		}
+		if record[3], err = csvEncode(_node.NillableInt); err != nil {
+			return fmt.Errorf("ent: encoding Comment.NillableInt: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Table); err != nil {
+			return fmt.Errorf("ent: encoding Comment.Table: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Dir); err != nil {
+			return fmt.Errorf("ent: encoding Comment.Dir: %w", err)
+		}
+		if record[6], err = csvEncode(_node.Client); err != nil {
+			return fmt.Errorf("ent: encoding Comment.Client: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Comment entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *CommentClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Comment, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Comment entities", t)
+	}
+	var nodes, batch []*Comment
+	for dec.More() {
+		_node := &Comment{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Comment records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *CommentClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Comment, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Comment
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Comment{}
+		for idx, v := range record {
+			switch header[idx] {
+			case comment.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case comment.FieldUniqueInt:
+				err = csvDecode(v, &_node.UniqueInt)
+			case comment.FieldUniqueFloat:
+				err = csvDecode(v, &_node.UniqueFloat)
+			case comment.FieldNillableInt:
+				if v != "" {
+					_node.NillableInt = new(int)
+					err = csvDecode(v, _node.NillableInt)
+				}
+			case comment.FieldTable:
+				err = csvDecode(v, &_node.Table)
+			case comment.FieldDir:
+				err = csvDecode(v, &_node.Dir)
+			case comment.FieldClient:
+				err = csvDecode(v, &_node.Client)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Comment column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Comment entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *CommentClient) CloneTo(ctx context.Context, dst *Client, q *CommentQuery, opts ...ExportOption) ([]*Comment, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Comment
+	)
+	err := c.export(ctx, q, func(_node *Comment) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Comment.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Comment.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Comment entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *CommentClient) exportValue(_node *Comment, o *exportOptions) (_ *Comment, err error) {
+	v := *_node
+	if o.fields["Comment.unique_int"] {
+		if v.UniqueInt, err = anonymizeValue(o, "Comment", comment.FieldUniqueInt, v.UniqueInt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Comment.unique_float"] {
+		if v.UniqueFloat, err = anonymizeValue(o, "Comment", comment.FieldUniqueFloat, v.UniqueFloat); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Comment.nillable_int"] {
+		if v.NillableInt, err = anonymizeValue(o, "Comment", comment.FieldNillableInt, v.NillableInt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Comment.table"] {
+		if v.Table, err = anonymizeValue(o, "Comment", comment.FieldTable, v.Table); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Comment.dir"] {
+		if v.Dir, err = anonymizeValue(o, "Comment", comment.FieldDir, v.Dir); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Comment.client"] {
+		if v.Client, err = anonymizeValue(o, "Comment", comment.FieldClient, v.Client); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *CommentClient) export(ctx context.Context, q *CommentQuery, fn func(*Comment) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(comment.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Comment entities using a single bulk operation.
+func (c *CommentClient) importBatch(ctx context.Context, nodes []*Comment) ([]*Comment, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*CommentCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetUniqueInt(_node.UniqueInt)
+		builders[idx].SetUniqueFloat(_node.UniqueFloat)
+		if _node.NillableInt != nil {
+			builders[idx].SetNillableInt(*_node.NillableInt)
+		}
+		if !exportIsZero(_node.Table) {
+			builders[idx].SetTable(_node.Table)
+		}
+		if !exportIsZero(_node.Dir) {
+			builders[idx].SetDir(_node.Dir)
+		}
+		if !exportIsZero(_node.Client) {
+			builders[idx].SetClient(_node.Client)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the ExValueScan entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *ExValueScanClient) ExportJSON(ctx context.Context, w io.Writer, q *ExValueScanQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *ExValueScan) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the ExValueScan entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *ExValueScanClient) ExportCSV(ctx context.Context, w io.Writer, q *ExValueScanQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{exvaluescan.FieldID, exvaluescan.FieldBinary, exvaluescan.FieldBinaryOptional, exvaluescan.FieldText, exvaluescan.FieldTextOptional, exvaluescan.FieldBase64, exvaluescan.FieldCustom, exvaluescan.FieldCustomOptional}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *ExValueScan) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Binary); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.Binary: %w", err)
+		}
+		if record[2], err = csvEncode(_node.BinaryOptional); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.BinaryOptional: %w", err)
+		}
+		if record[3], err = csvEncode(_node.Text); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.Text: %w", err)
+		}
+		if record[4], err = csvEncode(_node.TextOptional); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.TextOptional: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Base64); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.Base64: %w", err)
+		}
+		if record[6], err = csvEncode(_node.Custom); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.Custom: %w", err)
+		}
+		if record[7], err = csvEncode(_node.CustomOptional); err != nil {
+			return fmt.Errorf("ent: encoding ExValueScan.CustomOptional: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of ExValueScan entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *ExValueScanClient) ImportJSON(ctx context.Context, r io.Reader) ([]*ExValueScan, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of ExValueScan entities", t)
+	}
+	var nodes, batch []*ExValueScan
+	for dec.More() {
+		_node := &ExValueScan{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads ExValueScan records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *ExValueScanClient) ImportCSV(ctx context.Context, r io.Reader) ([]*ExValueScan, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*ExValueScan
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &ExValueScan{}
+		for idx, v := range record {
+			switch header[idx] {
+			case exvaluescan.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case exvaluescan.FieldBinary:
+				err = csvDecode(v, &_node.Binary)
+			case exvaluescan.FieldBinaryOptional:
+				err = csvDecode(v, &_node.BinaryOptional)
+			case exvaluescan.FieldText:
+				err = csvDecode(v, &_node.Text)
+			case exvaluescan.FieldTextOptional:
+				err = csvDecode(v, &_node.TextOptional)
+			case exvaluescan.FieldBase64:
+				err = csvDecode(v, &_node.Base64)
+			case exvaluescan.FieldCustom:
+				err = csvDecode(v, &_node.Custom)
+			case exvaluescan.FieldCustomOptional:
+				err = csvDecode(v, &_node.CustomOptional)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding ExValueScan column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the ExValueScan entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *ExValueScanClient) CloneTo(ctx context.Context, dst *Client, q *ExValueScanQuery, opts ...ExportOption) ([]*ExValueScan, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*ExValueScan
+	)
+	err := c.export(ctx, q, func(_node *ExValueScan) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.ExValueScan.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.ExValueScan.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given ExValueScan entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *ExValueScanClient) exportValue(_node *ExValueScan, o *exportOptions) (_ *ExValueScan, err error) {
+	v := *_node
+	if o.fields["ExValueScan.binary"] {
+		if v.Binary, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldBinary, v.Binary); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["ExValueScan.binary_optional"] {
+		if v.BinaryOptional, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldBinaryOptional, v.BinaryOptional); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["ExValueScan.text"] {
+		if v.Text, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldText, v.Text); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["ExValueScan.text_optional"] {
+		if v.TextOptional, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldTextOptional, v.TextOptional); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["ExValueScan.base64"] {
+		if v.Base64, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldBase64, v.Base64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["ExValueScan.custom"] {
+		if v.Custom, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldCustom, v.Custom); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["ExValueScan.custom_optional"] {
+		if v.CustomOptional, err = anonymizeValue(o, "ExValueScan", exvaluescan.FieldCustomOptional, v.CustomOptional); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *ExValueScanClient) export(ctx context.Context, q *ExValueScanQuery, fn func(*ExValueScan) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(exvaluescan.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of ExValueScan entities using a single bulk operation.
+func (c *ExValueScanClient) importBatch(ctx context.Context, nodes []*ExValueScan) ([]*ExValueScan, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*ExValueScanCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		if _node.Binary != nil {
+			builders[idx].SetBinary(_node.Binary)
+		}
+		if _node.BinaryOptional != nil {
+			builders[idx].SetBinaryOptional(_node.BinaryOptional)
+		}
+		if _node.Text != nil {
+			builders[idx].SetText(_node.Text)
+		}
+		if _node.TextOptional != nil {
+			builders[idx].SetTextOptional(_node.TextOptional)
+		}
+		builders[idx].SetBase64(_node.Base64)
+		builders[idx].SetCustom(_node.Custom)
+		if !exportIsZero(_node.CustomOptional) {
+			builders[idx].SetCustomOptional(_node.CustomOptional)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the FieldType entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *FieldTypeClient) ExportJSON(ctx context.Context, w io.Writer, q *FieldTypeQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *FieldType) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		if o.anonymizer != nil {
+			return enc.Encode(fieldtypeJSON{FieldType: _node, Password: _node.Password, Sensitive: _node.Sensitive, PasswordOther: _node.PasswordOther})
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the FieldType entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *FieldTypeClient) ExportCSV(ctx context.Context, w io.Writer, q *FieldTypeQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{fieldtype.FieldID, fieldtype.FieldInt, fieldtype.FieldInt8, fieldtype.FieldInt16, fieldtype.FieldInt32, fieldtype.FieldInt64, fieldtype.FieldOptionalInt, fieldtype.FieldOptionalInt8, fieldtype.FieldOptionalInt16, fieldtype.FieldOptionalInt32, fieldtype.FieldOptionalInt64, fieldtype.FieldNillableInt, fieldtype.FieldNillableInt8, fieldtype.FieldNillableInt16, fieldtype.FieldNillableInt32, fieldtype.FieldNillableInt64, fieldtype.FieldValidateOptionalInt32, fieldtype.FieldOptionalUint, fieldtype.FieldOptionalUint8, fieldtype.FieldOptionalUint16, fieldtype.FieldOptionalUint32, fieldtype.FieldOptionalUint64, fieldtype.FieldState, fieldtype.FieldOptionalFloat, fieldtype.FieldOptionalFloat32, fieldtype.FieldText, fieldtype.FieldDatetime, fieldtype.FieldDecimal, fieldtype.FieldLinkOther, fieldtype.FieldLinkOtherFunc, fieldtype.FieldMAC, fieldtype.FieldStringArray, fieldtype.FieldStringScanner, fieldtype.FieldDuration, fieldtype.FieldDir, fieldtype.FieldNdir, fieldtype.FieldStr, fieldtype.FieldNullStr, fieldtype.FieldLink, fieldtype.FieldNullLink, fieldtype.FieldActive, fieldtype.FieldNullActive, fieldtype.FieldDeleted, fieldtype.FieldDeletedAt, fieldtype.FieldRawData, fieldtype.FieldIP, fieldtype.FieldNullInt64, fieldtype.FieldSchemaInt, fieldtype.FieldSchemaInt8, fieldtype.FieldSchemaInt64, fieldtype.FieldSchemaFloat, fieldtype.FieldSchemaFloat32, fieldtype.FieldNullFloat, fieldtype.FieldRole, fieldtype.FieldPriority, fieldtype.FieldOptionalUUID, fieldtype.FieldNillableUUID, fieldtype.FieldStrings, fieldtype.FieldPair, fieldtype.FieldNilPair, fieldtype.FieldVstring, fieldtype.FieldTriple, fieldtype.FieldBigInt}
+	)
+	if o.anonymizer != nil {
+		header = append(header, fieldtype.FieldPassword, fieldtype.FieldSensitive, fieldtype.FieldPasswordOther)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *FieldType) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Int); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Int: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Int8); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Int8: %w", err)
+		}
+		if record[3], err = csvEncode(_node.Int16); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Int16: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Int32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Int32: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Int64); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Int64: %w", err)
+		}
+		if record[6], err = csvEncode(_node.OptionalInt); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalInt: %w", err)
+		}
+		if record[7], err = csvEncode(_node.OptionalInt8); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalInt8: %w", err)
+		}
+		if record[8], err = csvEncode(_node.OptionalInt16); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalInt16: %w", err)
+		}
+		if record[9], err = csvEncode(_node.OptionalInt32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalInt32: %w", err)
+		}
+		if record[10], err = csvEncode(_node.OptionalInt64); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalInt64: %w", err)
+		}
+		if record[11], err = csvEncode(_node.NillableInt); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NillableInt: %w", err)
+		}
+		if record[12], err = csvEncode(_node.NillableInt8); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NillableInt8: %w", err)
+		}
+		if record[13], err = csvEncode(_node.NillableInt16); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NillableInt16: %w", err)
+		}
+		if record[14], err = csvEncode(_node.NillableInt32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NillableInt32: %w", err)
+		}
+		if record[15], err = csvEncode(_node.NillableInt64); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NillableInt64: %w", err)
+		}
+		if record[16], err = csvEncode(_node.ValidateOptionalInt32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.ValidateOptionalInt32: %w", err)
+		}
+		if record[17], err = csvEncode(_node.OptionalUint); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalUint: %w", err)
+		}
+		if record[18], err = csvEncode(_node.OptionalUint8); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalUint8: %w", err)
+		}
+		if record[19], err = csvEncode(_node.OptionalUint16); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalUint16: %w", err)
+		}
+		if record[20], err = csvEncode(_node.OptionalUint32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalUint32: %w", err)
+		}
+		if record[21], err = csvEncode(_node.OptionalUint64); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalUint64: %w", err)
+		}
+		if record[22], err = csvEncode(_node.State); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.State: %w", err)
+		}
+		if record[23], err = csvEncode(_node.OptionalFloat); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalFloat: %w", err)
+		}
+		if record[24], err = csvEncode(_node.OptionalFloat32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalFloat32: %w", err)
+		}
+		if record[25], err = csvEncode(_node.Text); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Text: %w", err)
+		}
+		if record[26], err = csvEncode(_node.Datetime); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Datetime: %w", err)
+		}
+		if record[27], err = csvEncode(_node.Decimal); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Decimal: %w", err)
+		}
+		if record[28], err = csvEncode(_node.LinkOther); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.LinkOther: %w", err)
+		}
+		if record[29], err = csvEncode(_node.LinkOtherFunc); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.LinkOtherFunc: %w", err)
+		}
+		if record[30], err = csvEncode(_node.MAC); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.MAC: %w", err)
+		}
+		if record[31], err = csvEncode(_node.StringArray); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.StringArray: %w", err)
+		}
+		if record[32], err = csvEncode(_node.StringScanner); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.StringScanner: %w", err)
+		}
+		if record[33], err = csvEncode(_node.Duration); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Duration: %w", err)
+		}
+		if record[34], err = csvEncode(_node.Dir); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Dir: %w", err)
+		}
+		if record[35], err = csvEncode(_node.Ndir); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Ndir: %w", err)
+		}
+		if record[36], err = csvEncode(_node.Str); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Str: %w", err)
+		}
+		if record[37], err = csvEncode(_node.NullStr); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NullStr: %w", err)
+		}
+		if record[38], err = csvEncode(_node.Link); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Link: %w", err)
+		}
+		if record[39], err = csvEncode(_node.NullLink); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NullLink: %w", err)
+		}
+		if record[40], err = csvEncode(_node.Active); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Active: %w", err)
+		}
+		if record[41], err = csvEncode(_node.NullActive); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NullActive: %w", err)
+		}
+		if record[42], err = csvEncode(_node.Deleted); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Deleted: %w", err)
+		}
+		if record[43], err = csvEncode(_node.DeletedAt); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.DeletedAt: %w", err)
+		}
+		if record[44], err = csvEncode(_node.RawData); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.RawData: %w", err)
+		}
+		if record[45], err = csvEncode(_node.IP); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.IP: %w", err)
+		}
+		if record[46], err = csvEncode(_node.NullInt64); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NullInt64: %w", err)
+		}
+		if record[47], err = csvEncode(_node.SchemaInt); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.SchemaInt: %w", err)
+		}
+		if record[48], err = csvEncode(_node.SchemaInt8); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.SchemaInt8: %w", err)
+		}
+		if record[49], err = csvEncode(_node.SchemaInt64); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.SchemaInt64: %w", err)
+		}
+		if record[50], err = csvEncode(_node.SchemaFloat); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.SchemaFloat: %w", err)
+		}
+		if record[51], err = csvEncode(_node.SchemaFloat32); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.SchemaFloat32: %w", err)
+		}
+		if record[52], err = csvEncode(_node.NullFloat); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NullFloat: %w", err)
+		}
+		if record[53], err = csvEncode(_node.Role); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Role: %w", err)
+		}
+		if record[54], err = csvEncode(_node.Priority); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Priority: %w", err)
+		}
+		if record[55], err = csvEncode(_node.OptionalUUID); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.OptionalUUID: %w", err)
+		}
+		if record[56], err = csvEncode(_node.NillableUUID); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NillableUUID: %w", err)
+		}
+		if record[57], err = csvEncode(_node.Strings); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Strings: %w", err)
+		}
+		if record[58], err = csvEncode(_node.Pair); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Pair: %w", err)
+		}
+		if record[59], err = csvEncode(_node.NilPair); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.NilPair: %w", err)
+		}
+		if record[60], err = csvEncode(_node.Vstring); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Vstring: %w", err)
+		}
+		if record[61], err = csvEncode(_node.Triple); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.Triple: %w", err)
+		}
+		if record[62], err = csvEncode(_node.BigInt); err != nil {
+			return fmt.Errorf("ent: encoding FieldType.BigInt: %w", err)
+		}
+		if o.anonymizer != nil {
+			if record[63], err = csvEncode(_node.Password); err != nil {
+				return fmt.Errorf("ent: encoding FieldType.Password: %w", err)
+			}
+			if record[64], err = csvEncode(_node.Sensitive); err != nil {
+				return fmt.Errorf("ent: encoding FieldType.Sensitive: %w", err)
+			}
+			if record[65], err = csvEncode(_node.PasswordOther); err != nil {
+				return fmt.Errorf("ent: encoding FieldType.PasswordOther: %w", err)
+			}
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of FieldType entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *FieldTypeClient) ImportJSON(ctx context.Context, r io.Reader) ([]*FieldType, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of FieldType entities", t)
+	}
+	var nodes, batch []*FieldType
+	for dec.More() {
+		_node := &FieldType{}
+		v := fieldtypeJSON{FieldType: _node}
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		_node.Password = v.Password
+		_node.Sensitive = v.Sensitive
+		_node.PasswordOther = v.PasswordOther
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads FieldType records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *FieldTypeClient) ImportCSV(ctx context.Context, r io.Reader) ([]*FieldType, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*FieldType
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &FieldType{}
+		for idx, v := range record {
+			switch header[idx] {
+			case fieldtype.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case fieldtype.FieldInt:
+				err = csvDecode(v, &_node.Int)
+			case fieldtype.FieldInt8:
+				err = csvDecode(v, &_node.Int8)
+			case fieldtype.FieldInt16:
+				err = csvDecode(v, &_node.Int16)
+			case fieldtype.FieldInt32:
+				err = csvDecode(v, &_node.Int32)
+			case fieldtype.FieldInt64:
+				err = csvDecode(v, &_node.Int64)
+			case fieldtype.FieldOptionalInt:
+				err = csvDecode(v, &_node.OptionalInt)
+			case fieldtype.FieldOptionalInt8:
+				err = csvDecode(v, &_node.OptionalInt8)
+			case fieldtype.FieldOptionalInt16:
+				err = csvDecode(v, &_node.OptionalInt16)
+			case fieldtype.FieldOptionalInt32:
+				err = csvDecode(v, &_node.OptionalInt32)
+			case fieldtype.FieldOptionalInt64:
+				err = csvDecode(v, &_node.OptionalInt64)
+			case fieldtype.FieldNillableInt:
+				if v != "" {
+					_node.NillableInt = new(int)
+					err = csvDecode(v, _node.NillableInt)
+				}
+			case fieldtype.FieldNillableInt8:
+				if v != "" {
+					_node.NillableInt8 = new(int8)
+					err = csvDecode(v, _node.NillableInt8)
+				}
+			case fieldtype.FieldNillableInt16:
+				if v != "" {
+					_node.NillableInt16 = new(int16)
+					err = csvDecode(v, _node.NillableInt16)
+				}
+			case fieldtype.FieldNillableInt32:
+				if v != "" {
+					_node.NillableInt32 = new(int32)
+					err = csvDecode(v, _node.NillableInt32)
+				}
+			case fieldtype.FieldNillableInt64:
+				if v != "" {
+					_node.NillableInt64 = new(int64)
+					err = csvDecode(v, _node.NillableInt64)
+				}
+			case fieldtype.FieldValidateOptionalInt32:
+				err = csvDecode(v, &_node.ValidateOptionalInt32)
+			case fieldtype.FieldOptionalUint:
+				err = csvDecode(v, &_node.OptionalUint)
+			case fieldtype.FieldOptionalUint8:
+				err = csvDecode(v, &_node.OptionalUint8)
+			case fieldtype.FieldOptionalUint16:
+				err = csvDecode(v, &_node.OptionalUint16)
+			case fieldtype.FieldOptionalUint32:
+				err = csvDecode(v, &_node.OptionalUint32)
+			case fieldtype.FieldOptionalUint64:
+				err = csvDecode(v, &_node.OptionalUint64)
+			case fieldtype.FieldState:
+				err = csvDecode(v, &_node.State)
+			case fieldtype.FieldOptionalFloat:
+				err = csvDecode(v, &_node.OptionalFloat)
+			case fieldtype.FieldOptionalFloat32:
+				err = csvDecode(v, &_node.OptionalFloat32)
+			case fieldtype.FieldText:
+				err = csvDecode(v, &_node.Text)
+			case fieldtype.FieldDatetime:
+				err = csvDecode(v, &_node.Datetime)
+			case fieldtype.FieldDecimal:
+				err = csvDecode(v, &_node.Decimal)
+			case fieldtype.FieldLinkOther:
+				err = csvDecode(v, &_node.LinkOther)
+			case fieldtype.FieldLinkOtherFunc:
+				err = csvDecode(v, &_node.LinkOtherFunc)
+			case fieldtype.FieldMAC:
+				err = csvDecode(v, &_node.MAC)
+			case fieldtype.FieldStringArray:
+				err = csvDecode(v, &_node.StringArray)
+			case fieldtype.FieldPassword:
+				err = csvDecode(v, &_node.Password)
+			case fieldtype.FieldStringScanner:
+				if v != "" {
+					_node.StringScanner = new(schema.StringScanner)
+					err = csvDecode(v, _node.StringScanner)
+				}
+			case fieldtype.FieldDuration:
+				err = csvDecode(v, &_node.Duration)
+			case fieldtype.FieldDir:
+				err = csvDecode(v, &_node.Dir)
+			case fieldtype.FieldNdir:
+				if v != "" {
+					_node.Ndir = new(http.Dir)
+					err = csvDecode(v, _node.Ndir)
+				}
+			case fieldtype.FieldStr:
+				err = csvDecode(v, &_node.Str)
+			case fieldtype.FieldNullStr:
+				err = csvDecode(v, &_node.NullStr)
+			case fieldtype.FieldLink:
+				err = csvDecode(v, &_node.Link)
+			case fieldtype.FieldNullLink:
+				err = csvDecode(v, &_node.NullLink)
+			case fieldtype.FieldActive:
+				err = csvDecode(v, &_node.Active)
+			case fieldtype.FieldNullActive:
+				if v != "" {
+					_node.NullActive = new(schema.Status)
+					err = csvDecode(v, _node.NullActive)
+				}
+			case fieldtype.FieldDeleted:
+				err = csvDecode(v, &_node.Deleted)
+			case fieldtype.FieldDeletedAt:
+				err = csvDecode(v, &_node.DeletedAt)
+			case fieldtype.FieldRawData:
+				err = csvDecode(v, &_node.RawData)
+			case fieldtype.FieldSensitive:
+				err = csvDecode(v, &_node.Sensitive)
+			case fieldtype.FieldIP:
+				err = csvDecode(v, &_node.IP)
+			case fieldtype.FieldNullInt64:
+				err = csvDecode(v, &_node.NullInt64)
+			case fieldtype.FieldSchemaInt:
+				err = csvDecode(v, &_node.SchemaInt)
+			case fieldtype.FieldSchemaInt8:
+				err = csvDecode(v, &_node.SchemaInt8)
+			case fieldtype.FieldSchemaInt64:
+				err = csvDecode(v, &_node.SchemaInt64)
+			case fieldtype.FieldSchemaFloat:
+				err = csvDecode(v, &_node.SchemaFloat)
+			case fieldtype.FieldSchemaFloat32:
+				err = csvDecode(v, &_node.SchemaFloat32)
+			case fieldtype.FieldNullFloat:
+				err = csvDecode(v, &_node.NullFloat)
+			case fieldtype.FieldRole:
+				err = csvDecode(v, &_node.Role)
+			case fieldtype.FieldPriority:
+				err = csvDecode(v, &_node.Priority)
+			case fieldtype.FieldOptionalUUID:
+				err = csvDecode(v, &_node.OptionalUUID)
+			case fieldtype.FieldNillableUUID:
+				if v != "" {
+					_node.NillableUUID = new(uuid.UUID)
+					err = csvDecode(v, _node.NillableUUID)
+				}
+			case fieldtype.FieldStrings:
+				err = csvDecode(v, &_node.Strings)
+			case fieldtype.FieldPair:
+				err = csvDecode(v, &_node.Pair)
+			case fieldtype.FieldNilPair:
+				err = csvDecode(v, &_node.NilPair)
+			case fieldtype.FieldVstring:
+				err = csvDecode(v, &_node.Vstring)
+			case fieldtype.FieldTriple:
+				err = csvDecode(v, &_node.Triple)
+			case fieldtype.FieldBigInt:
+				err = csvDecode(v, &_node.BigInt)
+			case fieldtype.FieldPasswordOther:
+				err = csvDecode(v, &_node.PasswordOther)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding FieldType column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the FieldType entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *FieldTypeClient) CloneTo(ctx context.Context, dst *Client, q *FieldTypeQuery, opts ...ExportOption) ([]*FieldType, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*FieldType
+	)
+	err := c.export(ctx, q, func(_node *FieldType) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.FieldType.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.FieldType.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// fieldtypeJSON is the JSON representation of anonymized FieldType entities, including the sensitive fields.
+type fieldtypeJSON struct {
+	*FieldType
+	Password      string          `json:"password,omitempty"`
+	Sensitive     []byte          `json:"sensitive,omitempty"`
+	PasswordOther schema.Password `json:"password_other,omitempty"`
+}
+
+// exportValue returns a copy of the given FieldType entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *FieldTypeClient) exportValue(_node *FieldType, o *exportOptions) (_ *FieldType, err error) {
+	v := *_node
+	if o.anonymizer == nil {
+		v.Password = *new(string)
+	} else if v.Password, err = anonymizeValue(o, "FieldType", fieldtype.FieldPassword, v.Password); err != nil {
+		return nil, err
+	}
+	if o.anonymizer == nil {
+		v.Sensitive = nil
+	} else if v.Sensitive, err = anonymizeValue(o, "FieldType", fieldtype.FieldSensitive, v.Sensitive); err != nil {
+		return nil, err
+	}
+	if o.anonymizer == nil {
+		v.PasswordOther = *new(schema.Password)
+	} else if v.PasswordOther, err = anonymizeValue(o, "FieldType", fieldtype.FieldPasswordOther, v.PasswordOther); err != nil {
+		return nil, err
+	}
+	if o.fields["FieldType.int"] {
+		if v.Int, err = anonymizeValue(o, "FieldType", fieldtype.FieldInt, v.Int); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.int8"] {
+		if v.Int8, err = anonymizeValue(o, "FieldType", fieldtype.FieldInt8, v.Int8); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.int16"] {
+		if v.Int16, err = anonymizeValue(o, "FieldType", fieldtype.FieldInt16, v.Int16); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.int32"] {
+		if v.Int32, err = anonymizeValue(o, "FieldType", fieldtype.FieldInt32, v.Int32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.int64"] {
+		if v.Int64, err = anonymizeValue(o, "FieldType", fieldtype.FieldInt64, v.Int64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_int"] {
+		if v.OptionalInt, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalInt, v.OptionalInt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_int8"] {
+		if v.OptionalInt8, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalInt8, v.OptionalInt8); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_int16"] {
+		if v.OptionalInt16, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalInt16, v.OptionalInt16); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_int32"] {
+		if v.OptionalInt32, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalInt32, v.OptionalInt32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_int64"] {
+		if v.OptionalInt64, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalInt64, v.OptionalInt64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nillable_int"] {
+		if v.NillableInt, err = anonymizeValue(o, "FieldType", fieldtype.FieldNillableInt, v.NillableInt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nillable_int8"] {
+		if v.NillableInt8, err = anonymizeValue(o, "FieldType", fieldtype.FieldNillableInt8, v.NillableInt8); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nillable_int16"] {
+		if v.NillableInt16, err = anonymizeValue(o, "FieldType", fieldtype.FieldNillableInt16, v.NillableInt16); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nillable_int32"] {
+		if v.NillableInt32, err = anonymizeValue(o, "FieldType", fieldtype.FieldNillableInt32, v.NillableInt32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nillable_int64"] {
+		if v.NillableInt64, err = anonymizeValue(o, "FieldType", fieldtype.FieldNillableInt64, v.NillableInt64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.validate_optional_int32"] {
+		if v.ValidateOptionalInt32, err = anonymizeValue(o, "FieldType", fieldtype.FieldValidateOptionalInt32, v.ValidateOptionalInt32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_uint"] {
+		if v.OptionalUint, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalUint, v.OptionalUint); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_uint8"] {
+		if v.OptionalUint8, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalUint8, v.OptionalUint8); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_uint16"] {
+		if v.OptionalUint16, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalUint16, v.OptionalUint16); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_uint32"] {
+		if v.OptionalUint32, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalUint32, v.OptionalUint32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_uint64"] {
+		if v.OptionalUint64, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalUint64, v.OptionalUint64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.state"] {
+		if v.State, err = anonymizeValue(o, "FieldType", fieldtype.FieldState, v.State); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_float"] {
+		if v.OptionalFloat, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalFloat, v.OptionalFloat); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_float32"] {
+		if v.OptionalFloat32, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalFloat32, v.OptionalFloat32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.text"] {
+		if v.Text, err = anonymizeValue(o, "FieldType", fieldtype.FieldText, v.Text); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.datetime"] {
+		if v.Datetime, err = anonymizeValue(o, "FieldType", fieldtype.FieldDatetime, v.Datetime); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.decimal"] {
+		if v.Decimal, err = anonymizeValue(o, "FieldType", fieldtype.FieldDecimal, v.Decimal); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.link_other"] {
+		if v.LinkOther, err = anonymizeValue(o, "FieldType", fieldtype.FieldLinkOther, v.LinkOther); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.link_other_func"] {
+		if v.LinkOtherFunc, err = anonymizeValue(o, "FieldType", fieldtype.FieldLinkOtherFunc, v.LinkOtherFunc); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.mac"] {
+		if v.MAC, err = anonymizeValue(o, "FieldType", fieldtype.FieldMAC, v.MAC); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.string_array"] {
+		if v.StringArray, err = anonymizeValue(o, "FieldType", fieldtype.FieldStringArray, v.StringArray); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.string_scanner"] {
+		if v.StringScanner, err = anonymizeValue(o, "FieldType", fieldtype.FieldStringScanner, v.StringScanner); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.duration"] {
+		if v.Duration, err = anonymizeValue(o, "FieldType", fieldtype.FieldDuration, v.Duration); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.dir"] {
+		if v.Dir, err = anonymizeValue(o, "FieldType", fieldtype.FieldDir, v.Dir); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.ndir"] {
+		if v.Ndir, err = anonymizeValue(o, "FieldType", fieldtype.FieldNdir, v.Ndir); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.str"] {
+		if v.Str, err = anonymizeValue(o, "FieldType", fieldtype.FieldStr, v.Str); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.null_str"] {
+		if v.NullStr, err = anonymizeValue(o, "FieldType", fieldtype.FieldNullStr, v.NullStr); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.link"] {
+		if v.Link, err = anonymizeValue(o, "FieldType", fieldtype.FieldLink, v.Link); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.null_link"] {
+		if v.NullLink, err = anonymizeValue(o, "FieldType", fieldtype.FieldNullLink, v.NullLink); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.active"] {
+		if v.Active, err = anonymizeValue(o, "FieldType", fieldtype.FieldActive, v.Active); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.null_active"] {
+		if v.NullActive, err = anonymizeValue(o, "FieldType", fieldtype.FieldNullActive, v.NullActive); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.deleted"] {
+		if v.Deleted, err = anonymizeValue(o, "FieldType", fieldtype.FieldDeleted, v.Deleted); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.deleted_at"] {
+		if v.DeletedAt, err = anonymizeValue(o, "FieldType", fieldtype.FieldDeletedAt, v.DeletedAt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.raw_data"] {
+		if v.RawData, err = anonymizeValue(o, "FieldType", fieldtype.FieldRawData, v.RawData); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.ip"] {
+		if v.IP, err = anonymizeValue(o, "FieldType", fieldtype.FieldIP, v.IP); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.null_int64"] {
+		if v.NullInt64, err = anonymizeValue(o, "FieldType", fieldtype.FieldNullInt64, v.NullInt64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.schema_int"] {
+		if v.SchemaInt, err = anonymizeValue(o, "FieldType", fieldtype.FieldSchemaInt, v.SchemaInt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.schema_int8"] {
+		if v.SchemaInt8, err = anonymizeValue(o, "FieldType", fieldtype.FieldSchemaInt8, v.SchemaInt8); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.schema_int64"] {
+		if v.SchemaInt64, err = anonymizeValue(o, "FieldType", fieldtype.FieldSchemaInt64, v.SchemaInt64); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.schema_float"] {
+		if v.SchemaFloat, err = anonymizeValue(o, "FieldType", fieldtype.FieldSchemaFloat, v.SchemaFloat); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.schema_float32"] {
+		if v.SchemaFloat32, err = anonymizeValue(o, "FieldType", fieldtype.FieldSchemaFloat32, v.SchemaFloat32); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.null_float"] {
+		if v.NullFloat, err = anonymizeValue(o, "FieldType", fieldtype.FieldNullFloat, v.NullFloat); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.role"] {
+		if v.Role, err = anonymizeValue(o, "FieldType", fieldtype.FieldRole, v.Role); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.priority"] {
+		if v.Priority, err = anonymizeValue(o, "FieldType", fieldtype.FieldPriority, v.Priority); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.optional_uuid"] {
+		if v.OptionalUUID, err = anonymizeValue(o, "FieldType", fieldtype.FieldOptionalUUID, v.OptionalUUID); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nillable_uuid"] {
+		if v.NillableUUID, err = anonymizeValue(o, "FieldType", fieldtype.FieldNillableUUID, v.NillableUUID); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.strings"] {
+		if v.Strings, err = anonymizeValue(o, "FieldType", fieldtype.FieldStrings, v.Strings); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.pair"] {
+		if v.Pair, err = anonymizeValue(o, "FieldType", fieldtype.FieldPair, v.Pair); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.nil_pair"] {
+		if v.NilPair, err = anonymizeValue(o, "FieldType", fieldtype.FieldNilPair, v.NilPair); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.vstring"] {
+		if v.Vstring, err = anonymizeValue(o, "FieldType", fieldtype.FieldVstring, v.Vstring); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.triple"] {
+		if v.Triple, err = anonymizeValue(o, "FieldType", fieldtype.FieldTriple, v.Triple); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FieldType.big_int"] {
+		if v.BigInt, err = anonymizeValue(o, "FieldType", fieldtype.FieldBigInt, v.BigInt); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *FieldTypeClient) export(ctx context.Context, q *FieldTypeQuery, fn func(*FieldType) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(fieldtype.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of FieldType entities using a single bulk operation.
+func (c *FieldTypeClient) importBatch(ctx context.Context, nodes []*FieldType) ([]*FieldType, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*FieldTypeCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetInt(_node.Int)
+		builders[idx].SetInt8(_node.Int8)
+		builders[idx].SetInt16(_node.Int16)
+		builders[idx].SetInt32(_node.Int32)
+		builders[idx].SetInt64(_node.Int64)
+		if !exportIsZero(_node.OptionalInt) {
+			builders[idx].SetOptionalInt(_node.OptionalInt)
+		}
+		if !exportIsZero(_node.OptionalInt8) {
+			builders[idx].SetOptionalInt8(_node.OptionalInt8)
+		}
+		if !exportIsZero(_node.OptionalInt16) {
+			builders[idx].SetOptionalInt16(_node.OptionalInt16)
+		}
+		if !exportIsZero(_node.OptionalInt32) {
+			builders[idx].SetOptionalInt32(_node.OptionalInt32)
+		}
+		if !exportIsZero(_node.OptionalInt64) {
+			builders[idx].SetOptionalInt64(_node.OptionalInt64)
+		}
+		if _node.NillableInt != nil {
+			builders[idx].SetNillableInt(*_node.NillableInt)
+		}
+		if _node.NillableInt8 != nil {
+			builders[idx].SetNillableInt8(*_node.NillableInt8)
+		}
+		if _node.NillableInt16 != nil {
+			builders[idx].SetNillableInt16(*_node.NillableInt16)
+		}
+		if _node.NillableInt32 != nil {
+			builders[idx].SetNillableInt32(*_node.NillableInt32)
+		}
+		if _node.NillableInt64 != nil {
+			builders[idx].SetNillableInt64(*_node.NillableInt64)
+		}
+		if !exportIsZero(_node.ValidateOptionalInt32) {
+			builders[idx].SetValidateOptionalInt32(_node.ValidateOptionalInt32)
+		}
+		if !exportIsZero(_node.OptionalUint) {
+			builders[idx].SetOptionalUint(_node.OptionalUint)
+		}
+		if !exportIsZero(_node.OptionalUint8) {
+			builders[idx].SetOptionalUint8(_node.OptionalUint8)
+		}
+		if !exportIsZero(_node.OptionalUint16) {
+			builders[idx].SetOptionalUint16(_node.OptionalUint16)
+		}
+		if !exportIsZero(_node.OptionalUint32) {
+			builders[idx].SetOptionalUint32(_node.OptionalUint32)
+		}
+		if !exportIsZero(_node.OptionalUint64) {
+			builders[idx].SetOptionalUint64(_node.OptionalUint64)
+		}
+		if !exportIsZero(_node.State) {
+			builders[idx].SetState(_node.State)
+		}
+		if !exportIsZero(_node.OptionalFloat) {
+			builders[idx].SetOptionalFloat(_node.OptionalFloat)
+		}
+		if !exportIsZero(_node.OptionalFloat32) {
+			builders[idx].SetOptionalFloat32(_node.OptionalFloat32)
+		}
+		if !exportIsZero(_node.Text) {
+			builders[idx].SetText(_node.Text)
+		}
+		if !exportIsZero(_node.Datetime) {
+			builders[idx].SetDatetime(_node.Datetime)
+		}
+		if !exportIsZero(_node.Decimal) {
+			builders[idx].SetDecimal(_node.Decimal)
+		}
+		if _node.LinkOther != nil {
+			builders[idx].SetLinkOther(_node.LinkOther)
+		}
+		if _node.LinkOtherFunc != nil {
+			builders[idx].SetLinkOtherFunc(_node.LinkOtherFunc)
+		}
+		if !exportIsZero(_node.MAC) {
+			builders[idx].SetMAC(_node.MAC)
+		}
+		if _node.StringArray != nil {
+			builders[idx].SetStringArray(_node.StringArray)
+		}
+		if !exportIsZero(_node.Password) {
+			builders[idx].SetPassword(_node.Password)
+		}
+		if _node.StringScanner != nil {
+			builders[idx].SetStringScanner(*_node.StringScanner)
+		}
+		if !exportIsZero(_node.Duration) {
+			builders[idx].SetDuration(_node.Duration)
+		}
+		builders[idx].SetDir(_node.Dir)
+		if _node.Ndir != nil {
+			builders[idx].SetNdir(*_node.Ndir)
+		}
+		builders[idx].SetStr(_node.Str)
+		if _node.NullStr != nil {
+			builders[idx].SetNullStr(_node.NullStr)
+		}
+		if !exportIsZero(_node.Link) {
+			builders[idx].SetLink(_node.Link)
+		}
+		if _node.NullLink != nil {
+			builders[idx].SetNullLink(_node.NullLink)
+		}
+		if !exportIsZero(_node.Active) {
+			builders[idx].SetActive(_node.Active)
+		}
+		if _node.NullActive != nil {
+			builders[idx].SetNullActive(*_node.NullActive)
+		}
+		if _node.Deleted != nil {
+			builders[idx].SetDeleted(_node.Deleted)
+		}
+		if _node.DeletedAt != nil {
+			builders[idx].SetDeletedAt(_node.DeletedAt)
+		}
+		if _node.RawData != nil {
+			builders[idx].SetRawData(_node.RawData)
+		}
+		if _node.Sensitive != nil {
+			builders[idx].SetSensitive(_node.Sensitive)
+		}
+		if _node.IP != nil {
+			builders[idx].SetIP(_node.IP)
+		}
+		if _node.NullInt64 != nil {
+			builders[idx].SetNullInt64(_node.NullInt64)
+		}
+		if !exportIsZero(_node.SchemaInt) {
+			builders[idx].SetSchemaInt(_node.SchemaInt)
+		}
+		if !exportIsZero(_node.SchemaInt8) {
+			builders[idx].SetSchemaInt8(_node.SchemaInt8)
+		}
+		if !exportIsZero(_node.SchemaInt64) {
+			builders[idx].SetSchemaInt64(_node.SchemaInt64)
+		}
+		if !exportIsZero(_node.SchemaFloat) {
+			builders[idx].SetSchemaFloat(_node.SchemaFloat)
+		}
+		if !exportIsZero(_node.SchemaFloat32) {
+			builders[idx].SetSchemaFloat32(_node.SchemaFloat32)
+		}
+		if _node.NullFloat != nil {
+			builders[idx].SetNullFloat(_node.NullFloat)
+		}
+		builders[idx].SetRole(_node.Role)
+		if !exportIsZero(_node.Priority) {
+			builders[idx].SetPriority(_node.Priority)
+		}
+		if !exportIsZero(_node.OptionalUUID) {
+			builders[idx].SetOptionalUUID(_node.OptionalUUID)
+		}
+		if _node.NillableUUID != nil {
+			builders[idx].SetNillableUUID(*_node.NillableUUID)
+		}
+		if _node.Strings != nil {
+			builders[idx].SetStrings(_node.Strings)
+		}
+		builders[idx].SetPair(_node.Pair)
+		if _node.NilPair != nil {
+			builders[idx].SetNilPair(_node.NilPair)
+		}
+		builders[idx].SetVstring(_node.Vstring)
+		builders[idx].SetTriple(_node.Triple)
+		if !exportIsZero(_node.BigInt) {
+			builders[idx].SetBigInt(_node.BigInt)
+		}
+		if !exportIsZero(_node.PasswordOther) {
+			builders[idx].SetPasswordOther(_node.PasswordOther)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the File entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *FileClient) ExportJSON(ctx context.Context, w io.Writer, q *FileQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *File) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the File entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *FileClient) ExportCSV(ctx context.Context, w io.Writer, q *FileQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{file.FieldID, file.FieldSize, file.FieldName, file.FieldUser, file.FieldGroup, file.FieldOp, file.FieldFieldID}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *File) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Size); err != nil {
+			return fmt.Errorf("ent: encoding File.Size: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding File.Name: %w", err)
+		}
+		if record[3], err = csvEncode(_node.User); err != nil {
+			return fmt.Errorf("ent: encoding File.User: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Group); err != nil {
+			return fmt.Errorf("ent: encoding File.Group: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Op); err != nil {
+			return fmt.Errorf("ent: encoding File.Op: %w", err)
+		}
+		if record[6], err = csvEncode(_node.FieldID); err != nil {
+			return fmt.Errorf("ent: encoding File.FieldID: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of File entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *FileClient) ImportJSON(ctx context.Context, r io.Reader) ([]*File, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of File entities", t)
+	}
+	var nodes, batch []*File
+	for dec.More() {
+		_node := &File{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads File records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *FileClient) ImportCSV(ctx context.Context, r io.Reader) ([]*File, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*File
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &File{}
+		for idx, v := range record {
+			switch header[idx] {
+			case file.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case file.FieldSize:
+				err = csvDecode(v, &_node.Size)
+			case file.FieldName:
+				err = csvDecode(v, &_node.Name)
+			case file.FieldUser:
+				if v != "" {
+					_node.User = new(string)
+					err = csvDecode(v, _node.User)
+				}
+			case file.FieldGroup:
+				err = csvDecode(v, &_node.Group)
+			case file.FieldOp:
+				err = csvDecode(v, &_node.Op)
+			case file.FieldFieldID:
+				err = csvDecode(v, &_node.FieldID)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding File column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the File entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *FileClient) CloneTo(ctx context.Context, dst *Client, q *FileQuery, opts ...ExportOption) ([]*File, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*File
+	)
+	err := c.export(ctx, q, func(_node *File) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.File.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.File.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given File entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *FileClient) exportValue(_node *File, o *exportOptions) (_ *File, err error) {
+	v := *_node
+	if o.fields["File.size"] {
+		if v.Size, err = anonymizeValue(o, "File", file.FieldSize, v.Size); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["File.name"] {
+		if v.Name, err = anonymizeValue(o, "File", file.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["File.user"] {
+		if v.User, err = anonymizeValue(o, "File", file.FieldUser, v.User); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["File.group"] {
+		if v.Group, err = anonymizeValue(o, "File", file.FieldGroup, v.Group); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["File.op"] {
+		if v.Op, err = anonymizeValue(o, "File", file.FieldOp, v.Op); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["File.field_id"] {
+		if v.FieldID, err = anonymizeValue(o, "File", file.FieldFieldID, v.FieldID); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *FileClient) export(ctx context.Context, q *FileQuery, fn func(*File) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(file.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of File entities using a single bulk operation.
+func (c *FileClient) importBatch(ctx context.Context, nodes []*File) ([]*File, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*FileCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetSize(_node.Size)
+		builders[idx].SetName(_node.Name)
+		if _node.User != nil {
+			builders[idx].SetUser(*_node.User)
+		}
+		if !exportIsZero(_node.Group) {
+			builders[idx].SetGroup(_node.Group)
+		}
+		if !exportIsZero(_node.Op) {
+			builders[idx].SetOp(_node.Op)
+		}
+		if !exportIsZero(_node.FieldID) {
+			builders[idx].SetFieldID(_node.FieldID)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the FileType entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *FileTypeClient) ExportJSON(ctx context.Context, w io.Writer, q *FileTypeQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *FileType) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the FileType entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *FileTypeClient) ExportCSV(ctx context.Context, w io.Writer, q *FileTypeQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{filetype.FieldID, filetype.FieldName, filetype.FieldType, filetype.FieldState}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *FileType) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding FileType.Name: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Type); err != nil {
+			return fmt.Errorf("ent: encoding FileType.Type: %w", err)
+		}
+		if record[3], err = csvEncode(_node.State); err != nil {
+			return fmt.Errorf("ent: encoding FileType.State: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of FileType entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *FileTypeClient) ImportJSON(ctx context.Context, r io.Reader) ([]*FileType, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of FileType entities", t)
+	}
+	var nodes, batch []*FileType
+	for dec.More() {
+		_node := &FileType{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads FileType records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *FileTypeClient) ImportCSV(ctx context.Context, r io.Reader) ([]*FileType, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*FileType
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &FileType{}
+		for idx, v := range record {
+			switch header[idx] {
+			case filetype.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case filetype.FieldName:
+				err = csvDecode(v, &_node.Name)
+			case filetype.FieldType:
+				err = csvDecode(v, &_node.Type)
+			case filetype.FieldState:
+				err = csvDecode(v, &_node.State)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding FileType column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the FileType entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *FileTypeClient) CloneTo(ctx context.Context, dst *Client, q *FileTypeQuery, opts ...ExportOption) ([]*FileType, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*FileType
+	)
+	err := c.export(ctx, q, func(_node *FileType) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.FileType.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.FileType.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given FileType entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *FileTypeClient) exportValue(_node *FileType, o *exportOptions) (_ *FileType, err error) {
+	v := *_node
+	if o.fields["FileType.name"] {
+		if v.Name, err = anonymizeValue(o, "FileType", filetype.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FileType.type"] {
+		if v.Type, err = anonymizeValue(o, "FileType", filetype.FieldType, v.Type); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["FileType.state"] {
+		if v.State, err = anonymizeValue(o, "FileType", filetype.FieldState, v.State); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *FileTypeClient) export(ctx context.Context, q *FileTypeQuery, fn func(*FileType) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(filetype.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of FileType entities using a single bulk operation.
+func (c *FileTypeClient) importBatch(ctx context.Context, nodes []*FileType) ([]*FileType, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*FileTypeCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetName(_node.Name)
+		builders[idx].SetType(_node.Type)
+		builders[idx].SetState(_node.State)
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Goods entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *GoodsClient) ExportJSON(ctx context.Context, w io.Writer, q *GoodsQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Goods) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Goods entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *GoodsClient) ExportCSV(ctx context.Context, w io.Writer, q *GoodsQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{goods.FieldID}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Goods) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Goods entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *GoodsClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Goods, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Goods entities", t)
+	}
+	var nodes, batch []*Goods
+	for dec.More() {
+		_node := &Goods{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Goods records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *GoodsClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Goods, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Goods
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Goods{}
+		for idx, v := range record {
+			switch header[idx] {
+			case goods.FieldID:
+				err = csvDecode(v, &_node.ID)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Goods column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Goods entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *GoodsClient) CloneTo(ctx context.Context, dst *Client, q *GoodsQuery, opts ...ExportOption) ([]*Goods, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Goods
+	)
+	err := c.export(ctx, q, func(_node *Goods) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Goods.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Goods.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Goods entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *GoodsClient) exportValue(_node *Goods, o *exportOptions) (_ *Goods, err error) {
+	v := *_node
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *GoodsClient) export(ctx context.Context, q *GoodsQuery, fn func(*Goods) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(goods.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Goods entities using a single bulk operation.
+func (c *GoodsClient) importBatch(ctx context.Context, nodes []*Goods) ([]*Goods, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*GoodsCreate, len(nodes))
+	for idx := range nodes {
+		builders[idx] = c.Create()
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Group entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *GroupClient) ExportJSON(ctx context.Context, w io.Writer, q *GroupQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Group) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Group entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *GroupClient) ExportCSV(ctx context.Context, w io.Writer, q *GroupQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{group.FieldID, group.FieldActive, group.FieldExpire, group.FieldType, group.FieldMaxUsers, group.FieldName}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Group) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Active); err != nil {
+			return fmt.Errorf("ent: encoding Group.Active: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Expire); err != nil {
+			return fmt.Errorf("ent: encoding Group.Expire: %w", err)
+		}
+		if record[3], err = csvEncode(_node.Type); err != nil {
+			return fmt.Errorf("ent: encoding Group.Type: %w", err)
+		}
+		if record[4], err = csvEncode(_node.MaxUsers); err != nil {
+			return fmt.Errorf("ent: encoding Group.MaxUsers: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding Group.Name: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Group entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *GroupClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Group, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Group entities", t)
+	}
+	var nodes, batch []*Group
+	for dec.More() {
+		_node := &Group{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Group records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *GroupClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Group, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Group
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Group{}
+		for idx, v := range record {
+			switch header[idx] {
+			case group.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case group.FieldActive:
+				err = csvDecode(v, &_node.Active)
+			case group.FieldExpire:
+				err = csvDecode(v, &_node.Expire)
+			case group.FieldType:
+				if v != "" {
+					_node.Type = new(string)
+					err = csvDecode(v, _node.Type)
+				}
+			case group.FieldMaxUsers:
+				err = csvDecode(v, &_node.MaxUsers)
+			case group.FieldName:
+				err = csvDecode(v, &_node.Name)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Group column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Group entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *GroupClient) CloneTo(ctx context.Context, dst *Client, q *GroupQuery, opts ...ExportOption) ([]*Group, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Group
+	)
+	err := c.export(ctx, q, func(_node *Group) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Group.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Group.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Group entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *GroupClient) exportValue(_node *Group, o *exportOptions) (_ *Group, err error) {
+	v := *_node
+	if o.fields["Group.active"] {
+		if v.Active, err = anonymizeValue(o, "Group", group.FieldActive, v.Active); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Group.expire"] {
+		if v.Expire, err = anonymizeValue(o, "Group", group.FieldExpire, v.Expire); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Group.type"] {
+		if v.Type, err = anonymizeValue(o, "Group", group.FieldType, v.Type); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Group.max_users"] {
+		if v.MaxUsers, err = anonymizeValue(o, "Group", group.FieldMaxUsers, v.MaxUsers); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Group.name"] {
+		if v.Name, err = anonymizeValue(o, "Group", group.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *GroupClient) export(ctx context.Context, q *GroupQuery, fn func(*Group) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(group.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Group entities using a single bulk operation.
+func (c *GroupClient) importBatch(ctx context.Context, nodes []*Group) ([]*Group, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*GroupCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetActive(_node.Active)
+		builders[idx].SetExpire(_node.Expire)
+		if _node.Type != nil {
+			builders[idx].SetType(*_node.Type)
+		}
+		builders[idx].SetMaxUsers(_node.MaxUsers)
+		builders[idx].SetName(_node.Name)
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the GroupInfo entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *GroupInfoClient) ExportJSON(ctx context.Context, w io.Writer, q *GroupInfoQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *GroupInfo) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the GroupInfo entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *GroupInfoClient) ExportCSV(ctx context.Context, w io.Writer, q *GroupInfoQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{groupinfo.FieldID, groupinfo.FieldDesc, groupinfo.FieldMaxUsers}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *GroupInfo) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Desc); err != nil {
+			return fmt.Errorf("ent: encoding GroupInfo.Desc: %w", err)
+		}
+		if record[2], err = csvEncode(_node.MaxUsers); err != nil {
+			return fmt.Errorf("ent: encoding GroupInfo.MaxUsers: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of GroupInfo entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *GroupInfoClient) ImportJSON(ctx context.Context, r io.Reader) ([]*GroupInfo, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of GroupInfo entities", t)
+	}
+	var nodes, batch []*GroupInfo
+	for dec.More() {
+		_node := &GroupInfo{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads GroupInfo records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *GroupInfoClient) ImportCSV(ctx context.Context, r io.Reader) ([]*GroupInfo, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*GroupInfo
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &GroupInfo{}
+		for idx, v := range record {
+			switch header[idx] {
+			case groupinfo.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case groupinfo.FieldDesc:
+				err = csvDecode(v, &_node.Desc)
+			case groupinfo.FieldMaxUsers:
+				err = csvDecode(v, &_node.MaxUsers)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding GroupInfo column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the GroupInfo entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *GroupInfoClient) CloneTo(ctx context.Context, dst *Client, q *GroupInfoQuery, opts ...ExportOption) ([]*GroupInfo, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*GroupInfo
+	)
+	err := c.export(ctx, q, func(_node *GroupInfo) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.GroupInfo.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.GroupInfo.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given GroupInfo entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *GroupInfoClient) exportValue(_node *GroupInfo, o *exportOptions) (_ *GroupInfo, err error) {
+	v := *_node
+	if o.fields["GroupInfo.desc"] {
+		if v.Desc, err = anonymizeValue(o, "GroupInfo", groupinfo.FieldDesc, v.Desc); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["GroupInfo.max_users"] {
+		if v.MaxUsers, err = anonymizeValue(o, "GroupInfo", groupinfo.FieldMaxUsers, v.MaxUsers); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *GroupInfoClient) export(ctx context.Context, q *GroupInfoQuery, fn func(*GroupInfo) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(groupinfo.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of GroupInfo entities using a single bulk operation.
+func (c *GroupInfoClient) importBatch(ctx context.Context, nodes []*GroupInfo) ([]*GroupInfo, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*GroupInfoCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetDesc(_node.Desc)
+		builders[idx].SetMaxUsers(_node.MaxUsers)
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Item entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *ItemClient) ExportJSON(ctx context.Context, w io.Writer, q *ItemQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Item) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Item entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *ItemClient) ExportCSV(ctx context.Context, w io.Writer, q *ItemQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{item.FieldID, item.FieldText}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Item) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Text); err != nil {
+			return fmt.Errorf("ent: encoding Item.Text: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Item entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *ItemClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Item, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Item entities", t)
+	}
+	var nodes, batch []*Item
+	for dec.More() {
+		_node := &Item{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Item records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *ItemClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Item, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Item
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Item{}
+		for idx, v := range record {
+			switch header[idx] {
+			case item.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case item.FieldText:
+				err = csvDecode(v, &_node.Text)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Item column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Item entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *ItemClient) CloneTo(ctx context.Context, dst *Client, q *ItemQuery, opts ...ExportOption) ([]*Item, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Item
+	)
+	err := c.export(ctx, q, func(_node *Item) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Item.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Item.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Item entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *ItemClient) exportValue(_node *Item, o *exportOptions) (_ *Item, err error) {
+	v := *_node
+	if o.fields["Item.text"] {
+		if v.Text, err = anonymizeValue(o, "Item", item.FieldText, v.Text); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *ItemClient) export(ctx context.Context, q *ItemQuery, fn func(*Item) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(item.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Item entities using a single bulk operation.
+func (c *ItemClient) importBatch(ctx context.Context, nodes []*Item) ([]*Item, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*ItemCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetID(_node.ID)
+		if !exportIsZero(_node.Text) {
+			builders[idx].SetText(_node.Text)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the License entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *LicenseClient) ExportJSON(ctx context.Context, w io.Writer, q *LicenseQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *License) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the License entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *LicenseClient) ExportCSV(ctx context.Context, w io.Writer, q *LicenseQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{license.FieldID, license.FieldCreateTime, license.FieldUpdateTime}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *License) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.CreateTime); err != nil {
+			return fmt.Errorf("ent: encoding License.CreateTime: %w", err)
+		}
+		if record[2], err = csvEncode(_node.UpdateTime); err != nil {
+			return fmt.Errorf("ent: encoding License.UpdateTime: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of License entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *LicenseClient) ImportJSON(ctx context.Context, r io.Reader) ([]*License, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of License entities", t)
+	}
+	var nodes, batch []*License
+	for dec.More() {
+		_node := &License{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads License records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *LicenseClient) ImportCSV(ctx context.Context, r io.Reader) ([]*License, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*License
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &License{}
+		for idx, v := range record {
+			switch header[idx] {
+			case license.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case license.FieldCreateTime:
+				err = csvDecode(v, &_node.CreateTime)
+			case license.FieldUpdateTime:
+				err = csvDecode(v, &_node.UpdateTime)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding License column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the License entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *LicenseClient) CloneTo(ctx context.Context, dst *Client, q *LicenseQuery, opts ...ExportOption) ([]*License, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*License
+	)
+	err := c.export(ctx, q, func(_node *License) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.License.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.License.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given License entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *LicenseClient) exportValue(_node *License, o *exportOptions) (_ *License, err error) {
+	v := *_node
+	if o.fields["License.create_time"] {
+		if v.CreateTime, err = anonymizeValue(o, "License", license.FieldCreateTime, v.CreateTime); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["License.update_time"] {
+		if v.UpdateTime, err = anonymizeValue(o, "License", license.FieldUpdateTime, v.UpdateTime); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *LicenseClient) export(ctx context.Context, q *LicenseQuery, fn func(*License) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(license.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of License entities using a single bulk operation.
+func (c *LicenseClient) importBatch(ctx context.Context, nodes []*License) ([]*License, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*LicenseCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetID(_node.ID)
+		builders[idx].SetCreateTime(_node.CreateTime)
+		builders[idx].SetUpdateTime(_node.UpdateTime)
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Node entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *NodeClient) ExportJSON(ctx context.Context, w io.Writer, q *NodeQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Node) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Node entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *NodeClient) ExportCSV(ctx context.Context, w io.Writer, q *NodeQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{node.FieldID, node.FieldValue, node.FieldUpdatedAt}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Node) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Value); err != nil {
+			return fmt.Errorf("ent: encoding Node.Value: %w", err)
+		}
+		if record[2], err = csvEncode(_node.UpdatedAt); err != nil {
+			return fmt.Errorf("ent: encoding Node.UpdatedAt: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Node entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *NodeClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Node, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Node entities", t)
+	}
+	var nodes, batch []*Node
+	for dec.More() {
+		_node := &Node{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Node records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *NodeClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Node, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Node
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Node{}
+		for idx, v := range record {
+			switch header[idx] {
+			case node.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case node.FieldValue:
+				err = csvDecode(v, &_node.Value)
+			case node.FieldUpdatedAt:
+				if v != "" {
+					_node.UpdatedAt = new(time.Time)
+					err = csvDecode(v, _node.UpdatedAt)
+				}
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Node column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Node entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *NodeClient) CloneTo(ctx context.Context, dst *Client, q *NodeQuery, opts ...ExportOption) ([]*Node, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Node
+	)
+	err := c.export(ctx, q, func(_node *Node) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Node.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Node.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Node entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *NodeClient) exportValue(_node *Node, o *exportOptions) (_ *Node, err error) {
+	v := *_node
+	if o.fields["Node.value"] {
+		if v.Value, err = anonymizeValue(o, "Node", node.FieldValue, v.Value); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Node.updated_at"] {
+		if v.UpdatedAt, err = anonymizeValue(o, "Node", node.FieldUpdatedAt, v.UpdatedAt); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *NodeClient) export(ctx context.Context, q *NodeQuery, fn func(*Node) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(node.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Node entities using a single bulk operation.
+func (c *NodeClient) importBatch(ctx context.Context, nodes []*Node) ([]*Node, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*NodeCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		if !exportIsZero(_node.Value) {
+			builders[idx].SetValue(_node.Value)
+		}
+		if _node.UpdatedAt != nil {
+			builders[idx].SetUpdatedAt(*_node.UpdatedAt)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the PC entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *PCClient) ExportJSON(ctx context.Context, w io.Writer, q *PCQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *PC) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the PC entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *PCClient) ExportCSV(ctx context.Context, w io.Writer, q *PCQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{pc.FieldID}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *PC) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of PC entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *PCClient) ImportJSON(ctx context.Context, r io.Reader) ([]*PC, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of PC entities", t)
+	}
+	var nodes, batch []*PC
+	for dec.More() {
+		_node := &PC{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads PC records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *PCClient) ImportCSV(ctx context.Context, r io.Reader) ([]*PC, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*PC
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &PC{}
+		for idx, v := range record {
+			switch header[idx] {
+			case pc.FieldID:
+				err = csvDecode(v, &_node.ID)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding PC column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the PC entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *PCClient) CloneTo(ctx context.Context, dst *Client, q *PCQuery, opts ...ExportOption) ([]*PC, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*PC
+	)
+	err := c.export(ctx, q, func(_node *PC) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.PC.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.PC.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given PC entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *PCClient) exportValue(_node *PC, o *exportOptions) (_ *PC, err error) {
+	v := *_node
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *PCClient) export(ctx context.Context, q *PCQuery, fn func(*PC) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(pc.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of PC entities using a single bulk operation.
+func (c *PCClient) importBatch(ctx context.Context, nodes []*PC) ([]*PC, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*PCCreate, len(nodes))
+	for idx := range nodes {
+		builders[idx] = c.Create()
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Pet entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *PetClient) ExportJSON(ctx context.Context, w io.Writer, q *PetQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Pet) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Pet entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *PetClient) ExportCSV(ctx context.Context, w io.Writer, q *PetQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{pet.FieldID, pet.FieldAge, pet.FieldName, pet.FieldUUID, pet.FieldNickname, pet.FieldTrained}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Pet) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Age); err != nil {
+			return fmt.Errorf("ent: encoding Pet.Age: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding Pet.Name: %w", err)
+		}
+		if record[3], err = csvEncode(_node.UUID); err != nil {
+			return fmt.Errorf("ent: encoding Pet.UUID: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Nickname); err != nil {
+			return fmt.Errorf("ent: encoding Pet.Nickname: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Trained); err != nil {
+			return fmt.Errorf("ent: encoding Pet.Trained: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Pet entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *PetClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Pet, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Pet entities", t)
+	}
+	var nodes, batch []*Pet
+	for dec.More() {
+		_node := &Pet{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Pet records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *PetClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Pet, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Pet
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Pet{}
+		for idx, v := range record {
+			switch header[idx] {
+			case pet.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case pet.FieldAge:
+				err = csvDecode(v, &_node.Age)
+			case pet.FieldName:
+				err = csvDecode(v, &_node.Name)
+			case pet.FieldUUID:
+				err = csvDecode(v, &_node.UUID)
+			case pet.FieldNickname:
+				err = csvDecode(v, &_node.Nickname)
+			case pet.FieldTrained:
+				err = csvDecode(v, &_node.Trained)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Pet column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Pet entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *PetClient) CloneTo(ctx context.Context, dst *Client, q *PetQuery, opts ...ExportOption) ([]*Pet, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Pet
+	)
+	err := c.export(ctx, q, func(_node *Pet) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Pet.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Pet.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Pet entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *PetClient) exportValue(_node *Pet, o *exportOptions) (_ *Pet, err error) {
+	v := *_node
+	if o.fields["Pet.age"] {
+		if v.Age, err = anonymizeValue(o, "Pet", pet.FieldAge, v.Age); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Pet.name"] {
+		if v.Name, err = anonymizeValue(o, "Pet", pet.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Pet.uuid"] {
+		if v.UUID, err = anonymizeValue(o, "Pet", pet.FieldUUID, v.UUID); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Pet.nickname"] {
+		if v.Nickname, err = anonymizeValue(o, "Pet", pet.FieldNickname, v.Nickname); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Pet.trained"] {
+		if v.Trained, err = anonymizeValue(o, "Pet", pet.FieldTrained, v.Trained); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *PetClient) export(ctx context.Context, q *PetQuery, fn func(*Pet) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(pet.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Pet entities using a single bulk operation.
+func (c *PetClient) importBatch(ctx context.Context, nodes []*Pet) ([]*Pet, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*PetCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetAge(_node.Age)
+		builders[idx].SetName(_node.Name)
+		if !exportIsZero(_node.UUID) {
+			builders[idx].SetUUID(_node.UUID)
+		}
+		if !exportIsZero(_node.Nickname) {
+			builders[idx].SetNickname(_node.Nickname)
+		}
+		builders[idx].SetTrained(_node.Trained)
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Spec entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *SpecClient) ExportJSON(ctx context.Context, w io.Writer, q *SpecQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Spec) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Spec entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *SpecClient) ExportCSV(ctx context.Context, w io.Writer, q *SpecQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{spec.FieldID}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Spec) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Spec entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *SpecClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Spec, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Spec entities", t)
+	}
+	var nodes, batch []*Spec
+	for dec.More() {
+		_node := &Spec{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Spec records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *SpecClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Spec, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Spec
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Spec{}
+		for idx, v := range record {
+			switch header[idx] {
+			case spec.FieldID:
+				err = csvDecode(v, &_node.ID)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Spec column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Spec entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *SpecClient) CloneTo(ctx context.Context, dst *Client, q *SpecQuery, opts ...ExportOption) ([]*Spec, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Spec
+	)
+	err := c.export(ctx, q, func(_node *Spec) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Spec.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Spec.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Spec entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *SpecClient) exportValue(_node *Spec, o *exportOptions) (_ *Spec, err error) {
+	v := *_node
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *SpecClient) export(ctx context.Context, q *SpecQuery, fn func(*Spec) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(spec.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Spec entities using a single bulk operation.
+func (c *SpecClient) importBatch(ctx context.Context, nodes []*Spec) ([]*Spec, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*SpecCreate, len(nodes))
+	for idx := range nodes {
+		builders[idx] = c.Create()
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the Task entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *TaskClient) ExportJSON(ctx context.Context, w io.Writer, q *TaskQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *Task) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the Task entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *TaskClient) ExportCSV(ctx context.Context, w io.Writer, q *TaskQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{enttask.FieldID, enttask.FieldPriority, enttask.FieldPriorities, enttask.FieldCreatedAt, enttask.FieldName, enttask.FieldOwner, enttask.FieldOrder, enttask.FieldOrderOption, enttask.FieldOp}
+	)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *Task) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.Priority); err != nil {
+			return fmt.Errorf("ent: encoding Task.Priority: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Priorities); err != nil {
+			return fmt.Errorf("ent: encoding Task.Priorities: %w", err)
+		}
+		if record[3], err = csvEncode(_node.CreatedAt); err != nil {
+			return fmt.Errorf("ent: encoding Task.CreatedAt: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding Task.Name: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Owner); err != nil {
+			return fmt.Errorf("ent: encoding Task.Owner: %w", err)
+		}
+		if record[6], err = csvEncode(_node.Order); err != nil {
+			return fmt.Errorf("ent: encoding Task.Order: %w", err)
+		}
+		if record[7], err = csvEncode(_node.OrderOption); err != nil {
+			return fmt.Errorf("ent: encoding Task.OrderOption: %w", err)
+		}
+		if record[8], err = csvEncode(_node.Op); err != nil {
+			return fmt.Errorf("ent: encoding Task.Op: %w", err)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of Task entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *TaskClient) ImportJSON(ctx context.Context, r io.Reader) ([]*Task, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of Task entities", t)
+	}
+	var nodes, batch []*Task
+	for dec.More() {
+		_node := &Task{}
+		if err := dec.Decode(_node); err != nil {
+			return nil, err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads Task records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *TaskClient) ImportCSV(ctx context.Context, r io.Reader) ([]*Task, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*Task
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &Task{}
+		for idx, v := range record {
+			switch header[idx] {
+			case enttask.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case enttask.FieldPriority:
+				err = csvDecode(v, &_node.Priority)
+			case enttask.FieldPriorities:
+				err = csvDecode(v, &_node.Priorities)
+			case enttask.FieldCreatedAt:
+				if v != "" {
+					_node.CreatedAt = new(time.Time)
+					err = csvDecode(v, _node.CreatedAt)
+				}
+			case enttask.FieldName:
+				err = csvDecode(v, &_node.Name)
+			case enttask.FieldOwner:
+				err = csvDecode(v, &_node.Owner)
+			case enttask.FieldOrder:
+				err = csvDecode(v, &_node.Order)
+			case enttask.FieldOrderOption:
+				err = csvDecode(v, &_node.OrderOption)
+			case enttask.FieldOp:
+				err = csvDecode(v, &_node.Op)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding Task column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the Task entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *TaskClient) CloneTo(ctx context.Context, dst *Client, q *TaskQuery, opts ...ExportOption) ([]*Task, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*Task
+	)
+	err := c.export(ctx, q, func(_node *Task) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.Task.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.Task.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// exportValue returns a copy of the given Task entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *TaskClient) exportValue(_node *Task, o *exportOptions) (_ *Task, err error) {
+	v := *_node
+	if o.fields["Task.priority"] {
+		if v.Priority, err = anonymizeValue(o, "Task", enttask.FieldPriority, v.Priority); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.priorities"] {
+		if v.Priorities, err = anonymizeValue(o, "Task", enttask.FieldPriorities, v.Priorities); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.created_at"] {
+		if v.CreatedAt, err = anonymizeValue(o, "Task", enttask.FieldCreatedAt, v.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.name"] {
+		if v.Name, err = anonymizeValue(o, "Task", enttask.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.owner"] {
+		if v.Owner, err = anonymizeValue(o, "Task", enttask.FieldOwner, v.Owner); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.order"] {
+		if v.Order, err = anonymizeValue(o, "Task", enttask.FieldOrder, v.Order); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.order_option"] {
+		if v.OrderOption, err = anonymizeValue(o, "Task", enttask.FieldOrderOption, v.OrderOption); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["Task.op"] {
+		if v.Op, err = anonymizeValue(o, "Task", enttask.FieldOp, v.Op); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *TaskClient) export(ctx context.Context, q *TaskQuery, fn func(*Task) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(enttask.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of Task entities using a single bulk operation.
+func (c *TaskClient) importBatch(ctx context.Context, nodes []*Task) ([]*Task, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*TaskCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		builders[idx].SetPriority(_node.Priority)
+		if _node.Priorities != nil {
+			builders[idx].SetPriorities(_node.Priorities)
+		}
+		if _node.CreatedAt != nil {
+			builders[idx].SetCreatedAt(*_node.CreatedAt)
+		}
+		if !exportIsZero(_node.Name) {
+			builders[idx].SetName(_node.Name)
+		}
+		if !exportIsZero(_node.Owner) {
+			builders[idx].SetOwner(_node.Owner)
+		}
+		if !exportIsZero(_node.Order) {
+			builders[idx].SetOrder(_node.Order)
+		}
+		if !exportIsZero(_node.OrderOption) {
+			builders[idx].SetOrderOption(_node.OrderOption)
+		}
+		builders[idx].SetOp(_node.Op)
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// ExportJSON streams the User entities returned by the given query to w as a JSON array.
+// Edges that were eager-loaded on the query (e.g. using the With<E> methods) are included under
+// the "edges" key of each entity. A nil query exports all entities. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *UserClient) ExportJSON(ctx context.Context, w io.Writer, q *UserQuery, opts ...ExportOption) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	var (
+		count int
+		enc   = json.NewEncoder(w)
+		o     = newExportOptions(opts)
+	)
+	err := c.export(ctx, q, func(_node *User) (err error) {
+		if count++; count > 1 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		if o.anonymizer != nil {
+			return enc.Encode(userJSON{User: _node, Password: _node.Password})
+		}
+		return enc.Encode(_node)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportCSV streams the User entities returned by the given query to w as CSV records.
+// The first record holds the column names, and the rest hold the entity values. A nil query
+// exports all entities. Edges are omitted, and so are sensitive fields, unless the ExportAnonymized
+// option is used.
+func (c *UserClient) ExportCSV(ctx context.Context, w io.Writer, q *UserQuery, opts ...ExportOption) error {
+	var (
+		cw     = csv.NewWriter(w)
+		o      = newExportOptions(opts)
+		header = []string{user.FieldID, user.FieldOptionalInt, user.FieldAge, user.FieldName, user.FieldLast, user.FieldNickname, user.FieldAddress, user.FieldPhone, user.FieldRole, user.FieldEmployment, user.FieldSSOCert, user.FieldFilesCount}
+	)
+	if o.anonymizer != nil {
+		header = append(header, user.FieldPassword)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	err := c.export(ctx, q, func(_node *User) (err error) {
+		if _node, err = c.exportValue(_node, o); err != nil {
+			return err
+		}
+		record := make([]string, len(header))
+		if record[0], err = csvEncode(_node.ID); err != nil {
+			return err
+		}
+		if record[1], err = csvEncode(_node.OptionalInt); err != nil {
+			return fmt.Errorf("ent: encoding User.OptionalInt: %w", err)
+		}
+		if record[2], err = csvEncode(_node.Age); err != nil {
+			return fmt.Errorf("ent: encoding User.Age: %w", err)
+		}
+		if record[3], err = csvEncode(_node.Name); err != nil {
+			return fmt.Errorf("ent: encoding User.Name: %w", err)
+		}
+		if record[4], err = csvEncode(_node.Last); err != nil {
+			return fmt.Errorf("ent: encoding User.Last: %w", err)
+		}
+		if record[5], err = csvEncode(_node.Nickname); err != nil {
+			return fmt.Errorf("ent: encoding User.Nickname: %w", err)
+		}
+		if record[6], err = csvEncode(_node.Address); err != nil {
+			return fmt.Errorf("ent: encoding User.Address: %w", err)
+		}
+		if record[7], err = csvEncode(_node.Phone); err != nil {
+			return fmt.Errorf("ent: encoding User.Phone: %w", err)
+		}
+		if record[8], err = csvEncode(_node.Role); err != nil {
+			return fmt.Errorf("ent: encoding User.Role: %w", err)
+		}
+		if record[9], err = csvEncode(_node.Employment); err != nil {
+			return fmt.Errorf("ent: encoding User.Employment: %w", err)
+		}
+		if record[10], err = csvEncode(_node.SSOCert); err != nil {
+			return fmt.Errorf("ent: encoding User.SSOCert: %w", err)
+		}
+		if record[11], err = csvEncode(_node.FilesCount); err != nil {
+			return fmt.Errorf("ent: encoding User.FilesCount: %w", err)
+		}
+		if o.anonymizer != nil {
+			if record[12], err = csvEncode(_node.Password); err != nil {
+				return fmt.Errorf("ent: encoding User.Password: %w", err)
+			}
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportJSON reads a JSON array of User entities from r, as written by ExportJSON, and creates
+// them in batches. Edges are not imported, but edge-fields are. Note that IDs are preserved only if the
+// ID field is defined in the schema. Otherwise, new IDs are assigned by the database. Sensitive fields
+// are imported only if they were exported using the ExportAnonymized option.
+func (c *UserClient) ImportJSON(ctx context.Context, r io.Reader) ([]*User, error) {
+	dec := json.NewDecoder(r)
+	switch t, err := dec.Token(); {
+	case err != nil:
+		return nil, err
+	case t != json.Delim('['):
+		return nil, fmt.Errorf("ent: unexpected JSON token %v, expect an array of User entities", t)
+	}
+	var nodes, batch []*User
+	for dec.More() {
+		_node := &User{}
+		v := userJSON{User: _node}
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		_node.Password = v.Password
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// ImportCSV reads User records from r, as written by ExportCSV, and creates them in batches.
+// The first record must hold the column names. Unknown columns are rejected.
+func (c *UserClient) ImportCSV(ctx context.Context, r io.Reader) ([]*User, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var nodes, batch []*User
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		_node := &User{}
+		for idx, v := range record {
+			switch header[idx] {
+			case user.FieldID:
+				err = csvDecode(v, &_node.ID)
+			case user.FieldOptionalInt:
+				err = csvDecode(v, &_node.OptionalInt)
+			case user.FieldAge:
+				err = csvDecode(v, &_node.Age)
+			case user.FieldName:
+				err = csvDecode(v, &_node.Name)
+			case user.FieldLast:
+				err = csvDecode(v, &_node.Last)
+			case user.FieldNickname:
+				err = csvDecode(v, &_node.Nickname)
+			case user.FieldAddress:
+				err = csvDecode(v, &_node.Address)
+			case user.FieldPhone:
+				err = csvDecode(v, &_node.Phone)
+			case user.FieldPassword:
+				err = csvDecode(v, &_node.Password)
+			case user.FieldRole:
+				err = csvDecode(v, &_node.Role)
+			case user.FieldEmployment:
+				err = csvDecode(v, &_node.Employment)
+			case user.FieldSSOCert:
+				err = csvDecode(v, &_node.SSOCert)
+			case user.FieldFilesCount:
+				err = csvDecode(v, &_node.FilesCount)
+			default:
+				err = fmt.Errorf("unknown column %q", header[idx])
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: decoding User column %q: %w", header[idx], err)
+			}
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := c.importBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+	}
+	created, err := c.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// CloneTo copies the User entities returned by the given query to the database of the given client
+// in batches, and returns the created entities. For example, from a production database to a staging one.
+// A nil query clones all entities. Edges are not cloned, but edge-fields are. Sensitive fields are omitted,
+// unless the ExportAnonymized option is used.
+func (c *UserClient) CloneTo(ctx context.Context, dst *Client, q *UserQuery, opts ...ExportOption) ([]*User, error) {
+	var (
+		o            = newExportOptions(opts)
+		nodes, batch []*User
+	)
+	err := c.export(ctx, q, func(_node *User) error {
+		_node, err := c.exportValue(_node, o)
+		if err != nil {
+			return err
+		}
+		if batch = append(batch, _node); len(batch) == exportBatchSize {
+			created, err := dst.User.importBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+			nodes, batch = append(nodes, created...), nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created, err := dst.User.importBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return append(nodes, created...), nil
+}
+
+// userJSON is the JSON representation of anonymized User entities, including the sensitive fields.
+type userJSON struct {
+	*User
+	Password string `json:"password,omitempty"`
+}
+
+// exportValue returns a copy of the given User entity prepared for export. Sensitive fields are reset,
+// or anonymized together with the configured fields if the ExportAnonymized option is used.
+func (c *UserClient) exportValue(_node *User, o *exportOptions) (_ *User, err error) {
+	v := *_node
+	if o.anonymizer == nil {
+		v.Password = *new(string)
+	} else if v.Password, err = anonymizeValue(o, "User", user.FieldPassword, v.Password); err != nil {
+		return nil, err
+	}
+	if o.fields["User.optional_int"] {
+		if v.OptionalInt, err = anonymizeValue(o, "User", user.FieldOptionalInt, v.OptionalInt); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.age"] {
+		if v.Age, err = anonymizeValue(o, "User", user.FieldAge, v.Age); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.name"] {
+		if v.Name, err = anonymizeValue(o, "User", user.FieldName, v.Name); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.last"] {
+		if v.Last, err = anonymizeValue(o, "User", user.FieldLast, v.Last); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.nickname"] {
+		if v.Nickname, err = anonymizeValue(o, "User", user.FieldNickname, v.Nickname); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.address"] {
+		if v.Address, err = anonymizeValue(o, "User", user.FieldAddress, v.Address); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.phone"] {
+		if v.Phone, err = anonymizeValue(o, "User", user.FieldPhone, v.Phone); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.role"] {
+		if v.Role, err = anonymizeValue(o, "User", user.FieldRole, v.Role); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.employment"] {
+		if v.Employment, err = anonymizeValue(o, "User", user.FieldEmployment, v.Employment); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.SSOCert"] {
+		if v.SSOCert, err = anonymizeValue(o, "User", user.FieldSSOCert, v.SSOCert); err != nil {
+			return nil, err
+		}
+	}
+	if o.fields["User.files_count"] {
+		if v.FilesCount, err = anonymizeValue(o, "User", user.FieldFilesCount, v.FilesCount); err != nil {
+			return nil, err
+		}
+	}
+	return &v, nil
+}
+
+// export iterates over the entities returned by the query in batches and calls fn for each one of them.
+func (c *UserClient) export(ctx context.Context, q *UserQuery, fn func(*User) error) error {
+	if q == nil {
+		q = c.Query()
+	}
+	// Queries with an explicit window are exported as-is.
+	if q.ctx.Limit != nil || q.ctx.Offset != nil {
+		nodes, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// A stable order is required for paginating the results.
+	if len(q.order) == 0 {
+		q = q.Clone().Order(user.ByID())
+	}
+	for offset := 0; ; offset += exportBatchSize {
+		nodes, err := q.Clone().Offset(offset).Limit(exportBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		if len(nodes) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// importBatch creates the given batch of User entities using a single bulk operation.
+func (c *UserClient) importBatch(ctx context.Context, nodes []*User) ([]*User, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	builders := make([]*UserCreate, len(nodes))
+	for idx, _node := range nodes {
+		builders[idx] = c.Create()
+		if !exportIsZero(_node.OptionalInt) {
+			builders[idx].SetOptionalInt(_node.OptionalInt)
+		}
+		builders[idx].SetAge(_node.Age)
+		builders[idx].SetName(_node.Name)
+		builders[idx].SetLast(_node.Last)
+		if !exportIsZero(_node.Nickname) {
+			builders[idx].SetNickname(_node.Nickname)
+		}
+		builders[idx].SetAddress(_node.Address)
+		if !exportIsZero(_node.Phone) {
+			builders[idx].SetPhone(_node.Phone)
+		}
+		if !exportIsZero(_node.Password) {
+			builders[idx].SetPassword(_node.Password)
+		}
+		builders[idx].SetRole(_node.Role)
+		builders[idx].SetEmployment(_node.Employment)
+		if !exportIsZero(_node.SSOCert) {
+			builders[idx].SetSSOCert(_node.SSOCert)
+		}
+		if !exportIsZero(_node.FilesCount) {
+			builders[idx].SetFilesCount(_node.FilesCount)
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
+// csvEncode returns the CSV representation of a field value.
+func csvEncode(v any) (string, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return "", nil
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v), nil
+	case encoding.TextMarshaler:
+		b, err := v.MarshalText()
+		return string(b), err
+	}
+	switch {
+	case rv.Kind() == reflect.Ptr:
+		return csvEncode(rv.Elem().Interface())
+	case rv.Kind() == reflect.String:
+		return rv.String(), nil
+	}
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+// csvDecode decodes the CSV representation of a field value into v.
+func csvDecode(s string, v any) error {
+	if s == "" {
+		return nil
+	}
+	switch v := v.(type) {
+	case *string:
+		*v = s
+		return nil
+	case *[]byte:
+		b, err := base64.StdEncoding.DecodeString(s)
+		*v = b
+		return err
+	case encoding.TextUnmarshaler:
+		return v.UnmarshalText([]byte(s))
+	}
+	if rv := reflect.ValueOf(v).Elem(); rv.Kind() == reflect.String {
+		rv.SetString(s)
+		return nil
+	}
+	return json.Unmarshal([]byte(s), v)
+}
+
+// exportIsZero reports if v holds the zero value of its type.
+func exportIsZero(v any) bool {
+	return reflect.ValueOf(v).IsZero()
+}
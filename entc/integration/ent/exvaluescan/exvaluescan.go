@@ -7,6 +7,7 @@
 package exvaluescan
 
 import (
+	"fmt"
 	"math/big"
 	"net/url"
 
@@ -115,4 +116,93 @@ func ByCustomOptional(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCustomOptional, opts...).ToFunc()
 }
 
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that ExValueScan queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that ExValueScan queries can be ordered by.
+const (
+	OrderFieldID             OrderField = "id"
+	OrderFieldBinary         OrderField = "binary"
+	OrderFieldBinaryOptional OrderField = "binary_optional"
+	OrderFieldText           OrderField = "text"
+	OrderFieldTextOptional   OrderField = "text_optional"
+	OrderFieldBase64         OrderField = "base64"
+	OrderFieldCustom         OrderField = "custom"
+	OrderFieldCustomOptional OrderField = "custom_optional"
+)
+
+// OrderFields holds all fields and edge counts that ExValueScan queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldBinary,
+	OrderFieldBinaryOptional,
+	OrderFieldText,
+	OrderFieldTextOptional,
+	OrderFieldBase64,
+	OrderFieldCustom,
+	OrderFieldCustomOptional,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if ExValueScan queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("exvaluescan: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := exvaluescan.OrderByField(exvaluescan.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.ExValueScan.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldBinary:
+		return ByBinary(opts...), nil
+	case OrderFieldBinaryOptional:
+		return ByBinaryOptional(opts...), nil
+	case OrderFieldText:
+		return ByText(opts...), nil
+	case OrderFieldTextOptional:
+		return ByTextOptional(opts...), nil
+	case OrderFieldBase64:
+		return ByBase64(opts...), nil
+	case OrderFieldCustom:
+		return ByCustom(opts...), nil
+	case OrderFieldCustomOptional:
+		return ByCustomOptional(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 // comment from another template.
@@ -216,6 +216,16 @@ func BinaryContainsFold(v *url.URL) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldBinary, vcs), err)
 }
 
+// BinaryHasPrefixFold applies the HasPrefixFold predicate on the "binary" field.
+func BinaryHasPrefixFold(v *url.URL) predicate.ExValueScan {
+	vc, err := ValueScanner.Binary.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("binary value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldBinary, vcs), err)
+}
+
 // BinaryOptionalEQ applies the EQ predicate on the "binary_optional" field.
 func BinaryOptionalEQ(v *url.URL) predicate.ExValueScan {
 	vc, err := ValueScanner.BinaryOptional.Value(v)
@@ -340,6 +350,16 @@ func BinaryOptionalContainsFold(v *url.URL) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldBinaryOptional, vcs), err)
 }
 
+// BinaryOptionalHasPrefixFold applies the HasPrefixFold predicate on the "binary_optional" field.
+func BinaryOptionalHasPrefixFold(v *url.URL) predicate.ExValueScan {
+	vc, err := ValueScanner.BinaryOptional.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("binary_optional value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldBinaryOptional, vcs), err)
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v *big.Int) predicate.ExValueScan {
 	vc, err := ValueScanner.Text.Value(v)
@@ -454,6 +474,16 @@ func TextContainsFold(v *big.Int) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldText, vcs), err)
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v *big.Int) predicate.ExValueScan {
+	vc, err := ValueScanner.Text.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("text value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldText, vcs), err)
+}
+
 // TextOptionalEQ applies the EQ predicate on the "text_optional" field.
 func TextOptionalEQ(v *big.Int) predicate.ExValueScan {
 	vc, err := ValueScanner.TextOptional.Value(v)
@@ -578,6 +608,16 @@ func TextOptionalContainsFold(v *big.Int) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldTextOptional, vcs), err)
 }
 
+// TextOptionalHasPrefixFold applies the HasPrefixFold predicate on the "text_optional" field.
+func TextOptionalHasPrefixFold(v *big.Int) predicate.ExValueScan {
+	vc, err := ValueScanner.TextOptional.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("text_optional value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldTextOptional, vcs), err)
+}
+
 // Base64EQ applies the EQ predicate on the "base64" field.
 func Base64EQ(v string) predicate.ExValueScan {
 	vc, err := ValueScanner.Base64.Value(v)
@@ -692,6 +732,16 @@ func Base64ContainsFold(v string) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldBase64, vcs), err)
 }
 
+// Base64HasPrefixFold applies the HasPrefixFold predicate on the "base64" field.
+func Base64HasPrefixFold(v string) predicate.ExValueScan {
+	vc, err := ValueScanner.Base64.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("base64 value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldBase64, vcs), err)
+}
+
 // CustomEQ applies the EQ predicate on the "custom" field.
 func CustomEQ(v string) predicate.ExValueScan {
 	vc, err := ValueScanner.Custom.Value(v)
@@ -806,6 +856,16 @@ func CustomContainsFold(v string) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldCustom, vcs), err)
 }
 
+// CustomHasPrefixFold applies the HasPrefixFold predicate on the "custom" field.
+func CustomHasPrefixFold(v string) predicate.ExValueScan {
+	vc, err := ValueScanner.Custom.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("custom value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldCustom, vcs), err)
+}
+
 // CustomOptionalEQ applies the EQ predicate on the "custom_optional" field.
 func CustomOptionalEQ(v string) predicate.ExValueScan {
 	vc, err := ValueScanner.CustomOptional.Value(v)
@@ -930,6 +990,16 @@ func CustomOptionalContainsFold(v string) predicate.ExValueScan {
 	return predicate.ExValueScanOrErr(sql.FieldContainsFold(FieldCustomOptional, vcs), err)
 }
 
+// CustomOptionalHasPrefixFold applies the HasPrefixFold predicate on the "custom_optional" field.
+func CustomOptionalHasPrefixFold(v string) predicate.ExValueScan {
+	vc, err := ValueScanner.CustomOptional.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("custom_optional value is not a string: %T", vc)
+	}
+	return predicate.ExValueScanOrErr(sql.FieldHasPrefixFold(FieldCustomOptional, vcs), err)
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ExValueScan) predicate.ExValueScan {
 	return predicate.ExValueScan(func(s *sql.Selector) {
@@ -111,19 +111,20 @@ func (evsc *ExValueScanCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (evsc *ExValueScanCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := evsc.mutation.Binary(); !ok {
-		return &ValidationError{Name: "binary", err: errors.New(`ent: missing required field "ExValueScan.binary"`)}
+		errs = append(errs, &ValidationError{Name: "binary", Entity: "ExValueScan", Field: "binary", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.binary"`)})
 	}
 	if _, ok := evsc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ExValueScan.text"`)}
+		errs = append(errs, &ValidationError{Name: "text", Entity: "ExValueScan", Field: "text", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.text"`)})
 	}
 	if _, ok := evsc.mutation.Base64(); !ok {
-		return &ValidationError{Name: "base64", err: errors.New(`ent: missing required field "ExValueScan.base64"`)}
+		errs = append(errs, &ValidationError{Name: "base64", Entity: "ExValueScan", Field: "base64", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.base64"`)})
 	}
 	if _, ok := evsc.mutation.Custom(); !ok {
-		return &ValidationError{Name: "custom", err: errors.New(`ent: missing required field "ExValueScan.custom"`)}
+		errs = append(errs, &ValidationError{Name: "custom", Entity: "ExValueScan", Field: "custom", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.custom"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (evsc *ExValueScanCreate) sqlSave(ctx context.Context) (*ExValueScan, error) {
@@ -136,7 +137,7 @@ func (evsc *ExValueScanCreate) sqlSave(ctx context.Context) (*ExValueScan, error
 	}
 	if err := sqlgraph.CreateNode(ctx, evsc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -273,6 +274,12 @@ func (u *ExValueScanUpsert) UpdateBinary() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreBinary keeps the current value of the "binary" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreBinary() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldBinary)
+	return u
+}
+
 // SetBinaryOptional sets the "binary_optional" field.
 func (u *ExValueScanUpsert) SetBinaryOptional(v *url.URL) *ExValueScanUpsert {
 	u.Set(exvaluescan.FieldBinaryOptional, v)
@@ -285,6 +292,12 @@ func (u *ExValueScanUpsert) UpdateBinaryOptional() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreBinaryOptional keeps the current value of the "binary_optional" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreBinaryOptional() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldBinaryOptional)
+	return u
+}
+
 // ClearBinaryOptional clears the value of the "binary_optional" field.
 func (u *ExValueScanUpsert) ClearBinaryOptional() *ExValueScanUpsert {
 	u.SetNull(exvaluescan.FieldBinaryOptional)
@@ -303,6 +316,12 @@ func (u *ExValueScanUpsert) UpdateText() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreText() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldText)
+	return u
+}
+
 // SetTextOptional sets the "text_optional" field.
 func (u *ExValueScanUpsert) SetTextOptional(v *big.Int) *ExValueScanUpsert {
 	u.Set(exvaluescan.FieldTextOptional, v)
@@ -315,6 +334,12 @@ func (u *ExValueScanUpsert) UpdateTextOptional() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreTextOptional keeps the current value of the "text_optional" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreTextOptional() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldTextOptional)
+	return u
+}
+
 // ClearTextOptional clears the value of the "text_optional" field.
 func (u *ExValueScanUpsert) ClearTextOptional() *ExValueScanUpsert {
 	u.SetNull(exvaluescan.FieldTextOptional)
@@ -333,6 +358,12 @@ func (u *ExValueScanUpsert) UpdateBase64() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreBase64 keeps the current value of the "base64" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreBase64() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldBase64)
+	return u
+}
+
 // SetCustom sets the "custom" field.
 func (u *ExValueScanUpsert) SetCustom(v string) *ExValueScanUpsert {
 	u.Set(exvaluescan.FieldCustom, v)
@@ -345,6 +376,12 @@ func (u *ExValueScanUpsert) UpdateCustom() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreCustom keeps the current value of the "custom" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreCustom() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldCustom)
+	return u
+}
+
 // SetCustomOptional sets the "custom_optional" field.
 func (u *ExValueScanUpsert) SetCustomOptional(v string) *ExValueScanUpsert {
 	u.Set(exvaluescan.FieldCustomOptional, v)
@@ -357,6 +394,12 @@ func (u *ExValueScanUpsert) UpdateCustomOptional() *ExValueScanUpsert {
 	return u
 }
 
+// IgnoreCustomOptional keeps the current value of the "custom_optional" field in case of conflict.
+func (u *ExValueScanUpsert) IgnoreCustomOptional() *ExValueScanUpsert {
+	u.SetIgnore(exvaluescan.FieldCustomOptional)
+	return u
+}
+
 // ClearCustomOptional clears the value of the "custom_optional" field.
 func (u *ExValueScanUpsert) ClearCustomOptional() *ExValueScanUpsert {
 	u.SetNull(exvaluescan.FieldCustomOptional)
@@ -417,6 +460,14 @@ func (u *ExValueScanUpsertOne) UpdateBinary() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreBinary keeps the current value of the "binary" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreBinary() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreBinary()
+	})
+}
+
 // SetBinaryOptional sets the "binary_optional" field.
 func (u *ExValueScanUpsertOne) SetBinaryOptional(v *url.URL) *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -431,6 +482,14 @@ func (u *ExValueScanUpsertOne) UpdateBinaryOptional() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreBinaryOptional keeps the current value of the "binary_optional" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreBinaryOptional() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreBinaryOptional()
+	})
+}
+
 // ClearBinaryOptional clears the value of the "binary_optional" field.
 func (u *ExValueScanUpsertOne) ClearBinaryOptional() *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -452,6 +511,14 @@ func (u *ExValueScanUpsertOne) UpdateText() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreText() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // SetTextOptional sets the "text_optional" field.
 func (u *ExValueScanUpsertOne) SetTextOptional(v *big.Int) *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -466,6 +533,14 @@ func (u *ExValueScanUpsertOne) UpdateTextOptional() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreTextOptional keeps the current value of the "text_optional" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreTextOptional() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreTextOptional()
+	})
+}
+
 // ClearTextOptional clears the value of the "text_optional" field.
 func (u *ExValueScanUpsertOne) ClearTextOptional() *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -487,6 +562,14 @@ func (u *ExValueScanUpsertOne) UpdateBase64() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreBase64 keeps the current value of the "base64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreBase64() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreBase64()
+	})
+}
+
 // SetCustom sets the "custom" field.
 func (u *ExValueScanUpsertOne) SetCustom(v string) *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -501,6 +584,14 @@ func (u *ExValueScanUpsertOne) UpdateCustom() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreCustom keeps the current value of the "custom" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreCustom() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreCustom()
+	})
+}
+
 // SetCustomOptional sets the "custom_optional" field.
 func (u *ExValueScanUpsertOne) SetCustomOptional(v string) *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -515,6 +606,14 @@ func (u *ExValueScanUpsertOne) UpdateCustomOptional() *ExValueScanUpsertOne {
 	})
 }
 
+// IgnoreCustomOptional keeps the current value of the "custom_optional" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertOne) IgnoreCustomOptional() *ExValueScanUpsertOne {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreCustomOptional()
+	})
+}
+
 // ClearCustomOptional clears the value of the "custom_optional" field.
 func (u *ExValueScanUpsertOne) ClearCustomOptional() *ExValueScanUpsertOne {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -558,12 +657,16 @@ func (u *ExValueScanUpsertOne) IDX(ctx context.Context) int {
 // ExValueScanCreateBulk is the builder for creating many ExValueScan entities in bulk.
 type ExValueScanCreateBulk struct {
 	config
+	err      error
 	builders []*ExValueScanCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the ExValueScan entities in the database.
 func (evscb *ExValueScanCreateBulk) Save(ctx context.Context) ([]*ExValueScan, error) {
+	if evscb.err != nil {
+		return nil, evscb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(evscb.builders))
 	nodes := make([]*ExValueScan, len(evscb.builders))
 	mutators := make([]Mutator, len(evscb.builders))
@@ -592,7 +695,7 @@ func (evscb *ExValueScanCreateBulk) Save(ctx context.Context) ([]*ExValueScan, e
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, evscb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -738,6 +841,14 @@ func (u *ExValueScanUpsertBulk) UpdateBinary() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreBinary keeps the current value of the "binary" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreBinary() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreBinary()
+	})
+}
+
 // SetBinaryOptional sets the "binary_optional" field.
 func (u *ExValueScanUpsertBulk) SetBinaryOptional(v *url.URL) *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -752,6 +863,14 @@ func (u *ExValueScanUpsertBulk) UpdateBinaryOptional() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreBinaryOptional keeps the current value of the "binary_optional" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreBinaryOptional() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreBinaryOptional()
+	})
+}
+
 // ClearBinaryOptional clears the value of the "binary_optional" field.
 func (u *ExValueScanUpsertBulk) ClearBinaryOptional() *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -773,6 +892,14 @@ func (u *ExValueScanUpsertBulk) UpdateText() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreText() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // SetTextOptional sets the "text_optional" field.
 func (u *ExValueScanUpsertBulk) SetTextOptional(v *big.Int) *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -787,6 +914,14 @@ func (u *ExValueScanUpsertBulk) UpdateTextOptional() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreTextOptional keeps the current value of the "text_optional" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreTextOptional() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreTextOptional()
+	})
+}
+
 // ClearTextOptional clears the value of the "text_optional" field.
 func (u *ExValueScanUpsertBulk) ClearTextOptional() *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -808,6 +943,14 @@ func (u *ExValueScanUpsertBulk) UpdateBase64() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreBase64 keeps the current value of the "base64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreBase64() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreBase64()
+	})
+}
+
 // SetCustom sets the "custom" field.
 func (u *ExValueScanUpsertBulk) SetCustom(v string) *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -822,6 +965,14 @@ func (u *ExValueScanUpsertBulk) UpdateCustom() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreCustom keeps the current value of the "custom" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreCustom() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreCustom()
+	})
+}
+
 // SetCustomOptional sets the "custom_optional" field.
 func (u *ExValueScanUpsertBulk) SetCustomOptional(v string) *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
@@ -836,6 +987,14 @@ func (u *ExValueScanUpsertBulk) UpdateCustomOptional() *ExValueScanUpsertBulk {
 	})
 }
 
+// IgnoreCustomOptional keeps the current value of the "custom_optional" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ExValueScanUpsertBulk) IgnoreCustomOptional() *ExValueScanUpsertBulk {
+	return u.Update(func(s *ExValueScanUpsert) {
+		s.IgnoreCustomOptional()
+	})
+}
+
 // ClearCustomOptional clears the value of the "custom_optional" field.
 func (u *ExValueScanUpsertBulk) ClearCustomOptional() *ExValueScanUpsertBulk {
 	return u.Update(func(s *ExValueScanUpsert) {
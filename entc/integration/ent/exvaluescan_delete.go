@@ -19,8 +19,9 @@ import (
 // ExValueScanDelete is the builder for deleting a ExValueScan entity.
 type ExValueScanDelete struct {
 	config
-	hooks    []Hook
-	mutation *ExValueScanMutation
+	hooks     []Hook
+	mutation  *ExValueScanMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the ExValueScanDelete builder.
@@ -43,8 +44,21 @@ func (evsd *ExValueScanDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (evsd *ExValueScanDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *ExValueScanDelete {
+	evsd.modifiers = append(evsd.modifiers, modifiers...)
+	return evsd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (evsdo *ExValueScanDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *ExValueScanDeleteOne {
+	evsdo.evsd.Modify(modifiers...)
+	return evsdo
+}
+
 func (evsd *ExValueScanDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(exvaluescan.Table, sqlgraph.NewFieldSpec(exvaluescan.FieldID, field.TypeInt))
+	_spec.AddModifiers(evsd.modifiers...)
 	if ps := evsd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (evsd *ExValueScanDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, evsd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	evsd.mutation.done = true
 	return affected, err
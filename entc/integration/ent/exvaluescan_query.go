@@ -322,7 +322,7 @@ func (evsq *ExValueScanQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range evsq.ctx.Fields {
 		if !exvaluescan.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "ExValueScan", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if evsq.path != nil {
@@ -482,10 +482,53 @@ func (evsq *ExValueScanQuery) Modify(modifiers ...func(s *sql.Selector)) *ExValu
 	return evsq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (evsq *ExValueScanQuery) Union(queries ...*ExValueScanQuery) *ExValueScanQuery {
+	return evsq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (evsq *ExValueScanQuery) UnionAll(queries ...*ExValueScanQuery) *ExValueScanQuery {
+	return evsq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (evsq *ExValueScanQuery) Intersect(queries ...*ExValueScanQuery) *ExValueScanQuery {
+	return evsq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (evsq *ExValueScanQuery) Except(queries ...*ExValueScanQuery) *ExValueScanQuery {
+	return evsq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (evsq *ExValueScanQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*ExValueScanQuery) *ExValueScanQuery {
+	evsq.modifiers = append(evsq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return evsq
+}
+
 // ExValueScanGroupBy is the group-by builder for ExValueScan entities.
 type ExValueScanGroupBy struct {
 	selector
-	build *ExValueScanQuery
+	build  *ExValueScanQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,6 +546,14 @@ func (evsgb *ExValueScanGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*ExValueScanQuery, *ExValueScanGroupBy](ctx, evsgb.build, evsgb, evsgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (evsgb *ExValueScanGroupBy) Having(ps ...*sql.Predicate) *ExValueScanGroupBy {
+	evsgb.having = append(evsgb.having, ps...)
+	return evsgb
+}
+
 func (evsgb *ExValueScanGroupBy) sqlScan(ctx context.Context, root *ExValueScanQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(evsgb.fns))
@@ -518,6 +569,9 @@ func (evsgb *ExValueScanGroupBy) sqlScan(ctx context.Context, root *ExValueScanQ
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*evsgb.flds...)...)
+	if len(evsgb.having) > 0 {
+		selector.Having(sql.And(evsgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -636,6 +636,295 @@ func ByPasswordOther(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPasswordOther, opts...).ToFunc()
 }
 
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that FieldType queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that FieldType queries can be ordered by.
+const (
+	OrderFieldID                    OrderField = "id"
+	OrderFieldInt                   OrderField = "int"
+	OrderFieldInt8                  OrderField = "int8"
+	OrderFieldInt16                 OrderField = "int16"
+	OrderFieldInt32                 OrderField = "int32"
+	OrderFieldInt64                 OrderField = "int64"
+	OrderFieldOptionalInt           OrderField = "optional_int"
+	OrderFieldOptionalInt8          OrderField = "optional_int8"
+	OrderFieldOptionalInt16         OrderField = "optional_int16"
+	OrderFieldOptionalInt32         OrderField = "optional_int32"
+	OrderFieldOptionalInt64         OrderField = "optional_int64"
+	OrderFieldNillableInt           OrderField = "nillable_int"
+	OrderFieldNillableInt8          OrderField = "nillable_int8"
+	OrderFieldNillableInt16         OrderField = "nillable_int16"
+	OrderFieldNillableInt32         OrderField = "nillable_int32"
+	OrderFieldNillableInt64         OrderField = "nillable_int64"
+	OrderFieldValidateOptionalInt32 OrderField = "validate_optional_int32"
+	OrderFieldOptionalUint          OrderField = "optional_uint"
+	OrderFieldOptionalUint8         OrderField = "optional_uint8"
+	OrderFieldOptionalUint16        OrderField = "optional_uint16"
+	OrderFieldOptionalUint32        OrderField = "optional_uint32"
+	OrderFieldOptionalUint64        OrderField = "optional_uint64"
+	OrderFieldState                 OrderField = "state"
+	OrderFieldOptionalFloat         OrderField = "optional_float"
+	OrderFieldOptionalFloat32       OrderField = "optional_float32"
+	OrderFieldText                  OrderField = "text"
+	OrderFieldDatetime              OrderField = "datetime"
+	OrderFieldDecimal               OrderField = "decimal"
+	OrderFieldLinkOther             OrderField = "link_other"
+	OrderFieldLinkOtherFunc         OrderField = "link_other_func"
+	OrderFieldMAC                   OrderField = "mac"
+	OrderFieldStringArray           OrderField = "string_array"
+	OrderFieldStringScanner         OrderField = "string_scanner"
+	OrderFieldDuration              OrderField = "duration"
+	OrderFieldDir                   OrderField = "dir"
+	OrderFieldNdir                  OrderField = "ndir"
+	OrderFieldStr                   OrderField = "str"
+	OrderFieldNullStr               OrderField = "null_str"
+	OrderFieldLink                  OrderField = "link"
+	OrderFieldNullLink              OrderField = "null_link"
+	OrderFieldActive                OrderField = "active"
+	OrderFieldNullActive            OrderField = "null_active"
+	OrderFieldDeleted               OrderField = "deleted"
+	OrderFieldDeletedAt             OrderField = "deleted_at"
+	OrderFieldNullInt64             OrderField = "null_int64"
+	OrderFieldSchemaInt             OrderField = "schema_int"
+	OrderFieldSchemaInt8            OrderField = "schema_int8"
+	OrderFieldSchemaInt64           OrderField = "schema_int64"
+	OrderFieldSchemaFloat           OrderField = "schema_float"
+	OrderFieldSchemaFloat32         OrderField = "schema_float32"
+	OrderFieldNullFloat             OrderField = "null_float"
+	OrderFieldRole                  OrderField = "role"
+	OrderFieldPriority              OrderField = "priority"
+	OrderFieldOptionalUUID          OrderField = "optional_uuid"
+	OrderFieldNillableUUID          OrderField = "nillable_uuid"
+	OrderFieldVstring               OrderField = "vstring"
+	OrderFieldTriple                OrderField = "triple"
+	OrderFieldBigInt                OrderField = "big_int"
+)
+
+// OrderFields holds all fields and edge counts that FieldType queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldInt,
+	OrderFieldInt8,
+	OrderFieldInt16,
+	OrderFieldInt32,
+	OrderFieldInt64,
+	OrderFieldOptionalInt,
+	OrderFieldOptionalInt8,
+	OrderFieldOptionalInt16,
+	OrderFieldOptionalInt32,
+	OrderFieldOptionalInt64,
+	OrderFieldNillableInt,
+	OrderFieldNillableInt8,
+	OrderFieldNillableInt16,
+	OrderFieldNillableInt32,
+	OrderFieldNillableInt64,
+	OrderFieldValidateOptionalInt32,
+	OrderFieldOptionalUint,
+	OrderFieldOptionalUint8,
+	OrderFieldOptionalUint16,
+	OrderFieldOptionalUint32,
+	OrderFieldOptionalUint64,
+	OrderFieldState,
+	OrderFieldOptionalFloat,
+	OrderFieldOptionalFloat32,
+	OrderFieldText,
+	OrderFieldDatetime,
+	OrderFieldDecimal,
+	OrderFieldLinkOther,
+	OrderFieldLinkOtherFunc,
+	OrderFieldMAC,
+	OrderFieldStringArray,
+	OrderFieldStringScanner,
+	OrderFieldDuration,
+	OrderFieldDir,
+	OrderFieldNdir,
+	OrderFieldStr,
+	OrderFieldNullStr,
+	OrderFieldLink,
+	OrderFieldNullLink,
+	OrderFieldActive,
+	OrderFieldNullActive,
+	OrderFieldDeleted,
+	OrderFieldDeletedAt,
+	OrderFieldNullInt64,
+	OrderFieldSchemaInt,
+	OrderFieldSchemaInt8,
+	OrderFieldSchemaInt64,
+	OrderFieldSchemaFloat,
+	OrderFieldSchemaFloat32,
+	OrderFieldNullFloat,
+	OrderFieldRole,
+	OrderFieldPriority,
+	OrderFieldOptionalUUID,
+	OrderFieldNillableUUID,
+	OrderFieldVstring,
+	OrderFieldTriple,
+	OrderFieldBigInt,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if FieldType queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("fieldtype: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := fieldtype.OrderByField(fieldtype.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.FieldType.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldInt:
+		return ByInt(opts...), nil
+	case OrderFieldInt8:
+		return ByInt8(opts...), nil
+	case OrderFieldInt16:
+		return ByInt16(opts...), nil
+	case OrderFieldInt32:
+		return ByInt32(opts...), nil
+	case OrderFieldInt64:
+		return ByInt64(opts...), nil
+	case OrderFieldOptionalInt:
+		return ByOptionalInt(opts...), nil
+	case OrderFieldOptionalInt8:
+		return ByOptionalInt8(opts...), nil
+	case OrderFieldOptionalInt16:
+		return ByOptionalInt16(opts...), nil
+	case OrderFieldOptionalInt32:
+		return ByOptionalInt32(opts...), nil
+	case OrderFieldOptionalInt64:
+		return ByOptionalInt64(opts...), nil
+	case OrderFieldNillableInt:
+		return ByNillableInt(opts...), nil
+	case OrderFieldNillableInt8:
+		return ByNillableInt8(opts...), nil
+	case OrderFieldNillableInt16:
+		return ByNillableInt16(opts...), nil
+	case OrderFieldNillableInt32:
+		return ByNillableInt32(opts...), nil
+	case OrderFieldNillableInt64:
+		return ByNillableInt64(opts...), nil
+	case OrderFieldValidateOptionalInt32:
+		return ByValidateOptionalInt32(opts...), nil
+	case OrderFieldOptionalUint:
+		return ByOptionalUint(opts...), nil
+	case OrderFieldOptionalUint8:
+		return ByOptionalUint8(opts...), nil
+	case OrderFieldOptionalUint16:
+		return ByOptionalUint16(opts...), nil
+	case OrderFieldOptionalUint32:
+		return ByOptionalUint32(opts...), nil
+	case OrderFieldOptionalUint64:
+		return ByOptionalUint64(opts...), nil
+	case OrderFieldState:
+		return ByState(opts...), nil
+	case OrderFieldOptionalFloat:
+		return ByOptionalFloat(opts...), nil
+	case OrderFieldOptionalFloat32:
+		return ByOptionalFloat32(opts...), nil
+	case OrderFieldText:
+		return ByText(opts...), nil
+	case OrderFieldDatetime:
+		return ByDatetime(opts...), nil
+	case OrderFieldDecimal:
+		return ByDecimal(opts...), nil
+	case OrderFieldLinkOther:
+		return ByLinkOther(opts...), nil
+	case OrderFieldLinkOtherFunc:
+		return ByLinkOtherFunc(opts...), nil
+	case OrderFieldMAC:
+		return ByMAC(opts...), nil
+	case OrderFieldStringArray:
+		return ByStringArray(opts...), nil
+	case OrderFieldStringScanner:
+		return ByStringScanner(opts...), nil
+	case OrderFieldDuration:
+		return ByDuration(opts...), nil
+	case OrderFieldDir:
+		return ByDir(opts...), nil
+	case OrderFieldNdir:
+		return ByNdir(opts...), nil
+	case OrderFieldStr:
+		return ByStr(opts...), nil
+	case OrderFieldNullStr:
+		return ByNullStr(opts...), nil
+	case OrderFieldLink:
+		return ByLink(opts...), nil
+	case OrderFieldNullLink:
+		return ByNullLink(opts...), nil
+	case OrderFieldActive:
+		return ByActive(opts...), nil
+	case OrderFieldNullActive:
+		return ByNullActive(opts...), nil
+	case OrderFieldDeleted:
+		return ByDeleted(opts...), nil
+	case OrderFieldDeletedAt:
+		return ByDeletedAt(opts...), nil
+	case OrderFieldNullInt64:
+		return ByNullInt64(opts...), nil
+	case OrderFieldSchemaInt:
+		return BySchemaInt(opts...), nil
+	case OrderFieldSchemaInt8:
+		return BySchemaInt8(opts...), nil
+	case OrderFieldSchemaInt64:
+		return BySchemaInt64(opts...), nil
+	case OrderFieldSchemaFloat:
+		return BySchemaFloat(opts...), nil
+	case OrderFieldSchemaFloat32:
+		return BySchemaFloat32(opts...), nil
+	case OrderFieldNullFloat:
+		return ByNullFloat(opts...), nil
+	case OrderFieldRole:
+		return ByRole(opts...), nil
+	case OrderFieldPriority:
+		return ByPriority(opts...), nil
+	case OrderFieldOptionalUUID:
+		return ByOptionalUUID(opts...), nil
+	case OrderFieldNillableUUID:
+		return ByNillableUUID(opts...), nil
+	case OrderFieldVstring:
+		return ByVstring(opts...), nil
+	case OrderFieldTriple:
+		return ByTriple(opts...), nil
+	case OrderFieldBigInt:
+		return ByBigInt(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 // Ptr returns a new pointer to the enum value.
 func (s State) Ptr() *State {
 	return &s
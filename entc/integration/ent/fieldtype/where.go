@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
@@ -1584,6 +1585,11 @@ func TextContainsFold(v string) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.FieldType {
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // DatetimeEQ applies the EQ predicate on the "datetime" field.
 func DatetimeEQ(v time.Time) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldDatetime, v))
@@ -1864,6 +1870,12 @@ func MACContainsFold(v schema.MAC) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldMAC, vc))
 }
 
+// MACHasPrefixFold applies the HasPrefixFold predicate on the "mac" field.
+func MACHasPrefixFold(v schema.MAC) predicate.FieldType {
+	vc := v.String()
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldMAC, vc))
+}
+
 // StringArrayEQ applies the EQ predicate on the "string_array" field.
 func StringArrayEQ(v schema.Strings) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldStringArray, v))
@@ -1989,6 +2001,11 @@ func PasswordContainsFold(v string) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// PasswordHasPrefixFold applies the HasPrefixFold predicate on the "password" field.
+func PasswordHasPrefixFold(v string) predicate.FieldType {
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldPassword, v))
+}
+
 // StringScannerEQ applies the EQ predicate on the "string_scanner" field.
 func StringScannerEQ(v schema.StringScanner) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldStringScanner, v))
@@ -2069,6 +2086,12 @@ func StringScannerContainsFold(v schema.StringScanner) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldStringScanner, vc))
 }
 
+// StringScannerHasPrefixFold applies the HasPrefixFold predicate on the "string_scanner" field.
+func StringScannerHasPrefixFold(v schema.StringScanner) predicate.FieldType {
+	vc := string(v)
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldStringScanner, vc))
+}
+
 // DurationEQ applies the EQ predicate on the "duration" field.
 func DurationEQ(v time.Duration) predicate.FieldType {
 	vc := int64(v)
@@ -2217,6 +2240,12 @@ func DirContainsFold(v http.Dir) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldDir, vc))
 }
 
+// DirHasPrefixFold applies the HasPrefixFold predicate on the "dir" field.
+func DirHasPrefixFold(v http.Dir) predicate.FieldType {
+	vc := string(v)
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldDir, vc))
+}
+
 // NdirEQ applies the EQ predicate on the "ndir" field.
 func NdirEQ(v http.Dir) predicate.FieldType {
 	vc := string(v)
@@ -2311,6 +2340,12 @@ func NdirContainsFold(v http.Dir) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldNdir, vc))
 }
 
+// NdirHasPrefixFold applies the HasPrefixFold predicate on the "ndir" field.
+func NdirHasPrefixFold(v http.Dir) predicate.FieldType {
+	vc := string(v)
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldNdir, vc))
+}
+
 // StrEQ applies the EQ predicate on the "str" field.
 func StrEQ(v sql.NullString) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldStr, v))
@@ -2391,6 +2426,12 @@ func StrContainsFold(v sql.NullString) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldStr, vc))
 }
 
+// StrHasPrefixFold applies the HasPrefixFold predicate on the "str" field.
+func StrHasPrefixFold(v sql.NullString) predicate.FieldType {
+	vc := v.String
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldStr, vc))
+}
+
 // NullStrEQ applies the EQ predicate on the "null_str" field.
 func NullStrEQ(v *sql.NullString) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldNullStr, v))
@@ -2471,6 +2512,12 @@ func NullStrContainsFold(v *sql.NullString) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldNullStr, vc))
 }
 
+// NullStrHasPrefixFold applies the HasPrefixFold predicate on the "null_str" field.
+func NullStrHasPrefixFold(v *sql.NullString) predicate.FieldType {
+	vc := v.String
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldNullStr, vc))
+}
+
 // LinkEQ applies the EQ predicate on the "link" field.
 func LinkEQ(v schema.Link) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldLink, v))
@@ -2551,6 +2598,12 @@ func LinkContainsFold(v schema.Link) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldLink, vc))
 }
 
+// LinkHasPrefixFold applies the HasPrefixFold predicate on the "link" field.
+func LinkHasPrefixFold(v schema.Link) predicate.FieldType {
+	vc := v.String()
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldLink, vc))
+}
+
 // NullLinkEQ applies the EQ predicate on the "null_link" field.
 func NullLinkEQ(v *schema.Link) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldNullLink, v))
@@ -2631,6 +2684,12 @@ func NullLinkContainsFold(v *schema.Link) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldNullLink, vc))
 }
 
+// NullLinkHasPrefixFold applies the HasPrefixFold predicate on the "null_link" field.
+func NullLinkHasPrefixFold(v *schema.Link) predicate.FieldType {
+	vc := v.String()
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldNullLink, vc))
+}
+
 // ActiveEQ applies the EQ predicate on the "active" field.
 func ActiveEQ(v schema.Status) predicate.FieldType {
 	vc := bool(v)
@@ -3659,6 +3718,12 @@ func VstringContainsFold(v schema.VString) predicate.FieldType {
 	return predicate.FieldType(sql.FieldContainsFold(FieldVstring, vc))
 }
 
+// VstringHasPrefixFold applies the HasPrefixFold predicate on the "vstring" field.
+func VstringHasPrefixFold(v schema.VString) predicate.FieldType {
+	vc := string(v)
+	return predicate.FieldType(sql.FieldHasPrefixFold(FieldVstring, vc))
+}
+
 // TripleEQ applies the EQ predicate on the "triple" field.
 func TripleEQ(v schema.Triple) predicate.FieldType {
 	return predicate.FieldType(sql.FieldEQ(FieldTriple, v))
@@ -3799,6 +3864,36 @@ func PasswordOtherNotNil() predicate.FieldType {
 	return predicate.FieldType(sql.FieldNotNull(FieldPasswordOther))
 }
 
+// DatetimeWithinLast applies the WithinLast predicate on the "datetime" field.
+// It matches values that are within the given duration before the current time.
+func DatetimeWithinLast(d time.Duration) predicate.FieldType {
+	return predicate.FieldType(sql.FieldWithinLast(FieldDatetime, d))
+}
+
+// StringsHasKey applies the HasKey predicate on the "strings" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func StringsHasKey(path ...string) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldStrings), sqljson.Path(path...)))
+	})
+}
+
+// StringsValueEQ checks that the value at the given path of the
+// "strings" JSON field is equal to v.
+func StringsValueEQ(v any, path ...string) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldStrings), v, sqljson.Path(path...)))
+	})
+}
+
+// StringsContains checks that the value at the given path of the "strings"
+// JSON field contains v. e.g. an array that contains the element v.
+func StringsContains(v any, path ...string) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldStrings), v, sqljson.Path(path...)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FieldType) predicate.FieldType {
 	return predicate.FieldType(func(s *sql.Selector) {
@@ -872,82 +872,83 @@ func (ftc *FieldTypeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftc *FieldTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Int(); !ok {
-		return &ValidationError{Name: "int", err: errors.New(`ent: missing required field "FieldType.int"`)}
+		errs = append(errs, &ValidationError{Name: "int", Entity: "FieldType", Field: "int", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int"`)})
 	}
 	if _, ok := ftc.mutation.Int8(); !ok {
-		return &ValidationError{Name: "int8", err: errors.New(`ent: missing required field "FieldType.int8"`)}
+		errs = append(errs, &ValidationError{Name: "int8", Entity: "FieldType", Field: "int8", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int8"`)})
 	}
 	if _, ok := ftc.mutation.Int16(); !ok {
-		return &ValidationError{Name: "int16", err: errors.New(`ent: missing required field "FieldType.int16"`)}
+		errs = append(errs, &ValidationError{Name: "int16", Entity: "FieldType", Field: "int16", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int16"`)})
 	}
 	if _, ok := ftc.mutation.Int32(); !ok {
-		return &ValidationError{Name: "int32", err: errors.New(`ent: missing required field "FieldType.int32"`)}
+		errs = append(errs, &ValidationError{Name: "int32", Entity: "FieldType", Field: "int32", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int32"`)})
 	}
 	if _, ok := ftc.mutation.Int64(); !ok {
-		return &ValidationError{Name: "int64", err: errors.New(`ent: missing required field "FieldType.int64"`)}
+		errs = append(errs, &ValidationError{Name: "int64", Entity: "FieldType", Field: "int64", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int64"`)})
 	}
 	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "validate_optional_int32", Entity: "FieldType", Field: "validate_optional_int32", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "mac", Entity: "FieldType", Field: "mac", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.Dir(); !ok {
-		return &ValidationError{Name: "dir", err: errors.New(`ent: missing required field "FieldType.dir"`)}
+		errs = append(errs, &ValidationError{Name: "dir", Entity: "FieldType", Field: "dir", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.dir"`)})
 	}
 	if v, ok := ftc.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ndir", Entity: "FieldType", Field: "ndir", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "link", Entity: "FieldType", Field: "link", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "raw_data", Entity: "FieldType", Field: "raw_data", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ip", Entity: "FieldType", Field: "ip", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "FieldType.role"`)}
+		errs = append(errs, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.role"`)})
 	}
 	if v, ok := ftc.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.Pair(); !ok {
-		return &ValidationError{Name: "pair", err: errors.New(`ent: missing required field "FieldType.pair"`)}
+		errs = append(errs, &ValidationError{Name: "pair", Entity: "FieldType", Field: "pair", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.pair"`)})
 	}
 	if _, ok := ftc.mutation.Vstring(); !ok {
-		return &ValidationError{Name: "vstring", err: errors.New(`ent: missing required field "FieldType.vstring"`)}
+		errs = append(errs, &ValidationError{Name: "vstring", Entity: "FieldType", Field: "vstring", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.vstring"`)})
 	}
 	if _, ok := ftc.mutation.Triple(); !ok {
-		return &ValidationError{Name: "triple", err: errors.New(`ent: missing required field "FieldType.triple"`)}
+		errs = append(errs, &ValidationError{Name: "triple", Entity: "FieldType", Field: "triple", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.triple"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
@@ -957,7 +958,7 @@ func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
 	_node, _spec := ftc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ftc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -1298,6 +1299,12 @@ func (u *FieldTypeUpsert) UpdateInt() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreInt keeps the current value of the "int" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreInt() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldInt)
+	return u
+}
+
 // AddInt adds v to the "int" field.
 func (u *FieldTypeUpsert) AddInt(v int) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldInt, v)
@@ -1316,6 +1323,12 @@ func (u *FieldTypeUpsert) UpdateInt8() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreInt8 keeps the current value of the "int8" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreInt8() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldInt8)
+	return u
+}
+
 // AddInt8 adds v to the "int8" field.
 func (u *FieldTypeUpsert) AddInt8(v int8) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldInt8, v)
@@ -1334,6 +1347,12 @@ func (u *FieldTypeUpsert) UpdateInt16() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreInt16 keeps the current value of the "int16" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreInt16() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldInt16)
+	return u
+}
+
 // AddInt16 adds v to the "int16" field.
 func (u *FieldTypeUpsert) AddInt16(v int16) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldInt16, v)
@@ -1352,6 +1371,12 @@ func (u *FieldTypeUpsert) UpdateInt32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreInt32 keeps the current value of the "int32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreInt32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldInt32)
+	return u
+}
+
 // AddInt32 adds v to the "int32" field.
 func (u *FieldTypeUpsert) AddInt32(v int32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldInt32, v)
@@ -1370,6 +1395,12 @@ func (u *FieldTypeUpsert) UpdateInt64() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreInt64 keeps the current value of the "int64" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreInt64() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldInt64)
+	return u
+}
+
 // AddInt64 adds v to the "int64" field.
 func (u *FieldTypeUpsert) AddInt64(v int64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldInt64, v)
@@ -1388,6 +1419,12 @@ func (u *FieldTypeUpsert) UpdateOptionalInt() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalInt keeps the current value of the "optional_int" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalInt() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalInt)
+	return u
+}
+
 // AddOptionalInt adds v to the "optional_int" field.
 func (u *FieldTypeUpsert) AddOptionalInt(v int) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalInt, v)
@@ -1412,6 +1449,12 @@ func (u *FieldTypeUpsert) UpdateOptionalInt8() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalInt8 keeps the current value of the "optional_int8" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalInt8() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalInt8)
+	return u
+}
+
 // AddOptionalInt8 adds v to the "optional_int8" field.
 func (u *FieldTypeUpsert) AddOptionalInt8(v int8) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalInt8, v)
@@ -1436,6 +1479,12 @@ func (u *FieldTypeUpsert) UpdateOptionalInt16() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalInt16 keeps the current value of the "optional_int16" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalInt16() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalInt16)
+	return u
+}
+
 // AddOptionalInt16 adds v to the "optional_int16" field.
 func (u *FieldTypeUpsert) AddOptionalInt16(v int16) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalInt16, v)
@@ -1460,6 +1509,12 @@ func (u *FieldTypeUpsert) UpdateOptionalInt32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalInt32 keeps the current value of the "optional_int32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalInt32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalInt32)
+	return u
+}
+
 // AddOptionalInt32 adds v to the "optional_int32" field.
 func (u *FieldTypeUpsert) AddOptionalInt32(v int32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalInt32, v)
@@ -1484,6 +1539,12 @@ func (u *FieldTypeUpsert) UpdateOptionalInt64() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalInt64 keeps the current value of the "optional_int64" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalInt64() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalInt64)
+	return u
+}
+
 // AddOptionalInt64 adds v to the "optional_int64" field.
 func (u *FieldTypeUpsert) AddOptionalInt64(v int64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalInt64, v)
@@ -1508,6 +1569,12 @@ func (u *FieldTypeUpsert) UpdateNillableInt() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNillableInt keeps the current value of the "nillable_int" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNillableInt() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNillableInt)
+	return u
+}
+
 // AddNillableInt adds v to the "nillable_int" field.
 func (u *FieldTypeUpsert) AddNillableInt(v int) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldNillableInt, v)
@@ -1532,6 +1599,12 @@ func (u *FieldTypeUpsert) UpdateNillableInt8() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNillableInt8 keeps the current value of the "nillable_int8" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNillableInt8() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNillableInt8)
+	return u
+}
+
 // AddNillableInt8 adds v to the "nillable_int8" field.
 func (u *FieldTypeUpsert) AddNillableInt8(v int8) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldNillableInt8, v)
@@ -1556,6 +1629,12 @@ func (u *FieldTypeUpsert) UpdateNillableInt16() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNillableInt16 keeps the current value of the "nillable_int16" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNillableInt16() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNillableInt16)
+	return u
+}
+
 // AddNillableInt16 adds v to the "nillable_int16" field.
 func (u *FieldTypeUpsert) AddNillableInt16(v int16) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldNillableInt16, v)
@@ -1580,6 +1659,12 @@ func (u *FieldTypeUpsert) UpdateNillableInt32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNillableInt32 keeps the current value of the "nillable_int32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNillableInt32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNillableInt32)
+	return u
+}
+
 // AddNillableInt32 adds v to the "nillable_int32" field.
 func (u *FieldTypeUpsert) AddNillableInt32(v int32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldNillableInt32, v)
@@ -1604,6 +1689,12 @@ func (u *FieldTypeUpsert) UpdateNillableInt64() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNillableInt64 keeps the current value of the "nillable_int64" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNillableInt64() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNillableInt64)
+	return u
+}
+
 // AddNillableInt64 adds v to the "nillable_int64" field.
 func (u *FieldTypeUpsert) AddNillableInt64(v int64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldNillableInt64, v)
@@ -1628,6 +1719,12 @@ func (u *FieldTypeUpsert) UpdateValidateOptionalInt32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreValidateOptionalInt32 keeps the current value of the "validate_optional_int32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreValidateOptionalInt32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldValidateOptionalInt32)
+	return u
+}
+
 // AddValidateOptionalInt32 adds v to the "validate_optional_int32" field.
 func (u *FieldTypeUpsert) AddValidateOptionalInt32(v int32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldValidateOptionalInt32, v)
@@ -1652,6 +1749,12 @@ func (u *FieldTypeUpsert) UpdateOptionalUint() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalUint keeps the current value of the "optional_uint" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalUint() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalUint)
+	return u
+}
+
 // AddOptionalUint adds v to the "optional_uint" field.
 func (u *FieldTypeUpsert) AddOptionalUint(v uint) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalUint, v)
@@ -1676,6 +1779,12 @@ func (u *FieldTypeUpsert) UpdateOptionalUint8() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalUint8 keeps the current value of the "optional_uint8" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalUint8() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalUint8)
+	return u
+}
+
 // AddOptionalUint8 adds v to the "optional_uint8" field.
 func (u *FieldTypeUpsert) AddOptionalUint8(v uint8) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalUint8, v)
@@ -1700,6 +1809,12 @@ func (u *FieldTypeUpsert) UpdateOptionalUint16() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalUint16 keeps the current value of the "optional_uint16" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalUint16() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalUint16)
+	return u
+}
+
 // AddOptionalUint16 adds v to the "optional_uint16" field.
 func (u *FieldTypeUpsert) AddOptionalUint16(v uint16) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalUint16, v)
@@ -1724,6 +1839,12 @@ func (u *FieldTypeUpsert) UpdateOptionalUint32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalUint32 keeps the current value of the "optional_uint32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalUint32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalUint32)
+	return u
+}
+
 // AddOptionalUint32 adds v to the "optional_uint32" field.
 func (u *FieldTypeUpsert) AddOptionalUint32(v uint32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalUint32, v)
@@ -1748,6 +1869,12 @@ func (u *FieldTypeUpsert) UpdateOptionalUint64() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalUint64 keeps the current value of the "optional_uint64" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalUint64() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalUint64)
+	return u
+}
+
 // AddOptionalUint64 adds v to the "optional_uint64" field.
 func (u *FieldTypeUpsert) AddOptionalUint64(v uint64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalUint64, v)
@@ -1772,6 +1899,12 @@ func (u *FieldTypeUpsert) UpdateState() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreState keeps the current value of the "state" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreState() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldState)
+	return u
+}
+
 // ClearState clears the value of the "state" field.
 func (u *FieldTypeUpsert) ClearState() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldState)
@@ -1790,6 +1923,12 @@ func (u *FieldTypeUpsert) UpdateOptionalFloat() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalFloat keeps the current value of the "optional_float" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalFloat() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalFloat)
+	return u
+}
+
 // AddOptionalFloat adds v to the "optional_float" field.
 func (u *FieldTypeUpsert) AddOptionalFloat(v float64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalFloat, v)
@@ -1814,6 +1953,12 @@ func (u *FieldTypeUpsert) UpdateOptionalFloat32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalFloat32 keeps the current value of the "optional_float32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalFloat32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalFloat32)
+	return u
+}
+
 // AddOptionalFloat32 adds v to the "optional_float32" field.
 func (u *FieldTypeUpsert) AddOptionalFloat32(v float32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldOptionalFloat32, v)
@@ -1838,6 +1983,12 @@ func (u *FieldTypeUpsert) UpdateText() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreText() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldText)
+	return u
+}
+
 // ClearText clears the value of the "text" field.
 func (u *FieldTypeUpsert) ClearText() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldText)
@@ -1856,6 +2007,12 @@ func (u *FieldTypeUpsert) UpdateDatetime() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreDatetime keeps the current value of the "datetime" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreDatetime() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldDatetime)
+	return u
+}
+
 // ClearDatetime clears the value of the "datetime" field.
 func (u *FieldTypeUpsert) ClearDatetime() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldDatetime)
@@ -1874,6 +2031,12 @@ func (u *FieldTypeUpsert) UpdateDecimal() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreDecimal keeps the current value of the "decimal" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreDecimal() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldDecimal)
+	return u
+}
+
 // AddDecimal adds v to the "decimal" field.
 func (u *FieldTypeUpsert) AddDecimal(v float64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldDecimal, v)
@@ -1898,6 +2061,12 @@ func (u *FieldTypeUpsert) UpdateLinkOther() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreLinkOther keeps the current value of the "link_other" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreLinkOther() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldLinkOther)
+	return u
+}
+
 // ClearLinkOther clears the value of the "link_other" field.
 func (u *FieldTypeUpsert) ClearLinkOther() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldLinkOther)
@@ -1916,6 +2085,12 @@ func (u *FieldTypeUpsert) UpdateLinkOtherFunc() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreLinkOtherFunc keeps the current value of the "link_other_func" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreLinkOtherFunc() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldLinkOtherFunc)
+	return u
+}
+
 // ClearLinkOtherFunc clears the value of the "link_other_func" field.
 func (u *FieldTypeUpsert) ClearLinkOtherFunc() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldLinkOtherFunc)
@@ -1934,6 +2109,12 @@ func (u *FieldTypeUpsert) UpdateMAC() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreMAC keeps the current value of the "mac" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreMAC() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldMAC)
+	return u
+}
+
 // ClearMAC clears the value of the "mac" field.
 func (u *FieldTypeUpsert) ClearMAC() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldMAC)
@@ -1952,6 +2133,12 @@ func (u *FieldTypeUpsert) UpdateStringArray() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreStringArray keeps the current value of the "string_array" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreStringArray() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldStringArray)
+	return u
+}
+
 // ClearStringArray clears the value of the "string_array" field.
 func (u *FieldTypeUpsert) ClearStringArray() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldStringArray)
@@ -1970,6 +2157,12 @@ func (u *FieldTypeUpsert) UpdatePassword() *FieldTypeUpsert {
 	return u
 }
 
+// IgnorePassword keeps the current value of the "password" field in case of conflict.
+func (u *FieldTypeUpsert) IgnorePassword() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldPassword)
+	return u
+}
+
 // ClearPassword clears the value of the "password" field.
 func (u *FieldTypeUpsert) ClearPassword() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldPassword)
@@ -1988,6 +2181,12 @@ func (u *FieldTypeUpsert) UpdateStringScanner() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreStringScanner keeps the current value of the "string_scanner" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreStringScanner() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldStringScanner)
+	return u
+}
+
 // ClearStringScanner clears the value of the "string_scanner" field.
 func (u *FieldTypeUpsert) ClearStringScanner() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldStringScanner)
@@ -2006,6 +2205,12 @@ func (u *FieldTypeUpsert) UpdateDuration() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreDuration keeps the current value of the "duration" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreDuration() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldDuration)
+	return u
+}
+
 // AddDuration adds v to the "duration" field.
 func (u *FieldTypeUpsert) AddDuration(v time.Duration) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldDuration, v)
@@ -2030,6 +2235,12 @@ func (u *FieldTypeUpsert) UpdateDir() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreDir keeps the current value of the "dir" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreDir() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldDir)
+	return u
+}
+
 // SetNdir sets the "ndir" field.
 func (u *FieldTypeUpsert) SetNdir(v http.Dir) *FieldTypeUpsert {
 	u.Set(fieldtype.FieldNdir, v)
@@ -2042,6 +2253,12 @@ func (u *FieldTypeUpsert) UpdateNdir() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNdir keeps the current value of the "ndir" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNdir() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNdir)
+	return u
+}
+
 // ClearNdir clears the value of the "ndir" field.
 func (u *FieldTypeUpsert) ClearNdir() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNdir)
@@ -2060,6 +2277,12 @@ func (u *FieldTypeUpsert) UpdateStr() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreStr keeps the current value of the "str" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreStr() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldStr)
+	return u
+}
+
 // ClearStr clears the value of the "str" field.
 func (u *FieldTypeUpsert) ClearStr() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldStr)
@@ -2078,6 +2301,12 @@ func (u *FieldTypeUpsert) UpdateNullStr() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNullStr keeps the current value of the "null_str" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNullStr() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNullStr)
+	return u
+}
+
 // ClearNullStr clears the value of the "null_str" field.
 func (u *FieldTypeUpsert) ClearNullStr() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNullStr)
@@ -2096,6 +2325,12 @@ func (u *FieldTypeUpsert) UpdateLink() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreLink keeps the current value of the "link" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreLink() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldLink)
+	return u
+}
+
 // ClearLink clears the value of the "link" field.
 func (u *FieldTypeUpsert) ClearLink() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldLink)
@@ -2114,6 +2349,12 @@ func (u *FieldTypeUpsert) UpdateNullLink() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNullLink keeps the current value of the "null_link" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNullLink() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNullLink)
+	return u
+}
+
 // ClearNullLink clears the value of the "null_link" field.
 func (u *FieldTypeUpsert) ClearNullLink() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNullLink)
@@ -2132,6 +2373,12 @@ func (u *FieldTypeUpsert) UpdateActive() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreActive keeps the current value of the "active" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreActive() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldActive)
+	return u
+}
+
 // ClearActive clears the value of the "active" field.
 func (u *FieldTypeUpsert) ClearActive() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldActive)
@@ -2150,6 +2397,12 @@ func (u *FieldTypeUpsert) UpdateNullActive() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNullActive keeps the current value of the "null_active" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNullActive() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNullActive)
+	return u
+}
+
 // ClearNullActive clears the value of the "null_active" field.
 func (u *FieldTypeUpsert) ClearNullActive() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNullActive)
@@ -2168,6 +2421,12 @@ func (u *FieldTypeUpsert) UpdateDeleted() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreDeleted keeps the current value of the "deleted" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreDeleted() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldDeleted)
+	return u
+}
+
 // ClearDeleted clears the value of the "deleted" field.
 func (u *FieldTypeUpsert) ClearDeleted() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldDeleted)
@@ -2186,6 +2445,12 @@ func (u *FieldTypeUpsert) UpdateDeletedAt() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreDeletedAt keeps the current value of the "deleted_at" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreDeletedAt() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldDeletedAt)
+	return u
+}
+
 // ClearDeletedAt clears the value of the "deleted_at" field.
 func (u *FieldTypeUpsert) ClearDeletedAt() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldDeletedAt)
@@ -2204,6 +2469,12 @@ func (u *FieldTypeUpsert) UpdateRawData() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreRawData keeps the current value of the "raw_data" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreRawData() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldRawData)
+	return u
+}
+
 // ClearRawData clears the value of the "raw_data" field.
 func (u *FieldTypeUpsert) ClearRawData() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldRawData)
@@ -2222,6 +2493,12 @@ func (u *FieldTypeUpsert) UpdateSensitive() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreSensitive keeps the current value of the "sensitive" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreSensitive() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldSensitive)
+	return u
+}
+
 // ClearSensitive clears the value of the "sensitive" field.
 func (u *FieldTypeUpsert) ClearSensitive() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldSensitive)
@@ -2240,6 +2517,12 @@ func (u *FieldTypeUpsert) UpdateIP() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreIP keeps the current value of the "ip" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreIP() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldIP)
+	return u
+}
+
 // ClearIP clears the value of the "ip" field.
 func (u *FieldTypeUpsert) ClearIP() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldIP)
@@ -2258,6 +2541,12 @@ func (u *FieldTypeUpsert) UpdateNullInt64() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNullInt64 keeps the current value of the "null_int64" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNullInt64() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNullInt64)
+	return u
+}
+
 // ClearNullInt64 clears the value of the "null_int64" field.
 func (u *FieldTypeUpsert) ClearNullInt64() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNullInt64)
@@ -2276,6 +2565,12 @@ func (u *FieldTypeUpsert) UpdateSchemaInt() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreSchemaInt keeps the current value of the "schema_int" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreSchemaInt() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldSchemaInt)
+	return u
+}
+
 // AddSchemaInt adds v to the "schema_int" field.
 func (u *FieldTypeUpsert) AddSchemaInt(v schema.Int) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldSchemaInt, v)
@@ -2300,6 +2595,12 @@ func (u *FieldTypeUpsert) UpdateSchemaInt8() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreSchemaInt8 keeps the current value of the "schema_int8" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreSchemaInt8() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldSchemaInt8)
+	return u
+}
+
 // AddSchemaInt8 adds v to the "schema_int8" field.
 func (u *FieldTypeUpsert) AddSchemaInt8(v schema.Int8) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldSchemaInt8, v)
@@ -2324,6 +2625,12 @@ func (u *FieldTypeUpsert) UpdateSchemaInt64() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreSchemaInt64 keeps the current value of the "schema_int64" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreSchemaInt64() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldSchemaInt64)
+	return u
+}
+
 // AddSchemaInt64 adds v to the "schema_int64" field.
 func (u *FieldTypeUpsert) AddSchemaInt64(v schema.Int64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldSchemaInt64, v)
@@ -2348,6 +2655,12 @@ func (u *FieldTypeUpsert) UpdateSchemaFloat() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreSchemaFloat keeps the current value of the "schema_float" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreSchemaFloat() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldSchemaFloat)
+	return u
+}
+
 // AddSchemaFloat adds v to the "schema_float" field.
 func (u *FieldTypeUpsert) AddSchemaFloat(v schema.Float64) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldSchemaFloat, v)
@@ -2372,6 +2685,12 @@ func (u *FieldTypeUpsert) UpdateSchemaFloat32() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreSchemaFloat32 keeps the current value of the "schema_float32" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreSchemaFloat32() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldSchemaFloat32)
+	return u
+}
+
 // AddSchemaFloat32 adds v to the "schema_float32" field.
 func (u *FieldTypeUpsert) AddSchemaFloat32(v schema.Float32) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldSchemaFloat32, v)
@@ -2396,6 +2715,12 @@ func (u *FieldTypeUpsert) UpdateNullFloat() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNullFloat keeps the current value of the "null_float" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNullFloat() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNullFloat)
+	return u
+}
+
 // ClearNullFloat clears the value of the "null_float" field.
 func (u *FieldTypeUpsert) ClearNullFloat() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNullFloat)
@@ -2414,6 +2739,12 @@ func (u *FieldTypeUpsert) UpdateRole() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreRole keeps the current value of the "role" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreRole() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldRole)
+	return u
+}
+
 // SetPriority sets the "priority" field.
 func (u *FieldTypeUpsert) SetPriority(v role.Priority) *FieldTypeUpsert {
 	u.Set(fieldtype.FieldPriority, v)
@@ -2426,6 +2757,12 @@ func (u *FieldTypeUpsert) UpdatePriority() *FieldTypeUpsert {
 	return u
 }
 
+// IgnorePriority keeps the current value of the "priority" field in case of conflict.
+func (u *FieldTypeUpsert) IgnorePriority() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldPriority)
+	return u
+}
+
 // ClearPriority clears the value of the "priority" field.
 func (u *FieldTypeUpsert) ClearPriority() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldPriority)
@@ -2444,6 +2781,12 @@ func (u *FieldTypeUpsert) UpdateOptionalUUID() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreOptionalUUID keeps the current value of the "optional_uuid" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreOptionalUUID() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldOptionalUUID)
+	return u
+}
+
 // ClearOptionalUUID clears the value of the "optional_uuid" field.
 func (u *FieldTypeUpsert) ClearOptionalUUID() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldOptionalUUID)
@@ -2462,6 +2805,12 @@ func (u *FieldTypeUpsert) UpdateNillableUUID() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNillableUUID keeps the current value of the "nillable_uuid" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNillableUUID() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNillableUUID)
+	return u
+}
+
 // ClearNillableUUID clears the value of the "nillable_uuid" field.
 func (u *FieldTypeUpsert) ClearNillableUUID() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNillableUUID)
@@ -2480,6 +2829,12 @@ func (u *FieldTypeUpsert) UpdateStrings() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreStrings keeps the current value of the "strings" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreStrings() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldStrings)
+	return u
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (u *FieldTypeUpsert) ClearStrings() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldStrings)
@@ -2498,6 +2853,12 @@ func (u *FieldTypeUpsert) UpdatePair() *FieldTypeUpsert {
 	return u
 }
 
+// IgnorePair keeps the current value of the "pair" field in case of conflict.
+func (u *FieldTypeUpsert) IgnorePair() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldPair)
+	return u
+}
+
 // SetNilPair sets the "nil_pair" field.
 func (u *FieldTypeUpsert) SetNilPair(v *schema.Pair) *FieldTypeUpsert {
 	u.Set(fieldtype.FieldNilPair, v)
@@ -2510,6 +2871,12 @@ func (u *FieldTypeUpsert) UpdateNilPair() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreNilPair keeps the current value of the "nil_pair" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreNilPair() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldNilPair)
+	return u
+}
+
 // ClearNilPair clears the value of the "nil_pair" field.
 func (u *FieldTypeUpsert) ClearNilPair() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldNilPair)
@@ -2528,6 +2895,12 @@ func (u *FieldTypeUpsert) UpdateVstring() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreVstring keeps the current value of the "vstring" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreVstring() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldVstring)
+	return u
+}
+
 // SetTriple sets the "triple" field.
 func (u *FieldTypeUpsert) SetTriple(v schema.Triple) *FieldTypeUpsert {
 	u.Set(fieldtype.FieldTriple, v)
@@ -2540,6 +2913,12 @@ func (u *FieldTypeUpsert) UpdateTriple() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreTriple keeps the current value of the "triple" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreTriple() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldTriple)
+	return u
+}
+
 // SetBigInt sets the "big_int" field.
 func (u *FieldTypeUpsert) SetBigInt(v schema.BigInt) *FieldTypeUpsert {
 	u.Set(fieldtype.FieldBigInt, v)
@@ -2552,6 +2931,12 @@ func (u *FieldTypeUpsert) UpdateBigInt() *FieldTypeUpsert {
 	return u
 }
 
+// IgnoreBigInt keeps the current value of the "big_int" field in case of conflict.
+func (u *FieldTypeUpsert) IgnoreBigInt() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldBigInt)
+	return u
+}
+
 // AddBigInt adds v to the "big_int" field.
 func (u *FieldTypeUpsert) AddBigInt(v schema.BigInt) *FieldTypeUpsert {
 	u.Add(fieldtype.FieldBigInt, v)
@@ -2576,6 +2961,12 @@ func (u *FieldTypeUpsert) UpdatePasswordOther() *FieldTypeUpsert {
 	return u
 }
 
+// IgnorePasswordOther keeps the current value of the "password_other" field in case of conflict.
+func (u *FieldTypeUpsert) IgnorePasswordOther() *FieldTypeUpsert {
+	u.SetIgnore(fieldtype.FieldPasswordOther)
+	return u
+}
+
 // ClearPasswordOther clears the value of the "password_other" field.
 func (u *FieldTypeUpsert) ClearPasswordOther() *FieldTypeUpsert {
 	u.SetNull(fieldtype.FieldPasswordOther)
@@ -2643,6 +3034,14 @@ func (u *FieldTypeUpsertOne) UpdateInt() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreInt keeps the current value of the "int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreInt() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt()
+	})
+}
+
 // SetInt8 sets the "int8" field.
 func (u *FieldTypeUpsertOne) SetInt8(v int8) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2664,6 +3063,14 @@ func (u *FieldTypeUpsertOne) UpdateInt8() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreInt8 keeps the current value of the "int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreInt8() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt8()
+	})
+}
+
 // SetInt16 sets the "int16" field.
 func (u *FieldTypeUpsertOne) SetInt16(v int16) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2685,6 +3092,14 @@ func (u *FieldTypeUpsertOne) UpdateInt16() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreInt16 keeps the current value of the "int16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreInt16() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt16()
+	})
+}
+
 // SetInt32 sets the "int32" field.
 func (u *FieldTypeUpsertOne) SetInt32(v int32) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2706,6 +3121,14 @@ func (u *FieldTypeUpsertOne) UpdateInt32() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreInt32 keeps the current value of the "int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreInt32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt32()
+	})
+}
+
 // SetInt64 sets the "int64" field.
 func (u *FieldTypeUpsertOne) SetInt64(v int64) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2727,6 +3150,14 @@ func (u *FieldTypeUpsertOne) UpdateInt64() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreInt64 keeps the current value of the "int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreInt64() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt64()
+	})
+}
+
 // SetOptionalInt sets the "optional_int" field.
 func (u *FieldTypeUpsertOne) SetOptionalInt(v int) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2748,6 +3179,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalInt() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalInt keeps the current value of the "optional_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalInt() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt()
+	})
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (u *FieldTypeUpsertOne) ClearOptionalInt() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2776,6 +3215,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalInt8() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalInt8 keeps the current value of the "optional_int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalInt8() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt8()
+	})
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (u *FieldTypeUpsertOne) ClearOptionalInt8() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2804,6 +3251,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalInt16() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalInt16 keeps the current value of the "optional_int16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalInt16() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt16()
+	})
+}
+
 // ClearOptionalInt16 clears the value of the "optional_int16" field.
 func (u *FieldTypeUpsertOne) ClearOptionalInt16() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2832,6 +3287,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalInt32() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalInt32 keeps the current value of the "optional_int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalInt32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt32()
+	})
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (u *FieldTypeUpsertOne) ClearOptionalInt32() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2860,6 +3323,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalInt64() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalInt64 keeps the current value of the "optional_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalInt64() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt64()
+	})
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (u *FieldTypeUpsertOne) ClearOptionalInt64() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2888,6 +3359,14 @@ func (u *FieldTypeUpsertOne) UpdateNillableInt() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNillableInt keeps the current value of the "nillable_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNillableInt() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt()
+	})
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (u *FieldTypeUpsertOne) ClearNillableInt() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2916,6 +3395,14 @@ func (u *FieldTypeUpsertOne) UpdateNillableInt8() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNillableInt8 keeps the current value of the "nillable_int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNillableInt8() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt8()
+	})
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (u *FieldTypeUpsertOne) ClearNillableInt8() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2944,6 +3431,14 @@ func (u *FieldTypeUpsertOne) UpdateNillableInt16() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNillableInt16 keeps the current value of the "nillable_int16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNillableInt16() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt16()
+	})
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (u *FieldTypeUpsertOne) ClearNillableInt16() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -2972,6 +3467,14 @@ func (u *FieldTypeUpsertOne) UpdateNillableInt32() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNillableInt32 keeps the current value of the "nillable_int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNillableInt32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt32()
+	})
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (u *FieldTypeUpsertOne) ClearNillableInt32() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3000,6 +3503,14 @@ func (u *FieldTypeUpsertOne) UpdateNillableInt64() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNillableInt64 keeps the current value of the "nillable_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNillableInt64() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt64()
+	})
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (u *FieldTypeUpsertOne) ClearNillableInt64() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3028,7 +3539,15 @@ func (u *FieldTypeUpsertOne) UpdateValidateOptionalInt32() *FieldTypeUpsertOne {
 	})
 }
 
-// ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
+// IgnoreValidateOptionalInt32 keeps the current value of the "validate_optional_int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreValidateOptionalInt32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreValidateOptionalInt32()
+	})
+}
+
+// ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (u *FieldTypeUpsertOne) ClearValidateOptionalInt32() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
 		s.ClearValidateOptionalInt32()
@@ -3056,6 +3575,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalUint() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalUint keeps the current value of the "optional_uint" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalUint() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint()
+	})
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (u *FieldTypeUpsertOne) ClearOptionalUint() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3084,6 +3611,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalUint8() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalUint8 keeps the current value of the "optional_uint8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalUint8() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint8()
+	})
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (u *FieldTypeUpsertOne) ClearOptionalUint8() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3112,6 +3647,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalUint16() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalUint16 keeps the current value of the "optional_uint16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalUint16() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint16()
+	})
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (u *FieldTypeUpsertOne) ClearOptionalUint16() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3140,6 +3683,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalUint32() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalUint32 keeps the current value of the "optional_uint32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalUint32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint32()
+	})
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (u *FieldTypeUpsertOne) ClearOptionalUint32() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3168,6 +3719,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalUint64() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalUint64 keeps the current value of the "optional_uint64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalUint64() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint64()
+	})
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (u *FieldTypeUpsertOne) ClearOptionalUint64() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3189,6 +3748,14 @@ func (u *FieldTypeUpsertOne) UpdateState() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreState keeps the current value of the "state" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreState() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreState()
+	})
+}
+
 // ClearState clears the value of the "state" field.
 func (u *FieldTypeUpsertOne) ClearState() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3217,6 +3784,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalFloat() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalFloat keeps the current value of the "optional_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalFloat() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalFloat()
+	})
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (u *FieldTypeUpsertOne) ClearOptionalFloat() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3245,6 +3820,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalFloat32() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalFloat32 keeps the current value of the "optional_float32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalFloat32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalFloat32()
+	})
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (u *FieldTypeUpsertOne) ClearOptionalFloat32() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3266,6 +3849,14 @@ func (u *FieldTypeUpsertOne) UpdateText() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreText() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *FieldTypeUpsertOne) ClearText() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3287,6 +3878,14 @@ func (u *FieldTypeUpsertOne) UpdateDatetime() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreDatetime keeps the current value of the "datetime" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreDatetime() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDatetime()
+	})
+}
+
 // ClearDatetime clears the value of the "datetime" field.
 func (u *FieldTypeUpsertOne) ClearDatetime() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3315,6 +3914,14 @@ func (u *FieldTypeUpsertOne) UpdateDecimal() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreDecimal keeps the current value of the "decimal" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreDecimal() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDecimal()
+	})
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (u *FieldTypeUpsertOne) ClearDecimal() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3336,6 +3943,14 @@ func (u *FieldTypeUpsertOne) UpdateLinkOther() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreLinkOther keeps the current value of the "link_other" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreLinkOther() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreLinkOther()
+	})
+}
+
 // ClearLinkOther clears the value of the "link_other" field.
 func (u *FieldTypeUpsertOne) ClearLinkOther() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3357,6 +3972,14 @@ func (u *FieldTypeUpsertOne) UpdateLinkOtherFunc() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreLinkOtherFunc keeps the current value of the "link_other_func" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreLinkOtherFunc() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreLinkOtherFunc()
+	})
+}
+
 // ClearLinkOtherFunc clears the value of the "link_other_func" field.
 func (u *FieldTypeUpsertOne) ClearLinkOtherFunc() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3378,6 +4001,14 @@ func (u *FieldTypeUpsertOne) UpdateMAC() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreMAC keeps the current value of the "mac" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreMAC() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreMAC()
+	})
+}
+
 // ClearMAC clears the value of the "mac" field.
 func (u *FieldTypeUpsertOne) ClearMAC() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3399,6 +4030,14 @@ func (u *FieldTypeUpsertOne) UpdateStringArray() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreStringArray keeps the current value of the "string_array" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreStringArray() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStringArray()
+	})
+}
+
 // ClearStringArray clears the value of the "string_array" field.
 func (u *FieldTypeUpsertOne) ClearStringArray() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3420,6 +4059,14 @@ func (u *FieldTypeUpsertOne) UpdatePassword() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnorePassword keeps the current value of the "password" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnorePassword() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePassword()
+	})
+}
+
 // ClearPassword clears the value of the "password" field.
 func (u *FieldTypeUpsertOne) ClearPassword() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3441,6 +4088,14 @@ func (u *FieldTypeUpsertOne) UpdateStringScanner() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreStringScanner keeps the current value of the "string_scanner" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreStringScanner() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStringScanner()
+	})
+}
+
 // ClearStringScanner clears the value of the "string_scanner" field.
 func (u *FieldTypeUpsertOne) ClearStringScanner() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3469,6 +4124,14 @@ func (u *FieldTypeUpsertOne) UpdateDuration() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreDuration keeps the current value of the "duration" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreDuration() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDuration()
+	})
+}
+
 // ClearDuration clears the value of the "duration" field.
 func (u *FieldTypeUpsertOne) ClearDuration() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3490,6 +4153,14 @@ func (u *FieldTypeUpsertOne) UpdateDir() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreDir keeps the current value of the "dir" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreDir() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDir()
+	})
+}
+
 // SetNdir sets the "ndir" field.
 func (u *FieldTypeUpsertOne) SetNdir(v http.Dir) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3504,6 +4175,14 @@ func (u *FieldTypeUpsertOne) UpdateNdir() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNdir keeps the current value of the "ndir" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNdir() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNdir()
+	})
+}
+
 // ClearNdir clears the value of the "ndir" field.
 func (u *FieldTypeUpsertOne) ClearNdir() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3525,6 +4204,14 @@ func (u *FieldTypeUpsertOne) UpdateStr() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreStr keeps the current value of the "str" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreStr() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStr()
+	})
+}
+
 // ClearStr clears the value of the "str" field.
 func (u *FieldTypeUpsertOne) ClearStr() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3546,6 +4233,14 @@ func (u *FieldTypeUpsertOne) UpdateNullStr() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNullStr keeps the current value of the "null_str" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNullStr() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullStr()
+	})
+}
+
 // ClearNullStr clears the value of the "null_str" field.
 func (u *FieldTypeUpsertOne) ClearNullStr() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3567,6 +4262,14 @@ func (u *FieldTypeUpsertOne) UpdateLink() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreLink keeps the current value of the "link" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreLink() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreLink()
+	})
+}
+
 // ClearLink clears the value of the "link" field.
 func (u *FieldTypeUpsertOne) ClearLink() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3588,6 +4291,14 @@ func (u *FieldTypeUpsertOne) UpdateNullLink() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNullLink keeps the current value of the "null_link" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNullLink() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullLink()
+	})
+}
+
 // ClearNullLink clears the value of the "null_link" field.
 func (u *FieldTypeUpsertOne) ClearNullLink() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3609,6 +4320,14 @@ func (u *FieldTypeUpsertOne) UpdateActive() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreActive keeps the current value of the "active" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreActive() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreActive()
+	})
+}
+
 // ClearActive clears the value of the "active" field.
 func (u *FieldTypeUpsertOne) ClearActive() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3630,6 +4349,14 @@ func (u *FieldTypeUpsertOne) UpdateNullActive() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNullActive keeps the current value of the "null_active" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNullActive() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullActive()
+	})
+}
+
 // ClearNullActive clears the value of the "null_active" field.
 func (u *FieldTypeUpsertOne) ClearNullActive() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3651,6 +4378,14 @@ func (u *FieldTypeUpsertOne) UpdateDeleted() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreDeleted keeps the current value of the "deleted" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreDeleted() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDeleted()
+	})
+}
+
 // ClearDeleted clears the value of the "deleted" field.
 func (u *FieldTypeUpsertOne) ClearDeleted() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3672,6 +4407,14 @@ func (u *FieldTypeUpsertOne) UpdateDeletedAt() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreDeletedAt keeps the current value of the "deleted_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreDeletedAt() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDeletedAt()
+	})
+}
+
 // ClearDeletedAt clears the value of the "deleted_at" field.
 func (u *FieldTypeUpsertOne) ClearDeletedAt() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3693,6 +4436,14 @@ func (u *FieldTypeUpsertOne) UpdateRawData() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreRawData keeps the current value of the "raw_data" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreRawData() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreRawData()
+	})
+}
+
 // ClearRawData clears the value of the "raw_data" field.
 func (u *FieldTypeUpsertOne) ClearRawData() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3714,6 +4465,14 @@ func (u *FieldTypeUpsertOne) UpdateSensitive() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreSensitive keeps the current value of the "sensitive" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreSensitive() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSensitive()
+	})
+}
+
 // ClearSensitive clears the value of the "sensitive" field.
 func (u *FieldTypeUpsertOne) ClearSensitive() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3735,6 +4494,14 @@ func (u *FieldTypeUpsertOne) UpdateIP() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreIP keeps the current value of the "ip" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreIP() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreIP()
+	})
+}
+
 // ClearIP clears the value of the "ip" field.
 func (u *FieldTypeUpsertOne) ClearIP() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3756,6 +4523,14 @@ func (u *FieldTypeUpsertOne) UpdateNullInt64() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNullInt64 keeps the current value of the "null_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNullInt64() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullInt64()
+	})
+}
+
 // ClearNullInt64 clears the value of the "null_int64" field.
 func (u *FieldTypeUpsertOne) ClearNullInt64() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3784,6 +4559,14 @@ func (u *FieldTypeUpsertOne) UpdateSchemaInt() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreSchemaInt keeps the current value of the "schema_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreSchemaInt() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaInt()
+	})
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (u *FieldTypeUpsertOne) ClearSchemaInt() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3812,6 +4595,14 @@ func (u *FieldTypeUpsertOne) UpdateSchemaInt8() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreSchemaInt8 keeps the current value of the "schema_int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreSchemaInt8() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaInt8()
+	})
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (u *FieldTypeUpsertOne) ClearSchemaInt8() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3840,6 +4631,14 @@ func (u *FieldTypeUpsertOne) UpdateSchemaInt64() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreSchemaInt64 keeps the current value of the "schema_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreSchemaInt64() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaInt64()
+	})
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (u *FieldTypeUpsertOne) ClearSchemaInt64() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3868,6 +4667,14 @@ func (u *FieldTypeUpsertOne) UpdateSchemaFloat() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreSchemaFloat keeps the current value of the "schema_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreSchemaFloat() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaFloat()
+	})
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (u *FieldTypeUpsertOne) ClearSchemaFloat() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3896,6 +4703,14 @@ func (u *FieldTypeUpsertOne) UpdateSchemaFloat32() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreSchemaFloat32 keeps the current value of the "schema_float32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreSchemaFloat32() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaFloat32()
+	})
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (u *FieldTypeUpsertOne) ClearSchemaFloat32() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3917,6 +4732,14 @@ func (u *FieldTypeUpsertOne) UpdateNullFloat() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNullFloat keeps the current value of the "null_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNullFloat() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullFloat()
+	})
+}
+
 // ClearNullFloat clears the value of the "null_float" field.
 func (u *FieldTypeUpsertOne) ClearNullFloat() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3938,6 +4761,14 @@ func (u *FieldTypeUpsertOne) UpdateRole() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreRole keeps the current value of the "role" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreRole() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreRole()
+	})
+}
+
 // SetPriority sets the "priority" field.
 func (u *FieldTypeUpsertOne) SetPriority(v role.Priority) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3952,6 +4783,14 @@ func (u *FieldTypeUpsertOne) UpdatePriority() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnorePriority keeps the current value of the "priority" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnorePriority() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePriority()
+	})
+}
+
 // ClearPriority clears the value of the "priority" field.
 func (u *FieldTypeUpsertOne) ClearPriority() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3973,6 +4812,14 @@ func (u *FieldTypeUpsertOne) UpdateOptionalUUID() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreOptionalUUID keeps the current value of the "optional_uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreOptionalUUID() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUUID()
+	})
+}
+
 // ClearOptionalUUID clears the value of the "optional_uuid" field.
 func (u *FieldTypeUpsertOne) ClearOptionalUUID() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -3994,6 +4841,14 @@ func (u *FieldTypeUpsertOne) UpdateNillableUUID() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNillableUUID keeps the current value of the "nillable_uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNillableUUID() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableUUID()
+	})
+}
+
 // ClearNillableUUID clears the value of the "nillable_uuid" field.
 func (u *FieldTypeUpsertOne) ClearNillableUUID() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4015,6 +4870,14 @@ func (u *FieldTypeUpsertOne) UpdateStrings() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreStrings keeps the current value of the "strings" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreStrings() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStrings()
+	})
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (u *FieldTypeUpsertOne) ClearStrings() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4036,6 +4899,14 @@ func (u *FieldTypeUpsertOne) UpdatePair() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnorePair keeps the current value of the "pair" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnorePair() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePair()
+	})
+}
+
 // SetNilPair sets the "nil_pair" field.
 func (u *FieldTypeUpsertOne) SetNilPair(v *schema.Pair) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4050,6 +4921,14 @@ func (u *FieldTypeUpsertOne) UpdateNilPair() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreNilPair keeps the current value of the "nil_pair" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreNilPair() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNilPair()
+	})
+}
+
 // ClearNilPair clears the value of the "nil_pair" field.
 func (u *FieldTypeUpsertOne) ClearNilPair() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4071,6 +4950,14 @@ func (u *FieldTypeUpsertOne) UpdateVstring() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreVstring keeps the current value of the "vstring" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreVstring() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreVstring()
+	})
+}
+
 // SetTriple sets the "triple" field.
 func (u *FieldTypeUpsertOne) SetTriple(v schema.Triple) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4085,6 +4972,14 @@ func (u *FieldTypeUpsertOne) UpdateTriple() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreTriple keeps the current value of the "triple" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreTriple() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreTriple()
+	})
+}
+
 // SetBigInt sets the "big_int" field.
 func (u *FieldTypeUpsertOne) SetBigInt(v schema.BigInt) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4106,6 +5001,14 @@ func (u *FieldTypeUpsertOne) UpdateBigInt() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnoreBigInt keeps the current value of the "big_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnoreBigInt() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreBigInt()
+	})
+}
+
 // ClearBigInt clears the value of the "big_int" field.
 func (u *FieldTypeUpsertOne) ClearBigInt() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4127,6 +5030,14 @@ func (u *FieldTypeUpsertOne) UpdatePasswordOther() *FieldTypeUpsertOne {
 	})
 }
 
+// IgnorePasswordOther keeps the current value of the "password_other" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertOne) IgnorePasswordOther() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePasswordOther()
+	})
+}
+
 // ClearPasswordOther clears the value of the "password_other" field.
 func (u *FieldTypeUpsertOne) ClearPasswordOther() *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4170,12 +5081,16 @@ func (u *FieldTypeUpsertOne) IDX(ctx context.Context) int {
 // FieldTypeCreateBulk is the builder for creating many FieldType entities in bulk.
 type FieldTypeCreateBulk struct {
 	config
+	err      error
 	builders []*FieldTypeCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the FieldType entities in the database.
 func (ftcb *FieldTypeCreateBulk) Save(ctx context.Context) ([]*FieldType, error) {
+	if ftcb.err != nil {
+		return nil, ftcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ftcb.builders))
 	nodes := make([]*FieldType, len(ftcb.builders))
 	mutators := make([]Mutator, len(ftcb.builders))
@@ -4202,7 +5117,7 @@ func (ftcb *FieldTypeCreateBulk) Save(ctx context.Context) ([]*FieldType, error)
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ftcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -4355,6 +5270,14 @@ func (u *FieldTypeUpsertBulk) UpdateInt() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreInt keeps the current value of the "int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreInt() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt()
+	})
+}
+
 // SetInt8 sets the "int8" field.
 func (u *FieldTypeUpsertBulk) SetInt8(v int8) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4376,6 +5299,14 @@ func (u *FieldTypeUpsertBulk) UpdateInt8() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreInt8 keeps the current value of the "int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreInt8() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt8()
+	})
+}
+
 // SetInt16 sets the "int16" field.
 func (u *FieldTypeUpsertBulk) SetInt16(v int16) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4397,6 +5328,14 @@ func (u *FieldTypeUpsertBulk) UpdateInt16() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreInt16 keeps the current value of the "int16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreInt16() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt16()
+	})
+}
+
 // SetInt32 sets the "int32" field.
 func (u *FieldTypeUpsertBulk) SetInt32(v int32) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4418,6 +5357,14 @@ func (u *FieldTypeUpsertBulk) UpdateInt32() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreInt32 keeps the current value of the "int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreInt32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt32()
+	})
+}
+
 // SetInt64 sets the "int64" field.
 func (u *FieldTypeUpsertBulk) SetInt64(v int64) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4439,6 +5386,14 @@ func (u *FieldTypeUpsertBulk) UpdateInt64() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreInt64 keeps the current value of the "int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreInt64() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreInt64()
+	})
+}
+
 // SetOptionalInt sets the "optional_int" field.
 func (u *FieldTypeUpsertBulk) SetOptionalInt(v int) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4460,6 +5415,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalInt() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalInt keeps the current value of the "optional_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalInt() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt()
+	})
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalInt() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4488,6 +5451,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalInt8() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalInt8 keeps the current value of the "optional_int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalInt8() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt8()
+	})
+}
+
 // ClearOptionalInt8 clears the value of the "optional_int8" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalInt8() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4516,6 +5487,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalInt16() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalInt16 keeps the current value of the "optional_int16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalInt16() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt16()
+	})
+}
+
 // ClearOptionalInt16 clears the value of the "optional_int16" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalInt16() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4544,6 +5523,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalInt32() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalInt32 keeps the current value of the "optional_int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalInt32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt32()
+	})
+}
+
 // ClearOptionalInt32 clears the value of the "optional_int32" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalInt32() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4572,6 +5559,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalInt64() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalInt64 keeps the current value of the "optional_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalInt64() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalInt64()
+	})
+}
+
 // ClearOptionalInt64 clears the value of the "optional_int64" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalInt64() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4600,6 +5595,14 @@ func (u *FieldTypeUpsertBulk) UpdateNillableInt() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNillableInt keeps the current value of the "nillable_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNillableInt() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt()
+	})
+}
+
 // ClearNillableInt clears the value of the "nillable_int" field.
 func (u *FieldTypeUpsertBulk) ClearNillableInt() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4628,6 +5631,14 @@ func (u *FieldTypeUpsertBulk) UpdateNillableInt8() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNillableInt8 keeps the current value of the "nillable_int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNillableInt8() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt8()
+	})
+}
+
 // ClearNillableInt8 clears the value of the "nillable_int8" field.
 func (u *FieldTypeUpsertBulk) ClearNillableInt8() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4656,6 +5667,14 @@ func (u *FieldTypeUpsertBulk) UpdateNillableInt16() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNillableInt16 keeps the current value of the "nillable_int16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNillableInt16() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt16()
+	})
+}
+
 // ClearNillableInt16 clears the value of the "nillable_int16" field.
 func (u *FieldTypeUpsertBulk) ClearNillableInt16() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4684,6 +5703,14 @@ func (u *FieldTypeUpsertBulk) UpdateNillableInt32() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNillableInt32 keeps the current value of the "nillable_int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNillableInt32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt32()
+	})
+}
+
 // ClearNillableInt32 clears the value of the "nillable_int32" field.
 func (u *FieldTypeUpsertBulk) ClearNillableInt32() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4712,6 +5739,14 @@ func (u *FieldTypeUpsertBulk) UpdateNillableInt64() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNillableInt64 keeps the current value of the "nillable_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNillableInt64() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableInt64()
+	})
+}
+
 // ClearNillableInt64 clears the value of the "nillable_int64" field.
 func (u *FieldTypeUpsertBulk) ClearNillableInt64() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4740,6 +5775,14 @@ func (u *FieldTypeUpsertBulk) UpdateValidateOptionalInt32() *FieldTypeUpsertBulk
 	})
 }
 
+// IgnoreValidateOptionalInt32 keeps the current value of the "validate_optional_int32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreValidateOptionalInt32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreValidateOptionalInt32()
+	})
+}
+
 // ClearValidateOptionalInt32 clears the value of the "validate_optional_int32" field.
 func (u *FieldTypeUpsertBulk) ClearValidateOptionalInt32() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4768,6 +5811,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalUint() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalUint keeps the current value of the "optional_uint" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalUint() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint()
+	})
+}
+
 // ClearOptionalUint clears the value of the "optional_uint" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalUint() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4796,6 +5847,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalUint8() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalUint8 keeps the current value of the "optional_uint8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalUint8() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint8()
+	})
+}
+
 // ClearOptionalUint8 clears the value of the "optional_uint8" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalUint8() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4824,6 +5883,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalUint16() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalUint16 keeps the current value of the "optional_uint16" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalUint16() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint16()
+	})
+}
+
 // ClearOptionalUint16 clears the value of the "optional_uint16" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalUint16() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4852,6 +5919,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalUint32() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalUint32 keeps the current value of the "optional_uint32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalUint32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint32()
+	})
+}
+
 // ClearOptionalUint32 clears the value of the "optional_uint32" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalUint32() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4880,6 +5955,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalUint64() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalUint64 keeps the current value of the "optional_uint64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalUint64() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUint64()
+	})
+}
+
 // ClearOptionalUint64 clears the value of the "optional_uint64" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalUint64() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4901,6 +5984,14 @@ func (u *FieldTypeUpsertBulk) UpdateState() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreState keeps the current value of the "state" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreState() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreState()
+	})
+}
+
 // ClearState clears the value of the "state" field.
 func (u *FieldTypeUpsertBulk) ClearState() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4929,6 +6020,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalFloat() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalFloat keeps the current value of the "optional_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalFloat() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalFloat()
+	})
+}
+
 // ClearOptionalFloat clears the value of the "optional_float" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalFloat() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4957,6 +6056,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalFloat32() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalFloat32 keeps the current value of the "optional_float32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalFloat32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalFloat32()
+	})
+}
+
 // ClearOptionalFloat32 clears the value of the "optional_float32" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalFloat32() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4978,6 +6085,14 @@ func (u *FieldTypeUpsertBulk) UpdateText() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreText() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *FieldTypeUpsertBulk) ClearText() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4999,6 +6114,14 @@ func (u *FieldTypeUpsertBulk) UpdateDatetime() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreDatetime keeps the current value of the "datetime" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreDatetime() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDatetime()
+	})
+}
+
 // ClearDatetime clears the value of the "datetime" field.
 func (u *FieldTypeUpsertBulk) ClearDatetime() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5027,6 +6150,14 @@ func (u *FieldTypeUpsertBulk) UpdateDecimal() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreDecimal keeps the current value of the "decimal" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreDecimal() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDecimal()
+	})
+}
+
 // ClearDecimal clears the value of the "decimal" field.
 func (u *FieldTypeUpsertBulk) ClearDecimal() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5048,6 +6179,14 @@ func (u *FieldTypeUpsertBulk) UpdateLinkOther() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreLinkOther keeps the current value of the "link_other" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreLinkOther() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreLinkOther()
+	})
+}
+
 // ClearLinkOther clears the value of the "link_other" field.
 func (u *FieldTypeUpsertBulk) ClearLinkOther() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5069,6 +6208,14 @@ func (u *FieldTypeUpsertBulk) UpdateLinkOtherFunc() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreLinkOtherFunc keeps the current value of the "link_other_func" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreLinkOtherFunc() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreLinkOtherFunc()
+	})
+}
+
 // ClearLinkOtherFunc clears the value of the "link_other_func" field.
 func (u *FieldTypeUpsertBulk) ClearLinkOtherFunc() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5090,6 +6237,14 @@ func (u *FieldTypeUpsertBulk) UpdateMAC() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreMAC keeps the current value of the "mac" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreMAC() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreMAC()
+	})
+}
+
 // ClearMAC clears the value of the "mac" field.
 func (u *FieldTypeUpsertBulk) ClearMAC() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5111,6 +6266,14 @@ func (u *FieldTypeUpsertBulk) UpdateStringArray() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreStringArray keeps the current value of the "string_array" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreStringArray() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStringArray()
+	})
+}
+
 // ClearStringArray clears the value of the "string_array" field.
 func (u *FieldTypeUpsertBulk) ClearStringArray() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5132,6 +6295,14 @@ func (u *FieldTypeUpsertBulk) UpdatePassword() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnorePassword keeps the current value of the "password" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnorePassword() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePassword()
+	})
+}
+
 // ClearPassword clears the value of the "password" field.
 func (u *FieldTypeUpsertBulk) ClearPassword() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5153,6 +6324,14 @@ func (u *FieldTypeUpsertBulk) UpdateStringScanner() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreStringScanner keeps the current value of the "string_scanner" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreStringScanner() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStringScanner()
+	})
+}
+
 // ClearStringScanner clears the value of the "string_scanner" field.
 func (u *FieldTypeUpsertBulk) ClearStringScanner() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5181,6 +6360,14 @@ func (u *FieldTypeUpsertBulk) UpdateDuration() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreDuration keeps the current value of the "duration" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreDuration() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDuration()
+	})
+}
+
 // ClearDuration clears the value of the "duration" field.
 func (u *FieldTypeUpsertBulk) ClearDuration() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5202,6 +6389,14 @@ func (u *FieldTypeUpsertBulk) UpdateDir() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreDir keeps the current value of the "dir" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreDir() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDir()
+	})
+}
+
 // SetNdir sets the "ndir" field.
 func (u *FieldTypeUpsertBulk) SetNdir(v http.Dir) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5216,6 +6411,14 @@ func (u *FieldTypeUpsertBulk) UpdateNdir() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNdir keeps the current value of the "ndir" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNdir() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNdir()
+	})
+}
+
 // ClearNdir clears the value of the "ndir" field.
 func (u *FieldTypeUpsertBulk) ClearNdir() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5237,6 +6440,14 @@ func (u *FieldTypeUpsertBulk) UpdateStr() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreStr keeps the current value of the "str" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreStr() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStr()
+	})
+}
+
 // ClearStr clears the value of the "str" field.
 func (u *FieldTypeUpsertBulk) ClearStr() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5258,6 +6469,14 @@ func (u *FieldTypeUpsertBulk) UpdateNullStr() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNullStr keeps the current value of the "null_str" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNullStr() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullStr()
+	})
+}
+
 // ClearNullStr clears the value of the "null_str" field.
 func (u *FieldTypeUpsertBulk) ClearNullStr() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5279,6 +6498,14 @@ func (u *FieldTypeUpsertBulk) UpdateLink() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreLink keeps the current value of the "link" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreLink() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreLink()
+	})
+}
+
 // ClearLink clears the value of the "link" field.
 func (u *FieldTypeUpsertBulk) ClearLink() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5300,6 +6527,14 @@ func (u *FieldTypeUpsertBulk) UpdateNullLink() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNullLink keeps the current value of the "null_link" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNullLink() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullLink()
+	})
+}
+
 // ClearNullLink clears the value of the "null_link" field.
 func (u *FieldTypeUpsertBulk) ClearNullLink() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5321,6 +6556,14 @@ func (u *FieldTypeUpsertBulk) UpdateActive() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreActive keeps the current value of the "active" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreActive() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreActive()
+	})
+}
+
 // ClearActive clears the value of the "active" field.
 func (u *FieldTypeUpsertBulk) ClearActive() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5342,6 +6585,14 @@ func (u *FieldTypeUpsertBulk) UpdateNullActive() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNullActive keeps the current value of the "null_active" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNullActive() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullActive()
+	})
+}
+
 // ClearNullActive clears the value of the "null_active" field.
 func (u *FieldTypeUpsertBulk) ClearNullActive() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5363,6 +6614,14 @@ func (u *FieldTypeUpsertBulk) UpdateDeleted() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreDeleted keeps the current value of the "deleted" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreDeleted() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDeleted()
+	})
+}
+
 // ClearDeleted clears the value of the "deleted" field.
 func (u *FieldTypeUpsertBulk) ClearDeleted() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5384,6 +6643,14 @@ func (u *FieldTypeUpsertBulk) UpdateDeletedAt() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreDeletedAt keeps the current value of the "deleted_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreDeletedAt() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreDeletedAt()
+	})
+}
+
 // ClearDeletedAt clears the value of the "deleted_at" field.
 func (u *FieldTypeUpsertBulk) ClearDeletedAt() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5405,6 +6672,14 @@ func (u *FieldTypeUpsertBulk) UpdateRawData() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreRawData keeps the current value of the "raw_data" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreRawData() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreRawData()
+	})
+}
+
 // ClearRawData clears the value of the "raw_data" field.
 func (u *FieldTypeUpsertBulk) ClearRawData() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5426,6 +6701,14 @@ func (u *FieldTypeUpsertBulk) UpdateSensitive() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreSensitive keeps the current value of the "sensitive" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreSensitive() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSensitive()
+	})
+}
+
 // ClearSensitive clears the value of the "sensitive" field.
 func (u *FieldTypeUpsertBulk) ClearSensitive() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5447,6 +6730,14 @@ func (u *FieldTypeUpsertBulk) UpdateIP() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreIP keeps the current value of the "ip" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreIP() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreIP()
+	})
+}
+
 // ClearIP clears the value of the "ip" field.
 func (u *FieldTypeUpsertBulk) ClearIP() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5468,6 +6759,14 @@ func (u *FieldTypeUpsertBulk) UpdateNullInt64() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNullInt64 keeps the current value of the "null_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNullInt64() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullInt64()
+	})
+}
+
 // ClearNullInt64 clears the value of the "null_int64" field.
 func (u *FieldTypeUpsertBulk) ClearNullInt64() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5496,6 +6795,14 @@ func (u *FieldTypeUpsertBulk) UpdateSchemaInt() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreSchemaInt keeps the current value of the "schema_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreSchemaInt() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaInt()
+	})
+}
+
 // ClearSchemaInt clears the value of the "schema_int" field.
 func (u *FieldTypeUpsertBulk) ClearSchemaInt() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5524,6 +6831,14 @@ func (u *FieldTypeUpsertBulk) UpdateSchemaInt8() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreSchemaInt8 keeps the current value of the "schema_int8" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreSchemaInt8() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaInt8()
+	})
+}
+
 // ClearSchemaInt8 clears the value of the "schema_int8" field.
 func (u *FieldTypeUpsertBulk) ClearSchemaInt8() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5552,6 +6867,14 @@ func (u *FieldTypeUpsertBulk) UpdateSchemaInt64() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreSchemaInt64 keeps the current value of the "schema_int64" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreSchemaInt64() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaInt64()
+	})
+}
+
 // ClearSchemaInt64 clears the value of the "schema_int64" field.
 func (u *FieldTypeUpsertBulk) ClearSchemaInt64() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5580,6 +6903,14 @@ func (u *FieldTypeUpsertBulk) UpdateSchemaFloat() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreSchemaFloat keeps the current value of the "schema_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreSchemaFloat() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaFloat()
+	})
+}
+
 // ClearSchemaFloat clears the value of the "schema_float" field.
 func (u *FieldTypeUpsertBulk) ClearSchemaFloat() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5608,6 +6939,14 @@ func (u *FieldTypeUpsertBulk) UpdateSchemaFloat32() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreSchemaFloat32 keeps the current value of the "schema_float32" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreSchemaFloat32() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreSchemaFloat32()
+	})
+}
+
 // ClearSchemaFloat32 clears the value of the "schema_float32" field.
 func (u *FieldTypeUpsertBulk) ClearSchemaFloat32() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5629,6 +6968,14 @@ func (u *FieldTypeUpsertBulk) UpdateNullFloat() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNullFloat keeps the current value of the "null_float" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNullFloat() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNullFloat()
+	})
+}
+
 // ClearNullFloat clears the value of the "null_float" field.
 func (u *FieldTypeUpsertBulk) ClearNullFloat() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5650,6 +6997,14 @@ func (u *FieldTypeUpsertBulk) UpdateRole() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreRole keeps the current value of the "role" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreRole() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreRole()
+	})
+}
+
 // SetPriority sets the "priority" field.
 func (u *FieldTypeUpsertBulk) SetPriority(v role.Priority) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5664,6 +7019,14 @@ func (u *FieldTypeUpsertBulk) UpdatePriority() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnorePriority keeps the current value of the "priority" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnorePriority() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePriority()
+	})
+}
+
 // ClearPriority clears the value of the "priority" field.
 func (u *FieldTypeUpsertBulk) ClearPriority() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5685,6 +7048,14 @@ func (u *FieldTypeUpsertBulk) UpdateOptionalUUID() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreOptionalUUID keeps the current value of the "optional_uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreOptionalUUID() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreOptionalUUID()
+	})
+}
+
 // ClearOptionalUUID clears the value of the "optional_uuid" field.
 func (u *FieldTypeUpsertBulk) ClearOptionalUUID() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5706,6 +7077,14 @@ func (u *FieldTypeUpsertBulk) UpdateNillableUUID() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNillableUUID keeps the current value of the "nillable_uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNillableUUID() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNillableUUID()
+	})
+}
+
 // ClearNillableUUID clears the value of the "nillable_uuid" field.
 func (u *FieldTypeUpsertBulk) ClearNillableUUID() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5727,6 +7106,14 @@ func (u *FieldTypeUpsertBulk) UpdateStrings() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreStrings keeps the current value of the "strings" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreStrings() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreStrings()
+	})
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (u *FieldTypeUpsertBulk) ClearStrings() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5748,6 +7135,14 @@ func (u *FieldTypeUpsertBulk) UpdatePair() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnorePair keeps the current value of the "pair" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnorePair() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePair()
+	})
+}
+
 // SetNilPair sets the "nil_pair" field.
 func (u *FieldTypeUpsertBulk) SetNilPair(v *schema.Pair) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5762,6 +7157,14 @@ func (u *FieldTypeUpsertBulk) UpdateNilPair() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreNilPair keeps the current value of the "nil_pair" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreNilPair() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreNilPair()
+	})
+}
+
 // ClearNilPair clears the value of the "nil_pair" field.
 func (u *FieldTypeUpsertBulk) ClearNilPair() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5783,6 +7186,14 @@ func (u *FieldTypeUpsertBulk) UpdateVstring() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreVstring keeps the current value of the "vstring" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreVstring() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreVstring()
+	})
+}
+
 // SetTriple sets the "triple" field.
 func (u *FieldTypeUpsertBulk) SetTriple(v schema.Triple) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5797,6 +7208,14 @@ func (u *FieldTypeUpsertBulk) UpdateTriple() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreTriple keeps the current value of the "triple" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreTriple() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreTriple()
+	})
+}
+
 // SetBigInt sets the "big_int" field.
 func (u *FieldTypeUpsertBulk) SetBigInt(v schema.BigInt) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5818,6 +7237,14 @@ func (u *FieldTypeUpsertBulk) UpdateBigInt() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnoreBigInt keeps the current value of the "big_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnoreBigInt() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnoreBigInt()
+	})
+}
+
 // ClearBigInt clears the value of the "big_int" field.
 func (u *FieldTypeUpsertBulk) ClearBigInt() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -5839,6 +7266,14 @@ func (u *FieldTypeUpsertBulk) UpdatePasswordOther() *FieldTypeUpsertBulk {
 	})
 }
 
+// IgnorePasswordOther keeps the current value of the "password_other" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FieldTypeUpsertBulk) IgnorePasswordOther() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.IgnorePasswordOther()
+	})
+}
+
 // ClearPasswordOther clears the value of the "password_other" field.
 func (u *FieldTypeUpsertBulk) ClearPasswordOther() *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
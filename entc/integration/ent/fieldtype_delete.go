@@ -19,8 +19,9 @@ import (
 // FieldTypeDelete is the builder for deleting a FieldType entity.
 type FieldTypeDelete struct {
 	config
-	hooks    []Hook
-	mutation *FieldTypeMutation
+	hooks     []Hook
+	mutation  *FieldTypeMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the FieldTypeDelete builder.
@@ -43,8 +44,21 @@ func (ftd *FieldTypeDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ftd *FieldTypeDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FieldTypeDelete {
+	ftd.modifiers = append(ftd.modifiers, modifiers...)
+	return ftd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ftdo *FieldTypeDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FieldTypeDeleteOne {
+	ftdo.ftd.Modify(modifiers...)
+	return ftdo
+}
+
 func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(fieldtype.Table, sqlgraph.NewFieldSpec(fieldtype.FieldID, field.TypeInt))
+	_spec.AddModifiers(ftd.modifiers...)
 	if ps := ftd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, ftd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	ftd.mutation.done = true
 	return affected, err
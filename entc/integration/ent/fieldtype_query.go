@@ -323,7 +323,7 @@ func (ftq *FieldTypeQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range ftq.ctx.Fields {
 		if !fieldtype.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "FieldType", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if ftq.path != nil {
@@ -487,10 +487,53 @@ func (ftq *FieldTypeQuery) Modify(modifiers ...func(s *sql.Selector)) *FieldType
 	return ftq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (ftq *FieldTypeQuery) Union(queries ...*FieldTypeQuery) *FieldTypeQuery {
+	return ftq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (ftq *FieldTypeQuery) UnionAll(queries ...*FieldTypeQuery) *FieldTypeQuery {
+	return ftq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (ftq *FieldTypeQuery) Intersect(queries ...*FieldTypeQuery) *FieldTypeQuery {
+	return ftq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (ftq *FieldTypeQuery) Except(queries ...*FieldTypeQuery) *FieldTypeQuery {
+	return ftq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (ftq *FieldTypeQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*FieldTypeQuery) *FieldTypeQuery {
+	ftq.modifiers = append(ftq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return ftq
+}
+
 // FieldTypeGroupBy is the group-by builder for FieldType entities.
 type FieldTypeGroupBy struct {
 	selector
-	build *FieldTypeQuery
+	build  *FieldTypeQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -508,6 +551,14 @@ func (ftgb *FieldTypeGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*FieldTypeQuery, *FieldTypeGroupBy](ctx, ftgb.build, ftgb, ftgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ftgb *FieldTypeGroupBy) Having(ps ...*sql.Predicate) *FieldTypeGroupBy {
+	ftgb.having = append(ftgb.having, ps...)
+	return ftgb
+}
+
 func (ftgb *FieldTypeGroupBy) sqlScan(ctx context.Context, root *FieldTypeQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ftgb.fns))
@@ -523,6 +574,9 @@ func (ftgb *FieldTypeGroupBy) sqlScan(ctx context.Context, root *FieldTypeQuery,
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ftgb.flds...)...)
+	if len(ftgb.having) > 0 {
+		selector.Having(sql.And(ftgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
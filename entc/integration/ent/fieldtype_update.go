@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -1393,52 +1394,53 @@ func (ftu *FieldTypeUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftu *FieldTypeUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "validate_optional_int32", Entity: "FieldType", Field: "validate_optional_int32", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "mac", Entity: "FieldType", Field: "mac", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ndir", Entity: "FieldType", Field: "ndir", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "link", Entity: "FieldType", Field: "link", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "raw_data", Entity: "FieldType", Field: "raw_data", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ip", Entity: "FieldType", Field: "ip", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -1922,7 +1924,7 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fieldtype.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -3306,52 +3308,53 @@ func (ftuo *FieldTypeUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftuo *FieldTypeUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "validate_optional_int32", Entity: "FieldType", Field: "validate_optional_int32", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "mac", Entity: "FieldType", Field: "mac", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ndir", Entity: "FieldType", Field: "ndir", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "link", Entity: "FieldType", Field: "link", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "raw_data", Entity: "FieldType", Field: "raw_data", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ip", Entity: "FieldType", Field: "ip", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -3367,7 +3370,7 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 	_spec := sqlgraph.NewUpdateSpec(fieldtype.Table, fieldtype.Columns, sqlgraph.NewFieldSpec(fieldtype.FieldID, field.TypeInt))
 	id, ok := ftuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FieldType.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "FieldType", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "FieldType.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := ftuo.fields; len(fields) > 0 {
@@ -3375,7 +3378,7 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 		_spec.Node.Columns = append(_spec.Node.Columns, fieldtype.FieldID)
 		for _, f := range fields {
 			if !fieldtype.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "FieldType", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != fieldtype.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -3855,10 +3858,72 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fieldtype.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	ftuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (ftuo *FieldTypeUpdateOne) sameUpdate(other *FieldTypeUpdateOne) bool {
+	if len(ftuo.modifiers) > 0 || len(other.modifiers) > 0 {
+		return false
+	}
+	m1, m2 := *ftuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// FieldTypeUpdateMany is the builder for updating many FieldType entities by their ids.
+type FieldTypeUpdateMany struct {
+	config
+	builders []*FieldTypeUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (ftum *FieldTypeUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*FieldTypeUpdateOne
+Builders:
+	for _, b := range ftum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*FieldTypeUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(fieldtype.IDIn(ids...))
+		if err := (&FieldTypeUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftum *FieldTypeUpdateMany) ExecX(ctx context.Context) {
+	if err := ftum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -187,6 +187,11 @@ func NameContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // UserEQ applies the EQ predicate on the "user" field.
 func UserEQ(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldUser, v))
@@ -262,6 +267,11 @@ func UserContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldUser, v))
 }
 
+// UserHasPrefixFold applies the HasPrefixFold predicate on the "user" field.
+func UserHasPrefixFold(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefixFold(FieldUser, v))
+}
+
 // GroupEQ applies the EQ predicate on the "group" field.
 func GroupEQ(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldGroup, v))
@@ -337,6 +347,11 @@ func GroupContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldGroup, v))
 }
 
+// GroupHasPrefixFold applies the HasPrefixFold predicate on the "group" field.
+func GroupHasPrefixFold(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefixFold(FieldGroup, v))
+}
+
 // OpEQ applies the EQ predicate on the "op" field.
 func OpEQ(v bool) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldOp, v))
@@ -200,18 +200,19 @@ func (fc *FileCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (fc *FileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Size(); !ok {
-		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
+		errs = append(errs, &ValidationError{Name: "size", Entity: "File", Field: "size", Reason: "missing required field", err: errors.New(`ent: missing required field "File.size"`)})
 	}
 	if v, ok := fc.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "size", Entity: "File", Field: "size", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)})
 		}
 	}
 	if _, ok := fc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "File.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "File", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "File.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
@@ -221,7 +222,7 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 	_node, _spec := fc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -376,6 +377,12 @@ func (u *FileUpsert) UpdateSize() *FileUpsert {
 	return u
 }
 
+// IgnoreSize keeps the current value of the "size" field in case of conflict.
+func (u *FileUpsert) IgnoreSize() *FileUpsert {
+	u.SetIgnore(file.FieldSize)
+	return u
+}
+
 // AddSize adds v to the "size" field.
 func (u *FileUpsert) AddSize(v int) *FileUpsert {
 	u.Add(file.FieldSize, v)
@@ -394,6 +401,12 @@ func (u *FileUpsert) UpdateName() *FileUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *FileUpsert) IgnoreName() *FileUpsert {
+	u.SetIgnore(file.FieldName)
+	return u
+}
+
 // SetUser sets the "user" field.
 func (u *FileUpsert) SetUser(v string) *FileUpsert {
 	u.Set(file.FieldUser, v)
@@ -406,6 +419,12 @@ func (u *FileUpsert) UpdateUser() *FileUpsert {
 	return u
 }
 
+// IgnoreUser keeps the current value of the "user" field in case of conflict.
+func (u *FileUpsert) IgnoreUser() *FileUpsert {
+	u.SetIgnore(file.FieldUser)
+	return u
+}
+
 // ClearUser clears the value of the "user" field.
 func (u *FileUpsert) ClearUser() *FileUpsert {
 	u.SetNull(file.FieldUser)
@@ -424,6 +443,12 @@ func (u *FileUpsert) UpdateGroup() *FileUpsert {
 	return u
 }
 
+// IgnoreGroup keeps the current value of the "group" field in case of conflict.
+func (u *FileUpsert) IgnoreGroup() *FileUpsert {
+	u.SetIgnore(file.FieldGroup)
+	return u
+}
+
 // ClearGroup clears the value of the "group" field.
 func (u *FileUpsert) ClearGroup() *FileUpsert {
 	u.SetNull(file.FieldGroup)
@@ -442,6 +467,12 @@ func (u *FileUpsert) UpdateOp() *FileUpsert {
 	return u
 }
 
+// IgnoreOp keeps the current value of the "op" field in case of conflict.
+func (u *FileUpsert) IgnoreOp() *FileUpsert {
+	u.SetIgnore(file.FieldOp)
+	return u
+}
+
 // ClearOp clears the value of the "op" field.
 func (u *FileUpsert) ClearOp() *FileUpsert {
 	u.SetNull(file.FieldOp)
@@ -460,6 +491,12 @@ func (u *FileUpsert) UpdateFieldID() *FileUpsert {
 	return u
 }
 
+// IgnoreFieldID keeps the current value of the "field_id" field in case of conflict.
+func (u *FileUpsert) IgnoreFieldID() *FileUpsert {
+	u.SetIgnore(file.FieldFieldID)
+	return u
+}
+
 // AddFieldID adds v to the "field_id" field.
 func (u *FileUpsert) AddFieldID(v int) *FileUpsert {
 	u.Add(file.FieldFieldID, v)
@@ -533,6 +570,14 @@ func (u *FileUpsertOne) UpdateSize() *FileUpsertOne {
 	})
 }
 
+// IgnoreSize keeps the current value of the "size" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreSize() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreSize()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *FileUpsertOne) SetName(v string) *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -547,6 +592,14 @@ func (u *FileUpsertOne) UpdateName() *FileUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetUser sets the "user" field.
 func (u *FileUpsertOne) SetUser(v string) *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -561,6 +614,14 @@ func (u *FileUpsertOne) UpdateUser() *FileUpsertOne {
 	})
 }
 
+// IgnoreUser keeps the current value of the "user" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreUser() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreUser()
+	})
+}
+
 // ClearUser clears the value of the "user" field.
 func (u *FileUpsertOne) ClearUser() *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -582,6 +643,14 @@ func (u *FileUpsertOne) UpdateGroup() *FileUpsertOne {
 	})
 }
 
+// IgnoreGroup keeps the current value of the "group" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreGroup() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreGroup()
+	})
+}
+
 // ClearGroup clears the value of the "group" field.
 func (u *FileUpsertOne) ClearGroup() *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -603,6 +672,14 @@ func (u *FileUpsertOne) UpdateOp() *FileUpsertOne {
 	})
 }
 
+// IgnoreOp keeps the current value of the "op" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreOp() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreOp()
+	})
+}
+
 // ClearOp clears the value of the "op" field.
 func (u *FileUpsertOne) ClearOp() *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -631,6 +708,14 @@ func (u *FileUpsertOne) UpdateFieldID() *FileUpsertOne {
 	})
 }
 
+// IgnoreFieldID keeps the current value of the "field_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertOne) IgnoreFieldID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreFieldID()
+	})
+}
+
 // ClearFieldID clears the value of the "field_id" field.
 func (u *FileUpsertOne) ClearFieldID() *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -674,12 +759,16 @@ func (u *FileUpsertOne) IDX(ctx context.Context) int {
 // FileCreateBulk is the builder for creating many File entities in bulk.
 type FileCreateBulk struct {
 	config
+	err      error
 	builders []*FileCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the File entities in the database.
 func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
 	nodes := make([]*File, len(fcb.builders))
 	mutators := make([]Mutator, len(fcb.builders))
@@ -706,7 +795,7 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -859,6 +948,14 @@ func (u *FileUpsertBulk) UpdateSize() *FileUpsertBulk {
 	})
 }
 
+// IgnoreSize keeps the current value of the "size" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreSize() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreSize()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *FileUpsertBulk) SetName(v string) *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -873,6 +970,14 @@ func (u *FileUpsertBulk) UpdateName() *FileUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetUser sets the "user" field.
 func (u *FileUpsertBulk) SetUser(v string) *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -887,6 +992,14 @@ func (u *FileUpsertBulk) UpdateUser() *FileUpsertBulk {
 	})
 }
 
+// IgnoreUser keeps the current value of the "user" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreUser() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreUser()
+	})
+}
+
 // ClearUser clears the value of the "user" field.
 func (u *FileUpsertBulk) ClearUser() *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -908,6 +1021,14 @@ func (u *FileUpsertBulk) UpdateGroup() *FileUpsertBulk {
 	})
 }
 
+// IgnoreGroup keeps the current value of the "group" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreGroup() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreGroup()
+	})
+}
+
 // ClearGroup clears the value of the "group" field.
 func (u *FileUpsertBulk) ClearGroup() *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -929,6 +1050,14 @@ func (u *FileUpsertBulk) UpdateOp() *FileUpsertBulk {
 	})
 }
 
+// IgnoreOp keeps the current value of the "op" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreOp() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreOp()
+	})
+}
+
 // ClearOp clears the value of the "op" field.
 func (u *FileUpsertBulk) ClearOp() *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -957,6 +1086,14 @@ func (u *FileUpsertBulk) UpdateFieldID() *FileUpsertBulk {
 	})
 }
 
+// IgnoreFieldID keeps the current value of the "field_id" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileUpsertBulk) IgnoreFieldID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.IgnoreFieldID()
+	})
+}
+
 // ClearFieldID clears the value of the "field_id" field.
 func (u *FileUpsertBulk) ClearFieldID() *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -19,8 +19,9 @@ import (
 // FileDelete is the builder for deleting a File entity.
 type FileDelete struct {
 	config
-	hooks    []Hook
-	mutation *FileMutation
+	hooks     []Hook
+	mutation  *FileMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the FileDelete builder.
@@ -43,8 +44,21 @@ func (fd *FileDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (fd *FileDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FileDelete {
+	fd.modifiers = append(fd.modifiers, modifiers...)
+	return fd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (fdo *FileDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FileDeleteOne {
+	fdo.fd.Modify(modifiers...)
+	return fdo
+}
+
 func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(file.Table, sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt))
+	_spec.AddModifiers(fd.modifiers...)
 	if ps := fd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, fd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	fd.mutation.done = true
 	return affected, err
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -269,12 +270,13 @@ func (fu *FileUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fu *FileUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := fu.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "size", Entity: "File", Field: "size", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -439,7 +441,7 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -703,12 +705,13 @@ func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (fuo *FileUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := fuo.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "size", Entity: "File", Field: "size", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -724,7 +727,7 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt))
 	id, ok := fuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "File.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "File", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "File.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := fuo.fields; len(fields) > 0 {
@@ -732,7 +735,7 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 		_spec.Node.Columns = append(_spec.Node.Columns, file.FieldID)
 		for _, f := range fields {
 			if !file.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "File", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != file.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -893,10 +896,72 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	fuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (fuo *FileUpdateOne) sameUpdate(other *FileUpdateOne) bool {
+	if len(fuo.modifiers) > 0 || len(other.modifiers) > 0 {
+		return false
+	}
+	m1, m2 := *fuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// FileUpdateMany is the builder for updating many File entities by their ids.
+type FileUpdateMany struct {
+	config
+	builders []*FileUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (fum *FileUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*FileUpdateOne
+Builders:
+	for _, b := range fum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*FileUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(file.IDIn(ids...))
+		if err := (&FileUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fum *FileUpdateMany) ExecX(ctx context.Context) {
+	if err := fum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
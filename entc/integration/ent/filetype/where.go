@@ -127,6 +127,11 @@ func NameContainsFold(v string) predicate.FileType {
 	return predicate.FileType(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.FileType {
+	return predicate.FileType(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.FileType {
 	return predicate.FileType(sql.FieldEQ(FieldType, v))
@@ -122,26 +122,27 @@ func (ftc *FileTypeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftc *FileTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FileType.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "FileType", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "FileType.name"`)})
 	}
 	if _, ok := ftc.mutation.GetType(); !ok {
-		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FileType.type"`)}
+		errs = append(errs, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: "missing required field", err: errors.New(`ent: missing required field "FileType.type"`)})
 	}
 	if v, ok := ftc.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.State(); !ok {
-		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "FileType.state"`)}
+		errs = append(errs, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: "missing required field", err: errors.New(`ent: missing required field "FileType.state"`)})
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
@@ -151,7 +152,7 @@ func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
 	_node, _spec := ftc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ftc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -260,6 +261,12 @@ func (u *FileTypeUpsert) UpdateName() *FileTypeUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *FileTypeUpsert) IgnoreName() *FileTypeUpsert {
+	u.SetIgnore(filetype.FieldName)
+	return u
+}
+
 // SetType sets the "type" field.
 func (u *FileTypeUpsert) SetType(v filetype.Type) *FileTypeUpsert {
 	u.Set(filetype.FieldType, v)
@@ -272,6 +279,12 @@ func (u *FileTypeUpsert) UpdateType() *FileTypeUpsert {
 	return u
 }
 
+// IgnoreType keeps the current value of the "type" field in case of conflict.
+func (u *FileTypeUpsert) IgnoreType() *FileTypeUpsert {
+	u.SetIgnore(filetype.FieldType)
+	return u
+}
+
 // SetState sets the "state" field.
 func (u *FileTypeUpsert) SetState(v filetype.State) *FileTypeUpsert {
 	u.Set(filetype.FieldState, v)
@@ -284,6 +297,12 @@ func (u *FileTypeUpsert) UpdateState() *FileTypeUpsert {
 	return u
 }
 
+// IgnoreState keeps the current value of the "state" field in case of conflict.
+func (u *FileTypeUpsert) IgnoreState() *FileTypeUpsert {
+	u.SetIgnore(filetype.FieldState)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -338,6 +357,14 @@ func (u *FileTypeUpsertOne) UpdateName() *FileTypeUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileTypeUpsertOne) IgnoreName() *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetType sets the "type" field.
 func (u *FileTypeUpsertOne) SetType(v filetype.Type) *FileTypeUpsertOne {
 	return u.Update(func(s *FileTypeUpsert) {
@@ -352,6 +379,14 @@ func (u *FileTypeUpsertOne) UpdateType() *FileTypeUpsertOne {
 	})
 }
 
+// IgnoreType keeps the current value of the "type" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileTypeUpsertOne) IgnoreType() *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.IgnoreType()
+	})
+}
+
 // SetState sets the "state" field.
 func (u *FileTypeUpsertOne) SetState(v filetype.State) *FileTypeUpsertOne {
 	return u.Update(func(s *FileTypeUpsert) {
@@ -366,6 +401,14 @@ func (u *FileTypeUpsertOne) UpdateState() *FileTypeUpsertOne {
 	})
 }
 
+// IgnoreState keeps the current value of the "state" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileTypeUpsertOne) IgnoreState() *FileTypeUpsertOne {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.IgnoreState()
+	})
+}
+
 // Exec executes the query.
 func (u *FileTypeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -402,12 +445,16 @@ func (u *FileTypeUpsertOne) IDX(ctx context.Context) int {
 // FileTypeCreateBulk is the builder for creating many FileType entities in bulk.
 type FileTypeCreateBulk struct {
 	config
+	err      error
 	builders []*FileTypeCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the FileType entities in the database.
 func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
+	if ftcb.err != nil {
+		return nil, ftcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ftcb.builders))
 	nodes := make([]*FileType, len(ftcb.builders))
 	mutators := make([]Mutator, len(ftcb.builders))
@@ -434,7 +481,7 @@ func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ftcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -580,6 +627,14 @@ func (u *FileTypeUpsertBulk) UpdateName() *FileTypeUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileTypeUpsertBulk) IgnoreName() *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetType sets the "type" field.
 func (u *FileTypeUpsertBulk) SetType(v filetype.Type) *FileTypeUpsertBulk {
 	return u.Update(func(s *FileTypeUpsert) {
@@ -594,6 +649,14 @@ func (u *FileTypeUpsertBulk) UpdateType() *FileTypeUpsertBulk {
 	})
 }
 
+// IgnoreType keeps the current value of the "type" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileTypeUpsertBulk) IgnoreType() *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.IgnoreType()
+	})
+}
+
 // SetState sets the "state" field.
 func (u *FileTypeUpsertBulk) SetState(v filetype.State) *FileTypeUpsertBulk {
 	return u.Update(func(s *FileTypeUpsert) {
@@ -608,6 +671,14 @@ func (u *FileTypeUpsertBulk) UpdateState() *FileTypeUpsertBulk {
 	})
 }
 
+// IgnoreState keeps the current value of the "state" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *FileTypeUpsertBulk) IgnoreState() *FileTypeUpsertBulk {
+	return u.Update(func(s *FileTypeUpsert) {
+		s.IgnoreState()
+	})
+}
+
 // Exec executes the query.
 func (u *FileTypeUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
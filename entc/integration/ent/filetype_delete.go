@@ -19,8 +19,9 @@ import (
 // FileTypeDelete is the builder for deleting a FileType entity.
 type FileTypeDelete struct {
 	config
-	hooks    []Hook
-	mutation *FileTypeMutation
+	hooks     []Hook
+	mutation  *FileTypeMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the FileTypeDelete builder.
@@ -43,8 +44,21 @@ func (ftd *FileTypeDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ftd *FileTypeDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FileTypeDelete {
+	ftd.modifiers = append(ftd.modifiers, modifiers...)
+	return ftd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ftdo *FileTypeDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FileTypeDeleteOne {
+	ftdo.ftd.Modify(modifiers...)
+	return ftdo
+}
+
 func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(filetype.Table, sqlgraph.NewFieldSpec(filetype.FieldID, field.TypeInt))
+	_spec.AddModifiers(ftd.modifiers...)
 	if ps := ftd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, ftd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	ftd.mutation.done = true
 	return affected, err
@@ -360,7 +360,7 @@ func (ftq *FileTypeQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range ftq.ctx.Fields {
 		if !filetype.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "FileType", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if ftq.path != nil {
@@ -420,6 +420,22 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fi
 }
 
 func (ftq *FileTypeQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*FileType, init func(*FileType), assign func(*FileType, *File)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := ftq.loadFiles(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*FileType)
 	for i := range nodes {
@@ -584,10 +600,53 @@ func (ftq *FileTypeQuery) WithNamedFiles(name string, opts ...func(*FileQuery))
 	return ftq
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (ftq *FileTypeQuery) Union(queries ...*FileTypeQuery) *FileTypeQuery {
+	return ftq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (ftq *FileTypeQuery) UnionAll(queries ...*FileTypeQuery) *FileTypeQuery {
+	return ftq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (ftq *FileTypeQuery) Intersect(queries ...*FileTypeQuery) *FileTypeQuery {
+	return ftq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (ftq *FileTypeQuery) Except(queries ...*FileTypeQuery) *FileTypeQuery {
+	return ftq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (ftq *FileTypeQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*FileTypeQuery) *FileTypeQuery {
+	ftq.modifiers = append(ftq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return ftq
+}
+
 // FileTypeGroupBy is the group-by builder for FileType entities.
 type FileTypeGroupBy struct {
 	selector
-	build *FileTypeQuery
+	build  *FileTypeQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -605,6 +664,14 @@ func (ftgb *FileTypeGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*FileTypeQuery, *FileTypeGroupBy](ctx, ftgb.build, ftgb, ftgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ftgb *FileTypeGroupBy) Having(ps ...*sql.Predicate) *FileTypeGroupBy {
+	ftgb.having = append(ftgb.having, ps...)
+	return ftgb
+}
+
 func (ftgb *FileTypeGroupBy) sqlScan(ctx context.Context, root *FileTypeQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ftgb.fns))
@@ -620,6 +687,9 @@ func (ftgb *FileTypeGroupBy) sqlScan(ctx context.Context, root *FileTypeQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ftgb.flds...)...)
+	if len(ftgb.having) > 0 {
+		selector.Having(sql.And(ftgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
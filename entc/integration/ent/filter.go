@@ -0,0 +1,8522 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"time"
+
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/ent/api"
+	"entgo.io/ent/entc/integration/ent/builder"
+	"entgo.io/ent/entc/integration/ent/card"
+	"entgo.io/ent/entc/integration/ent/comment"
+	"entgo.io/ent/entc/integration/ent/exvaluescan"
+	"entgo.io/ent/entc/integration/ent/fieldtype"
+	"entgo.io/ent/entc/integration/ent/file"
+	"entgo.io/ent/entc/integration/ent/filetype"
+	"entgo.io/ent/entc/integration/ent/goods"
+	"entgo.io/ent/entc/integration/ent/group"
+	"entgo.io/ent/entc/integration/ent/groupinfo"
+	"entgo.io/ent/entc/integration/ent/item"
+	"entgo.io/ent/entc/integration/ent/license"
+	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/pc"
+	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/predicate"
+	"entgo.io/ent/entc/integration/ent/role"
+	"entgo.io/ent/entc/integration/ent/schema"
+	"entgo.io/ent/entc/integration/ent/schema/task"
+	"entgo.io/ent/entc/integration/ent/spec"
+	enttask "entgo.io/ent/entc/integration/ent/task"
+	"entgo.io/ent/entc/integration/ent/user"
+	"github.com/google/uuid"
+)
+
+// ApiFilterInput is a typed filter of Api entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the api package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.ApiFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Api.Query())
+type ApiFilterInput struct {
+	Not *ApiFilterInput   `json:"not,omitempty"`
+	And []*ApiFilterInput `json:"and,omitempty"`
+	Or  []*ApiFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *ApiFilterInput) Apply(q *APIQuery) (*APIQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *ApiFilterInput) P() (predicate.Api, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Api
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, api.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*ApiFilterInput
+		join    func(...predicate.Api) predicate.Api
+	}{
+		{filters: f.And, join: api.And},
+		{filters: f.Or, join: api.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Api, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, api.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, api.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, api.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, api.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, api.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, api.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, api.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, api.IDLTE(*f.IDLTE))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return api.And(preds...), nil
+	}
+}
+
+// BuilderFilterInput is a typed filter of Builder entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the builder package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.BuilderFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Builder.Query())
+type BuilderFilterInput struct {
+	Not *BuilderFilterInput   `json:"not,omitempty"`
+	And []*BuilderFilterInput `json:"and,omitempty"`
+	Or  []*BuilderFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *BuilderFilterInput) Apply(q *BuilderQuery) (*BuilderQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *BuilderFilterInput) P() (predicate.Builder, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Builder
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, builder.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*BuilderFilterInput
+		join    func(...predicate.Builder) predicate.Builder
+	}{
+		{filters: f.And, join: builder.And},
+		{filters: f.Or, join: builder.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Builder, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, builder.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, builder.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, builder.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, builder.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, builder.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, builder.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, builder.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, builder.IDLTE(*f.IDLTE))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return builder.And(preds...), nil
+	}
+}
+
+// CardFilterInput is a typed filter of Card entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the card package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.CardFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Card.Query())
+type CardFilterInput struct {
+	Not *CardFilterInput   `json:"not,omitempty"`
+	And []*CardFilterInput `json:"and,omitempty"`
+	Or  []*CardFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "create_time" field predicates.
+	CreateTime      *time.Time  `json:"createTime,omitempty"`
+	CreateTimeNEQ   *time.Time  `json:"createTimeNEQ,omitempty"`
+	CreateTimeIn    []time.Time `json:"createTimeIn,omitempty"`
+	CreateTimeNotIn []time.Time `json:"createTimeNotIn,omitempty"`
+	CreateTimeGT    *time.Time  `json:"createTimeGT,omitempty"`
+	CreateTimeGTE   *time.Time  `json:"createTimeGTE,omitempty"`
+	CreateTimeLT    *time.Time  `json:"createTimeLT,omitempty"`
+	CreateTimeLTE   *time.Time  `json:"createTimeLTE,omitempty"`
+
+	// "update_time" field predicates.
+	UpdateTime      *time.Time  `json:"updateTime,omitempty"`
+	UpdateTimeNEQ   *time.Time  `json:"updateTimeNEQ,omitempty"`
+	UpdateTimeIn    []time.Time `json:"updateTimeIn,omitempty"`
+	UpdateTimeNotIn []time.Time `json:"updateTimeNotIn,omitempty"`
+	UpdateTimeGT    *time.Time  `json:"updateTimeGT,omitempty"`
+	UpdateTimeGTE   *time.Time  `json:"updateTimeGTE,omitempty"`
+	UpdateTimeLT    *time.Time  `json:"updateTimeLT,omitempty"`
+	UpdateTimeLTE   *time.Time  `json:"updateTimeLTE,omitempty"`
+
+	// "balance" field predicates.
+	Balance      *float64  `json:"balance,omitempty"`
+	BalanceNEQ   *float64  `json:"balanceNEQ,omitempty"`
+	BalanceIn    []float64 `json:"balanceIn,omitempty"`
+	BalanceNotIn []float64 `json:"balanceNotIn,omitempty"`
+	BalanceGT    *float64  `json:"balanceGT,omitempty"`
+	BalanceGTE   *float64  `json:"balanceGTE,omitempty"`
+	BalanceLT    *float64  `json:"balanceLT,omitempty"`
+	BalanceLTE   *float64  `json:"balanceLTE,omitempty"`
+
+	// "number" field predicates.
+	Number              *string  `json:"number,omitempty"`
+	NumberNEQ           *string  `json:"numberNEQ,omitempty"`
+	NumberIn            []string `json:"numberIn,omitempty"`
+	NumberNotIn         []string `json:"numberNotIn,omitempty"`
+	NumberGT            *string  `json:"numberGT,omitempty"`
+	NumberGTE           *string  `json:"numberGTE,omitempty"`
+	NumberLT            *string  `json:"numberLT,omitempty"`
+	NumberLTE           *string  `json:"numberLTE,omitempty"`
+	NumberContains      *string  `json:"numberContains,omitempty"`
+	NumberHasPrefix     *string  `json:"numberHasPrefix,omitempty"`
+	NumberHasSuffix     *string  `json:"numberHasSuffix,omitempty"`
+	NumberEqualFold     *string  `json:"numberEqualFold,omitempty"`
+	NumberContainsFold  *string  `json:"numberContainsFold,omitempty"`
+	NumberHasPrefixFold *string  `json:"numberHasPrefixFold,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameIsNil         bool     `json:"nameIsNil,omitempty"`
+	NameNotNil        bool     `json:"nameNotNil,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool              `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserFilterInput `json:"hasOwnerWith,omitempty"`
+
+	// "spec" edge predicates.
+	HasSpec     *bool              `json:"hasSpec,omitempty"`
+	HasSpecWith []*SpecFilterInput `json:"hasSpecWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *CardFilterInput) Apply(q *CardQuery) (*CardQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *CardFilterInput) P() (predicate.Card, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Card
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, card.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*CardFilterInput
+		join    func(...predicate.Card) predicate.Card
+	}{
+		{filters: f.And, join: card.And},
+		{filters: f.Or, join: card.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Card, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, card.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, card.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, card.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, card.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, card.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, card.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, card.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, card.IDLTE(*f.IDLTE))
+	}
+	if f.CreateTime != nil {
+		preds = append(preds, card.CreateTimeEQ(*f.CreateTime))
+	}
+	if f.CreateTimeNEQ != nil {
+		preds = append(preds, card.CreateTimeNEQ(*f.CreateTimeNEQ))
+	}
+	if f.CreateTimeIn != nil {
+		preds = append(preds, card.CreateTimeIn(f.CreateTimeIn...))
+	}
+	if f.CreateTimeNotIn != nil {
+		preds = append(preds, card.CreateTimeNotIn(f.CreateTimeNotIn...))
+	}
+	if f.CreateTimeGT != nil {
+		preds = append(preds, card.CreateTimeGT(*f.CreateTimeGT))
+	}
+	if f.CreateTimeGTE != nil {
+		preds = append(preds, card.CreateTimeGTE(*f.CreateTimeGTE))
+	}
+	if f.CreateTimeLT != nil {
+		preds = append(preds, card.CreateTimeLT(*f.CreateTimeLT))
+	}
+	if f.CreateTimeLTE != nil {
+		preds = append(preds, card.CreateTimeLTE(*f.CreateTimeLTE))
+	}
+	if f.UpdateTime != nil {
+		preds = append(preds, card.UpdateTimeEQ(*f.UpdateTime))
+	}
+	if f.UpdateTimeNEQ != nil {
+		preds = append(preds, card.UpdateTimeNEQ(*f.UpdateTimeNEQ))
+	}
+	if f.UpdateTimeIn != nil {
+		preds = append(preds, card.UpdateTimeIn(f.UpdateTimeIn...))
+	}
+	if f.UpdateTimeNotIn != nil {
+		preds = append(preds, card.UpdateTimeNotIn(f.UpdateTimeNotIn...))
+	}
+	if f.UpdateTimeGT != nil {
+		preds = append(preds, card.UpdateTimeGT(*f.UpdateTimeGT))
+	}
+	if f.UpdateTimeGTE != nil {
+		preds = append(preds, card.UpdateTimeGTE(*f.UpdateTimeGTE))
+	}
+	if f.UpdateTimeLT != nil {
+		preds = append(preds, card.UpdateTimeLT(*f.UpdateTimeLT))
+	}
+	if f.UpdateTimeLTE != nil {
+		preds = append(preds, card.UpdateTimeLTE(*f.UpdateTimeLTE))
+	}
+	if f.Balance != nil {
+		preds = append(preds, card.BalanceEQ(*f.Balance))
+	}
+	if f.BalanceNEQ != nil {
+		preds = append(preds, card.BalanceNEQ(*f.BalanceNEQ))
+	}
+	if f.BalanceIn != nil {
+		preds = append(preds, card.BalanceIn(f.BalanceIn...))
+	}
+	if f.BalanceNotIn != nil {
+		preds = append(preds, card.BalanceNotIn(f.BalanceNotIn...))
+	}
+	if f.BalanceGT != nil {
+		preds = append(preds, card.BalanceGT(*f.BalanceGT))
+	}
+	if f.BalanceGTE != nil {
+		preds = append(preds, card.BalanceGTE(*f.BalanceGTE))
+	}
+	if f.BalanceLT != nil {
+		preds = append(preds, card.BalanceLT(*f.BalanceLT))
+	}
+	if f.BalanceLTE != nil {
+		preds = append(preds, card.BalanceLTE(*f.BalanceLTE))
+	}
+	if f.Number != nil {
+		preds = append(preds, card.NumberEQ(*f.Number))
+	}
+	if f.NumberNEQ != nil {
+		preds = append(preds, card.NumberNEQ(*f.NumberNEQ))
+	}
+	if f.NumberIn != nil {
+		preds = append(preds, card.NumberIn(f.NumberIn...))
+	}
+	if f.NumberNotIn != nil {
+		preds = append(preds, card.NumberNotIn(f.NumberNotIn...))
+	}
+	if f.NumberGT != nil {
+		preds = append(preds, card.NumberGT(*f.NumberGT))
+	}
+	if f.NumberGTE != nil {
+		preds = append(preds, card.NumberGTE(*f.NumberGTE))
+	}
+	if f.NumberLT != nil {
+		preds = append(preds, card.NumberLT(*f.NumberLT))
+	}
+	if f.NumberLTE != nil {
+		preds = append(preds, card.NumberLTE(*f.NumberLTE))
+	}
+	if f.NumberContains != nil {
+		preds = append(preds, card.NumberContains(*f.NumberContains))
+	}
+	if f.NumberHasPrefix != nil {
+		preds = append(preds, card.NumberHasPrefix(*f.NumberHasPrefix))
+	}
+	if f.NumberHasSuffix != nil {
+		preds = append(preds, card.NumberHasSuffix(*f.NumberHasSuffix))
+	}
+	if f.NumberEqualFold != nil {
+		preds = append(preds, card.NumberEqualFold(*f.NumberEqualFold))
+	}
+	if f.NumberContainsFold != nil {
+		preds = append(preds, card.NumberContainsFold(*f.NumberContainsFold))
+	}
+	if f.NumberHasPrefixFold != nil {
+		preds = append(preds, card.NumberHasPrefixFold(*f.NumberHasPrefixFold))
+	}
+	if f.Name != nil {
+		preds = append(preds, card.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, card.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, card.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, card.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, card.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, card.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, card.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, card.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, card.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, card.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, card.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameIsNil {
+		preds = append(preds, card.NameIsNil())
+	}
+	if f.NameNotNil {
+		preds = append(preds, card.NameNotNil())
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, card.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, card.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, card.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.HasOwner != nil {
+		p := card.HasOwner()
+		if !*f.HasOwner {
+			p = card.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasOwnerWith))
+		for _, w := range f.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, card.HasOwnerWith(with...))
+	}
+	if f.HasSpec != nil {
+		p := card.HasSpec()
+		if !*f.HasSpec {
+			p = card.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasSpecWith) > 0 {
+		with := make([]predicate.Spec, 0, len(f.HasSpecWith))
+		for _, w := range f.HasSpecWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, card.HasSpecWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return card.And(preds...), nil
+	}
+}
+
+// CommentFilterInput is a typed filter of Comment entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the comment package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.CommentFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Comment.Query())
+type CommentFilterInput struct {
+	Not *CommentFilterInput   `json:"not,omitempty"`
+	And []*CommentFilterInput `json:"and,omitempty"`
+	Or  []*CommentFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "unique_int" field predicates.
+	UniqueInt      *int  `json:"uniqueInt,omitempty"`
+	UniqueIntNEQ   *int  `json:"uniqueIntNEQ,omitempty"`
+	UniqueIntIn    []int `json:"uniqueIntIn,omitempty"`
+	UniqueIntNotIn []int `json:"uniqueIntNotIn,omitempty"`
+	UniqueIntGT    *int  `json:"uniqueIntGT,omitempty"`
+	UniqueIntGTE   *int  `json:"uniqueIntGTE,omitempty"`
+	UniqueIntLT    *int  `json:"uniqueIntLT,omitempty"`
+	UniqueIntLTE   *int  `json:"uniqueIntLTE,omitempty"`
+
+	// "unique_float" field predicates.
+	UniqueFloat      *float64  `json:"uniqueFloat,omitempty"`
+	UniqueFloatNEQ   *float64  `json:"uniqueFloatNEQ,omitempty"`
+	UniqueFloatIn    []float64 `json:"uniqueFloatIn,omitempty"`
+	UniqueFloatNotIn []float64 `json:"uniqueFloatNotIn,omitempty"`
+	UniqueFloatGT    *float64  `json:"uniqueFloatGT,omitempty"`
+	UniqueFloatGTE   *float64  `json:"uniqueFloatGTE,omitempty"`
+	UniqueFloatLT    *float64  `json:"uniqueFloatLT,omitempty"`
+	UniqueFloatLTE   *float64  `json:"uniqueFloatLTE,omitempty"`
+
+	// "nillable_int" field predicates.
+	NillableInt       *int  `json:"nillableInt,omitempty"`
+	NillableIntNEQ    *int  `json:"nillableIntNEQ,omitempty"`
+	NillableIntIn     []int `json:"nillableIntIn,omitempty"`
+	NillableIntNotIn  []int `json:"nillableIntNotIn,omitempty"`
+	NillableIntGT     *int  `json:"nillableIntGT,omitempty"`
+	NillableIntGTE    *int  `json:"nillableIntGTE,omitempty"`
+	NillableIntLT     *int  `json:"nillableIntLT,omitempty"`
+	NillableIntLTE    *int  `json:"nillableIntLTE,omitempty"`
+	NillableIntIsNil  bool  `json:"nillableIntIsNil,omitempty"`
+	NillableIntNotNil bool  `json:"nillableIntNotNil,omitempty"`
+
+	// "table" field predicates.
+	Table              *string  `json:"table,omitempty"`
+	TableNEQ           *string  `json:"tableNEQ,omitempty"`
+	TableIn            []string `json:"tableIn,omitempty"`
+	TableNotIn         []string `json:"tableNotIn,omitempty"`
+	TableGT            *string  `json:"tableGT,omitempty"`
+	TableGTE           *string  `json:"tableGTE,omitempty"`
+	TableLT            *string  `json:"tableLT,omitempty"`
+	TableLTE           *string  `json:"tableLTE,omitempty"`
+	TableContains      *string  `json:"tableContains,omitempty"`
+	TableHasPrefix     *string  `json:"tableHasPrefix,omitempty"`
+	TableHasSuffix     *string  `json:"tableHasSuffix,omitempty"`
+	TableIsNil         bool     `json:"tableIsNil,omitempty"`
+	TableNotNil        bool     `json:"tableNotNil,omitempty"`
+	TableEqualFold     *string  `json:"tableEqualFold,omitempty"`
+	TableContainsFold  *string  `json:"tableContainsFold,omitempty"`
+	TableHasPrefixFold *string  `json:"tableHasPrefixFold,omitempty"`
+
+	// "dir" field predicates.
+	DirIsNil  bool `json:"dirIsNil,omitempty"`
+	DirNotNil bool `json:"dirNotNil,omitempty"`
+
+	// "client" field predicates.
+	Client              *string  `json:"client,omitempty"`
+	ClientNEQ           *string  `json:"clientNEQ,omitempty"`
+	ClientIn            []string `json:"clientIn,omitempty"`
+	ClientNotIn         []string `json:"clientNotIn,omitempty"`
+	ClientGT            *string  `json:"clientGT,omitempty"`
+	ClientGTE           *string  `json:"clientGTE,omitempty"`
+	ClientLT            *string  `json:"clientLT,omitempty"`
+	ClientLTE           *string  `json:"clientLTE,omitempty"`
+	ClientContains      *string  `json:"clientContains,omitempty"`
+	ClientHasPrefix     *string  `json:"clientHasPrefix,omitempty"`
+	ClientHasSuffix     *string  `json:"clientHasSuffix,omitempty"`
+	ClientIsNil         bool     `json:"clientIsNil,omitempty"`
+	ClientNotNil        bool     `json:"clientNotNil,omitempty"`
+	ClientEqualFold     *string  `json:"clientEqualFold,omitempty"`
+	ClientContainsFold  *string  `json:"clientContainsFold,omitempty"`
+	ClientHasPrefixFold *string  `json:"clientHasPrefixFold,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *CommentFilterInput) Apply(q *CommentQuery) (*CommentQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *CommentFilterInput) P() (predicate.Comment, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Comment
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, comment.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*CommentFilterInput
+		join    func(...predicate.Comment) predicate.Comment
+	}{
+		{filters: f.And, join: comment.And},
+		{filters: f.Or, join: comment.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Comment, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, comment.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, comment.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, comment.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, comment.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, comment.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, comment.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, comment.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, comment.IDLTE(*f.IDLTE))
+	}
+	if f.UniqueInt != nil {
+		preds = append(preds, comment.UniqueIntEQ(*f.UniqueInt))
+	}
+	if f.UniqueIntNEQ != nil {
+		preds = append(preds, comment.UniqueIntNEQ(*f.UniqueIntNEQ))
+	}
+	if f.UniqueIntIn != nil {
+		preds = append(preds, comment.UniqueIntIn(f.UniqueIntIn...))
+	}
+	if f.UniqueIntNotIn != nil {
+		preds = append(preds, comment.UniqueIntNotIn(f.UniqueIntNotIn...))
+	}
+	if f.UniqueIntGT != nil {
+		preds = append(preds, comment.UniqueIntGT(*f.UniqueIntGT))
+	}
+	if f.UniqueIntGTE != nil {
+		preds = append(preds, comment.UniqueIntGTE(*f.UniqueIntGTE))
+	}
+	if f.UniqueIntLT != nil {
+		preds = append(preds, comment.UniqueIntLT(*f.UniqueIntLT))
+	}
+	if f.UniqueIntLTE != nil {
+		preds = append(preds, comment.UniqueIntLTE(*f.UniqueIntLTE))
+	}
+	if f.UniqueFloat != nil {
+		preds = append(preds, comment.UniqueFloatEQ(*f.UniqueFloat))
+	}
+	if f.UniqueFloatNEQ != nil {
+		preds = append(preds, comment.UniqueFloatNEQ(*f.UniqueFloatNEQ))
+	}
+	if f.UniqueFloatIn != nil {
+		preds = append(preds, comment.UniqueFloatIn(f.UniqueFloatIn...))
+	}
+	if f.UniqueFloatNotIn != nil {
+		preds = append(preds, comment.UniqueFloatNotIn(f.UniqueFloatNotIn...))
+	}
+	if f.UniqueFloatGT != nil {
+		preds = append(preds, comment.UniqueFloatGT(*f.UniqueFloatGT))
+	}
+	if f.UniqueFloatGTE != nil {
+		preds = append(preds, comment.UniqueFloatGTE(*f.UniqueFloatGTE))
+	}
+	if f.UniqueFloatLT != nil {
+		preds = append(preds, comment.UniqueFloatLT(*f.UniqueFloatLT))
+	}
+	if f.UniqueFloatLTE != nil {
+		preds = append(preds, comment.UniqueFloatLTE(*f.UniqueFloatLTE))
+	}
+	if f.NillableInt != nil {
+		preds = append(preds, comment.NillableIntEQ(*f.NillableInt))
+	}
+	if f.NillableIntNEQ != nil {
+		preds = append(preds, comment.NillableIntNEQ(*f.NillableIntNEQ))
+	}
+	if f.NillableIntIn != nil {
+		preds = append(preds, comment.NillableIntIn(f.NillableIntIn...))
+	}
+	if f.NillableIntNotIn != nil {
+		preds = append(preds, comment.NillableIntNotIn(f.NillableIntNotIn...))
+	}
+	if f.NillableIntGT != nil {
+		preds = append(preds, comment.NillableIntGT(*f.NillableIntGT))
+	}
+	if f.NillableIntGTE != nil {
+		preds = append(preds, comment.NillableIntGTE(*f.NillableIntGTE))
+	}
+	if f.NillableIntLT != nil {
+		preds = append(preds, comment.NillableIntLT(*f.NillableIntLT))
+	}
+	if f.NillableIntLTE != nil {
+		preds = append(preds, comment.NillableIntLTE(*f.NillableIntLTE))
+	}
+	if f.NillableIntIsNil {
+		preds = append(preds, comment.NillableIntIsNil())
+	}
+	if f.NillableIntNotNil {
+		preds = append(preds, comment.NillableIntNotNil())
+	}
+	if f.Table != nil {
+		preds = append(preds, comment.TableEQ(*f.Table))
+	}
+	if f.TableNEQ != nil {
+		preds = append(preds, comment.TableNEQ(*f.TableNEQ))
+	}
+	if f.TableIn != nil {
+		preds = append(preds, comment.TableIn(f.TableIn...))
+	}
+	if f.TableNotIn != nil {
+		preds = append(preds, comment.TableNotIn(f.TableNotIn...))
+	}
+	if f.TableGT != nil {
+		preds = append(preds, comment.TableGT(*f.TableGT))
+	}
+	if f.TableGTE != nil {
+		preds = append(preds, comment.TableGTE(*f.TableGTE))
+	}
+	if f.TableLT != nil {
+		preds = append(preds, comment.TableLT(*f.TableLT))
+	}
+	if f.TableLTE != nil {
+		preds = append(preds, comment.TableLTE(*f.TableLTE))
+	}
+	if f.TableContains != nil {
+		preds = append(preds, comment.TableContains(*f.TableContains))
+	}
+	if f.TableHasPrefix != nil {
+		preds = append(preds, comment.TableHasPrefix(*f.TableHasPrefix))
+	}
+	if f.TableHasSuffix != nil {
+		preds = append(preds, comment.TableHasSuffix(*f.TableHasSuffix))
+	}
+	if f.TableIsNil {
+		preds = append(preds, comment.TableIsNil())
+	}
+	if f.TableNotNil {
+		preds = append(preds, comment.TableNotNil())
+	}
+	if f.TableEqualFold != nil {
+		preds = append(preds, comment.TableEqualFold(*f.TableEqualFold))
+	}
+	if f.TableContainsFold != nil {
+		preds = append(preds, comment.TableContainsFold(*f.TableContainsFold))
+	}
+	if f.TableHasPrefixFold != nil {
+		preds = append(preds, comment.TableHasPrefixFold(*f.TableHasPrefixFold))
+	}
+	if f.DirIsNil {
+		preds = append(preds, comment.DirIsNil())
+	}
+	if f.DirNotNil {
+		preds = append(preds, comment.DirNotNil())
+	}
+	if f.Client != nil {
+		preds = append(preds, comment.ClientEQ(*f.Client))
+	}
+	if f.ClientNEQ != nil {
+		preds = append(preds, comment.ClientNEQ(*f.ClientNEQ))
+	}
+	if f.ClientIn != nil {
+		preds = append(preds, comment.ClientIn(f.ClientIn...))
+	}
+	if f.ClientNotIn != nil {
+		preds = append(preds, comment.ClientNotIn(f.ClientNotIn...))
+	}
+	if f.ClientGT != nil {
+		preds = append(preds, comment.ClientGT(*f.ClientGT))
+	}
+	if f.ClientGTE != nil {
+		preds = append(preds, comment.ClientGTE(*f.ClientGTE))
+	}
+	if f.ClientLT != nil {
+		preds = append(preds, comment.ClientLT(*f.ClientLT))
+	}
+	if f.ClientLTE != nil {
+		preds = append(preds, comment.ClientLTE(*f.ClientLTE))
+	}
+	if f.ClientContains != nil {
+		preds = append(preds, comment.ClientContains(*f.ClientContains))
+	}
+	if f.ClientHasPrefix != nil {
+		preds = append(preds, comment.ClientHasPrefix(*f.ClientHasPrefix))
+	}
+	if f.ClientHasSuffix != nil {
+		preds = append(preds, comment.ClientHasSuffix(*f.ClientHasSuffix))
+	}
+	if f.ClientIsNil {
+		preds = append(preds, comment.ClientIsNil())
+	}
+	if f.ClientNotNil {
+		preds = append(preds, comment.ClientNotNil())
+	}
+	if f.ClientEqualFold != nil {
+		preds = append(preds, comment.ClientEqualFold(*f.ClientEqualFold))
+	}
+	if f.ClientContainsFold != nil {
+		preds = append(preds, comment.ClientContainsFold(*f.ClientContainsFold))
+	}
+	if f.ClientHasPrefixFold != nil {
+		preds = append(preds, comment.ClientHasPrefixFold(*f.ClientHasPrefixFold))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return comment.And(preds...), nil
+	}
+}
+
+// ExValueScanFilterInput is a typed filter of ExValueScan entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the exvaluescan package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.ExValueScanFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.ExValueScan.Query())
+type ExValueScanFilterInput struct {
+	Not *ExValueScanFilterInput   `json:"not,omitempty"`
+	And []*ExValueScanFilterInput `json:"and,omitempty"`
+	Or  []*ExValueScanFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "binary" field predicates.
+	Binary              **url.URL  `json:"binary,omitempty"`
+	BinaryNEQ           **url.URL  `json:"binaryNEQ,omitempty"`
+	BinaryIn            []*url.URL `json:"binaryIn,omitempty"`
+	BinaryNotIn         []*url.URL `json:"binaryNotIn,omitempty"`
+	BinaryGT            **url.URL  `json:"binaryGT,omitempty"`
+	BinaryGTE           **url.URL  `json:"binaryGTE,omitempty"`
+	BinaryLT            **url.URL  `json:"binaryLT,omitempty"`
+	BinaryLTE           **url.URL  `json:"binaryLTE,omitempty"`
+	BinaryContains      **url.URL  `json:"binaryContains,omitempty"`
+	BinaryHasPrefix     **url.URL  `json:"binaryHasPrefix,omitempty"`
+	BinaryHasSuffix     **url.URL  `json:"binaryHasSuffix,omitempty"`
+	BinaryEqualFold     **url.URL  `json:"binaryEqualFold,omitempty"`
+	BinaryContainsFold  **url.URL  `json:"binaryContainsFold,omitempty"`
+	BinaryHasPrefixFold **url.URL  `json:"binaryHasPrefixFold,omitempty"`
+
+	// "binary_optional" field predicates.
+	BinaryOptional              **url.URL  `json:"binaryOptional,omitempty"`
+	BinaryOptionalNEQ           **url.URL  `json:"binaryOptionalNEQ,omitempty"`
+	BinaryOptionalIn            []*url.URL `json:"binaryOptionalIn,omitempty"`
+	BinaryOptionalNotIn         []*url.URL `json:"binaryOptionalNotIn,omitempty"`
+	BinaryOptionalGT            **url.URL  `json:"binaryOptionalGT,omitempty"`
+	BinaryOptionalGTE           **url.URL  `json:"binaryOptionalGTE,omitempty"`
+	BinaryOptionalLT            **url.URL  `json:"binaryOptionalLT,omitempty"`
+	BinaryOptionalLTE           **url.URL  `json:"binaryOptionalLTE,omitempty"`
+	BinaryOptionalContains      **url.URL  `json:"binaryOptionalContains,omitempty"`
+	BinaryOptionalHasPrefix     **url.URL  `json:"binaryOptionalHasPrefix,omitempty"`
+	BinaryOptionalHasSuffix     **url.URL  `json:"binaryOptionalHasSuffix,omitempty"`
+	BinaryOptionalIsNil         bool       `json:"binaryOptionalIsNil,omitempty"`
+	BinaryOptionalNotNil        bool       `json:"binaryOptionalNotNil,omitempty"`
+	BinaryOptionalEqualFold     **url.URL  `json:"binaryOptionalEqualFold,omitempty"`
+	BinaryOptionalContainsFold  **url.URL  `json:"binaryOptionalContainsFold,omitempty"`
+	BinaryOptionalHasPrefixFold **url.URL  `json:"binaryOptionalHasPrefixFold,omitempty"`
+
+	// "text" field predicates.
+	Text              **big.Int  `json:"text,omitempty"`
+	TextNEQ           **big.Int  `json:"textNEQ,omitempty"`
+	TextIn            []*big.Int `json:"textIn,omitempty"`
+	TextNotIn         []*big.Int `json:"textNotIn,omitempty"`
+	TextGT            **big.Int  `json:"textGT,omitempty"`
+	TextGTE           **big.Int  `json:"textGTE,omitempty"`
+	TextLT            **big.Int  `json:"textLT,omitempty"`
+	TextLTE           **big.Int  `json:"textLTE,omitempty"`
+	TextContains      **big.Int  `json:"textContains,omitempty"`
+	TextHasPrefix     **big.Int  `json:"textHasPrefix,omitempty"`
+	TextHasSuffix     **big.Int  `json:"textHasSuffix,omitempty"`
+	TextEqualFold     **big.Int  `json:"textEqualFold,omitempty"`
+	TextContainsFold  **big.Int  `json:"textContainsFold,omitempty"`
+	TextHasPrefixFold **big.Int  `json:"textHasPrefixFold,omitempty"`
+
+	// "text_optional" field predicates.
+	TextOptional              **big.Int  `json:"textOptional,omitempty"`
+	TextOptionalNEQ           **big.Int  `json:"textOptionalNEQ,omitempty"`
+	TextOptionalIn            []*big.Int `json:"textOptionalIn,omitempty"`
+	TextOptionalNotIn         []*big.Int `json:"textOptionalNotIn,omitempty"`
+	TextOptionalGT            **big.Int  `json:"textOptionalGT,omitempty"`
+	TextOptionalGTE           **big.Int  `json:"textOptionalGTE,omitempty"`
+	TextOptionalLT            **big.Int  `json:"textOptionalLT,omitempty"`
+	TextOptionalLTE           **big.Int  `json:"textOptionalLTE,omitempty"`
+	TextOptionalContains      **big.Int  `json:"textOptionalContains,omitempty"`
+	TextOptionalHasPrefix     **big.Int  `json:"textOptionalHasPrefix,omitempty"`
+	TextOptionalHasSuffix     **big.Int  `json:"textOptionalHasSuffix,omitempty"`
+	TextOptionalIsNil         bool       `json:"textOptionalIsNil,omitempty"`
+	TextOptionalNotNil        bool       `json:"textOptionalNotNil,omitempty"`
+	TextOptionalEqualFold     **big.Int  `json:"textOptionalEqualFold,omitempty"`
+	TextOptionalContainsFold  **big.Int  `json:"textOptionalContainsFold,omitempty"`
+	TextOptionalHasPrefixFold **big.Int  `json:"textOptionalHasPrefixFold,omitempty"`
+
+	// "base64" field predicates.
+	Base64              *string  `json:"base64,omitempty"`
+	Base64NEQ           *string  `json:"base64NEQ,omitempty"`
+	Base64In            []string `json:"base64In,omitempty"`
+	Base64NotIn         []string `json:"base64NotIn,omitempty"`
+	Base64GT            *string  `json:"base64GT,omitempty"`
+	Base64GTE           *string  `json:"base64GTE,omitempty"`
+	Base64LT            *string  `json:"base64LT,omitempty"`
+	Base64LTE           *string  `json:"base64LTE,omitempty"`
+	Base64Contains      *string  `json:"base64Contains,omitempty"`
+	Base64HasPrefix     *string  `json:"base64HasPrefix,omitempty"`
+	Base64HasSuffix     *string  `json:"base64HasSuffix,omitempty"`
+	Base64EqualFold     *string  `json:"base64EqualFold,omitempty"`
+	Base64ContainsFold  *string  `json:"base64ContainsFold,omitempty"`
+	Base64HasPrefixFold *string  `json:"base64HasPrefixFold,omitempty"`
+
+	// "custom" field predicates.
+	Custom              *string  `json:"custom,omitempty"`
+	CustomNEQ           *string  `json:"customNEQ,omitempty"`
+	CustomIn            []string `json:"customIn,omitempty"`
+	CustomNotIn         []string `json:"customNotIn,omitempty"`
+	CustomGT            *string  `json:"customGT,omitempty"`
+	CustomGTE           *string  `json:"customGTE,omitempty"`
+	CustomLT            *string  `json:"customLT,omitempty"`
+	CustomLTE           *string  `json:"customLTE,omitempty"`
+	CustomContains      *string  `json:"customContains,omitempty"`
+	CustomHasPrefix     *string  `json:"customHasPrefix,omitempty"`
+	CustomHasSuffix     *string  `json:"customHasSuffix,omitempty"`
+	CustomEqualFold     *string  `json:"customEqualFold,omitempty"`
+	CustomContainsFold  *string  `json:"customContainsFold,omitempty"`
+	CustomHasPrefixFold *string  `json:"customHasPrefixFold,omitempty"`
+
+	// "custom_optional" field predicates.
+	CustomOptional              *string  `json:"customOptional,omitempty"`
+	CustomOptionalNEQ           *string  `json:"customOptionalNEQ,omitempty"`
+	CustomOptionalIn            []string `json:"customOptionalIn,omitempty"`
+	CustomOptionalNotIn         []string `json:"customOptionalNotIn,omitempty"`
+	CustomOptionalGT            *string  `json:"customOptionalGT,omitempty"`
+	CustomOptionalGTE           *string  `json:"customOptionalGTE,omitempty"`
+	CustomOptionalLT            *string  `json:"customOptionalLT,omitempty"`
+	CustomOptionalLTE           *string  `json:"customOptionalLTE,omitempty"`
+	CustomOptionalContains      *string  `json:"customOptionalContains,omitempty"`
+	CustomOptionalHasPrefix     *string  `json:"customOptionalHasPrefix,omitempty"`
+	CustomOptionalHasSuffix     *string  `json:"customOptionalHasSuffix,omitempty"`
+	CustomOptionalIsNil         bool     `json:"customOptionalIsNil,omitempty"`
+	CustomOptionalNotNil        bool     `json:"customOptionalNotNil,omitempty"`
+	CustomOptionalEqualFold     *string  `json:"customOptionalEqualFold,omitempty"`
+	CustomOptionalContainsFold  *string  `json:"customOptionalContainsFold,omitempty"`
+	CustomOptionalHasPrefixFold *string  `json:"customOptionalHasPrefixFold,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *ExValueScanFilterInput) Apply(q *ExValueScanQuery) (*ExValueScanQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *ExValueScanFilterInput) P() (predicate.ExValueScan, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.ExValueScan
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, exvaluescan.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*ExValueScanFilterInput
+		join    func(...predicate.ExValueScan) predicate.ExValueScan
+	}{
+		{filters: f.And, join: exvaluescan.And},
+		{filters: f.Or, join: exvaluescan.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.ExValueScan, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, exvaluescan.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, exvaluescan.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, exvaluescan.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, exvaluescan.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, exvaluescan.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, exvaluescan.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, exvaluescan.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, exvaluescan.IDLTE(*f.IDLTE))
+	}
+	if f.Binary != nil {
+		preds = append(preds, exvaluescan.BinaryEQ(*f.Binary))
+	}
+	if f.BinaryNEQ != nil {
+		preds = append(preds, exvaluescan.BinaryNEQ(*f.BinaryNEQ))
+	}
+	if f.BinaryIn != nil {
+		preds = append(preds, exvaluescan.BinaryIn(f.BinaryIn...))
+	}
+	if f.BinaryNotIn != nil {
+		preds = append(preds, exvaluescan.BinaryNotIn(f.BinaryNotIn...))
+	}
+	if f.BinaryGT != nil {
+		preds = append(preds, exvaluescan.BinaryGT(*f.BinaryGT))
+	}
+	if f.BinaryGTE != nil {
+		preds = append(preds, exvaluescan.BinaryGTE(*f.BinaryGTE))
+	}
+	if f.BinaryLT != nil {
+		preds = append(preds, exvaluescan.BinaryLT(*f.BinaryLT))
+	}
+	if f.BinaryLTE != nil {
+		preds = append(preds, exvaluescan.BinaryLTE(*f.BinaryLTE))
+	}
+	if f.BinaryContains != nil {
+		preds = append(preds, exvaluescan.BinaryContains(*f.BinaryContains))
+	}
+	if f.BinaryHasPrefix != nil {
+		preds = append(preds, exvaluescan.BinaryHasPrefix(*f.BinaryHasPrefix))
+	}
+	if f.BinaryHasSuffix != nil {
+		preds = append(preds, exvaluescan.BinaryHasSuffix(*f.BinaryHasSuffix))
+	}
+	if f.BinaryEqualFold != nil {
+		preds = append(preds, exvaluescan.BinaryEqualFold(*f.BinaryEqualFold))
+	}
+	if f.BinaryContainsFold != nil {
+		preds = append(preds, exvaluescan.BinaryContainsFold(*f.BinaryContainsFold))
+	}
+	if f.BinaryHasPrefixFold != nil {
+		preds = append(preds, exvaluescan.BinaryHasPrefixFold(*f.BinaryHasPrefixFold))
+	}
+	if f.BinaryOptional != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalEQ(*f.BinaryOptional))
+	}
+	if f.BinaryOptionalNEQ != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalNEQ(*f.BinaryOptionalNEQ))
+	}
+	if f.BinaryOptionalIn != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalIn(f.BinaryOptionalIn...))
+	}
+	if f.BinaryOptionalNotIn != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalNotIn(f.BinaryOptionalNotIn...))
+	}
+	if f.BinaryOptionalGT != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalGT(*f.BinaryOptionalGT))
+	}
+	if f.BinaryOptionalGTE != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalGTE(*f.BinaryOptionalGTE))
+	}
+	if f.BinaryOptionalLT != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalLT(*f.BinaryOptionalLT))
+	}
+	if f.BinaryOptionalLTE != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalLTE(*f.BinaryOptionalLTE))
+	}
+	if f.BinaryOptionalContains != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalContains(*f.BinaryOptionalContains))
+	}
+	if f.BinaryOptionalHasPrefix != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalHasPrefix(*f.BinaryOptionalHasPrefix))
+	}
+	if f.BinaryOptionalHasSuffix != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalHasSuffix(*f.BinaryOptionalHasSuffix))
+	}
+	if f.BinaryOptionalIsNil {
+		preds = append(preds, exvaluescan.BinaryOptionalIsNil())
+	}
+	if f.BinaryOptionalNotNil {
+		preds = append(preds, exvaluescan.BinaryOptionalNotNil())
+	}
+	if f.BinaryOptionalEqualFold != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalEqualFold(*f.BinaryOptionalEqualFold))
+	}
+	if f.BinaryOptionalContainsFold != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalContainsFold(*f.BinaryOptionalContainsFold))
+	}
+	if f.BinaryOptionalHasPrefixFold != nil {
+		preds = append(preds, exvaluescan.BinaryOptionalHasPrefixFold(*f.BinaryOptionalHasPrefixFold))
+	}
+	if f.Text != nil {
+		preds = append(preds, exvaluescan.TextEQ(*f.Text))
+	}
+	if f.TextNEQ != nil {
+		preds = append(preds, exvaluescan.TextNEQ(*f.TextNEQ))
+	}
+	if f.TextIn != nil {
+		preds = append(preds, exvaluescan.TextIn(f.TextIn...))
+	}
+	if f.TextNotIn != nil {
+		preds = append(preds, exvaluescan.TextNotIn(f.TextNotIn...))
+	}
+	if f.TextGT != nil {
+		preds = append(preds, exvaluescan.TextGT(*f.TextGT))
+	}
+	if f.TextGTE != nil {
+		preds = append(preds, exvaluescan.TextGTE(*f.TextGTE))
+	}
+	if f.TextLT != nil {
+		preds = append(preds, exvaluescan.TextLT(*f.TextLT))
+	}
+	if f.TextLTE != nil {
+		preds = append(preds, exvaluescan.TextLTE(*f.TextLTE))
+	}
+	if f.TextContains != nil {
+		preds = append(preds, exvaluescan.TextContains(*f.TextContains))
+	}
+	if f.TextHasPrefix != nil {
+		preds = append(preds, exvaluescan.TextHasPrefix(*f.TextHasPrefix))
+	}
+	if f.TextHasSuffix != nil {
+		preds = append(preds, exvaluescan.TextHasSuffix(*f.TextHasSuffix))
+	}
+	if f.TextEqualFold != nil {
+		preds = append(preds, exvaluescan.TextEqualFold(*f.TextEqualFold))
+	}
+	if f.TextContainsFold != nil {
+		preds = append(preds, exvaluescan.TextContainsFold(*f.TextContainsFold))
+	}
+	if f.TextHasPrefixFold != nil {
+		preds = append(preds, exvaluescan.TextHasPrefixFold(*f.TextHasPrefixFold))
+	}
+	if f.TextOptional != nil {
+		preds = append(preds, exvaluescan.TextOptionalEQ(*f.TextOptional))
+	}
+	if f.TextOptionalNEQ != nil {
+		preds = append(preds, exvaluescan.TextOptionalNEQ(*f.TextOptionalNEQ))
+	}
+	if f.TextOptionalIn != nil {
+		preds = append(preds, exvaluescan.TextOptionalIn(f.TextOptionalIn...))
+	}
+	if f.TextOptionalNotIn != nil {
+		preds = append(preds, exvaluescan.TextOptionalNotIn(f.TextOptionalNotIn...))
+	}
+	if f.TextOptionalGT != nil {
+		preds = append(preds, exvaluescan.TextOptionalGT(*f.TextOptionalGT))
+	}
+	if f.TextOptionalGTE != nil {
+		preds = append(preds, exvaluescan.TextOptionalGTE(*f.TextOptionalGTE))
+	}
+	if f.TextOptionalLT != nil {
+		preds = append(preds, exvaluescan.TextOptionalLT(*f.TextOptionalLT))
+	}
+	if f.TextOptionalLTE != nil {
+		preds = append(preds, exvaluescan.TextOptionalLTE(*f.TextOptionalLTE))
+	}
+	if f.TextOptionalContains != nil {
+		preds = append(preds, exvaluescan.TextOptionalContains(*f.TextOptionalContains))
+	}
+	if f.TextOptionalHasPrefix != nil {
+		preds = append(preds, exvaluescan.TextOptionalHasPrefix(*f.TextOptionalHasPrefix))
+	}
+	if f.TextOptionalHasSuffix != nil {
+		preds = append(preds, exvaluescan.TextOptionalHasSuffix(*f.TextOptionalHasSuffix))
+	}
+	if f.TextOptionalIsNil {
+		preds = append(preds, exvaluescan.TextOptionalIsNil())
+	}
+	if f.TextOptionalNotNil {
+		preds = append(preds, exvaluescan.TextOptionalNotNil())
+	}
+	if f.TextOptionalEqualFold != nil {
+		preds = append(preds, exvaluescan.TextOptionalEqualFold(*f.TextOptionalEqualFold))
+	}
+	if f.TextOptionalContainsFold != nil {
+		preds = append(preds, exvaluescan.TextOptionalContainsFold(*f.TextOptionalContainsFold))
+	}
+	if f.TextOptionalHasPrefixFold != nil {
+		preds = append(preds, exvaluescan.TextOptionalHasPrefixFold(*f.TextOptionalHasPrefixFold))
+	}
+	if f.Base64 != nil {
+		preds = append(preds, exvaluescan.Base64EQ(*f.Base64))
+	}
+	if f.Base64NEQ != nil {
+		preds = append(preds, exvaluescan.Base64NEQ(*f.Base64NEQ))
+	}
+	if f.Base64In != nil {
+		preds = append(preds, exvaluescan.Base64In(f.Base64In...))
+	}
+	if f.Base64NotIn != nil {
+		preds = append(preds, exvaluescan.Base64NotIn(f.Base64NotIn...))
+	}
+	if f.Base64GT != nil {
+		preds = append(preds, exvaluescan.Base64GT(*f.Base64GT))
+	}
+	if f.Base64GTE != nil {
+		preds = append(preds, exvaluescan.Base64GTE(*f.Base64GTE))
+	}
+	if f.Base64LT != nil {
+		preds = append(preds, exvaluescan.Base64LT(*f.Base64LT))
+	}
+	if f.Base64LTE != nil {
+		preds = append(preds, exvaluescan.Base64LTE(*f.Base64LTE))
+	}
+	if f.Base64Contains != nil {
+		preds = append(preds, exvaluescan.Base64Contains(*f.Base64Contains))
+	}
+	if f.Base64HasPrefix != nil {
+		preds = append(preds, exvaluescan.Base64HasPrefix(*f.Base64HasPrefix))
+	}
+	if f.Base64HasSuffix != nil {
+		preds = append(preds, exvaluescan.Base64HasSuffix(*f.Base64HasSuffix))
+	}
+	if f.Base64EqualFold != nil {
+		preds = append(preds, exvaluescan.Base64EqualFold(*f.Base64EqualFold))
+	}
+	if f.Base64ContainsFold != nil {
+		preds = append(preds, exvaluescan.Base64ContainsFold(*f.Base64ContainsFold))
+	}
+	if f.Base64HasPrefixFold != nil {
+		preds = append(preds, exvaluescan.Base64HasPrefixFold(*f.Base64HasPrefixFold))
+	}
+	if f.Custom != nil {
+		preds = append(preds, exvaluescan.CustomEQ(*f.Custom))
+	}
+	if f.CustomNEQ != nil {
+		preds = append(preds, exvaluescan.CustomNEQ(*f.CustomNEQ))
+	}
+	if f.CustomIn != nil {
+		preds = append(preds, exvaluescan.CustomIn(f.CustomIn...))
+	}
+	if f.CustomNotIn != nil {
+		preds = append(preds, exvaluescan.CustomNotIn(f.CustomNotIn...))
+	}
+	if f.CustomGT != nil {
+		preds = append(preds, exvaluescan.CustomGT(*f.CustomGT))
+	}
+	if f.CustomGTE != nil {
+		preds = append(preds, exvaluescan.CustomGTE(*f.CustomGTE))
+	}
+	if f.CustomLT != nil {
+		preds = append(preds, exvaluescan.CustomLT(*f.CustomLT))
+	}
+	if f.CustomLTE != nil {
+		preds = append(preds, exvaluescan.CustomLTE(*f.CustomLTE))
+	}
+	if f.CustomContains != nil {
+		preds = append(preds, exvaluescan.CustomContains(*f.CustomContains))
+	}
+	if f.CustomHasPrefix != nil {
+		preds = append(preds, exvaluescan.CustomHasPrefix(*f.CustomHasPrefix))
+	}
+	if f.CustomHasSuffix != nil {
+		preds = append(preds, exvaluescan.CustomHasSuffix(*f.CustomHasSuffix))
+	}
+	if f.CustomEqualFold != nil {
+		preds = append(preds, exvaluescan.CustomEqualFold(*f.CustomEqualFold))
+	}
+	if f.CustomContainsFold != nil {
+		preds = append(preds, exvaluescan.CustomContainsFold(*f.CustomContainsFold))
+	}
+	if f.CustomHasPrefixFold != nil {
+		preds = append(preds, exvaluescan.CustomHasPrefixFold(*f.CustomHasPrefixFold))
+	}
+	if f.CustomOptional != nil {
+		preds = append(preds, exvaluescan.CustomOptionalEQ(*f.CustomOptional))
+	}
+	if f.CustomOptionalNEQ != nil {
+		preds = append(preds, exvaluescan.CustomOptionalNEQ(*f.CustomOptionalNEQ))
+	}
+	if f.CustomOptionalIn != nil {
+		preds = append(preds, exvaluescan.CustomOptionalIn(f.CustomOptionalIn...))
+	}
+	if f.CustomOptionalNotIn != nil {
+		preds = append(preds, exvaluescan.CustomOptionalNotIn(f.CustomOptionalNotIn...))
+	}
+	if f.CustomOptionalGT != nil {
+		preds = append(preds, exvaluescan.CustomOptionalGT(*f.CustomOptionalGT))
+	}
+	if f.CustomOptionalGTE != nil {
+		preds = append(preds, exvaluescan.CustomOptionalGTE(*f.CustomOptionalGTE))
+	}
+	if f.CustomOptionalLT != nil {
+		preds = append(preds, exvaluescan.CustomOptionalLT(*f.CustomOptionalLT))
+	}
+	if f.CustomOptionalLTE != nil {
+		preds = append(preds, exvaluescan.CustomOptionalLTE(*f.CustomOptionalLTE))
+	}
+	if f.CustomOptionalContains != nil {
+		preds = append(preds, exvaluescan.CustomOptionalContains(*f.CustomOptionalContains))
+	}
+	if f.CustomOptionalHasPrefix != nil {
+		preds = append(preds, exvaluescan.CustomOptionalHasPrefix(*f.CustomOptionalHasPrefix))
+	}
+	if f.CustomOptionalHasSuffix != nil {
+		preds = append(preds, exvaluescan.CustomOptionalHasSuffix(*f.CustomOptionalHasSuffix))
+	}
+	if f.CustomOptionalIsNil {
+		preds = append(preds, exvaluescan.CustomOptionalIsNil())
+	}
+	if f.CustomOptionalNotNil {
+		preds = append(preds, exvaluescan.CustomOptionalNotNil())
+	}
+	if f.CustomOptionalEqualFold != nil {
+		preds = append(preds, exvaluescan.CustomOptionalEqualFold(*f.CustomOptionalEqualFold))
+	}
+	if f.CustomOptionalContainsFold != nil {
+		preds = append(preds, exvaluescan.CustomOptionalContainsFold(*f.CustomOptionalContainsFold))
+	}
+	if f.CustomOptionalHasPrefixFold != nil {
+		preds = append(preds, exvaluescan.CustomOptionalHasPrefixFold(*f.CustomOptionalHasPrefixFold))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return exvaluescan.And(preds...), nil
+	}
+}
+
+// FieldTypeFilterInput is a typed filter of FieldType entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the fieldtype package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.FieldTypeFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.FieldType.Query())
+type FieldTypeFilterInput struct {
+	Not *FieldTypeFilterInput   `json:"not,omitempty"`
+	And []*FieldTypeFilterInput `json:"and,omitempty"`
+	Or  []*FieldTypeFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "int" field predicates.
+	Int      *int  `json:"int,omitempty"`
+	IntNEQ   *int  `json:"intNEQ,omitempty"`
+	IntIn    []int `json:"intIn,omitempty"`
+	IntNotIn []int `json:"intNotIn,omitempty"`
+	IntGT    *int  `json:"intGT,omitempty"`
+	IntGTE   *int  `json:"intGTE,omitempty"`
+	IntLT    *int  `json:"intLT,omitempty"`
+	IntLTE   *int  `json:"intLTE,omitempty"`
+
+	// "int8" field predicates.
+	Int8      *int8  `json:"int8,omitempty"`
+	Int8NEQ   *int8  `json:"int8NEQ,omitempty"`
+	Int8In    []int8 `json:"int8In,omitempty"`
+	Int8NotIn []int8 `json:"int8NotIn,omitempty"`
+	Int8GT    *int8  `json:"int8GT,omitempty"`
+	Int8GTE   *int8  `json:"int8GTE,omitempty"`
+	Int8LT    *int8  `json:"int8LT,omitempty"`
+	Int8LTE   *int8  `json:"int8LTE,omitempty"`
+
+	// "int16" field predicates.
+	Int16      *int16  `json:"int16,omitempty"`
+	Int16NEQ   *int16  `json:"int16NEQ,omitempty"`
+	Int16In    []int16 `json:"int16In,omitempty"`
+	Int16NotIn []int16 `json:"int16NotIn,omitempty"`
+	Int16GT    *int16  `json:"int16GT,omitempty"`
+	Int16GTE   *int16  `json:"int16GTE,omitempty"`
+	Int16LT    *int16  `json:"int16LT,omitempty"`
+	Int16LTE   *int16  `json:"int16LTE,omitempty"`
+
+	// "int32" field predicates.
+	Int32      *int32  `json:"int32,omitempty"`
+	Int32NEQ   *int32  `json:"int32NEQ,omitempty"`
+	Int32In    []int32 `json:"int32In,omitempty"`
+	Int32NotIn []int32 `json:"int32NotIn,omitempty"`
+	Int32GT    *int32  `json:"int32GT,omitempty"`
+	Int32GTE   *int32  `json:"int32GTE,omitempty"`
+	Int32LT    *int32  `json:"int32LT,omitempty"`
+	Int32LTE   *int32  `json:"int32LTE,omitempty"`
+
+	// "int64" field predicates.
+	Int64      *int64  `json:"int64,omitempty"`
+	Int64NEQ   *int64  `json:"int64NEQ,omitempty"`
+	Int64In    []int64 `json:"int64In,omitempty"`
+	Int64NotIn []int64 `json:"int64NotIn,omitempty"`
+	Int64GT    *int64  `json:"int64GT,omitempty"`
+	Int64GTE   *int64  `json:"int64GTE,omitempty"`
+	Int64LT    *int64  `json:"int64LT,omitempty"`
+	Int64LTE   *int64  `json:"int64LTE,omitempty"`
+
+	// "optional_int" field predicates.
+	OptionalInt       *int  `json:"optionalInt,omitempty"`
+	OptionalIntNEQ    *int  `json:"optionalIntNEQ,omitempty"`
+	OptionalIntIn     []int `json:"optionalIntIn,omitempty"`
+	OptionalIntNotIn  []int `json:"optionalIntNotIn,omitempty"`
+	OptionalIntGT     *int  `json:"optionalIntGT,omitempty"`
+	OptionalIntGTE    *int  `json:"optionalIntGTE,omitempty"`
+	OptionalIntLT     *int  `json:"optionalIntLT,omitempty"`
+	OptionalIntLTE    *int  `json:"optionalIntLTE,omitempty"`
+	OptionalIntIsNil  bool  `json:"optionalIntIsNil,omitempty"`
+	OptionalIntNotNil bool  `json:"optionalIntNotNil,omitempty"`
+
+	// "optional_int8" field predicates.
+	OptionalInt8       *int8  `json:"optionalInt8,omitempty"`
+	OptionalInt8NEQ    *int8  `json:"optionalInt8NEQ,omitempty"`
+	OptionalInt8In     []int8 `json:"optionalInt8In,omitempty"`
+	OptionalInt8NotIn  []int8 `json:"optionalInt8NotIn,omitempty"`
+	OptionalInt8GT     *int8  `json:"optionalInt8GT,omitempty"`
+	OptionalInt8GTE    *int8  `json:"optionalInt8GTE,omitempty"`
+	OptionalInt8LT     *int8  `json:"optionalInt8LT,omitempty"`
+	OptionalInt8LTE    *int8  `json:"optionalInt8LTE,omitempty"`
+	OptionalInt8IsNil  bool   `json:"optionalInt8IsNil,omitempty"`
+	OptionalInt8NotNil bool   `json:"optionalInt8NotNil,omitempty"`
+
+	// "optional_int16" field predicates.
+	OptionalInt16       *int16  `json:"optionalInt16,omitempty"`
+	OptionalInt16NEQ    *int16  `json:"optionalInt16NEQ,omitempty"`
+	OptionalInt16In     []int16 `json:"optionalInt16In,omitempty"`
+	OptionalInt16NotIn  []int16 `json:"optionalInt16NotIn,omitempty"`
+	OptionalInt16GT     *int16  `json:"optionalInt16GT,omitempty"`
+	OptionalInt16GTE    *int16  `json:"optionalInt16GTE,omitempty"`
+	OptionalInt16LT     *int16  `json:"optionalInt16LT,omitempty"`
+	OptionalInt16LTE    *int16  `json:"optionalInt16LTE,omitempty"`
+	OptionalInt16IsNil  bool    `json:"optionalInt16IsNil,omitempty"`
+	OptionalInt16NotNil bool    `json:"optionalInt16NotNil,omitempty"`
+
+	// "optional_int32" field predicates.
+	OptionalInt32       *int32  `json:"optionalInt32,omitempty"`
+	OptionalInt32NEQ    *int32  `json:"optionalInt32NEQ,omitempty"`
+	OptionalInt32In     []int32 `json:"optionalInt32In,omitempty"`
+	OptionalInt32NotIn  []int32 `json:"optionalInt32NotIn,omitempty"`
+	OptionalInt32GT     *int32  `json:"optionalInt32GT,omitempty"`
+	OptionalInt32GTE    *int32  `json:"optionalInt32GTE,omitempty"`
+	OptionalInt32LT     *int32  `json:"optionalInt32LT,omitempty"`
+	OptionalInt32LTE    *int32  `json:"optionalInt32LTE,omitempty"`
+	OptionalInt32IsNil  bool    `json:"optionalInt32IsNil,omitempty"`
+	OptionalInt32NotNil bool    `json:"optionalInt32NotNil,omitempty"`
+
+	// "optional_int64" field predicates.
+	OptionalInt64       *int64  `json:"optionalInt64,omitempty"`
+	OptionalInt64NEQ    *int64  `json:"optionalInt64NEQ,omitempty"`
+	OptionalInt64In     []int64 `json:"optionalInt64In,omitempty"`
+	OptionalInt64NotIn  []int64 `json:"optionalInt64NotIn,omitempty"`
+	OptionalInt64GT     *int64  `json:"optionalInt64GT,omitempty"`
+	OptionalInt64GTE    *int64  `json:"optionalInt64GTE,omitempty"`
+	OptionalInt64LT     *int64  `json:"optionalInt64LT,omitempty"`
+	OptionalInt64LTE    *int64  `json:"optionalInt64LTE,omitempty"`
+	OptionalInt64IsNil  bool    `json:"optionalInt64IsNil,omitempty"`
+	OptionalInt64NotNil bool    `json:"optionalInt64NotNil,omitempty"`
+
+	// "nillable_int" field predicates.
+	NillableInt       *int  `json:"nillableInt,omitempty"`
+	NillableIntNEQ    *int  `json:"nillableIntNEQ,omitempty"`
+	NillableIntIn     []int `json:"nillableIntIn,omitempty"`
+	NillableIntNotIn  []int `json:"nillableIntNotIn,omitempty"`
+	NillableIntGT     *int  `json:"nillableIntGT,omitempty"`
+	NillableIntGTE    *int  `json:"nillableIntGTE,omitempty"`
+	NillableIntLT     *int  `json:"nillableIntLT,omitempty"`
+	NillableIntLTE    *int  `json:"nillableIntLTE,omitempty"`
+	NillableIntIsNil  bool  `json:"nillableIntIsNil,omitempty"`
+	NillableIntNotNil bool  `json:"nillableIntNotNil,omitempty"`
+
+	// "nillable_int8" field predicates.
+	NillableInt8       *int8  `json:"nillableInt8,omitempty"`
+	NillableInt8NEQ    *int8  `json:"nillableInt8NEQ,omitempty"`
+	NillableInt8In     []int8 `json:"nillableInt8In,omitempty"`
+	NillableInt8NotIn  []int8 `json:"nillableInt8NotIn,omitempty"`
+	NillableInt8GT     *int8  `json:"nillableInt8GT,omitempty"`
+	NillableInt8GTE    *int8  `json:"nillableInt8GTE,omitempty"`
+	NillableInt8LT     *int8  `json:"nillableInt8LT,omitempty"`
+	NillableInt8LTE    *int8  `json:"nillableInt8LTE,omitempty"`
+	NillableInt8IsNil  bool   `json:"nillableInt8IsNil,omitempty"`
+	NillableInt8NotNil bool   `json:"nillableInt8NotNil,omitempty"`
+
+	// "nillable_int16" field predicates.
+	NillableInt16       *int16  `json:"nillableInt16,omitempty"`
+	NillableInt16NEQ    *int16  `json:"nillableInt16NEQ,omitempty"`
+	NillableInt16In     []int16 `json:"nillableInt16In,omitempty"`
+	NillableInt16NotIn  []int16 `json:"nillableInt16NotIn,omitempty"`
+	NillableInt16GT     *int16  `json:"nillableInt16GT,omitempty"`
+	NillableInt16GTE    *int16  `json:"nillableInt16GTE,omitempty"`
+	NillableInt16LT     *int16  `json:"nillableInt16LT,omitempty"`
+	NillableInt16LTE    *int16  `json:"nillableInt16LTE,omitempty"`
+	NillableInt16IsNil  bool    `json:"nillableInt16IsNil,omitempty"`
+	NillableInt16NotNil bool    `json:"nillableInt16NotNil,omitempty"`
+
+	// "nillable_int32" field predicates.
+	NillableInt32       *int32  `json:"nillableInt32,omitempty"`
+	NillableInt32NEQ    *int32  `json:"nillableInt32NEQ,omitempty"`
+	NillableInt32In     []int32 `json:"nillableInt32In,omitempty"`
+	NillableInt32NotIn  []int32 `json:"nillableInt32NotIn,omitempty"`
+	NillableInt32GT     *int32  `json:"nillableInt32GT,omitempty"`
+	NillableInt32GTE    *int32  `json:"nillableInt32GTE,omitempty"`
+	NillableInt32LT     *int32  `json:"nillableInt32LT,omitempty"`
+	NillableInt32LTE    *int32  `json:"nillableInt32LTE,omitempty"`
+	NillableInt32IsNil  bool    `json:"nillableInt32IsNil,omitempty"`
+	NillableInt32NotNil bool    `json:"nillableInt32NotNil,omitempty"`
+
+	// "nillable_int64" field predicates.
+	NillableInt64       *int64  `json:"nillableInt64,omitempty"`
+	NillableInt64NEQ    *int64  `json:"nillableInt64NEQ,omitempty"`
+	NillableInt64In     []int64 `json:"nillableInt64In,omitempty"`
+	NillableInt64NotIn  []int64 `json:"nillableInt64NotIn,omitempty"`
+	NillableInt64GT     *int64  `json:"nillableInt64GT,omitempty"`
+	NillableInt64GTE    *int64  `json:"nillableInt64GTE,omitempty"`
+	NillableInt64LT     *int64  `json:"nillableInt64LT,omitempty"`
+	NillableInt64LTE    *int64  `json:"nillableInt64LTE,omitempty"`
+	NillableInt64IsNil  bool    `json:"nillableInt64IsNil,omitempty"`
+	NillableInt64NotNil bool    `json:"nillableInt64NotNil,omitempty"`
+
+	// "validate_optional_int32" field predicates.
+	ValidateOptionalInt32       *int32  `json:"validateOptionalInt32,omitempty"`
+	ValidateOptionalInt32NEQ    *int32  `json:"validateOptionalInt32NEQ,omitempty"`
+	ValidateOptionalInt32In     []int32 `json:"validateOptionalInt32In,omitempty"`
+	ValidateOptionalInt32NotIn  []int32 `json:"validateOptionalInt32NotIn,omitempty"`
+	ValidateOptionalInt32GT     *int32  `json:"validateOptionalInt32GT,omitempty"`
+	ValidateOptionalInt32GTE    *int32  `json:"validateOptionalInt32GTE,omitempty"`
+	ValidateOptionalInt32LT     *int32  `json:"validateOptionalInt32LT,omitempty"`
+	ValidateOptionalInt32LTE    *int32  `json:"validateOptionalInt32LTE,omitempty"`
+	ValidateOptionalInt32IsNil  bool    `json:"validateOptionalInt32IsNil,omitempty"`
+	ValidateOptionalInt32NotNil bool    `json:"validateOptionalInt32NotNil,omitempty"`
+
+	// "optional_uint" field predicates.
+	OptionalUint       *uint  `json:"optionalUint,omitempty"`
+	OptionalUintNEQ    *uint  `json:"optionalUintNEQ,omitempty"`
+	OptionalUintIn     []uint `json:"optionalUintIn,omitempty"`
+	OptionalUintNotIn  []uint `json:"optionalUintNotIn,omitempty"`
+	OptionalUintGT     *uint  `json:"optionalUintGT,omitempty"`
+	OptionalUintGTE    *uint  `json:"optionalUintGTE,omitempty"`
+	OptionalUintLT     *uint  `json:"optionalUintLT,omitempty"`
+	OptionalUintLTE    *uint  `json:"optionalUintLTE,omitempty"`
+	OptionalUintIsNil  bool   `json:"optionalUintIsNil,omitempty"`
+	OptionalUintNotNil bool   `json:"optionalUintNotNil,omitempty"`
+
+	// "optional_uint8" field predicates.
+	OptionalUint8       *uint8  `json:"optionalUint8,omitempty"`
+	OptionalUint8NEQ    *uint8  `json:"optionalUint8NEQ,omitempty"`
+	OptionalUint8In     []uint8 `json:"optionalUint8In,omitempty"`
+	OptionalUint8NotIn  []uint8 `json:"optionalUint8NotIn,omitempty"`
+	OptionalUint8GT     *uint8  `json:"optionalUint8GT,omitempty"`
+	OptionalUint8GTE    *uint8  `json:"optionalUint8GTE,omitempty"`
+	OptionalUint8LT     *uint8  `json:"optionalUint8LT,omitempty"`
+	OptionalUint8LTE    *uint8  `json:"optionalUint8LTE,omitempty"`
+	OptionalUint8IsNil  bool    `json:"optionalUint8IsNil,omitempty"`
+	OptionalUint8NotNil bool    `json:"optionalUint8NotNil,omitempty"`
+
+	// "optional_uint16" field predicates.
+	OptionalUint16       *uint16  `json:"optionalUint16,omitempty"`
+	OptionalUint16NEQ    *uint16  `json:"optionalUint16NEQ,omitempty"`
+	OptionalUint16In     []uint16 `json:"optionalUint16In,omitempty"`
+	OptionalUint16NotIn  []uint16 `json:"optionalUint16NotIn,omitempty"`
+	OptionalUint16GT     *uint16  `json:"optionalUint16GT,omitempty"`
+	OptionalUint16GTE    *uint16  `json:"optionalUint16GTE,omitempty"`
+	OptionalUint16LT     *uint16  `json:"optionalUint16LT,omitempty"`
+	OptionalUint16LTE    *uint16  `json:"optionalUint16LTE,omitempty"`
+	OptionalUint16IsNil  bool     `json:"optionalUint16IsNil,omitempty"`
+	OptionalUint16NotNil bool     `json:"optionalUint16NotNil,omitempty"`
+
+	// "optional_uint32" field predicates.
+	OptionalUint32       *uint32  `json:"optionalUint32,omitempty"`
+	OptionalUint32NEQ    *uint32  `json:"optionalUint32NEQ,omitempty"`
+	OptionalUint32In     []uint32 `json:"optionalUint32In,omitempty"`
+	OptionalUint32NotIn  []uint32 `json:"optionalUint32NotIn,omitempty"`
+	OptionalUint32GT     *uint32  `json:"optionalUint32GT,omitempty"`
+	OptionalUint32GTE    *uint32  `json:"optionalUint32GTE,omitempty"`
+	OptionalUint32LT     *uint32  `json:"optionalUint32LT,omitempty"`
+	OptionalUint32LTE    *uint32  `json:"optionalUint32LTE,omitempty"`
+	OptionalUint32IsNil  bool     `json:"optionalUint32IsNil,omitempty"`
+	OptionalUint32NotNil bool     `json:"optionalUint32NotNil,omitempty"`
+
+	// "optional_uint64" field predicates.
+	OptionalUint64       *uint64  `json:"optionalUint64,omitempty"`
+	OptionalUint64NEQ    *uint64  `json:"optionalUint64NEQ,omitempty"`
+	OptionalUint64In     []uint64 `json:"optionalUint64In,omitempty"`
+	OptionalUint64NotIn  []uint64 `json:"optionalUint64NotIn,omitempty"`
+	OptionalUint64GT     *uint64  `json:"optionalUint64GT,omitempty"`
+	OptionalUint64GTE    *uint64  `json:"optionalUint64GTE,omitempty"`
+	OptionalUint64LT     *uint64  `json:"optionalUint64LT,omitempty"`
+	OptionalUint64LTE    *uint64  `json:"optionalUint64LTE,omitempty"`
+	OptionalUint64IsNil  bool     `json:"optionalUint64IsNil,omitempty"`
+	OptionalUint64NotNil bool     `json:"optionalUint64NotNil,omitempty"`
+
+	// "state" field predicates.
+	State       *fieldtype.State  `json:"state,omitempty"`
+	StateNEQ    *fieldtype.State  `json:"stateNEQ,omitempty"`
+	StateIn     []fieldtype.State `json:"stateIn,omitempty"`
+	StateNotIn  []fieldtype.State `json:"stateNotIn,omitempty"`
+	StateIsNil  bool              `json:"stateIsNil,omitempty"`
+	StateNotNil bool              `json:"stateNotNil,omitempty"`
+
+	// "optional_float" field predicates.
+	OptionalFloat       *float64  `json:"optionalFloat,omitempty"`
+	OptionalFloatNEQ    *float64  `json:"optionalFloatNEQ,omitempty"`
+	OptionalFloatIn     []float64 `json:"optionalFloatIn,omitempty"`
+	OptionalFloatNotIn  []float64 `json:"optionalFloatNotIn,omitempty"`
+	OptionalFloatGT     *float64  `json:"optionalFloatGT,omitempty"`
+	OptionalFloatGTE    *float64  `json:"optionalFloatGTE,omitempty"`
+	OptionalFloatLT     *float64  `json:"optionalFloatLT,omitempty"`
+	OptionalFloatLTE    *float64  `json:"optionalFloatLTE,omitempty"`
+	OptionalFloatIsNil  bool      `json:"optionalFloatIsNil,omitempty"`
+	OptionalFloatNotNil bool      `json:"optionalFloatNotNil,omitempty"`
+
+	// "optional_float32" field predicates.
+	OptionalFloat32       *float32  `json:"optionalFloat32,omitempty"`
+	OptionalFloat32NEQ    *float32  `json:"optionalFloat32NEQ,omitempty"`
+	OptionalFloat32In     []float32 `json:"optionalFloat32In,omitempty"`
+	OptionalFloat32NotIn  []float32 `json:"optionalFloat32NotIn,omitempty"`
+	OptionalFloat32GT     *float32  `json:"optionalFloat32GT,omitempty"`
+	OptionalFloat32GTE    *float32  `json:"optionalFloat32GTE,omitempty"`
+	OptionalFloat32LT     *float32  `json:"optionalFloat32LT,omitempty"`
+	OptionalFloat32LTE    *float32  `json:"optionalFloat32LTE,omitempty"`
+	OptionalFloat32IsNil  bool      `json:"optionalFloat32IsNil,omitempty"`
+	OptionalFloat32NotNil bool      `json:"optionalFloat32NotNil,omitempty"`
+
+	// "text" field predicates.
+	Text              *string  `json:"text,omitempty"`
+	TextNEQ           *string  `json:"textNEQ,omitempty"`
+	TextIn            []string `json:"textIn,omitempty"`
+	TextNotIn         []string `json:"textNotIn,omitempty"`
+	TextGT            *string  `json:"textGT,omitempty"`
+	TextGTE           *string  `json:"textGTE,omitempty"`
+	TextLT            *string  `json:"textLT,omitempty"`
+	TextLTE           *string  `json:"textLTE,omitempty"`
+	TextContains      *string  `json:"textContains,omitempty"`
+	TextHasPrefix     *string  `json:"textHasPrefix,omitempty"`
+	TextHasSuffix     *string  `json:"textHasSuffix,omitempty"`
+	TextIsNil         bool     `json:"textIsNil,omitempty"`
+	TextNotNil        bool     `json:"textNotNil,omitempty"`
+	TextEqualFold     *string  `json:"textEqualFold,omitempty"`
+	TextContainsFold  *string  `json:"textContainsFold,omitempty"`
+	TextHasPrefixFold *string  `json:"textHasPrefixFold,omitempty"`
+
+	// "datetime" field predicates.
+	Datetime       *time.Time  `json:"datetime,omitempty"`
+	DatetimeNEQ    *time.Time  `json:"datetimeNEQ,omitempty"`
+	DatetimeIn     []time.Time `json:"datetimeIn,omitempty"`
+	DatetimeNotIn  []time.Time `json:"datetimeNotIn,omitempty"`
+	DatetimeGT     *time.Time  `json:"datetimeGT,omitempty"`
+	DatetimeGTE    *time.Time  `json:"datetimeGTE,omitempty"`
+	DatetimeLT     *time.Time  `json:"datetimeLT,omitempty"`
+	DatetimeLTE    *time.Time  `json:"datetimeLTE,omitempty"`
+	DatetimeIsNil  bool        `json:"datetimeIsNil,omitempty"`
+	DatetimeNotNil bool        `json:"datetimeNotNil,omitempty"`
+
+	// "decimal" field predicates.
+	Decimal       *float64  `json:"decimal,omitempty"`
+	DecimalNEQ    *float64  `json:"decimalNEQ,omitempty"`
+	DecimalIn     []float64 `json:"decimalIn,omitempty"`
+	DecimalNotIn  []float64 `json:"decimalNotIn,omitempty"`
+	DecimalGT     *float64  `json:"decimalGT,omitempty"`
+	DecimalGTE    *float64  `json:"decimalGTE,omitempty"`
+	DecimalLT     *float64  `json:"decimalLT,omitempty"`
+	DecimalLTE    *float64  `json:"decimalLTE,omitempty"`
+	DecimalIsNil  bool      `json:"decimalIsNil,omitempty"`
+	DecimalNotNil bool      `json:"decimalNotNil,omitempty"`
+
+	// "link_other" field predicates.
+	LinkOther       **schema.Link  `json:"linkOther,omitempty"`
+	LinkOtherNEQ    **schema.Link  `json:"linkOtherNEQ,omitempty"`
+	LinkOtherIn     []*schema.Link `json:"linkOtherIn,omitempty"`
+	LinkOtherNotIn  []*schema.Link `json:"linkOtherNotIn,omitempty"`
+	LinkOtherGT     **schema.Link  `json:"linkOtherGT,omitempty"`
+	LinkOtherGTE    **schema.Link  `json:"linkOtherGTE,omitempty"`
+	LinkOtherLT     **schema.Link  `json:"linkOtherLT,omitempty"`
+	LinkOtherLTE    **schema.Link  `json:"linkOtherLTE,omitempty"`
+	LinkOtherIsNil  bool           `json:"linkOtherIsNil,omitempty"`
+	LinkOtherNotNil bool           `json:"linkOtherNotNil,omitempty"`
+
+	// "link_other_func" field predicates.
+	LinkOtherFunc       **schema.Link  `json:"linkOtherFunc,omitempty"`
+	LinkOtherFuncNEQ    **schema.Link  `json:"linkOtherFuncNEQ,omitempty"`
+	LinkOtherFuncIn     []*schema.Link `json:"linkOtherFuncIn,omitempty"`
+	LinkOtherFuncNotIn  []*schema.Link `json:"linkOtherFuncNotIn,omitempty"`
+	LinkOtherFuncGT     **schema.Link  `json:"linkOtherFuncGT,omitempty"`
+	LinkOtherFuncGTE    **schema.Link  `json:"linkOtherFuncGTE,omitempty"`
+	LinkOtherFuncLT     **schema.Link  `json:"linkOtherFuncLT,omitempty"`
+	LinkOtherFuncLTE    **schema.Link  `json:"linkOtherFuncLTE,omitempty"`
+	LinkOtherFuncIsNil  bool           `json:"linkOtherFuncIsNil,omitempty"`
+	LinkOtherFuncNotNil bool           `json:"linkOtherFuncNotNil,omitempty"`
+
+	// "mac" field predicates.
+	MAC              *schema.MAC  `json:"mac,omitempty"`
+	MACNEQ           *schema.MAC  `json:"macNEQ,omitempty"`
+	MACIn            []schema.MAC `json:"macIn,omitempty"`
+	MACNotIn         []schema.MAC `json:"macNotIn,omitempty"`
+	MACGT            *schema.MAC  `json:"macGT,omitempty"`
+	MACGTE           *schema.MAC  `json:"macGTE,omitempty"`
+	MACLT            *schema.MAC  `json:"macLT,omitempty"`
+	MACLTE           *schema.MAC  `json:"macLTE,omitempty"`
+	MACContains      *schema.MAC  `json:"macContains,omitempty"`
+	MACHasPrefix     *schema.MAC  `json:"macHasPrefix,omitempty"`
+	MACHasSuffix     *schema.MAC  `json:"macHasSuffix,omitempty"`
+	MACIsNil         bool         `json:"macIsNil,omitempty"`
+	MACNotNil        bool         `json:"macNotNil,omitempty"`
+	MACEqualFold     *schema.MAC  `json:"macEqualFold,omitempty"`
+	MACContainsFold  *schema.MAC  `json:"macContainsFold,omitempty"`
+	MACHasPrefixFold *schema.MAC  `json:"macHasPrefixFold,omitempty"`
+
+	// "string_array" field predicates.
+	StringArray       *schema.Strings  `json:"stringArray,omitempty"`
+	StringArrayNEQ    *schema.Strings  `json:"stringArrayNEQ,omitempty"`
+	StringArrayIn     []schema.Strings `json:"stringArrayIn,omitempty"`
+	StringArrayNotIn  []schema.Strings `json:"stringArrayNotIn,omitempty"`
+	StringArrayGT     *schema.Strings  `json:"stringArrayGT,omitempty"`
+	StringArrayGTE    *schema.Strings  `json:"stringArrayGTE,omitempty"`
+	StringArrayLT     *schema.Strings  `json:"stringArrayLT,omitempty"`
+	StringArrayLTE    *schema.Strings  `json:"stringArrayLTE,omitempty"`
+	StringArrayIsNil  bool             `json:"stringArrayIsNil,omitempty"`
+	StringArrayNotNil bool             `json:"stringArrayNotNil,omitempty"`
+
+	// "string_scanner" field predicates.
+	StringScanner              *schema.StringScanner  `json:"stringScanner,omitempty"`
+	StringScannerNEQ           *schema.StringScanner  `json:"stringScannerNEQ,omitempty"`
+	StringScannerIn            []schema.StringScanner `json:"stringScannerIn,omitempty"`
+	StringScannerNotIn         []schema.StringScanner `json:"stringScannerNotIn,omitempty"`
+	StringScannerGT            *schema.StringScanner  `json:"stringScannerGT,omitempty"`
+	StringScannerGTE           *schema.StringScanner  `json:"stringScannerGTE,omitempty"`
+	StringScannerLT            *schema.StringScanner  `json:"stringScannerLT,omitempty"`
+	StringScannerLTE           *schema.StringScanner  `json:"stringScannerLTE,omitempty"`
+	StringScannerContains      *schema.StringScanner  `json:"stringScannerContains,omitempty"`
+	StringScannerHasPrefix     *schema.StringScanner  `json:"stringScannerHasPrefix,omitempty"`
+	StringScannerHasSuffix     *schema.StringScanner  `json:"stringScannerHasSuffix,omitempty"`
+	StringScannerIsNil         bool                   `json:"stringScannerIsNil,omitempty"`
+	StringScannerNotNil        bool                   `json:"stringScannerNotNil,omitempty"`
+	StringScannerEqualFold     *schema.StringScanner  `json:"stringScannerEqualFold,omitempty"`
+	StringScannerContainsFold  *schema.StringScanner  `json:"stringScannerContainsFold,omitempty"`
+	StringScannerHasPrefixFold *schema.StringScanner  `json:"stringScannerHasPrefixFold,omitempty"`
+
+	// "duration" field predicates.
+	Duration       *time.Duration  `json:"duration,omitempty"`
+	DurationNEQ    *time.Duration  `json:"durationNEQ,omitempty"`
+	DurationIn     []time.Duration `json:"durationIn,omitempty"`
+	DurationNotIn  []time.Duration `json:"durationNotIn,omitempty"`
+	DurationGT     *time.Duration  `json:"durationGT,omitempty"`
+	DurationGTE    *time.Duration  `json:"durationGTE,omitempty"`
+	DurationLT     *time.Duration  `json:"durationLT,omitempty"`
+	DurationLTE    *time.Duration  `json:"durationLTE,omitempty"`
+	DurationIsNil  bool            `json:"durationIsNil,omitempty"`
+	DurationNotNil bool            `json:"durationNotNil,omitempty"`
+
+	// "dir" field predicates.
+	Dir              *http.Dir  `json:"dir,omitempty"`
+	DirNEQ           *http.Dir  `json:"dirNEQ,omitempty"`
+	DirIn            []http.Dir `json:"dirIn,omitempty"`
+	DirNotIn         []http.Dir `json:"dirNotIn,omitempty"`
+	DirGT            *http.Dir  `json:"dirGT,omitempty"`
+	DirGTE           *http.Dir  `json:"dirGTE,omitempty"`
+	DirLT            *http.Dir  `json:"dirLT,omitempty"`
+	DirLTE           *http.Dir  `json:"dirLTE,omitempty"`
+	DirContains      *http.Dir  `json:"dirContains,omitempty"`
+	DirHasPrefix     *http.Dir  `json:"dirHasPrefix,omitempty"`
+	DirHasSuffix     *http.Dir  `json:"dirHasSuffix,omitempty"`
+	DirEqualFold     *http.Dir  `json:"dirEqualFold,omitempty"`
+	DirContainsFold  *http.Dir  `json:"dirContainsFold,omitempty"`
+	DirHasPrefixFold *http.Dir  `json:"dirHasPrefixFold,omitempty"`
+
+	// "ndir" field predicates.
+	Ndir              *http.Dir  `json:"ndir,omitempty"`
+	NdirNEQ           *http.Dir  `json:"ndirNEQ,omitempty"`
+	NdirIn            []http.Dir `json:"ndirIn,omitempty"`
+	NdirNotIn         []http.Dir `json:"ndirNotIn,omitempty"`
+	NdirGT            *http.Dir  `json:"ndirGT,omitempty"`
+	NdirGTE           *http.Dir  `json:"ndirGTE,omitempty"`
+	NdirLT            *http.Dir  `json:"ndirLT,omitempty"`
+	NdirLTE           *http.Dir  `json:"ndirLTE,omitempty"`
+	NdirContains      *http.Dir  `json:"ndirContains,omitempty"`
+	NdirHasPrefix     *http.Dir  `json:"ndirHasPrefix,omitempty"`
+	NdirHasSuffix     *http.Dir  `json:"ndirHasSuffix,omitempty"`
+	NdirIsNil         bool       `json:"ndirIsNil,omitempty"`
+	NdirNotNil        bool       `json:"ndirNotNil,omitempty"`
+	NdirEqualFold     *http.Dir  `json:"ndirEqualFold,omitempty"`
+	NdirContainsFold  *http.Dir  `json:"ndirContainsFold,omitempty"`
+	NdirHasPrefixFold *http.Dir  `json:"ndirHasPrefixFold,omitempty"`
+
+	// "str" field predicates.
+	Str              *sql.NullString  `json:"str,omitempty"`
+	StrNEQ           *sql.NullString  `json:"strNEQ,omitempty"`
+	StrIn            []sql.NullString `json:"strIn,omitempty"`
+	StrNotIn         []sql.NullString `json:"strNotIn,omitempty"`
+	StrGT            *sql.NullString  `json:"strGT,omitempty"`
+	StrGTE           *sql.NullString  `json:"strGTE,omitempty"`
+	StrLT            *sql.NullString  `json:"strLT,omitempty"`
+	StrLTE           *sql.NullString  `json:"strLTE,omitempty"`
+	StrContains      *sql.NullString  `json:"strContains,omitempty"`
+	StrHasPrefix     *sql.NullString  `json:"strHasPrefix,omitempty"`
+	StrHasSuffix     *sql.NullString  `json:"strHasSuffix,omitempty"`
+	StrIsNil         bool             `json:"strIsNil,omitempty"`
+	StrNotNil        bool             `json:"strNotNil,omitempty"`
+	StrEqualFold     *sql.NullString  `json:"strEqualFold,omitempty"`
+	StrContainsFold  *sql.NullString  `json:"strContainsFold,omitempty"`
+	StrHasPrefixFold *sql.NullString  `json:"strHasPrefixFold,omitempty"`
+
+	// "null_str" field predicates.
+	NullStr              **sql.NullString  `json:"nullStr,omitempty"`
+	NullStrNEQ           **sql.NullString  `json:"nullStrNEQ,omitempty"`
+	NullStrIn            []*sql.NullString `json:"nullStrIn,omitempty"`
+	NullStrNotIn         []*sql.NullString `json:"nullStrNotIn,omitempty"`
+	NullStrGT            **sql.NullString  `json:"nullStrGT,omitempty"`
+	NullStrGTE           **sql.NullString  `json:"nullStrGTE,omitempty"`
+	NullStrLT            **sql.NullString  `json:"nullStrLT,omitempty"`
+	NullStrLTE           **sql.NullString  `json:"nullStrLTE,omitempty"`
+	NullStrContains      **sql.NullString  `json:"nullStrContains,omitempty"`
+	NullStrHasPrefix     **sql.NullString  `json:"nullStrHasPrefix,omitempty"`
+	NullStrHasSuffix     **sql.NullString  `json:"nullStrHasSuffix,omitempty"`
+	NullStrIsNil         bool              `json:"nullStrIsNil,omitempty"`
+	NullStrNotNil        bool              `json:"nullStrNotNil,omitempty"`
+	NullStrEqualFold     **sql.NullString  `json:"nullStrEqualFold,omitempty"`
+	NullStrContainsFold  **sql.NullString  `json:"nullStrContainsFold,omitempty"`
+	NullStrHasPrefixFold **sql.NullString  `json:"nullStrHasPrefixFold,omitempty"`
+
+	// "link" field predicates.
+	Link              *schema.Link  `json:"link,omitempty"`
+	LinkNEQ           *schema.Link  `json:"linkNEQ,omitempty"`
+	LinkIn            []schema.Link `json:"linkIn,omitempty"`
+	LinkNotIn         []schema.Link `json:"linkNotIn,omitempty"`
+	LinkGT            *schema.Link  `json:"linkGT,omitempty"`
+	LinkGTE           *schema.Link  `json:"linkGTE,omitempty"`
+	LinkLT            *schema.Link  `json:"linkLT,omitempty"`
+	LinkLTE           *schema.Link  `json:"linkLTE,omitempty"`
+	LinkContains      *schema.Link  `json:"linkContains,omitempty"`
+	LinkHasPrefix     *schema.Link  `json:"linkHasPrefix,omitempty"`
+	LinkHasSuffix     *schema.Link  `json:"linkHasSuffix,omitempty"`
+	LinkIsNil         bool          `json:"linkIsNil,omitempty"`
+	LinkNotNil        bool          `json:"linkNotNil,omitempty"`
+	LinkEqualFold     *schema.Link  `json:"linkEqualFold,omitempty"`
+	LinkContainsFold  *schema.Link  `json:"linkContainsFold,omitempty"`
+	LinkHasPrefixFold *schema.Link  `json:"linkHasPrefixFold,omitempty"`
+
+	// "null_link" field predicates.
+	NullLink              **schema.Link  `json:"nullLink,omitempty"`
+	NullLinkNEQ           **schema.Link  `json:"nullLinkNEQ,omitempty"`
+	NullLinkIn            []*schema.Link `json:"nullLinkIn,omitempty"`
+	NullLinkNotIn         []*schema.Link `json:"nullLinkNotIn,omitempty"`
+	NullLinkGT            **schema.Link  `json:"nullLinkGT,omitempty"`
+	NullLinkGTE           **schema.Link  `json:"nullLinkGTE,omitempty"`
+	NullLinkLT            **schema.Link  `json:"nullLinkLT,omitempty"`
+	NullLinkLTE           **schema.Link  `json:"nullLinkLTE,omitempty"`
+	NullLinkContains      **schema.Link  `json:"nullLinkContains,omitempty"`
+	NullLinkHasPrefix     **schema.Link  `json:"nullLinkHasPrefix,omitempty"`
+	NullLinkHasSuffix     **schema.Link  `json:"nullLinkHasSuffix,omitempty"`
+	NullLinkIsNil         bool           `json:"nullLinkIsNil,omitempty"`
+	NullLinkNotNil        bool           `json:"nullLinkNotNil,omitempty"`
+	NullLinkEqualFold     **schema.Link  `json:"nullLinkEqualFold,omitempty"`
+	NullLinkContainsFold  **schema.Link  `json:"nullLinkContainsFold,omitempty"`
+	NullLinkHasPrefixFold **schema.Link  `json:"nullLinkHasPrefixFold,omitempty"`
+
+	// "active" field predicates.
+	Active       *schema.Status `json:"active,omitempty"`
+	ActiveNEQ    *schema.Status `json:"activeNEQ,omitempty"`
+	ActiveIsNil  bool           `json:"activeIsNil,omitempty"`
+	ActiveNotNil bool           `json:"activeNotNil,omitempty"`
+
+	// "null_active" field predicates.
+	NullActive       *schema.Status `json:"nullActive,omitempty"`
+	NullActiveNEQ    *schema.Status `json:"nullActiveNEQ,omitempty"`
+	NullActiveIsNil  bool           `json:"nullActiveIsNil,omitempty"`
+	NullActiveNotNil bool           `json:"nullActiveNotNil,omitempty"`
+
+	// "deleted" field predicates.
+	Deleted       **sql.NullBool `json:"deleted,omitempty"`
+	DeletedNEQ    **sql.NullBool `json:"deletedNEQ,omitempty"`
+	DeletedIsNil  bool           `json:"deletedIsNil,omitempty"`
+	DeletedNotNil bool           `json:"deletedNotNil,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       **sql.NullTime  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    **sql.NullTime  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*sql.NullTime `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*sql.NullTime `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     **sql.NullTime  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    **sql.NullTime  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     **sql.NullTime  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    **sql.NullTime  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool            `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool            `json:"deletedAtNotNil,omitempty"`
+
+	// "raw_data" field predicates.
+	RawData       *[]byte  `json:"rawData,omitempty"`
+	RawDataNEQ    *[]byte  `json:"rawDataNEQ,omitempty"`
+	RawDataIn     [][]byte `json:"rawDataIn,omitempty"`
+	RawDataNotIn  [][]byte `json:"rawDataNotIn,omitempty"`
+	RawDataGT     *[]byte  `json:"rawDataGT,omitempty"`
+	RawDataGTE    *[]byte  `json:"rawDataGTE,omitempty"`
+	RawDataLT     *[]byte  `json:"rawDataLT,omitempty"`
+	RawDataLTE    *[]byte  `json:"rawDataLTE,omitempty"`
+	RawDataIsNil  bool     `json:"rawDataIsNil,omitempty"`
+	RawDataNotNil bool     `json:"rawDataNotNil,omitempty"`
+
+	// "ip" field predicates.
+	IP       *net.IP  `json:"ip,omitempty"`
+	IPNEQ    *net.IP  `json:"ipNEQ,omitempty"`
+	IPIn     []net.IP `json:"ipIn,omitempty"`
+	IPNotIn  []net.IP `json:"ipNotIn,omitempty"`
+	IPGT     *net.IP  `json:"ipGT,omitempty"`
+	IPGTE    *net.IP  `json:"ipGTE,omitempty"`
+	IPLT     *net.IP  `json:"ipLT,omitempty"`
+	IPLTE    *net.IP  `json:"ipLTE,omitempty"`
+	IPIsNil  bool     `json:"ipIsNil,omitempty"`
+	IPNotNil bool     `json:"ipNotNil,omitempty"`
+
+	// "null_int64" field predicates.
+	NullInt64       **sql.NullInt64  `json:"nullInt64,omitempty"`
+	NullInt64NEQ    **sql.NullInt64  `json:"nullInt64NEQ,omitempty"`
+	NullInt64In     []*sql.NullInt64 `json:"nullInt64In,omitempty"`
+	NullInt64NotIn  []*sql.NullInt64 `json:"nullInt64NotIn,omitempty"`
+	NullInt64GT     **sql.NullInt64  `json:"nullInt64GT,omitempty"`
+	NullInt64GTE    **sql.NullInt64  `json:"nullInt64GTE,omitempty"`
+	NullInt64LT     **sql.NullInt64  `json:"nullInt64LT,omitempty"`
+	NullInt64LTE    **sql.NullInt64  `json:"nullInt64LTE,omitempty"`
+	NullInt64IsNil  bool             `json:"nullInt64IsNil,omitempty"`
+	NullInt64NotNil bool             `json:"nullInt64NotNil,omitempty"`
+
+	// "schema_int" field predicates.
+	SchemaInt       *schema.Int  `json:"schemaInt,omitempty"`
+	SchemaIntNEQ    *schema.Int  `json:"schemaIntNEQ,omitempty"`
+	SchemaIntIn     []schema.Int `json:"schemaIntIn,omitempty"`
+	SchemaIntNotIn  []schema.Int `json:"schemaIntNotIn,omitempty"`
+	SchemaIntGT     *schema.Int  `json:"schemaIntGT,omitempty"`
+	SchemaIntGTE    *schema.Int  `json:"schemaIntGTE,omitempty"`
+	SchemaIntLT     *schema.Int  `json:"schemaIntLT,omitempty"`
+	SchemaIntLTE    *schema.Int  `json:"schemaIntLTE,omitempty"`
+	SchemaIntIsNil  bool         `json:"schemaIntIsNil,omitempty"`
+	SchemaIntNotNil bool         `json:"schemaIntNotNil,omitempty"`
+
+	// "schema_int8" field predicates.
+	SchemaInt8       *schema.Int8  `json:"schemaInt8,omitempty"`
+	SchemaInt8NEQ    *schema.Int8  `json:"schemaInt8NEQ,omitempty"`
+	SchemaInt8In     []schema.Int8 `json:"schemaInt8In,omitempty"`
+	SchemaInt8NotIn  []schema.Int8 `json:"schemaInt8NotIn,omitempty"`
+	SchemaInt8GT     *schema.Int8  `json:"schemaInt8GT,omitempty"`
+	SchemaInt8GTE    *schema.Int8  `json:"schemaInt8GTE,omitempty"`
+	SchemaInt8LT     *schema.Int8  `json:"schemaInt8LT,omitempty"`
+	SchemaInt8LTE    *schema.Int8  `json:"schemaInt8LTE,omitempty"`
+	SchemaInt8IsNil  bool          `json:"schemaInt8IsNil,omitempty"`
+	SchemaInt8NotNil bool          `json:"schemaInt8NotNil,omitempty"`
+
+	// "schema_int64" field predicates.
+	SchemaInt64       *schema.Int64  `json:"schemaInt64,omitempty"`
+	SchemaInt64NEQ    *schema.Int64  `json:"schemaInt64NEQ,omitempty"`
+	SchemaInt64In     []schema.Int64 `json:"schemaInt64In,omitempty"`
+	SchemaInt64NotIn  []schema.Int64 `json:"schemaInt64NotIn,omitempty"`
+	SchemaInt64GT     *schema.Int64  `json:"schemaInt64GT,omitempty"`
+	SchemaInt64GTE    *schema.Int64  `json:"schemaInt64GTE,omitempty"`
+	SchemaInt64LT     *schema.Int64  `json:"schemaInt64LT,omitempty"`
+	SchemaInt64LTE    *schema.Int64  `json:"schemaInt64LTE,omitempty"`
+	SchemaInt64IsNil  bool           `json:"schemaInt64IsNil,omitempty"`
+	SchemaInt64NotNil bool           `json:"schemaInt64NotNil,omitempty"`
+
+	// "schema_float" field predicates.
+	SchemaFloat       *schema.Float64  `json:"schemaFloat,omitempty"`
+	SchemaFloatNEQ    *schema.Float64  `json:"schemaFloatNEQ,omitempty"`
+	SchemaFloatIn     []schema.Float64 `json:"schemaFloatIn,omitempty"`
+	SchemaFloatNotIn  []schema.Float64 `json:"schemaFloatNotIn,omitempty"`
+	SchemaFloatGT     *schema.Float64  `json:"schemaFloatGT,omitempty"`
+	SchemaFloatGTE    *schema.Float64  `json:"schemaFloatGTE,omitempty"`
+	SchemaFloatLT     *schema.Float64  `json:"schemaFloatLT,omitempty"`
+	SchemaFloatLTE    *schema.Float64  `json:"schemaFloatLTE,omitempty"`
+	SchemaFloatIsNil  bool             `json:"schemaFloatIsNil,omitempty"`
+	SchemaFloatNotNil bool             `json:"schemaFloatNotNil,omitempty"`
+
+	// "schema_float32" field predicates.
+	SchemaFloat32       *schema.Float32  `json:"schemaFloat32,omitempty"`
+	SchemaFloat32NEQ    *schema.Float32  `json:"schemaFloat32NEQ,omitempty"`
+	SchemaFloat32In     []schema.Float32 `json:"schemaFloat32In,omitempty"`
+	SchemaFloat32NotIn  []schema.Float32 `json:"schemaFloat32NotIn,omitempty"`
+	SchemaFloat32GT     *schema.Float32  `json:"schemaFloat32GT,omitempty"`
+	SchemaFloat32GTE    *schema.Float32  `json:"schemaFloat32GTE,omitempty"`
+	SchemaFloat32LT     *schema.Float32  `json:"schemaFloat32LT,omitempty"`
+	SchemaFloat32LTE    *schema.Float32  `json:"schemaFloat32LTE,omitempty"`
+	SchemaFloat32IsNil  bool             `json:"schemaFloat32IsNil,omitempty"`
+	SchemaFloat32NotNil bool             `json:"schemaFloat32NotNil,omitempty"`
+
+	// "null_float" field predicates.
+	NullFloat       **sql.NullFloat64  `json:"nullFloat,omitempty"`
+	NullFloatNEQ    **sql.NullFloat64  `json:"nullFloatNEQ,omitempty"`
+	NullFloatIn     []*sql.NullFloat64 `json:"nullFloatIn,omitempty"`
+	NullFloatNotIn  []*sql.NullFloat64 `json:"nullFloatNotIn,omitempty"`
+	NullFloatGT     **sql.NullFloat64  `json:"nullFloatGT,omitempty"`
+	NullFloatGTE    **sql.NullFloat64  `json:"nullFloatGTE,omitempty"`
+	NullFloatLT     **sql.NullFloat64  `json:"nullFloatLT,omitempty"`
+	NullFloatLTE    **sql.NullFloat64  `json:"nullFloatLTE,omitempty"`
+	NullFloatIsNil  bool               `json:"nullFloatIsNil,omitempty"`
+	NullFloatNotNil bool               `json:"nullFloatNotNil,omitempty"`
+
+	// "role" field predicates.
+	Role      *role.Role  `json:"role,omitempty"`
+	RoleNEQ   *role.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []role.Role `json:"roleIn,omitempty"`
+	RoleNotIn []role.Role `json:"roleNotIn,omitempty"`
+
+	// "priority" field predicates.
+	Priority       *role.Priority  `json:"priority,omitempty"`
+	PriorityNEQ    *role.Priority  `json:"priorityNEQ,omitempty"`
+	PriorityIn     []role.Priority `json:"priorityIn,omitempty"`
+	PriorityNotIn  []role.Priority `json:"priorityNotIn,omitempty"`
+	PriorityIsNil  bool            `json:"priorityIsNil,omitempty"`
+	PriorityNotNil bool            `json:"priorityNotNil,omitempty"`
+
+	// "optional_uuid" field predicates.
+	OptionalUUID       *uuid.UUID  `json:"optionalUUID,omitempty"`
+	OptionalUUIDNEQ    *uuid.UUID  `json:"optionalUUIDNEQ,omitempty"`
+	OptionalUUIDIn     []uuid.UUID `json:"optionalUUIDIn,omitempty"`
+	OptionalUUIDNotIn  []uuid.UUID `json:"optionalUUIDNotIn,omitempty"`
+	OptionalUUIDGT     *uuid.UUID  `json:"optionalUUIDGT,omitempty"`
+	OptionalUUIDGTE    *uuid.UUID  `json:"optionalUUIDGTE,omitempty"`
+	OptionalUUIDLT     *uuid.UUID  `json:"optionalUUIDLT,omitempty"`
+	OptionalUUIDLTE    *uuid.UUID  `json:"optionalUUIDLTE,omitempty"`
+	OptionalUUIDIsNil  bool        `json:"optionalUUIDIsNil,omitempty"`
+	OptionalUUIDNotNil bool        `json:"optionalUUIDNotNil,omitempty"`
+
+	// "nillable_uuid" field predicates.
+	NillableUUID       *uuid.UUID  `json:"nillableUUID,omitempty"`
+	NillableUUIDNEQ    *uuid.UUID  `json:"nillableUUIDNEQ,omitempty"`
+	NillableUUIDIn     []uuid.UUID `json:"nillableUUIDIn,omitempty"`
+	NillableUUIDNotIn  []uuid.UUID `json:"nillableUUIDNotIn,omitempty"`
+	NillableUUIDGT     *uuid.UUID  `json:"nillableUUIDGT,omitempty"`
+	NillableUUIDGTE    *uuid.UUID  `json:"nillableUUIDGTE,omitempty"`
+	NillableUUIDLT     *uuid.UUID  `json:"nillableUUIDLT,omitempty"`
+	NillableUUIDLTE    *uuid.UUID  `json:"nillableUUIDLTE,omitempty"`
+	NillableUUIDIsNil  bool        `json:"nillableUUIDIsNil,omitempty"`
+	NillableUUIDNotNil bool        `json:"nillableUUIDNotNil,omitempty"`
+
+	// "strings" field predicates.
+	StringsIsNil  bool `json:"stringsIsNil,omitempty"`
+	StringsNotNil bool `json:"stringsNotNil,omitempty"`
+
+	// "pair" field predicates.
+	Pair      *schema.Pair  `json:"pair,omitempty"`
+	PairNEQ   *schema.Pair  `json:"pairNEQ,omitempty"`
+	PairIn    []schema.Pair `json:"pairIn,omitempty"`
+	PairNotIn []schema.Pair `json:"pairNotIn,omitempty"`
+	PairGT    *schema.Pair  `json:"pairGT,omitempty"`
+	PairGTE   *schema.Pair  `json:"pairGTE,omitempty"`
+	PairLT    *schema.Pair  `json:"pairLT,omitempty"`
+	PairLTE   *schema.Pair  `json:"pairLTE,omitempty"`
+
+	// "nil_pair" field predicates.
+	NilPair       **schema.Pair  `json:"nilPair,omitempty"`
+	NilPairNEQ    **schema.Pair  `json:"nilPairNEQ,omitempty"`
+	NilPairIn     []*schema.Pair `json:"nilPairIn,omitempty"`
+	NilPairNotIn  []*schema.Pair `json:"nilPairNotIn,omitempty"`
+	NilPairGT     **schema.Pair  `json:"nilPairGT,omitempty"`
+	NilPairGTE    **schema.Pair  `json:"nilPairGTE,omitempty"`
+	NilPairLT     **schema.Pair  `json:"nilPairLT,omitempty"`
+	NilPairLTE    **schema.Pair  `json:"nilPairLTE,omitempty"`
+	NilPairIsNil  bool           `json:"nilPairIsNil,omitempty"`
+	NilPairNotNil bool           `json:"nilPairNotNil,omitempty"`
+
+	// "vstring" field predicates.
+	Vstring              *schema.VString  `json:"vstring,omitempty"`
+	VstringNEQ           *schema.VString  `json:"vstringNEQ,omitempty"`
+	VstringIn            []schema.VString `json:"vstringIn,omitempty"`
+	VstringNotIn         []schema.VString `json:"vstringNotIn,omitempty"`
+	VstringGT            *schema.VString  `json:"vstringGT,omitempty"`
+	VstringGTE           *schema.VString  `json:"vstringGTE,omitempty"`
+	VstringLT            *schema.VString  `json:"vstringLT,omitempty"`
+	VstringLTE           *schema.VString  `json:"vstringLTE,omitempty"`
+	VstringContains      *schema.VString  `json:"vstringContains,omitempty"`
+	VstringHasPrefix     *schema.VString  `json:"vstringHasPrefix,omitempty"`
+	VstringHasSuffix     *schema.VString  `json:"vstringHasSuffix,omitempty"`
+	VstringEqualFold     *schema.VString  `json:"vstringEqualFold,omitempty"`
+	VstringContainsFold  *schema.VString  `json:"vstringContainsFold,omitempty"`
+	VstringHasPrefixFold *schema.VString  `json:"vstringHasPrefixFold,omitempty"`
+
+	// "triple" field predicates.
+	Triple      *schema.Triple  `json:"triple,omitempty"`
+	TripleNEQ   *schema.Triple  `json:"tripleNEQ,omitempty"`
+	TripleIn    []schema.Triple `json:"tripleIn,omitempty"`
+	TripleNotIn []schema.Triple `json:"tripleNotIn,omitempty"`
+	TripleGT    *schema.Triple  `json:"tripleGT,omitempty"`
+	TripleGTE   *schema.Triple  `json:"tripleGTE,omitempty"`
+	TripleLT    *schema.Triple  `json:"tripleLT,omitempty"`
+	TripleLTE   *schema.Triple  `json:"tripleLTE,omitempty"`
+
+	// "big_int" field predicates.
+	BigInt       *schema.BigInt  `json:"bigInt,omitempty"`
+	BigIntNEQ    *schema.BigInt  `json:"bigIntNEQ,omitempty"`
+	BigIntIn     []schema.BigInt `json:"bigIntIn,omitempty"`
+	BigIntNotIn  []schema.BigInt `json:"bigIntNotIn,omitempty"`
+	BigIntGT     *schema.BigInt  `json:"bigIntGT,omitempty"`
+	BigIntGTE    *schema.BigInt  `json:"bigIntGTE,omitempty"`
+	BigIntLT     *schema.BigInt  `json:"bigIntLT,omitempty"`
+	BigIntLTE    *schema.BigInt  `json:"bigIntLTE,omitempty"`
+	BigIntIsNil  bool            `json:"bigIntIsNil,omitempty"`
+	BigIntNotNil bool            `json:"bigIntNotNil,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *FieldTypeFilterInput) Apply(q *FieldTypeQuery) (*FieldTypeQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *FieldTypeFilterInput) P() (predicate.FieldType, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.FieldType
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, fieldtype.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*FieldTypeFilterInput
+		join    func(...predicate.FieldType) predicate.FieldType
+	}{
+		{filters: f.And, join: fieldtype.And},
+		{filters: f.Or, join: fieldtype.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.FieldType, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, fieldtype.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, fieldtype.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, fieldtype.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, fieldtype.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, fieldtype.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, fieldtype.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, fieldtype.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, fieldtype.IDLTE(*f.IDLTE))
+	}
+	if f.Int != nil {
+		preds = append(preds, fieldtype.IntEQ(*f.Int))
+	}
+	if f.IntNEQ != nil {
+		preds = append(preds, fieldtype.IntNEQ(*f.IntNEQ))
+	}
+	if f.IntIn != nil {
+		preds = append(preds, fieldtype.IntIn(f.IntIn...))
+	}
+	if f.IntNotIn != nil {
+		preds = append(preds, fieldtype.IntNotIn(f.IntNotIn...))
+	}
+	if f.IntGT != nil {
+		preds = append(preds, fieldtype.IntGT(*f.IntGT))
+	}
+	if f.IntGTE != nil {
+		preds = append(preds, fieldtype.IntGTE(*f.IntGTE))
+	}
+	if f.IntLT != nil {
+		preds = append(preds, fieldtype.IntLT(*f.IntLT))
+	}
+	if f.IntLTE != nil {
+		preds = append(preds, fieldtype.IntLTE(*f.IntLTE))
+	}
+	if f.Int8 != nil {
+		preds = append(preds, fieldtype.Int8EQ(*f.Int8))
+	}
+	if f.Int8NEQ != nil {
+		preds = append(preds, fieldtype.Int8NEQ(*f.Int8NEQ))
+	}
+	if f.Int8In != nil {
+		preds = append(preds, fieldtype.Int8In(f.Int8In...))
+	}
+	if f.Int8NotIn != nil {
+		preds = append(preds, fieldtype.Int8NotIn(f.Int8NotIn...))
+	}
+	if f.Int8GT != nil {
+		preds = append(preds, fieldtype.Int8GT(*f.Int8GT))
+	}
+	if f.Int8GTE != nil {
+		preds = append(preds, fieldtype.Int8GTE(*f.Int8GTE))
+	}
+	if f.Int8LT != nil {
+		preds = append(preds, fieldtype.Int8LT(*f.Int8LT))
+	}
+	if f.Int8LTE != nil {
+		preds = append(preds, fieldtype.Int8LTE(*f.Int8LTE))
+	}
+	if f.Int16 != nil {
+		preds = append(preds, fieldtype.Int16EQ(*f.Int16))
+	}
+	if f.Int16NEQ != nil {
+		preds = append(preds, fieldtype.Int16NEQ(*f.Int16NEQ))
+	}
+	if f.Int16In != nil {
+		preds = append(preds, fieldtype.Int16In(f.Int16In...))
+	}
+	if f.Int16NotIn != nil {
+		preds = append(preds, fieldtype.Int16NotIn(f.Int16NotIn...))
+	}
+	if f.Int16GT != nil {
+		preds = append(preds, fieldtype.Int16GT(*f.Int16GT))
+	}
+	if f.Int16GTE != nil {
+		preds = append(preds, fieldtype.Int16GTE(*f.Int16GTE))
+	}
+	if f.Int16LT != nil {
+		preds = append(preds, fieldtype.Int16LT(*f.Int16LT))
+	}
+	if f.Int16LTE != nil {
+		preds = append(preds, fieldtype.Int16LTE(*f.Int16LTE))
+	}
+	if f.Int32 != nil {
+		preds = append(preds, fieldtype.Int32EQ(*f.Int32))
+	}
+	if f.Int32NEQ != nil {
+		preds = append(preds, fieldtype.Int32NEQ(*f.Int32NEQ))
+	}
+	if f.Int32In != nil {
+		preds = append(preds, fieldtype.Int32In(f.Int32In...))
+	}
+	if f.Int32NotIn != nil {
+		preds = append(preds, fieldtype.Int32NotIn(f.Int32NotIn...))
+	}
+	if f.Int32GT != nil {
+		preds = append(preds, fieldtype.Int32GT(*f.Int32GT))
+	}
+	if f.Int32GTE != nil {
+		preds = append(preds, fieldtype.Int32GTE(*f.Int32GTE))
+	}
+	if f.Int32LT != nil {
+		preds = append(preds, fieldtype.Int32LT(*f.Int32LT))
+	}
+	if f.Int32LTE != nil {
+		preds = append(preds, fieldtype.Int32LTE(*f.Int32LTE))
+	}
+	if f.Int64 != nil {
+		preds = append(preds, fieldtype.Int64EQ(*f.Int64))
+	}
+	if f.Int64NEQ != nil {
+		preds = append(preds, fieldtype.Int64NEQ(*f.Int64NEQ))
+	}
+	if f.Int64In != nil {
+		preds = append(preds, fieldtype.Int64In(f.Int64In...))
+	}
+	if f.Int64NotIn != nil {
+		preds = append(preds, fieldtype.Int64NotIn(f.Int64NotIn...))
+	}
+	if f.Int64GT != nil {
+		preds = append(preds, fieldtype.Int64GT(*f.Int64GT))
+	}
+	if f.Int64GTE != nil {
+		preds = append(preds, fieldtype.Int64GTE(*f.Int64GTE))
+	}
+	if f.Int64LT != nil {
+		preds = append(preds, fieldtype.Int64LT(*f.Int64LT))
+	}
+	if f.Int64LTE != nil {
+		preds = append(preds, fieldtype.Int64LTE(*f.Int64LTE))
+	}
+	if f.OptionalInt != nil {
+		preds = append(preds, fieldtype.OptionalIntEQ(*f.OptionalInt))
+	}
+	if f.OptionalIntNEQ != nil {
+		preds = append(preds, fieldtype.OptionalIntNEQ(*f.OptionalIntNEQ))
+	}
+	if f.OptionalIntIn != nil {
+		preds = append(preds, fieldtype.OptionalIntIn(f.OptionalIntIn...))
+	}
+	if f.OptionalIntNotIn != nil {
+		preds = append(preds, fieldtype.OptionalIntNotIn(f.OptionalIntNotIn...))
+	}
+	if f.OptionalIntGT != nil {
+		preds = append(preds, fieldtype.OptionalIntGT(*f.OptionalIntGT))
+	}
+	if f.OptionalIntGTE != nil {
+		preds = append(preds, fieldtype.OptionalIntGTE(*f.OptionalIntGTE))
+	}
+	if f.OptionalIntLT != nil {
+		preds = append(preds, fieldtype.OptionalIntLT(*f.OptionalIntLT))
+	}
+	if f.OptionalIntLTE != nil {
+		preds = append(preds, fieldtype.OptionalIntLTE(*f.OptionalIntLTE))
+	}
+	if f.OptionalIntIsNil {
+		preds = append(preds, fieldtype.OptionalIntIsNil())
+	}
+	if f.OptionalIntNotNil {
+		preds = append(preds, fieldtype.OptionalIntNotNil())
+	}
+	if f.OptionalInt8 != nil {
+		preds = append(preds, fieldtype.OptionalInt8EQ(*f.OptionalInt8))
+	}
+	if f.OptionalInt8NEQ != nil {
+		preds = append(preds, fieldtype.OptionalInt8NEQ(*f.OptionalInt8NEQ))
+	}
+	if f.OptionalInt8In != nil {
+		preds = append(preds, fieldtype.OptionalInt8In(f.OptionalInt8In...))
+	}
+	if f.OptionalInt8NotIn != nil {
+		preds = append(preds, fieldtype.OptionalInt8NotIn(f.OptionalInt8NotIn...))
+	}
+	if f.OptionalInt8GT != nil {
+		preds = append(preds, fieldtype.OptionalInt8GT(*f.OptionalInt8GT))
+	}
+	if f.OptionalInt8GTE != nil {
+		preds = append(preds, fieldtype.OptionalInt8GTE(*f.OptionalInt8GTE))
+	}
+	if f.OptionalInt8LT != nil {
+		preds = append(preds, fieldtype.OptionalInt8LT(*f.OptionalInt8LT))
+	}
+	if f.OptionalInt8LTE != nil {
+		preds = append(preds, fieldtype.OptionalInt8LTE(*f.OptionalInt8LTE))
+	}
+	if f.OptionalInt8IsNil {
+		preds = append(preds, fieldtype.OptionalInt8IsNil())
+	}
+	if f.OptionalInt8NotNil {
+		preds = append(preds, fieldtype.OptionalInt8NotNil())
+	}
+	if f.OptionalInt16 != nil {
+		preds = append(preds, fieldtype.OptionalInt16EQ(*f.OptionalInt16))
+	}
+	if f.OptionalInt16NEQ != nil {
+		preds = append(preds, fieldtype.OptionalInt16NEQ(*f.OptionalInt16NEQ))
+	}
+	if f.OptionalInt16In != nil {
+		preds = append(preds, fieldtype.OptionalInt16In(f.OptionalInt16In...))
+	}
+	if f.OptionalInt16NotIn != nil {
+		preds = append(preds, fieldtype.OptionalInt16NotIn(f.OptionalInt16NotIn...))
+	}
+	if f.OptionalInt16GT != nil {
+		preds = append(preds, fieldtype.OptionalInt16GT(*f.OptionalInt16GT))
+	}
+	if f.OptionalInt16GTE != nil {
+		preds = append(preds, fieldtype.OptionalInt16GTE(*f.OptionalInt16GTE))
+	}
+	if f.OptionalInt16LT != nil {
+		preds = append(preds, fieldtype.OptionalInt16LT(*f.OptionalInt16LT))
+	}
+	if f.OptionalInt16LTE != nil {
+		preds = append(preds, fieldtype.OptionalInt16LTE(*f.OptionalInt16LTE))
+	}
+	if f.OptionalInt16IsNil {
+		preds = append(preds, fieldtype.OptionalInt16IsNil())
+	}
+	if f.OptionalInt16NotNil {
+		preds = append(preds, fieldtype.OptionalInt16NotNil())
+	}
+	if f.OptionalInt32 != nil {
+		preds = append(preds, fieldtype.OptionalInt32EQ(*f.OptionalInt32))
+	}
+	if f.OptionalInt32NEQ != nil {
+		preds = append(preds, fieldtype.OptionalInt32NEQ(*f.OptionalInt32NEQ))
+	}
+	if f.OptionalInt32In != nil {
+		preds = append(preds, fieldtype.OptionalInt32In(f.OptionalInt32In...))
+	}
+	if f.OptionalInt32NotIn != nil {
+		preds = append(preds, fieldtype.OptionalInt32NotIn(f.OptionalInt32NotIn...))
+	}
+	if f.OptionalInt32GT != nil {
+		preds = append(preds, fieldtype.OptionalInt32GT(*f.OptionalInt32GT))
+	}
+	if f.OptionalInt32GTE != nil {
+		preds = append(preds, fieldtype.OptionalInt32GTE(*f.OptionalInt32GTE))
+	}
+	if f.OptionalInt32LT != nil {
+		preds = append(preds, fieldtype.OptionalInt32LT(*f.OptionalInt32LT))
+	}
+	if f.OptionalInt32LTE != nil {
+		preds = append(preds, fieldtype.OptionalInt32LTE(*f.OptionalInt32LTE))
+	}
+	if f.OptionalInt32IsNil {
+		preds = append(preds, fieldtype.OptionalInt32IsNil())
+	}
+	if f.OptionalInt32NotNil {
+		preds = append(preds, fieldtype.OptionalInt32NotNil())
+	}
+	if f.OptionalInt64 != nil {
+		preds = append(preds, fieldtype.OptionalInt64EQ(*f.OptionalInt64))
+	}
+	if f.OptionalInt64NEQ != nil {
+		preds = append(preds, fieldtype.OptionalInt64NEQ(*f.OptionalInt64NEQ))
+	}
+	if f.OptionalInt64In != nil {
+		preds = append(preds, fieldtype.OptionalInt64In(f.OptionalInt64In...))
+	}
+	if f.OptionalInt64NotIn != nil {
+		preds = append(preds, fieldtype.OptionalInt64NotIn(f.OptionalInt64NotIn...))
+	}
+	if f.OptionalInt64GT != nil {
+		preds = append(preds, fieldtype.OptionalInt64GT(*f.OptionalInt64GT))
+	}
+	if f.OptionalInt64GTE != nil {
+		preds = append(preds, fieldtype.OptionalInt64GTE(*f.OptionalInt64GTE))
+	}
+	if f.OptionalInt64LT != nil {
+		preds = append(preds, fieldtype.OptionalInt64LT(*f.OptionalInt64LT))
+	}
+	if f.OptionalInt64LTE != nil {
+		preds = append(preds, fieldtype.OptionalInt64LTE(*f.OptionalInt64LTE))
+	}
+	if f.OptionalInt64IsNil {
+		preds = append(preds, fieldtype.OptionalInt64IsNil())
+	}
+	if f.OptionalInt64NotNil {
+		preds = append(preds, fieldtype.OptionalInt64NotNil())
+	}
+	if f.NillableInt != nil {
+		preds = append(preds, fieldtype.NillableIntEQ(*f.NillableInt))
+	}
+	if f.NillableIntNEQ != nil {
+		preds = append(preds, fieldtype.NillableIntNEQ(*f.NillableIntNEQ))
+	}
+	if f.NillableIntIn != nil {
+		preds = append(preds, fieldtype.NillableIntIn(f.NillableIntIn...))
+	}
+	if f.NillableIntNotIn != nil {
+		preds = append(preds, fieldtype.NillableIntNotIn(f.NillableIntNotIn...))
+	}
+	if f.NillableIntGT != nil {
+		preds = append(preds, fieldtype.NillableIntGT(*f.NillableIntGT))
+	}
+	if f.NillableIntGTE != nil {
+		preds = append(preds, fieldtype.NillableIntGTE(*f.NillableIntGTE))
+	}
+	if f.NillableIntLT != nil {
+		preds = append(preds, fieldtype.NillableIntLT(*f.NillableIntLT))
+	}
+	if f.NillableIntLTE != nil {
+		preds = append(preds, fieldtype.NillableIntLTE(*f.NillableIntLTE))
+	}
+	if f.NillableIntIsNil {
+		preds = append(preds, fieldtype.NillableIntIsNil())
+	}
+	if f.NillableIntNotNil {
+		preds = append(preds, fieldtype.NillableIntNotNil())
+	}
+	if f.NillableInt8 != nil {
+		preds = append(preds, fieldtype.NillableInt8EQ(*f.NillableInt8))
+	}
+	if f.NillableInt8NEQ != nil {
+		preds = append(preds, fieldtype.NillableInt8NEQ(*f.NillableInt8NEQ))
+	}
+	if f.NillableInt8In != nil {
+		preds = append(preds, fieldtype.NillableInt8In(f.NillableInt8In...))
+	}
+	if f.NillableInt8NotIn != nil {
+		preds = append(preds, fieldtype.NillableInt8NotIn(f.NillableInt8NotIn...))
+	}
+	if f.NillableInt8GT != nil {
+		preds = append(preds, fieldtype.NillableInt8GT(*f.NillableInt8GT))
+	}
+	if f.NillableInt8GTE != nil {
+		preds = append(preds, fieldtype.NillableInt8GTE(*f.NillableInt8GTE))
+	}
+	if f.NillableInt8LT != nil {
+		preds = append(preds, fieldtype.NillableInt8LT(*f.NillableInt8LT))
+	}
+	if f.NillableInt8LTE != nil {
+		preds = append(preds, fieldtype.NillableInt8LTE(*f.NillableInt8LTE))
+	}
+	if f.NillableInt8IsNil {
+		preds = append(preds, fieldtype.NillableInt8IsNil())
+	}
+	if f.NillableInt8NotNil {
+		preds = append(preds, fieldtype.NillableInt8NotNil())
+	}
+	if f.NillableInt16 != nil {
+		preds = append(preds, fieldtype.NillableInt16EQ(*f.NillableInt16))
+	}
+	if f.NillableInt16NEQ != nil {
+		preds = append(preds, fieldtype.NillableInt16NEQ(*f.NillableInt16NEQ))
+	}
+	if f.NillableInt16In != nil {
+		preds = append(preds, fieldtype.NillableInt16In(f.NillableInt16In...))
+	}
+	if f.NillableInt16NotIn != nil {
+		preds = append(preds, fieldtype.NillableInt16NotIn(f.NillableInt16NotIn...))
+	}
+	if f.NillableInt16GT != nil {
+		preds = append(preds, fieldtype.NillableInt16GT(*f.NillableInt16GT))
+	}
+	if f.NillableInt16GTE != nil {
+		preds = append(preds, fieldtype.NillableInt16GTE(*f.NillableInt16GTE))
+	}
+	if f.NillableInt16LT != nil {
+		preds = append(preds, fieldtype.NillableInt16LT(*f.NillableInt16LT))
+	}
+	if f.NillableInt16LTE != nil {
+		preds = append(preds, fieldtype.NillableInt16LTE(*f.NillableInt16LTE))
+	}
+	if f.NillableInt16IsNil {
+		preds = append(preds, fieldtype.NillableInt16IsNil())
+	}
+	if f.NillableInt16NotNil {
+		preds = append(preds, fieldtype.NillableInt16NotNil())
+	}
+	if f.NillableInt32 != nil {
+		preds = append(preds, fieldtype.NillableInt32EQ(*f.NillableInt32))
+	}
+	if f.NillableInt32NEQ != nil {
+		preds = append(preds, fieldtype.NillableInt32NEQ(*f.NillableInt32NEQ))
+	}
+	if f.NillableInt32In != nil {
+		preds = append(preds, fieldtype.NillableInt32In(f.NillableInt32In...))
+	}
+	if f.NillableInt32NotIn != nil {
+		preds = append(preds, fieldtype.NillableInt32NotIn(f.NillableInt32NotIn...))
+	}
+	if f.NillableInt32GT != nil {
+		preds = append(preds, fieldtype.NillableInt32GT(*f.NillableInt32GT))
+	}
+	if f.NillableInt32GTE != nil {
+		preds = append(preds, fieldtype.NillableInt32GTE(*f.NillableInt32GTE))
+	}
+	if f.NillableInt32LT != nil {
+		preds = append(preds, fieldtype.NillableInt32LT(*f.NillableInt32LT))
+	}
+	if f.NillableInt32LTE != nil {
+		preds = append(preds, fieldtype.NillableInt32LTE(*f.NillableInt32LTE))
+	}
+	if f.NillableInt32IsNil {
+		preds = append(preds, fieldtype.NillableInt32IsNil())
+	}
+	if f.NillableInt32NotNil {
+		preds = append(preds, fieldtype.NillableInt32NotNil())
+	}
+	if f.NillableInt64 != nil {
+		preds = append(preds, fieldtype.NillableInt64EQ(*f.NillableInt64))
+	}
+	if f.NillableInt64NEQ != nil {
+		preds = append(preds, fieldtype.NillableInt64NEQ(*f.NillableInt64NEQ))
+	}
+	if f.NillableInt64In != nil {
+		preds = append(preds, fieldtype.NillableInt64In(f.NillableInt64In...))
+	}
+	if f.NillableInt64NotIn != nil {
+		preds = append(preds, fieldtype.NillableInt64NotIn(f.NillableInt64NotIn...))
+	}
+	if f.NillableInt64GT != nil {
+		preds = append(preds, fieldtype.NillableInt64GT(*f.NillableInt64GT))
+	}
+	if f.NillableInt64GTE != nil {
+		preds = append(preds, fieldtype.NillableInt64GTE(*f.NillableInt64GTE))
+	}
+	if f.NillableInt64LT != nil {
+		preds = append(preds, fieldtype.NillableInt64LT(*f.NillableInt64LT))
+	}
+	if f.NillableInt64LTE != nil {
+		preds = append(preds, fieldtype.NillableInt64LTE(*f.NillableInt64LTE))
+	}
+	if f.NillableInt64IsNil {
+		preds = append(preds, fieldtype.NillableInt64IsNil())
+	}
+	if f.NillableInt64NotNil {
+		preds = append(preds, fieldtype.NillableInt64NotNil())
+	}
+	if f.ValidateOptionalInt32 != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32EQ(*f.ValidateOptionalInt32))
+	}
+	if f.ValidateOptionalInt32NEQ != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32NEQ(*f.ValidateOptionalInt32NEQ))
+	}
+	if f.ValidateOptionalInt32In != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32In(f.ValidateOptionalInt32In...))
+	}
+	if f.ValidateOptionalInt32NotIn != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32NotIn(f.ValidateOptionalInt32NotIn...))
+	}
+	if f.ValidateOptionalInt32GT != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32GT(*f.ValidateOptionalInt32GT))
+	}
+	if f.ValidateOptionalInt32GTE != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32GTE(*f.ValidateOptionalInt32GTE))
+	}
+	if f.ValidateOptionalInt32LT != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32LT(*f.ValidateOptionalInt32LT))
+	}
+	if f.ValidateOptionalInt32LTE != nil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32LTE(*f.ValidateOptionalInt32LTE))
+	}
+	if f.ValidateOptionalInt32IsNil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32IsNil())
+	}
+	if f.ValidateOptionalInt32NotNil {
+		preds = append(preds, fieldtype.ValidateOptionalInt32NotNil())
+	}
+	if f.OptionalUint != nil {
+		preds = append(preds, fieldtype.OptionalUintEQ(*f.OptionalUint))
+	}
+	if f.OptionalUintNEQ != nil {
+		preds = append(preds, fieldtype.OptionalUintNEQ(*f.OptionalUintNEQ))
+	}
+	if f.OptionalUintIn != nil {
+		preds = append(preds, fieldtype.OptionalUintIn(f.OptionalUintIn...))
+	}
+	if f.OptionalUintNotIn != nil {
+		preds = append(preds, fieldtype.OptionalUintNotIn(f.OptionalUintNotIn...))
+	}
+	if f.OptionalUintGT != nil {
+		preds = append(preds, fieldtype.OptionalUintGT(*f.OptionalUintGT))
+	}
+	if f.OptionalUintGTE != nil {
+		preds = append(preds, fieldtype.OptionalUintGTE(*f.OptionalUintGTE))
+	}
+	if f.OptionalUintLT != nil {
+		preds = append(preds, fieldtype.OptionalUintLT(*f.OptionalUintLT))
+	}
+	if f.OptionalUintLTE != nil {
+		preds = append(preds, fieldtype.OptionalUintLTE(*f.OptionalUintLTE))
+	}
+	if f.OptionalUintIsNil {
+		preds = append(preds, fieldtype.OptionalUintIsNil())
+	}
+	if f.OptionalUintNotNil {
+		preds = append(preds, fieldtype.OptionalUintNotNil())
+	}
+	if f.OptionalUint8 != nil {
+		preds = append(preds, fieldtype.OptionalUint8EQ(*f.OptionalUint8))
+	}
+	if f.OptionalUint8NEQ != nil {
+		preds = append(preds, fieldtype.OptionalUint8NEQ(*f.OptionalUint8NEQ))
+	}
+	if f.OptionalUint8In != nil {
+		preds = append(preds, fieldtype.OptionalUint8In(f.OptionalUint8In...))
+	}
+	if f.OptionalUint8NotIn != nil {
+		preds = append(preds, fieldtype.OptionalUint8NotIn(f.OptionalUint8NotIn...))
+	}
+	if f.OptionalUint8GT != nil {
+		preds = append(preds, fieldtype.OptionalUint8GT(*f.OptionalUint8GT))
+	}
+	if f.OptionalUint8GTE != nil {
+		preds = append(preds, fieldtype.OptionalUint8GTE(*f.OptionalUint8GTE))
+	}
+	if f.OptionalUint8LT != nil {
+		preds = append(preds, fieldtype.OptionalUint8LT(*f.OptionalUint8LT))
+	}
+	if f.OptionalUint8LTE != nil {
+		preds = append(preds, fieldtype.OptionalUint8LTE(*f.OptionalUint8LTE))
+	}
+	if f.OptionalUint8IsNil {
+		preds = append(preds, fieldtype.OptionalUint8IsNil())
+	}
+	if f.OptionalUint8NotNil {
+		preds = append(preds, fieldtype.OptionalUint8NotNil())
+	}
+	if f.OptionalUint16 != nil {
+		preds = append(preds, fieldtype.OptionalUint16EQ(*f.OptionalUint16))
+	}
+	if f.OptionalUint16NEQ != nil {
+		preds = append(preds, fieldtype.OptionalUint16NEQ(*f.OptionalUint16NEQ))
+	}
+	if f.OptionalUint16In != nil {
+		preds = append(preds, fieldtype.OptionalUint16In(f.OptionalUint16In...))
+	}
+	if f.OptionalUint16NotIn != nil {
+		preds = append(preds, fieldtype.OptionalUint16NotIn(f.OptionalUint16NotIn...))
+	}
+	if f.OptionalUint16GT != nil {
+		preds = append(preds, fieldtype.OptionalUint16GT(*f.OptionalUint16GT))
+	}
+	if f.OptionalUint16GTE != nil {
+		preds = append(preds, fieldtype.OptionalUint16GTE(*f.OptionalUint16GTE))
+	}
+	if f.OptionalUint16LT != nil {
+		preds = append(preds, fieldtype.OptionalUint16LT(*f.OptionalUint16LT))
+	}
+	if f.OptionalUint16LTE != nil {
+		preds = append(preds, fieldtype.OptionalUint16LTE(*f.OptionalUint16LTE))
+	}
+	if f.OptionalUint16IsNil {
+		preds = append(preds, fieldtype.OptionalUint16IsNil())
+	}
+	if f.OptionalUint16NotNil {
+		preds = append(preds, fieldtype.OptionalUint16NotNil())
+	}
+	if f.OptionalUint32 != nil {
+		preds = append(preds, fieldtype.OptionalUint32EQ(*f.OptionalUint32))
+	}
+	if f.OptionalUint32NEQ != nil {
+		preds = append(preds, fieldtype.OptionalUint32NEQ(*f.OptionalUint32NEQ))
+	}
+	if f.OptionalUint32In != nil {
+		preds = append(preds, fieldtype.OptionalUint32In(f.OptionalUint32In...))
+	}
+	if f.OptionalUint32NotIn != nil {
+		preds = append(preds, fieldtype.OptionalUint32NotIn(f.OptionalUint32NotIn...))
+	}
+	if f.OptionalUint32GT != nil {
+		preds = append(preds, fieldtype.OptionalUint32GT(*f.OptionalUint32GT))
+	}
+	if f.OptionalUint32GTE != nil {
+		preds = append(preds, fieldtype.OptionalUint32GTE(*f.OptionalUint32GTE))
+	}
+	if f.OptionalUint32LT != nil {
+		preds = append(preds, fieldtype.OptionalUint32LT(*f.OptionalUint32LT))
+	}
+	if f.OptionalUint32LTE != nil {
+		preds = append(preds, fieldtype.OptionalUint32LTE(*f.OptionalUint32LTE))
+	}
+	if f.OptionalUint32IsNil {
+		preds = append(preds, fieldtype.OptionalUint32IsNil())
+	}
+	if f.OptionalUint32NotNil {
+		preds = append(preds, fieldtype.OptionalUint32NotNil())
+	}
+	if f.OptionalUint64 != nil {
+		preds = append(preds, fieldtype.OptionalUint64EQ(*f.OptionalUint64))
+	}
+	if f.OptionalUint64NEQ != nil {
+		preds = append(preds, fieldtype.OptionalUint64NEQ(*f.OptionalUint64NEQ))
+	}
+	if f.OptionalUint64In != nil {
+		preds = append(preds, fieldtype.OptionalUint64In(f.OptionalUint64In...))
+	}
+	if f.OptionalUint64NotIn != nil {
+		preds = append(preds, fieldtype.OptionalUint64NotIn(f.OptionalUint64NotIn...))
+	}
+	if f.OptionalUint64GT != nil {
+		preds = append(preds, fieldtype.OptionalUint64GT(*f.OptionalUint64GT))
+	}
+	if f.OptionalUint64GTE != nil {
+		preds = append(preds, fieldtype.OptionalUint64GTE(*f.OptionalUint64GTE))
+	}
+	if f.OptionalUint64LT != nil {
+		preds = append(preds, fieldtype.OptionalUint64LT(*f.OptionalUint64LT))
+	}
+	if f.OptionalUint64LTE != nil {
+		preds = append(preds, fieldtype.OptionalUint64LTE(*f.OptionalUint64LTE))
+	}
+	if f.OptionalUint64IsNil {
+		preds = append(preds, fieldtype.OptionalUint64IsNil())
+	}
+	if f.OptionalUint64NotNil {
+		preds = append(preds, fieldtype.OptionalUint64NotNil())
+	}
+	if f.State != nil {
+		for _, v := range []fieldtype.State{*f.State} {
+			if err := fieldtype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.state": %w`, err)}
+			}
+		}
+	}
+	if f.State != nil {
+		preds = append(preds, fieldtype.StateEQ(*f.State))
+	}
+	if f.StateNEQ != nil {
+		for _, v := range []fieldtype.State{*f.StateNEQ} {
+			if err := fieldtype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.state": %w`, err)}
+			}
+		}
+	}
+	if f.StateNEQ != nil {
+		preds = append(preds, fieldtype.StateNEQ(*f.StateNEQ))
+	}
+	if f.StateIn != nil {
+		for _, v := range f.StateIn {
+			if err := fieldtype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.state": %w`, err)}
+			}
+		}
+	}
+	if f.StateIn != nil {
+		preds = append(preds, fieldtype.StateIn(f.StateIn...))
+	}
+	if f.StateNotIn != nil {
+		for _, v := range f.StateNotIn {
+			if err := fieldtype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.state": %w`, err)}
+			}
+		}
+	}
+	if f.StateNotIn != nil {
+		preds = append(preds, fieldtype.StateNotIn(f.StateNotIn...))
+	}
+	if f.StateIsNil {
+		preds = append(preds, fieldtype.StateIsNil())
+	}
+	if f.StateNotNil {
+		preds = append(preds, fieldtype.StateNotNil())
+	}
+	if f.OptionalFloat != nil {
+		preds = append(preds, fieldtype.OptionalFloatEQ(*f.OptionalFloat))
+	}
+	if f.OptionalFloatNEQ != nil {
+		preds = append(preds, fieldtype.OptionalFloatNEQ(*f.OptionalFloatNEQ))
+	}
+	if f.OptionalFloatIn != nil {
+		preds = append(preds, fieldtype.OptionalFloatIn(f.OptionalFloatIn...))
+	}
+	if f.OptionalFloatNotIn != nil {
+		preds = append(preds, fieldtype.OptionalFloatNotIn(f.OptionalFloatNotIn...))
+	}
+	if f.OptionalFloatGT != nil {
+		preds = append(preds, fieldtype.OptionalFloatGT(*f.OptionalFloatGT))
+	}
+	if f.OptionalFloatGTE != nil {
+		preds = append(preds, fieldtype.OptionalFloatGTE(*f.OptionalFloatGTE))
+	}
+	if f.OptionalFloatLT != nil {
+		preds = append(preds, fieldtype.OptionalFloatLT(*f.OptionalFloatLT))
+	}
+	if f.OptionalFloatLTE != nil {
+		preds = append(preds, fieldtype.OptionalFloatLTE(*f.OptionalFloatLTE))
+	}
+	if f.OptionalFloatIsNil {
+		preds = append(preds, fieldtype.OptionalFloatIsNil())
+	}
+	if f.OptionalFloatNotNil {
+		preds = append(preds, fieldtype.OptionalFloatNotNil())
+	}
+	if f.OptionalFloat32 != nil {
+		preds = append(preds, fieldtype.OptionalFloat32EQ(*f.OptionalFloat32))
+	}
+	if f.OptionalFloat32NEQ != nil {
+		preds = append(preds, fieldtype.OptionalFloat32NEQ(*f.OptionalFloat32NEQ))
+	}
+	if f.OptionalFloat32In != nil {
+		preds = append(preds, fieldtype.OptionalFloat32In(f.OptionalFloat32In...))
+	}
+	if f.OptionalFloat32NotIn != nil {
+		preds = append(preds, fieldtype.OptionalFloat32NotIn(f.OptionalFloat32NotIn...))
+	}
+	if f.OptionalFloat32GT != nil {
+		preds = append(preds, fieldtype.OptionalFloat32GT(*f.OptionalFloat32GT))
+	}
+	if f.OptionalFloat32GTE != nil {
+		preds = append(preds, fieldtype.OptionalFloat32GTE(*f.OptionalFloat32GTE))
+	}
+	if f.OptionalFloat32LT != nil {
+		preds = append(preds, fieldtype.OptionalFloat32LT(*f.OptionalFloat32LT))
+	}
+	if f.OptionalFloat32LTE != nil {
+		preds = append(preds, fieldtype.OptionalFloat32LTE(*f.OptionalFloat32LTE))
+	}
+	if f.OptionalFloat32IsNil {
+		preds = append(preds, fieldtype.OptionalFloat32IsNil())
+	}
+	if f.OptionalFloat32NotNil {
+		preds = append(preds, fieldtype.OptionalFloat32NotNil())
+	}
+	if f.Text != nil {
+		preds = append(preds, fieldtype.TextEQ(*f.Text))
+	}
+	if f.TextNEQ != nil {
+		preds = append(preds, fieldtype.TextNEQ(*f.TextNEQ))
+	}
+	if f.TextIn != nil {
+		preds = append(preds, fieldtype.TextIn(f.TextIn...))
+	}
+	if f.TextNotIn != nil {
+		preds = append(preds, fieldtype.TextNotIn(f.TextNotIn...))
+	}
+	if f.TextGT != nil {
+		preds = append(preds, fieldtype.TextGT(*f.TextGT))
+	}
+	if f.TextGTE != nil {
+		preds = append(preds, fieldtype.TextGTE(*f.TextGTE))
+	}
+	if f.TextLT != nil {
+		preds = append(preds, fieldtype.TextLT(*f.TextLT))
+	}
+	if f.TextLTE != nil {
+		preds = append(preds, fieldtype.TextLTE(*f.TextLTE))
+	}
+	if f.TextContains != nil {
+		preds = append(preds, fieldtype.TextContains(*f.TextContains))
+	}
+	if f.TextHasPrefix != nil {
+		preds = append(preds, fieldtype.TextHasPrefix(*f.TextHasPrefix))
+	}
+	if f.TextHasSuffix != nil {
+		preds = append(preds, fieldtype.TextHasSuffix(*f.TextHasSuffix))
+	}
+	if f.TextIsNil {
+		preds = append(preds, fieldtype.TextIsNil())
+	}
+	if f.TextNotNil {
+		preds = append(preds, fieldtype.TextNotNil())
+	}
+	if f.TextEqualFold != nil {
+		preds = append(preds, fieldtype.TextEqualFold(*f.TextEqualFold))
+	}
+	if f.TextContainsFold != nil {
+		preds = append(preds, fieldtype.TextContainsFold(*f.TextContainsFold))
+	}
+	if f.TextHasPrefixFold != nil {
+		preds = append(preds, fieldtype.TextHasPrefixFold(*f.TextHasPrefixFold))
+	}
+	if f.Datetime != nil {
+		preds = append(preds, fieldtype.DatetimeEQ(*f.Datetime))
+	}
+	if f.DatetimeNEQ != nil {
+		preds = append(preds, fieldtype.DatetimeNEQ(*f.DatetimeNEQ))
+	}
+	if f.DatetimeIn != nil {
+		preds = append(preds, fieldtype.DatetimeIn(f.DatetimeIn...))
+	}
+	if f.DatetimeNotIn != nil {
+		preds = append(preds, fieldtype.DatetimeNotIn(f.DatetimeNotIn...))
+	}
+	if f.DatetimeGT != nil {
+		preds = append(preds, fieldtype.DatetimeGT(*f.DatetimeGT))
+	}
+	if f.DatetimeGTE != nil {
+		preds = append(preds, fieldtype.DatetimeGTE(*f.DatetimeGTE))
+	}
+	if f.DatetimeLT != nil {
+		preds = append(preds, fieldtype.DatetimeLT(*f.DatetimeLT))
+	}
+	if f.DatetimeLTE != nil {
+		preds = append(preds, fieldtype.DatetimeLTE(*f.DatetimeLTE))
+	}
+	if f.DatetimeIsNil {
+		preds = append(preds, fieldtype.DatetimeIsNil())
+	}
+	if f.DatetimeNotNil {
+		preds = append(preds, fieldtype.DatetimeNotNil())
+	}
+	if f.Decimal != nil {
+		preds = append(preds, fieldtype.DecimalEQ(*f.Decimal))
+	}
+	if f.DecimalNEQ != nil {
+		preds = append(preds, fieldtype.DecimalNEQ(*f.DecimalNEQ))
+	}
+	if f.DecimalIn != nil {
+		preds = append(preds, fieldtype.DecimalIn(f.DecimalIn...))
+	}
+	if f.DecimalNotIn != nil {
+		preds = append(preds, fieldtype.DecimalNotIn(f.DecimalNotIn...))
+	}
+	if f.DecimalGT != nil {
+		preds = append(preds, fieldtype.DecimalGT(*f.DecimalGT))
+	}
+	if f.DecimalGTE != nil {
+		preds = append(preds, fieldtype.DecimalGTE(*f.DecimalGTE))
+	}
+	if f.DecimalLT != nil {
+		preds = append(preds, fieldtype.DecimalLT(*f.DecimalLT))
+	}
+	if f.DecimalLTE != nil {
+		preds = append(preds, fieldtype.DecimalLTE(*f.DecimalLTE))
+	}
+	if f.DecimalIsNil {
+		preds = append(preds, fieldtype.DecimalIsNil())
+	}
+	if f.DecimalNotNil {
+		preds = append(preds, fieldtype.DecimalNotNil())
+	}
+	if f.LinkOther != nil {
+		preds = append(preds, fieldtype.LinkOtherEQ(*f.LinkOther))
+	}
+	if f.LinkOtherNEQ != nil {
+		preds = append(preds, fieldtype.LinkOtherNEQ(*f.LinkOtherNEQ))
+	}
+	if f.LinkOtherIn != nil {
+		preds = append(preds, fieldtype.LinkOtherIn(f.LinkOtherIn...))
+	}
+	if f.LinkOtherNotIn != nil {
+		preds = append(preds, fieldtype.LinkOtherNotIn(f.LinkOtherNotIn...))
+	}
+	if f.LinkOtherGT != nil {
+		preds = append(preds, fieldtype.LinkOtherGT(*f.LinkOtherGT))
+	}
+	if f.LinkOtherGTE != nil {
+		preds = append(preds, fieldtype.LinkOtherGTE(*f.LinkOtherGTE))
+	}
+	if f.LinkOtherLT != nil {
+		preds = append(preds, fieldtype.LinkOtherLT(*f.LinkOtherLT))
+	}
+	if f.LinkOtherLTE != nil {
+		preds = append(preds, fieldtype.LinkOtherLTE(*f.LinkOtherLTE))
+	}
+	if f.LinkOtherIsNil {
+		preds = append(preds, fieldtype.LinkOtherIsNil())
+	}
+	if f.LinkOtherNotNil {
+		preds = append(preds, fieldtype.LinkOtherNotNil())
+	}
+	if f.LinkOtherFunc != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncEQ(*f.LinkOtherFunc))
+	}
+	if f.LinkOtherFuncNEQ != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncNEQ(*f.LinkOtherFuncNEQ))
+	}
+	if f.LinkOtherFuncIn != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncIn(f.LinkOtherFuncIn...))
+	}
+	if f.LinkOtherFuncNotIn != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncNotIn(f.LinkOtherFuncNotIn...))
+	}
+	if f.LinkOtherFuncGT != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncGT(*f.LinkOtherFuncGT))
+	}
+	if f.LinkOtherFuncGTE != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncGTE(*f.LinkOtherFuncGTE))
+	}
+	if f.LinkOtherFuncLT != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncLT(*f.LinkOtherFuncLT))
+	}
+	if f.LinkOtherFuncLTE != nil {
+		preds = append(preds, fieldtype.LinkOtherFuncLTE(*f.LinkOtherFuncLTE))
+	}
+	if f.LinkOtherFuncIsNil {
+		preds = append(preds, fieldtype.LinkOtherFuncIsNil())
+	}
+	if f.LinkOtherFuncNotNil {
+		preds = append(preds, fieldtype.LinkOtherFuncNotNil())
+	}
+	if f.MAC != nil {
+		preds = append(preds, fieldtype.MACEQ(*f.MAC))
+	}
+	if f.MACNEQ != nil {
+		preds = append(preds, fieldtype.MACNEQ(*f.MACNEQ))
+	}
+	if f.MACIn != nil {
+		preds = append(preds, fieldtype.MACIn(f.MACIn...))
+	}
+	if f.MACNotIn != nil {
+		preds = append(preds, fieldtype.MACNotIn(f.MACNotIn...))
+	}
+	if f.MACGT != nil {
+		preds = append(preds, fieldtype.MACGT(*f.MACGT))
+	}
+	if f.MACGTE != nil {
+		preds = append(preds, fieldtype.MACGTE(*f.MACGTE))
+	}
+	if f.MACLT != nil {
+		preds = append(preds, fieldtype.MACLT(*f.MACLT))
+	}
+	if f.MACLTE != nil {
+		preds = append(preds, fieldtype.MACLTE(*f.MACLTE))
+	}
+	if f.MACContains != nil {
+		preds = append(preds, fieldtype.MACContains(*f.MACContains))
+	}
+	if f.MACHasPrefix != nil {
+		preds = append(preds, fieldtype.MACHasPrefix(*f.MACHasPrefix))
+	}
+	if f.MACHasSuffix != nil {
+		preds = append(preds, fieldtype.MACHasSuffix(*f.MACHasSuffix))
+	}
+	if f.MACIsNil {
+		preds = append(preds, fieldtype.MACIsNil())
+	}
+	if f.MACNotNil {
+		preds = append(preds, fieldtype.MACNotNil())
+	}
+	if f.MACEqualFold != nil {
+		preds = append(preds, fieldtype.MACEqualFold(*f.MACEqualFold))
+	}
+	if f.MACContainsFold != nil {
+		preds = append(preds, fieldtype.MACContainsFold(*f.MACContainsFold))
+	}
+	if f.MACHasPrefixFold != nil {
+		preds = append(preds, fieldtype.MACHasPrefixFold(*f.MACHasPrefixFold))
+	}
+	if f.StringArray != nil {
+		preds = append(preds, fieldtype.StringArrayEQ(*f.StringArray))
+	}
+	if f.StringArrayNEQ != nil {
+		preds = append(preds, fieldtype.StringArrayNEQ(*f.StringArrayNEQ))
+	}
+	if f.StringArrayIn != nil {
+		preds = append(preds, fieldtype.StringArrayIn(f.StringArrayIn...))
+	}
+	if f.StringArrayNotIn != nil {
+		preds = append(preds, fieldtype.StringArrayNotIn(f.StringArrayNotIn...))
+	}
+	if f.StringArrayGT != nil {
+		preds = append(preds, fieldtype.StringArrayGT(*f.StringArrayGT))
+	}
+	if f.StringArrayGTE != nil {
+		preds = append(preds, fieldtype.StringArrayGTE(*f.StringArrayGTE))
+	}
+	if f.StringArrayLT != nil {
+		preds = append(preds, fieldtype.StringArrayLT(*f.StringArrayLT))
+	}
+	if f.StringArrayLTE != nil {
+		preds = append(preds, fieldtype.StringArrayLTE(*f.StringArrayLTE))
+	}
+	if f.StringArrayIsNil {
+		preds = append(preds, fieldtype.StringArrayIsNil())
+	}
+	if f.StringArrayNotNil {
+		preds = append(preds, fieldtype.StringArrayNotNil())
+	}
+	if f.StringScanner != nil {
+		preds = append(preds, fieldtype.StringScannerEQ(*f.StringScanner))
+	}
+	if f.StringScannerNEQ != nil {
+		preds = append(preds, fieldtype.StringScannerNEQ(*f.StringScannerNEQ))
+	}
+	if f.StringScannerIn != nil {
+		preds = append(preds, fieldtype.StringScannerIn(f.StringScannerIn...))
+	}
+	if f.StringScannerNotIn != nil {
+		preds = append(preds, fieldtype.StringScannerNotIn(f.StringScannerNotIn...))
+	}
+	if f.StringScannerGT != nil {
+		preds = append(preds, fieldtype.StringScannerGT(*f.StringScannerGT))
+	}
+	if f.StringScannerGTE != nil {
+		preds = append(preds, fieldtype.StringScannerGTE(*f.StringScannerGTE))
+	}
+	if f.StringScannerLT != nil {
+		preds = append(preds, fieldtype.StringScannerLT(*f.StringScannerLT))
+	}
+	if f.StringScannerLTE != nil {
+		preds = append(preds, fieldtype.StringScannerLTE(*f.StringScannerLTE))
+	}
+	if f.StringScannerContains != nil {
+		preds = append(preds, fieldtype.StringScannerContains(*f.StringScannerContains))
+	}
+	if f.StringScannerHasPrefix != nil {
+		preds = append(preds, fieldtype.StringScannerHasPrefix(*f.StringScannerHasPrefix))
+	}
+	if f.StringScannerHasSuffix != nil {
+		preds = append(preds, fieldtype.StringScannerHasSuffix(*f.StringScannerHasSuffix))
+	}
+	if f.StringScannerIsNil {
+		preds = append(preds, fieldtype.StringScannerIsNil())
+	}
+	if f.StringScannerNotNil {
+		preds = append(preds, fieldtype.StringScannerNotNil())
+	}
+	if f.StringScannerEqualFold != nil {
+		preds = append(preds, fieldtype.StringScannerEqualFold(*f.StringScannerEqualFold))
+	}
+	if f.StringScannerContainsFold != nil {
+		preds = append(preds, fieldtype.StringScannerContainsFold(*f.StringScannerContainsFold))
+	}
+	if f.StringScannerHasPrefixFold != nil {
+		preds = append(preds, fieldtype.StringScannerHasPrefixFold(*f.StringScannerHasPrefixFold))
+	}
+	if f.Duration != nil {
+		preds = append(preds, fieldtype.DurationEQ(*f.Duration))
+	}
+	if f.DurationNEQ != nil {
+		preds = append(preds, fieldtype.DurationNEQ(*f.DurationNEQ))
+	}
+	if f.DurationIn != nil {
+		preds = append(preds, fieldtype.DurationIn(f.DurationIn...))
+	}
+	if f.DurationNotIn != nil {
+		preds = append(preds, fieldtype.DurationNotIn(f.DurationNotIn...))
+	}
+	if f.DurationGT != nil {
+		preds = append(preds, fieldtype.DurationGT(*f.DurationGT))
+	}
+	if f.DurationGTE != nil {
+		preds = append(preds, fieldtype.DurationGTE(*f.DurationGTE))
+	}
+	if f.DurationLT != nil {
+		preds = append(preds, fieldtype.DurationLT(*f.DurationLT))
+	}
+	if f.DurationLTE != nil {
+		preds = append(preds, fieldtype.DurationLTE(*f.DurationLTE))
+	}
+	if f.DurationIsNil {
+		preds = append(preds, fieldtype.DurationIsNil())
+	}
+	if f.DurationNotNil {
+		preds = append(preds, fieldtype.DurationNotNil())
+	}
+	if f.Dir != nil {
+		preds = append(preds, fieldtype.DirEQ(*f.Dir))
+	}
+	if f.DirNEQ != nil {
+		preds = append(preds, fieldtype.DirNEQ(*f.DirNEQ))
+	}
+	if f.DirIn != nil {
+		preds = append(preds, fieldtype.DirIn(f.DirIn...))
+	}
+	if f.DirNotIn != nil {
+		preds = append(preds, fieldtype.DirNotIn(f.DirNotIn...))
+	}
+	if f.DirGT != nil {
+		preds = append(preds, fieldtype.DirGT(*f.DirGT))
+	}
+	if f.DirGTE != nil {
+		preds = append(preds, fieldtype.DirGTE(*f.DirGTE))
+	}
+	if f.DirLT != nil {
+		preds = append(preds, fieldtype.DirLT(*f.DirLT))
+	}
+	if f.DirLTE != nil {
+		preds = append(preds, fieldtype.DirLTE(*f.DirLTE))
+	}
+	if f.DirContains != nil {
+		preds = append(preds, fieldtype.DirContains(*f.DirContains))
+	}
+	if f.DirHasPrefix != nil {
+		preds = append(preds, fieldtype.DirHasPrefix(*f.DirHasPrefix))
+	}
+	if f.DirHasSuffix != nil {
+		preds = append(preds, fieldtype.DirHasSuffix(*f.DirHasSuffix))
+	}
+	if f.DirEqualFold != nil {
+		preds = append(preds, fieldtype.DirEqualFold(*f.DirEqualFold))
+	}
+	if f.DirContainsFold != nil {
+		preds = append(preds, fieldtype.DirContainsFold(*f.DirContainsFold))
+	}
+	if f.DirHasPrefixFold != nil {
+		preds = append(preds, fieldtype.DirHasPrefixFold(*f.DirHasPrefixFold))
+	}
+	if f.Ndir != nil {
+		preds = append(preds, fieldtype.NdirEQ(*f.Ndir))
+	}
+	if f.NdirNEQ != nil {
+		preds = append(preds, fieldtype.NdirNEQ(*f.NdirNEQ))
+	}
+	if f.NdirIn != nil {
+		preds = append(preds, fieldtype.NdirIn(f.NdirIn...))
+	}
+	if f.NdirNotIn != nil {
+		preds = append(preds, fieldtype.NdirNotIn(f.NdirNotIn...))
+	}
+	if f.NdirGT != nil {
+		preds = append(preds, fieldtype.NdirGT(*f.NdirGT))
+	}
+	if f.NdirGTE != nil {
+		preds = append(preds, fieldtype.NdirGTE(*f.NdirGTE))
+	}
+	if f.NdirLT != nil {
+		preds = append(preds, fieldtype.NdirLT(*f.NdirLT))
+	}
+	if f.NdirLTE != nil {
+		preds = append(preds, fieldtype.NdirLTE(*f.NdirLTE))
+	}
+	if f.NdirContains != nil {
+		preds = append(preds, fieldtype.NdirContains(*f.NdirContains))
+	}
+	if f.NdirHasPrefix != nil {
+		preds = append(preds, fieldtype.NdirHasPrefix(*f.NdirHasPrefix))
+	}
+	if f.NdirHasSuffix != nil {
+		preds = append(preds, fieldtype.NdirHasSuffix(*f.NdirHasSuffix))
+	}
+	if f.NdirIsNil {
+		preds = append(preds, fieldtype.NdirIsNil())
+	}
+	if f.NdirNotNil {
+		preds = append(preds, fieldtype.NdirNotNil())
+	}
+	if f.NdirEqualFold != nil {
+		preds = append(preds, fieldtype.NdirEqualFold(*f.NdirEqualFold))
+	}
+	if f.NdirContainsFold != nil {
+		preds = append(preds, fieldtype.NdirContainsFold(*f.NdirContainsFold))
+	}
+	if f.NdirHasPrefixFold != nil {
+		preds = append(preds, fieldtype.NdirHasPrefixFold(*f.NdirHasPrefixFold))
+	}
+	if f.Str != nil {
+		preds = append(preds, fieldtype.StrEQ(*f.Str))
+	}
+	if f.StrNEQ != nil {
+		preds = append(preds, fieldtype.StrNEQ(*f.StrNEQ))
+	}
+	if f.StrIn != nil {
+		preds = append(preds, fieldtype.StrIn(f.StrIn...))
+	}
+	if f.StrNotIn != nil {
+		preds = append(preds, fieldtype.StrNotIn(f.StrNotIn...))
+	}
+	if f.StrGT != nil {
+		preds = append(preds, fieldtype.StrGT(*f.StrGT))
+	}
+	if f.StrGTE != nil {
+		preds = append(preds, fieldtype.StrGTE(*f.StrGTE))
+	}
+	if f.StrLT != nil {
+		preds = append(preds, fieldtype.StrLT(*f.StrLT))
+	}
+	if f.StrLTE != nil {
+		preds = append(preds, fieldtype.StrLTE(*f.StrLTE))
+	}
+	if f.StrContains != nil {
+		preds = append(preds, fieldtype.StrContains(*f.StrContains))
+	}
+	if f.StrHasPrefix != nil {
+		preds = append(preds, fieldtype.StrHasPrefix(*f.StrHasPrefix))
+	}
+	if f.StrHasSuffix != nil {
+		preds = append(preds, fieldtype.StrHasSuffix(*f.StrHasSuffix))
+	}
+	if f.StrIsNil {
+		preds = append(preds, fieldtype.StrIsNil())
+	}
+	if f.StrNotNil {
+		preds = append(preds, fieldtype.StrNotNil())
+	}
+	if f.StrEqualFold != nil {
+		preds = append(preds, fieldtype.StrEqualFold(*f.StrEqualFold))
+	}
+	if f.StrContainsFold != nil {
+		preds = append(preds, fieldtype.StrContainsFold(*f.StrContainsFold))
+	}
+	if f.StrHasPrefixFold != nil {
+		preds = append(preds, fieldtype.StrHasPrefixFold(*f.StrHasPrefixFold))
+	}
+	if f.NullStr != nil {
+		preds = append(preds, fieldtype.NullStrEQ(*f.NullStr))
+	}
+	if f.NullStrNEQ != nil {
+		preds = append(preds, fieldtype.NullStrNEQ(*f.NullStrNEQ))
+	}
+	if f.NullStrIn != nil {
+		preds = append(preds, fieldtype.NullStrIn(f.NullStrIn...))
+	}
+	if f.NullStrNotIn != nil {
+		preds = append(preds, fieldtype.NullStrNotIn(f.NullStrNotIn...))
+	}
+	if f.NullStrGT != nil {
+		preds = append(preds, fieldtype.NullStrGT(*f.NullStrGT))
+	}
+	if f.NullStrGTE != nil {
+		preds = append(preds, fieldtype.NullStrGTE(*f.NullStrGTE))
+	}
+	if f.NullStrLT != nil {
+		preds = append(preds, fieldtype.NullStrLT(*f.NullStrLT))
+	}
+	if f.NullStrLTE != nil {
+		preds = append(preds, fieldtype.NullStrLTE(*f.NullStrLTE))
+	}
+	if f.NullStrContains != nil {
+		preds = append(preds, fieldtype.NullStrContains(*f.NullStrContains))
+	}
+	if f.NullStrHasPrefix != nil {
+		preds = append(preds, fieldtype.NullStrHasPrefix(*f.NullStrHasPrefix))
+	}
+	if f.NullStrHasSuffix != nil {
+		preds = append(preds, fieldtype.NullStrHasSuffix(*f.NullStrHasSuffix))
+	}
+	if f.NullStrIsNil {
+		preds = append(preds, fieldtype.NullStrIsNil())
+	}
+	if f.NullStrNotNil {
+		preds = append(preds, fieldtype.NullStrNotNil())
+	}
+	if f.NullStrEqualFold != nil {
+		preds = append(preds, fieldtype.NullStrEqualFold(*f.NullStrEqualFold))
+	}
+	if f.NullStrContainsFold != nil {
+		preds = append(preds, fieldtype.NullStrContainsFold(*f.NullStrContainsFold))
+	}
+	if f.NullStrHasPrefixFold != nil {
+		preds = append(preds, fieldtype.NullStrHasPrefixFold(*f.NullStrHasPrefixFold))
+	}
+	if f.Link != nil {
+		preds = append(preds, fieldtype.LinkEQ(*f.Link))
+	}
+	if f.LinkNEQ != nil {
+		preds = append(preds, fieldtype.LinkNEQ(*f.LinkNEQ))
+	}
+	if f.LinkIn != nil {
+		preds = append(preds, fieldtype.LinkIn(f.LinkIn...))
+	}
+	if f.LinkNotIn != nil {
+		preds = append(preds, fieldtype.LinkNotIn(f.LinkNotIn...))
+	}
+	if f.LinkGT != nil {
+		preds = append(preds, fieldtype.LinkGT(*f.LinkGT))
+	}
+	if f.LinkGTE != nil {
+		preds = append(preds, fieldtype.LinkGTE(*f.LinkGTE))
+	}
+	if f.LinkLT != nil {
+		preds = append(preds, fieldtype.LinkLT(*f.LinkLT))
+	}
+	if f.LinkLTE != nil {
+		preds = append(preds, fieldtype.LinkLTE(*f.LinkLTE))
+	}
+	if f.LinkContains != nil {
+		preds = append(preds, fieldtype.LinkContains(*f.LinkContains))
+	}
+	if f.LinkHasPrefix != nil {
+		preds = append(preds, fieldtype.LinkHasPrefix(*f.LinkHasPrefix))
+	}
+	if f.LinkHasSuffix != nil {
+		preds = append(preds, fieldtype.LinkHasSuffix(*f.LinkHasSuffix))
+	}
+	if f.LinkIsNil {
+		preds = append(preds, fieldtype.LinkIsNil())
+	}
+	if f.LinkNotNil {
+		preds = append(preds, fieldtype.LinkNotNil())
+	}
+	if f.LinkEqualFold != nil {
+		preds = append(preds, fieldtype.LinkEqualFold(*f.LinkEqualFold))
+	}
+	if f.LinkContainsFold != nil {
+		preds = append(preds, fieldtype.LinkContainsFold(*f.LinkContainsFold))
+	}
+	if f.LinkHasPrefixFold != nil {
+		preds = append(preds, fieldtype.LinkHasPrefixFold(*f.LinkHasPrefixFold))
+	}
+	if f.NullLink != nil {
+		preds = append(preds, fieldtype.NullLinkEQ(*f.NullLink))
+	}
+	if f.NullLinkNEQ != nil {
+		preds = append(preds, fieldtype.NullLinkNEQ(*f.NullLinkNEQ))
+	}
+	if f.NullLinkIn != nil {
+		preds = append(preds, fieldtype.NullLinkIn(f.NullLinkIn...))
+	}
+	if f.NullLinkNotIn != nil {
+		preds = append(preds, fieldtype.NullLinkNotIn(f.NullLinkNotIn...))
+	}
+	if f.NullLinkGT != nil {
+		preds = append(preds, fieldtype.NullLinkGT(*f.NullLinkGT))
+	}
+	if f.NullLinkGTE != nil {
+		preds = append(preds, fieldtype.NullLinkGTE(*f.NullLinkGTE))
+	}
+	if f.NullLinkLT != nil {
+		preds = append(preds, fieldtype.NullLinkLT(*f.NullLinkLT))
+	}
+	if f.NullLinkLTE != nil {
+		preds = append(preds, fieldtype.NullLinkLTE(*f.NullLinkLTE))
+	}
+	if f.NullLinkContains != nil {
+		preds = append(preds, fieldtype.NullLinkContains(*f.NullLinkContains))
+	}
+	if f.NullLinkHasPrefix != nil {
+		preds = append(preds, fieldtype.NullLinkHasPrefix(*f.NullLinkHasPrefix))
+	}
+	if f.NullLinkHasSuffix != nil {
+		preds = append(preds, fieldtype.NullLinkHasSuffix(*f.NullLinkHasSuffix))
+	}
+	if f.NullLinkIsNil {
+		preds = append(preds, fieldtype.NullLinkIsNil())
+	}
+	if f.NullLinkNotNil {
+		preds = append(preds, fieldtype.NullLinkNotNil())
+	}
+	if f.NullLinkEqualFold != nil {
+		preds = append(preds, fieldtype.NullLinkEqualFold(*f.NullLinkEqualFold))
+	}
+	if f.NullLinkContainsFold != nil {
+		preds = append(preds, fieldtype.NullLinkContainsFold(*f.NullLinkContainsFold))
+	}
+	if f.NullLinkHasPrefixFold != nil {
+		preds = append(preds, fieldtype.NullLinkHasPrefixFold(*f.NullLinkHasPrefixFold))
+	}
+	if f.Active != nil {
+		preds = append(preds, fieldtype.ActiveEQ(*f.Active))
+	}
+	if f.ActiveNEQ != nil {
+		preds = append(preds, fieldtype.ActiveNEQ(*f.ActiveNEQ))
+	}
+	if f.ActiveIsNil {
+		preds = append(preds, fieldtype.ActiveIsNil())
+	}
+	if f.ActiveNotNil {
+		preds = append(preds, fieldtype.ActiveNotNil())
+	}
+	if f.NullActive != nil {
+		preds = append(preds, fieldtype.NullActiveEQ(*f.NullActive))
+	}
+	if f.NullActiveNEQ != nil {
+		preds = append(preds, fieldtype.NullActiveNEQ(*f.NullActiveNEQ))
+	}
+	if f.NullActiveIsNil {
+		preds = append(preds, fieldtype.NullActiveIsNil())
+	}
+	if f.NullActiveNotNil {
+		preds = append(preds, fieldtype.NullActiveNotNil())
+	}
+	if f.Deleted != nil {
+		preds = append(preds, fieldtype.DeletedEQ(*f.Deleted))
+	}
+	if f.DeletedNEQ != nil {
+		preds = append(preds, fieldtype.DeletedNEQ(*f.DeletedNEQ))
+	}
+	if f.DeletedIsNil {
+		preds = append(preds, fieldtype.DeletedIsNil())
+	}
+	if f.DeletedNotNil {
+		preds = append(preds, fieldtype.DeletedNotNil())
+	}
+	if f.DeletedAt != nil {
+		preds = append(preds, fieldtype.DeletedAtEQ(*f.DeletedAt))
+	}
+	if f.DeletedAtNEQ != nil {
+		preds = append(preds, fieldtype.DeletedAtNEQ(*f.DeletedAtNEQ))
+	}
+	if f.DeletedAtIn != nil {
+		preds = append(preds, fieldtype.DeletedAtIn(f.DeletedAtIn...))
+	}
+	if f.DeletedAtNotIn != nil {
+		preds = append(preds, fieldtype.DeletedAtNotIn(f.DeletedAtNotIn...))
+	}
+	if f.DeletedAtGT != nil {
+		preds = append(preds, fieldtype.DeletedAtGT(*f.DeletedAtGT))
+	}
+	if f.DeletedAtGTE != nil {
+		preds = append(preds, fieldtype.DeletedAtGTE(*f.DeletedAtGTE))
+	}
+	if f.DeletedAtLT != nil {
+		preds = append(preds, fieldtype.DeletedAtLT(*f.DeletedAtLT))
+	}
+	if f.DeletedAtLTE != nil {
+		preds = append(preds, fieldtype.DeletedAtLTE(*f.DeletedAtLTE))
+	}
+	if f.DeletedAtIsNil {
+		preds = append(preds, fieldtype.DeletedAtIsNil())
+	}
+	if f.DeletedAtNotNil {
+		preds = append(preds, fieldtype.DeletedAtNotNil())
+	}
+	if f.RawData != nil {
+		preds = append(preds, fieldtype.RawDataEQ(*f.RawData))
+	}
+	if f.RawDataNEQ != nil {
+		preds = append(preds, fieldtype.RawDataNEQ(*f.RawDataNEQ))
+	}
+	if f.RawDataIn != nil {
+		preds = append(preds, fieldtype.RawDataIn(f.RawDataIn...))
+	}
+	if f.RawDataNotIn != nil {
+		preds = append(preds, fieldtype.RawDataNotIn(f.RawDataNotIn...))
+	}
+	if f.RawDataGT != nil {
+		preds = append(preds, fieldtype.RawDataGT(*f.RawDataGT))
+	}
+	if f.RawDataGTE != nil {
+		preds = append(preds, fieldtype.RawDataGTE(*f.RawDataGTE))
+	}
+	if f.RawDataLT != nil {
+		preds = append(preds, fieldtype.RawDataLT(*f.RawDataLT))
+	}
+	if f.RawDataLTE != nil {
+		preds = append(preds, fieldtype.RawDataLTE(*f.RawDataLTE))
+	}
+	if f.RawDataIsNil {
+		preds = append(preds, fieldtype.RawDataIsNil())
+	}
+	if f.RawDataNotNil {
+		preds = append(preds, fieldtype.RawDataNotNil())
+	}
+	if f.IP != nil {
+		preds = append(preds, fieldtype.IPEQ(*f.IP))
+	}
+	if f.IPNEQ != nil {
+		preds = append(preds, fieldtype.IPNEQ(*f.IPNEQ))
+	}
+	if f.IPIn != nil {
+		preds = append(preds, fieldtype.IPIn(f.IPIn...))
+	}
+	if f.IPNotIn != nil {
+		preds = append(preds, fieldtype.IPNotIn(f.IPNotIn...))
+	}
+	if f.IPGT != nil {
+		preds = append(preds, fieldtype.IPGT(*f.IPGT))
+	}
+	if f.IPGTE != nil {
+		preds = append(preds, fieldtype.IPGTE(*f.IPGTE))
+	}
+	if f.IPLT != nil {
+		preds = append(preds, fieldtype.IPLT(*f.IPLT))
+	}
+	if f.IPLTE != nil {
+		preds = append(preds, fieldtype.IPLTE(*f.IPLTE))
+	}
+	if f.IPIsNil {
+		preds = append(preds, fieldtype.IPIsNil())
+	}
+	if f.IPNotNil {
+		preds = append(preds, fieldtype.IPNotNil())
+	}
+	if f.NullInt64 != nil {
+		preds = append(preds, fieldtype.NullInt64EQ(*f.NullInt64))
+	}
+	if f.NullInt64NEQ != nil {
+		preds = append(preds, fieldtype.NullInt64NEQ(*f.NullInt64NEQ))
+	}
+	if f.NullInt64In != nil {
+		preds = append(preds, fieldtype.NullInt64In(f.NullInt64In...))
+	}
+	if f.NullInt64NotIn != nil {
+		preds = append(preds, fieldtype.NullInt64NotIn(f.NullInt64NotIn...))
+	}
+	if f.NullInt64GT != nil {
+		preds = append(preds, fieldtype.NullInt64GT(*f.NullInt64GT))
+	}
+	if f.NullInt64GTE != nil {
+		preds = append(preds, fieldtype.NullInt64GTE(*f.NullInt64GTE))
+	}
+	if f.NullInt64LT != nil {
+		preds = append(preds, fieldtype.NullInt64LT(*f.NullInt64LT))
+	}
+	if f.NullInt64LTE != nil {
+		preds = append(preds, fieldtype.NullInt64LTE(*f.NullInt64LTE))
+	}
+	if f.NullInt64IsNil {
+		preds = append(preds, fieldtype.NullInt64IsNil())
+	}
+	if f.NullInt64NotNil {
+		preds = append(preds, fieldtype.NullInt64NotNil())
+	}
+	if f.SchemaInt != nil {
+		preds = append(preds, fieldtype.SchemaIntEQ(*f.SchemaInt))
+	}
+	if f.SchemaIntNEQ != nil {
+		preds = append(preds, fieldtype.SchemaIntNEQ(*f.SchemaIntNEQ))
+	}
+	if f.SchemaIntIn != nil {
+		preds = append(preds, fieldtype.SchemaIntIn(f.SchemaIntIn...))
+	}
+	if f.SchemaIntNotIn != nil {
+		preds = append(preds, fieldtype.SchemaIntNotIn(f.SchemaIntNotIn...))
+	}
+	if f.SchemaIntGT != nil {
+		preds = append(preds, fieldtype.SchemaIntGT(*f.SchemaIntGT))
+	}
+	if f.SchemaIntGTE != nil {
+		preds = append(preds, fieldtype.SchemaIntGTE(*f.SchemaIntGTE))
+	}
+	if f.SchemaIntLT != nil {
+		preds = append(preds, fieldtype.SchemaIntLT(*f.SchemaIntLT))
+	}
+	if f.SchemaIntLTE != nil {
+		preds = append(preds, fieldtype.SchemaIntLTE(*f.SchemaIntLTE))
+	}
+	if f.SchemaIntIsNil {
+		preds = append(preds, fieldtype.SchemaIntIsNil())
+	}
+	if f.SchemaIntNotNil {
+		preds = append(preds, fieldtype.SchemaIntNotNil())
+	}
+	if f.SchemaInt8 != nil {
+		preds = append(preds, fieldtype.SchemaInt8EQ(*f.SchemaInt8))
+	}
+	if f.SchemaInt8NEQ != nil {
+		preds = append(preds, fieldtype.SchemaInt8NEQ(*f.SchemaInt8NEQ))
+	}
+	if f.SchemaInt8In != nil {
+		preds = append(preds, fieldtype.SchemaInt8In(f.SchemaInt8In...))
+	}
+	if f.SchemaInt8NotIn != nil {
+		preds = append(preds, fieldtype.SchemaInt8NotIn(f.SchemaInt8NotIn...))
+	}
+	if f.SchemaInt8GT != nil {
+		preds = append(preds, fieldtype.SchemaInt8GT(*f.SchemaInt8GT))
+	}
+	if f.SchemaInt8GTE != nil {
+		preds = append(preds, fieldtype.SchemaInt8GTE(*f.SchemaInt8GTE))
+	}
+	if f.SchemaInt8LT != nil {
+		preds = append(preds, fieldtype.SchemaInt8LT(*f.SchemaInt8LT))
+	}
+	if f.SchemaInt8LTE != nil {
+		preds = append(preds, fieldtype.SchemaInt8LTE(*f.SchemaInt8LTE))
+	}
+	if f.SchemaInt8IsNil {
+		preds = append(preds, fieldtype.SchemaInt8IsNil())
+	}
+	if f.SchemaInt8NotNil {
+		preds = append(preds, fieldtype.SchemaInt8NotNil())
+	}
+	if f.SchemaInt64 != nil {
+		preds = append(preds, fieldtype.SchemaInt64EQ(*f.SchemaInt64))
+	}
+	if f.SchemaInt64NEQ != nil {
+		preds = append(preds, fieldtype.SchemaInt64NEQ(*f.SchemaInt64NEQ))
+	}
+	if f.SchemaInt64In != nil {
+		preds = append(preds, fieldtype.SchemaInt64In(f.SchemaInt64In...))
+	}
+	if f.SchemaInt64NotIn != nil {
+		preds = append(preds, fieldtype.SchemaInt64NotIn(f.SchemaInt64NotIn...))
+	}
+	if f.SchemaInt64GT != nil {
+		preds = append(preds, fieldtype.SchemaInt64GT(*f.SchemaInt64GT))
+	}
+	if f.SchemaInt64GTE != nil {
+		preds = append(preds, fieldtype.SchemaInt64GTE(*f.SchemaInt64GTE))
+	}
+	if f.SchemaInt64LT != nil {
+		preds = append(preds, fieldtype.SchemaInt64LT(*f.SchemaInt64LT))
+	}
+	if f.SchemaInt64LTE != nil {
+		preds = append(preds, fieldtype.SchemaInt64LTE(*f.SchemaInt64LTE))
+	}
+	if f.SchemaInt64IsNil {
+		preds = append(preds, fieldtype.SchemaInt64IsNil())
+	}
+	if f.SchemaInt64NotNil {
+		preds = append(preds, fieldtype.SchemaInt64NotNil())
+	}
+	if f.SchemaFloat != nil {
+		preds = append(preds, fieldtype.SchemaFloatEQ(*f.SchemaFloat))
+	}
+	if f.SchemaFloatNEQ != nil {
+		preds = append(preds, fieldtype.SchemaFloatNEQ(*f.SchemaFloatNEQ))
+	}
+	if f.SchemaFloatIn != nil {
+		preds = append(preds, fieldtype.SchemaFloatIn(f.SchemaFloatIn...))
+	}
+	if f.SchemaFloatNotIn != nil {
+		preds = append(preds, fieldtype.SchemaFloatNotIn(f.SchemaFloatNotIn...))
+	}
+	if f.SchemaFloatGT != nil {
+		preds = append(preds, fieldtype.SchemaFloatGT(*f.SchemaFloatGT))
+	}
+	if f.SchemaFloatGTE != nil {
+		preds = append(preds, fieldtype.SchemaFloatGTE(*f.SchemaFloatGTE))
+	}
+	if f.SchemaFloatLT != nil {
+		preds = append(preds, fieldtype.SchemaFloatLT(*f.SchemaFloatLT))
+	}
+	if f.SchemaFloatLTE != nil {
+		preds = append(preds, fieldtype.SchemaFloatLTE(*f.SchemaFloatLTE))
+	}
+	if f.SchemaFloatIsNil {
+		preds = append(preds, fieldtype.SchemaFloatIsNil())
+	}
+	if f.SchemaFloatNotNil {
+		preds = append(preds, fieldtype.SchemaFloatNotNil())
+	}
+	if f.SchemaFloat32 != nil {
+		preds = append(preds, fieldtype.SchemaFloat32EQ(*f.SchemaFloat32))
+	}
+	if f.SchemaFloat32NEQ != nil {
+		preds = append(preds, fieldtype.SchemaFloat32NEQ(*f.SchemaFloat32NEQ))
+	}
+	if f.SchemaFloat32In != nil {
+		preds = append(preds, fieldtype.SchemaFloat32In(f.SchemaFloat32In...))
+	}
+	if f.SchemaFloat32NotIn != nil {
+		preds = append(preds, fieldtype.SchemaFloat32NotIn(f.SchemaFloat32NotIn...))
+	}
+	if f.SchemaFloat32GT != nil {
+		preds = append(preds, fieldtype.SchemaFloat32GT(*f.SchemaFloat32GT))
+	}
+	if f.SchemaFloat32GTE != nil {
+		preds = append(preds, fieldtype.SchemaFloat32GTE(*f.SchemaFloat32GTE))
+	}
+	if f.SchemaFloat32LT != nil {
+		preds = append(preds, fieldtype.SchemaFloat32LT(*f.SchemaFloat32LT))
+	}
+	if f.SchemaFloat32LTE != nil {
+		preds = append(preds, fieldtype.SchemaFloat32LTE(*f.SchemaFloat32LTE))
+	}
+	if f.SchemaFloat32IsNil {
+		preds = append(preds, fieldtype.SchemaFloat32IsNil())
+	}
+	if f.SchemaFloat32NotNil {
+		preds = append(preds, fieldtype.SchemaFloat32NotNil())
+	}
+	if f.NullFloat != nil {
+		preds = append(preds, fieldtype.NullFloatEQ(*f.NullFloat))
+	}
+	if f.NullFloatNEQ != nil {
+		preds = append(preds, fieldtype.NullFloatNEQ(*f.NullFloatNEQ))
+	}
+	if f.NullFloatIn != nil {
+		preds = append(preds, fieldtype.NullFloatIn(f.NullFloatIn...))
+	}
+	if f.NullFloatNotIn != nil {
+		preds = append(preds, fieldtype.NullFloatNotIn(f.NullFloatNotIn...))
+	}
+	if f.NullFloatGT != nil {
+		preds = append(preds, fieldtype.NullFloatGT(*f.NullFloatGT))
+	}
+	if f.NullFloatGTE != nil {
+		preds = append(preds, fieldtype.NullFloatGTE(*f.NullFloatGTE))
+	}
+	if f.NullFloatLT != nil {
+		preds = append(preds, fieldtype.NullFloatLT(*f.NullFloatLT))
+	}
+	if f.NullFloatLTE != nil {
+		preds = append(preds, fieldtype.NullFloatLTE(*f.NullFloatLTE))
+	}
+	if f.NullFloatIsNil {
+		preds = append(preds, fieldtype.NullFloatIsNil())
+	}
+	if f.NullFloatNotNil {
+		preds = append(preds, fieldtype.NullFloatNotNil())
+	}
+	if f.Role != nil {
+		for _, v := range []role.Role{*f.Role} {
+			if err := fieldtype.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.role": %w`, err)}
+			}
+		}
+	}
+	if f.Role != nil {
+		preds = append(preds, fieldtype.RoleEQ(*f.Role))
+	}
+	if f.RoleNEQ != nil {
+		for _, v := range []role.Role{*f.RoleNEQ} {
+			if err := fieldtype.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.role": %w`, err)}
+			}
+		}
+	}
+	if f.RoleNEQ != nil {
+		preds = append(preds, fieldtype.RoleNEQ(*f.RoleNEQ))
+	}
+	if f.RoleIn != nil {
+		for _, v := range f.RoleIn {
+			if err := fieldtype.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.role": %w`, err)}
+			}
+		}
+	}
+	if f.RoleIn != nil {
+		preds = append(preds, fieldtype.RoleIn(f.RoleIn...))
+	}
+	if f.RoleNotIn != nil {
+		for _, v := range f.RoleNotIn {
+			if err := fieldtype.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.role": %w`, err)}
+			}
+		}
+	}
+	if f.RoleNotIn != nil {
+		preds = append(preds, fieldtype.RoleNotIn(f.RoleNotIn...))
+	}
+	if f.Priority != nil {
+		for _, v := range []role.Priority{*f.Priority} {
+			if err := fieldtype.PriorityValidator(v); err != nil {
+				return nil, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.priority": %w`, err)}
+			}
+		}
+	}
+	if f.Priority != nil {
+		preds = append(preds, fieldtype.PriorityEQ(*f.Priority))
+	}
+	if f.PriorityNEQ != nil {
+		for _, v := range []role.Priority{*f.PriorityNEQ} {
+			if err := fieldtype.PriorityValidator(v); err != nil {
+				return nil, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.priority": %w`, err)}
+			}
+		}
+	}
+	if f.PriorityNEQ != nil {
+		preds = append(preds, fieldtype.PriorityNEQ(*f.PriorityNEQ))
+	}
+	if f.PriorityIn != nil {
+		for _, v := range f.PriorityIn {
+			if err := fieldtype.PriorityValidator(v); err != nil {
+				return nil, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.priority": %w`, err)}
+			}
+		}
+	}
+	if f.PriorityIn != nil {
+		preds = append(preds, fieldtype.PriorityIn(f.PriorityIn...))
+	}
+	if f.PriorityNotIn != nil {
+		for _, v := range f.PriorityNotIn {
+			if err := fieldtype.PriorityValidator(v); err != nil {
+				return nil, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FieldType.priority": %w`, err)}
+			}
+		}
+	}
+	if f.PriorityNotIn != nil {
+		preds = append(preds, fieldtype.PriorityNotIn(f.PriorityNotIn...))
+	}
+	if f.PriorityIsNil {
+		preds = append(preds, fieldtype.PriorityIsNil())
+	}
+	if f.PriorityNotNil {
+		preds = append(preds, fieldtype.PriorityNotNil())
+	}
+	if f.OptionalUUID != nil {
+		preds = append(preds, fieldtype.OptionalUUIDEQ(*f.OptionalUUID))
+	}
+	if f.OptionalUUIDNEQ != nil {
+		preds = append(preds, fieldtype.OptionalUUIDNEQ(*f.OptionalUUIDNEQ))
+	}
+	if f.OptionalUUIDIn != nil {
+		preds = append(preds, fieldtype.OptionalUUIDIn(f.OptionalUUIDIn...))
+	}
+	if f.OptionalUUIDNotIn != nil {
+		preds = append(preds, fieldtype.OptionalUUIDNotIn(f.OptionalUUIDNotIn...))
+	}
+	if f.OptionalUUIDGT != nil {
+		preds = append(preds, fieldtype.OptionalUUIDGT(*f.OptionalUUIDGT))
+	}
+	if f.OptionalUUIDGTE != nil {
+		preds = append(preds, fieldtype.OptionalUUIDGTE(*f.OptionalUUIDGTE))
+	}
+	if f.OptionalUUIDLT != nil {
+		preds = append(preds, fieldtype.OptionalUUIDLT(*f.OptionalUUIDLT))
+	}
+	if f.OptionalUUIDLTE != nil {
+		preds = append(preds, fieldtype.OptionalUUIDLTE(*f.OptionalUUIDLTE))
+	}
+	if f.OptionalUUIDIsNil {
+		preds = append(preds, fieldtype.OptionalUUIDIsNil())
+	}
+	if f.OptionalUUIDNotNil {
+		preds = append(preds, fieldtype.OptionalUUIDNotNil())
+	}
+	if f.NillableUUID != nil {
+		preds = append(preds, fieldtype.NillableUUIDEQ(*f.NillableUUID))
+	}
+	if f.NillableUUIDNEQ != nil {
+		preds = append(preds, fieldtype.NillableUUIDNEQ(*f.NillableUUIDNEQ))
+	}
+	if f.NillableUUIDIn != nil {
+		preds = append(preds, fieldtype.NillableUUIDIn(f.NillableUUIDIn...))
+	}
+	if f.NillableUUIDNotIn != nil {
+		preds = append(preds, fieldtype.NillableUUIDNotIn(f.NillableUUIDNotIn...))
+	}
+	if f.NillableUUIDGT != nil {
+		preds = append(preds, fieldtype.NillableUUIDGT(*f.NillableUUIDGT))
+	}
+	if f.NillableUUIDGTE != nil {
+		preds = append(preds, fieldtype.NillableUUIDGTE(*f.NillableUUIDGTE))
+	}
+	if f.NillableUUIDLT != nil {
+		preds = append(preds, fieldtype.NillableUUIDLT(*f.NillableUUIDLT))
+	}
+	if f.NillableUUIDLTE != nil {
+		preds = append(preds, fieldtype.NillableUUIDLTE(*f.NillableUUIDLTE))
+	}
+	if f.NillableUUIDIsNil {
+		preds = append(preds, fieldtype.NillableUUIDIsNil())
+	}
+	if f.NillableUUIDNotNil {
+		preds = append(preds, fieldtype.NillableUUIDNotNil())
+	}
+	if f.StringsIsNil {
+		preds = append(preds, fieldtype.StringsIsNil())
+	}
+	if f.StringsNotNil {
+		preds = append(preds, fieldtype.StringsNotNil())
+	}
+	if f.Pair != nil {
+		preds = append(preds, fieldtype.PairEQ(*f.Pair))
+	}
+	if f.PairNEQ != nil {
+		preds = append(preds, fieldtype.PairNEQ(*f.PairNEQ))
+	}
+	if f.PairIn != nil {
+		preds = append(preds, fieldtype.PairIn(f.PairIn...))
+	}
+	if f.PairNotIn != nil {
+		preds = append(preds, fieldtype.PairNotIn(f.PairNotIn...))
+	}
+	if f.PairGT != nil {
+		preds = append(preds, fieldtype.PairGT(*f.PairGT))
+	}
+	if f.PairGTE != nil {
+		preds = append(preds, fieldtype.PairGTE(*f.PairGTE))
+	}
+	if f.PairLT != nil {
+		preds = append(preds, fieldtype.PairLT(*f.PairLT))
+	}
+	if f.PairLTE != nil {
+		preds = append(preds, fieldtype.PairLTE(*f.PairLTE))
+	}
+	if f.NilPair != nil {
+		preds = append(preds, fieldtype.NilPairEQ(*f.NilPair))
+	}
+	if f.NilPairNEQ != nil {
+		preds = append(preds, fieldtype.NilPairNEQ(*f.NilPairNEQ))
+	}
+	if f.NilPairIn != nil {
+		preds = append(preds, fieldtype.NilPairIn(f.NilPairIn...))
+	}
+	if f.NilPairNotIn != nil {
+		preds = append(preds, fieldtype.NilPairNotIn(f.NilPairNotIn...))
+	}
+	if f.NilPairGT != nil {
+		preds = append(preds, fieldtype.NilPairGT(*f.NilPairGT))
+	}
+	if f.NilPairGTE != nil {
+		preds = append(preds, fieldtype.NilPairGTE(*f.NilPairGTE))
+	}
+	if f.NilPairLT != nil {
+		preds = append(preds, fieldtype.NilPairLT(*f.NilPairLT))
+	}
+	if f.NilPairLTE != nil {
+		preds = append(preds, fieldtype.NilPairLTE(*f.NilPairLTE))
+	}
+	if f.NilPairIsNil {
+		preds = append(preds, fieldtype.NilPairIsNil())
+	}
+	if f.NilPairNotNil {
+		preds = append(preds, fieldtype.NilPairNotNil())
+	}
+	if f.Vstring != nil {
+		preds = append(preds, fieldtype.VstringEQ(*f.Vstring))
+	}
+	if f.VstringNEQ != nil {
+		preds = append(preds, fieldtype.VstringNEQ(*f.VstringNEQ))
+	}
+	if f.VstringIn != nil {
+		preds = append(preds, fieldtype.VstringIn(f.VstringIn...))
+	}
+	if f.VstringNotIn != nil {
+		preds = append(preds, fieldtype.VstringNotIn(f.VstringNotIn...))
+	}
+	if f.VstringGT != nil {
+		preds = append(preds, fieldtype.VstringGT(*f.VstringGT))
+	}
+	if f.VstringGTE != nil {
+		preds = append(preds, fieldtype.VstringGTE(*f.VstringGTE))
+	}
+	if f.VstringLT != nil {
+		preds = append(preds, fieldtype.VstringLT(*f.VstringLT))
+	}
+	if f.VstringLTE != nil {
+		preds = append(preds, fieldtype.VstringLTE(*f.VstringLTE))
+	}
+	if f.VstringContains != nil {
+		preds = append(preds, fieldtype.VstringContains(*f.VstringContains))
+	}
+	if f.VstringHasPrefix != nil {
+		preds = append(preds, fieldtype.VstringHasPrefix(*f.VstringHasPrefix))
+	}
+	if f.VstringHasSuffix != nil {
+		preds = append(preds, fieldtype.VstringHasSuffix(*f.VstringHasSuffix))
+	}
+	if f.VstringEqualFold != nil {
+		preds = append(preds, fieldtype.VstringEqualFold(*f.VstringEqualFold))
+	}
+	if f.VstringContainsFold != nil {
+		preds = append(preds, fieldtype.VstringContainsFold(*f.VstringContainsFold))
+	}
+	if f.VstringHasPrefixFold != nil {
+		preds = append(preds, fieldtype.VstringHasPrefixFold(*f.VstringHasPrefixFold))
+	}
+	if f.Triple != nil {
+		preds = append(preds, fieldtype.TripleEQ(*f.Triple))
+	}
+	if f.TripleNEQ != nil {
+		preds = append(preds, fieldtype.TripleNEQ(*f.TripleNEQ))
+	}
+	if f.TripleIn != nil {
+		preds = append(preds, fieldtype.TripleIn(f.TripleIn...))
+	}
+	if f.TripleNotIn != nil {
+		preds = append(preds, fieldtype.TripleNotIn(f.TripleNotIn...))
+	}
+	if f.TripleGT != nil {
+		preds = append(preds, fieldtype.TripleGT(*f.TripleGT))
+	}
+	if f.TripleGTE != nil {
+		preds = append(preds, fieldtype.TripleGTE(*f.TripleGTE))
+	}
+	if f.TripleLT != nil {
+		preds = append(preds, fieldtype.TripleLT(*f.TripleLT))
+	}
+	if f.TripleLTE != nil {
+		preds = append(preds, fieldtype.TripleLTE(*f.TripleLTE))
+	}
+	if f.BigInt != nil {
+		preds = append(preds, fieldtype.BigIntEQ(*f.BigInt))
+	}
+	if f.BigIntNEQ != nil {
+		preds = append(preds, fieldtype.BigIntNEQ(*f.BigIntNEQ))
+	}
+	if f.BigIntIn != nil {
+		preds = append(preds, fieldtype.BigIntIn(f.BigIntIn...))
+	}
+	if f.BigIntNotIn != nil {
+		preds = append(preds, fieldtype.BigIntNotIn(f.BigIntNotIn...))
+	}
+	if f.BigIntGT != nil {
+		preds = append(preds, fieldtype.BigIntGT(*f.BigIntGT))
+	}
+	if f.BigIntGTE != nil {
+		preds = append(preds, fieldtype.BigIntGTE(*f.BigIntGTE))
+	}
+	if f.BigIntLT != nil {
+		preds = append(preds, fieldtype.BigIntLT(*f.BigIntLT))
+	}
+	if f.BigIntLTE != nil {
+		preds = append(preds, fieldtype.BigIntLTE(*f.BigIntLTE))
+	}
+	if f.BigIntIsNil {
+		preds = append(preds, fieldtype.BigIntIsNil())
+	}
+	if f.BigIntNotNil {
+		preds = append(preds, fieldtype.BigIntNotNil())
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return fieldtype.And(preds...), nil
+	}
+}
+
+// FileFilterInput is a typed filter of File entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the file package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.FileFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.File.Query())
+type FileFilterInput struct {
+	Not *FileFilterInput   `json:"not,omitempty"`
+	And []*FileFilterInput `json:"and,omitempty"`
+	Or  []*FileFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "size" field predicates.
+	Size      *int  `json:"size,omitempty"`
+	SizeNEQ   *int  `json:"sizeNEQ,omitempty"`
+	SizeIn    []int `json:"sizeIn,omitempty"`
+	SizeNotIn []int `json:"sizeNotIn,omitempty"`
+	SizeGT    *int  `json:"sizeGT,omitempty"`
+	SizeGTE   *int  `json:"sizeGTE,omitempty"`
+	SizeLT    *int  `json:"sizeLT,omitempty"`
+	SizeLTE   *int  `json:"sizeLTE,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "user" field predicates.
+	User              *string  `json:"user,omitempty"`
+	UserNEQ           *string  `json:"userNEQ,omitempty"`
+	UserIn            []string `json:"userIn,omitempty"`
+	UserNotIn         []string `json:"userNotIn,omitempty"`
+	UserGT            *string  `json:"userGT,omitempty"`
+	UserGTE           *string  `json:"userGTE,omitempty"`
+	UserLT            *string  `json:"userLT,omitempty"`
+	UserLTE           *string  `json:"userLTE,omitempty"`
+	UserContains      *string  `json:"userContains,omitempty"`
+	UserHasPrefix     *string  `json:"userHasPrefix,omitempty"`
+	UserHasSuffix     *string  `json:"userHasSuffix,omitempty"`
+	UserIsNil         bool     `json:"userIsNil,omitempty"`
+	UserNotNil        bool     `json:"userNotNil,omitempty"`
+	UserEqualFold     *string  `json:"userEqualFold,omitempty"`
+	UserContainsFold  *string  `json:"userContainsFold,omitempty"`
+	UserHasPrefixFold *string  `json:"userHasPrefixFold,omitempty"`
+
+	// "group" field predicates.
+	Group              *string  `json:"group,omitempty"`
+	GroupNEQ           *string  `json:"groupNEQ,omitempty"`
+	GroupIn            []string `json:"groupIn,omitempty"`
+	GroupNotIn         []string `json:"groupNotIn,omitempty"`
+	GroupGT            *string  `json:"groupGT,omitempty"`
+	GroupGTE           *string  `json:"groupGTE,omitempty"`
+	GroupLT            *string  `json:"groupLT,omitempty"`
+	GroupLTE           *string  `json:"groupLTE,omitempty"`
+	GroupContains      *string  `json:"groupContains,omitempty"`
+	GroupHasPrefix     *string  `json:"groupHasPrefix,omitempty"`
+	GroupHasSuffix     *string  `json:"groupHasSuffix,omitempty"`
+	GroupIsNil         bool     `json:"groupIsNil,omitempty"`
+	GroupNotNil        bool     `json:"groupNotNil,omitempty"`
+	GroupEqualFold     *string  `json:"groupEqualFold,omitempty"`
+	GroupContainsFold  *string  `json:"groupContainsFold,omitempty"`
+	GroupHasPrefixFold *string  `json:"groupHasPrefixFold,omitempty"`
+
+	// "op" field predicates.
+	Op       *bool `json:"op,omitempty"`
+	OpNEQ    *bool `json:"opNEQ,omitempty"`
+	OpIsNil  bool  `json:"opIsNil,omitempty"`
+	OpNotNil bool  `json:"opNotNil,omitempty"`
+
+	// "field_id" field predicates.
+	FieldID       *int  `json:"fieldID,omitempty"`
+	FieldIDNEQ    *int  `json:"fieldIDNEQ,omitempty"`
+	FieldIDIn     []int `json:"fieldIDIn,omitempty"`
+	FieldIDNotIn  []int `json:"fieldIDNotIn,omitempty"`
+	FieldIDGT     *int  `json:"fieldIDGT,omitempty"`
+	FieldIDGTE    *int  `json:"fieldIDGTE,omitempty"`
+	FieldIDLT     *int  `json:"fieldIDLT,omitempty"`
+	FieldIDLTE    *int  `json:"fieldIDLTE,omitempty"`
+	FieldIDIsNil  bool  `json:"fieldIDIsNil,omitempty"`
+	FieldIDNotNil bool  `json:"fieldIDNotNil,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool              `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserFilterInput `json:"hasOwnerWith,omitempty"`
+
+	// "type" edge predicates.
+	HasType     *bool                  `json:"hasType,omitempty"`
+	HasTypeWith []*FileTypeFilterInput `json:"hasTypeWith,omitempty"`
+
+	// "field" edge predicates.
+	HasField     *bool                   `json:"hasField,omitempty"`
+	HasFieldWith []*FieldTypeFilterInput `json:"hasFieldWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *FileFilterInput) Apply(q *FileQuery) (*FileQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *FileFilterInput) P() (predicate.File, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.File
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, file.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*FileFilterInput
+		join    func(...predicate.File) predicate.File
+	}{
+		{filters: f.And, join: file.And},
+		{filters: f.Or, join: file.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.File, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, file.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, file.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, file.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, file.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, file.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, file.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, file.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, file.IDLTE(*f.IDLTE))
+	}
+	if f.Size != nil {
+		preds = append(preds, file.SizeEQ(*f.Size))
+	}
+	if f.SizeNEQ != nil {
+		preds = append(preds, file.SizeNEQ(*f.SizeNEQ))
+	}
+	if f.SizeIn != nil {
+		preds = append(preds, file.SizeIn(f.SizeIn...))
+	}
+	if f.SizeNotIn != nil {
+		preds = append(preds, file.SizeNotIn(f.SizeNotIn...))
+	}
+	if f.SizeGT != nil {
+		preds = append(preds, file.SizeGT(*f.SizeGT))
+	}
+	if f.SizeGTE != nil {
+		preds = append(preds, file.SizeGTE(*f.SizeGTE))
+	}
+	if f.SizeLT != nil {
+		preds = append(preds, file.SizeLT(*f.SizeLT))
+	}
+	if f.SizeLTE != nil {
+		preds = append(preds, file.SizeLTE(*f.SizeLTE))
+	}
+	if f.Name != nil {
+		preds = append(preds, file.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, file.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, file.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, file.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, file.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, file.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, file.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, file.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, file.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, file.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, file.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, file.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, file.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, file.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.User != nil {
+		preds = append(preds, file.UserEQ(*f.User))
+	}
+	if f.UserNEQ != nil {
+		preds = append(preds, file.UserNEQ(*f.UserNEQ))
+	}
+	if f.UserIn != nil {
+		preds = append(preds, file.UserIn(f.UserIn...))
+	}
+	if f.UserNotIn != nil {
+		preds = append(preds, file.UserNotIn(f.UserNotIn...))
+	}
+	if f.UserGT != nil {
+		preds = append(preds, file.UserGT(*f.UserGT))
+	}
+	if f.UserGTE != nil {
+		preds = append(preds, file.UserGTE(*f.UserGTE))
+	}
+	if f.UserLT != nil {
+		preds = append(preds, file.UserLT(*f.UserLT))
+	}
+	if f.UserLTE != nil {
+		preds = append(preds, file.UserLTE(*f.UserLTE))
+	}
+	if f.UserContains != nil {
+		preds = append(preds, file.UserContains(*f.UserContains))
+	}
+	if f.UserHasPrefix != nil {
+		preds = append(preds, file.UserHasPrefix(*f.UserHasPrefix))
+	}
+	if f.UserHasSuffix != nil {
+		preds = append(preds, file.UserHasSuffix(*f.UserHasSuffix))
+	}
+	if f.UserIsNil {
+		preds = append(preds, file.UserIsNil())
+	}
+	if f.UserNotNil {
+		preds = append(preds, file.UserNotNil())
+	}
+	if f.UserEqualFold != nil {
+		preds = append(preds, file.UserEqualFold(*f.UserEqualFold))
+	}
+	if f.UserContainsFold != nil {
+		preds = append(preds, file.UserContainsFold(*f.UserContainsFold))
+	}
+	if f.UserHasPrefixFold != nil {
+		preds = append(preds, file.UserHasPrefixFold(*f.UserHasPrefixFold))
+	}
+	if f.Group != nil {
+		preds = append(preds, file.GroupEQ(*f.Group))
+	}
+	if f.GroupNEQ != nil {
+		preds = append(preds, file.GroupNEQ(*f.GroupNEQ))
+	}
+	if f.GroupIn != nil {
+		preds = append(preds, file.GroupIn(f.GroupIn...))
+	}
+	if f.GroupNotIn != nil {
+		preds = append(preds, file.GroupNotIn(f.GroupNotIn...))
+	}
+	if f.GroupGT != nil {
+		preds = append(preds, file.GroupGT(*f.GroupGT))
+	}
+	if f.GroupGTE != nil {
+		preds = append(preds, file.GroupGTE(*f.GroupGTE))
+	}
+	if f.GroupLT != nil {
+		preds = append(preds, file.GroupLT(*f.GroupLT))
+	}
+	if f.GroupLTE != nil {
+		preds = append(preds, file.GroupLTE(*f.GroupLTE))
+	}
+	if f.GroupContains != nil {
+		preds = append(preds, file.GroupContains(*f.GroupContains))
+	}
+	if f.GroupHasPrefix != nil {
+		preds = append(preds, file.GroupHasPrefix(*f.GroupHasPrefix))
+	}
+	if f.GroupHasSuffix != nil {
+		preds = append(preds, file.GroupHasSuffix(*f.GroupHasSuffix))
+	}
+	if f.GroupIsNil {
+		preds = append(preds, file.GroupIsNil())
+	}
+	if f.GroupNotNil {
+		preds = append(preds, file.GroupNotNil())
+	}
+	if f.GroupEqualFold != nil {
+		preds = append(preds, file.GroupEqualFold(*f.GroupEqualFold))
+	}
+	if f.GroupContainsFold != nil {
+		preds = append(preds, file.GroupContainsFold(*f.GroupContainsFold))
+	}
+	if f.GroupHasPrefixFold != nil {
+		preds = append(preds, file.GroupHasPrefixFold(*f.GroupHasPrefixFold))
+	}
+	if f.Op != nil {
+		preds = append(preds, file.OpEQ(*f.Op))
+	}
+	if f.OpNEQ != nil {
+		preds = append(preds, file.OpNEQ(*f.OpNEQ))
+	}
+	if f.OpIsNil {
+		preds = append(preds, file.OpIsNil())
+	}
+	if f.OpNotNil {
+		preds = append(preds, file.OpNotNil())
+	}
+	if f.FieldID != nil {
+		preds = append(preds, file.FieldIDEQ(*f.FieldID))
+	}
+	if f.FieldIDNEQ != nil {
+		preds = append(preds, file.FieldIDNEQ(*f.FieldIDNEQ))
+	}
+	if f.FieldIDIn != nil {
+		preds = append(preds, file.FieldIDIn(f.FieldIDIn...))
+	}
+	if f.FieldIDNotIn != nil {
+		preds = append(preds, file.FieldIDNotIn(f.FieldIDNotIn...))
+	}
+	if f.FieldIDGT != nil {
+		preds = append(preds, file.FieldIDGT(*f.FieldIDGT))
+	}
+	if f.FieldIDGTE != nil {
+		preds = append(preds, file.FieldIDGTE(*f.FieldIDGTE))
+	}
+	if f.FieldIDLT != nil {
+		preds = append(preds, file.FieldIDLT(*f.FieldIDLT))
+	}
+	if f.FieldIDLTE != nil {
+		preds = append(preds, file.FieldIDLTE(*f.FieldIDLTE))
+	}
+	if f.FieldIDIsNil {
+		preds = append(preds, file.FieldIDIsNil())
+	}
+	if f.FieldIDNotNil {
+		preds = append(preds, file.FieldIDNotNil())
+	}
+	if f.HasOwner != nil {
+		p := file.HasOwner()
+		if !*f.HasOwner {
+			p = file.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasOwnerWith))
+		for _, w := range f.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, file.HasOwnerWith(with...))
+	}
+	if f.HasType != nil {
+		p := file.HasType()
+		if !*f.HasType {
+			p = file.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasTypeWith) > 0 {
+		with := make([]predicate.FileType, 0, len(f.HasTypeWith))
+		for _, w := range f.HasTypeWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, file.HasTypeWith(with...))
+	}
+	if f.HasField != nil {
+		p := file.HasField()
+		if !*f.HasField {
+			p = file.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFieldWith) > 0 {
+		with := make([]predicate.FieldType, 0, len(f.HasFieldWith))
+		for _, w := range f.HasFieldWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, file.HasFieldWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return file.And(preds...), nil
+	}
+}
+
+// FileTypeFilterInput is a typed filter of FileType entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the filetype package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.FileTypeFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.FileType.Query())
+type FileTypeFilterInput struct {
+	Not *FileTypeFilterInput   `json:"not,omitempty"`
+	And []*FileTypeFilterInput `json:"and,omitempty"`
+	Or  []*FileTypeFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "type" field predicates.
+	Type      *filetype.Type  `json:"type,omitempty"`
+	TypeNEQ   *filetype.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []filetype.Type `json:"typeIn,omitempty"`
+	TypeNotIn []filetype.Type `json:"typeNotIn,omitempty"`
+
+	// "state" field predicates.
+	State      *filetype.State  `json:"state,omitempty"`
+	StateNEQ   *filetype.State  `json:"stateNEQ,omitempty"`
+	StateIn    []filetype.State `json:"stateIn,omitempty"`
+	StateNotIn []filetype.State `json:"stateNotIn,omitempty"`
+
+	// "files" edge predicates.
+	HasFiles     *bool              `json:"hasFiles,omitempty"`
+	HasFilesWith []*FileFilterInput `json:"hasFilesWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *FileTypeFilterInput) Apply(q *FileTypeQuery) (*FileTypeQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *FileTypeFilterInput) P() (predicate.FileType, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.FileType
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, filetype.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*FileTypeFilterInput
+		join    func(...predicate.FileType) predicate.FileType
+	}{
+		{filters: f.And, join: filetype.And},
+		{filters: f.Or, join: filetype.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.FileType, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, filetype.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, filetype.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, filetype.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, filetype.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, filetype.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, filetype.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, filetype.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, filetype.IDLTE(*f.IDLTE))
+	}
+	if f.Name != nil {
+		preds = append(preds, filetype.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, filetype.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, filetype.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, filetype.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, filetype.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, filetype.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, filetype.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, filetype.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, filetype.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, filetype.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, filetype.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, filetype.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, filetype.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, filetype.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.Type != nil {
+		for _, v := range []filetype.Type{*f.Type} {
+			if err := filetype.TypeValidator(v); err != nil {
+				return nil, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.type": %w`, err)}
+			}
+		}
+	}
+	if f.Type != nil {
+		preds = append(preds, filetype.TypeEQ(*f.Type))
+	}
+	if f.TypeNEQ != nil {
+		for _, v := range []filetype.Type{*f.TypeNEQ} {
+			if err := filetype.TypeValidator(v); err != nil {
+				return nil, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.type": %w`, err)}
+			}
+		}
+	}
+	if f.TypeNEQ != nil {
+		preds = append(preds, filetype.TypeNEQ(*f.TypeNEQ))
+	}
+	if f.TypeIn != nil {
+		for _, v := range f.TypeIn {
+			if err := filetype.TypeValidator(v); err != nil {
+				return nil, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.type": %w`, err)}
+			}
+		}
+	}
+	if f.TypeIn != nil {
+		preds = append(preds, filetype.TypeIn(f.TypeIn...))
+	}
+	if f.TypeNotIn != nil {
+		for _, v := range f.TypeNotIn {
+			if err := filetype.TypeValidator(v); err != nil {
+				return nil, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.type": %w`, err)}
+			}
+		}
+	}
+	if f.TypeNotIn != nil {
+		preds = append(preds, filetype.TypeNotIn(f.TypeNotIn...))
+	}
+	if f.State != nil {
+		for _, v := range []filetype.State{*f.State} {
+			if err := filetype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.state": %w`, err)}
+			}
+		}
+	}
+	if f.State != nil {
+		preds = append(preds, filetype.StateEQ(*f.State))
+	}
+	if f.StateNEQ != nil {
+		for _, v := range []filetype.State{*f.StateNEQ} {
+			if err := filetype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.state": %w`, err)}
+			}
+		}
+	}
+	if f.StateNEQ != nil {
+		preds = append(preds, filetype.StateNEQ(*f.StateNEQ))
+	}
+	if f.StateIn != nil {
+		for _, v := range f.StateIn {
+			if err := filetype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.state": %w`, err)}
+			}
+		}
+	}
+	if f.StateIn != nil {
+		preds = append(preds, filetype.StateIn(f.StateIn...))
+	}
+	if f.StateNotIn != nil {
+		for _, v := range f.StateNotIn {
+			if err := filetype.StateValidator(v); err != nil {
+				return nil, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "FileType.state": %w`, err)}
+			}
+		}
+	}
+	if f.StateNotIn != nil {
+		preds = append(preds, filetype.StateNotIn(f.StateNotIn...))
+	}
+	if f.HasFiles != nil {
+		p := filetype.HasFiles()
+		if !*f.HasFiles {
+			p = filetype.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFilesWith) > 0 {
+		with := make([]predicate.File, 0, len(f.HasFilesWith))
+		for _, w := range f.HasFilesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, filetype.HasFilesWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return filetype.And(preds...), nil
+	}
+}
+
+// GoodsFilterInput is a typed filter of Goods entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the goods package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.GoodsFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Goods.Query())
+type GoodsFilterInput struct {
+	Not *GoodsFilterInput   `json:"not,omitempty"`
+	And []*GoodsFilterInput `json:"and,omitempty"`
+	Or  []*GoodsFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *GoodsFilterInput) Apply(q *GoodsQuery) (*GoodsQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *GoodsFilterInput) P() (predicate.Goods, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Goods
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, goods.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*GoodsFilterInput
+		join    func(...predicate.Goods) predicate.Goods
+	}{
+		{filters: f.And, join: goods.And},
+		{filters: f.Or, join: goods.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Goods, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, goods.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, goods.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, goods.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, goods.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, goods.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, goods.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, goods.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, goods.IDLTE(*f.IDLTE))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return goods.And(preds...), nil
+	}
+}
+
+// GroupFilterInput is a typed filter of Group entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the group package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.GroupFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Group.Query())
+type GroupFilterInput struct {
+	Not *GroupFilterInput   `json:"not,omitempty"`
+	And []*GroupFilterInput `json:"and,omitempty"`
+	Or  []*GroupFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "active" field predicates.
+	Active    *bool `json:"active,omitempty"`
+	ActiveNEQ *bool `json:"activeNEQ,omitempty"`
+
+	// "expire" field predicates.
+	Expire      *time.Time  `json:"expire,omitempty"`
+	ExpireNEQ   *time.Time  `json:"expireNEQ,omitempty"`
+	ExpireIn    []time.Time `json:"expireIn,omitempty"`
+	ExpireNotIn []time.Time `json:"expireNotIn,omitempty"`
+	ExpireGT    *time.Time  `json:"expireGT,omitempty"`
+	ExpireGTE   *time.Time  `json:"expireGTE,omitempty"`
+	ExpireLT    *time.Time  `json:"expireLT,omitempty"`
+	ExpireLTE   *time.Time  `json:"expireLTE,omitempty"`
+
+	// "type" field predicates.
+	Type              *string  `json:"type,omitempty"`
+	TypeNEQ           *string  `json:"typeNEQ,omitempty"`
+	TypeIn            []string `json:"typeIn,omitempty"`
+	TypeNotIn         []string `json:"typeNotIn,omitempty"`
+	TypeGT            *string  `json:"typeGT,omitempty"`
+	TypeGTE           *string  `json:"typeGTE,omitempty"`
+	TypeLT            *string  `json:"typeLT,omitempty"`
+	TypeLTE           *string  `json:"typeLTE,omitempty"`
+	TypeContains      *string  `json:"typeContains,omitempty"`
+	TypeHasPrefix     *string  `json:"typeHasPrefix,omitempty"`
+	TypeHasSuffix     *string  `json:"typeHasSuffix,omitempty"`
+	TypeIsNil         bool     `json:"typeIsNil,omitempty"`
+	TypeNotNil        bool     `json:"typeNotNil,omitempty"`
+	TypeEqualFold     *string  `json:"typeEqualFold,omitempty"`
+	TypeContainsFold  *string  `json:"typeContainsFold,omitempty"`
+	TypeHasPrefixFold *string  `json:"typeHasPrefixFold,omitempty"`
+
+	// "max_users" field predicates.
+	MaxUsers       *int  `json:"maxUsers,omitempty"`
+	MaxUsersNEQ    *int  `json:"maxUsersNEQ,omitempty"`
+	MaxUsersIn     []int `json:"maxUsersIn,omitempty"`
+	MaxUsersNotIn  []int `json:"maxUsersNotIn,omitempty"`
+	MaxUsersGT     *int  `json:"maxUsersGT,omitempty"`
+	MaxUsersGTE    *int  `json:"maxUsersGTE,omitempty"`
+	MaxUsersLT     *int  `json:"maxUsersLT,omitempty"`
+	MaxUsersLTE    *int  `json:"maxUsersLTE,omitempty"`
+	MaxUsersIsNil  bool  `json:"maxUsersIsNil,omitempty"`
+	MaxUsersNotNil bool  `json:"maxUsersNotNil,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "files" edge predicates.
+	HasFiles     *bool              `json:"hasFiles,omitempty"`
+	HasFilesWith []*FileFilterInput `json:"hasFilesWith,omitempty"`
+
+	// "blocked" edge predicates.
+	HasBlocked     *bool              `json:"hasBlocked,omitempty"`
+	HasBlockedWith []*UserFilterInput `json:"hasBlockedWith,omitempty"`
+
+	// "users" edge predicates.
+	HasUsers     *bool              `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserFilterInput `json:"hasUsersWith,omitempty"`
+
+	// "info" edge predicates.
+	HasInfo     *bool                   `json:"hasInfo,omitempty"`
+	HasInfoWith []*GroupInfoFilterInput `json:"hasInfoWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *GroupFilterInput) Apply(q *GroupQuery) (*GroupQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *GroupFilterInput) P() (predicate.Group, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Group
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, group.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*GroupFilterInput
+		join    func(...predicate.Group) predicate.Group
+	}{
+		{filters: f.And, join: group.And},
+		{filters: f.Or, join: group.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Group, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, group.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, group.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, group.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, group.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, group.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, group.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, group.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, group.IDLTE(*f.IDLTE))
+	}
+	if f.Active != nil {
+		preds = append(preds, group.ActiveEQ(*f.Active))
+	}
+	if f.ActiveNEQ != nil {
+		preds = append(preds, group.ActiveNEQ(*f.ActiveNEQ))
+	}
+	if f.Expire != nil {
+		preds = append(preds, group.ExpireEQ(*f.Expire))
+	}
+	if f.ExpireNEQ != nil {
+		preds = append(preds, group.ExpireNEQ(*f.ExpireNEQ))
+	}
+	if f.ExpireIn != nil {
+		preds = append(preds, group.ExpireIn(f.ExpireIn...))
+	}
+	if f.ExpireNotIn != nil {
+		preds = append(preds, group.ExpireNotIn(f.ExpireNotIn...))
+	}
+	if f.ExpireGT != nil {
+		preds = append(preds, group.ExpireGT(*f.ExpireGT))
+	}
+	if f.ExpireGTE != nil {
+		preds = append(preds, group.ExpireGTE(*f.ExpireGTE))
+	}
+	if f.ExpireLT != nil {
+		preds = append(preds, group.ExpireLT(*f.ExpireLT))
+	}
+	if f.ExpireLTE != nil {
+		preds = append(preds, group.ExpireLTE(*f.ExpireLTE))
+	}
+	if f.Type != nil {
+		preds = append(preds, group.TypeEQ(*f.Type))
+	}
+	if f.TypeNEQ != nil {
+		preds = append(preds, group.TypeNEQ(*f.TypeNEQ))
+	}
+	if f.TypeIn != nil {
+		preds = append(preds, group.TypeIn(f.TypeIn...))
+	}
+	if f.TypeNotIn != nil {
+		preds = append(preds, group.TypeNotIn(f.TypeNotIn...))
+	}
+	if f.TypeGT != nil {
+		preds = append(preds, group.TypeGT(*f.TypeGT))
+	}
+	if f.TypeGTE != nil {
+		preds = append(preds, group.TypeGTE(*f.TypeGTE))
+	}
+	if f.TypeLT != nil {
+		preds = append(preds, group.TypeLT(*f.TypeLT))
+	}
+	if f.TypeLTE != nil {
+		preds = append(preds, group.TypeLTE(*f.TypeLTE))
+	}
+	if f.TypeContains != nil {
+		preds = append(preds, group.TypeContains(*f.TypeContains))
+	}
+	if f.TypeHasPrefix != nil {
+		preds = append(preds, group.TypeHasPrefix(*f.TypeHasPrefix))
+	}
+	if f.TypeHasSuffix != nil {
+		preds = append(preds, group.TypeHasSuffix(*f.TypeHasSuffix))
+	}
+	if f.TypeIsNil {
+		preds = append(preds, group.TypeIsNil())
+	}
+	if f.TypeNotNil {
+		preds = append(preds, group.TypeNotNil())
+	}
+	if f.TypeEqualFold != nil {
+		preds = append(preds, group.TypeEqualFold(*f.TypeEqualFold))
+	}
+	if f.TypeContainsFold != nil {
+		preds = append(preds, group.TypeContainsFold(*f.TypeContainsFold))
+	}
+	if f.TypeHasPrefixFold != nil {
+		preds = append(preds, group.TypeHasPrefixFold(*f.TypeHasPrefixFold))
+	}
+	if f.MaxUsers != nil {
+		preds = append(preds, group.MaxUsersEQ(*f.MaxUsers))
+	}
+	if f.MaxUsersNEQ != nil {
+		preds = append(preds, group.MaxUsersNEQ(*f.MaxUsersNEQ))
+	}
+	if f.MaxUsersIn != nil {
+		preds = append(preds, group.MaxUsersIn(f.MaxUsersIn...))
+	}
+	if f.MaxUsersNotIn != nil {
+		preds = append(preds, group.MaxUsersNotIn(f.MaxUsersNotIn...))
+	}
+	if f.MaxUsersGT != nil {
+		preds = append(preds, group.MaxUsersGT(*f.MaxUsersGT))
+	}
+	if f.MaxUsersGTE != nil {
+		preds = append(preds, group.MaxUsersGTE(*f.MaxUsersGTE))
+	}
+	if f.MaxUsersLT != nil {
+		preds = append(preds, group.MaxUsersLT(*f.MaxUsersLT))
+	}
+	if f.MaxUsersLTE != nil {
+		preds = append(preds, group.MaxUsersLTE(*f.MaxUsersLTE))
+	}
+	if f.MaxUsersIsNil {
+		preds = append(preds, group.MaxUsersIsNil())
+	}
+	if f.MaxUsersNotNil {
+		preds = append(preds, group.MaxUsersNotNil())
+	}
+	if f.Name != nil {
+		preds = append(preds, group.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, group.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, group.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, group.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, group.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, group.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, group.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, group.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, group.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, group.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, group.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, group.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, group.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, group.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.HasFiles != nil {
+		p := group.HasFiles()
+		if !*f.HasFiles {
+			p = group.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFilesWith) > 0 {
+		with := make([]predicate.File, 0, len(f.HasFilesWith))
+		for _, w := range f.HasFilesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, group.HasFilesWith(with...))
+	}
+	if f.HasBlocked != nil {
+		p := group.HasBlocked()
+		if !*f.HasBlocked {
+			p = group.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasBlockedWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasBlockedWith))
+		for _, w := range f.HasBlockedWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, group.HasBlockedWith(with...))
+	}
+	if f.HasUsers != nil {
+		p := group.HasUsers()
+		if !*f.HasUsers {
+			p = group.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasUsersWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasUsersWith))
+		for _, w := range f.HasUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, group.HasUsersWith(with...))
+	}
+	if f.HasInfo != nil {
+		p := group.HasInfo()
+		if !*f.HasInfo {
+			p = group.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasInfoWith) > 0 {
+		with := make([]predicate.GroupInfo, 0, len(f.HasInfoWith))
+		for _, w := range f.HasInfoWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, group.HasInfoWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return group.And(preds...), nil
+	}
+}
+
+// GroupInfoFilterInput is a typed filter of GroupInfo entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the groupinfo package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.GroupInfoFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.GroupInfo.Query())
+type GroupInfoFilterInput struct {
+	Not *GroupInfoFilterInput   `json:"not,omitempty"`
+	And []*GroupInfoFilterInput `json:"and,omitempty"`
+	Or  []*GroupInfoFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "desc" field predicates.
+	Desc              *string  `json:"desc,omitempty"`
+	DescNEQ           *string  `json:"descNEQ,omitempty"`
+	DescIn            []string `json:"descIn,omitempty"`
+	DescNotIn         []string `json:"descNotIn,omitempty"`
+	DescGT            *string  `json:"descGT,omitempty"`
+	DescGTE           *string  `json:"descGTE,omitempty"`
+	DescLT            *string  `json:"descLT,omitempty"`
+	DescLTE           *string  `json:"descLTE,omitempty"`
+	DescContains      *string  `json:"descContains,omitempty"`
+	DescHasPrefix     *string  `json:"descHasPrefix,omitempty"`
+	DescHasSuffix     *string  `json:"descHasSuffix,omitempty"`
+	DescEqualFold     *string  `json:"descEqualFold,omitempty"`
+	DescContainsFold  *string  `json:"descContainsFold,omitempty"`
+	DescHasPrefixFold *string  `json:"descHasPrefixFold,omitempty"`
+
+	// "max_users" field predicates.
+	MaxUsers      *int  `json:"maxUsers,omitempty"`
+	MaxUsersNEQ   *int  `json:"maxUsersNEQ,omitempty"`
+	MaxUsersIn    []int `json:"maxUsersIn,omitempty"`
+	MaxUsersNotIn []int `json:"maxUsersNotIn,omitempty"`
+	MaxUsersGT    *int  `json:"maxUsersGT,omitempty"`
+	MaxUsersGTE   *int  `json:"maxUsersGTE,omitempty"`
+	MaxUsersLT    *int  `json:"maxUsersLT,omitempty"`
+	MaxUsersLTE   *int  `json:"maxUsersLTE,omitempty"`
+
+	// "groups" edge predicates.
+	HasGroups     *bool               `json:"hasGroups,omitempty"`
+	HasGroupsWith []*GroupFilterInput `json:"hasGroupsWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *GroupInfoFilterInput) Apply(q *GroupInfoQuery) (*GroupInfoQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *GroupInfoFilterInput) P() (predicate.GroupInfo, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.GroupInfo
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, groupinfo.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*GroupInfoFilterInput
+		join    func(...predicate.GroupInfo) predicate.GroupInfo
+	}{
+		{filters: f.And, join: groupinfo.And},
+		{filters: f.Or, join: groupinfo.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.GroupInfo, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, groupinfo.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, groupinfo.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, groupinfo.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, groupinfo.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, groupinfo.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, groupinfo.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, groupinfo.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, groupinfo.IDLTE(*f.IDLTE))
+	}
+	if f.Desc != nil {
+		preds = append(preds, groupinfo.DescEQ(*f.Desc))
+	}
+	if f.DescNEQ != nil {
+		preds = append(preds, groupinfo.DescNEQ(*f.DescNEQ))
+	}
+	if f.DescIn != nil {
+		preds = append(preds, groupinfo.DescIn(f.DescIn...))
+	}
+	if f.DescNotIn != nil {
+		preds = append(preds, groupinfo.DescNotIn(f.DescNotIn...))
+	}
+	if f.DescGT != nil {
+		preds = append(preds, groupinfo.DescGT(*f.DescGT))
+	}
+	if f.DescGTE != nil {
+		preds = append(preds, groupinfo.DescGTE(*f.DescGTE))
+	}
+	if f.DescLT != nil {
+		preds = append(preds, groupinfo.DescLT(*f.DescLT))
+	}
+	if f.DescLTE != nil {
+		preds = append(preds, groupinfo.DescLTE(*f.DescLTE))
+	}
+	if f.DescContains != nil {
+		preds = append(preds, groupinfo.DescContains(*f.DescContains))
+	}
+	if f.DescHasPrefix != nil {
+		preds = append(preds, groupinfo.DescHasPrefix(*f.DescHasPrefix))
+	}
+	if f.DescHasSuffix != nil {
+		preds = append(preds, groupinfo.DescHasSuffix(*f.DescHasSuffix))
+	}
+	if f.DescEqualFold != nil {
+		preds = append(preds, groupinfo.DescEqualFold(*f.DescEqualFold))
+	}
+	if f.DescContainsFold != nil {
+		preds = append(preds, groupinfo.DescContainsFold(*f.DescContainsFold))
+	}
+	if f.DescHasPrefixFold != nil {
+		preds = append(preds, groupinfo.DescHasPrefixFold(*f.DescHasPrefixFold))
+	}
+	if f.MaxUsers != nil {
+		preds = append(preds, groupinfo.MaxUsersEQ(*f.MaxUsers))
+	}
+	if f.MaxUsersNEQ != nil {
+		preds = append(preds, groupinfo.MaxUsersNEQ(*f.MaxUsersNEQ))
+	}
+	if f.MaxUsersIn != nil {
+		preds = append(preds, groupinfo.MaxUsersIn(f.MaxUsersIn...))
+	}
+	if f.MaxUsersNotIn != nil {
+		preds = append(preds, groupinfo.MaxUsersNotIn(f.MaxUsersNotIn...))
+	}
+	if f.MaxUsersGT != nil {
+		preds = append(preds, groupinfo.MaxUsersGT(*f.MaxUsersGT))
+	}
+	if f.MaxUsersGTE != nil {
+		preds = append(preds, groupinfo.MaxUsersGTE(*f.MaxUsersGTE))
+	}
+	if f.MaxUsersLT != nil {
+		preds = append(preds, groupinfo.MaxUsersLT(*f.MaxUsersLT))
+	}
+	if f.MaxUsersLTE != nil {
+		preds = append(preds, groupinfo.MaxUsersLTE(*f.MaxUsersLTE))
+	}
+	if f.HasGroups != nil {
+		p := groupinfo.HasGroups()
+		if !*f.HasGroups {
+			p = groupinfo.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasGroupsWith) > 0 {
+		with := make([]predicate.Group, 0, len(f.HasGroupsWith))
+		for _, w := range f.HasGroupsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, groupinfo.HasGroupsWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return groupinfo.And(preds...), nil
+	}
+}
+
+// ItemFilterInput is a typed filter of Item entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the item package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.ItemFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Item.Query())
+type ItemFilterInput struct {
+	Not *ItemFilterInput   `json:"not,omitempty"`
+	And []*ItemFilterInput `json:"and,omitempty"`
+	Or  []*ItemFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID              *string  `json:"id,omitempty"`
+	IDNEQ           *string  `json:"idNEQ,omitempty"`
+	IDIn            []string `json:"idIn,omitempty"`
+	IDNotIn         []string `json:"idNotIn,omitempty"`
+	IDGT            *string  `json:"idGT,omitempty"`
+	IDGTE           *string  `json:"idGTE,omitempty"`
+	IDLT            *string  `json:"idLT,omitempty"`
+	IDLTE           *string  `json:"idLTE,omitempty"`
+	IDEqualFold     *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold  *string  `json:"idContainsFold,omitempty"`
+	IDHasPrefixFold *string  `json:"idHasPrefixFold,omitempty"`
+
+	// "text" field predicates.
+	Text              *string  `json:"text,omitempty"`
+	TextNEQ           *string  `json:"textNEQ,omitempty"`
+	TextIn            []string `json:"textIn,omitempty"`
+	TextNotIn         []string `json:"textNotIn,omitempty"`
+	TextGT            *string  `json:"textGT,omitempty"`
+	TextGTE           *string  `json:"textGTE,omitempty"`
+	TextLT            *string  `json:"textLT,omitempty"`
+	TextLTE           *string  `json:"textLTE,omitempty"`
+	TextContains      *string  `json:"textContains,omitempty"`
+	TextHasPrefix     *string  `json:"textHasPrefix,omitempty"`
+	TextHasSuffix     *string  `json:"textHasSuffix,omitempty"`
+	TextIsNil         bool     `json:"textIsNil,omitempty"`
+	TextNotNil        bool     `json:"textNotNil,omitempty"`
+	TextEqualFold     *string  `json:"textEqualFold,omitempty"`
+	TextContainsFold  *string  `json:"textContainsFold,omitempty"`
+	TextHasPrefixFold *string  `json:"textHasPrefixFold,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *ItemFilterInput) Apply(q *ItemQuery) (*ItemQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *ItemFilterInput) P() (predicate.Item, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Item
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, item.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*ItemFilterInput
+		join    func(...predicate.Item) predicate.Item
+	}{
+		{filters: f.And, join: item.And},
+		{filters: f.Or, join: item.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Item, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, item.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, item.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, item.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, item.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, item.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, item.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, item.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, item.IDLTE(*f.IDLTE))
+	}
+	if f.IDEqualFold != nil {
+		preds = append(preds, item.IDEqualFold(*f.IDEqualFold))
+	}
+	if f.IDContainsFold != nil {
+		preds = append(preds, item.IDContainsFold(*f.IDContainsFold))
+	}
+	if f.IDHasPrefixFold != nil {
+		preds = append(preds, item.IDHasPrefixFold(*f.IDHasPrefixFold))
+	}
+	if f.Text != nil {
+		preds = append(preds, item.TextEQ(*f.Text))
+	}
+	if f.TextNEQ != nil {
+		preds = append(preds, item.TextNEQ(*f.TextNEQ))
+	}
+	if f.TextIn != nil {
+		preds = append(preds, item.TextIn(f.TextIn...))
+	}
+	if f.TextNotIn != nil {
+		preds = append(preds, item.TextNotIn(f.TextNotIn...))
+	}
+	if f.TextGT != nil {
+		preds = append(preds, item.TextGT(*f.TextGT))
+	}
+	if f.TextGTE != nil {
+		preds = append(preds, item.TextGTE(*f.TextGTE))
+	}
+	if f.TextLT != nil {
+		preds = append(preds, item.TextLT(*f.TextLT))
+	}
+	if f.TextLTE != nil {
+		preds = append(preds, item.TextLTE(*f.TextLTE))
+	}
+	if f.TextContains != nil {
+		preds = append(preds, item.TextContains(*f.TextContains))
+	}
+	if f.TextHasPrefix != nil {
+		preds = append(preds, item.TextHasPrefix(*f.TextHasPrefix))
+	}
+	if f.TextHasSuffix != nil {
+		preds = append(preds, item.TextHasSuffix(*f.TextHasSuffix))
+	}
+	if f.TextIsNil {
+		preds = append(preds, item.TextIsNil())
+	}
+	if f.TextNotNil {
+		preds = append(preds, item.TextNotNil())
+	}
+	if f.TextEqualFold != nil {
+		preds = append(preds, item.TextEqualFold(*f.TextEqualFold))
+	}
+	if f.TextContainsFold != nil {
+		preds = append(preds, item.TextContainsFold(*f.TextContainsFold))
+	}
+	if f.TextHasPrefixFold != nil {
+		preds = append(preds, item.TextHasPrefixFold(*f.TextHasPrefixFold))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return item.And(preds...), nil
+	}
+}
+
+// LicenseFilterInput is a typed filter of License entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the license package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.LicenseFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.License.Query())
+type LicenseFilterInput struct {
+	Not *LicenseFilterInput   `json:"not,omitempty"`
+	And []*LicenseFilterInput `json:"and,omitempty"`
+	Or  []*LicenseFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "create_time" field predicates.
+	CreateTime      *time.Time  `json:"createTime,omitempty"`
+	CreateTimeNEQ   *time.Time  `json:"createTimeNEQ,omitempty"`
+	CreateTimeIn    []time.Time `json:"createTimeIn,omitempty"`
+	CreateTimeNotIn []time.Time `json:"createTimeNotIn,omitempty"`
+	CreateTimeGT    *time.Time  `json:"createTimeGT,omitempty"`
+	CreateTimeGTE   *time.Time  `json:"createTimeGTE,omitempty"`
+	CreateTimeLT    *time.Time  `json:"createTimeLT,omitempty"`
+	CreateTimeLTE   *time.Time  `json:"createTimeLTE,omitempty"`
+
+	// "update_time" field predicates.
+	UpdateTime      *time.Time  `json:"updateTime,omitempty"`
+	UpdateTimeNEQ   *time.Time  `json:"updateTimeNEQ,omitempty"`
+	UpdateTimeIn    []time.Time `json:"updateTimeIn,omitempty"`
+	UpdateTimeNotIn []time.Time `json:"updateTimeNotIn,omitempty"`
+	UpdateTimeGT    *time.Time  `json:"updateTimeGT,omitempty"`
+	UpdateTimeGTE   *time.Time  `json:"updateTimeGTE,omitempty"`
+	UpdateTimeLT    *time.Time  `json:"updateTimeLT,omitempty"`
+	UpdateTimeLTE   *time.Time  `json:"updateTimeLTE,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *LicenseFilterInput) Apply(q *LicenseQuery) (*LicenseQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *LicenseFilterInput) P() (predicate.License, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.License
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, license.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*LicenseFilterInput
+		join    func(...predicate.License) predicate.License
+	}{
+		{filters: f.And, join: license.And},
+		{filters: f.Or, join: license.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.License, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, license.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, license.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, license.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, license.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, license.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, license.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, license.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, license.IDLTE(*f.IDLTE))
+	}
+	if f.CreateTime != nil {
+		preds = append(preds, license.CreateTimeEQ(*f.CreateTime))
+	}
+	if f.CreateTimeNEQ != nil {
+		preds = append(preds, license.CreateTimeNEQ(*f.CreateTimeNEQ))
+	}
+	if f.CreateTimeIn != nil {
+		preds = append(preds, license.CreateTimeIn(f.CreateTimeIn...))
+	}
+	if f.CreateTimeNotIn != nil {
+		preds = append(preds, license.CreateTimeNotIn(f.CreateTimeNotIn...))
+	}
+	if f.CreateTimeGT != nil {
+		preds = append(preds, license.CreateTimeGT(*f.CreateTimeGT))
+	}
+	if f.CreateTimeGTE != nil {
+		preds = append(preds, license.CreateTimeGTE(*f.CreateTimeGTE))
+	}
+	if f.CreateTimeLT != nil {
+		preds = append(preds, license.CreateTimeLT(*f.CreateTimeLT))
+	}
+	if f.CreateTimeLTE != nil {
+		preds = append(preds, license.CreateTimeLTE(*f.CreateTimeLTE))
+	}
+	if f.UpdateTime != nil {
+		preds = append(preds, license.UpdateTimeEQ(*f.UpdateTime))
+	}
+	if f.UpdateTimeNEQ != nil {
+		preds = append(preds, license.UpdateTimeNEQ(*f.UpdateTimeNEQ))
+	}
+	if f.UpdateTimeIn != nil {
+		preds = append(preds, license.UpdateTimeIn(f.UpdateTimeIn...))
+	}
+	if f.UpdateTimeNotIn != nil {
+		preds = append(preds, license.UpdateTimeNotIn(f.UpdateTimeNotIn...))
+	}
+	if f.UpdateTimeGT != nil {
+		preds = append(preds, license.UpdateTimeGT(*f.UpdateTimeGT))
+	}
+	if f.UpdateTimeGTE != nil {
+		preds = append(preds, license.UpdateTimeGTE(*f.UpdateTimeGTE))
+	}
+	if f.UpdateTimeLT != nil {
+		preds = append(preds, license.UpdateTimeLT(*f.UpdateTimeLT))
+	}
+	if f.UpdateTimeLTE != nil {
+		preds = append(preds, license.UpdateTimeLTE(*f.UpdateTimeLTE))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return license.And(preds...), nil
+	}
+}
+
+// NodeFilterInput is a typed filter of Node entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the node package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.NodeFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Node.Query())
+type NodeFilterInput struct {
+	Not *NodeFilterInput   `json:"not,omitempty"`
+	And []*NodeFilterInput `json:"and,omitempty"`
+	Or  []*NodeFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "value" field predicates.
+	Value       *int  `json:"value,omitempty"`
+	ValueNEQ    *int  `json:"valueNEQ,omitempty"`
+	ValueIn     []int `json:"valueIn,omitempty"`
+	ValueNotIn  []int `json:"valueNotIn,omitempty"`
+	ValueGT     *int  `json:"valueGT,omitempty"`
+	ValueGTE    *int  `json:"valueGTE,omitempty"`
+	ValueLT     *int  `json:"valueLT,omitempty"`
+	ValueLTE    *int  `json:"valueLTE,omitempty"`
+	ValueIsNil  bool  `json:"valueIsNil,omitempty"`
+	ValueNotNil bool  `json:"valueNotNil,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt       *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ    *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT     *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE    *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT     *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE    *time.Time  `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil bool        `json:"updatedAtNotNil,omitempty"`
+
+	// "prev" edge predicates.
+	HasPrev     *bool              `json:"hasPrev,omitempty"`
+	HasPrevWith []*NodeFilterInput `json:"hasPrevWith,omitempty"`
+
+	// "next" edge predicates.
+	HasNext     *bool              `json:"hasNext,omitempty"`
+	HasNextWith []*NodeFilterInput `json:"hasNextWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *NodeFilterInput) Apply(q *NodeQuery) (*NodeQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *NodeFilterInput) P() (predicate.Node, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Node
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, node.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*NodeFilterInput
+		join    func(...predicate.Node) predicate.Node
+	}{
+		{filters: f.And, join: node.And},
+		{filters: f.Or, join: node.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Node, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, node.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, node.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, node.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, node.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, node.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, node.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, node.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, node.IDLTE(*f.IDLTE))
+	}
+	if f.Value != nil {
+		preds = append(preds, node.ValueEQ(*f.Value))
+	}
+	if f.ValueNEQ != nil {
+		preds = append(preds, node.ValueNEQ(*f.ValueNEQ))
+	}
+	if f.ValueIn != nil {
+		preds = append(preds, node.ValueIn(f.ValueIn...))
+	}
+	if f.ValueNotIn != nil {
+		preds = append(preds, node.ValueNotIn(f.ValueNotIn...))
+	}
+	if f.ValueGT != nil {
+		preds = append(preds, node.ValueGT(*f.ValueGT))
+	}
+	if f.ValueGTE != nil {
+		preds = append(preds, node.ValueGTE(*f.ValueGTE))
+	}
+	if f.ValueLT != nil {
+		preds = append(preds, node.ValueLT(*f.ValueLT))
+	}
+	if f.ValueLTE != nil {
+		preds = append(preds, node.ValueLTE(*f.ValueLTE))
+	}
+	if f.ValueIsNil {
+		preds = append(preds, node.ValueIsNil())
+	}
+	if f.ValueNotNil {
+		preds = append(preds, node.ValueNotNil())
+	}
+	if f.UpdatedAt != nil {
+		preds = append(preds, node.UpdatedAtEQ(*f.UpdatedAt))
+	}
+	if f.UpdatedAtNEQ != nil {
+		preds = append(preds, node.UpdatedAtNEQ(*f.UpdatedAtNEQ))
+	}
+	if f.UpdatedAtIn != nil {
+		preds = append(preds, node.UpdatedAtIn(f.UpdatedAtIn...))
+	}
+	if f.UpdatedAtNotIn != nil {
+		preds = append(preds, node.UpdatedAtNotIn(f.UpdatedAtNotIn...))
+	}
+	if f.UpdatedAtGT != nil {
+		preds = append(preds, node.UpdatedAtGT(*f.UpdatedAtGT))
+	}
+	if f.UpdatedAtGTE != nil {
+		preds = append(preds, node.UpdatedAtGTE(*f.UpdatedAtGTE))
+	}
+	if f.UpdatedAtLT != nil {
+		preds = append(preds, node.UpdatedAtLT(*f.UpdatedAtLT))
+	}
+	if f.UpdatedAtLTE != nil {
+		preds = append(preds, node.UpdatedAtLTE(*f.UpdatedAtLTE))
+	}
+	if f.UpdatedAtIsNil {
+		preds = append(preds, node.UpdatedAtIsNil())
+	}
+	if f.UpdatedAtNotNil {
+		preds = append(preds, node.UpdatedAtNotNil())
+	}
+	if f.HasPrev != nil {
+		p := node.HasPrev()
+		if !*f.HasPrev {
+			p = node.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasPrevWith) > 0 {
+		with := make([]predicate.Node, 0, len(f.HasPrevWith))
+		for _, w := range f.HasPrevWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, node.HasPrevWith(with...))
+	}
+	if f.HasNext != nil {
+		p := node.HasNext()
+		if !*f.HasNext {
+			p = node.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasNextWith) > 0 {
+		with := make([]predicate.Node, 0, len(f.HasNextWith))
+		for _, w := range f.HasNextWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, node.HasNextWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return node.And(preds...), nil
+	}
+}
+
+// PCFilterInput is a typed filter of PC entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the pc package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.PCFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.PC.Query())
+type PCFilterInput struct {
+	Not *PCFilterInput   `json:"not,omitempty"`
+	And []*PCFilterInput `json:"and,omitempty"`
+	Or  []*PCFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *PCFilterInput) Apply(q *PCQuery) (*PCQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *PCFilterInput) P() (predicate.PC, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.PC
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, pc.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*PCFilterInput
+		join    func(...predicate.PC) predicate.PC
+	}{
+		{filters: f.And, join: pc.And},
+		{filters: f.Or, join: pc.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.PC, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, pc.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, pc.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, pc.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, pc.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, pc.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, pc.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, pc.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, pc.IDLTE(*f.IDLTE))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return pc.And(preds...), nil
+	}
+}
+
+// PetFilterInput is a typed filter of Pet entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the pet package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.PetFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Pet.Query())
+type PetFilterInput struct {
+	Not *PetFilterInput   `json:"not,omitempty"`
+	And []*PetFilterInput `json:"and,omitempty"`
+	Or  []*PetFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "age" field predicates.
+	Age      *float64  `json:"age,omitempty"`
+	AgeNEQ   *float64  `json:"ageNEQ,omitempty"`
+	AgeIn    []float64 `json:"ageIn,omitempty"`
+	AgeNotIn []float64 `json:"ageNotIn,omitempty"`
+	AgeGT    *float64  `json:"ageGT,omitempty"`
+	AgeGTE   *float64  `json:"ageGTE,omitempty"`
+	AgeLT    *float64  `json:"ageLT,omitempty"`
+	AgeLTE   *float64  `json:"ageLTE,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "uuid" field predicates.
+	UUID       *uuid.UUID  `json:"uuid,omitempty"`
+	UUIDNEQ    *uuid.UUID  `json:"uuidNEQ,omitempty"`
+	UUIDIn     []uuid.UUID `json:"uuidIn,omitempty"`
+	UUIDNotIn  []uuid.UUID `json:"uuidNotIn,omitempty"`
+	UUIDGT     *uuid.UUID  `json:"uuidGT,omitempty"`
+	UUIDGTE    *uuid.UUID  `json:"uuidGTE,omitempty"`
+	UUIDLT     *uuid.UUID  `json:"uuidLT,omitempty"`
+	UUIDLTE    *uuid.UUID  `json:"uuidLTE,omitempty"`
+	UUIDIsNil  bool        `json:"uuidIsNil,omitempty"`
+	UUIDNotNil bool        `json:"uuidNotNil,omitempty"`
+
+	// "nickname" field predicates.
+	Nickname              *string  `json:"nickname,omitempty"`
+	NicknameNEQ           *string  `json:"nicknameNEQ,omitempty"`
+	NicknameIn            []string `json:"nicknameIn,omitempty"`
+	NicknameNotIn         []string `json:"nicknameNotIn,omitempty"`
+	NicknameGT            *string  `json:"nicknameGT,omitempty"`
+	NicknameGTE           *string  `json:"nicknameGTE,omitempty"`
+	NicknameLT            *string  `json:"nicknameLT,omitempty"`
+	NicknameLTE           *string  `json:"nicknameLTE,omitempty"`
+	NicknameContains      *string  `json:"nicknameContains,omitempty"`
+	NicknameHasPrefix     *string  `json:"nicknameHasPrefix,omitempty"`
+	NicknameHasSuffix     *string  `json:"nicknameHasSuffix,omitempty"`
+	NicknameIsNil         bool     `json:"nicknameIsNil,omitempty"`
+	NicknameNotNil        bool     `json:"nicknameNotNil,omitempty"`
+	NicknameEqualFold     *string  `json:"nicknameEqualFold,omitempty"`
+	NicknameContainsFold  *string  `json:"nicknameContainsFold,omitempty"`
+	NicknameHasPrefixFold *string  `json:"nicknameHasPrefixFold,omitempty"`
+
+	// "trained" field predicates.
+	Trained    *bool `json:"trained,omitempty"`
+	TrainedNEQ *bool `json:"trainedNEQ,omitempty"`
+
+	// "team" edge predicates.
+	HasTeam     *bool              `json:"hasTeam,omitempty"`
+	HasTeamWith []*UserFilterInput `json:"hasTeamWith,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool              `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserFilterInput `json:"hasOwnerWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *PetFilterInput) Apply(q *PetQuery) (*PetQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *PetFilterInput) P() (predicate.Pet, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Pet
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, pet.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*PetFilterInput
+		join    func(...predicate.Pet) predicate.Pet
+	}{
+		{filters: f.And, join: pet.And},
+		{filters: f.Or, join: pet.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Pet, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, pet.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, pet.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, pet.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, pet.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, pet.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, pet.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, pet.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, pet.IDLTE(*f.IDLTE))
+	}
+	if f.Age != nil {
+		preds = append(preds, pet.AgeEQ(*f.Age))
+	}
+	if f.AgeNEQ != nil {
+		preds = append(preds, pet.AgeNEQ(*f.AgeNEQ))
+	}
+	if f.AgeIn != nil {
+		preds = append(preds, pet.AgeIn(f.AgeIn...))
+	}
+	if f.AgeNotIn != nil {
+		preds = append(preds, pet.AgeNotIn(f.AgeNotIn...))
+	}
+	if f.AgeGT != nil {
+		preds = append(preds, pet.AgeGT(*f.AgeGT))
+	}
+	if f.AgeGTE != nil {
+		preds = append(preds, pet.AgeGTE(*f.AgeGTE))
+	}
+	if f.AgeLT != nil {
+		preds = append(preds, pet.AgeLT(*f.AgeLT))
+	}
+	if f.AgeLTE != nil {
+		preds = append(preds, pet.AgeLTE(*f.AgeLTE))
+	}
+	if f.Name != nil {
+		preds = append(preds, pet.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, pet.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, pet.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, pet.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, pet.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, pet.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, pet.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, pet.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, pet.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, pet.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, pet.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, pet.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, pet.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, pet.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.UUID != nil {
+		preds = append(preds, pet.UUIDEQ(*f.UUID))
+	}
+	if f.UUIDNEQ != nil {
+		preds = append(preds, pet.UUIDNEQ(*f.UUIDNEQ))
+	}
+	if f.UUIDIn != nil {
+		preds = append(preds, pet.UUIDIn(f.UUIDIn...))
+	}
+	if f.UUIDNotIn != nil {
+		preds = append(preds, pet.UUIDNotIn(f.UUIDNotIn...))
+	}
+	if f.UUIDGT != nil {
+		preds = append(preds, pet.UUIDGT(*f.UUIDGT))
+	}
+	if f.UUIDGTE != nil {
+		preds = append(preds, pet.UUIDGTE(*f.UUIDGTE))
+	}
+	if f.UUIDLT != nil {
+		preds = append(preds, pet.UUIDLT(*f.UUIDLT))
+	}
+	if f.UUIDLTE != nil {
+		preds = append(preds, pet.UUIDLTE(*f.UUIDLTE))
+	}
+	if f.UUIDIsNil {
+		preds = append(preds, pet.UUIDIsNil())
+	}
+	if f.UUIDNotNil {
+		preds = append(preds, pet.UUIDNotNil())
+	}
+	if f.Nickname != nil {
+		preds = append(preds, pet.NicknameEQ(*f.Nickname))
+	}
+	if f.NicknameNEQ != nil {
+		preds = append(preds, pet.NicknameNEQ(*f.NicknameNEQ))
+	}
+	if f.NicknameIn != nil {
+		preds = append(preds, pet.NicknameIn(f.NicknameIn...))
+	}
+	if f.NicknameNotIn != nil {
+		preds = append(preds, pet.NicknameNotIn(f.NicknameNotIn...))
+	}
+	if f.NicknameGT != nil {
+		preds = append(preds, pet.NicknameGT(*f.NicknameGT))
+	}
+	if f.NicknameGTE != nil {
+		preds = append(preds, pet.NicknameGTE(*f.NicknameGTE))
+	}
+	if f.NicknameLT != nil {
+		preds = append(preds, pet.NicknameLT(*f.NicknameLT))
+	}
+	if f.NicknameLTE != nil {
+		preds = append(preds, pet.NicknameLTE(*f.NicknameLTE))
+	}
+	if f.NicknameContains != nil {
+		preds = append(preds, pet.NicknameContains(*f.NicknameContains))
+	}
+	if f.NicknameHasPrefix != nil {
+		preds = append(preds, pet.NicknameHasPrefix(*f.NicknameHasPrefix))
+	}
+	if f.NicknameHasSuffix != nil {
+		preds = append(preds, pet.NicknameHasSuffix(*f.NicknameHasSuffix))
+	}
+	if f.NicknameIsNil {
+		preds = append(preds, pet.NicknameIsNil())
+	}
+	if f.NicknameNotNil {
+		preds = append(preds, pet.NicknameNotNil())
+	}
+	if f.NicknameEqualFold != nil {
+		preds = append(preds, pet.NicknameEqualFold(*f.NicknameEqualFold))
+	}
+	if f.NicknameContainsFold != nil {
+		preds = append(preds, pet.NicknameContainsFold(*f.NicknameContainsFold))
+	}
+	if f.NicknameHasPrefixFold != nil {
+		preds = append(preds, pet.NicknameHasPrefixFold(*f.NicknameHasPrefixFold))
+	}
+	if f.Trained != nil {
+		preds = append(preds, pet.TrainedEQ(*f.Trained))
+	}
+	if f.TrainedNEQ != nil {
+		preds = append(preds, pet.TrainedNEQ(*f.TrainedNEQ))
+	}
+	if f.HasTeam != nil {
+		p := pet.HasTeam()
+		if !*f.HasTeam {
+			p = pet.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasTeamWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasTeamWith))
+		for _, w := range f.HasTeamWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, pet.HasTeamWith(with...))
+	}
+	if f.HasOwner != nil {
+		p := pet.HasOwner()
+		if !*f.HasOwner {
+			p = pet.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasOwnerWith))
+		for _, w := range f.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, pet.HasOwnerWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return pet.And(preds...), nil
+	}
+}
+
+// SpecFilterInput is a typed filter of Spec entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the spec package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.SpecFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Spec.Query())
+type SpecFilterInput struct {
+	Not *SpecFilterInput   `json:"not,omitempty"`
+	And []*SpecFilterInput `json:"and,omitempty"`
+	Or  []*SpecFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "card" edge predicates.
+	HasCard     *bool              `json:"hasCard,omitempty"`
+	HasCardWith []*CardFilterInput `json:"hasCardWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *SpecFilterInput) Apply(q *SpecQuery) (*SpecQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *SpecFilterInput) P() (predicate.Spec, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Spec
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, spec.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*SpecFilterInput
+		join    func(...predicate.Spec) predicate.Spec
+	}{
+		{filters: f.And, join: spec.And},
+		{filters: f.Or, join: spec.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Spec, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, spec.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, spec.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, spec.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, spec.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, spec.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, spec.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, spec.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, spec.IDLTE(*f.IDLTE))
+	}
+	if f.HasCard != nil {
+		p := spec.HasCard()
+		if !*f.HasCard {
+			p = spec.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasCardWith) > 0 {
+		with := make([]predicate.Card, 0, len(f.HasCardWith))
+		for _, w := range f.HasCardWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, spec.HasCardWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return spec.And(preds...), nil
+	}
+}
+
+// TaskFilterInput is a typed filter of Task entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the enttask package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.TaskFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.Task.Query())
+type TaskFilterInput struct {
+	Not *TaskFilterInput   `json:"not,omitempty"`
+	And []*TaskFilterInput `json:"and,omitempty"`
+	Or  []*TaskFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "priority" field predicates.
+	Priority      *task.Priority  `json:"priority,omitempty"`
+	PriorityNEQ   *task.Priority  `json:"priorityNEQ,omitempty"`
+	PriorityIn    []task.Priority `json:"priorityIn,omitempty"`
+	PriorityNotIn []task.Priority `json:"priorityNotIn,omitempty"`
+	PriorityGT    *task.Priority  `json:"priorityGT,omitempty"`
+	PriorityGTE   *task.Priority  `json:"priorityGTE,omitempty"`
+	PriorityLT    *task.Priority  `json:"priorityLT,omitempty"`
+	PriorityLTE   *task.Priority  `json:"priorityLTE,omitempty"`
+
+	// "priorities" field predicates.
+	PrioritiesIsNil  bool `json:"prioritiesIsNil,omitempty"`
+	PrioritiesNotNil bool `json:"prioritiesNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameIsNil         bool     `json:"nameIsNil,omitempty"`
+	NameNotNil        bool     `json:"nameNotNil,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "owner" field predicates.
+	Owner              *string  `json:"owner,omitempty"`
+	OwnerNEQ           *string  `json:"ownerNEQ,omitempty"`
+	OwnerIn            []string `json:"ownerIn,omitempty"`
+	OwnerNotIn         []string `json:"ownerNotIn,omitempty"`
+	OwnerGT            *string  `json:"ownerGT,omitempty"`
+	OwnerGTE           *string  `json:"ownerGTE,omitempty"`
+	OwnerLT            *string  `json:"ownerLT,omitempty"`
+	OwnerLTE           *string  `json:"ownerLTE,omitempty"`
+	OwnerContains      *string  `json:"ownerContains,omitempty"`
+	OwnerHasPrefix     *string  `json:"ownerHasPrefix,omitempty"`
+	OwnerHasSuffix     *string  `json:"ownerHasSuffix,omitempty"`
+	OwnerIsNil         bool     `json:"ownerIsNil,omitempty"`
+	OwnerNotNil        bool     `json:"ownerNotNil,omitempty"`
+	OwnerEqualFold     *string  `json:"ownerEqualFold,omitempty"`
+	OwnerContainsFold  *string  `json:"ownerContainsFold,omitempty"`
+	OwnerHasPrefixFold *string  `json:"ownerHasPrefixFold,omitempty"`
+
+	// "order" field predicates.
+	Order       *int  `json:"order,omitempty"`
+	OrderNEQ    *int  `json:"orderNEQ,omitempty"`
+	OrderIn     []int `json:"orderIn,omitempty"`
+	OrderNotIn  []int `json:"orderNotIn,omitempty"`
+	OrderGT     *int  `json:"orderGT,omitempty"`
+	OrderGTE    *int  `json:"orderGTE,omitempty"`
+	OrderLT     *int  `json:"orderLT,omitempty"`
+	OrderLTE    *int  `json:"orderLTE,omitempty"`
+	OrderIsNil  bool  `json:"orderIsNil,omitempty"`
+	OrderNotNil bool  `json:"orderNotNil,omitempty"`
+
+	// "order_option" field predicates.
+	OrderOption       *int  `json:"orderOption,omitempty"`
+	OrderOptionNEQ    *int  `json:"orderOptionNEQ,omitempty"`
+	OrderOptionIn     []int `json:"orderOptionIn,omitempty"`
+	OrderOptionNotIn  []int `json:"orderOptionNotIn,omitempty"`
+	OrderOptionGT     *int  `json:"orderOptionGT,omitempty"`
+	OrderOptionGTE    *int  `json:"orderOptionGTE,omitempty"`
+	OrderOptionLT     *int  `json:"orderOptionLT,omitempty"`
+	OrderOptionLTE    *int  `json:"orderOptionLTE,omitempty"`
+	OrderOptionIsNil  bool  `json:"orderOptionIsNil,omitempty"`
+	OrderOptionNotNil bool  `json:"orderOptionNotNil,omitempty"`
+
+	// "op" field predicates.
+	Op              *string  `json:"op,omitempty"`
+	OpNEQ           *string  `json:"opNEQ,omitempty"`
+	OpIn            []string `json:"opIn,omitempty"`
+	OpNotIn         []string `json:"opNotIn,omitempty"`
+	OpGT            *string  `json:"opGT,omitempty"`
+	OpGTE           *string  `json:"opGTE,omitempty"`
+	OpLT            *string  `json:"opLT,omitempty"`
+	OpLTE           *string  `json:"opLTE,omitempty"`
+	OpContains      *string  `json:"opContains,omitempty"`
+	OpHasPrefix     *string  `json:"opHasPrefix,omitempty"`
+	OpHasSuffix     *string  `json:"opHasSuffix,omitempty"`
+	OpEqualFold     *string  `json:"opEqualFold,omitempty"`
+	OpContainsFold  *string  `json:"opContainsFold,omitempty"`
+	OpHasPrefixFold *string  `json:"opHasPrefixFold,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *TaskFilterInput) Apply(q *TaskQuery) (*TaskQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *TaskFilterInput) P() (predicate.Task, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.Task
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, enttask.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*TaskFilterInput
+		join    func(...predicate.Task) predicate.Task
+	}{
+		{filters: f.And, join: enttask.And},
+		{filters: f.Or, join: enttask.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.Task, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, enttask.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, enttask.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, enttask.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, enttask.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, enttask.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, enttask.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, enttask.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, enttask.IDLTE(*f.IDLTE))
+	}
+	if f.Priority != nil {
+		preds = append(preds, enttask.PriorityEQ(*f.Priority))
+	}
+	if f.PriorityNEQ != nil {
+		preds = append(preds, enttask.PriorityNEQ(*f.PriorityNEQ))
+	}
+	if f.PriorityIn != nil {
+		preds = append(preds, enttask.PriorityIn(f.PriorityIn...))
+	}
+	if f.PriorityNotIn != nil {
+		preds = append(preds, enttask.PriorityNotIn(f.PriorityNotIn...))
+	}
+	if f.PriorityGT != nil {
+		preds = append(preds, enttask.PriorityGT(*f.PriorityGT))
+	}
+	if f.PriorityGTE != nil {
+		preds = append(preds, enttask.PriorityGTE(*f.PriorityGTE))
+	}
+	if f.PriorityLT != nil {
+		preds = append(preds, enttask.PriorityLT(*f.PriorityLT))
+	}
+	if f.PriorityLTE != nil {
+		preds = append(preds, enttask.PriorityLTE(*f.PriorityLTE))
+	}
+	if f.PrioritiesIsNil {
+		preds = append(preds, enttask.PrioritiesIsNil())
+	}
+	if f.PrioritiesNotNil {
+		preds = append(preds, enttask.PrioritiesNotNil())
+	}
+	if f.CreatedAt != nil {
+		preds = append(preds, enttask.CreatedAtEQ(*f.CreatedAt))
+	}
+	if f.CreatedAtNEQ != nil {
+		preds = append(preds, enttask.CreatedAtNEQ(*f.CreatedAtNEQ))
+	}
+	if f.CreatedAtIn != nil {
+		preds = append(preds, enttask.CreatedAtIn(f.CreatedAtIn...))
+	}
+	if f.CreatedAtNotIn != nil {
+		preds = append(preds, enttask.CreatedAtNotIn(f.CreatedAtNotIn...))
+	}
+	if f.CreatedAtGT != nil {
+		preds = append(preds, enttask.CreatedAtGT(*f.CreatedAtGT))
+	}
+	if f.CreatedAtGTE != nil {
+		preds = append(preds, enttask.CreatedAtGTE(*f.CreatedAtGTE))
+	}
+	if f.CreatedAtLT != nil {
+		preds = append(preds, enttask.CreatedAtLT(*f.CreatedAtLT))
+	}
+	if f.CreatedAtLTE != nil {
+		preds = append(preds, enttask.CreatedAtLTE(*f.CreatedAtLTE))
+	}
+	if f.Name != nil {
+		preds = append(preds, enttask.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, enttask.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, enttask.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, enttask.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, enttask.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, enttask.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, enttask.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, enttask.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, enttask.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, enttask.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, enttask.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameIsNil {
+		preds = append(preds, enttask.NameIsNil())
+	}
+	if f.NameNotNil {
+		preds = append(preds, enttask.NameNotNil())
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, enttask.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, enttask.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, enttask.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.Owner != nil {
+		preds = append(preds, enttask.OwnerEQ(*f.Owner))
+	}
+	if f.OwnerNEQ != nil {
+		preds = append(preds, enttask.OwnerNEQ(*f.OwnerNEQ))
+	}
+	if f.OwnerIn != nil {
+		preds = append(preds, enttask.OwnerIn(f.OwnerIn...))
+	}
+	if f.OwnerNotIn != nil {
+		preds = append(preds, enttask.OwnerNotIn(f.OwnerNotIn...))
+	}
+	if f.OwnerGT != nil {
+		preds = append(preds, enttask.OwnerGT(*f.OwnerGT))
+	}
+	if f.OwnerGTE != nil {
+		preds = append(preds, enttask.OwnerGTE(*f.OwnerGTE))
+	}
+	if f.OwnerLT != nil {
+		preds = append(preds, enttask.OwnerLT(*f.OwnerLT))
+	}
+	if f.OwnerLTE != nil {
+		preds = append(preds, enttask.OwnerLTE(*f.OwnerLTE))
+	}
+	if f.OwnerContains != nil {
+		preds = append(preds, enttask.OwnerContains(*f.OwnerContains))
+	}
+	if f.OwnerHasPrefix != nil {
+		preds = append(preds, enttask.OwnerHasPrefix(*f.OwnerHasPrefix))
+	}
+	if f.OwnerHasSuffix != nil {
+		preds = append(preds, enttask.OwnerHasSuffix(*f.OwnerHasSuffix))
+	}
+	if f.OwnerIsNil {
+		preds = append(preds, enttask.OwnerIsNil())
+	}
+	if f.OwnerNotNil {
+		preds = append(preds, enttask.OwnerNotNil())
+	}
+	if f.OwnerEqualFold != nil {
+		preds = append(preds, enttask.OwnerEqualFold(*f.OwnerEqualFold))
+	}
+	if f.OwnerContainsFold != nil {
+		preds = append(preds, enttask.OwnerContainsFold(*f.OwnerContainsFold))
+	}
+	if f.OwnerHasPrefixFold != nil {
+		preds = append(preds, enttask.OwnerHasPrefixFold(*f.OwnerHasPrefixFold))
+	}
+	if f.Order != nil {
+		preds = append(preds, enttask.OrderEQ(*f.Order))
+	}
+	if f.OrderNEQ != nil {
+		preds = append(preds, enttask.OrderNEQ(*f.OrderNEQ))
+	}
+	if f.OrderIn != nil {
+		preds = append(preds, enttask.OrderIn(f.OrderIn...))
+	}
+	if f.OrderNotIn != nil {
+		preds = append(preds, enttask.OrderNotIn(f.OrderNotIn...))
+	}
+	if f.OrderGT != nil {
+		preds = append(preds, enttask.OrderGT(*f.OrderGT))
+	}
+	if f.OrderGTE != nil {
+		preds = append(preds, enttask.OrderGTE(*f.OrderGTE))
+	}
+	if f.OrderLT != nil {
+		preds = append(preds, enttask.OrderLT(*f.OrderLT))
+	}
+	if f.OrderLTE != nil {
+		preds = append(preds, enttask.OrderLTE(*f.OrderLTE))
+	}
+	if f.OrderIsNil {
+		preds = append(preds, enttask.OrderIsNil())
+	}
+	if f.OrderNotNil {
+		preds = append(preds, enttask.OrderNotNil())
+	}
+	if f.OrderOption != nil {
+		preds = append(preds, enttask.OrderOptionEQ(*f.OrderOption))
+	}
+	if f.OrderOptionNEQ != nil {
+		preds = append(preds, enttask.OrderOptionNEQ(*f.OrderOptionNEQ))
+	}
+	if f.OrderOptionIn != nil {
+		preds = append(preds, enttask.OrderOptionIn(f.OrderOptionIn...))
+	}
+	if f.OrderOptionNotIn != nil {
+		preds = append(preds, enttask.OrderOptionNotIn(f.OrderOptionNotIn...))
+	}
+	if f.OrderOptionGT != nil {
+		preds = append(preds, enttask.OrderOptionGT(*f.OrderOptionGT))
+	}
+	if f.OrderOptionGTE != nil {
+		preds = append(preds, enttask.OrderOptionGTE(*f.OrderOptionGTE))
+	}
+	if f.OrderOptionLT != nil {
+		preds = append(preds, enttask.OrderOptionLT(*f.OrderOptionLT))
+	}
+	if f.OrderOptionLTE != nil {
+		preds = append(preds, enttask.OrderOptionLTE(*f.OrderOptionLTE))
+	}
+	if f.OrderOptionIsNil {
+		preds = append(preds, enttask.OrderOptionIsNil())
+	}
+	if f.OrderOptionNotNil {
+		preds = append(preds, enttask.OrderOptionNotNil())
+	}
+	if f.Op != nil {
+		preds = append(preds, enttask.OpEQ(*f.Op))
+	}
+	if f.OpNEQ != nil {
+		preds = append(preds, enttask.OpNEQ(*f.OpNEQ))
+	}
+	if f.OpIn != nil {
+		preds = append(preds, enttask.OpIn(f.OpIn...))
+	}
+	if f.OpNotIn != nil {
+		preds = append(preds, enttask.OpNotIn(f.OpNotIn...))
+	}
+	if f.OpGT != nil {
+		preds = append(preds, enttask.OpGT(*f.OpGT))
+	}
+	if f.OpGTE != nil {
+		preds = append(preds, enttask.OpGTE(*f.OpGTE))
+	}
+	if f.OpLT != nil {
+		preds = append(preds, enttask.OpLT(*f.OpLT))
+	}
+	if f.OpLTE != nil {
+		preds = append(preds, enttask.OpLTE(*f.OpLTE))
+	}
+	if f.OpContains != nil {
+		preds = append(preds, enttask.OpContains(*f.OpContains))
+	}
+	if f.OpHasPrefix != nil {
+		preds = append(preds, enttask.OpHasPrefix(*f.OpHasPrefix))
+	}
+	if f.OpHasSuffix != nil {
+		preds = append(preds, enttask.OpHasSuffix(*f.OpHasSuffix))
+	}
+	if f.OpEqualFold != nil {
+		preds = append(preds, enttask.OpEqualFold(*f.OpEqualFold))
+	}
+	if f.OpContainsFold != nil {
+		preds = append(preds, enttask.OpContainsFold(*f.OpContainsFold))
+	}
+	if f.OpHasPrefixFold != nil {
+		preds = append(preds, enttask.OpHasPrefixFold(*f.OpHasPrefixFold))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return enttask.And(preds...), nil
+	}
+}
+
+// UserFilterInput is a typed filter of User entities, that can be decoded from JSON or bound to a GraphQL
+// input, and mapped to the predicates of the user package. All conditions that are set on the filter
+// must match, and its And, Or and Not fields allow composing other filters. Sensitive fields cannot be filtered.
+//
+//	var f ent.UserFilterInput
+//	if err := json.NewDecoder(r.Body).Decode(&f); err != nil {
+//		return err
+//	}
+//	q, err := f.Apply(client.User.Query())
+type UserFilterInput struct {
+	Not *UserFilterInput   `json:"not,omitempty"`
+	And []*UserFilterInput `json:"and,omitempty"`
+	Or  []*UserFilterInput `json:"or,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "optional_int" field predicates.
+	OptionalInt       *int  `json:"optionalInt,omitempty"`
+	OptionalIntNEQ    *int  `json:"optionalIntNEQ,omitempty"`
+	OptionalIntIn     []int `json:"optionalIntIn,omitempty"`
+	OptionalIntNotIn  []int `json:"optionalIntNotIn,omitempty"`
+	OptionalIntGT     *int  `json:"optionalIntGT,omitempty"`
+	OptionalIntGTE    *int  `json:"optionalIntGTE,omitempty"`
+	OptionalIntLT     *int  `json:"optionalIntLT,omitempty"`
+	OptionalIntLTE    *int  `json:"optionalIntLTE,omitempty"`
+	OptionalIntIsNil  bool  `json:"optionalIntIsNil,omitempty"`
+	OptionalIntNotNil bool  `json:"optionalIntNotNil,omitempty"`
+
+	// "age" field predicates.
+	Age      *int  `json:"age,omitempty"`
+	AgeNEQ   *int  `json:"ageNEQ,omitempty"`
+	AgeIn    []int `json:"ageIn,omitempty"`
+	AgeNotIn []int `json:"ageNotIn,omitempty"`
+	AgeGT    *int  `json:"ageGT,omitempty"`
+	AgeGTE   *int  `json:"ageGTE,omitempty"`
+	AgeLT    *int  `json:"ageLT,omitempty"`
+	AgeLTE   *int  `json:"ageLTE,omitempty"`
+
+	// "name" field predicates.
+	Name              *string  `json:"name,omitempty"`
+	NameNEQ           *string  `json:"nameNEQ,omitempty"`
+	NameIn            []string `json:"nameIn,omitempty"`
+	NameNotIn         []string `json:"nameNotIn,omitempty"`
+	NameGT            *string  `json:"nameGT,omitempty"`
+	NameGTE           *string  `json:"nameGTE,omitempty"`
+	NameLT            *string  `json:"nameLT,omitempty"`
+	NameLTE           *string  `json:"nameLTE,omitempty"`
+	NameContains      *string  `json:"nameContains,omitempty"`
+	NameHasPrefix     *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix     *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold     *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold  *string  `json:"nameContainsFold,omitempty"`
+	NameHasPrefixFold *string  `json:"nameHasPrefixFold,omitempty"`
+
+	// "last" field predicates.
+	Last              *string  `json:"last,omitempty"`
+	LastNEQ           *string  `json:"lastNEQ,omitempty"`
+	LastIn            []string `json:"lastIn,omitempty"`
+	LastNotIn         []string `json:"lastNotIn,omitempty"`
+	LastGT            *string  `json:"lastGT,omitempty"`
+	LastGTE           *string  `json:"lastGTE,omitempty"`
+	LastLT            *string  `json:"lastLT,omitempty"`
+	LastLTE           *string  `json:"lastLTE,omitempty"`
+	LastContains      *string  `json:"lastContains,omitempty"`
+	LastHasPrefix     *string  `json:"lastHasPrefix,omitempty"`
+	LastHasSuffix     *string  `json:"lastHasSuffix,omitempty"`
+	LastEqualFold     *string  `json:"lastEqualFold,omitempty"`
+	LastContainsFold  *string  `json:"lastContainsFold,omitempty"`
+	LastHasPrefixFold *string  `json:"lastHasPrefixFold,omitempty"`
+
+	// "nickname" field predicates.
+	Nickname              *string  `json:"nickname,omitempty"`
+	NicknameNEQ           *string  `json:"nicknameNEQ,omitempty"`
+	NicknameIn            []string `json:"nicknameIn,omitempty"`
+	NicknameNotIn         []string `json:"nicknameNotIn,omitempty"`
+	NicknameGT            *string  `json:"nicknameGT,omitempty"`
+	NicknameGTE           *string  `json:"nicknameGTE,omitempty"`
+	NicknameLT            *string  `json:"nicknameLT,omitempty"`
+	NicknameLTE           *string  `json:"nicknameLTE,omitempty"`
+	NicknameContains      *string  `json:"nicknameContains,omitempty"`
+	NicknameHasPrefix     *string  `json:"nicknameHasPrefix,omitempty"`
+	NicknameHasSuffix     *string  `json:"nicknameHasSuffix,omitempty"`
+	NicknameIsNil         bool     `json:"nicknameIsNil,omitempty"`
+	NicknameNotNil        bool     `json:"nicknameNotNil,omitempty"`
+	NicknameEqualFold     *string  `json:"nicknameEqualFold,omitempty"`
+	NicknameContainsFold  *string  `json:"nicknameContainsFold,omitempty"`
+	NicknameHasPrefixFold *string  `json:"nicknameHasPrefixFold,omitempty"`
+
+	// "address" field predicates.
+	Address              *string  `json:"address,omitempty"`
+	AddressNEQ           *string  `json:"addressNEQ,omitempty"`
+	AddressIn            []string `json:"addressIn,omitempty"`
+	AddressNotIn         []string `json:"addressNotIn,omitempty"`
+	AddressGT            *string  `json:"addressGT,omitempty"`
+	AddressGTE           *string  `json:"addressGTE,omitempty"`
+	AddressLT            *string  `json:"addressLT,omitempty"`
+	AddressLTE           *string  `json:"addressLTE,omitempty"`
+	AddressContains      *string  `json:"addressContains,omitempty"`
+	AddressHasPrefix     *string  `json:"addressHasPrefix,omitempty"`
+	AddressHasSuffix     *string  `json:"addressHasSuffix,omitempty"`
+	AddressIsNil         bool     `json:"addressIsNil,omitempty"`
+	AddressNotNil        bool     `json:"addressNotNil,omitempty"`
+	AddressEqualFold     *string  `json:"addressEqualFold,omitempty"`
+	AddressContainsFold  *string  `json:"addressContainsFold,omitempty"`
+	AddressHasPrefixFold *string  `json:"addressHasPrefixFold,omitempty"`
+
+	// "phone" field predicates.
+	Phone              *string  `json:"phone,omitempty"`
+	PhoneNEQ           *string  `json:"phoneNEQ,omitempty"`
+	PhoneIn            []string `json:"phoneIn,omitempty"`
+	PhoneNotIn         []string `json:"phoneNotIn,omitempty"`
+	PhoneGT            *string  `json:"phoneGT,omitempty"`
+	PhoneGTE           *string  `json:"phoneGTE,omitempty"`
+	PhoneLT            *string  `json:"phoneLT,omitempty"`
+	PhoneLTE           *string  `json:"phoneLTE,omitempty"`
+	PhoneContains      *string  `json:"phoneContains,omitempty"`
+	PhoneHasPrefix     *string  `json:"phoneHasPrefix,omitempty"`
+	PhoneHasSuffix     *string  `json:"phoneHasSuffix,omitempty"`
+	PhoneIsNil         bool     `json:"phoneIsNil,omitempty"`
+	PhoneNotNil        bool     `json:"phoneNotNil,omitempty"`
+	PhoneEqualFold     *string  `json:"phoneEqualFold,omitempty"`
+	PhoneContainsFold  *string  `json:"phoneContainsFold,omitempty"`
+	PhoneHasPrefixFold *string  `json:"phoneHasPrefixFold,omitempty"`
+
+	// "role" field predicates.
+	Role      *user.Role  `json:"role,omitempty"`
+	RoleNEQ   *user.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []user.Role `json:"roleIn,omitempty"`
+	RoleNotIn []user.Role `json:"roleNotIn,omitempty"`
+
+	// "employment" field predicates.
+	Employment      *user.Employment  `json:"employment,omitempty"`
+	EmploymentNEQ   *user.Employment  `json:"employmentNEQ,omitempty"`
+	EmploymentIn    []user.Employment `json:"employmentIn,omitempty"`
+	EmploymentNotIn []user.Employment `json:"employmentNotIn,omitempty"`
+
+	// "SSOCert" field predicates.
+	SSOCert              *string  `json:"ssocert,omitempty"`
+	SSOCertNEQ           *string  `json:"ssocertNEQ,omitempty"`
+	SSOCertIn            []string `json:"ssocertIn,omitempty"`
+	SSOCertNotIn         []string `json:"ssocertNotIn,omitempty"`
+	SSOCertGT            *string  `json:"ssocertGT,omitempty"`
+	SSOCertGTE           *string  `json:"ssocertGTE,omitempty"`
+	SSOCertLT            *string  `json:"ssocertLT,omitempty"`
+	SSOCertLTE           *string  `json:"ssocertLTE,omitempty"`
+	SSOCertContains      *string  `json:"ssocertContains,omitempty"`
+	SSOCertHasPrefix     *string  `json:"ssocertHasPrefix,omitempty"`
+	SSOCertHasSuffix     *string  `json:"ssocertHasSuffix,omitempty"`
+	SSOCertIsNil         bool     `json:"ssocertIsNil,omitempty"`
+	SSOCertNotNil        bool     `json:"ssocertNotNil,omitempty"`
+	SSOCertEqualFold     *string  `json:"ssocertEqualFold,omitempty"`
+	SSOCertContainsFold  *string  `json:"ssocertContainsFold,omitempty"`
+	SSOCertHasPrefixFold *string  `json:"ssocertHasPrefixFold,omitempty"`
+
+	// "files_count" field predicates.
+	FilesCount       *int  `json:"filesCount,omitempty"`
+	FilesCountNEQ    *int  `json:"filesCountNEQ,omitempty"`
+	FilesCountIn     []int `json:"filesCountIn,omitempty"`
+	FilesCountNotIn  []int `json:"filesCountNotIn,omitempty"`
+	FilesCountGT     *int  `json:"filesCountGT,omitempty"`
+	FilesCountGTE    *int  `json:"filesCountGTE,omitempty"`
+	FilesCountLT     *int  `json:"filesCountLT,omitempty"`
+	FilesCountLTE    *int  `json:"filesCountLTE,omitempty"`
+	FilesCountIsNil  bool  `json:"filesCountIsNil,omitempty"`
+	FilesCountNotNil bool  `json:"filesCountNotNil,omitempty"`
+
+	// "card" edge predicates.
+	HasCard     *bool              `json:"hasCard,omitempty"`
+	HasCardWith []*CardFilterInput `json:"hasCardWith,omitempty"`
+
+	// "pets" edge predicates.
+	HasPets     *bool             `json:"hasPets,omitempty"`
+	HasPetsWith []*PetFilterInput `json:"hasPetsWith,omitempty"`
+
+	// "files" edge predicates.
+	HasFiles     *bool              `json:"hasFiles,omitempty"`
+	HasFilesWith []*FileFilterInput `json:"hasFilesWith,omitempty"`
+
+	// "groups" edge predicates.
+	HasGroups     *bool               `json:"hasGroups,omitempty"`
+	HasGroupsWith []*GroupFilterInput `json:"hasGroupsWith,omitempty"`
+
+	// "friends" edge predicates.
+	HasFriends     *bool              `json:"hasFriends,omitempty"`
+	HasFriendsWith []*UserFilterInput `json:"hasFriendsWith,omitempty"`
+
+	// "followers" edge predicates.
+	HasFollowers     *bool              `json:"hasFollowers,omitempty"`
+	HasFollowersWith []*UserFilterInput `json:"hasFollowersWith,omitempty"`
+
+	// "following" edge predicates.
+	HasFollowing     *bool              `json:"hasFollowing,omitempty"`
+	HasFollowingWith []*UserFilterInput `json:"hasFollowingWith,omitempty"`
+
+	// "team" edge predicates.
+	HasTeam     *bool             `json:"hasTeam,omitempty"`
+	HasTeamWith []*PetFilterInput `json:"hasTeamWith,omitempty"`
+
+	// "spouse" edge predicates.
+	HasSpouse     *bool              `json:"hasSpouse,omitempty"`
+	HasSpouseWith []*UserFilterInput `json:"hasSpouseWith,omitempty"`
+
+	// "children" edge predicates.
+	HasChildren     *bool              `json:"hasChildren,omitempty"`
+	HasChildrenWith []*UserFilterInput `json:"hasChildrenWith,omitempty"`
+
+	// "parent" edge predicates.
+	HasParent     *bool              `json:"hasParent,omitempty"`
+	HasParentWith []*UserFilterInput `json:"hasParentWith,omitempty"`
+}
+
+// Apply applies the predicate of the filter on the given query.
+// It fails if the filter holds invalid values, like unknown enum values.
+func (f *UserFilterInput) Apply(q *UserQuery) (*UserQuery, error) {
+	p, err := f.P()
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		q.Where(p)
+	}
+	return q, nil
+}
+
+// P returns the predicate of the filter, or nil if the filter is empty.
+func (f *UserFilterInput) P() (predicate.User, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var preds []predicate.User
+	if f.Not != nil {
+		p, err := f.Not.P()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			preds = append(preds, user.Not(p))
+		}
+	}
+	for _, list := range []struct {
+		filters []*UserFilterInput
+		join    func(...predicate.User) predicate.User
+	}{
+		{filters: f.And, join: user.And},
+		{filters: f.Or, join: user.Or},
+	} {
+		if len(list.filters) == 0 {
+			continue
+		}
+		ps := make([]predicate.User, 0, len(list.filters))
+		for _, sub := range list.filters {
+			p, err := sub.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				ps = append(ps, p)
+			}
+		}
+		if len(ps) > 0 {
+			preds = append(preds, list.join(ps...))
+		}
+	}
+	if f.ID != nil {
+		preds = append(preds, user.IDEQ(*f.ID))
+	}
+	if f.IDNEQ != nil {
+		preds = append(preds, user.IDNEQ(*f.IDNEQ))
+	}
+	if f.IDIn != nil {
+		preds = append(preds, user.IDIn(f.IDIn...))
+	}
+	if f.IDNotIn != nil {
+		preds = append(preds, user.IDNotIn(f.IDNotIn...))
+	}
+	if f.IDGT != nil {
+		preds = append(preds, user.IDGT(*f.IDGT))
+	}
+	if f.IDGTE != nil {
+		preds = append(preds, user.IDGTE(*f.IDGTE))
+	}
+	if f.IDLT != nil {
+		preds = append(preds, user.IDLT(*f.IDLT))
+	}
+	if f.IDLTE != nil {
+		preds = append(preds, user.IDLTE(*f.IDLTE))
+	}
+	if f.OptionalInt != nil {
+		preds = append(preds, user.OptionalIntEQ(*f.OptionalInt))
+	}
+	if f.OptionalIntNEQ != nil {
+		preds = append(preds, user.OptionalIntNEQ(*f.OptionalIntNEQ))
+	}
+	if f.OptionalIntIn != nil {
+		preds = append(preds, user.OptionalIntIn(f.OptionalIntIn...))
+	}
+	if f.OptionalIntNotIn != nil {
+		preds = append(preds, user.OptionalIntNotIn(f.OptionalIntNotIn...))
+	}
+	if f.OptionalIntGT != nil {
+		preds = append(preds, user.OptionalIntGT(*f.OptionalIntGT))
+	}
+	if f.OptionalIntGTE != nil {
+		preds = append(preds, user.OptionalIntGTE(*f.OptionalIntGTE))
+	}
+	if f.OptionalIntLT != nil {
+		preds = append(preds, user.OptionalIntLT(*f.OptionalIntLT))
+	}
+	if f.OptionalIntLTE != nil {
+		preds = append(preds, user.OptionalIntLTE(*f.OptionalIntLTE))
+	}
+	if f.OptionalIntIsNil {
+		preds = append(preds, user.OptionalIntIsNil())
+	}
+	if f.OptionalIntNotNil {
+		preds = append(preds, user.OptionalIntNotNil())
+	}
+	if f.Age != nil {
+		preds = append(preds, user.AgeEQ(*f.Age))
+	}
+	if f.AgeNEQ != nil {
+		preds = append(preds, user.AgeNEQ(*f.AgeNEQ))
+	}
+	if f.AgeIn != nil {
+		preds = append(preds, user.AgeIn(f.AgeIn...))
+	}
+	if f.AgeNotIn != nil {
+		preds = append(preds, user.AgeNotIn(f.AgeNotIn...))
+	}
+	if f.AgeGT != nil {
+		preds = append(preds, user.AgeGT(*f.AgeGT))
+	}
+	if f.AgeGTE != nil {
+		preds = append(preds, user.AgeGTE(*f.AgeGTE))
+	}
+	if f.AgeLT != nil {
+		preds = append(preds, user.AgeLT(*f.AgeLT))
+	}
+	if f.AgeLTE != nil {
+		preds = append(preds, user.AgeLTE(*f.AgeLTE))
+	}
+	if f.Name != nil {
+		preds = append(preds, user.NameEQ(*f.Name))
+	}
+	if f.NameNEQ != nil {
+		preds = append(preds, user.NameNEQ(*f.NameNEQ))
+	}
+	if f.NameIn != nil {
+		preds = append(preds, user.NameIn(f.NameIn...))
+	}
+	if f.NameNotIn != nil {
+		preds = append(preds, user.NameNotIn(f.NameNotIn...))
+	}
+	if f.NameGT != nil {
+		preds = append(preds, user.NameGT(*f.NameGT))
+	}
+	if f.NameGTE != nil {
+		preds = append(preds, user.NameGTE(*f.NameGTE))
+	}
+	if f.NameLT != nil {
+		preds = append(preds, user.NameLT(*f.NameLT))
+	}
+	if f.NameLTE != nil {
+		preds = append(preds, user.NameLTE(*f.NameLTE))
+	}
+	if f.NameContains != nil {
+		preds = append(preds, user.NameContains(*f.NameContains))
+	}
+	if f.NameHasPrefix != nil {
+		preds = append(preds, user.NameHasPrefix(*f.NameHasPrefix))
+	}
+	if f.NameHasSuffix != nil {
+		preds = append(preds, user.NameHasSuffix(*f.NameHasSuffix))
+	}
+	if f.NameEqualFold != nil {
+		preds = append(preds, user.NameEqualFold(*f.NameEqualFold))
+	}
+	if f.NameContainsFold != nil {
+		preds = append(preds, user.NameContainsFold(*f.NameContainsFold))
+	}
+	if f.NameHasPrefixFold != nil {
+		preds = append(preds, user.NameHasPrefixFold(*f.NameHasPrefixFold))
+	}
+	if f.Last != nil {
+		preds = append(preds, user.LastEQ(*f.Last))
+	}
+	if f.LastNEQ != nil {
+		preds = append(preds, user.LastNEQ(*f.LastNEQ))
+	}
+	if f.LastIn != nil {
+		preds = append(preds, user.LastIn(f.LastIn...))
+	}
+	if f.LastNotIn != nil {
+		preds = append(preds, user.LastNotIn(f.LastNotIn...))
+	}
+	if f.LastGT != nil {
+		preds = append(preds, user.LastGT(*f.LastGT))
+	}
+	if f.LastGTE != nil {
+		preds = append(preds, user.LastGTE(*f.LastGTE))
+	}
+	if f.LastLT != nil {
+		preds = append(preds, user.LastLT(*f.LastLT))
+	}
+	if f.LastLTE != nil {
+		preds = append(preds, user.LastLTE(*f.LastLTE))
+	}
+	if f.LastContains != nil {
+		preds = append(preds, user.LastContains(*f.LastContains))
+	}
+	if f.LastHasPrefix != nil {
+		preds = append(preds, user.LastHasPrefix(*f.LastHasPrefix))
+	}
+	if f.LastHasSuffix != nil {
+		preds = append(preds, user.LastHasSuffix(*f.LastHasSuffix))
+	}
+	if f.LastEqualFold != nil {
+		preds = append(preds, user.LastEqualFold(*f.LastEqualFold))
+	}
+	if f.LastContainsFold != nil {
+		preds = append(preds, user.LastContainsFold(*f.LastContainsFold))
+	}
+	if f.LastHasPrefixFold != nil {
+		preds = append(preds, user.LastHasPrefixFold(*f.LastHasPrefixFold))
+	}
+	if f.Nickname != nil {
+		preds = append(preds, user.NicknameEQ(*f.Nickname))
+	}
+	if f.NicknameNEQ != nil {
+		preds = append(preds, user.NicknameNEQ(*f.NicknameNEQ))
+	}
+	if f.NicknameIn != nil {
+		preds = append(preds, user.NicknameIn(f.NicknameIn...))
+	}
+	if f.NicknameNotIn != nil {
+		preds = append(preds, user.NicknameNotIn(f.NicknameNotIn...))
+	}
+	if f.NicknameGT != nil {
+		preds = append(preds, user.NicknameGT(*f.NicknameGT))
+	}
+	if f.NicknameGTE != nil {
+		preds = append(preds, user.NicknameGTE(*f.NicknameGTE))
+	}
+	if f.NicknameLT != nil {
+		preds = append(preds, user.NicknameLT(*f.NicknameLT))
+	}
+	if f.NicknameLTE != nil {
+		preds = append(preds, user.NicknameLTE(*f.NicknameLTE))
+	}
+	if f.NicknameContains != nil {
+		preds = append(preds, user.NicknameContains(*f.NicknameContains))
+	}
+	if f.NicknameHasPrefix != nil {
+		preds = append(preds, user.NicknameHasPrefix(*f.NicknameHasPrefix))
+	}
+	if f.NicknameHasSuffix != nil {
+		preds = append(preds, user.NicknameHasSuffix(*f.NicknameHasSuffix))
+	}
+	if f.NicknameIsNil {
+		preds = append(preds, user.NicknameIsNil())
+	}
+	if f.NicknameNotNil {
+		preds = append(preds, user.NicknameNotNil())
+	}
+	if f.NicknameEqualFold != nil {
+		preds = append(preds, user.NicknameEqualFold(*f.NicknameEqualFold))
+	}
+	if f.NicknameContainsFold != nil {
+		preds = append(preds, user.NicknameContainsFold(*f.NicknameContainsFold))
+	}
+	if f.NicknameHasPrefixFold != nil {
+		preds = append(preds, user.NicknameHasPrefixFold(*f.NicknameHasPrefixFold))
+	}
+	if f.Address != nil {
+		preds = append(preds, user.AddressEQ(*f.Address))
+	}
+	if f.AddressNEQ != nil {
+		preds = append(preds, user.AddressNEQ(*f.AddressNEQ))
+	}
+	if f.AddressIn != nil {
+		preds = append(preds, user.AddressIn(f.AddressIn...))
+	}
+	if f.AddressNotIn != nil {
+		preds = append(preds, user.AddressNotIn(f.AddressNotIn...))
+	}
+	if f.AddressGT != nil {
+		preds = append(preds, user.AddressGT(*f.AddressGT))
+	}
+	if f.AddressGTE != nil {
+		preds = append(preds, user.AddressGTE(*f.AddressGTE))
+	}
+	if f.AddressLT != nil {
+		preds = append(preds, user.AddressLT(*f.AddressLT))
+	}
+	if f.AddressLTE != nil {
+		preds = append(preds, user.AddressLTE(*f.AddressLTE))
+	}
+	if f.AddressContains != nil {
+		preds = append(preds, user.AddressContains(*f.AddressContains))
+	}
+	if f.AddressHasPrefix != nil {
+		preds = append(preds, user.AddressHasPrefix(*f.AddressHasPrefix))
+	}
+	if f.AddressHasSuffix != nil {
+		preds = append(preds, user.AddressHasSuffix(*f.AddressHasSuffix))
+	}
+	if f.AddressIsNil {
+		preds = append(preds, user.AddressIsNil())
+	}
+	if f.AddressNotNil {
+		preds = append(preds, user.AddressNotNil())
+	}
+	if f.AddressEqualFold != nil {
+		preds = append(preds, user.AddressEqualFold(*f.AddressEqualFold))
+	}
+	if f.AddressContainsFold != nil {
+		preds = append(preds, user.AddressContainsFold(*f.AddressContainsFold))
+	}
+	if f.AddressHasPrefixFold != nil {
+		preds = append(preds, user.AddressHasPrefixFold(*f.AddressHasPrefixFold))
+	}
+	if f.Phone != nil {
+		preds = append(preds, user.PhoneEQ(*f.Phone))
+	}
+	if f.PhoneNEQ != nil {
+		preds = append(preds, user.PhoneNEQ(*f.PhoneNEQ))
+	}
+	if f.PhoneIn != nil {
+		preds = append(preds, user.PhoneIn(f.PhoneIn...))
+	}
+	if f.PhoneNotIn != nil {
+		preds = append(preds, user.PhoneNotIn(f.PhoneNotIn...))
+	}
+	if f.PhoneGT != nil {
+		preds = append(preds, user.PhoneGT(*f.PhoneGT))
+	}
+	if f.PhoneGTE != nil {
+		preds = append(preds, user.PhoneGTE(*f.PhoneGTE))
+	}
+	if f.PhoneLT != nil {
+		preds = append(preds, user.PhoneLT(*f.PhoneLT))
+	}
+	if f.PhoneLTE != nil {
+		preds = append(preds, user.PhoneLTE(*f.PhoneLTE))
+	}
+	if f.PhoneContains != nil {
+		preds = append(preds, user.PhoneContains(*f.PhoneContains))
+	}
+	if f.PhoneHasPrefix != nil {
+		preds = append(preds, user.PhoneHasPrefix(*f.PhoneHasPrefix))
+	}
+	if f.PhoneHasSuffix != nil {
+		preds = append(preds, user.PhoneHasSuffix(*f.PhoneHasSuffix))
+	}
+	if f.PhoneIsNil {
+		preds = append(preds, user.PhoneIsNil())
+	}
+	if f.PhoneNotNil {
+		preds = append(preds, user.PhoneNotNil())
+	}
+	if f.PhoneEqualFold != nil {
+		preds = append(preds, user.PhoneEqualFold(*f.PhoneEqualFold))
+	}
+	if f.PhoneContainsFold != nil {
+		preds = append(preds, user.PhoneContainsFold(*f.PhoneContainsFold))
+	}
+	if f.PhoneHasPrefixFold != nil {
+		preds = append(preds, user.PhoneHasPrefixFold(*f.PhoneHasPrefixFold))
+	}
+	if f.Role != nil {
+		for _, v := range []user.Role{*f.Role} {
+			if err := user.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.role": %w`, err)}
+			}
+		}
+	}
+	if f.Role != nil {
+		preds = append(preds, user.RoleEQ(*f.Role))
+	}
+	if f.RoleNEQ != nil {
+		for _, v := range []user.Role{*f.RoleNEQ} {
+			if err := user.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.role": %w`, err)}
+			}
+		}
+	}
+	if f.RoleNEQ != nil {
+		preds = append(preds, user.RoleNEQ(*f.RoleNEQ))
+	}
+	if f.RoleIn != nil {
+		for _, v := range f.RoleIn {
+			if err := user.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.role": %w`, err)}
+			}
+		}
+	}
+	if f.RoleIn != nil {
+		preds = append(preds, user.RoleIn(f.RoleIn...))
+	}
+	if f.RoleNotIn != nil {
+		for _, v := range f.RoleNotIn {
+			if err := user.RoleValidator(v); err != nil {
+				return nil, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.role": %w`, err)}
+			}
+		}
+	}
+	if f.RoleNotIn != nil {
+		preds = append(preds, user.RoleNotIn(f.RoleNotIn...))
+	}
+	if f.Employment != nil {
+		for _, v := range []user.Employment{*f.Employment} {
+			if err := user.EmploymentValidator(v); err != nil {
+				return nil, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.employment": %w`, err)}
+			}
+		}
+	}
+	if f.Employment != nil {
+		preds = append(preds, user.EmploymentEQ(*f.Employment))
+	}
+	if f.EmploymentNEQ != nil {
+		for _, v := range []user.Employment{*f.EmploymentNEQ} {
+			if err := user.EmploymentValidator(v); err != nil {
+				return nil, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.employment": %w`, err)}
+			}
+		}
+	}
+	if f.EmploymentNEQ != nil {
+		preds = append(preds, user.EmploymentNEQ(*f.EmploymentNEQ))
+	}
+	if f.EmploymentIn != nil {
+		for _, v := range f.EmploymentIn {
+			if err := user.EmploymentValidator(v); err != nil {
+				return nil, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.employment": %w`, err)}
+			}
+		}
+	}
+	if f.EmploymentIn != nil {
+		preds = append(preds, user.EmploymentIn(f.EmploymentIn...))
+	}
+	if f.EmploymentNotIn != nil {
+		for _, v := range f.EmploymentNotIn {
+			if err := user.EmploymentValidator(v); err != nil {
+				return nil, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: err.Error(), err: fmt.Errorf(`ent: invalid filter value for field "User.employment": %w`, err)}
+			}
+		}
+	}
+	if f.EmploymentNotIn != nil {
+		preds = append(preds, user.EmploymentNotIn(f.EmploymentNotIn...))
+	}
+	if f.SSOCert != nil {
+		preds = append(preds, user.SSOCertEQ(*f.SSOCert))
+	}
+	if f.SSOCertNEQ != nil {
+		preds = append(preds, user.SSOCertNEQ(*f.SSOCertNEQ))
+	}
+	if f.SSOCertIn != nil {
+		preds = append(preds, user.SSOCertIn(f.SSOCertIn...))
+	}
+	if f.SSOCertNotIn != nil {
+		preds = append(preds, user.SSOCertNotIn(f.SSOCertNotIn...))
+	}
+	if f.SSOCertGT != nil {
+		preds = append(preds, user.SSOCertGT(*f.SSOCertGT))
+	}
+	if f.SSOCertGTE != nil {
+		preds = append(preds, user.SSOCertGTE(*f.SSOCertGTE))
+	}
+	if f.SSOCertLT != nil {
+		preds = append(preds, user.SSOCertLT(*f.SSOCertLT))
+	}
+	if f.SSOCertLTE != nil {
+		preds = append(preds, user.SSOCertLTE(*f.SSOCertLTE))
+	}
+	if f.SSOCertContains != nil {
+		preds = append(preds, user.SSOCertContains(*f.SSOCertContains))
+	}
+	if f.SSOCertHasPrefix != nil {
+		preds = append(preds, user.SSOCertHasPrefix(*f.SSOCertHasPrefix))
+	}
+	if f.SSOCertHasSuffix != nil {
+		preds = append(preds, user.SSOCertHasSuffix(*f.SSOCertHasSuffix))
+	}
+	if f.SSOCertIsNil {
+		preds = append(preds, user.SSOCertIsNil())
+	}
+	if f.SSOCertNotNil {
+		preds = append(preds, user.SSOCertNotNil())
+	}
+	if f.SSOCertEqualFold != nil {
+		preds = append(preds, user.SSOCertEqualFold(*f.SSOCertEqualFold))
+	}
+	if f.SSOCertContainsFold != nil {
+		preds = append(preds, user.SSOCertContainsFold(*f.SSOCertContainsFold))
+	}
+	if f.SSOCertHasPrefixFold != nil {
+		preds = append(preds, user.SSOCertHasPrefixFold(*f.SSOCertHasPrefixFold))
+	}
+	if f.FilesCount != nil {
+		preds = append(preds, user.FilesCountEQ(*f.FilesCount))
+	}
+	if f.FilesCountNEQ != nil {
+		preds = append(preds, user.FilesCountNEQ(*f.FilesCountNEQ))
+	}
+	if f.FilesCountIn != nil {
+		preds = append(preds, user.FilesCountIn(f.FilesCountIn...))
+	}
+	if f.FilesCountNotIn != nil {
+		preds = append(preds, user.FilesCountNotIn(f.FilesCountNotIn...))
+	}
+	if f.FilesCountGT != nil {
+		preds = append(preds, user.FilesCountGT(*f.FilesCountGT))
+	}
+	if f.FilesCountGTE != nil {
+		preds = append(preds, user.FilesCountGTE(*f.FilesCountGTE))
+	}
+	if f.FilesCountLT != nil {
+		preds = append(preds, user.FilesCountLT(*f.FilesCountLT))
+	}
+	if f.FilesCountLTE != nil {
+		preds = append(preds, user.FilesCountLTE(*f.FilesCountLTE))
+	}
+	if f.FilesCountIsNil {
+		preds = append(preds, user.FilesCountIsNil())
+	}
+	if f.FilesCountNotNil {
+		preds = append(preds, user.FilesCountNotNil())
+	}
+	if f.HasCard != nil {
+		p := user.HasCard()
+		if !*f.HasCard {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasCardWith) > 0 {
+		with := make([]predicate.Card, 0, len(f.HasCardWith))
+		for _, w := range f.HasCardWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasCardWith(with...))
+	}
+	if f.HasPets != nil {
+		p := user.HasPets()
+		if !*f.HasPets {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasPetsWith) > 0 {
+		with := make([]predicate.Pet, 0, len(f.HasPetsWith))
+		for _, w := range f.HasPetsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasPetsWith(with...))
+	}
+	if f.HasFiles != nil {
+		p := user.HasFiles()
+		if !*f.HasFiles {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFilesWith) > 0 {
+		with := make([]predicate.File, 0, len(f.HasFilesWith))
+		for _, w := range f.HasFilesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasFilesWith(with...))
+	}
+	if f.HasGroups != nil {
+		p := user.HasGroups()
+		if !*f.HasGroups {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasGroupsWith) > 0 {
+		with := make([]predicate.Group, 0, len(f.HasGroupsWith))
+		for _, w := range f.HasGroupsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasGroupsWith(with...))
+	}
+	if f.HasFriends != nil {
+		p := user.HasFriends()
+		if !*f.HasFriends {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFriendsWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasFriendsWith))
+		for _, w := range f.HasFriendsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasFriendsWith(with...))
+	}
+	if f.HasFollowers != nil {
+		p := user.HasFollowers()
+		if !*f.HasFollowers {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFollowersWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasFollowersWith))
+		for _, w := range f.HasFollowersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasFollowersWith(with...))
+	}
+	if f.HasFollowing != nil {
+		p := user.HasFollowing()
+		if !*f.HasFollowing {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasFollowingWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasFollowingWith))
+		for _, w := range f.HasFollowingWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasFollowingWith(with...))
+	}
+	if f.HasTeam != nil {
+		p := user.HasTeam()
+		if !*f.HasTeam {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasTeamWith) > 0 {
+		with := make([]predicate.Pet, 0, len(f.HasTeamWith))
+		for _, w := range f.HasTeamWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasTeamWith(with...))
+	}
+	if f.HasSpouse != nil {
+		p := user.HasSpouse()
+		if !*f.HasSpouse {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasSpouseWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasSpouseWith))
+		for _, w := range f.HasSpouseWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasSpouseWith(with...))
+	}
+	if f.HasChildren != nil {
+		p := user.HasChildren()
+		if !*f.HasChildren {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasChildrenWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasChildrenWith))
+		for _, w := range f.HasChildrenWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasChildrenWith(with...))
+	}
+	if f.HasParent != nil {
+		p := user.HasParent()
+		if !*f.HasParent {
+			p = user.Not(p)
+		}
+		preds = append(preds, p)
+	}
+	if len(f.HasParentWith) > 0 {
+		with := make([]predicate.User, 0, len(f.HasParentWith))
+		for _, w := range f.HasParentWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, err
+			}
+			if p != nil {
+				with = append(with, p)
+			}
+		}
+		preds = append(preds, user.HasParentWith(with...))
+	}
+	switch len(preds) {
+	case 0:
+		return nil, nil
+	case 1:
+		return preds[0], nil
+	default:
+		return user.And(preds...), nil
+	}
+}
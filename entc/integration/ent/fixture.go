@@ -0,0 +1,1642 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/ent/card"
+	"entgo.io/ent/entc/integration/ent/comment"
+	"entgo.io/ent/entc/integration/ent/exvaluescan"
+	"entgo.io/ent/entc/integration/ent/fieldtype"
+	"entgo.io/ent/entc/integration/ent/file"
+	"entgo.io/ent/entc/integration/ent/filetype"
+	"entgo.io/ent/entc/integration/ent/group"
+	"entgo.io/ent/entc/integration/ent/groupinfo"
+	"entgo.io/ent/entc/integration/ent/item"
+	"entgo.io/ent/entc/integration/ent/license"
+	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/role"
+	"entgo.io/ent/entc/integration/ent/schema"
+	schemadir "entgo.io/ent/entc/integration/ent/schema/dir"
+	"entgo.io/ent/entc/integration/ent/schema/task"
+	"entgo.io/ent/entc/integration/ent/spec"
+	enttask "entgo.io/ent/entc/integration/ent/task"
+	"entgo.io/ent/entc/integration/ent/user"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// Fixtures holds the entities that were created by LoadFixtures, keyed by their symbolic names.
+type Fixtures struct {
+	Api         map[string]*Api
+	Builder     map[string]*Builder
+	Card        map[string]*Card
+	Comment     map[string]*Comment
+	ExValueScan map[string]*ExValueScan
+	FieldType   map[string]*FieldType
+	File        map[string]*File
+	FileType    map[string]*FileType
+	Goods       map[string]*Goods
+	Group       map[string]*Group
+	GroupInfo   map[string]*GroupInfo
+	Item        map[string]*Item
+	License     map[string]*License
+	Node        map[string]*Node
+	PC          map[string]*PC
+	Pet         map[string]*Pet
+	Spec        map[string]*Spec
+	Task        map[string]*Task
+	User        map[string]*User
+}
+
+// LoadFixtures reads the given YAML files and creates the entities they define in a single transaction.
+// Each file maps type names to entities keyed by their symbolic names. The attributes of an entity are
+// either field values or, in case of edges, the symbolic names of the entities they point to. For example:
+//
+//	User:
+//	  a8m:
+//	    name: Ariel
+//	    pets: [pedro, xabi]
+//	Pet:
+//	  pedro:
+//	    name: Pedro
+//	  xabi:
+//	    name: Xabi
+//
+// Entities are created in dependency order. i.e. an entity is created after all entities its edges
+// point to. Therefore, edges that form a cycle should be declared only on one of their sides.
+func (c *Client) LoadFixtures(ctx context.Context, paths ...string) (*Fixtures, error) {
+	specs := make(map[fixtureKey]map[string]any)
+	for _, path := range paths {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var doc map[string]map[string]map[string]any
+		if err := yaml.Unmarshal(buf, &doc); err != nil {
+			return nil, fmt.Errorf("ent: decoding fixtures file %q: %w", path, err)
+		}
+		for typ, entities := range doc {
+			if _, ok := fixtureEdges[typ]; !ok {
+				return nil, fmt.Errorf("ent: unknown fixture type %q in file %q", typ, path)
+			}
+			for name, attrs := range entities {
+				k := fixtureKey{typ: typ, name: name}
+				if _, ok := specs[k]; ok {
+					return nil, fmt.Errorf("ent: duplicate fixture %s", k)
+				}
+				specs[k] = attrs
+			}
+		}
+	}
+	order, err := fixtureOrder(specs)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := &Fixtures{
+		Api:         make(map[string]*Api),
+		Builder:     make(map[string]*Builder),
+		Card:        make(map[string]*Card),
+		Comment:     make(map[string]*Comment),
+		ExValueScan: make(map[string]*ExValueScan),
+		FieldType:   make(map[string]*FieldType),
+		File:        make(map[string]*File),
+		FileType:    make(map[string]*FileType),
+		Goods:       make(map[string]*Goods),
+		Group:       make(map[string]*Group),
+		GroupInfo:   make(map[string]*GroupInfo),
+		Item:        make(map[string]*Item),
+		License:     make(map[string]*License),
+		Node:        make(map[string]*Node),
+		PC:          make(map[string]*PC),
+		Pet:         make(map[string]*Pet),
+		Spec:        make(map[string]*Spec),
+		Task:        make(map[string]*Task),
+		User:        make(map[string]*User),
+	}
+	for _, k := range order {
+		if err := f.create(ctx, tx, k, specs[k]); err != nil {
+			err = fmt.Errorf("ent: creating fixture %s: %w", k, err)
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: %v", err, rerr)
+			}
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	// Release the entities from the committed transaction.
+	for _, v := range f.Api {
+		v.Unwrap()
+	}
+	for _, v := range f.Builder {
+		v.Unwrap()
+	}
+	for _, v := range f.Card {
+		v.Unwrap()
+	}
+	for _, v := range f.Comment {
+		v.Unwrap()
+	}
+	for _, v := range f.ExValueScan {
+		v.Unwrap()
+	}
+	for _, v := range f.FieldType {
+		v.Unwrap()
+	}
+	for _, v := range f.File {
+		v.Unwrap()
+	}
+	for _, v := range f.FileType {
+		v.Unwrap()
+	}
+	for _, v := range f.Goods {
+		v.Unwrap()
+	}
+	for _, v := range f.Group {
+		v.Unwrap()
+	}
+	for _, v := range f.GroupInfo {
+		v.Unwrap()
+	}
+	for _, v := range f.Item {
+		v.Unwrap()
+	}
+	for _, v := range f.License {
+		v.Unwrap()
+	}
+	for _, v := range f.Node {
+		v.Unwrap()
+	}
+	for _, v := range f.PC {
+		v.Unwrap()
+	}
+	for _, v := range f.Pet {
+		v.Unwrap()
+	}
+	for _, v := range f.Spec {
+		v.Unwrap()
+	}
+	for _, v := range f.Task {
+		v.Unwrap()
+	}
+	for _, v := range f.User {
+		v.Unwrap()
+	}
+	return f, nil
+}
+
+// create creates the fixture entity that is identified by the given key.
+func (f *Fixtures) create(ctx context.Context, tx *Tx, k fixtureKey, attrs map[string]any) error {
+	switch k.typ {
+	case TypeAPI:
+		b := tx.Api.Create()
+		for name := range attrs {
+			switch name {
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Api[k.name] = v
+	case TypeBuilder:
+		b := tx.Builder.Create()
+		for name := range attrs {
+			switch name {
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Builder[k.name] = v
+	case TypeCard:
+		b := tx.Card.Create()
+		for name, v := range attrs {
+			switch name {
+			case card.FieldCreateTime:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetCreateTime(x)
+			case card.FieldUpdateTime:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUpdateTime(x)
+			case card.FieldBalance:
+				var x float64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetBalance(x)
+			case card.FieldNumber:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNumber(x)
+			case card.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case card.EdgeOwner:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetOwner(f.User[names[0]])
+			case card.EdgeSpec:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddSpec(f.Spec[n])
+				}
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Card[k.name] = v
+	case TypeComment:
+		b := tx.Comment.Create()
+		for name, v := range attrs {
+			switch name {
+			case comment.FieldUniqueInt:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUniqueInt(x)
+			case comment.FieldUniqueFloat:
+				var x float64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUniqueFloat(x)
+			case comment.FieldNillableInt:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableInt(x)
+			case comment.FieldTable:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetTable(x)
+			case comment.FieldDir:
+				var x schemadir.Dir
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDir(x)
+			case comment.FieldClient:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetClient(x)
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Comment[k.name] = v
+	case TypeExValueScan:
+		b := tx.ExValueScan.Create()
+		for name, v := range attrs {
+			switch name {
+			case exvaluescan.FieldBinary:
+				var x *url.URL
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetBinary(x)
+			case exvaluescan.FieldBinaryOptional:
+				var x *url.URL
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetBinaryOptional(x)
+			case exvaluescan.FieldText:
+				var x *big.Int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetText(x)
+			case exvaluescan.FieldTextOptional:
+				var x *big.Int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetTextOptional(x)
+			case exvaluescan.FieldBase64:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetBase64(x)
+			case exvaluescan.FieldCustom:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetCustom(x)
+			case exvaluescan.FieldCustomOptional:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetCustomOptional(x)
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.ExValueScan[k.name] = v
+	case TypeFieldType:
+		b := tx.FieldType.Create()
+		for name, v := range attrs {
+			switch name {
+			case fieldtype.FieldInt:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetInt(x)
+			case fieldtype.FieldInt8:
+				var x int8
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetInt8(x)
+			case fieldtype.FieldInt16:
+				var x int16
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetInt16(x)
+			case fieldtype.FieldInt32:
+				var x int32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetInt32(x)
+			case fieldtype.FieldInt64:
+				var x int64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetInt64(x)
+			case fieldtype.FieldOptionalInt:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalInt(x)
+			case fieldtype.FieldOptionalInt8:
+				var x int8
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalInt8(x)
+			case fieldtype.FieldOptionalInt16:
+				var x int16
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalInt16(x)
+			case fieldtype.FieldOptionalInt32:
+				var x int32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalInt32(x)
+			case fieldtype.FieldOptionalInt64:
+				var x int64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalInt64(x)
+			case fieldtype.FieldNillableInt:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableInt(x)
+			case fieldtype.FieldNillableInt8:
+				var x int8
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableInt8(x)
+			case fieldtype.FieldNillableInt16:
+				var x int16
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableInt16(x)
+			case fieldtype.FieldNillableInt32:
+				var x int32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableInt32(x)
+			case fieldtype.FieldNillableInt64:
+				var x int64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableInt64(x)
+			case fieldtype.FieldValidateOptionalInt32:
+				var x int32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetValidateOptionalInt32(x)
+			case fieldtype.FieldOptionalUint:
+				var x uint
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalUint(x)
+			case fieldtype.FieldOptionalUint8:
+				var x uint8
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalUint8(x)
+			case fieldtype.FieldOptionalUint16:
+				var x uint16
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalUint16(x)
+			case fieldtype.FieldOptionalUint32:
+				var x uint32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalUint32(x)
+			case fieldtype.FieldOptionalUint64:
+				var x uint64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalUint64(x)
+			case fieldtype.FieldState:
+				var x fieldtype.State
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetState(x)
+			case fieldtype.FieldOptionalFloat:
+				var x float64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalFloat(x)
+			case fieldtype.FieldOptionalFloat32:
+				var x float32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalFloat32(x)
+			case fieldtype.FieldText:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetText(x)
+			case fieldtype.FieldDatetime:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDatetime(x)
+			case fieldtype.FieldDecimal:
+				var x float64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDecimal(x)
+			case fieldtype.FieldLinkOther:
+				var x *schema.Link
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetLinkOther(x)
+			case fieldtype.FieldLinkOtherFunc:
+				var x *schema.Link
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetLinkOtherFunc(x)
+			case fieldtype.FieldMAC:
+				var x schema.MAC
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetMAC(x)
+			case fieldtype.FieldStringArray:
+				var x schema.Strings
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetStringArray(x)
+			case fieldtype.FieldPassword:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPassword(x)
+			case fieldtype.FieldStringScanner:
+				var x schema.StringScanner
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetStringScanner(x)
+			case fieldtype.FieldDuration:
+				var x time.Duration
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDuration(x)
+			case fieldtype.FieldDir:
+				var x http.Dir
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDir(x)
+			case fieldtype.FieldNdir:
+				var x http.Dir
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNdir(x)
+			case fieldtype.FieldStr:
+				var x sql.NullString
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetStr(x)
+			case fieldtype.FieldNullStr:
+				var x *sql.NullString
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNullStr(x)
+			case fieldtype.FieldLink:
+				var x schema.Link
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetLink(x)
+			case fieldtype.FieldNullLink:
+				var x *schema.Link
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNullLink(x)
+			case fieldtype.FieldActive:
+				var x schema.Status
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetActive(x)
+			case fieldtype.FieldNullActive:
+				var x schema.Status
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNullActive(x)
+			case fieldtype.FieldDeleted:
+				var x *sql.NullBool
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDeleted(x)
+			case fieldtype.FieldDeletedAt:
+				var x *sql.NullTime
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDeletedAt(x)
+			case fieldtype.FieldRawData:
+				var x []byte
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetRawData(x)
+			case fieldtype.FieldSensitive:
+				var x []byte
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSensitive(x)
+			case fieldtype.FieldIP:
+				var x net.IP
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetIP(x)
+			case fieldtype.FieldNullInt64:
+				var x *sql.NullInt64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNullInt64(x)
+			case fieldtype.FieldSchemaInt:
+				var x schema.Int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSchemaInt(x)
+			case fieldtype.FieldSchemaInt8:
+				var x schema.Int8
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSchemaInt8(x)
+			case fieldtype.FieldSchemaInt64:
+				var x schema.Int64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSchemaInt64(x)
+			case fieldtype.FieldSchemaFloat:
+				var x schema.Float64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSchemaFloat(x)
+			case fieldtype.FieldSchemaFloat32:
+				var x schema.Float32
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSchemaFloat32(x)
+			case fieldtype.FieldNullFloat:
+				var x *sql.NullFloat64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNullFloat(x)
+			case fieldtype.FieldRole:
+				var x role.Role
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetRole(x)
+			case fieldtype.FieldPriority:
+				var x role.Priority
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPriority(x)
+			case fieldtype.FieldOptionalUUID:
+				var x uuid.UUID
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalUUID(x)
+			case fieldtype.FieldNillableUUID:
+				var x uuid.UUID
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNillableUUID(x)
+			case fieldtype.FieldStrings:
+				var x []string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetStrings(x)
+			case fieldtype.FieldPair:
+				var x schema.Pair
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPair(x)
+			case fieldtype.FieldNilPair:
+				var x *schema.Pair
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNilPair(x)
+			case fieldtype.FieldVstring:
+				var x schema.VString
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetVstring(x)
+			case fieldtype.FieldTriple:
+				var x schema.Triple
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetTriple(x)
+			case fieldtype.FieldBigInt:
+				var x schema.BigInt
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetBigInt(x)
+			case fieldtype.FieldPasswordOther:
+				var x schema.Password
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPasswordOther(x)
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.FieldType[k.name] = v
+	case TypeFile:
+		b := tx.File.Create()
+		for name, v := range attrs {
+			switch name {
+			case file.FieldSize:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSize(x)
+			case file.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case file.FieldUser:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUser(x)
+			case file.FieldGroup:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetGroup(x)
+			case file.FieldOp:
+				var x bool
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOp(x)
+			case file.FieldFieldID:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetFieldID(x)
+			case file.EdgeOwner:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetOwner(f.User[names[0]])
+			case file.EdgeType:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetType(f.FileType[names[0]])
+			case file.EdgeField:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddField(f.FieldType[n])
+				}
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.File[k.name] = v
+	case TypeFileType:
+		b := tx.FileType.Create()
+		for name, v := range attrs {
+			switch name {
+			case filetype.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case filetype.FieldType:
+				var x filetype.Type
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetType(x)
+			case filetype.FieldState:
+				var x filetype.State
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetState(x)
+			case filetype.EdgeFiles:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddFiles(f.File[n])
+				}
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.FileType[k.name] = v
+	case TypeGoods:
+		b := tx.Goods.Create()
+		for name := range attrs {
+			switch name {
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Goods[k.name] = v
+	case TypeGroup:
+		b := tx.Group.Create()
+		for name, v := range attrs {
+			switch name {
+			case group.FieldActive:
+				var x bool
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetActive(x)
+			case group.FieldExpire:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetExpire(x)
+			case group.FieldType:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetType(x)
+			case group.FieldMaxUsers:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetMaxUsers(x)
+			case group.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case group.EdgeFiles:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddFiles(f.File[n])
+				}
+			case group.EdgeBlocked:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddBlocked(f.User[n])
+				}
+			case group.EdgeUsers:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddUsers(f.User[n])
+				}
+			case group.EdgeInfo:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetInfo(f.GroupInfo[names[0]])
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Group[k.name] = v
+	case TypeGroupInfo:
+		b := tx.GroupInfo.Create()
+		for name, v := range attrs {
+			switch name {
+			case groupinfo.FieldDesc:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetDesc(x)
+			case groupinfo.FieldMaxUsers:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetMaxUsers(x)
+			case groupinfo.EdgeGroups:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddGroups(f.Group[n])
+				}
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.GroupInfo[k.name] = v
+	case TypeItem:
+		b := tx.Item.Create()
+		for name, v := range attrs {
+			switch name {
+			case item.FieldText:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetText(x)
+			case item.FieldID:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetID(x)
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Item[k.name] = v
+	case TypeLicense:
+		b := tx.License.Create()
+		for name, v := range attrs {
+			switch name {
+			case license.FieldCreateTime:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetCreateTime(x)
+			case license.FieldUpdateTime:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUpdateTime(x)
+			case license.FieldID:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetID(x)
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.License[k.name] = v
+	case TypeNode:
+		b := tx.Node.Create()
+		for name, v := range attrs {
+			switch name {
+			case node.FieldValue:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetValue(x)
+			case node.FieldUpdatedAt:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUpdatedAt(x)
+			case node.EdgePrev:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetPrev(f.Node[names[0]])
+			case node.EdgeNext:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetNext(f.Node[names[0]])
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Node[k.name] = v
+	case TypePC:
+		b := tx.PC.Create()
+		for name := range attrs {
+			switch name {
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.PC[k.name] = v
+	case TypePet:
+		b := tx.Pet.Create()
+		for name, v := range attrs {
+			switch name {
+			case pet.FieldAge:
+				var x float64
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetAge(x)
+			case pet.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case pet.FieldUUID:
+				var x uuid.UUID
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetUUID(x)
+			case pet.FieldNickname:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNickname(x)
+			case pet.FieldTrained:
+				var x bool
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetTrained(x)
+			case pet.EdgeTeam:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetTeam(f.User[names[0]])
+			case pet.EdgeOwner:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetOwner(f.User[names[0]])
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Pet[k.name] = v
+	case TypeSpec:
+		b := tx.Spec.Create()
+		for name, v := range attrs {
+			switch name {
+			case spec.EdgeCard:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddCard(f.Card[n])
+				}
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Spec[k.name] = v
+	case TypeTask:
+		b := tx.Task.Create()
+		for name, v := range attrs {
+			switch name {
+			case enttask.FieldPriority:
+				var x task.Priority
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPriority(x)
+			case enttask.FieldPriorities:
+				var x map[string]task.Priority
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPriorities(x)
+			case enttask.FieldCreatedAt:
+				var x time.Time
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetCreatedAt(x)
+			case enttask.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case enttask.FieldOwner:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOwner(x)
+			case enttask.FieldOrder:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOrder(x)
+			case enttask.FieldOrderOption:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOrderOption(x)
+			case enttask.FieldOp:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOp(x)
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.Task[k.name] = v
+	case TypeUser:
+		b := tx.User.Create()
+		for name, v := range attrs {
+			switch name {
+			case user.FieldOptionalInt:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetOptionalInt(x)
+			case user.FieldAge:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetAge(x)
+			case user.FieldName:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetName(x)
+			case user.FieldLast:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetLast(x)
+			case user.FieldNickname:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetNickname(x)
+			case user.FieldAddress:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetAddress(x)
+			case user.FieldPhone:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPhone(x)
+			case user.FieldPassword:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetPassword(x)
+			case user.FieldRole:
+				var x user.Role
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetRole(x)
+			case user.FieldEmployment:
+				var x user.Employment
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetEmployment(x)
+			case user.FieldSSOCert:
+				var x string
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetSSOCert(x)
+			case user.FieldFilesCount:
+				var x int
+				if err := fixtureDecode(v, &x); err != nil {
+					return fmt.Errorf("decoding field %q: %w", name, err)
+				}
+				b.SetFilesCount(x)
+			case user.EdgeCard:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetCard(f.Card[names[0]])
+			case user.EdgePets:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddPets(f.Pet[n])
+				}
+			case user.EdgeFiles:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddFiles(f.File[n])
+				}
+			case user.EdgeGroups:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddGroups(f.Group[n])
+				}
+			case user.EdgeFriends:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddFriends(f.User[n])
+				}
+			case user.EdgeFollowers:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddFollowers(f.User[n])
+				}
+			case user.EdgeFollowing:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddFollowing(f.User[n])
+				}
+			case user.EdgeTeam:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetTeam(f.Pet[names[0]])
+			case user.EdgeSpouse:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetSpouse(f.User[names[0]])
+			case user.EdgeChildren:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				for _, n := range names {
+					b.AddChildren(f.User[n])
+				}
+			case user.EdgeParent:
+				names, err := fixtureRefs(v)
+				if err != nil {
+					return fmt.Errorf("edge %q: %w", name, err)
+				}
+				if len(names) != 1 {
+					return fmt.Errorf("edge %q expects a single entity, got %d", name, len(names))
+				}
+				b.SetParent(f.User[names[0]])
+			default:
+				return fmt.Errorf("unknown field or edge %q", name)
+			}
+		}
+		v, err := b.Save(ctx)
+		if err != nil {
+			return err
+		}
+		f.User[k.name] = v
+	default:
+		return fmt.Errorf("unknown fixture type %q", k.typ)
+	}
+	return nil
+}
+
+// fixtureEdges maps the fixture types to their edges and the types they point to.
+var fixtureEdges = map[string]map[string]string{
+	TypeAPI:     {},
+	TypeBuilder: {},
+	TypeCard: {
+		card.EdgeOwner: TypeUser,
+		card.EdgeSpec:  TypeSpec,
+	},
+	TypeComment:     {},
+	TypeExValueScan: {},
+	TypeFieldType:   {},
+	TypeFile: {
+		file.EdgeOwner: TypeUser,
+		file.EdgeType:  TypeFileType,
+		file.EdgeField: TypeFieldType,
+	},
+	TypeFileType: {
+		filetype.EdgeFiles: TypeFile,
+	},
+	TypeGoods: {},
+	TypeGroup: {
+		group.EdgeFiles:   TypeFile,
+		group.EdgeBlocked: TypeUser,
+		group.EdgeUsers:   TypeUser,
+		group.EdgeInfo:    TypeGroupInfo,
+	},
+	TypeGroupInfo: {
+		groupinfo.EdgeGroups: TypeGroup,
+	},
+	TypeItem:    {},
+	TypeLicense: {},
+	TypeNode: {
+		node.EdgePrev: TypeNode,
+		node.EdgeNext: TypeNode,
+	},
+	TypePC: {},
+	TypePet: {
+		pet.EdgeTeam:  TypeUser,
+		pet.EdgeOwner: TypeUser,
+	},
+	TypeSpec: {
+		spec.EdgeCard: TypeCard,
+	},
+	TypeTask: {},
+	TypeUser: {
+		user.EdgeCard:      TypeCard,
+		user.EdgePets:      TypePet,
+		user.EdgeFiles:     TypeFile,
+		user.EdgeGroups:    TypeGroup,
+		user.EdgeFriends:   TypeUser,
+		user.EdgeFollowers: TypeUser,
+		user.EdgeFollowing: TypeUser,
+		user.EdgeTeam:      TypePet,
+		user.EdgeSpouse:    TypeUser,
+		user.EdgeChildren:  TypeUser,
+		user.EdgeParent:    TypeUser,
+	},
+}
+
+// fixtureKey identifies a fixture entity.
+type fixtureKey struct {
+	typ, name string
+}
+
+// String implements the fmt.Stringer interface.
+func (k fixtureKey) String() string {
+	return fmt.Sprintf("%s(%q)", k.typ, k.name)
+}
+
+// fixtureOrder returns the fixture keys sorted by their dependencies, and
+// fails in case an edge points to an undefined entity or in case of a cycle.
+func fixtureOrder(specs map[fixtureKey]map[string]any) ([]fixtureKey, error) {
+	keys := make([]fixtureKey, 0, len(specs))
+	for k := range specs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].typ != keys[j].typ {
+			return keys[i].typ < keys[j].typ
+		}
+		return keys[i].name < keys[j].name
+	})
+	const (
+		visiting = iota + 1
+		visited
+	)
+	var (
+		order []fixtureKey
+		state = make(map[fixtureKey]int, len(keys))
+		visit func(fixtureKey) error
+	)
+	visit = func(k fixtureKey) error {
+		switch state[k] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("ent: fixtures cycle detected at %s", k)
+		}
+		state[k] = visiting
+		attrs := specs[k]
+		edges := make([]string, 0, len(attrs))
+		for name := range attrs {
+			if _, ok := fixtureEdges[k.typ][name]; ok {
+				edges = append(edges, name)
+			}
+		}
+		sort.Strings(edges)
+		for _, name := range edges {
+			names, err := fixtureRefs(attrs[name])
+			if err != nil {
+				return fmt.Errorf("ent: fixture %s edge %q: %w", k, name, err)
+			}
+			for _, n := range names {
+				dep := fixtureKey{typ: fixtureEdges[k.typ][name], name: n}
+				if _, ok := specs[dep]; !ok {
+					return fmt.Errorf("ent: fixture %s edge %q points to undefined fixture %s", k, name, dep)
+				}
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+		state[k] = visited
+		order = append(order, k)
+		return nil
+	}
+	for _, k := range keys {
+		if err := visit(k); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// fixtureRefs returns the symbolic names referenced by an edge value.
+func fixtureRefs(v any) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		names := make([]string, len(v))
+		for i := range v {
+			s, ok := v[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected reference type %T", v[i])
+			}
+			names[i] = s
+		}
+		return names, nil
+	default:
+		return nil, fmt.Errorf("unexpected reference type %T", v)
+	}
+}
+
+// fixtureDecode decodes a YAML value into the Go type of a field.
+func fixtureDecode(v, x any) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, x)
+}
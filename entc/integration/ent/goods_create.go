@@ -59,7 +59,8 @@ func (gc *GoodsCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gc *GoodsCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (gc *GoodsCreate) sqlSave(ctx context.Context) (*Goods, error) {
@@ -69,7 +70,7 @@ func (gc *GoodsCreate) sqlSave(ctx context.Context) (*Goods, error) {
 	_node, _spec := gc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -208,12 +209,16 @@ func (u *GoodsUpsertOne) IDX(ctx context.Context) int {
 // GoodsCreateBulk is the builder for creating many Goods entities in bulk.
 type GoodsCreateBulk struct {
 	config
+	err      error
 	builders []*GoodsCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Goods entities in the database.
 func (gcb *GoodsCreateBulk) Save(ctx context.Context) ([]*Goods, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
 	nodes := make([]*Goods, len(gcb.builders))
 	mutators := make([]Mutator, len(gcb.builders))
@@ -239,7 +244,7 @@ func (gcb *GoodsCreateBulk) Save(ctx context.Context) ([]*Goods, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
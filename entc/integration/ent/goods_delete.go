@@ -19,8 +19,9 @@ import (
 // GoodsDelete is the builder for deleting a Goods entity.
 type GoodsDelete struct {
 	config
-	hooks    []Hook
-	mutation *GoodsMutation
+	hooks     []Hook
+	mutation  *GoodsMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the GoodsDelete builder.
@@ -43,8 +44,21 @@ func (gd *GoodsDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (gd *GoodsDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *GoodsDelete {
+	gd.modifiers = append(gd.modifiers, modifiers...)
+	return gd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (gdo *GoodsDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *GoodsDeleteOne {
+	gdo.gd.Modify(modifiers...)
+	return gdo
+}
+
 func (gd *GoodsDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(goods.Table, sqlgraph.NewFieldSpec(goods.FieldID, field.TypeInt))
+	_spec.AddModifiers(gd.modifiers...)
 	if ps := gd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (gd *GoodsDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, gd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	gd.mutation.done = true
 	return affected, err
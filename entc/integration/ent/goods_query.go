@@ -300,7 +300,7 @@ func (gq *GoodsQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range gq.ctx.Fields {
 		if !goods.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Goods", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if gq.path != nil {
@@ -460,10 +460,53 @@ func (gq *GoodsQuery) Modify(modifiers ...func(s *sql.Selector)) *GoodsSelect {
 	return gq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (gq *GoodsQuery) Union(queries ...*GoodsQuery) *GoodsQuery {
+	return gq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (gq *GoodsQuery) UnionAll(queries ...*GoodsQuery) *GoodsQuery {
+	return gq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (gq *GoodsQuery) Intersect(queries ...*GoodsQuery) *GoodsQuery {
+	return gq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (gq *GoodsQuery) Except(queries ...*GoodsQuery) *GoodsQuery {
+	return gq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (gq *GoodsQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*GoodsQuery) *GoodsQuery {
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return gq
+}
+
 // GoodsGroupBy is the group-by builder for Goods entities.
 type GoodsGroupBy struct {
 	selector
-	build *GoodsQuery
+	build  *GoodsQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -481,6 +524,14 @@ func (ggb *GoodsGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*GoodsQuery, *GoodsGroupBy](ctx, ggb.build, ggb, ggb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ggb *GoodsGroupBy) Having(ps ...*sql.Predicate) *GoodsGroupBy {
+	ggb.having = append(ggb.having, ps...)
+	return ggb
+}
+
 func (ggb *GoodsGroupBy) sqlScan(ctx context.Context, root *GoodsQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ggb.fns))
@@ -496,6 +547,9 @@ func (ggb *GoodsGroupBy) sqlScan(ctx context.Context, root *GoodsQuery, v any) e
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ggb.flds...)...)
+	if len(ggb.having) > 0 {
+		selector.Having(sql.And(ggb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
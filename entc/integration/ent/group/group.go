@@ -7,6 +7,8 @@
 package group
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -195,6 +197,100 @@ func ByInfoField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInfoStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "files_count") that Group queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Group queries can be ordered by.
+const (
+	OrderFieldID           OrderField = "id"
+	OrderFieldActive       OrderField = "active"
+	OrderFieldExpire       OrderField = "expire"
+	OrderFieldType         OrderField = "type"
+	OrderFieldMaxUsers     OrderField = "max_users"
+	OrderFieldName         OrderField = "name"
+	OrderFieldFilesCount   OrderField = "files_count"
+	OrderFieldBlockedCount OrderField = "blocked_count"
+	OrderFieldUsersCount   OrderField = "users_count"
+)
+
+// OrderFields holds all fields and edge counts that Group queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldActive,
+	OrderFieldExpire,
+	OrderFieldType,
+	OrderFieldMaxUsers,
+	OrderFieldName,
+	OrderFieldFilesCount,
+	OrderFieldBlockedCount,
+	OrderFieldUsersCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Group queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("group: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := group.OrderByField(group.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Group.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldActive:
+		return ByActive(opts...), nil
+	case OrderFieldExpire:
+		return ByExpire(opts...), nil
+	case OrderFieldType:
+		return ByType(opts...), nil
+	case OrderFieldMaxUsers:
+		return ByMaxUsers(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldFilesCount:
+		return ByFilesCount(opts...), nil
+	case OrderFieldBlockedCount:
+		return ByBlockedCount(opts...), nil
+	case OrderFieldUsersCount:
+		return ByUsersCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newFilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
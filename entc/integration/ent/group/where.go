@@ -209,6 +209,11 @@ func TypeContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldType, v))
 }
 
+// TypeHasPrefixFold applies the HasPrefixFold predicate on the "type" field.
+func TypeHasPrefixFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefixFold(FieldType, v))
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldMaxUsers, v))
@@ -324,6 +329,17 @@ func NameContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefixFold(FieldName, v))
+}
+
+// ExpireWithinLast applies the WithinLast predicate on the "expire" field.
+// It matches values that are within the given duration before the current time.
+func ExpireWithinLast(d time.Duration) predicate.Group {
+	return predicate.Group(sql.FieldWithinLast(FieldExpire, d))
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -186,34 +186,35 @@ func (gc *GroupCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (gc *GroupCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gc.mutation.Active(); !ok {
-		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Group.active"`)}
+		errs = append(errs, &ValidationError{Name: "active", Entity: "Group", Field: "active", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.active"`)})
 	}
 	if _, ok := gc.mutation.Expire(); !ok {
-		return &ValidationError{Name: "expire", err: errors.New(`ent: missing required field "Group.expire"`)}
+		errs = append(errs, &ValidationError{Name: "expire", Entity: "Group", Field: "expire", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.expire"`)})
 	}
 	if v, ok := gc.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "Group", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)})
 		}
 	}
 	if v, ok := gc.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "max_users", Entity: "Group", Field: "max_users", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)})
 		}
 	}
 	if _, ok := gc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Group.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.name"`)})
 	}
 	if v, ok := gc.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)})
 		}
 	}
 	if _, ok := gc.mutation.InfoID(); !ok {
-		return &ValidationError{Name: "info", err: errors.New(`ent: missing required edge "Group.info"`)}
+		errs = append(errs, &ValidationError{Name: "info", Entity: "Group", Field: "info", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Group.info"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
@@ -223,7 +224,7 @@ func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
 	_node, _spec := gc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -389,6 +390,12 @@ func (u *GroupUpsert) UpdateActive() *GroupUpsert {
 	return u
 }
 
+// IgnoreActive keeps the current value of the "active" field in case of conflict.
+func (u *GroupUpsert) IgnoreActive() *GroupUpsert {
+	u.SetIgnore(group.FieldActive)
+	return u
+}
+
 // SetExpire sets the "expire" field.
 func (u *GroupUpsert) SetExpire(v time.Time) *GroupUpsert {
 	u.Set(group.FieldExpire, v)
@@ -401,6 +408,12 @@ func (u *GroupUpsert) UpdateExpire() *GroupUpsert {
 	return u
 }
 
+// IgnoreExpire keeps the current value of the "expire" field in case of conflict.
+func (u *GroupUpsert) IgnoreExpire() *GroupUpsert {
+	u.SetIgnore(group.FieldExpire)
+	return u
+}
+
 // SetType sets the "type" field.
 func (u *GroupUpsert) SetType(v string) *GroupUpsert {
 	u.Set(group.FieldType, v)
@@ -413,6 +426,12 @@ func (u *GroupUpsert) UpdateType() *GroupUpsert {
 	return u
 }
 
+// IgnoreType keeps the current value of the "type" field in case of conflict.
+func (u *GroupUpsert) IgnoreType() *GroupUpsert {
+	u.SetIgnore(group.FieldType)
+	return u
+}
+
 // ClearType clears the value of the "type" field.
 func (u *GroupUpsert) ClearType() *GroupUpsert {
 	u.SetNull(group.FieldType)
@@ -431,6 +450,12 @@ func (u *GroupUpsert) UpdateMaxUsers() *GroupUpsert {
 	return u
 }
 
+// IgnoreMaxUsers keeps the current value of the "max_users" field in case of conflict.
+func (u *GroupUpsert) IgnoreMaxUsers() *GroupUpsert {
+	u.SetIgnore(group.FieldMaxUsers)
+	return u
+}
+
 // AddMaxUsers adds v to the "max_users" field.
 func (u *GroupUpsert) AddMaxUsers(v int) *GroupUpsert {
 	u.Add(group.FieldMaxUsers, v)
@@ -455,6 +480,12 @@ func (u *GroupUpsert) UpdateName() *GroupUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *GroupUpsert) IgnoreName() *GroupUpsert {
+	u.SetIgnore(group.FieldName)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -509,6 +540,14 @@ func (u *GroupUpsertOne) UpdateActive() *GroupUpsertOne {
 	})
 }
 
+// IgnoreActive keeps the current value of the "active" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertOne) IgnoreActive() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreActive()
+	})
+}
+
 // SetExpire sets the "expire" field.
 func (u *GroupUpsertOne) SetExpire(v time.Time) *GroupUpsertOne {
 	return u.Update(func(s *GroupUpsert) {
@@ -523,6 +562,14 @@ func (u *GroupUpsertOne) UpdateExpire() *GroupUpsertOne {
 	})
 }
 
+// IgnoreExpire keeps the current value of the "expire" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertOne) IgnoreExpire() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreExpire()
+	})
+}
+
 // SetType sets the "type" field.
 func (u *GroupUpsertOne) SetType(v string) *GroupUpsertOne {
 	return u.Update(func(s *GroupUpsert) {
@@ -537,6 +584,14 @@ func (u *GroupUpsertOne) UpdateType() *GroupUpsertOne {
 	})
 }
 
+// IgnoreType keeps the current value of the "type" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertOne) IgnoreType() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreType()
+	})
+}
+
 // ClearType clears the value of the "type" field.
 func (u *GroupUpsertOne) ClearType() *GroupUpsertOne {
 	return u.Update(func(s *GroupUpsert) {
@@ -565,6 +620,14 @@ func (u *GroupUpsertOne) UpdateMaxUsers() *GroupUpsertOne {
 	})
 }
 
+// IgnoreMaxUsers keeps the current value of the "max_users" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertOne) IgnoreMaxUsers() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreMaxUsers()
+	})
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (u *GroupUpsertOne) ClearMaxUsers() *GroupUpsertOne {
 	return u.Update(func(s *GroupUpsert) {
@@ -586,6 +649,14 @@ func (u *GroupUpsertOne) UpdateName() *GroupUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertOne) IgnoreName() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -622,12 +693,16 @@ func (u *GroupUpsertOne) IDX(ctx context.Context) int {
 // GroupCreateBulk is the builder for creating many Group entities in bulk.
 type GroupCreateBulk struct {
 	config
+	err      error
 	builders []*GroupCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Group entities in the database.
 func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
 	nodes := make([]*Group, len(gcb.builders))
 	mutators := make([]Mutator, len(gcb.builders))
@@ -654,7 +729,7 @@ func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -800,6 +875,14 @@ func (u *GroupUpsertBulk) UpdateActive() *GroupUpsertBulk {
 	})
 }
 
+// IgnoreActive keeps the current value of the "active" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertBulk) IgnoreActive() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreActive()
+	})
+}
+
 // SetExpire sets the "expire" field.
 func (u *GroupUpsertBulk) SetExpire(v time.Time) *GroupUpsertBulk {
 	return u.Update(func(s *GroupUpsert) {
@@ -814,6 +897,14 @@ func (u *GroupUpsertBulk) UpdateExpire() *GroupUpsertBulk {
 	})
 }
 
+// IgnoreExpire keeps the current value of the "expire" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertBulk) IgnoreExpire() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreExpire()
+	})
+}
+
 // SetType sets the "type" field.
 func (u *GroupUpsertBulk) SetType(v string) *GroupUpsertBulk {
 	return u.Update(func(s *GroupUpsert) {
@@ -828,6 +919,14 @@ func (u *GroupUpsertBulk) UpdateType() *GroupUpsertBulk {
 	})
 }
 
+// IgnoreType keeps the current value of the "type" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertBulk) IgnoreType() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreType()
+	})
+}
+
 // ClearType clears the value of the "type" field.
 func (u *GroupUpsertBulk) ClearType() *GroupUpsertBulk {
 	return u.Update(func(s *GroupUpsert) {
@@ -856,6 +955,14 @@ func (u *GroupUpsertBulk) UpdateMaxUsers() *GroupUpsertBulk {
 	})
 }
 
+// IgnoreMaxUsers keeps the current value of the "max_users" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertBulk) IgnoreMaxUsers() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreMaxUsers()
+	})
+}
+
 // ClearMaxUsers clears the value of the "max_users" field.
 func (u *GroupUpsertBulk) ClearMaxUsers() *GroupUpsertBulk {
 	return u.Update(func(s *GroupUpsert) {
@@ -877,6 +984,14 @@ func (u *GroupUpsertBulk) UpdateName() *GroupUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupUpsertBulk) IgnoreName() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
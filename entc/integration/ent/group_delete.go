@@ -19,8 +19,9 @@ import (
 // GroupDelete is the builder for deleting a Group entity.
 type GroupDelete struct {
 	config
-	hooks    []Hook
-	mutation *GroupMutation
+	hooks     []Hook
+	mutation  *GroupMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the GroupDelete builder.
@@ -43,8 +44,21 @@ func (gd *GroupDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (gd *GroupDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *GroupDelete {
+	gd.modifiers = append(gd.modifiers, modifiers...)
+	return gd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (gdo *GroupDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *GroupDeleteOne {
+	gdo.gd.Modify(modifiers...)
+	return gdo
+}
+
 func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(group.Table, sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt))
+	_spec.AddModifiers(gd.modifiers...)
 	if ps := gd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, gd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	gd.mutation.done = true
 	return affected, err
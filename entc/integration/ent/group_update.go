@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -268,25 +269,26 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gu *GroupUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := gu.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "Group", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)})
 		}
 	}
 	if v, ok := gu.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "max_users", Entity: "Group", Field: "max_users", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)})
 		}
 	}
 	if v, ok := gu.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)})
 		}
 	}
 	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.info"`)
+		errs = append(errs, &ValidationError{Name: "info", Entity: "Group", Field: "info", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Group.info"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -500,7 +502,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -762,25 +764,26 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (guo *GroupUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := guo.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "Group", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)})
 		}
 	}
 	if v, ok := guo.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "max_users", Entity: "Group", Field: "max_users", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)})
 		}
 	}
 	if v, ok := guo.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)})
 		}
 	}
 	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.info"`)
+		errs = append(errs, &ValidationError{Name: "info", Entity: "Group", Field: "info", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Group.info"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
@@ -796,7 +799,7 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	_spec := sqlgraph.NewUpdateSpec(group.Table, group.Columns, sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt))
 	id, ok := guo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Group", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Group.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := guo.fields; len(fields) > 0 {
@@ -804,7 +807,7 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		_spec.Node.Columns = append(_spec.Node.Columns, group.FieldID)
 		for _, f := range fields {
 			if !group.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Group", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 			}
 			if f != group.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -1014,10 +1017,72 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	guo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (guo *GroupUpdateOne) sameUpdate(other *GroupUpdateOne) bool {
+	if len(guo.modifiers) > 0 || len(other.modifiers) > 0 {
+		return false
+	}
+	m1, m2 := *guo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// GroupUpdateMany is the builder for updating many Group entities by their ids.
+type GroupUpdateMany struct {
+	config
+	builders []*GroupUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (gum *GroupUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*GroupUpdateOne
+Builders:
+	for _, b := range gum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*GroupUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(group.IDIn(ids...))
+		if err := (&GroupUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gum *GroupUpdateMany) ExecX(ctx context.Context) {
+	if err := gum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
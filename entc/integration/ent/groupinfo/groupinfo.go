@@ -7,6 +7,8 @@
 package groupinfo
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -86,6 +88,80 @@ func ByGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "groups_count") that GroupInfo queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that GroupInfo queries can be ordered by.
+const (
+	OrderFieldID          OrderField = "id"
+	OrderFieldDesc        OrderField = "desc"
+	OrderFieldMaxUsers    OrderField = "max_users"
+	OrderFieldGroupsCount OrderField = "groups_count"
+)
+
+// OrderFields holds all fields and edge counts that GroupInfo queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldDesc,
+	OrderFieldMaxUsers,
+	OrderFieldGroupsCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if GroupInfo queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("groupinfo: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := groupinfo.OrderByField(groupinfo.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.GroupInfo.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldDesc:
+		return ByDesc(opts...), nil
+	case OrderFieldMaxUsers:
+		return ByMaxUsers(opts...), nil
+	case OrderFieldGroupsCount:
+		return ByGroupsCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newGroupsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -132,6 +132,11 @@ func DescContainsFold(v string) predicate.GroupInfo {
 	return predicate.GroupInfo(sql.FieldContainsFold(FieldDesc, v))
 }
 
+// DescHasPrefixFold applies the HasPrefixFold predicate on the "desc" field.
+func DescHasPrefixFold(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(sql.FieldHasPrefixFold(FieldDesc, v))
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.GroupInfo {
 	return predicate.GroupInfo(sql.FieldEQ(FieldMaxUsers, v))
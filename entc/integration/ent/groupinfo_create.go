@@ -104,13 +104,14 @@ func (gic *GroupInfoCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (gic *GroupInfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gic.mutation.Desc(); !ok {
-		return &ValidationError{Name: "desc", err: errors.New(`ent: missing required field "GroupInfo.desc"`)}
+		errs = append(errs, &ValidationError{Name: "desc", Entity: "GroupInfo", Field: "desc", Reason: "missing required field", err: errors.New(`ent: missing required field "GroupInfo.desc"`)})
 	}
 	if _, ok := gic.mutation.MaxUsers(); !ok {
-		return &ValidationError{Name: "max_users", err: errors.New(`ent: missing required field "GroupInfo.max_users"`)}
+		errs = append(errs, &ValidationError{Name: "max_users", Entity: "GroupInfo", Field: "max_users", Reason: "missing required field", err: errors.New(`ent: missing required field "GroupInfo.max_users"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
@@ -120,7 +121,7 @@ func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
 	_node, _spec := gic.createSpec()
 	if err := sqlgraph.CreateNode(ctx, gic.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -225,6 +226,12 @@ func (u *GroupInfoUpsert) UpdateDesc() *GroupInfoUpsert {
 	return u
 }
 
+// IgnoreDesc keeps the current value of the "desc" field in case of conflict.
+func (u *GroupInfoUpsert) IgnoreDesc() *GroupInfoUpsert {
+	u.SetIgnore(groupinfo.FieldDesc)
+	return u
+}
+
 // SetMaxUsers sets the "max_users" field.
 func (u *GroupInfoUpsert) SetMaxUsers(v int) *GroupInfoUpsert {
 	u.Set(groupinfo.FieldMaxUsers, v)
@@ -237,6 +244,12 @@ func (u *GroupInfoUpsert) UpdateMaxUsers() *GroupInfoUpsert {
 	return u
 }
 
+// IgnoreMaxUsers keeps the current value of the "max_users" field in case of conflict.
+func (u *GroupInfoUpsert) IgnoreMaxUsers() *GroupInfoUpsert {
+	u.SetIgnore(groupinfo.FieldMaxUsers)
+	return u
+}
+
 // AddMaxUsers adds v to the "max_users" field.
 func (u *GroupInfoUpsert) AddMaxUsers(v int) *GroupInfoUpsert {
 	u.Add(groupinfo.FieldMaxUsers, v)
@@ -297,6 +310,14 @@ func (u *GroupInfoUpsertOne) UpdateDesc() *GroupInfoUpsertOne {
 	})
 }
 
+// IgnoreDesc keeps the current value of the "desc" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupInfoUpsertOne) IgnoreDesc() *GroupInfoUpsertOne {
+	return u.Update(func(s *GroupInfoUpsert) {
+		s.IgnoreDesc()
+	})
+}
+
 // SetMaxUsers sets the "max_users" field.
 func (u *GroupInfoUpsertOne) SetMaxUsers(v int) *GroupInfoUpsertOne {
 	return u.Update(func(s *GroupInfoUpsert) {
@@ -318,6 +339,14 @@ func (u *GroupInfoUpsertOne) UpdateMaxUsers() *GroupInfoUpsertOne {
 	})
 }
 
+// IgnoreMaxUsers keeps the current value of the "max_users" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupInfoUpsertOne) IgnoreMaxUsers() *GroupInfoUpsertOne {
+	return u.Update(func(s *GroupInfoUpsert) {
+		s.IgnoreMaxUsers()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupInfoUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -354,12 +383,16 @@ func (u *GroupInfoUpsertOne) IDX(ctx context.Context) int {
 // GroupInfoCreateBulk is the builder for creating many GroupInfo entities in bulk.
 type GroupInfoCreateBulk struct {
 	config
+	err      error
 	builders []*GroupInfoCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the GroupInfo entities in the database.
 func (gicb *GroupInfoCreateBulk) Save(ctx context.Context) ([]*GroupInfo, error) {
+	if gicb.err != nil {
+		return nil, gicb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(gicb.builders))
 	nodes := make([]*GroupInfo, len(gicb.builders))
 	mutators := make([]Mutator, len(gicb.builders))
@@ -386,7 +419,7 @@ func (gicb *GroupInfoCreateBulk) Save(ctx context.Context) ([]*GroupInfo, error)
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, gicb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -532,6 +565,14 @@ func (u *GroupInfoUpsertBulk) UpdateDesc() *GroupInfoUpsertBulk {
 	})
 }
 
+// IgnoreDesc keeps the current value of the "desc" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupInfoUpsertBulk) IgnoreDesc() *GroupInfoUpsertBulk {
+	return u.Update(func(s *GroupInfoUpsert) {
+		s.IgnoreDesc()
+	})
+}
+
 // SetMaxUsers sets the "max_users" field.
 func (u *GroupInfoUpsertBulk) SetMaxUsers(v int) *GroupInfoUpsertBulk {
 	return u.Update(func(s *GroupInfoUpsert) {
@@ -553,6 +594,14 @@ func (u *GroupInfoUpsertBulk) UpdateMaxUsers() *GroupInfoUpsertBulk {
 	})
 }
 
+// IgnoreMaxUsers keeps the current value of the "max_users" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *GroupInfoUpsertBulk) IgnoreMaxUsers() *GroupInfoUpsertBulk {
+	return u.Update(func(s *GroupInfoUpsert) {
+		s.IgnoreMaxUsers()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupInfoUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -19,8 +19,9 @@ import (
 // GroupInfoDelete is the builder for deleting a GroupInfo entity.
 type GroupInfoDelete struct {
 	config
-	hooks    []Hook
-	mutation *GroupInfoMutation
+	hooks     []Hook
+	mutation  *GroupInfoMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the GroupInfoDelete builder.
@@ -43,8 +44,21 @@ func (gid *GroupInfoDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (gid *GroupInfoDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *GroupInfoDelete {
+	gid.modifiers = append(gid.modifiers, modifiers...)
+	return gid
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (gido *GroupInfoDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *GroupInfoDeleteOne {
+	gido.gid.Modify(modifiers...)
+	return gido
+}
+
 func (gid *GroupInfoDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(groupinfo.Table, sqlgraph.NewFieldSpec(groupinfo.FieldID, field.TypeInt))
+	_spec.AddModifiers(gid.modifiers...)
 	if ps := gid.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (gid *GroupInfoDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, gid.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	gid.mutation.done = true
 	return affected, err
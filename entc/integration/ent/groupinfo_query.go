@@ -360,7 +360,7 @@ func (giq *GroupInfoQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range giq.ctx.Fields {
 		if !groupinfo.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "GroupInfo", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if giq.path != nil {
@@ -420,6 +420,22 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*G
 }
 
 func (giq *GroupInfoQuery) loadGroups(ctx context.Context, query *GroupQuery, nodes []*GroupInfo, init func(*GroupInfo), assign func(*GroupInfo, *Group)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := giq.loadGroups(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*GroupInfo)
 	for i := range nodes {
@@ -584,10 +600,53 @@ func (giq *GroupInfoQuery) WithNamedGroups(name string, opts ...func(*GroupQuery
 	return giq
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (giq *GroupInfoQuery) Union(queries ...*GroupInfoQuery) *GroupInfoQuery {
+	return giq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (giq *GroupInfoQuery) UnionAll(queries ...*GroupInfoQuery) *GroupInfoQuery {
+	return giq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (giq *GroupInfoQuery) Intersect(queries ...*GroupInfoQuery) *GroupInfoQuery {
+	return giq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (giq *GroupInfoQuery) Except(queries ...*GroupInfoQuery) *GroupInfoQuery {
+	return giq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (giq *GroupInfoQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*GroupInfoQuery) *GroupInfoQuery {
+	giq.modifiers = append(giq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return giq
+}
+
 // GroupInfoGroupBy is the group-by builder for GroupInfo entities.
 type GroupInfoGroupBy struct {
 	selector
-	build *GroupInfoQuery
+	build  *GroupInfoQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -605,6 +664,14 @@ func (gigb *GroupInfoGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*GroupInfoQuery, *GroupInfoGroupBy](ctx, gigb.build, gigb, gigb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (gigb *GroupInfoGroupBy) Having(ps ...*sql.Predicate) *GroupInfoGroupBy {
+	gigb.having = append(gigb.having, ps...)
+	return gigb
+}
+
 func (gigb *GroupInfoGroupBy) sqlScan(ctx context.Context, root *GroupInfoQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(gigb.fns))
@@ -620,6 +687,9 @@ func (gigb *GroupInfoGroupBy) sqlScan(ctx context.Context, root *GroupInfoQuery,
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*gigb.flds...)...)
+	if len(gigb.having) > 0 {
+		selector.Having(sql.And(gigb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
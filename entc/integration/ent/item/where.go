@@ -66,6 +66,11 @@ func IDContainsFold(id string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldID, id))
 }
 
+// IDHasPrefixFold applies the HasPrefixFold predicate on the ID field.
+func IDHasPrefixFold(id string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefixFold(FieldID, id))
+}
+
 // Text applies equality check predicate on the "text" field. It's identical to TextEQ.
 func Text(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldText, v))
@@ -146,6 +151,11 @@ func TextContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
@@ -97,17 +97,18 @@ func (ic *ItemCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ic *ItemCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := ic.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "text", Entity: "Item", Field: "text", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)})
 		}
 	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Item", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
@@ -117,7 +118,7 @@ func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
 	_node, _spec := ic.createSpec()
 	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -211,6 +212,12 @@ func (u *ItemUpsert) UpdateText() *ItemUpsert {
 	return u
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+func (u *ItemUpsert) IgnoreText() *ItemUpsert {
+	u.SetIgnore(item.FieldText)
+	return u
+}
+
 // ClearText clears the value of the "text" field.
 func (u *ItemUpsert) ClearText() *ItemUpsert {
 	u.SetNull(item.FieldText)
@@ -279,6 +286,14 @@ func (u *ItemUpsertOne) UpdateText() *ItemUpsertOne {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ItemUpsertOne) IgnoreText() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *ItemUpsertOne) ClearText() *ItemUpsertOne {
 	return u.Update(func(s *ItemUpsert) {
@@ -327,12 +342,16 @@ func (u *ItemUpsertOne) IDX(ctx context.Context) string {
 // ItemCreateBulk is the builder for creating many Item entities in bulk.
 type ItemCreateBulk struct {
 	config
+	err      error
 	builders []*ItemCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Item entities in the database.
 func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
 	nodes := make([]*Item, len(icb.builders))
 	mutators := make([]Mutator, len(icb.builders))
@@ -359,7 +378,7 @@ func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -511,6 +530,14 @@ func (u *ItemUpsertBulk) UpdateText() *ItemUpsertBulk {
 	})
 }
 
+// IgnoreText keeps the current value of the "text" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *ItemUpsertBulk) IgnoreText() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.IgnoreText()
+	})
+}
+
 // ClearText clears the value of the "text" field.
 func (u *ItemUpsertBulk) ClearText() *ItemUpsertBulk {
 	return u.Update(func(s *ItemUpsert) {
@@ -19,8 +19,9 @@ import (
 // ItemDelete is the builder for deleting a Item entity.
 type ItemDelete struct {
 	config
-	hooks    []Hook
-	mutation *ItemMutation
+	hooks     []Hook
+	mutation  *ItemMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the ItemDelete builder.
@@ -43,8 +44,21 @@ func (id *ItemDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (id *ItemDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *ItemDelete {
+	id.modifiers = append(id.modifiers, modifiers...)
+	return id
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ido *ItemDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *ItemDeleteOne {
+	ido.id.Modify(modifiers...)
+	return ido
+}
+
 func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(item.Table, sqlgraph.NewFieldSpec(item.FieldID, field.TypeString))
+	_spec.AddModifiers(id.modifiers...)
 	if ps := id.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, id.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	id.mutation.done = true
 	return affected, err
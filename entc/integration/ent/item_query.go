@@ -322,7 +322,7 @@ func (iq *ItemQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range iq.ctx.Fields {
 		if !item.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Item", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if iq.path != nil {
@@ -482,10 +482,53 @@ func (iq *ItemQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemSelect {
 	return iq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (iq *ItemQuery) Union(queries ...*ItemQuery) *ItemQuery {
+	return iq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (iq *ItemQuery) UnionAll(queries ...*ItemQuery) *ItemQuery {
+	return iq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (iq *ItemQuery) Intersect(queries ...*ItemQuery) *ItemQuery {
+	return iq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (iq *ItemQuery) Except(queries ...*ItemQuery) *ItemQuery {
+	return iq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (iq *ItemQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*ItemQuery) *ItemQuery {
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return iq
+}
+
 // ItemGroupBy is the group-by builder for Item entities.
 type ItemGroupBy struct {
 	selector
-	build *ItemQuery
+	build  *ItemQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,6 +546,14 @@ func (igb *ItemGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*ItemQuery, *ItemGroupBy](ctx, igb.build, igb, igb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (igb *ItemGroupBy) Having(ps ...*sql.Predicate) *ItemGroupBy {
+	igb.having = append(igb.having, ps...)
+	return igb
+}
+
 func (igb *ItemGroupBy) sqlScan(ctx context.Context, root *ItemQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(igb.fns))
@@ -518,6 +569,9 @@ func (igb *ItemGroupBy) sqlScan(ctx context.Context, root *ItemQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*igb.flds...)...)
+	if len(igb.having) > 0 {
+		selector.Having(sql.And(igb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
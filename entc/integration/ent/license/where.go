@@ -148,6 +148,18 @@ func UpdateTimeLTE(v time.Time) predicate.License {
 	return predicate.License(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// CreateTimeWithinLast applies the WithinLast predicate on the "create_time" field.
+// It matches values that are within the given duration before the current time.
+func CreateTimeWithinLast(d time.Duration) predicate.License {
+	return predicate.License(sql.FieldWithinLast(FieldCreateTime, d))
+}
+
+// UpdateTimeWithinLast applies the WithinLast predicate on the "update_time" field.
+// It matches values that are within the given duration before the current time.
+func UpdateTimeWithinLast(d time.Duration) predicate.License {
+	return predicate.License(sql.FieldWithinLast(FieldUpdateTime, d))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.License) predicate.License {
 	return predicate.License(func(s *sql.Selector) {
@@ -107,13 +107,14 @@ func (lc *LicenseCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (lc *LicenseCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := lc.mutation.CreateTime(); !ok {
-		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "License.create_time"`)}
+		errs = append(errs, &ValidationError{Name: "create_time", Entity: "License", Field: "create_time", Reason: "missing required field", err: errors.New(`ent: missing required field "License.create_time"`)})
 	}
 	if _, ok := lc.mutation.UpdateTime(); !ok {
-		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "License.update_time"`)}
+		errs = append(errs, &ValidationError{Name: "update_time", Entity: "License", Field: "update_time", Reason: "missing required field", err: errors.New(`ent: missing required field "License.update_time"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (lc *LicenseCreate) sqlSave(ctx context.Context) (*License, error) {
@@ -123,7 +124,7 @@ func (lc *LicenseCreate) sqlSave(ctx context.Context) (*License, error) {
 	_node, _spec := lc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -218,6 +219,12 @@ func (u *LicenseUpsert) UpdateUpdateTime() *LicenseUpsert {
 	return u
 }
 
+// IgnoreUpdateTime keeps the current value of the "update_time" field in case of conflict.
+func (u *LicenseUpsert) IgnoreUpdateTime() *LicenseUpsert {
+	u.SetIgnore(license.FieldUpdateTime)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -283,6 +290,14 @@ func (u *LicenseUpsertOne) UpdateUpdateTime() *LicenseUpsertOne {
 	})
 }
 
+// IgnoreUpdateTime keeps the current value of the "update_time" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *LicenseUpsertOne) IgnoreUpdateTime() *LicenseUpsertOne {
+	return u.Update(func(s *LicenseUpsert) {
+		s.IgnoreUpdateTime()
+	})
+}
+
 // Exec executes the query.
 func (u *LicenseUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -319,12 +334,16 @@ func (u *LicenseUpsertOne) IDX(ctx context.Context) int {
 // LicenseCreateBulk is the builder for creating many License entities in bulk.
 type LicenseCreateBulk struct {
 	config
+	err      error
 	builders []*LicenseCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the License entities in the database.
 func (lcb *LicenseCreateBulk) Save(ctx context.Context) ([]*License, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
 	nodes := make([]*License, len(lcb.builders))
 	mutators := make([]Mutator, len(lcb.builders))
@@ -351,7 +370,7 @@ func (lcb *LicenseCreateBulk) Save(ctx context.Context) ([]*License, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -510,6 +529,14 @@ func (u *LicenseUpsertBulk) UpdateUpdateTime() *LicenseUpsertBulk {
 	})
 }
 
+// IgnoreUpdateTime keeps the current value of the "update_time" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *LicenseUpsertBulk) IgnoreUpdateTime() *LicenseUpsertBulk {
+	return u.Update(func(s *LicenseUpsert) {
+		s.IgnoreUpdateTime()
+	})
+}
+
 // Exec executes the query.
 func (u *LicenseUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -19,8 +19,9 @@ import (
 // LicenseDelete is the builder for deleting a License entity.
 type LicenseDelete struct {
 	config
-	hooks    []Hook
-	mutation *LicenseMutation
+	hooks     []Hook
+	mutation  *LicenseMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the LicenseDelete builder.
@@ -43,8 +44,21 @@ func (ld *LicenseDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ld *LicenseDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *LicenseDelete {
+	ld.modifiers = append(ld.modifiers, modifiers...)
+	return ld
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ldo *LicenseDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *LicenseDeleteOne {
+	ldo.ld.Modify(modifiers...)
+	return ldo
+}
+
 func (ld *LicenseDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(license.Table, sqlgraph.NewFieldSpec(license.FieldID, field.TypeInt))
+	_spec.AddModifiers(ld.modifiers...)
 	if ps := ld.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (ld *LicenseDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, ld.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	ld.mutation.done = true
 	return affected, err
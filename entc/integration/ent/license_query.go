@@ -322,7 +322,7 @@ func (lq *LicenseQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range lq.ctx.Fields {
 		if !license.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "License", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if lq.path != nil {
@@ -482,10 +482,53 @@ func (lq *LicenseQuery) Modify(modifiers ...func(s *sql.Selector)) *LicenseSelec
 	return lq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (lq *LicenseQuery) Union(queries ...*LicenseQuery) *LicenseQuery {
+	return lq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (lq *LicenseQuery) UnionAll(queries ...*LicenseQuery) *LicenseQuery {
+	return lq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (lq *LicenseQuery) Intersect(queries ...*LicenseQuery) *LicenseQuery {
+	return lq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (lq *LicenseQuery) Except(queries ...*LicenseQuery) *LicenseQuery {
+	return lq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (lq *LicenseQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*LicenseQuery) *LicenseQuery {
+	lq.modifiers = append(lq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return lq
+}
+
 // LicenseGroupBy is the group-by builder for License entities.
 type LicenseGroupBy struct {
 	selector
-	build *LicenseQuery
+	build  *LicenseQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,6 +546,14 @@ func (lgb *LicenseGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*LicenseQuery, *LicenseGroupBy](ctx, lgb.build, lgb, lgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (lgb *LicenseGroupBy) Having(ps ...*sql.Predicate) *LicenseGroupBy {
+	lgb.having = append(lgb.having, ps...)
+	return lgb
+}
+
 func (lgb *LicenseGroupBy) sqlScan(ctx context.Context, root *LicenseQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(lgb.fns))
@@ -518,6 +569,9 @@ func (lgb *LicenseGroupBy) sqlScan(ctx context.Context, root *LicenseQuery, v an
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*lgb.flds...)...)
+	if len(lgb.having) > 0 {
+		selector.Having(sql.And(lgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -169,6 +169,12 @@ func UpdatedAtNotNil() predicate.Node {
 	return predicate.Node(sql.FieldNotNull(FieldUpdatedAt))
 }
 
+// UpdatedAtWithinLast applies the WithinLast predicate on the "updated_at" field.
+// It matches values that are within the given duration before the current time.
+func UpdatedAtWithinLast(d time.Duration) predicate.Node {
+	return predicate.Node(sql.FieldWithinLast(FieldUpdatedAt, d))
+}
+
 // HasPrev applies the HasEdge predicate on the "prev" edge.
 func HasPrev() predicate.Node {
 	return predicate.Node(func(s *sql.Selector) {
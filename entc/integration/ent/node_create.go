@@ -126,7 +126,8 @@ func (nc *NodeCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (nc *NodeCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
@@ -136,7 +137,7 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 	_node, _spec := nc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -258,6 +259,12 @@ func (u *NodeUpsert) UpdateValue() *NodeUpsert {
 	return u
 }
 
+// IgnoreValue keeps the current value of the "value" field in case of conflict.
+func (u *NodeUpsert) IgnoreValue() *NodeUpsert {
+	u.SetIgnore(node.FieldValue)
+	return u
+}
+
 // AddValue adds v to the "value" field.
 func (u *NodeUpsert) AddValue(v int) *NodeUpsert {
 	u.Add(node.FieldValue, v)
@@ -282,6 +289,12 @@ func (u *NodeUpsert) UpdateUpdatedAt() *NodeUpsert {
 	return u
 }
 
+// IgnoreUpdatedAt keeps the current value of the "updated_at" field in case of conflict.
+func (u *NodeUpsert) IgnoreUpdatedAt() *NodeUpsert {
+	u.SetIgnore(node.FieldUpdatedAt)
+	return u
+}
+
 // ClearUpdatedAt clears the value of the "updated_at" field.
 func (u *NodeUpsert) ClearUpdatedAt() *NodeUpsert {
 	u.SetNull(node.FieldUpdatedAt)
@@ -349,6 +362,14 @@ func (u *NodeUpsertOne) UpdateValue() *NodeUpsertOne {
 	})
 }
 
+// IgnoreValue keeps the current value of the "value" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *NodeUpsertOne) IgnoreValue() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.IgnoreValue()
+	})
+}
+
 // ClearValue clears the value of the "value" field.
 func (u *NodeUpsertOne) ClearValue() *NodeUpsertOne {
 	return u.Update(func(s *NodeUpsert) {
@@ -370,6 +391,14 @@ func (u *NodeUpsertOne) UpdateUpdatedAt() *NodeUpsertOne {
 	})
 }
 
+// IgnoreUpdatedAt keeps the current value of the "updated_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *NodeUpsertOne) IgnoreUpdatedAt() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.IgnoreUpdatedAt()
+	})
+}
+
 // ClearUpdatedAt clears the value of the "updated_at" field.
 func (u *NodeUpsertOne) ClearUpdatedAt() *NodeUpsertOne {
 	return u.Update(func(s *NodeUpsert) {
@@ -413,12 +442,16 @@ func (u *NodeUpsertOne) IDX(ctx context.Context) int {
 // NodeCreateBulk is the builder for creating many Node entities in bulk.
 type NodeCreateBulk struct {
 	config
+	err      error
 	builders []*NodeCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Node entities in the database.
 func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
 	nodes := make([]*Node, len(ncb.builders))
 	mutators := make([]Mutator, len(ncb.builders))
@@ -444,7 +477,7 @@ func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -597,6 +630,14 @@ func (u *NodeUpsertBulk) UpdateValue() *NodeUpsertBulk {
 	})
 }
 
+// IgnoreValue keeps the current value of the "value" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *NodeUpsertBulk) IgnoreValue() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.IgnoreValue()
+	})
+}
+
 // ClearValue clears the value of the "value" field.
 func (u *NodeUpsertBulk) ClearValue() *NodeUpsertBulk {
 	return u.Update(func(s *NodeUpsert) {
@@ -618,6 +659,14 @@ func (u *NodeUpsertBulk) UpdateUpdatedAt() *NodeUpsertBulk {
 	})
 }
 
+// IgnoreUpdatedAt keeps the current value of the "updated_at" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *NodeUpsertBulk) IgnoreUpdatedAt() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.IgnoreUpdatedAt()
+	})
+}
+
 // ClearUpdatedAt clears the value of the "updated_at" field.
 func (u *NodeUpsertBulk) ClearUpdatedAt() *NodeUpsertBulk {
 	return u.Update(func(s *NodeUpsert) {
@@ -19,8 +19,9 @@ import (
 // NodeDelete is the builder for deleting a Node entity.
 type NodeDelete struct {
 	config
-	hooks    []Hook
-	mutation *NodeMutation
+	hooks     []Hook
+	mutation  *NodeMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the NodeDelete builder.
@@ -43,8 +44,21 @@ func (nd *NodeDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (nd *NodeDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *NodeDelete {
+	nd.modifiers = append(nd.modifiers, modifiers...)
+	return nd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ndo *NodeDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *NodeDeleteOne {
+	ndo.nd.Modify(modifiers...)
+	return ndo
+}
+
 func (nd *NodeDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(node.Table, sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt))
+	_spec.AddModifiers(nd.modifiers...)
 	if ps := nd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (nd *NodeDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, nd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	nd.mutation.done = true
 	return affected, err
@@ -394,7 +394,7 @@ func (nq *NodeQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range nq.ctx.Fields {
 		if !node.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Node", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if nq.path != nil {
@@ -472,26 +472,49 @@ func (nq *NodeQuery) loadPrev(ctx context.Context, query *NodeQuery, nodes []*No
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(node.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "node_next" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], node.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "node_next" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 func (nq *NodeQuery) loadNext(ctx context.Context, query *NodeQuery, nodes []*Node, init func(*Node), assign func(*Node, *Node)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := nq.loadNext(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Node)
 	for i := range nodes {
@@ -639,10 +662,101 @@ func (nq *NodeQuery) Modify(modifiers ...func(s *sql.Selector)) *NodeSelect {
 	return nq.Select()
 }
 
+// QueryPrevRecursive chains the current query on the "prev" edge, and returns all
+// nodes that are reachable by following the edge one or more times. For example, all
+// descendants or ancestors in a tree. The traversal is executed by the database using a recursive query.
+func (nq *NodeQuery) QueryPrevRecursive() *NodeQuery {
+	query := (&NodeClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+		)
+		fromU = sqlgraph.RecursiveNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNextRecursive chains the current query on the "next" edge, and returns all
+// nodes that are reachable by following the edge one or more times. For example, all
+// descendants or ancestors in a tree. The traversal is executed by the database using a recursive query.
+func (nq *NodeQuery) QueryNextRecursive() *NodeQuery {
+	query := (&NodeClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+		)
+		fromU = sqlgraph.RecursiveNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (nq *NodeQuery) Union(queries ...*NodeQuery) *NodeQuery {
+	return nq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (nq *NodeQuery) UnionAll(queries ...*NodeQuery) *NodeQuery {
+	return nq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (nq *NodeQuery) Intersect(queries ...*NodeQuery) *NodeQuery {
+	return nq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (nq *NodeQuery) Except(queries ...*NodeQuery) *NodeQuery {
+	return nq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (nq *NodeQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*NodeQuery) *NodeQuery {
+	nq.modifiers = append(nq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return nq
+}
+
 // NodeGroupBy is the group-by builder for Node entities.
 type NodeGroupBy struct {
 	selector
-	build *NodeQuery
+	build  *NodeQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -660,6 +774,14 @@ func (ngb *NodeGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*NodeQuery, *NodeGroupBy](ctx, ngb.build, ngb, ngb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ngb *NodeGroupBy) Having(ps ...*sql.Predicate) *NodeGroupBy {
+	ngb.having = append(ngb.having, ps...)
+	return ngb
+}
+
 func (ngb *NodeGroupBy) sqlScan(ctx context.Context, root *NodeQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ngb.fns))
@@ -675,6 +797,9 @@ func (ngb *NodeGroupBy) sqlScan(ctx context.Context, root *NodeQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ngb.flds...)...)
+	if len(ngb.having) > 0 {
+		selector.Having(sql.And(ngb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -59,7 +59,8 @@ func (_pc *PCCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_pc *PCCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (_pc *PCCreate) sqlSave(ctx context.Context) (*PC, error) {
@@ -69,7 +70,7 @@ func (_pc *PCCreate) sqlSave(ctx context.Context) (*PC, error) {
 	_node, _spec := _pc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, _pc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -208,12 +209,16 @@ func (u *PCUpsertOne) IDX(ctx context.Context) int {
 // PCCreateBulk is the builder for creating many PC entities in bulk.
 type PCCreateBulk struct {
 	config
+	err      error
 	builders []*PCCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the PC entities in the database.
 func (pcb *PCCreateBulk) Save(ctx context.Context) ([]*PC, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*PC, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
@@ -239,7 +244,7 @@ func (pcb *PCCreateBulk) Save(ctx context.Context) ([]*PC, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -19,8 +19,9 @@ import (
 // PCDelete is the builder for deleting a PC entity.
 type PCDelete struct {
 	config
-	hooks    []Hook
-	mutation *PCMutation
+	hooks     []Hook
+	mutation  *PCMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the PCDelete builder.
@@ -43,8 +44,21 @@ func (pd *PCDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (pd *PCDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *PCDelete {
+	pd.modifiers = append(pd.modifiers, modifiers...)
+	return pd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (pdo *PCDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *PCDeleteOne {
+	pdo.pd.Modify(modifiers...)
+	return pdo
+}
+
 func (pd *PCDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(pc.Table, sqlgraph.NewFieldSpec(pc.FieldID, field.TypeInt))
+	_spec.AddModifiers(pd.modifiers...)
 	if ps := pd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (pd *PCDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	pd.mutation.done = true
 	return affected, err
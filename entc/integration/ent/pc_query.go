@@ -300,7 +300,7 @@ func (pq *PCQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range pq.ctx.Fields {
 		if !pc.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "PC", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if pq.path != nil {
@@ -460,10 +460,53 @@ func (pq *PCQuery) Modify(modifiers ...func(s *sql.Selector)) *PCSelect {
 	return pq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (pq *PCQuery) Union(queries ...*PCQuery) *PCQuery {
+	return pq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (pq *PCQuery) UnionAll(queries ...*PCQuery) *PCQuery {
+	return pq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (pq *PCQuery) Intersect(queries ...*PCQuery) *PCQuery {
+	return pq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (pq *PCQuery) Except(queries ...*PCQuery) *PCQuery {
+	return pq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (pq *PCQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*PCQuery) *PCQuery {
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return pq
+}
+
 // PCGroupBy is the group-by builder for PC entities.
 type PCGroupBy struct {
 	selector
-	build *PCQuery
+	build  *PCQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -481,6 +524,14 @@ func (pgb *PCGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*PCQuery, *PCGroupBy](ctx, pgb.build, pgb, pgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (pgb *PCGroupBy) Having(ps ...*sql.Predicate) *PCGroupBy {
+	pgb.having = append(pgb.having, ps...)
+	return pgb
+}
+
 func (pgb *PCGroupBy) sqlScan(ctx context.Context, root *PCQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(pgb.fns))
@@ -496,6 +547,9 @@ func (pgb *PCGroupBy) sqlScan(ctx context.Context, root *PCQuery, v any) error {
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*pgb.flds...)...)
+	if len(pgb.having) > 0 {
+		selector.Having(sql.And(pgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
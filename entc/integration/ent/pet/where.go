@@ -188,6 +188,11 @@ func NameContainsFold(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // UUIDEQ applies the EQ predicate on the "uuid" field.
 func UUIDEQ(v uuid.UUID) predicate.Pet {
 	return predicate.Pet(sql.FieldEQ(FieldUUID, v))
@@ -313,6 +318,11 @@ func NicknameContainsFold(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldNickname, v))
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefixFold(FieldNickname, v))
+}
+
 // TrainedEQ applies the EQ predicate on the "trained" field.
 func TrainedEQ(v bool) predicate.Pet {
 	return predicate.Pet(sql.FieldEQ(FieldTrained, v))
@@ -174,16 +174,17 @@ func (pc *PetCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := pc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "Pet.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "Pet", Field: "age", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.age"`)})
 	}
 	if _, ok := pc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Pet.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Pet", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.name"`)})
 	}
 	if _, ok := pc.mutation.Trained(); !ok {
-		return &ValidationError{Name: "trained", err: errors.New(`ent: missing required field "Pet.trained"`)}
+		errs = append(errs, &ValidationError{Name: "trained", Entity: "Pet", Field: "trained", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.trained"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
@@ -193,7 +194,7 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 	_node, _spec := pc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -328,6 +329,12 @@ func (u *PetUpsert) UpdateAge() *PetUpsert {
 	return u
 }
 
+// IgnoreAge keeps the current value of the "age" field in case of conflict.
+func (u *PetUpsert) IgnoreAge() *PetUpsert {
+	u.SetIgnore(pet.FieldAge)
+	return u
+}
+
 // AddAge adds v to the "age" field.
 func (u *PetUpsert) AddAge(v float64) *PetUpsert {
 	u.Add(pet.FieldAge, v)
@@ -346,6 +353,12 @@ func (u *PetUpsert) UpdateName() *PetUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *PetUpsert) IgnoreName() *PetUpsert {
+	u.SetIgnore(pet.FieldName)
+	return u
+}
+
 // SetUUID sets the "uuid" field.
 func (u *PetUpsert) SetUUID(v uuid.UUID) *PetUpsert {
 	u.Set(pet.FieldUUID, v)
@@ -358,6 +371,12 @@ func (u *PetUpsert) UpdateUUID() *PetUpsert {
 	return u
 }
 
+// IgnoreUUID keeps the current value of the "uuid" field in case of conflict.
+func (u *PetUpsert) IgnoreUUID() *PetUpsert {
+	u.SetIgnore(pet.FieldUUID)
+	return u
+}
+
 // ClearUUID clears the value of the "uuid" field.
 func (u *PetUpsert) ClearUUID() *PetUpsert {
 	u.SetNull(pet.FieldUUID)
@@ -376,6 +395,12 @@ func (u *PetUpsert) UpdateNickname() *PetUpsert {
 	return u
 }
 
+// IgnoreNickname keeps the current value of the "nickname" field in case of conflict.
+func (u *PetUpsert) IgnoreNickname() *PetUpsert {
+	u.SetIgnore(pet.FieldNickname)
+	return u
+}
+
 // ClearNickname clears the value of the "nickname" field.
 func (u *PetUpsert) ClearNickname() *PetUpsert {
 	u.SetNull(pet.FieldNickname)
@@ -394,6 +419,12 @@ func (u *PetUpsert) UpdateTrained() *PetUpsert {
 	return u
 }
 
+// IgnoreTrained keeps the current value of the "trained" field in case of conflict.
+func (u *PetUpsert) IgnoreTrained() *PetUpsert {
+	u.SetIgnore(pet.FieldTrained)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -455,6 +486,14 @@ func (u *PetUpsertOne) UpdateAge() *PetUpsertOne {
 	})
 }
 
+// IgnoreAge keeps the current value of the "age" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertOne) IgnoreAge() *PetUpsertOne {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreAge()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *PetUpsertOne) SetName(v string) *PetUpsertOne {
 	return u.Update(func(s *PetUpsert) {
@@ -469,6 +508,14 @@ func (u *PetUpsertOne) UpdateName() *PetUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertOne) IgnoreName() *PetUpsertOne {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetUUID sets the "uuid" field.
 func (u *PetUpsertOne) SetUUID(v uuid.UUID) *PetUpsertOne {
 	return u.Update(func(s *PetUpsert) {
@@ -483,6 +530,14 @@ func (u *PetUpsertOne) UpdateUUID() *PetUpsertOne {
 	})
 }
 
+// IgnoreUUID keeps the current value of the "uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertOne) IgnoreUUID() *PetUpsertOne {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreUUID()
+	})
+}
+
 // ClearUUID clears the value of the "uuid" field.
 func (u *PetUpsertOne) ClearUUID() *PetUpsertOne {
 	return u.Update(func(s *PetUpsert) {
@@ -504,6 +559,14 @@ func (u *PetUpsertOne) UpdateNickname() *PetUpsertOne {
 	})
 }
 
+// IgnoreNickname keeps the current value of the "nickname" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertOne) IgnoreNickname() *PetUpsertOne {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreNickname()
+	})
+}
+
 // ClearNickname clears the value of the "nickname" field.
 func (u *PetUpsertOne) ClearNickname() *PetUpsertOne {
 	return u.Update(func(s *PetUpsert) {
@@ -525,6 +588,14 @@ func (u *PetUpsertOne) UpdateTrained() *PetUpsertOne {
 	})
 }
 
+// IgnoreTrained keeps the current value of the "trained" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertOne) IgnoreTrained() *PetUpsertOne {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreTrained()
+	})
+}
+
 // Exec executes the query.
 func (u *PetUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -561,12 +632,16 @@ func (u *PetUpsertOne) IDX(ctx context.Context) int {
 // PetCreateBulk is the builder for creating many Pet entities in bulk.
 type PetCreateBulk struct {
 	config
+	err      error
 	builders []*PetCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Pet entities in the database.
 func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Pet, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
@@ -593,7 +668,7 @@ func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -746,6 +821,14 @@ func (u *PetUpsertBulk) UpdateAge() *PetUpsertBulk {
 	})
 }
 
+// IgnoreAge keeps the current value of the "age" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertBulk) IgnoreAge() *PetUpsertBulk {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreAge()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *PetUpsertBulk) SetName(v string) *PetUpsertBulk {
 	return u.Update(func(s *PetUpsert) {
@@ -760,6 +843,14 @@ func (u *PetUpsertBulk) UpdateName() *PetUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertBulk) IgnoreName() *PetUpsertBulk {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetUUID sets the "uuid" field.
 func (u *PetUpsertBulk) SetUUID(v uuid.UUID) *PetUpsertBulk {
 	return u.Update(func(s *PetUpsert) {
@@ -774,6 +865,14 @@ func (u *PetUpsertBulk) UpdateUUID() *PetUpsertBulk {
 	})
 }
 
+// IgnoreUUID keeps the current value of the "uuid" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertBulk) IgnoreUUID() *PetUpsertBulk {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreUUID()
+	})
+}
+
 // ClearUUID clears the value of the "uuid" field.
 func (u *PetUpsertBulk) ClearUUID() *PetUpsertBulk {
 	return u.Update(func(s *PetUpsert) {
@@ -795,6 +894,14 @@ func (u *PetUpsertBulk) UpdateNickname() *PetUpsertBulk {
 	})
 }
 
+// IgnoreNickname keeps the current value of the "nickname" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertBulk) IgnoreNickname() *PetUpsertBulk {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreNickname()
+	})
+}
+
 // ClearNickname clears the value of the "nickname" field.
 func (u *PetUpsertBulk) ClearNickname() *PetUpsertBulk {
 	return u.Update(func(s *PetUpsert) {
@@ -816,6 +923,14 @@ func (u *PetUpsertBulk) UpdateTrained() *PetUpsertBulk {
 	})
 }
 
+// IgnoreTrained keeps the current value of the "trained" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *PetUpsertBulk) IgnoreTrained() *PetUpsertBulk {
+	return u.Update(func(s *PetUpsert) {
+		s.IgnoreTrained()
+	})
+}
+
 // Exec executes the query.
 func (u *PetUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
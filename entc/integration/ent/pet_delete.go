@@ -19,8 +19,9 @@ import (
 // PetDelete is the builder for deleting a Pet entity.
 type PetDelete struct {
 	config
-	hooks    []Hook
-	mutation *PetMutation
+	hooks     []Hook
+	mutation  *PetMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the PetDelete builder.
@@ -43,8 +44,21 @@ func (pd *PetDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (pd *PetDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *PetDelete {
+	pd.modifiers = append(pd.modifiers, modifiers...)
+	return pd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (pdo *PetDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *PetDeleteOne {
+	pdo.pd.Modify(modifiers...)
+	return pdo
+}
+
 func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(pet.Table, sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt))
+	_spec.AddModifiers(pd.modifiers...)
 	if ps := pd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	pd.mutation.done = true
 	return affected, err
@@ -0,0 +1,2473 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing/quick"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/ent/card"
+	"entgo.io/ent/entc/integration/ent/fieldtype"
+	"entgo.io/ent/entc/integration/ent/file"
+	"entgo.io/ent/entc/integration/ent/filetype"
+	"entgo.io/ent/entc/integration/ent/group"
+	"entgo.io/ent/entc/integration/ent/item"
+	"entgo.io/ent/entc/integration/ent/role"
+	"entgo.io/ent/entc/integration/ent/schema"
+	schemadir "entgo.io/ent/entc/integration/ent/schema/dir"
+	"entgo.io/ent/entc/integration/ent/schema/task"
+	enttask "entgo.io/ent/entc/integration/ent/task"
+	"entgo.io/ent/entc/integration/ent/user"
+	"github.com/google/uuid"
+)
+
+// quickAttempts is the number of attempts for generating a
+// value that passes the validators of its field.
+const quickAttempts = 100
+
+// quickSeq is used for generating distinct values for unique fields.
+var quickSeq int64
+
+// Generate implements the quick.Generator interface. It returns a random *Api (see GenerateApi),
+// and allows using Api entities as arguments of property functions that are checked by quick.Check.
+func (*Api) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateApi(r, size))
+}
+
+// GenerateApi returns a Api with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use ApiClient.Generate for creating it.
+func GenerateApi(r *rand.Rand, size int) *Api {
+	a := &Api{}
+	return a
+}
+
+// Generate returns a builder for creating a Api entity with random field values (see GenerateApi).
+// Required edges should be set on the returned builder before it is saved.
+func (c *APIClient) Generate(r *rand.Rand, size int) *APICreate {
+	create := c.Create()
+	return create
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Builder (see GenerateBuilder),
+// and allows using Builder entities as arguments of property functions that are checked by quick.Check.
+func (*Builder) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateBuilder(r, size))
+}
+
+// GenerateBuilder returns a Builder with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use BuilderClient.Generate for creating it.
+func GenerateBuilder(r *rand.Rand, size int) *Builder {
+	b := &Builder{}
+	return b
+}
+
+// Generate returns a builder for creating a Builder entity with random field values (see GenerateBuilder).
+// Required edges should be set on the returned builder before it is saved.
+func (c *BuilderClient) Generate(r *rand.Rand, size int) *BuilderCreate {
+	create := c.Create()
+	return create
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Card (see GenerateCard),
+// and allows using Card entities as arguments of property functions that are checked by quick.Check.
+func (*Card) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateCard(r, size))
+}
+
+// GenerateCard returns a Card with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use CardClient.Generate for creating it.
+func GenerateCard(r *rand.Rand, size int) *Card {
+	c := &Card{}
+	if v, ok := quickCardCreateTime(r, size); ok {
+		c.CreateTime = v
+	}
+	if v, ok := quickCardUpdateTime(r, size); ok {
+		c.UpdateTime = v
+	}
+	if v, ok := quickCardBalance(r, size); ok {
+		c.Balance = v
+	}
+	if v, ok := quickCardNumber(r, size); ok {
+		c.Number = v
+	}
+	if v, ok := quickCardName(r, size); ok {
+		c.Name = v
+	}
+	return c
+}
+
+// Generate returns a builder for creating a Card entity with random field values (see GenerateCard).
+// Required edges should be set on the returned builder before it is saved.
+func (c *CardClient) Generate(r *rand.Rand, size int) *CardCreate {
+	create := c.Create()
+	if v, ok := quickCardCreateTime(r, size); ok {
+		create.SetCreateTime(v)
+	}
+	if v, ok := quickCardUpdateTime(r, size); ok {
+		create.SetUpdateTime(v)
+	}
+	if v, ok := quickCardBalance(r, size); ok {
+		create.SetBalance(v)
+	}
+	if v, ok := quickCardNumber(r, size); ok {
+		create.SetNumber(v)
+	}
+	if v, ok := quickCardName(r, size); ok {
+		create.SetName(v)
+	}
+	return create
+}
+
+// quickCardCreateTime returns a random value for the "create_time" field.
+func quickCardCreateTime(r *rand.Rand, size int) (v time.Time, ok bool) {
+	v = quickTime(r)
+	return v, true
+}
+
+// quickCardUpdateTime returns a random value for the "update_time" field.
+func quickCardUpdateTime(r *rand.Rand, size int) (v time.Time, ok bool) {
+	v = quickTime(r)
+	return v, true
+}
+
+// quickCardBalance returns a random value for the "balance" field.
+func quickCardBalance(r *rand.Rand, size int) (v float64, ok bool) {
+	v = float64(quickFloat(r, size))
+	return v, true
+}
+
+// quickCardNumber returns a random value for the "number" field.
+func quickCardNumber(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	for i := 0; i < quickAttempts && card.NumberValidator(v) != nil; i++ {
+		v = quickString(r, size, false)
+	}
+	return v, true
+}
+
+// quickCardName returns a random value for the "name" field. The ok result is false if the field was left unset.
+func quickCardName(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	for i := 0; i < quickAttempts && card.NameValidator(v) != nil; i++ {
+		v = quickString(r, size, false)
+	}
+	if card.NameValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Comment (see GenerateComment),
+// and allows using Comment entities as arguments of property functions that are checked by quick.Check.
+func (*Comment) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateComment(r, size))
+}
+
+// GenerateComment returns a Comment with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use CommentClient.Generate for creating it.
+func GenerateComment(r *rand.Rand, size int) *Comment {
+	c := &Comment{}
+	if v, ok := quickCommentUniqueInt(r, size); ok {
+		c.UniqueInt = v
+	}
+	if v, ok := quickCommentUniqueFloat(r, size); ok {
+		c.UniqueFloat = v
+	}
+	if v, ok := quickCommentNillableInt(r, size); ok {
+		c.NillableInt = &v
+	}
+	if v, ok := quickCommentTable(r, size); ok {
+		c.Table = v
+	}
+	if v, ok := quickCommentDir(r, size); ok {
+		c.Dir = v
+	}
+	if v, ok := quickCommentClient(r, size); ok {
+		c.Client = v
+	}
+	return c
+}
+
+// Generate returns a builder for creating a Comment entity with random field values (see GenerateComment).
+// Required edges should be set on the returned builder before it is saved.
+func (c *CommentClient) Generate(r *rand.Rand, size int) *CommentCreate {
+	create := c.Create()
+	if v, ok := quickCommentUniqueInt(r, size); ok {
+		create.SetUniqueInt(v)
+	}
+	if v, ok := quickCommentUniqueFloat(r, size); ok {
+		create.SetUniqueFloat(v)
+	}
+	if v, ok := quickCommentNillableInt(r, size); ok {
+		create.SetNillableInt(v)
+	}
+	if v, ok := quickCommentTable(r, size); ok {
+		create.SetTable(v)
+	}
+	if v, ok := quickCommentDir(r, size); ok {
+		create.SetDir(v)
+	}
+	if v, ok := quickCommentClient(r, size); ok {
+		create.SetClient(v)
+	}
+	return create
+}
+
+// quickCommentUniqueInt returns a random value for the "unique_int" field.
+func quickCommentUniqueInt(r *rand.Rand, size int) (v int, ok bool) {
+	v = int(atomic.AddInt64(&quickSeq, 1))
+	return v, true
+}
+
+// quickCommentUniqueFloat returns a random value for the "unique_float" field.
+func quickCommentUniqueFloat(r *rand.Rand, size int) (v float64, ok bool) {
+	v = float64(atomic.AddInt64(&quickSeq, 1))
+	return v, true
+}
+
+// quickCommentNillableInt returns a random value for the "nillable_int" field. The ok result is false if the field was left unset.
+func quickCommentNillableInt(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickCommentTable returns a random value for the "table" field. The ok result is false if the field was left unset.
+func quickCommentTable(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickCommentDir returns a random value for the "dir" field. The ok result is false if the field was left unset.
+func quickCommentDir(r *rand.Rand, size int) (v schemadir.Dir, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[schemadir.Dir](r)
+	return v, true
+}
+
+// quickCommentClient returns a random value for the "client" field. The ok result is false if the field was left unset.
+func quickCommentClient(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *ExValueScan (see GenerateExValueScan),
+// and allows using ExValueScan entities as arguments of property functions that are checked by quick.Check.
+func (*ExValueScan) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateExValueScan(r, size))
+}
+
+// GenerateExValueScan returns a ExValueScan with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use ExValueScanClient.Generate for creating it.
+func GenerateExValueScan(r *rand.Rand, size int) *ExValueScan {
+	evs := &ExValueScan{}
+	if v, ok := quickExValueScanBinary(r, size); ok {
+		evs.Binary = v
+	}
+	if v, ok := quickExValueScanBinaryOptional(r, size); ok {
+		evs.BinaryOptional = v
+	}
+	if v, ok := quickExValueScanText(r, size); ok {
+		evs.Text = v
+	}
+	if v, ok := quickExValueScanTextOptional(r, size); ok {
+		evs.TextOptional = v
+	}
+	if v, ok := quickExValueScanBase64(r, size); ok {
+		evs.Base64 = v
+	}
+	if v, ok := quickExValueScanCustom(r, size); ok {
+		evs.Custom = v
+	}
+	if v, ok := quickExValueScanCustomOptional(r, size); ok {
+		evs.CustomOptional = v
+	}
+	return evs
+}
+
+// Generate returns a builder for creating a ExValueScan entity with random field values (see GenerateExValueScan).
+// Required edges should be set on the returned builder before it is saved.
+func (c *ExValueScanClient) Generate(r *rand.Rand, size int) *ExValueScanCreate {
+	create := c.Create()
+	if v, ok := quickExValueScanBinary(r, size); ok {
+		create.SetBinary(v)
+	}
+	if v, ok := quickExValueScanBinaryOptional(r, size); ok {
+		create.SetBinaryOptional(v)
+	}
+	if v, ok := quickExValueScanText(r, size); ok {
+		create.SetText(v)
+	}
+	if v, ok := quickExValueScanTextOptional(r, size); ok {
+		create.SetTextOptional(v)
+	}
+	if v, ok := quickExValueScanBase64(r, size); ok {
+		create.SetBase64(v)
+	}
+	if v, ok := quickExValueScanCustom(r, size); ok {
+		create.SetCustom(v)
+	}
+	if v, ok := quickExValueScanCustomOptional(r, size); ok {
+		create.SetCustomOptional(v)
+	}
+	return create
+}
+
+// quickExValueScanBinary returns a random value for the "binary" field.
+func quickExValueScanBinary(r *rand.Rand, size int) (v *url.URL, ok bool) {
+	v = quickValue[*url.URL](r)
+	return v, true
+}
+
+// quickExValueScanBinaryOptional returns a random value for the "binary_optional" field. The ok result is false if the field was left unset.
+func quickExValueScanBinaryOptional(r *rand.Rand, size int) (v *url.URL, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*url.URL](r)
+	return v, true
+}
+
+// quickExValueScanText returns a random value for the "text" field.
+func quickExValueScanText(r *rand.Rand, size int) (v *big.Int, ok bool) {
+	v = quickValue[*big.Int](r)
+	return v, true
+}
+
+// quickExValueScanTextOptional returns a random value for the "text_optional" field. The ok result is false if the field was left unset.
+func quickExValueScanTextOptional(r *rand.Rand, size int) (v *big.Int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*big.Int](r)
+	return v, true
+}
+
+// quickExValueScanBase64 returns a random value for the "base64" field.
+func quickExValueScanBase64(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickExValueScanCustom returns a random value for the "custom" field.
+func quickExValueScanCustom(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickExValueScanCustomOptional returns a random value for the "custom_optional" field. The ok result is false if the field was left unset.
+func quickExValueScanCustomOptional(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *FieldType (see GenerateFieldType),
+// and allows using FieldType entities as arguments of property functions that are checked by quick.Check.
+func (*FieldType) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateFieldType(r, size))
+}
+
+// GenerateFieldType returns a FieldType with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use FieldTypeClient.Generate for creating it.
+func GenerateFieldType(r *rand.Rand, size int) *FieldType {
+	ft := &FieldType{}
+	if v, ok := quickFieldTypeInt(r, size); ok {
+		ft.Int = v
+	}
+	if v, ok := quickFieldTypeInt8(r, size); ok {
+		ft.Int8 = v
+	}
+	if v, ok := quickFieldTypeInt16(r, size); ok {
+		ft.Int16 = v
+	}
+	if v, ok := quickFieldTypeInt32(r, size); ok {
+		ft.Int32 = v
+	}
+	if v, ok := quickFieldTypeInt64(r, size); ok {
+		ft.Int64 = v
+	}
+	if v, ok := quickFieldTypeOptionalInt(r, size); ok {
+		ft.OptionalInt = v
+	}
+	if v, ok := quickFieldTypeOptionalInt8(r, size); ok {
+		ft.OptionalInt8 = v
+	}
+	if v, ok := quickFieldTypeOptionalInt16(r, size); ok {
+		ft.OptionalInt16 = v
+	}
+	if v, ok := quickFieldTypeOptionalInt32(r, size); ok {
+		ft.OptionalInt32 = v
+	}
+	if v, ok := quickFieldTypeOptionalInt64(r, size); ok {
+		ft.OptionalInt64 = v
+	}
+	if v, ok := quickFieldTypeNillableInt(r, size); ok {
+		ft.NillableInt = &v
+	}
+	if v, ok := quickFieldTypeNillableInt8(r, size); ok {
+		ft.NillableInt8 = &v
+	}
+	if v, ok := quickFieldTypeNillableInt16(r, size); ok {
+		ft.NillableInt16 = &v
+	}
+	if v, ok := quickFieldTypeNillableInt32(r, size); ok {
+		ft.NillableInt32 = &v
+	}
+	if v, ok := quickFieldTypeNillableInt64(r, size); ok {
+		ft.NillableInt64 = &v
+	}
+	if v, ok := quickFieldTypeValidateOptionalInt32(r, size); ok {
+		ft.ValidateOptionalInt32 = v
+	}
+	if v, ok := quickFieldTypeOptionalUint(r, size); ok {
+		ft.OptionalUint = v
+	}
+	if v, ok := quickFieldTypeOptionalUint8(r, size); ok {
+		ft.OptionalUint8 = v
+	}
+	if v, ok := quickFieldTypeOptionalUint16(r, size); ok {
+		ft.OptionalUint16 = v
+	}
+	if v, ok := quickFieldTypeOptionalUint32(r, size); ok {
+		ft.OptionalUint32 = v
+	}
+	if v, ok := quickFieldTypeOptionalUint64(r, size); ok {
+		ft.OptionalUint64 = v
+	}
+	if v, ok := quickFieldTypeState(r, size); ok {
+		ft.State = v
+	}
+	if v, ok := quickFieldTypeOptionalFloat(r, size); ok {
+		ft.OptionalFloat = v
+	}
+	if v, ok := quickFieldTypeOptionalFloat32(r, size); ok {
+		ft.OptionalFloat32 = v
+	}
+	if v, ok := quickFieldTypeText(r, size); ok {
+		ft.Text = v
+	}
+	if v, ok := quickFieldTypeDatetime(r, size); ok {
+		ft.Datetime = v
+	}
+	if v, ok := quickFieldTypeDecimal(r, size); ok {
+		ft.Decimal = v
+	}
+	if v, ok := quickFieldTypeLinkOther(r, size); ok {
+		ft.LinkOther = v
+	}
+	if v, ok := quickFieldTypeLinkOtherFunc(r, size); ok {
+		ft.LinkOtherFunc = v
+	}
+	if v, ok := quickFieldTypeMAC(r, size); ok {
+		ft.MAC = v
+	}
+	if v, ok := quickFieldTypeStringArray(r, size); ok {
+		ft.StringArray = v
+	}
+	if v, ok := quickFieldTypePassword(r, size); ok {
+		ft.Password = v
+	}
+	if v, ok := quickFieldTypeStringScanner(r, size); ok {
+		ft.StringScanner = &v
+	}
+	if v, ok := quickFieldTypeDuration(r, size); ok {
+		ft.Duration = v
+	}
+	if v, ok := quickFieldTypeDir(r, size); ok {
+		ft.Dir = v
+	}
+	if v, ok := quickFieldTypeNdir(r, size); ok {
+		ft.Ndir = &v
+	}
+	if v, ok := quickFieldTypeStr(r, size); ok {
+		ft.Str = v
+	}
+	if v, ok := quickFieldTypeNullStr(r, size); ok {
+		ft.NullStr = v
+	}
+	if v, ok := quickFieldTypeLink(r, size); ok {
+		ft.Link = v
+	}
+	if v, ok := quickFieldTypeNullLink(r, size); ok {
+		ft.NullLink = v
+	}
+	if v, ok := quickFieldTypeActive(r, size); ok {
+		ft.Active = v
+	}
+	if v, ok := quickFieldTypeNullActive(r, size); ok {
+		ft.NullActive = &v
+	}
+	if v, ok := quickFieldTypeDeleted(r, size); ok {
+		ft.Deleted = v
+	}
+	if v, ok := quickFieldTypeDeletedAt(r, size); ok {
+		ft.DeletedAt = v
+	}
+	if v, ok := quickFieldTypeRawData(r, size); ok {
+		ft.RawData = v
+	}
+	if v, ok := quickFieldTypeSensitive(r, size); ok {
+		ft.Sensitive = v
+	}
+	if v, ok := quickFieldTypeIP(r, size); ok {
+		ft.IP = v
+	}
+	if v, ok := quickFieldTypeNullInt64(r, size); ok {
+		ft.NullInt64 = v
+	}
+	if v, ok := quickFieldTypeSchemaInt(r, size); ok {
+		ft.SchemaInt = v
+	}
+	if v, ok := quickFieldTypeSchemaInt8(r, size); ok {
+		ft.SchemaInt8 = v
+	}
+	if v, ok := quickFieldTypeSchemaInt64(r, size); ok {
+		ft.SchemaInt64 = v
+	}
+	if v, ok := quickFieldTypeSchemaFloat(r, size); ok {
+		ft.SchemaFloat = v
+	}
+	if v, ok := quickFieldTypeSchemaFloat32(r, size); ok {
+		ft.SchemaFloat32 = v
+	}
+	if v, ok := quickFieldTypeNullFloat(r, size); ok {
+		ft.NullFloat = v
+	}
+	if v, ok := quickFieldTypeRole(r, size); ok {
+		ft.Role = v
+	}
+	if v, ok := quickFieldTypePriority(r, size); ok {
+		ft.Priority = v
+	}
+	if v, ok := quickFieldTypeOptionalUUID(r, size); ok {
+		ft.OptionalUUID = v
+	}
+	if v, ok := quickFieldTypeNillableUUID(r, size); ok {
+		ft.NillableUUID = &v
+	}
+	if v, ok := quickFieldTypeStrings(r, size); ok {
+		ft.Strings = v
+	}
+	if v, ok := quickFieldTypePair(r, size); ok {
+		ft.Pair = v
+	}
+	if v, ok := quickFieldTypeNilPair(r, size); ok {
+		ft.NilPair = v
+	}
+	if v, ok := quickFieldTypeVstring(r, size); ok {
+		ft.Vstring = v
+	}
+	if v, ok := quickFieldTypeTriple(r, size); ok {
+		ft.Triple = v
+	}
+	if v, ok := quickFieldTypeBigInt(r, size); ok {
+		ft.BigInt = v
+	}
+	if v, ok := quickFieldTypePasswordOther(r, size); ok {
+		ft.PasswordOther = v
+	}
+	return ft
+}
+
+// Generate returns a builder for creating a FieldType entity with random field values (see GenerateFieldType).
+// Required edges should be set on the returned builder before it is saved.
+func (c *FieldTypeClient) Generate(r *rand.Rand, size int) *FieldTypeCreate {
+	create := c.Create()
+	if v, ok := quickFieldTypeInt(r, size); ok {
+		create.SetInt(v)
+	}
+	if v, ok := quickFieldTypeInt8(r, size); ok {
+		create.SetInt8(v)
+	}
+	if v, ok := quickFieldTypeInt16(r, size); ok {
+		create.SetInt16(v)
+	}
+	if v, ok := quickFieldTypeInt32(r, size); ok {
+		create.SetInt32(v)
+	}
+	if v, ok := quickFieldTypeInt64(r, size); ok {
+		create.SetInt64(v)
+	}
+	if v, ok := quickFieldTypeOptionalInt(r, size); ok {
+		create.SetOptionalInt(v)
+	}
+	if v, ok := quickFieldTypeOptionalInt8(r, size); ok {
+		create.SetOptionalInt8(v)
+	}
+	if v, ok := quickFieldTypeOptionalInt16(r, size); ok {
+		create.SetOptionalInt16(v)
+	}
+	if v, ok := quickFieldTypeOptionalInt32(r, size); ok {
+		create.SetOptionalInt32(v)
+	}
+	if v, ok := quickFieldTypeOptionalInt64(r, size); ok {
+		create.SetOptionalInt64(v)
+	}
+	if v, ok := quickFieldTypeNillableInt(r, size); ok {
+		create.SetNillableInt(v)
+	}
+	if v, ok := quickFieldTypeNillableInt8(r, size); ok {
+		create.SetNillableInt8(v)
+	}
+	if v, ok := quickFieldTypeNillableInt16(r, size); ok {
+		create.SetNillableInt16(v)
+	}
+	if v, ok := quickFieldTypeNillableInt32(r, size); ok {
+		create.SetNillableInt32(v)
+	}
+	if v, ok := quickFieldTypeNillableInt64(r, size); ok {
+		create.SetNillableInt64(v)
+	}
+	if v, ok := quickFieldTypeValidateOptionalInt32(r, size); ok {
+		create.SetValidateOptionalInt32(v)
+	}
+	if v, ok := quickFieldTypeOptionalUint(r, size); ok {
+		create.SetOptionalUint(v)
+	}
+	if v, ok := quickFieldTypeOptionalUint8(r, size); ok {
+		create.SetOptionalUint8(v)
+	}
+	if v, ok := quickFieldTypeOptionalUint16(r, size); ok {
+		create.SetOptionalUint16(v)
+	}
+	if v, ok := quickFieldTypeOptionalUint32(r, size); ok {
+		create.SetOptionalUint32(v)
+	}
+	if v, ok := quickFieldTypeOptionalUint64(r, size); ok {
+		create.SetOptionalUint64(v)
+	}
+	if v, ok := quickFieldTypeState(r, size); ok {
+		create.SetState(v)
+	}
+	if v, ok := quickFieldTypeOptionalFloat(r, size); ok {
+		create.SetOptionalFloat(v)
+	}
+	if v, ok := quickFieldTypeOptionalFloat32(r, size); ok {
+		create.SetOptionalFloat32(v)
+	}
+	if v, ok := quickFieldTypeText(r, size); ok {
+		create.SetText(v)
+	}
+	if v, ok := quickFieldTypeDatetime(r, size); ok {
+		create.SetDatetime(v)
+	}
+	if v, ok := quickFieldTypeDecimal(r, size); ok {
+		create.SetDecimal(v)
+	}
+	if v, ok := quickFieldTypeLinkOther(r, size); ok {
+		create.SetLinkOther(v)
+	}
+	if v, ok := quickFieldTypeLinkOtherFunc(r, size); ok {
+		create.SetLinkOtherFunc(v)
+	}
+	if v, ok := quickFieldTypeMAC(r, size); ok {
+		create.SetMAC(v)
+	}
+	if v, ok := quickFieldTypeStringArray(r, size); ok {
+		create.SetStringArray(v)
+	}
+	if v, ok := quickFieldTypePassword(r, size); ok {
+		create.SetPassword(v)
+	}
+	if v, ok := quickFieldTypeStringScanner(r, size); ok {
+		create.SetStringScanner(v)
+	}
+	if v, ok := quickFieldTypeDuration(r, size); ok {
+		create.SetDuration(v)
+	}
+	if v, ok := quickFieldTypeDir(r, size); ok {
+		create.SetDir(v)
+	}
+	if v, ok := quickFieldTypeNdir(r, size); ok {
+		create.SetNdir(v)
+	}
+	if v, ok := quickFieldTypeStr(r, size); ok {
+		create.SetStr(v)
+	}
+	if v, ok := quickFieldTypeNullStr(r, size); ok {
+		create.SetNullStr(v)
+	}
+	if v, ok := quickFieldTypeLink(r, size); ok {
+		create.SetLink(v)
+	}
+	if v, ok := quickFieldTypeNullLink(r, size); ok {
+		create.SetNullLink(v)
+	}
+	if v, ok := quickFieldTypeActive(r, size); ok {
+		create.SetActive(v)
+	}
+	if v, ok := quickFieldTypeNullActive(r, size); ok {
+		create.SetNullActive(v)
+	}
+	if v, ok := quickFieldTypeDeleted(r, size); ok {
+		create.SetDeleted(v)
+	}
+	if v, ok := quickFieldTypeDeletedAt(r, size); ok {
+		create.SetDeletedAt(v)
+	}
+	if v, ok := quickFieldTypeRawData(r, size); ok {
+		create.SetRawData(v)
+	}
+	if v, ok := quickFieldTypeSensitive(r, size); ok {
+		create.SetSensitive(v)
+	}
+	if v, ok := quickFieldTypeIP(r, size); ok {
+		create.SetIP(v)
+	}
+	if v, ok := quickFieldTypeNullInt64(r, size); ok {
+		create.SetNullInt64(v)
+	}
+	if v, ok := quickFieldTypeSchemaInt(r, size); ok {
+		create.SetSchemaInt(v)
+	}
+	if v, ok := quickFieldTypeSchemaInt8(r, size); ok {
+		create.SetSchemaInt8(v)
+	}
+	if v, ok := quickFieldTypeSchemaInt64(r, size); ok {
+		create.SetSchemaInt64(v)
+	}
+	if v, ok := quickFieldTypeSchemaFloat(r, size); ok {
+		create.SetSchemaFloat(v)
+	}
+	if v, ok := quickFieldTypeSchemaFloat32(r, size); ok {
+		create.SetSchemaFloat32(v)
+	}
+	if v, ok := quickFieldTypeNullFloat(r, size); ok {
+		create.SetNullFloat(v)
+	}
+	if v, ok := quickFieldTypeRole(r, size); ok {
+		create.SetRole(v)
+	}
+	if v, ok := quickFieldTypePriority(r, size); ok {
+		create.SetPriority(v)
+	}
+	if v, ok := quickFieldTypeOptionalUUID(r, size); ok {
+		create.SetOptionalUUID(v)
+	}
+	if v, ok := quickFieldTypeNillableUUID(r, size); ok {
+		create.SetNillableUUID(v)
+	}
+	if v, ok := quickFieldTypeStrings(r, size); ok {
+		create.SetStrings(v)
+	}
+	if v, ok := quickFieldTypePair(r, size); ok {
+		create.SetPair(v)
+	}
+	if v, ok := quickFieldTypeNilPair(r, size); ok {
+		create.SetNilPair(v)
+	}
+	if v, ok := quickFieldTypeVstring(r, size); ok {
+		create.SetVstring(v)
+	}
+	if v, ok := quickFieldTypeTriple(r, size); ok {
+		create.SetTriple(v)
+	}
+	if v, ok := quickFieldTypeBigInt(r, size); ok {
+		create.SetBigInt(v)
+	}
+	if v, ok := quickFieldTypePasswordOther(r, size); ok {
+		create.SetPasswordOther(v)
+	}
+	return create
+}
+
+// quickFieldTypeInt returns a random value for the "int" field.
+func quickFieldTypeInt(r *rand.Rand, size int) (v int, ok bool) {
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeInt8 returns a random value for the "int8" field.
+func quickFieldTypeInt8(r *rand.Rand, size int) (v int8, ok bool) {
+	v = int8(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeInt16 returns a random value for the "int16" field.
+func quickFieldTypeInt16(r *rand.Rand, size int) (v int16, ok bool) {
+	v = int16(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeInt32 returns a random value for the "int32" field.
+func quickFieldTypeInt32(r *rand.Rand, size int) (v int32, ok bool) {
+	v = int32(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeInt64 returns a random value for the "int64" field.
+func quickFieldTypeInt64(r *rand.Rand, size int) (v int64, ok bool) {
+	v = int64(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeOptionalInt returns a random value for the "optional_int" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalInt(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeOptionalInt8 returns a random value for the "optional_int8" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalInt8(r *rand.Rand, size int) (v int8, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int8(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeOptionalInt16 returns a random value for the "optional_int16" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalInt16(r *rand.Rand, size int) (v int16, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int16(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeOptionalInt32 returns a random value for the "optional_int32" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalInt32(r *rand.Rand, size int) (v int32, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int32(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeOptionalInt64 returns a random value for the "optional_int64" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalInt64(r *rand.Rand, size int) (v int64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int64(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeNillableInt returns a random value for the "nillable_int" field. The ok result is false if the field was left unset.
+func quickFieldTypeNillableInt(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeNillableInt8 returns a random value for the "nillable_int8" field. The ok result is false if the field was left unset.
+func quickFieldTypeNillableInt8(r *rand.Rand, size int) (v int8, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int8(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeNillableInt16 returns a random value for the "nillable_int16" field. The ok result is false if the field was left unset.
+func quickFieldTypeNillableInt16(r *rand.Rand, size int) (v int16, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int16(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeNillableInt32 returns a random value for the "nillable_int32" field. The ok result is false if the field was left unset.
+func quickFieldTypeNillableInt32(r *rand.Rand, size int) (v int32, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int32(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeNillableInt64 returns a random value for the "nillable_int64" field. The ok result is false if the field was left unset.
+func quickFieldTypeNillableInt64(r *rand.Rand, size int) (v int64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int64(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeValidateOptionalInt32 returns a random value for the "validate_optional_int32" field. The ok result is false if the field was left unset.
+func quickFieldTypeValidateOptionalInt32(r *rand.Rand, size int) (v int32, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int32(quickInt(r, size, true))
+	for i := 0; i < quickAttempts && fieldtype.ValidateOptionalInt32Validator(v) != nil; i++ {
+		v = int32(quickInt(r, size, true))
+	}
+	if fieldtype.ValidateOptionalInt32Validator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFieldTypeOptionalUint returns a random value for the "optional_uint" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalUint(r *rand.Rand, size int) (v uint, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = uint(quickInt(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeOptionalUint8 returns a random value for the "optional_uint8" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalUint8(r *rand.Rand, size int) (v uint8, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = uint8(quickInt(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeOptionalUint16 returns a random value for the "optional_uint16" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalUint16(r *rand.Rand, size int) (v uint16, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = uint16(quickInt(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeOptionalUint32 returns a random value for the "optional_uint32" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalUint32(r *rand.Rand, size int) (v uint32, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = uint32(quickInt(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeOptionalUint64 returns a random value for the "optional_uint64" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalUint64(r *rand.Rand, size int) (v uint64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = uint64(quickInt(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeState returns a random value for the "state" field. The ok result is false if the field was left unset.
+func quickFieldTypeState(r *rand.Rand, size int) (v fieldtype.State, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = []fieldtype.State{fieldtype.State("on"), fieldtype.State("off")}[r.Intn(2)]
+	return v, true
+}
+
+// quickFieldTypeOptionalFloat returns a random value for the "optional_float" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalFloat(r *rand.Rand, size int) (v float64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = float64(quickFloat(r, size))
+	return v, true
+}
+
+// quickFieldTypeOptionalFloat32 returns a random value for the "optional_float32" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalFloat32(r *rand.Rand, size int) (v float32, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = float32(quickFloat(r, size))
+	return v, true
+}
+
+// quickFieldTypeText returns a random value for the "text" field. The ok result is false if the field was left unset.
+func quickFieldTypeText(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickFieldTypeDatetime returns a random value for the "datetime" field. The ok result is false if the field was left unset.
+func quickFieldTypeDatetime(r *rand.Rand, size int) (v time.Time, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickTime(r)
+	return v, true
+}
+
+// quickFieldTypeDecimal returns a random value for the "decimal" field. The ok result is false if the field was left unset.
+func quickFieldTypeDecimal(r *rand.Rand, size int) (v float64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = float64(quickFloat(r, size))
+	return v, true
+}
+
+// quickFieldTypeLinkOther returns a random value for the "link_other" field. The ok result is false if the field was left unset.
+func quickFieldTypeLinkOther(r *rand.Rand, size int) (v *schema.Link, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*schema.Link](r)
+	return v, true
+}
+
+// quickFieldTypeLinkOtherFunc returns a random value for the "link_other_func" field. The ok result is false if the field was left unset.
+func quickFieldTypeLinkOtherFunc(r *rand.Rand, size int) (v *schema.Link, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*schema.Link](r)
+	return v, true
+}
+
+// quickFieldTypeMAC returns a random value for the "mac" field. The ok result is false if the field was left unset.
+func quickFieldTypeMAC(r *rand.Rand, size int) (v schema.MAC, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickScan[schema.MAC](quickString(r, size, false))
+	for i := 0; i < quickAttempts && fieldtype.MACValidator(v.String()) != nil; i++ {
+		v = quickScan[schema.MAC](quickString(r, size, false))
+	}
+	if fieldtype.MACValidator(v.String()) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFieldTypeStringArray returns a random value for the "string_array" field. The ok result is false if the field was left unset.
+func quickFieldTypeStringArray(r *rand.Rand, size int) (v schema.Strings, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[schema.Strings](r)
+	return v, true
+}
+
+// quickFieldTypePassword returns a random value for the "password" field. The ok result is false if the field was left unset.
+func quickFieldTypePassword(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickFieldTypeStringScanner returns a random value for the "string_scanner" field. The ok result is false if the field was left unset.
+func quickFieldTypeStringScanner(r *rand.Rand, size int) (v schema.StringScanner, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.StringScanner(quickString(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeDuration returns a random value for the "duration" field. The ok result is false if the field was left unset.
+func quickFieldTypeDuration(r *rand.Rand, size int) (v time.Duration, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = time.Duration(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeDir returns a random value for the "dir" field.
+func quickFieldTypeDir(r *rand.Rand, size int) (v http.Dir, ok bool) {
+	v = http.Dir(quickString(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeNdir returns a random value for the "ndir" field. The ok result is false if the field was left unset.
+func quickFieldTypeNdir(r *rand.Rand, size int) (v http.Dir, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = http.Dir(quickString(r, size, false))
+	for i := 0; i < quickAttempts && fieldtype.NdirValidator(string(v)) != nil; i++ {
+		v = http.Dir(quickString(r, size, false))
+	}
+	if fieldtype.NdirValidator(string(v)) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFieldTypeStr returns a random value for the "str" field. The ok result is false if the field was left unset.
+func quickFieldTypeStr(r *rand.Rand, size int) (v sql.NullString, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickScan[sql.NullString](quickString(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeNullStr returns a random value for the "null_str" field. The ok result is false if the field was left unset.
+func quickFieldTypeNullStr(r *rand.Rand, size int) (v *sql.NullString, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*sql.NullString](r)
+	return v, true
+}
+
+// quickFieldTypeLink returns a random value for the "link" field. The ok result is false if the field was left unset.
+func quickFieldTypeLink(r *rand.Rand, size int) (v schema.Link, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickScan[schema.Link](quickString(r, size, false))
+	for i := 0; i < quickAttempts && fieldtype.LinkValidator(v.String()) != nil; i++ {
+		v = quickScan[schema.Link](quickString(r, size, false))
+	}
+	if fieldtype.LinkValidator(v.String()) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFieldTypeNullLink returns a random value for the "null_link" field. The ok result is false if the field was left unset.
+func quickFieldTypeNullLink(r *rand.Rand, size int) (v *schema.Link, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*schema.Link](r)
+	return v, true
+}
+
+// quickFieldTypeActive returns a random value for the "active" field. The ok result is false if the field was left unset.
+func quickFieldTypeActive(r *rand.Rand, size int) (v schema.Status, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Status(r.Intn(2) == 1)
+	return v, true
+}
+
+// quickFieldTypeNullActive returns a random value for the "null_active" field. The ok result is false if the field was left unset.
+func quickFieldTypeNullActive(r *rand.Rand, size int) (v schema.Status, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Status(r.Intn(2) == 1)
+	return v, true
+}
+
+// quickFieldTypeDeleted returns a random value for the "deleted" field. The ok result is false if the field was left unset.
+func quickFieldTypeDeleted(r *rand.Rand, size int) (v *sql.NullBool, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*sql.NullBool](r)
+	return v, true
+}
+
+// quickFieldTypeDeletedAt returns a random value for the "deleted_at" field. The ok result is false if the field was left unset.
+func quickFieldTypeDeletedAt(r *rand.Rand, size int) (v *sql.NullTime, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*sql.NullTime](r)
+	return v, true
+}
+
+// quickFieldTypeRawData returns a random value for the "raw_data" field. The ok result is false if the field was left unset.
+func quickFieldTypeRawData(r *rand.Rand, size int) (v []byte, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = []byte(quickBytes(r, size, false))
+	for i := 0; i < quickAttempts && fieldtype.RawDataValidator(v) != nil; i++ {
+		v = []byte(quickBytes(r, size, false))
+	}
+	if fieldtype.RawDataValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFieldTypeSensitive returns a random value for the "sensitive" field. The ok result is false if the field was left unset.
+func quickFieldTypeSensitive(r *rand.Rand, size int) (v []byte, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = []byte(quickBytes(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeIP returns a random value for the "ip" field. The ok result is false if the field was left unset.
+func quickFieldTypeIP(r *rand.Rand, size int) (v net.IP, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = net.IP(quickBytes(r, size, false))
+	for i := 0; i < quickAttempts && fieldtype.IPValidator([]byte(v)) != nil; i++ {
+		v = net.IP(quickBytes(r, size, false))
+	}
+	if fieldtype.IPValidator([]byte(v)) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFieldTypeNullInt64 returns a random value for the "null_int64" field. The ok result is false if the field was left unset.
+func quickFieldTypeNullInt64(r *rand.Rand, size int) (v *sql.NullInt64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*sql.NullInt64](r)
+	return v, true
+}
+
+// quickFieldTypeSchemaInt returns a random value for the "schema_int" field. The ok result is false if the field was left unset.
+func quickFieldTypeSchemaInt(r *rand.Rand, size int) (v schema.Int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeSchemaInt8 returns a random value for the "schema_int8" field. The ok result is false if the field was left unset.
+func quickFieldTypeSchemaInt8(r *rand.Rand, size int) (v schema.Int8, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Int8(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeSchemaInt64 returns a random value for the "schema_int64" field. The ok result is false if the field was left unset.
+func quickFieldTypeSchemaInt64(r *rand.Rand, size int) (v schema.Int64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Int64(quickInt(r, size, true))
+	return v, true
+}
+
+// quickFieldTypeSchemaFloat returns a random value for the "schema_float" field. The ok result is false if the field was left unset.
+func quickFieldTypeSchemaFloat(r *rand.Rand, size int) (v schema.Float64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Float64(quickFloat(r, size))
+	return v, true
+}
+
+// quickFieldTypeSchemaFloat32 returns a random value for the "schema_float32" field. The ok result is false if the field was left unset.
+func quickFieldTypeSchemaFloat32(r *rand.Rand, size int) (v schema.Float32, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = schema.Float32(quickFloat(r, size))
+	return v, true
+}
+
+// quickFieldTypeNullFloat returns a random value for the "null_float" field. The ok result is false if the field was left unset.
+func quickFieldTypeNullFloat(r *rand.Rand, size int) (v *sql.NullFloat64, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*sql.NullFloat64](r)
+	return v, true
+}
+
+// quickFieldTypeRole returns a random value for the "role" field.
+func quickFieldTypeRole(r *rand.Rand, size int) (v role.Role, ok bool) {
+	v = []role.Role{role.Role("ADMIN"), role.Role("OWNER"), role.Role("USER"), role.Role("READ"), role.Role("WRITE"), role.Role("READ+WRITE")}[r.Intn(6)]
+	return v, true
+}
+
+// quickFieldTypePriority returns a random value for the "priority" field. The ok result is false if the field was left unset.
+func quickFieldTypePriority(r *rand.Rand, size int) (v role.Priority, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickScan[role.Priority]([]string{"UNKNOWN", "LOW", "HIGH"}[r.Intn(3)])
+	return v, true
+}
+
+// quickFieldTypeOptionalUUID returns a random value for the "optional_uuid" field. The ok result is false if the field was left unset.
+func quickFieldTypeOptionalUUID(r *rand.Rand, size int) (v uuid.UUID, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[uuid.UUID](r)
+	return v, true
+}
+
+// quickFieldTypeNillableUUID returns a random value for the "nillable_uuid" field. The ok result is false if the field was left unset.
+func quickFieldTypeNillableUUID(r *rand.Rand, size int) (v uuid.UUID, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[uuid.UUID](r)
+	return v, true
+}
+
+// quickFieldTypeStrings returns a random value for the "strings" field. The ok result is false if the field was left unset.
+func quickFieldTypeStrings(r *rand.Rand, size int) (v []string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[[]string](r)
+	return v, true
+}
+
+// quickFieldTypePair returns a random value for the "pair" field.
+func quickFieldTypePair(r *rand.Rand, size int) (v schema.Pair, ok bool) {
+	v = quickValue[schema.Pair](r)
+	return v, true
+}
+
+// quickFieldTypeNilPair returns a random value for the "nil_pair" field. The ok result is false if the field was left unset.
+func quickFieldTypeNilPair(r *rand.Rand, size int) (v *schema.Pair, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[*schema.Pair](r)
+	return v, true
+}
+
+// quickFieldTypeVstring returns a random value for the "vstring" field.
+func quickFieldTypeVstring(r *rand.Rand, size int) (v schema.VString, ok bool) {
+	v = schema.VString(quickString(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeTriple returns a random value for the "triple" field.
+func quickFieldTypeTriple(r *rand.Rand, size int) (v schema.Triple, ok bool) {
+	v = quickScan[schema.Triple](quickString(r, size, false))
+	return v, true
+}
+
+// quickFieldTypeBigInt returns a random value for the "big_int" field. The ok result is false if the field was left unset.
+func quickFieldTypeBigInt(r *rand.Rand, size int) (v schema.BigInt, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[schema.BigInt](r)
+	return v, true
+}
+
+// quickFieldTypePasswordOther returns a random value for the "password_other" field. The ok result is false if the field was left unset.
+func quickFieldTypePasswordOther(r *rand.Rand, size int) (v schema.Password, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[schema.Password](r)
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *File (see GenerateFile),
+// and allows using File entities as arguments of property functions that are checked by quick.Check.
+func (*File) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateFile(r, size))
+}
+
+// GenerateFile returns a File with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use FileClient.Generate for creating it.
+func GenerateFile(r *rand.Rand, size int) *File {
+	f := &File{}
+	if v, ok := quickFileSize(r, size); ok {
+		f.Size = v
+	}
+	if v, ok := quickFileName(r, size); ok {
+		f.Name = v
+	}
+	if v, ok := quickFileUser(r, size); ok {
+		f.User = &v
+	}
+	if v, ok := quickFileGroup(r, size); ok {
+		f.Group = v
+	}
+	if v, ok := quickFileOp(r, size); ok {
+		f.Op = v
+	}
+	if v, ok := quickFileFieldID(r, size); ok {
+		f.FieldID = v
+	}
+	return f
+}
+
+// Generate returns a builder for creating a File entity with random field values (see GenerateFile).
+// Required edges should be set on the returned builder before it is saved.
+func (c *FileClient) Generate(r *rand.Rand, size int) *FileCreate {
+	create := c.Create()
+	if v, ok := quickFileSize(r, size); ok {
+		create.SetSize(v)
+	}
+	if v, ok := quickFileName(r, size); ok {
+		create.SetName(v)
+	}
+	if v, ok := quickFileUser(r, size); ok {
+		create.SetUser(v)
+	}
+	if v, ok := quickFileGroup(r, size); ok {
+		create.SetGroup(v)
+	}
+	if v, ok := quickFileOp(r, size); ok {
+		create.SetOp(v)
+	}
+	if v, ok := quickFileFieldID(r, size); ok {
+		create.SetFieldID(v)
+	}
+	return create
+}
+
+// quickFileSize returns a random value for the "size" field.
+func quickFileSize(r *rand.Rand, size int) (v int, ok bool) {
+	v = int(quickInt(r, size, true))
+	for i := 0; i < quickAttempts && file.SizeValidator(v) != nil; i++ {
+		v = int(quickInt(r, size, true))
+	}
+	if file.SizeValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickFileName returns a random value for the "name" field.
+func quickFileName(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickFileUser returns a random value for the "user" field. The ok result is false if the field was left unset.
+func quickFileUser(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickFileGroup returns a random value for the "group" field. The ok result is false if the field was left unset.
+func quickFileGroup(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickFileOp returns a random value for the "op" field. The ok result is false if the field was left unset.
+func quickFileOp(r *rand.Rand, size int) (v bool, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = r.Intn(2) == 1
+	return v, true
+}
+
+// quickFileFieldID returns a random value for the "field_id" field. The ok result is false if the field was left unset.
+func quickFileFieldID(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *FileType (see GenerateFileType),
+// and allows using FileType entities as arguments of property functions that are checked by quick.Check.
+func (*FileType) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateFileType(r, size))
+}
+
+// GenerateFileType returns a FileType with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use FileTypeClient.Generate for creating it.
+func GenerateFileType(r *rand.Rand, size int) *FileType {
+	ft := &FileType{}
+	if v, ok := quickFileTypeName(r, size); ok {
+		ft.Name = v
+	}
+	if v, ok := quickFileTypeType(r, size); ok {
+		ft.Type = v
+	}
+	if v, ok := quickFileTypeState(r, size); ok {
+		ft.State = v
+	}
+	return ft
+}
+
+// Generate returns a builder for creating a FileType entity with random field values (see GenerateFileType).
+// Required edges should be set on the returned builder before it is saved.
+func (c *FileTypeClient) Generate(r *rand.Rand, size int) *FileTypeCreate {
+	create := c.Create()
+	if v, ok := quickFileTypeName(r, size); ok {
+		create.SetName(v)
+	}
+	if v, ok := quickFileTypeType(r, size); ok {
+		create.SetType(v)
+	}
+	if v, ok := quickFileTypeState(r, size); ok {
+		create.SetState(v)
+	}
+	return create
+}
+
+// quickFileTypeName returns a random value for the "name" field.
+func quickFileTypeName(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, true)
+	return v, true
+}
+
+// quickFileTypeType returns a random value for the "type" field.
+func quickFileTypeType(r *rand.Rand, size int) (v filetype.Type, ok bool) {
+	v = []filetype.Type{filetype.Type("png"), filetype.Type("svg"), filetype.Type("jpg")}[r.Intn(3)]
+	return v, true
+}
+
+// quickFileTypeState returns a random value for the "state" field.
+func quickFileTypeState(r *rand.Rand, size int) (v filetype.State, ok bool) {
+	v = []filetype.State{filetype.State("ON"), filetype.State("OFF")}[r.Intn(2)]
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Goods (see GenerateGoods),
+// and allows using Goods entities as arguments of property functions that are checked by quick.Check.
+func (*Goods) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateGoods(r, size))
+}
+
+// GenerateGoods returns a Goods with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use GoodsClient.Generate for creating it.
+func GenerateGoods(r *rand.Rand, size int) *Goods {
+	_go := &Goods{}
+	return _go
+}
+
+// Generate returns a builder for creating a Goods entity with random field values (see GenerateGoods).
+// Required edges should be set on the returned builder before it is saved.
+func (c *GoodsClient) Generate(r *rand.Rand, size int) *GoodsCreate {
+	create := c.Create()
+	return create
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Group (see GenerateGroup),
+// and allows using Group entities as arguments of property functions that are checked by quick.Check.
+func (*Group) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateGroup(r, size))
+}
+
+// GenerateGroup returns a Group with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use GroupClient.Generate for creating it.
+func GenerateGroup(r *rand.Rand, size int) *Group {
+	gr := &Group{}
+	if v, ok := quickGroupActive(r, size); ok {
+		gr.Active = v
+	}
+	if v, ok := quickGroupExpire(r, size); ok {
+		gr.Expire = v
+	}
+	if v, ok := quickGroupType(r, size); ok {
+		gr.Type = &v
+	}
+	if v, ok := quickGroupMaxUsers(r, size); ok {
+		gr.MaxUsers = v
+	}
+	if v, ok := quickGroupName(r, size); ok {
+		gr.Name = v
+	}
+	return gr
+}
+
+// Generate returns a builder for creating a Group entity with random field values (see GenerateGroup).
+// Required edges should be set on the returned builder before it is saved.
+func (c *GroupClient) Generate(r *rand.Rand, size int) *GroupCreate {
+	create := c.Create()
+	if v, ok := quickGroupActive(r, size); ok {
+		create.SetActive(v)
+	}
+	if v, ok := quickGroupExpire(r, size); ok {
+		create.SetExpire(v)
+	}
+	if v, ok := quickGroupType(r, size); ok {
+		create.SetType(v)
+	}
+	if v, ok := quickGroupMaxUsers(r, size); ok {
+		create.SetMaxUsers(v)
+	}
+	if v, ok := quickGroupName(r, size); ok {
+		create.SetName(v)
+	}
+	return create
+}
+
+// quickGroupActive returns a random value for the "active" field.
+func quickGroupActive(r *rand.Rand, size int) (v bool, ok bool) {
+	v = r.Intn(2) == 1
+	return v, true
+}
+
+// quickGroupExpire returns a random value for the "expire" field.
+func quickGroupExpire(r *rand.Rand, size int) (v time.Time, ok bool) {
+	v = quickTime(r)
+	return v, true
+}
+
+// quickGroupType returns a random value for the "type" field. The ok result is false if the field was left unset.
+func quickGroupType(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	for i := 0; i < quickAttempts && group.TypeValidator(v) != nil; i++ {
+		v = quickString(r, size, false)
+	}
+	if group.TypeValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickGroupMaxUsers returns a random value for the "max_users" field. The ok result is false if the field was left unset.
+func quickGroupMaxUsers(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	for i := 0; i < quickAttempts && group.MaxUsersValidator(v) != nil; i++ {
+		v = int(quickInt(r, size, true))
+	}
+	if group.MaxUsersValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickGroupName returns a random value for the "name" field.
+func quickGroupName(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	for i := 0; i < quickAttempts && group.NameValidator(v) != nil; i++ {
+		v = quickString(r, size, false)
+	}
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *GroupInfo (see GenerateGroupInfo),
+// and allows using GroupInfo entities as arguments of property functions that are checked by quick.Check.
+func (*GroupInfo) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateGroupInfo(r, size))
+}
+
+// GenerateGroupInfo returns a GroupInfo with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use GroupInfoClient.Generate for creating it.
+func GenerateGroupInfo(r *rand.Rand, size int) *GroupInfo {
+	gi := &GroupInfo{}
+	if v, ok := quickGroupInfoDesc(r, size); ok {
+		gi.Desc = v
+	}
+	if v, ok := quickGroupInfoMaxUsers(r, size); ok {
+		gi.MaxUsers = v
+	}
+	return gi
+}
+
+// Generate returns a builder for creating a GroupInfo entity with random field values (see GenerateGroupInfo).
+// Required edges should be set on the returned builder before it is saved.
+func (c *GroupInfoClient) Generate(r *rand.Rand, size int) *GroupInfoCreate {
+	create := c.Create()
+	if v, ok := quickGroupInfoDesc(r, size); ok {
+		create.SetDesc(v)
+	}
+	if v, ok := quickGroupInfoMaxUsers(r, size); ok {
+		create.SetMaxUsers(v)
+	}
+	return create
+}
+
+// quickGroupInfoDesc returns a random value for the "desc" field.
+func quickGroupInfoDesc(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickGroupInfoMaxUsers returns a random value for the "max_users" field.
+func quickGroupInfoMaxUsers(r *rand.Rand, size int) (v int, ok bool) {
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Item (see GenerateItem),
+// and allows using Item entities as arguments of property functions that are checked by quick.Check.
+func (*Item) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateItem(r, size))
+}
+
+// GenerateItem returns a Item with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use ItemClient.Generate for creating it.
+func GenerateItem(r *rand.Rand, size int) *Item {
+	i := &Item{}
+	if v, ok := quickItemText(r, size); ok {
+		i.Text = v
+	}
+	return i
+}
+
+// Generate returns a builder for creating a Item entity with random field values (see GenerateItem).
+// Required edges should be set on the returned builder before it is saved.
+func (c *ItemClient) Generate(r *rand.Rand, size int) *ItemCreate {
+	create := c.Create()
+	if v, ok := quickItemText(r, size); ok {
+		create.SetText(v)
+	}
+	return create
+}
+
+// quickItemText returns a random value for the "text" field. The ok result is false if the field was left unset.
+func quickItemText(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, true)
+	for i := 0; i < quickAttempts && item.TextValidator(v) != nil; i++ {
+		v = quickString(r, size, true)
+	}
+	if item.TextValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *License (see GenerateLicense),
+// and allows using License entities as arguments of property functions that are checked by quick.Check.
+func (*License) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateLicense(r, size))
+}
+
+// GenerateLicense returns a License with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use LicenseClient.Generate for creating it.
+func GenerateLicense(r *rand.Rand, size int) *License {
+	l := &License{}
+	if v, ok := quickLicenseID(r, size); ok {
+		l.ID = v
+	}
+	if v, ok := quickLicenseCreateTime(r, size); ok {
+		l.CreateTime = v
+	}
+	if v, ok := quickLicenseUpdateTime(r, size); ok {
+		l.UpdateTime = v
+	}
+	return l
+}
+
+// Generate returns a builder for creating a License entity with random field values (see GenerateLicense).
+// Required edges should be set on the returned builder before it is saved.
+func (c *LicenseClient) Generate(r *rand.Rand, size int) *LicenseCreate {
+	create := c.Create()
+	if v, ok := quickLicenseID(r, size); ok {
+		create.SetID(v)
+	}
+	if v, ok := quickLicenseCreateTime(r, size); ok {
+		create.SetCreateTime(v)
+	}
+	if v, ok := quickLicenseUpdateTime(r, size); ok {
+		create.SetUpdateTime(v)
+	}
+	return create
+}
+
+// quickLicenseID returns a random value for the "id" field.
+func quickLicenseID(r *rand.Rand, size int) (v int, ok bool) {
+	v = int(atomic.AddInt64(&quickSeq, 1))
+	return v, true
+}
+
+// quickLicenseCreateTime returns a random value for the "create_time" field.
+func quickLicenseCreateTime(r *rand.Rand, size int) (v time.Time, ok bool) {
+	v = quickTime(r)
+	return v, true
+}
+
+// quickLicenseUpdateTime returns a random value for the "update_time" field.
+func quickLicenseUpdateTime(r *rand.Rand, size int) (v time.Time, ok bool) {
+	v = quickTime(r)
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Node (see GenerateNode),
+// and allows using Node entities as arguments of property functions that are checked by quick.Check.
+func (*Node) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateNode(r, size))
+}
+
+// GenerateNode returns a Node with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use NodeClient.Generate for creating it.
+func GenerateNode(r *rand.Rand, size int) *Node {
+	n := &Node{}
+	if v, ok := quickNodeValue(r, size); ok {
+		n.Value = v
+	}
+	if v, ok := quickNodeUpdatedAt(r, size); ok {
+		n.UpdatedAt = &v
+	}
+	return n
+}
+
+// Generate returns a builder for creating a Node entity with random field values (see GenerateNode).
+// Required edges should be set on the returned builder before it is saved.
+func (c *NodeClient) Generate(r *rand.Rand, size int) *NodeCreate {
+	create := c.Create()
+	if v, ok := quickNodeValue(r, size); ok {
+		create.SetValue(v)
+	}
+	if v, ok := quickNodeUpdatedAt(r, size); ok {
+		create.SetUpdatedAt(v)
+	}
+	return create
+}
+
+// quickNodeValue returns a random value for the "value" field. The ok result is false if the field was left unset.
+func quickNodeValue(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickNodeUpdatedAt returns a random value for the "updated_at" field. The ok result is false if the field was left unset.
+func quickNodeUpdatedAt(r *rand.Rand, size int) (v time.Time, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickTime(r)
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *PC (see GeneratePC),
+// and allows using PC entities as arguments of property functions that are checked by quick.Check.
+func (*PC) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GeneratePC(r, size))
+}
+
+// GeneratePC returns a PC with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use PCClient.Generate for creating it.
+func GeneratePC(r *rand.Rand, size int) *PC {
+	_pc := &PC{}
+	return _pc
+}
+
+// Generate returns a builder for creating a PC entity with random field values (see GeneratePC).
+// Required edges should be set on the returned builder before it is saved.
+func (c *PCClient) Generate(r *rand.Rand, size int) *PCCreate {
+	create := c.Create()
+	return create
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Pet (see GeneratePet),
+// and allows using Pet entities as arguments of property functions that are checked by quick.Check.
+func (*Pet) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GeneratePet(r, size))
+}
+
+// GeneratePet returns a Pet with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use PetClient.Generate for creating it.
+func GeneratePet(r *rand.Rand, size int) *Pet {
+	pe := &Pet{}
+	if v, ok := quickPetAge(r, size); ok {
+		pe.Age = v
+	}
+	if v, ok := quickPetName(r, size); ok {
+		pe.Name = v
+	}
+	if v, ok := quickPetUUID(r, size); ok {
+		pe.UUID = v
+	}
+	if v, ok := quickPetNickname(r, size); ok {
+		pe.Nickname = v
+	}
+	if v, ok := quickPetTrained(r, size); ok {
+		pe.Trained = v
+	}
+	return pe
+}
+
+// Generate returns a builder for creating a Pet entity with random field values (see GeneratePet).
+// Required edges should be set on the returned builder before it is saved.
+func (c *PetClient) Generate(r *rand.Rand, size int) *PetCreate {
+	create := c.Create()
+	if v, ok := quickPetAge(r, size); ok {
+		create.SetAge(v)
+	}
+	if v, ok := quickPetName(r, size); ok {
+		create.SetName(v)
+	}
+	if v, ok := quickPetUUID(r, size); ok {
+		create.SetUUID(v)
+	}
+	if v, ok := quickPetNickname(r, size); ok {
+		create.SetNickname(v)
+	}
+	if v, ok := quickPetTrained(r, size); ok {
+		create.SetTrained(v)
+	}
+	return create
+}
+
+// quickPetAge returns a random value for the "age" field.
+func quickPetAge(r *rand.Rand, size int) (v float64, ok bool) {
+	v = float64(quickFloat(r, size))
+	return v, true
+}
+
+// quickPetName returns a random value for the "name" field.
+func quickPetName(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickPetUUID returns a random value for the "uuid" field. The ok result is false if the field was left unset.
+func quickPetUUID(r *rand.Rand, size int) (v uuid.UUID, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[uuid.UUID](r)
+	return v, true
+}
+
+// quickPetNickname returns a random value for the "nickname" field. The ok result is false if the field was left unset.
+func quickPetNickname(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickPetTrained returns a random value for the "trained" field.
+func quickPetTrained(r *rand.Rand, size int) (v bool, ok bool) {
+	v = r.Intn(2) == 1
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Spec (see GenerateSpec),
+// and allows using Spec entities as arguments of property functions that are checked by quick.Check.
+func (*Spec) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateSpec(r, size))
+}
+
+// GenerateSpec returns a Spec with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use SpecClient.Generate for creating it.
+func GenerateSpec(r *rand.Rand, size int) *Spec {
+	s := &Spec{}
+	return s
+}
+
+// Generate returns a builder for creating a Spec entity with random field values (see GenerateSpec).
+// Required edges should be set on the returned builder before it is saved.
+func (c *SpecClient) Generate(r *rand.Rand, size int) *SpecCreate {
+	create := c.Create()
+	return create
+}
+
+// Generate implements the quick.Generator interface. It returns a random *Task (see GenerateTask),
+// and allows using Task entities as arguments of property functions that are checked by quick.Check.
+func (*Task) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateTask(r, size))
+}
+
+// GenerateTask returns a Task with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use TaskClient.Generate for creating it.
+func GenerateTask(r *rand.Rand, size int) *Task {
+	t := &Task{}
+	if v, ok := quickTaskPriority(r, size); ok {
+		t.Priority = v
+	}
+	if v, ok := quickTaskPriorities(r, size); ok {
+		t.Priorities = v
+	}
+	if v, ok := quickTaskCreatedAt(r, size); ok {
+		t.CreatedAt = &v
+	}
+	if v, ok := quickTaskName(r, size); ok {
+		t.Name = v
+	}
+	if v, ok := quickTaskOwner(r, size); ok {
+		t.Owner = v
+	}
+	if v, ok := quickTaskOrder(r, size); ok {
+		t.Order = v
+	}
+	if v, ok := quickTaskOrderOption(r, size); ok {
+		t.OrderOption = v
+	}
+	if v, ok := quickTaskOp(r, size); ok {
+		t.Op = v
+	}
+	return t
+}
+
+// Generate returns a builder for creating a Task entity with random field values (see GenerateTask).
+// Required edges should be set on the returned builder before it is saved.
+func (c *TaskClient) Generate(r *rand.Rand, size int) *TaskCreate {
+	create := c.Create()
+	if v, ok := quickTaskPriority(r, size); ok {
+		create.SetPriority(v)
+	}
+	if v, ok := quickTaskPriorities(r, size); ok {
+		create.SetPriorities(v)
+	}
+	if v, ok := quickTaskCreatedAt(r, size); ok {
+		create.SetCreatedAt(v)
+	}
+	if v, ok := quickTaskName(r, size); ok {
+		create.SetName(v)
+	}
+	if v, ok := quickTaskOwner(r, size); ok {
+		create.SetOwner(v)
+	}
+	if v, ok := quickTaskOrder(r, size); ok {
+		create.SetOrder(v)
+	}
+	if v, ok := quickTaskOrderOption(r, size); ok {
+		create.SetOrderOption(v)
+	}
+	if v, ok := quickTaskOp(r, size); ok {
+		create.SetOp(v)
+	}
+	return create
+}
+
+// quickTaskPriority returns a random value for the "priority" field.
+func quickTaskPriority(r *rand.Rand, size int) (v task.Priority, ok bool) {
+	v = task.Priority(quickInt(r, size, true))
+	for i := 0; i < quickAttempts && v.Validate() != nil; i++ {
+		v = task.Priority(quickInt(r, size, true))
+	}
+	if v.Validate() != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickTaskPriorities returns a random value for the "priorities" field. The ok result is false if the field was left unset.
+func quickTaskPriorities(r *rand.Rand, size int) (v map[string]task.Priority, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickValue[map[string]task.Priority](r)
+	return v, true
+}
+
+// quickTaskCreatedAt returns a random value for the "created_at" field.
+func quickTaskCreatedAt(r *rand.Rand, size int) (v time.Time, ok bool) {
+	v = quickTime(r)
+	return v, true
+}
+
+// quickTaskName returns a random value for the "name" field. The ok result is false if the field was left unset.
+func quickTaskName(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickTaskOwner returns a random value for the "owner" field. The ok result is false if the field was left unset.
+func quickTaskOwner(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickTaskOrder returns a random value for the "order" field. The ok result is false if the field was left unset.
+func quickTaskOrder(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickTaskOrderOption returns a random value for the "order_option" field. The ok result is false if the field was left unset.
+func quickTaskOrderOption(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickTaskOp returns a random value for the "op" field.
+func quickTaskOp(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	for i := 0; i < quickAttempts && enttask.OpValidator(v) != nil; i++ {
+		v = quickString(r, size, false)
+	}
+	if enttask.OpValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// Generate implements the quick.Generator interface. It returns a random *User (see GenerateUser),
+// and allows using User entities as arguments of property functions that are checked by quick.Check.
+func (*User) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateUser(r, size))
+}
+
+// GenerateUser returns a User with random field values. Enum fields get one of their values,
+// unique fields get distinct values, and fields with validators are regenerated until their values pass
+// the validators. Optional fields are left unset at random, and edges and edge-fields are never set.
+// The entity is not stored in the database. Use UserClient.Generate for creating it.
+func GenerateUser(r *rand.Rand, size int) *User {
+	u := &User{}
+	if v, ok := quickUserOptionalInt(r, size); ok {
+		u.OptionalInt = v
+	}
+	if v, ok := quickUserAge(r, size); ok {
+		u.Age = v
+	}
+	if v, ok := quickUserName(r, size); ok {
+		u.Name = v
+	}
+	if v, ok := quickUserLast(r, size); ok {
+		u.Last = v
+	}
+	if v, ok := quickUserNickname(r, size); ok {
+		u.Nickname = v
+	}
+	if v, ok := quickUserAddress(r, size); ok {
+		u.Address = v
+	}
+	if v, ok := quickUserPhone(r, size); ok {
+		u.Phone = v
+	}
+	if v, ok := quickUserPassword(r, size); ok {
+		u.Password = v
+	}
+	if v, ok := quickUserRole(r, size); ok {
+		u.Role = v
+	}
+	if v, ok := quickUserEmployment(r, size); ok {
+		u.Employment = v
+	}
+	if v, ok := quickUserSSOCert(r, size); ok {
+		u.SSOCert = v
+	}
+	if v, ok := quickUserFilesCount(r, size); ok {
+		u.FilesCount = v
+	}
+	return u
+}
+
+// Generate returns a builder for creating a User entity with random field values (see GenerateUser).
+// Required edges should be set on the returned builder before it is saved.
+func (c *UserClient) Generate(r *rand.Rand, size int) *UserCreate {
+	create := c.Create()
+	if v, ok := quickUserOptionalInt(r, size); ok {
+		create.SetOptionalInt(v)
+	}
+	if v, ok := quickUserAge(r, size); ok {
+		create.SetAge(v)
+	}
+	if v, ok := quickUserName(r, size); ok {
+		create.SetName(v)
+	}
+	if v, ok := quickUserLast(r, size); ok {
+		create.SetLast(v)
+	}
+	if v, ok := quickUserNickname(r, size); ok {
+		create.SetNickname(v)
+	}
+	if v, ok := quickUserAddress(r, size); ok {
+		create.SetAddress(v)
+	}
+	if v, ok := quickUserPhone(r, size); ok {
+		create.SetPhone(v)
+	}
+	if v, ok := quickUserPassword(r, size); ok {
+		create.SetPassword(v)
+	}
+	if v, ok := quickUserRole(r, size); ok {
+		create.SetRole(v)
+	}
+	if v, ok := quickUserEmployment(r, size); ok {
+		create.SetEmployment(v)
+	}
+	if v, ok := quickUserSSOCert(r, size); ok {
+		create.SetSSOCert(v)
+	}
+	if v, ok := quickUserFilesCount(r, size); ok {
+		create.SetFilesCount(v)
+	}
+	return create
+}
+
+// quickUserOptionalInt returns a random value for the "optional_int" field. The ok result is false if the field was left unset.
+func quickUserOptionalInt(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	for i := 0; i < quickAttempts && user.OptionalIntValidator(v) != nil; i++ {
+		v = int(quickInt(r, size, true))
+	}
+	if user.OptionalIntValidator(v) != nil {
+		// Leave the field unset, and let its default value (if any) apply.
+		return v, false
+	}
+	return v, true
+}
+
+// quickUserAge returns a random value for the "age" field.
+func quickUserAge(r *rand.Rand, size int) (v int, ok bool) {
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickUserName returns a random value for the "name" field.
+func quickUserName(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickUserLast returns a random value for the "last" field.
+func quickUserLast(r *rand.Rand, size int) (v string, ok bool) {
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickUserNickname returns a random value for the "nickname" field. The ok result is false if the field was left unset.
+func quickUserNickname(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, true)
+	return v, true
+}
+
+// quickUserAddress returns a random value for the "address" field. The ok result is false if the field was left unset.
+func quickUserAddress(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickUserPhone returns a random value for the "phone" field. The ok result is false if the field was left unset.
+func quickUserPhone(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, true)
+	return v, true
+}
+
+// quickUserPassword returns a random value for the "password" field. The ok result is false if the field was left unset.
+func quickUserPassword(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickUserRole returns a random value for the "role" field.
+func quickUserRole(r *rand.Rand, size int) (v user.Role, ok bool) {
+	v = []user.Role{user.Role("user"), user.Role("admin"), user.Role("free-user"), user.Role("test user")}[r.Intn(4)]
+	return v, true
+}
+
+// quickUserEmployment returns a random value for the "employment" field.
+func quickUserEmployment(r *rand.Rand, size int) (v user.Employment, ok bool) {
+	v = []user.Employment{user.Employment("Full-Time"), user.Employment("Part-Time"), user.Employment("Contract")}[r.Intn(3)]
+	return v, true
+}
+
+// quickUserSSOCert returns a random value for the "SSOCert" field. The ok result is false if the field was left unset.
+func quickUserSSOCert(r *rand.Rand, size int) (v string, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = quickString(r, size, false)
+	return v, true
+}
+
+// quickUserFilesCount returns a random value for the "files_count" field. The ok result is false if the field was left unset.
+func quickUserFilesCount(r *rand.Rand, size int) (v int, ok bool) {
+	if r.Intn(2) == 0 {
+		return v, false
+	}
+	v = int(quickInt(r, size, true))
+	return v, true
+}
+
+// quickSize returns the size argument of generators, which is at least 1.
+func quickSize(size int) int {
+	if size < 1 {
+		return 1
+	}
+	return size
+}
+
+// quickLetters holds the characters of randomly generated strings.
+const quickLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// quickString returns a random alphanumeric string with a length of
+// 1 to size characters. Unique strings are suffixed with a sequence number.
+func quickString(r *rand.Rand, size int, unique bool) string {
+	b := make([]byte, 1+r.Intn(quickSize(size)))
+	for i := range b {
+		b[i] = quickLetters[r.Intn(len(quickLetters))]
+	}
+	if unique {
+		return fmt.Sprintf("%s-%d", b, atomic.AddInt64(&quickSeq, 1))
+	}
+	return string(b)
+}
+
+// quickBytes returns a random byte slice with a length of 0 to size bytes.
+// Unique byte slices are suffixed with a sequence number.
+func quickBytes(r *rand.Rand, size int, unique bool) []byte {
+	b := make([]byte, r.Intn(quickSize(size)+1))
+	r.Read(b)
+	if unique {
+		b = fmt.Appendf(b, "-%d", atomic.AddInt64(&quickSeq, 1))
+	}
+	return b
+}
+
+// quickInt returns a random integer in the range [-size, size],
+// or in the range [0, size] if the integer is not signed.
+func quickInt(r *rand.Rand, size int, signed bool) int64 {
+	n := r.Int63n(int64(quickSize(size)) + 1)
+	if signed && r.Intn(2) == 0 {
+		n = -n
+	}
+	return n
+}
+
+// quickFloat returns a random float in the range [-size, size).
+func quickFloat(r *rand.Rand, size int) float64 {
+	return (r.Float64()*2 - 1) * float64(quickSize(size))
+}
+
+// quickTime returns a random time in UTC, truncated to seconds,
+// in order to be stored by all databases without losing precision.
+func quickTime(r *rand.Rand) time.Time {
+	return time.Unix(946684800+r.Int63n(1<<30), 0).UTC()
+}
+
+// quickScan returns a value of a custom Go type that implements the ValueScanner interface, by scanning
+// the given random value into it. It is used for enum types that are not based on string, and for string
+// types that cannot be converted from a string (e.g. a struct that wraps a URL). The zero value is returned
+// if the value was not scanned successfully, or if the scanner panicked on the random value.
+func quickScan[T any, P interface {
+	*T
+	Scan(any) error
+}](src any) (v T) {
+	defer func() {
+		if recover() != nil {
+			v = *new(T)
+		}
+	}()
+	if err := P(&v).Scan(src); err != nil {
+		v = *new(T)
+	}
+	return v
+}
+
+// quickValue returns a random value for types without a dedicated generator, like UUID, JSON and
+// other custom types, using quick.Value. Hence, these types can control their random values by
+// implementing the quick.Generator interface. The zero value is returned for types that are not
+// supported by quick.Value, like interfaces and structs with unexported fields.
+func quickValue[T any](r *rand.Rand) (v T) {
+	defer func() {
+		if recover() != nil {
+			v = *new(T)
+		}
+	}()
+	if rv, ok := quick.Value(reflect.TypeOf(&v).Elem(), r); ok {
+		v = rv.Interface().(T)
+	}
+	return v
+}
@@ -75,7 +75,8 @@ func (sc *SpecCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (sc *SpecCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (sc *SpecCreate) sqlSave(ctx context.Context) (*Spec, error) {
@@ -85,7 +86,7 @@ func (sc *SpecCreate) sqlSave(ctx context.Context) (*Spec, error) {
 	_node, _spec := sc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -240,12 +241,16 @@ func (u *SpecUpsertOne) IDX(ctx context.Context) int {
 // SpecCreateBulk is the builder for creating many Spec entities in bulk.
 type SpecCreateBulk struct {
 	config
+	err      error
 	builders []*SpecCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Spec entities in the database.
 func (scb *SpecCreateBulk) Save(ctx context.Context) ([]*Spec, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
 	nodes := make([]*Spec, len(scb.builders))
 	mutators := make([]Mutator, len(scb.builders))
@@ -271,7 +276,7 @@ func (scb *SpecCreateBulk) Save(ctx context.Context) ([]*Spec, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -19,8 +19,9 @@ import (
 // SpecDelete is the builder for deleting a Spec entity.
 type SpecDelete struct {
 	config
-	hooks    []Hook
-	mutation *SpecMutation
+	hooks     []Hook
+	mutation  *SpecMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the SpecDelete builder.
@@ -43,8 +44,21 @@ func (sd *SpecDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (sd *SpecDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *SpecDelete {
+	sd.modifiers = append(sd.modifiers, modifiers...)
+	return sd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (sdo *SpecDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *SpecDeleteOne {
+	sdo.sd.Modify(modifiers...)
+	return sdo
+}
+
 func (sd *SpecDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(spec.Table, sqlgraph.NewFieldSpec(spec.FieldID, field.TypeInt))
+	_spec.AddModifiers(sd.modifiers...)
 	if ps := sd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (sd *SpecDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, sd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	sd.mutation.done = true
 	return affected, err
@@ -338,7 +338,7 @@ func (sq *SpecQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range sq.ctx.Fields {
 		if !spec.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Spec", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if sq.path != nil {
@@ -398,6 +398,22 @@ func (sq *SpecQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Spec, e
 }
 
 func (sq *SpecQuery) loadCard(ctx context.Context, query *CardQuery, nodes []*Spec, init func(*Spec), assign func(*Spec, *Card)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := sq.loadCard(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Spec)
 	nids := make(map[int]map[*Spec]struct{})
@@ -592,10 +608,53 @@ func (sq *SpecQuery) WithNamedCard(name string, opts ...func(*CardQuery)) *SpecQ
 	return sq
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (sq *SpecQuery) Union(queries ...*SpecQuery) *SpecQuery {
+	return sq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (sq *SpecQuery) UnionAll(queries ...*SpecQuery) *SpecQuery {
+	return sq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (sq *SpecQuery) Intersect(queries ...*SpecQuery) *SpecQuery {
+	return sq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (sq *SpecQuery) Except(queries ...*SpecQuery) *SpecQuery {
+	return sq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (sq *SpecQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*SpecQuery) *SpecQuery {
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return sq
+}
+
 // SpecGroupBy is the group-by builder for Spec entities.
 type SpecGroupBy struct {
 	selector
-	build *SpecQuery
+	build  *SpecQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -613,6 +672,14 @@ func (sgb *SpecGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*SpecQuery, *SpecGroupBy](ctx, sgb.build, sgb, sgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (sgb *SpecGroupBy) Having(ps ...*sql.Predicate) *SpecGroupBy {
+	sgb.having = append(sgb.having, ps...)
+	return sgb
+}
+
 func (sgb *SpecGroupBy) sqlScan(ctx context.Context, root *SpecQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(sgb.fns))
@@ -628,6 +695,9 @@ func (sgb *SpecGroupBy) sqlScan(ctx context.Context, root *SpecQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*sgb.flds...)...)
+	if len(sgb.having) > 0 {
+		selector.Having(sql.And(sgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
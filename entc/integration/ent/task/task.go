@@ -7,6 +7,7 @@
 package enttask
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -115,4 +116,93 @@ func ByOp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOp, opts...).ToFunc()
 }
 
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that Task queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Task queries can be ordered by.
+const (
+	OrderFieldID          OrderField = "id"
+	OrderFieldPriority    OrderField = "priority"
+	OrderFieldCreatedAt   OrderField = "created_at"
+	OrderFieldName        OrderField = "name"
+	OrderFieldOwner       OrderField = "owner"
+	OrderFieldOrder       OrderField = "order"
+	OrderFieldOrderOption OrderField = "order_option"
+	OrderFieldOp          OrderField = "op"
+)
+
+// OrderFields holds all fields and edge counts that Task queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldPriority,
+	OrderFieldCreatedAt,
+	OrderFieldName,
+	OrderFieldOwner,
+	OrderFieldOrder,
+	OrderFieldOrderOption,
+	OrderFieldOp,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Task queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("enttask: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := enttask.OrderByField(enttask.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Task.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldPriority:
+		return ByPriority(opts...), nil
+	case OrderFieldCreatedAt:
+		return ByCreatedAt(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldOwner:
+		return ByOwner(opts...), nil
+	case OrderFieldOrder:
+		return ByOrder(opts...), nil
+	case OrderFieldOrderOption:
+		return ByOrderOption(opts...), nil
+	case OrderFieldOp:
+		return ByOp(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 // comment from another template.
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/schema/task"
 )
@@ -269,6 +270,11 @@ func NameContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // OwnerEQ applies the EQ predicate on the "owner" field.
 func OwnerEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldOwner, v))
@@ -344,6 +350,11 @@ func OwnerContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldOwner, v))
 }
 
+// OwnerHasPrefixFold applies the HasPrefixFold predicate on the "owner" field.
+func OwnerHasPrefixFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefixFold(FieldOwner, v))
+}
+
 // OrderEQ applies the EQ predicate on the "order" field.
 func OrderEQ(v int) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldOrder, v))
@@ -509,6 +520,41 @@ func OpContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldOp, v))
 }
 
+// OpHasPrefixFold applies the HasPrefixFold predicate on the "op" field.
+func OpHasPrefixFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefixFold(FieldOp, v))
+}
+
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.Task {
+	return predicate.Task(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
+// PrioritiesHasKey applies the HasKey predicate on the "priorities" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func PrioritiesHasKey(path ...string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldPriorities), sqljson.Path(path...)))
+	})
+}
+
+// PrioritiesValueEQ checks that the value at the given path of the
+// "priorities" JSON field is equal to v.
+func PrioritiesValueEQ(v any, path ...string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldPriorities), v, sqljson.Path(path...)))
+	})
+}
+
+// PrioritiesContains checks that the value at the given path of the "priorities"
+// JSON field contains v. e.g. an array that contains the element v.
+func PrioritiesContains(v any, path ...string) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldPriorities), v, sqljson.Path(path...)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -182,26 +182,27 @@ func (tc *TaskCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TaskCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Priority(); !ok {
-		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Task.priority"`)}
+		errs = append(errs, &ValidationError{Name: "priority", Entity: "Task", Field: "priority", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.priority"`)})
 	}
 	if v, ok := tc.mutation.Priority(); ok {
 		if err := v.Validate(); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "Task", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)})
 		}
 	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "Task", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.created_at"`)})
 	}
 	if _, ok := tc.mutation.GetOp(); !ok {
-		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "Task.op"`)}
+		errs = append(errs, &ValidationError{Name: "op", Entity: "Task", Field: "op", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.op"`)})
 	}
 	if v, ok := tc.mutation.GetOp(); ok {
 		if err := enttask.OpValidator(v); err != nil {
-			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "op", Entity: "Task", Field: "op", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TaskCreate) sqlSave(ctx context.Context) (*Task, error) {
@@ -211,7 +212,7 @@ func (tc *TaskCreate) sqlSave(ctx context.Context) (*Task, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -324,6 +325,12 @@ func (u *TaskUpsert) UpdatePriority() *TaskUpsert {
 	return u
 }
 
+// IgnorePriority keeps the current value of the "priority" field in case of conflict.
+func (u *TaskUpsert) IgnorePriority() *TaskUpsert {
+	u.SetIgnore(enttask.FieldPriority)
+	return u
+}
+
 // AddPriority adds v to the "priority" field.
 func (u *TaskUpsert) AddPriority(v task.Priority) *TaskUpsert {
 	u.Add(enttask.FieldPriority, v)
@@ -342,6 +349,12 @@ func (u *TaskUpsert) UpdatePriorities() *TaskUpsert {
 	return u
 }
 
+// IgnorePriorities keeps the current value of the "priorities" field in case of conflict.
+func (u *TaskUpsert) IgnorePriorities() *TaskUpsert {
+	u.SetIgnore(enttask.FieldPriorities)
+	return u
+}
+
 // ClearPriorities clears the value of the "priorities" field.
 func (u *TaskUpsert) ClearPriorities() *TaskUpsert {
 	u.SetNull(enttask.FieldPriorities)
@@ -360,6 +373,12 @@ func (u *TaskUpsert) UpdateName() *TaskUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *TaskUpsert) IgnoreName() *TaskUpsert {
+	u.SetIgnore(enttask.FieldName)
+	return u
+}
+
 // ClearName clears the value of the "name" field.
 func (u *TaskUpsert) ClearName() *TaskUpsert {
 	u.SetNull(enttask.FieldName)
@@ -378,6 +397,12 @@ func (u *TaskUpsert) UpdateOwner() *TaskUpsert {
 	return u
 }
 
+// IgnoreOwner keeps the current value of the "owner" field in case of conflict.
+func (u *TaskUpsert) IgnoreOwner() *TaskUpsert {
+	u.SetIgnore(enttask.FieldOwner)
+	return u
+}
+
 // ClearOwner clears the value of the "owner" field.
 func (u *TaskUpsert) ClearOwner() *TaskUpsert {
 	u.SetNull(enttask.FieldOwner)
@@ -396,6 +421,12 @@ func (u *TaskUpsert) UpdateOrder() *TaskUpsert {
 	return u
 }
 
+// IgnoreOrder keeps the current value of the "order" field in case of conflict.
+func (u *TaskUpsert) IgnoreOrder() *TaskUpsert {
+	u.SetIgnore(enttask.FieldOrder)
+	return u
+}
+
 // AddOrder adds v to the "order" field.
 func (u *TaskUpsert) AddOrder(v int) *TaskUpsert {
 	u.Add(enttask.FieldOrder, v)
@@ -420,6 +451,12 @@ func (u *TaskUpsert) UpdateOrderOption() *TaskUpsert {
 	return u
 }
 
+// IgnoreOrderOption keeps the current value of the "order_option" field in case of conflict.
+func (u *TaskUpsert) IgnoreOrderOption() *TaskUpsert {
+	u.SetIgnore(enttask.FieldOrderOption)
+	return u
+}
+
 // AddOrderOption adds v to the "order_option" field.
 func (u *TaskUpsert) AddOrderOption(v int) *TaskUpsert {
 	u.Add(enttask.FieldOrderOption, v)
@@ -444,6 +481,12 @@ func (u *TaskUpsert) UpdateOp() *TaskUpsert {
 	return u
 }
 
+// IgnoreOp keeps the current value of the "op" field in case of conflict.
+func (u *TaskUpsert) IgnoreOp() *TaskUpsert {
+	u.SetIgnore(enttask.FieldOp)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -510,6 +553,14 @@ func (u *TaskUpsertOne) UpdatePriority() *TaskUpsertOne {
 	})
 }
 
+// IgnorePriority keeps the current value of the "priority" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnorePriority() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnorePriority()
+	})
+}
+
 // SetPriorities sets the "priorities" field.
 func (u *TaskUpsertOne) SetPriorities(v map[string]task.Priority) *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -524,6 +575,14 @@ func (u *TaskUpsertOne) UpdatePriorities() *TaskUpsertOne {
 	})
 }
 
+// IgnorePriorities keeps the current value of the "priorities" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnorePriorities() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnorePriorities()
+	})
+}
+
 // ClearPriorities clears the value of the "priorities" field.
 func (u *TaskUpsertOne) ClearPriorities() *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -545,6 +604,14 @@ func (u *TaskUpsertOne) UpdateName() *TaskUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnoreName() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // ClearName clears the value of the "name" field.
 func (u *TaskUpsertOne) ClearName() *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -566,6 +633,14 @@ func (u *TaskUpsertOne) UpdateOwner() *TaskUpsertOne {
 	})
 }
 
+// IgnoreOwner keeps the current value of the "owner" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnoreOwner() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOwner()
+	})
+}
+
 // ClearOwner clears the value of the "owner" field.
 func (u *TaskUpsertOne) ClearOwner() *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -594,6 +669,14 @@ func (u *TaskUpsertOne) UpdateOrder() *TaskUpsertOne {
 	})
 }
 
+// IgnoreOrder keeps the current value of the "order" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnoreOrder() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOrder()
+	})
+}
+
 // ClearOrder clears the value of the "order" field.
 func (u *TaskUpsertOne) ClearOrder() *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -622,6 +705,14 @@ func (u *TaskUpsertOne) UpdateOrderOption() *TaskUpsertOne {
 	})
 }
 
+// IgnoreOrderOption keeps the current value of the "order_option" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnoreOrderOption() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOrderOption()
+	})
+}
+
 // ClearOrderOption clears the value of the "order_option" field.
 func (u *TaskUpsertOne) ClearOrderOption() *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -643,6 +734,14 @@ func (u *TaskUpsertOne) UpdateOp() *TaskUpsertOne {
 	})
 }
 
+// IgnoreOp keeps the current value of the "op" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertOne) IgnoreOp() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOp()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -679,12 +778,16 @@ func (u *TaskUpsertOne) IDX(ctx context.Context) int {
 // TaskCreateBulk is the builder for creating many Task entities in bulk.
 type TaskCreateBulk struct {
 	config
+	err      error
 	builders []*TaskCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the Task entities in the database.
 func (tcb *TaskCreateBulk) Save(ctx context.Context) ([]*Task, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Task, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -711,7 +814,7 @@ func (tcb *TaskCreateBulk) Save(ctx context.Context) ([]*Task, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -871,6 +974,14 @@ func (u *TaskUpsertBulk) UpdatePriority() *TaskUpsertBulk {
 	})
 }
 
+// IgnorePriority keeps the current value of the "priority" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnorePriority() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnorePriority()
+	})
+}
+
 // SetPriorities sets the "priorities" field.
 func (u *TaskUpsertBulk) SetPriorities(v map[string]task.Priority) *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -885,6 +996,14 @@ func (u *TaskUpsertBulk) UpdatePriorities() *TaskUpsertBulk {
 	})
 }
 
+// IgnorePriorities keeps the current value of the "priorities" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnorePriorities() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnorePriorities()
+	})
+}
+
 // ClearPriorities clears the value of the "priorities" field.
 func (u *TaskUpsertBulk) ClearPriorities() *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -906,6 +1025,14 @@ func (u *TaskUpsertBulk) UpdateName() *TaskUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnoreName() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // ClearName clears the value of the "name" field.
 func (u *TaskUpsertBulk) ClearName() *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -927,6 +1054,14 @@ func (u *TaskUpsertBulk) UpdateOwner() *TaskUpsertBulk {
 	})
 }
 
+// IgnoreOwner keeps the current value of the "owner" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnoreOwner() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOwner()
+	})
+}
+
 // ClearOwner clears the value of the "owner" field.
 func (u *TaskUpsertBulk) ClearOwner() *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -955,6 +1090,14 @@ func (u *TaskUpsertBulk) UpdateOrder() *TaskUpsertBulk {
 	})
 }
 
+// IgnoreOrder keeps the current value of the "order" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnoreOrder() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOrder()
+	})
+}
+
 // ClearOrder clears the value of the "order" field.
 func (u *TaskUpsertBulk) ClearOrder() *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -983,6 +1126,14 @@ func (u *TaskUpsertBulk) UpdateOrderOption() *TaskUpsertBulk {
 	})
 }
 
+// IgnoreOrderOption keeps the current value of the "order_option" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnoreOrderOption() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOrderOption()
+	})
+}
+
 // ClearOrderOption clears the value of the "order_option" field.
 func (u *TaskUpsertBulk) ClearOrderOption() *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -1004,6 +1155,14 @@ func (u *TaskUpsertBulk) UpdateOp() *TaskUpsertBulk {
 	})
 }
 
+// IgnoreOp keeps the current value of the "op" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *TaskUpsertBulk) IgnoreOp() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.IgnoreOp()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
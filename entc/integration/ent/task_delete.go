@@ -20,8 +20,9 @@ import (
 // TaskDelete is the builder for deleting a Task entity.
 type TaskDelete struct {
 	config
-	hooks    []Hook
-	mutation *TaskMutation
+	hooks     []Hook
+	mutation  *TaskMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the TaskDelete builder.
@@ -44,8 +45,21 @@ func (td *TaskDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (td *TaskDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *TaskDelete {
+	td.modifiers = append(td.modifiers, modifiers...)
+	return td
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (tdo *TaskDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *TaskDeleteOne {
+	tdo.td.Modify(modifiers...)
+	return tdo
+}
+
 func (td *TaskDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(enttask.Table, sqlgraph.NewFieldSpec(enttask.FieldID, field.TypeInt))
+	_spec.AddModifiers(td.modifiers...)
 	if ps := td.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -55,7 +69,7 @@ func (td *TaskDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, td.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	td.mutation.done = true
 	return affected, err
@@ -322,7 +322,7 @@ func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range tq.ctx.Fields {
 		if !enttask.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Task", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if tq.path != nil {
@@ -482,10 +482,53 @@ func (tq *TaskQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskSelect {
 	return tq.Select()
 }
 
+// Union combines the result of the query with the results of the given queries
+// using the UNION operator, and eliminates duplicate rows.
+func (tq *TaskQuery) Union(queries ...*TaskQuery) *TaskQuery {
+	return tq.setOps((*sql.Selector).Union, queries)
+}
+
+// UnionAll combines the result of the query with the results of the given queries
+// using the UNION ALL operator, and keeps duplicate rows.
+func (tq *TaskQuery) UnionAll(queries ...*TaskQuery) *TaskQuery {
+	return tq.setOps((*sql.Selector).UnionAll, queries)
+}
+
+// Intersect returns only the rows of the query that are also returned
+// by the given queries, using the INTERSECT operator.
+func (tq *TaskQuery) Intersect(queries ...*TaskQuery) *TaskQuery {
+	return tq.setOps((*sql.Selector).Intersect, queries)
+}
+
+// Except returns only the rows of the query that are not returned
+// by the given queries, using the EXCEPT operator.
+func (tq *TaskQuery) Except(queries ...*TaskQuery) *TaskQuery {
+	return tq.setOps((*sql.Selector).Except, queries)
+}
+
+// setOps adds a query modifier that applies the given set operation on the given queries.
+func (tq *TaskQuery) setOps(op func(*sql.Selector, sql.TableView) *sql.Selector, queries []*TaskQuery) *TaskQuery {
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		ctx := s.Context()
+		for _, q := range queries {
+			if err := q.prepareQuery(ctx); err != nil {
+				s.AddError(err)
+				return
+			}
+			selector := q.sqlQuery(ctx)
+			// Operands select the same columns as the query.
+			selector.Select(selector.Columns(s.UnqualifiedColumns()...)...)
+			op(s, selector)
+		}
+	})
+	return tq
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	selector
-	build *TaskQuery
+	build  *TaskQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -503,6 +546,14 @@ func (tgb *TaskGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*TaskQuery, *TaskGroupBy](ctx, tgb.build, tgb, tgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (tgb *TaskGroupBy) Having(ps ...*sql.Predicate) *TaskGroupBy {
+	tgb.having = append(tgb.having, ps...)
+	return tgb
+}
+
 func (tgb *TaskGroupBy) sqlScan(ctx context.Context, root *TaskQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(tgb.fns))
@@ -518,6 +569,9 @@ func (tgb *TaskGroupBy) sqlScan(ctx context.Context, root *TaskQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*tgb.flds...)...)
+	if len(tgb.having) > 0 {
+		selector.Having(sql.And(tgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
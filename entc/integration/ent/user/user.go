@@ -431,6 +431,136 @@ func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "pets_count") that User queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that User queries can be ordered by.
+const (
+	OrderFieldID             OrderField = "id"
+	OrderFieldOptionalInt    OrderField = "optional_int"
+	OrderFieldAge            OrderField = "age"
+	OrderFieldName           OrderField = "name"
+	OrderFieldLast           OrderField = "last"
+	OrderFieldNickname       OrderField = "nickname"
+	OrderFieldAddress        OrderField = "address"
+	OrderFieldPhone          OrderField = "phone"
+	OrderFieldRole           OrderField = "role"
+	OrderFieldEmployment     OrderField = "employment"
+	OrderFieldSSOCert        OrderField = "SSOCert"
+	OrderFieldFilesCount     OrderField = "files_count"
+	OrderFieldPetsCount      OrderField = "pets_count"
+	OrderFieldGroupsCount    OrderField = "groups_count"
+	OrderFieldFriendsCount   OrderField = "friends_count"
+	OrderFieldFollowersCount OrderField = "followers_count"
+	OrderFieldFollowingCount OrderField = "following_count"
+	OrderFieldChildrenCount  OrderField = "children_count"
+)
+
+// OrderFields holds all fields and edge counts that User queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldOptionalInt,
+	OrderFieldAge,
+	OrderFieldName,
+	OrderFieldLast,
+	OrderFieldNickname,
+	OrderFieldAddress,
+	OrderFieldPhone,
+	OrderFieldRole,
+	OrderFieldEmployment,
+	OrderFieldSSOCert,
+	OrderFieldFilesCount,
+	OrderFieldPetsCount,
+	OrderFieldGroupsCount,
+	OrderFieldFriendsCount,
+	OrderFieldFollowersCount,
+	OrderFieldFollowingCount,
+	OrderFieldChildrenCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if User queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("user: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := user.OrderByField(user.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.User.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldOptionalInt:
+		return ByOptionalInt(opts...), nil
+	case OrderFieldAge:
+		return ByAge(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldLast:
+		return ByLast(opts...), nil
+	case OrderFieldNickname:
+		return ByNickname(opts...), nil
+	case OrderFieldAddress:
+		return ByAddress(opts...), nil
+	case OrderFieldPhone:
+		return ByPhone(opts...), nil
+	case OrderFieldRole:
+		return ByRole(opts...), nil
+	case OrderFieldEmployment:
+		return ByEmployment(opts...), nil
+	case OrderFieldSSOCert:
+		return BySSOCert(opts...), nil
+	case OrderFieldFilesCount:
+		return ByFilesCountField(opts...), nil
+	case OrderFieldPetsCount:
+		return ByPetsCount(opts...), nil
+	case OrderFieldGroupsCount:
+		return ByGroupsCount(opts...), nil
+	case OrderFieldFriendsCount:
+		return ByFriendsCount(opts...), nil
+	case OrderFieldFollowersCount:
+		return ByFollowersCount(opts...), nil
+	case OrderFieldFollowingCount:
+		return ByFollowingCount(opts...), nil
+	case OrderFieldChildrenCount:
+		return ByChildrenCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newCardStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
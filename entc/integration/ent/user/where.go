@@ -262,6 +262,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // LastEQ applies the EQ predicate on the "last" field.
 func LastEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLast, v))
@@ -327,6 +332,11 @@ func LastContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldLast, v))
 }
 
+// LastHasPrefixFold applies the HasPrefixFold predicate on the "last" field.
+func LastHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldLast, v))
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNickname, v))
@@ -402,6 +412,11 @@ func NicknameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNickname, v))
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldNickname, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAddress, v))
@@ -477,6 +492,11 @@ func AddressContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAddress, v))
 }
 
+// AddressHasPrefixFold applies the HasPrefixFold predicate on the "address" field.
+func AddressHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldAddress, v))
+}
+
 // PhoneEQ applies the EQ predicate on the "phone" field.
 func PhoneEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPhone, v))
@@ -552,6 +572,11 @@ func PhoneContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPhone, v))
 }
 
+// PhoneHasPrefixFold applies the HasPrefixFold predicate on the "phone" field.
+func PhoneHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldPhone, v))
+}
+
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPassword, v))
@@ -627,6 +652,11 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// PasswordHasPrefixFold applies the HasPrefixFold predicate on the "password" field.
+func PasswordHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldPassword, v))
+}
+
 // RoleEQ applies the EQ predicate on the "role" field.
 func RoleEQ(v Role) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldRole, v))
@@ -742,6 +772,11 @@ func SSOCertContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldSSOCert, v))
 }
 
+// SSOCertHasPrefixFold applies the HasPrefixFold predicate on the "SSOCert" field.
+func SSOCertHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldSSOCert, v))
+}
+
 // FilesCountEQ applies the EQ predicate on the "files_count" field.
 func FilesCountEQ(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldFilesCount, v))
@@ -417,37 +417,38 @@ func (uc *UserCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := uc.mutation.OptionalInt(); ok {
 		if err := user.OptionalIntValidator(v); err != nil {
-			return &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "optional_int", Entity: "User", Field: "optional_int", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "User.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "User", Field: "age", Reason: "missing required field", err: errors.New(`ent: missing required field "User.age"`)})
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "User", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "User.name"`)})
 	}
 	if _, ok := uc.mutation.Last(); !ok {
-		return &ValidationError{Name: "last", err: errors.New(`ent: missing required field "User.last"`)}
+		errs = append(errs, &ValidationError{Name: "last", Entity: "User", Field: "last", Reason: "missing required field", err: errors.New(`ent: missing required field "User.last"`)})
 	}
 	if _, ok := uc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+		errs = append(errs, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: "missing required field", err: errors.New(`ent: missing required field "User.role"`)})
 	}
 	if v, ok := uc.mutation.Role(); ok {
 		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Employment(); !ok {
-		return &ValidationError{Name: "employment", err: errors.New(`ent: missing required field "User.employment"`)}
+		errs = append(errs, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: "missing required field", err: errors.New(`ent: missing required field "User.employment"`)})
 	}
 	if v, ok := uc.mutation.Employment(); ok {
 		if err := user.EmploymentValidator(v); err != nil {
-			return &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -457,7 +458,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	_node, _spec := uc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -764,6 +765,12 @@ func (u *UserUpsert) UpdateOptionalInt() *UserUpsert {
 	return u
 }
 
+// IgnoreOptionalInt keeps the current value of the "optional_int" field in case of conflict.
+func (u *UserUpsert) IgnoreOptionalInt() *UserUpsert {
+	u.SetIgnore(user.FieldOptionalInt)
+	return u
+}
+
 // AddOptionalInt adds v to the "optional_int" field.
 func (u *UserUpsert) AddOptionalInt(v int) *UserUpsert {
 	u.Add(user.FieldOptionalInt, v)
@@ -788,6 +795,12 @@ func (u *UserUpsert) UpdateAge() *UserUpsert {
 	return u
 }
 
+// IgnoreAge keeps the current value of the "age" field in case of conflict.
+func (u *UserUpsert) IgnoreAge() *UserUpsert {
+	u.SetIgnore(user.FieldAge)
+	return u
+}
+
 // AddAge adds v to the "age" field.
 func (u *UserUpsert) AddAge(v int) *UserUpsert {
 	u.Add(user.FieldAge, v)
@@ -806,6 +819,12 @@ func (u *UserUpsert) UpdateName() *UserUpsert {
 	return u
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+func (u *UserUpsert) IgnoreName() *UserUpsert {
+	u.SetIgnore(user.FieldName)
+	return u
+}
+
 // SetLast sets the "last" field.
 func (u *UserUpsert) SetLast(v string) *UserUpsert {
 	u.Set(user.FieldLast, v)
@@ -818,6 +837,12 @@ func (u *UserUpsert) UpdateLast() *UserUpsert {
 	return u
 }
 
+// IgnoreLast keeps the current value of the "last" field in case of conflict.
+func (u *UserUpsert) IgnoreLast() *UserUpsert {
+	u.SetIgnore(user.FieldLast)
+	return u
+}
+
 // SetNickname sets the "nickname" field.
 func (u *UserUpsert) SetNickname(v string) *UserUpsert {
 	u.Set(user.FieldNickname, v)
@@ -830,6 +855,12 @@ func (u *UserUpsert) UpdateNickname() *UserUpsert {
 	return u
 }
 
+// IgnoreNickname keeps the current value of the "nickname" field in case of conflict.
+func (u *UserUpsert) IgnoreNickname() *UserUpsert {
+	u.SetIgnore(user.FieldNickname)
+	return u
+}
+
 // ClearNickname clears the value of the "nickname" field.
 func (u *UserUpsert) ClearNickname() *UserUpsert {
 	u.SetNull(user.FieldNickname)
@@ -848,6 +879,12 @@ func (u *UserUpsert) UpdateAddress() *UserUpsert {
 	return u
 }
 
+// IgnoreAddress keeps the current value of the "address" field in case of conflict.
+func (u *UserUpsert) IgnoreAddress() *UserUpsert {
+	u.SetIgnore(user.FieldAddress)
+	return u
+}
+
 // ClearAddress clears the value of the "address" field.
 func (u *UserUpsert) ClearAddress() *UserUpsert {
 	u.SetNull(user.FieldAddress)
@@ -866,6 +903,12 @@ func (u *UserUpsert) UpdatePhone() *UserUpsert {
 	return u
 }
 
+// IgnorePhone keeps the current value of the "phone" field in case of conflict.
+func (u *UserUpsert) IgnorePhone() *UserUpsert {
+	u.SetIgnore(user.FieldPhone)
+	return u
+}
+
 // ClearPhone clears the value of the "phone" field.
 func (u *UserUpsert) ClearPhone() *UserUpsert {
 	u.SetNull(user.FieldPhone)
@@ -884,6 +927,12 @@ func (u *UserUpsert) UpdatePassword() *UserUpsert {
 	return u
 }
 
+// IgnorePassword keeps the current value of the "password" field in case of conflict.
+func (u *UserUpsert) IgnorePassword() *UserUpsert {
+	u.SetIgnore(user.FieldPassword)
+	return u
+}
+
 // ClearPassword clears the value of the "password" field.
 func (u *UserUpsert) ClearPassword() *UserUpsert {
 	u.SetNull(user.FieldPassword)
@@ -902,6 +951,12 @@ func (u *UserUpsert) UpdateRole() *UserUpsert {
 	return u
 }
 
+// IgnoreRole keeps the current value of the "role" field in case of conflict.
+func (u *UserUpsert) IgnoreRole() *UserUpsert {
+	u.SetIgnore(user.FieldRole)
+	return u
+}
+
 // SetEmployment sets the "employment" field.
 func (u *UserUpsert) SetEmployment(v user.Employment) *UserUpsert {
 	u.Set(user.FieldEmployment, v)
@@ -914,6 +969,12 @@ func (u *UserUpsert) UpdateEmployment() *UserUpsert {
 	return u
 }
 
+// IgnoreEmployment keeps the current value of the "employment" field in case of conflict.
+func (u *UserUpsert) IgnoreEmployment() *UserUpsert {
+	u.SetIgnore(user.FieldEmployment)
+	return u
+}
+
 // SetSSOCert sets the "SSOCert" field.
 func (u *UserUpsert) SetSSOCert(v string) *UserUpsert {
 	u.Set(user.FieldSSOCert, v)
@@ -926,6 +987,12 @@ func (u *UserUpsert) UpdateSSOCert() *UserUpsert {
 	return u
 }
 
+// IgnoreSSOCert keeps the current value of the "SSOCert" field in case of conflict.
+func (u *UserUpsert) IgnoreSSOCert() *UserUpsert {
+	u.SetIgnore(user.FieldSSOCert)
+	return u
+}
+
 // ClearSSOCert clears the value of the "SSOCert" field.
 func (u *UserUpsert) ClearSSOCert() *UserUpsert {
 	u.SetNull(user.FieldSSOCert)
@@ -944,6 +1011,12 @@ func (u *UserUpsert) UpdateFilesCount() *UserUpsert {
 	return u
 }
 
+// IgnoreFilesCount keeps the current value of the "files_count" field in case of conflict.
+func (u *UserUpsert) IgnoreFilesCount() *UserUpsert {
+	u.SetIgnore(user.FieldFilesCount)
+	return u
+}
+
 // AddFilesCount adds v to the "files_count" field.
 func (u *UserUpsert) AddFilesCount(v int) *UserUpsert {
 	u.Add(user.FieldFilesCount, v)
@@ -1017,6 +1090,14 @@ func (u *UserUpsertOne) UpdateOptionalInt() *UserUpsertOne {
 	})
 }
 
+// IgnoreOptionalInt keeps the current value of the "optional_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreOptionalInt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreOptionalInt()
+	})
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (u *UserUpsertOne) ClearOptionalInt() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1045,6 +1126,14 @@ func (u *UserUpsertOne) UpdateAge() *UserUpsertOne {
 	})
 }
 
+// IgnoreAge keeps the current value of the "age" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreAge() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreAge()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsertOne) SetName(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1059,6 +1148,14 @@ func (u *UserUpsertOne) UpdateName() *UserUpsertOne {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetLast sets the "last" field.
 func (u *UserUpsertOne) SetLast(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1073,6 +1170,14 @@ func (u *UserUpsertOne) UpdateLast() *UserUpsertOne {
 	})
 }
 
+// IgnoreLast keeps the current value of the "last" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreLast() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreLast()
+	})
+}
+
 // SetNickname sets the "nickname" field.
 func (u *UserUpsertOne) SetNickname(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1087,6 +1192,14 @@ func (u *UserUpsertOne) UpdateNickname() *UserUpsertOne {
 	})
 }
 
+// IgnoreNickname keeps the current value of the "nickname" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreNickname() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreNickname()
+	})
+}
+
 // ClearNickname clears the value of the "nickname" field.
 func (u *UserUpsertOne) ClearNickname() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1108,6 +1221,14 @@ func (u *UserUpsertOne) UpdateAddress() *UserUpsertOne {
 	})
 }
 
+// IgnoreAddress keeps the current value of the "address" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreAddress() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreAddress()
+	})
+}
+
 // ClearAddress clears the value of the "address" field.
 func (u *UserUpsertOne) ClearAddress() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1129,6 +1250,14 @@ func (u *UserUpsertOne) UpdatePhone() *UserUpsertOne {
 	})
 }
 
+// IgnorePhone keeps the current value of the "phone" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnorePhone() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnorePhone()
+	})
+}
+
 // ClearPhone clears the value of the "phone" field.
 func (u *UserUpsertOne) ClearPhone() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1150,6 +1279,14 @@ func (u *UserUpsertOne) UpdatePassword() *UserUpsertOne {
 	})
 }
 
+// IgnorePassword keeps the current value of the "password" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnorePassword() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnorePassword()
+	})
+}
+
 // ClearPassword clears the value of the "password" field.
 func (u *UserUpsertOne) ClearPassword() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1171,6 +1308,14 @@ func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
 	})
 }
 
+// IgnoreRole keeps the current value of the "role" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreRole() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreRole()
+	})
+}
+
 // SetEmployment sets the "employment" field.
 func (u *UserUpsertOne) SetEmployment(v user.Employment) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1185,6 +1330,14 @@ func (u *UserUpsertOne) UpdateEmployment() *UserUpsertOne {
 	})
 }
 
+// IgnoreEmployment keeps the current value of the "employment" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreEmployment() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreEmployment()
+	})
+}
+
 // SetSSOCert sets the "SSOCert" field.
 func (u *UserUpsertOne) SetSSOCert(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1199,6 +1352,14 @@ func (u *UserUpsertOne) UpdateSSOCert() *UserUpsertOne {
 	})
 }
 
+// IgnoreSSOCert keeps the current value of the "SSOCert" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreSSOCert() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreSSOCert()
+	})
+}
+
 // ClearSSOCert clears the value of the "SSOCert" field.
 func (u *UserUpsertOne) ClearSSOCert() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1227,6 +1388,14 @@ func (u *UserUpsertOne) UpdateFilesCount() *UserUpsertOne {
 	})
 }
 
+// IgnoreFilesCount keeps the current value of the "files_count" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertOne) IgnoreFilesCount() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreFilesCount()
+	})
+}
+
 // ClearFilesCount clears the value of the "files_count" field.
 func (u *UserUpsertOne) ClearFilesCount() *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1270,12 +1439,16 @@ func (u *UserUpsertOne) IDX(ctx context.Context) int {
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
+	err      error
 	builders []*UserCreate
 	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
 func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
@@ -1302,7 +1475,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -1455,6 +1628,14 @@ func (u *UserUpsertBulk) UpdateOptionalInt() *UserUpsertBulk {
 	})
 }
 
+// IgnoreOptionalInt keeps the current value of the "optional_int" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreOptionalInt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreOptionalInt()
+	})
+}
+
 // ClearOptionalInt clears the value of the "optional_int" field.
 func (u *UserUpsertBulk) ClearOptionalInt() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1483,6 +1664,14 @@ func (u *UserUpsertBulk) UpdateAge() *UserUpsertBulk {
 	})
 }
 
+// IgnoreAge keeps the current value of the "age" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreAge() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreAge()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsertBulk) SetName(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1497,6 +1686,14 @@ func (u *UserUpsertBulk) UpdateName() *UserUpsertBulk {
 	})
 }
 
+// IgnoreName keeps the current value of the "name" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreName()
+	})
+}
+
 // SetLast sets the "last" field.
 func (u *UserUpsertBulk) SetLast(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1511,6 +1708,14 @@ func (u *UserUpsertBulk) UpdateLast() *UserUpsertBulk {
 	})
 }
 
+// IgnoreLast keeps the current value of the "last" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreLast() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreLast()
+	})
+}
+
 // SetNickname sets the "nickname" field.
 func (u *UserUpsertBulk) SetNickname(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1525,6 +1730,14 @@ func (u *UserUpsertBulk) UpdateNickname() *UserUpsertBulk {
 	})
 }
 
+// IgnoreNickname keeps the current value of the "nickname" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreNickname() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreNickname()
+	})
+}
+
 // ClearNickname clears the value of the "nickname" field.
 func (u *UserUpsertBulk) ClearNickname() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1546,6 +1759,14 @@ func (u *UserUpsertBulk) UpdateAddress() *UserUpsertBulk {
 	})
 }
 
+// IgnoreAddress keeps the current value of the "address" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreAddress() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreAddress()
+	})
+}
+
 // ClearAddress clears the value of the "address" field.
 func (u *UserUpsertBulk) ClearAddress() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1567,6 +1788,14 @@ func (u *UserUpsertBulk) UpdatePhone() *UserUpsertBulk {
 	})
 }
 
+// IgnorePhone keeps the current value of the "phone" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnorePhone() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnorePhone()
+	})
+}
+
 // ClearPhone clears the value of the "phone" field.
 func (u *UserUpsertBulk) ClearPhone() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1588,6 +1817,14 @@ func (u *UserUpsertBulk) UpdatePassword() *UserUpsertBulk {
 	})
 }
 
+// IgnorePassword keeps the current value of the "password" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnorePassword() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnorePassword()
+	})
+}
+
 // ClearPassword clears the value of the "password" field.
 func (u *UserUpsertBulk) ClearPassword() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1609,6 +1846,14 @@ func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
 	})
 }
 
+// IgnoreRole keeps the current value of the "role" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreRole() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreRole()
+	})
+}
+
 // SetEmployment sets the "employment" field.
 func (u *UserUpsertBulk) SetEmployment(v user.Employment) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1623,6 +1868,14 @@ func (u *UserUpsertBulk) UpdateEmployment() *UserUpsertBulk {
 	})
 }
 
+// IgnoreEmployment keeps the current value of the "employment" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreEmployment() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreEmployment()
+	})
+}
+
 // SetSSOCert sets the "SSOCert" field.
 func (u *UserUpsertBulk) SetSSOCert(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1637,6 +1890,14 @@ func (u *UserUpsertBulk) UpdateSSOCert() *UserUpsertBulk {
 	})
 }
 
+// IgnoreSSOCert keeps the current value of the "SSOCert" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreSSOCert() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreSSOCert()
+	})
+}
+
 // ClearSSOCert clears the value of the "SSOCert" field.
 func (u *UserUpsertBulk) ClearSSOCert() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1665,6 +1926,14 @@ func (u *UserUpsertBulk) UpdateFilesCount() *UserUpsertBulk {
 	})
 }
 
+// IgnoreFilesCount keeps the current value of the "files_count" field in case of conflict.
+// It is usually combined with UpdateNewValues, and must be called after it.
+func (u *UserUpsertBulk) IgnoreFilesCount() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.IgnoreFilesCount()
+	})
+}
+
 // ClearFilesCount clears the value of the "files_count" field.
 func (u *UserUpsertBulk) ClearFilesCount() *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -19,8 +19,9 @@ import (
 // UserDelete is the builder for deleting a User entity.
 type UserDelete struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the UserDelete builder.
@@ -43,8 +44,21 @@ func (ud *UserDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (ud *UserDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *UserDelete {
+	ud.modifiers = append(ud.modifiers, modifiers...)
+	return ud
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (udo *UserDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *UserDeleteOne {
+	udo.ud.Modify(modifiers...)
+	return udo
+}
+
 func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	_spec.AddModifiers(ud.modifiers...)
 	if ps := ud.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -54,7 +68,7 @@ func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, ud.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	ud.mutation.done = true
 	return affected, err
@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/entviz"
+
+	"github.com/stretchr/testify/require"
+)
+
+func EntViz(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.User.CreateBulk(
+		client.User.Create().SetName("a8m").SetAge(30),
+		client.User.Create().SetName("nati").SetAge(30),
+	).ExecX(ctx)
+
+	srv := httptest.NewServer(entviz.Handler(client, entviz.WithCounts()))
+	defer srv.Close()
+	resp, err := http.Get(srv.URL)
+	require.NoError(err)
+	resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+	require.Equal("text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+
+	resp, err = http.Post(srv.URL, "application/json", nil)
+	require.NoError(err)
+	resp.Body.Close()
+	require.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL + "?format=json")
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal("application/json", resp.Header.Get("Content-Type"))
+	var g entviz.Graph
+	require.NoError(json.NewDecoder(resp.Body).Decode(&g))
+	require.Len(g.Nodes, len(entviz.Schema().Nodes))
+	nodes := make(map[string]*entviz.Node)
+	for _, n := range g.Nodes {
+		require.Empty(n.CountErr, n.Name)
+		require.NotNil(n.Count, n.Name)
+		nodes[n.Name] = n
+	}
+	require.Equal(2, *nodes["User"].Count)
+	require.Equal("users", nodes["User"].Table)
+	require.Zero(*nodes["Pet"].Count)
+	require.Contains(g.Edges, &entviz.Edge{From: "User", To: "Pet", Name: "pets", Relation: "O2M"})
+	require.Contains(g.Edges, &entviz.Edge{From: "Pet", To: "User", Name: "owner", Relation: "M2O", Inverse: true})
+
+	// Counts are queried only if the handler was configured to.
+	rec := httptest.NewRecorder()
+	entviz.Handler(client).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?format=json", nil))
+	g = entviz.Graph{}
+	require.NoError(json.NewDecoder(rec.Body).Decode(&g))
+	for _, n := range g.Nodes {
+		require.Nil(n.Count, n.Name)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/item"
+	"entgo.io/ent/entc/integration/ent/node"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Export(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// JSON round-trip preserves user-defined IDs.
+	client.Item.CreateBulk(
+		client.Item.Create().SetText("a"),
+		client.Item.Create().SetText("b"),
+		client.Item.Create(),
+	).ExecX(ctx)
+	items := client.Item.Query().Order(ent.Asc(item.FieldID)).AllX(ctx)
+	var buf bytes.Buffer
+	require.NoError(client.Item.ExportJSON(ctx, &buf, nil))
+	client.Item.Delete().ExecX(ctx)
+	imported, err := client.Item.ImportJSON(ctx, &buf)
+	require.NoError(err)
+	require.Len(imported, len(items))
+	for i, it := range client.Item.Query().Order(ent.Asc(item.FieldID)).AllX(ctx) {
+		require.Equal(items[i].ID, it.ID)
+		require.Equal(items[i].Text, it.Text)
+	}
+
+	// CSV round-trip of optional and nillable fields.
+	n1 := client.Node.Create().SetValue(1).SaveX(ctx)
+	client.Node.Create().SetValue(2).SetPrev(n1).ExecX(ctx)
+	client.Node.Create().SetValue(3).ExecX(ctx)
+	buf.Reset()
+	require.NoError(client.Node.ExportCSV(ctx, &buf, client.Node.Query().Order(ent.Asc(node.FieldValue))))
+	client.Node.Delete().ExecX(ctx)
+	_, err = client.Node.ImportCSV(ctx, &buf)
+	require.NoError(err)
+	require.Equal([]int{1, 2, 3}, client.Node.Query().Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx))
+	require.Zero(client.Node.Query().Where(node.HasNext()).CountX(ctx), "edges are not imported")
+
+	// Eager-loaded edges are exported under the "edges" key.
+	n1 = client.Node.Query().Where(node.Value(1)).OnlyX(ctx)
+	client.Node.UpdateOneID(n1.ID).SetNext(client.Node.Query().Where(node.Value(2)).OnlyX(ctx)).ExecX(ctx)
+	buf.Reset()
+	require.NoError(client.Node.ExportJSON(ctx, &buf, client.Node.Query().Where(node.Value(1)).WithNext()))
+	require.Contains(buf.String(), `"edges":{"next":{`)
+
+	// Sensitive fields are exported and imported only when anonymized.
+	client.User.Create().SetName("a8m").SetAge(30).SetPassword("secret").ExecX(ctx)
+	buf.Reset()
+	require.NoError(client.User.ExportJSON(ctx, &buf, nil))
+	require.NotContains(buf.String(), "password")
+	buf.Reset()
+	require.NoError(client.User.ExportJSON(ctx, &buf, nil, ent.ExportAnonymized(nil, "User.name")))
+	require.NotContains(buf.String(), "secret")
+	require.NotContains(buf.String(), `"first_name":"a8m"`)
+	require.Contains(buf.String(), `"password":"anon-`)
+	client.User.Delete().ExecX(ctx)
+	_, err = client.User.ImportJSON(ctx, &buf)
+	require.NoError(err)
+	u := client.User.Query().OnlyX(ctx)
+	require.True(strings.HasPrefix(u.Name, "anon-"))
+	require.Equal(30, u.Age)
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/pet"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Feed(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	feed := ent.NewFeed(client)
+	subCtx, cancel := context.WithCancel(ctx)
+	all, pedro := feed.SubscribePet(subCtx), feed.SubscribePet(subCtx, pet.Name("pedro"))
+	// Events of mutations that are not executed in a
+	// transaction are sent before the mutation returns.
+	next := func(ch <-chan *ent.PetFeedEvent) *ent.PetFeedEvent {
+		select {
+		case e := <-ch:
+			return e
+		default:
+			return nil
+		}
+	}
+
+	p := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	e := next(all)
+	require.Equal(ent.FeedCreated, e.Op)
+	require.Equal(p.ID, e.Pet.ID)
+	require.Equal(p.ID, next(pedro).Pet.ID)
+	x := client.Pet.Create().SetName("xabi").SaveX(ctx)
+	require.Equal(x.ID, next(all).Pet.ID)
+	require.Nil(next(pedro), "predicates of subscribers are applied")
+
+	// Bulk updates send an event for each updated entity.
+	client.Pet.Update().SetTrained(true).ExecX(ctx)
+	for i := 0; i < 2; i++ {
+		e := next(all)
+		require.Equal(ent.FeedUpdated, e.Op)
+		require.True(e.Pet.Trained)
+	}
+	require.Nil(next(all))
+	require.Equal(ent.FeedUpdated, next(pedro).Op)
+
+	// Events of transactions are sent after they are committed.
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	tx.Pet.Create().SetName("pedro").ExecX(ctx)
+	require.NoError(tx.Rollback())
+	require.Nil(next(all))
+	tx, err = client.Tx(ctx)
+	require.NoError(err)
+	tx.Pet.UpdateOneID(p.ID).SetAge(2).ExecX(ctx)
+	require.Nil(next(pedro))
+	require.NoError(tx.Commit())
+	e = next(pedro)
+	require.Equal(ent.FeedUpdated, e.Op)
+	require.Equal(2.0, e.Pet.Age)
+	require.Equal(p.ID, next(all).Pet.ID)
+
+	// Deleted entities are sent as they were before they were deleted.
+	client.Pet.DeleteOne(x).ExecX(ctx)
+	e = next(all)
+	require.Equal(ent.FeedDeleted, e.Op)
+	require.Equal("xabi", e.Pet.Name)
+	require.Nil(next(pedro))
+
+	// Channels are closed when the context of the subscription is done.
+	cancel()
+	_, ok := <-all
+	require.False(ok)
+	_, ok = <-pedro
+	require.False(ok)
+	client.Pet.DeleteOne(p).ExecX(ctx)
+}
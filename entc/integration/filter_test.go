@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/user"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Filter(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetRole(user.RoleAdmin).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+	client.User.Create().SetName("alex").SetAge(35).ExecX(ctx)
+
+	names := func(input string) []string {
+		var f ent.UserFilterInput
+		dec := json.NewDecoder(strings.NewReader(input))
+		dec.DisallowUnknownFields()
+		require.NoError(dec.Decode(&f))
+		q, err := f.Apply(client.User.Query().Order(ent.Asc(user.FieldName)))
+		require.NoError(err)
+		return q.Select(user.FieldName).StringsX(ctx)
+	}
+	require.Equal([]string{"a8m", "alex", "nati"}, names(`{}`))
+	require.Equal([]string{"a8m", "alex"}, names(`{"or": [{"ageGT": 30}, {"nameHasPrefix": "a8"}]}`))
+	require.Equal([]string{"alex"}, names(`{"and": [{"ageGTE": 30}, {"not": {"name": "a8m"}}]}`))
+	require.Equal([]string{"a8m", "nati"}, names(`{"nameIn": ["a8m", "nati"], "ageLT": 35}`))
+	require.Equal([]string{"a8m"}, names(`{"role": "admin"}`))
+	require.Equal([]string{"a8m"}, names(`{"hasPetsWith": [{"name": "pedro"}]}`))
+	require.Equal([]string{"alex", "nati"}, names(`{"hasPets": false}`))
+	require.Empty(names(`{"hasPetsWith": [{"name": "xabi"}]}`))
+
+	// Unknown enum values fail with a validation error.
+	f := &ent.UserFilterInput{RoleIn: []user.Role{user.RoleAdmin, "unknown"}}
+	_, err := f.Apply(client.User.Query())
+	require.True(ent.IsValidationError(err))
+	_, err = (&ent.UserFilterInput{Or: []*ent.UserFilterInput{f}}).P()
+	require.True(ent.IsValidationError(err), "errors of composed filters are returned")
+
+	// Sensitive fields cannot be filtered.
+	dec := json.NewDecoder(strings.NewReader(`{"password": "secret"}`))
+	dec.DisallowUnknownFields()
+	require.Error(dec.Decode(&ent.UserFilterInput{}))
+
+	// Empty filters do not add predicates.
+	p, err := (*ent.UserFilterInput)(nil).P()
+	require.NoError(err)
+	require.Nil(p)
+}
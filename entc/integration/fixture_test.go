@@ -0,0 +1,56 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/user"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Fixtures(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	f, err := client.LoadFixtures(ctx, "testdata/fixtures.yaml")
+	require.NoError(err)
+	require.Len(f.User, 2)
+	require.Len(f.Pet, 2)
+	require.Equal("Ariel", f.User["a8m"].Name)
+	require.Equal(3.5, f.Pet["pedro"].Age)
+	require.True(f.Pet["xabi"].Trained)
+	require.True(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Equal(f.Group["github"].Expire))
+
+	// Edges are resolved by the symbolic names of the entities.
+	a8m := client.User.GetX(ctx, f.User["a8m"].ID)
+	require.Equal(
+		[]string{"Pedro", "Xabi"},
+		a8m.QueryPets().Order(ent.Asc(pet.FieldName)).Select(pet.FieldName).StringsX(ctx),
+	)
+	require.Equal(f.User["nati"].ID, a8m.QueryFriends().OnlyIDX(ctx))
+	require.Equal(
+		[]int{f.User["a8m"].ID, f.User["nati"].ID},
+		f.Group["github"].QueryUsers().Order(ent.Asc(user.FieldName)).IDsX(ctx),
+	)
+	require.Equal(f.GroupInfo["info"].ID, f.Group["github"].QueryInfo().OnlyIDX(ctx))
+
+	_, err = client.LoadFixtures(ctx, "testdata/fixtures_invalid.yaml")
+	require.EqualError(err, `ent: fixture User("a8m") edge "pets" points to undefined fixture Pet("undefined")`)
+	_, err = client.LoadFixtures(ctx, "testdata/fixtures.yaml", "testdata/fixtures.yaml")
+	require.Error(err, "duplicate fixtures")
+	require.Equal(2, client.User.Query().CountX(ctx))
+
+	// Fixtures are loaded in a single transaction.
+	_, err = client.LoadFixtures(ctx, "testdata/fixtures_rollback.yaml")
+	require.Error(err)
+	require.Equal(1, client.GroupInfo.Query().CountX(ctx))
+	require.Equal(1, client.Group.Query().CountX(ctx))
+}
@@ -56,7 +56,8 @@ func (ac *APICreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ac *APICreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (ac *APICreate) gremlinSave(ctx context.Context) (*Api, error) {
@@ -88,5 +89,6 @@ func (ac *APICreate) gremlin() *dsl.Traversal {
 // APICreateBulk is the builder for creating many Api entities in bulk.
 type APICreateBulk struct {
 	config
+	err      error
 	builders []*APICreate
 }
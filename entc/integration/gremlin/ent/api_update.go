@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -145,7 +146,7 @@ func (auo *APIUpdateOne) gremlinSave(ctx context.Context) (*Api, error) {
 	res := &gremlin.Response{}
 	id, ok := auo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Api.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Api", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Api.id" for update`)}
 	}
 	query, bindings := auo.gremlin(id).Query()
 	if err := auo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -180,3 +181,62 @@ func (auo *APIUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (auo *APIUpdateOne) sameUpdate(other *APIUpdateOne) bool {
+	m1, m2 := *auo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// APIUpdateMany is the builder for updating many Api entities by their ids.
+type APIUpdateMany struct {
+	config
+	builders []*APIUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (aum *APIUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*APIUpdateOne
+Builders:
+	for _, b := range aum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*APIUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(api.IDIn(ids...))
+		if err := (&APIUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aum *APIUpdateMany) ExecX(ctx context.Context) {
+	if err := aum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
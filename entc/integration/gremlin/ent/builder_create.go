@@ -56,7 +56,8 @@ func (bc *BuilderCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (bc *BuilderCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (bc *BuilderCreate) gremlinSave(ctx context.Context) (*Builder, error) {
@@ -88,5 +89,6 @@ func (bc *BuilderCreate) gremlin() *dsl.Traversal {
 // BuilderCreateBulk is the builder for creating many Builder entities in bulk.
 type BuilderCreateBulk struct {
 	config
+	err      error
 	builders []*BuilderCreate
 }
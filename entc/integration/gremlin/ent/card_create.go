@@ -176,29 +176,30 @@ func (cc *CardCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CardCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.CreateTime(); !ok {
-		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Card.create_time"`)}
+		errs = append(errs, &ValidationError{Name: "create_time", Entity: "Card", Field: "create_time", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.create_time"`)})
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
-		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Card.update_time"`)}
+		errs = append(errs, &ValidationError{Name: "update_time", Entity: "Card", Field: "update_time", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.update_time"`)})
 	}
 	if _, ok := cc.mutation.Balance(); !ok {
-		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Card.balance"`)}
+		errs = append(errs, &ValidationError{Name: "balance", Entity: "Card", Field: "balance", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.balance"`)})
 	}
 	if _, ok := cc.mutation.Number(); !ok {
-		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Card.number"`)}
+		errs = append(errs, &ValidationError{Name: "number", Entity: "Card", Field: "number", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.number"`)})
 	}
 	if v, ok := cc.mutation.Number(); ok {
 		if err := card.NumberValidator(v); err != nil {
-			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "number", Entity: "Card", Field: "number", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)})
 		}
 	}
 	if v, ok := cc.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Card", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CardCreate) gremlinSave(ctx context.Context) (*Card, error) {
@@ -267,5 +268,6 @@ func (cc *CardCreate) gremlin() *dsl.Traversal {
 // CardCreateBulk is the builder for creating many Card entities in bulk.
 type CardCreateBulk struct {
 	config
+	err      error
 	builders []*CardCreate
 }
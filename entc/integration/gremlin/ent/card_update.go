@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/gremlin"
@@ -187,12 +188,13 @@ func (cu *CardUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cu *CardUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := cu.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Card", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CardUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -451,12 +453,13 @@ func (cuo *CardUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cuo *CardUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := cuo.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Card", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
@@ -466,7 +469,7 @@ func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
 	res := &gremlin.Response{}
 	id, ok := cuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Card.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Card", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Card.id" for update`)}
 	}
 	query, bindings := cuo.gremlin(id).Query()
 	if err := cuo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -552,3 +555,62 @@ func (cuo *CardUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (cuo *CardUpdateOne) sameUpdate(other *CardUpdateOne) bool {
+	m1, m2 := *cuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// CardUpdateMany is the builder for updating many Card entities by their ids.
+type CardUpdateMany struct {
+	config
+	builders []*CardUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (cum *CardUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*CardUpdateOne
+Builders:
+	for _, b := range cum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*CardUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(card.IDIn(ids...))
+		if err := (&CardUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cum *CardUpdateMany) ExecX(ctx context.Context) {
+	if err := cum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
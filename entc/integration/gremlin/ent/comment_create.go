@@ -128,13 +128,14 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CommentCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.UniqueInt(); !ok {
-		return &ValidationError{Name: "unique_int", err: errors.New(`ent: missing required field "Comment.unique_int"`)}
+		errs = append(errs, &ValidationError{Name: "unique_int", Entity: "Comment", Field: "unique_int", Reason: "missing required field", err: errors.New(`ent: missing required field "Comment.unique_int"`)})
 	}
 	if _, ok := cc.mutation.UniqueFloat(); !ok {
-		return &ValidationError{Name: "unique_float", err: errors.New(`ent: missing required field "Comment.unique_float"`)}
+		errs = append(errs, &ValidationError{Name: "unique_float", Entity: "Comment", Field: "unique_float", Reason: "missing required field", err: errors.New(`ent: missing required field "Comment.unique_float"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CommentCreate) gremlinSave(ctx context.Context) (*Comment, error) {
@@ -204,5 +205,6 @@ func (cc *CommentCreate) gremlin() *dsl.Traversal {
 // CommentCreateBulk is the builder for creating many Comment entities in bulk.
 type CommentCreateBulk struct {
 	config
+	err      error
 	builders []*CommentCreate
 }
@@ -183,10 +183,14 @@ func Sum(field string) AggregateFunc {
 	}
 }
 
-// ValidationError returns when validating a field or edge fails.
+// ValidationError returns when validating a field or edge fails. Its exported fields
+// allow mapping validation failures to API errors without parsing error messages.
 type ValidationError struct {
-	Name string // Field or edge name.
-	err  error
+	Name   string // Field or edge name. Same as Field, kept for compatibility.
+	Entity string // Type name. For example, "User". Empty for query options.
+	Field  string // Field or edge name.
+	Reason string // Failure reason. For example, "missing required field".
+	err    error
 }
 
 // Error implements the error interface.
@@ -199,6 +203,40 @@ func (e *ValidationError) Unwrap() error {
 	return e.err
 }
 
+// ValidationErrors returns when validating multiple fields or edges of a builder fails.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the validation errors, and allows errors.As
+// to extract the first *ValidationError from the aggregate.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i := range e {
+		errs[i] = e[i]
+	}
+	return errs
+}
+
+// err returns nil if there are no errors, the error itself if there is one, or the aggregate otherwise.
+func (e ValidationErrors) err() error {
+	switch len(e) {
+	case 0:
+		return nil
+	case 1:
+		return e[0]
+	default:
+		return e
+	}
+}
+
 // IsValidationError returns a boolean indicating whether the error is a validation error.
 func IsValidationError(err error) bool {
 	if err == nil {
@@ -208,6 +246,25 @@ func IsValidationError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// AsValidationErrors returns all validation errors in the error tree, or nil if there are none.
+func AsValidationErrors(err error) ValidationErrors {
+	switch err := err.(type) {
+	case *ValidationError:
+		return ValidationErrors{err}
+	case ValidationErrors:
+		return err
+	case interface{ Unwrap() []error }:
+		var errs ValidationErrors
+		for _, err := range err.Unwrap() {
+			errs = append(errs, AsValidationErrors(err)...)
+		}
+		return errs
+	case interface{ Unwrap() error }:
+		return AsValidationErrors(err.Unwrap())
+	}
+	return nil
+}
+
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
@@ -273,12 +330,24 @@ func IsNotLoaded(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ConstraintKind is the kind of a violated constraint.
+type ConstraintKind string
+
+// Kinds of violated constraints.
+const (
+	UniqueConstraint     ConstraintKind = "unique"
+	ForeignKeyConstraint ConstraintKind = "foreign_key"
+)
+
 // ConstraintError returns when trying to create/update one or more entities and
 // one or more of their constraints failed. For example, violation of edge or
 // field uniqueness.
 type ConstraintError struct {
-	msg  string
-	wrap error
+	Kind       ConstraintKind // Constraint kind. Empty if it is unknown.
+	Constraint string         // Constraint or index name, if reported by the database.
+	Columns    []string       // Offending columns, if reported by the database.
+	msg        string
+	wrap       error
 }
 
 // Error implements the error interface.
@@ -300,6 +369,20 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// IsUniqueConstraintError returns a boolean indicating whether the error is a uniqueness constraint failure.
+// For example, creating an entity with a value that already exists in a unique field.
+func IsUniqueConstraintError(err error) bool {
+	var e *ConstraintError
+	return errors.As(err, &e) && e.Kind == UniqueConstraint
+}
+
+// IsForeignKeyConstraintError returns a boolean indicating whether the error is a foreign-key constraint failure.
+// For example, deleting an entity that is referenced by other entities.
+func IsForeignKeyConstraintError(err error) bool {
+	var e *ConstraintError
+	return errors.As(err, &e) && e.Kind == ForeignKeyConstraint
+}
+
 // selector embedded by the different Select/GroupBy builders.
 type selector struct {
 	label string
@@ -636,6 +719,13 @@ func (e *ConstraintError) UnmarshalGraphson(b []byte) error {
 		return fmt.Errorf("ent: invalid string for error: %s", *v[0])
 	}
 	e.msg = strings.TrimPrefix(*v[0], e.prefix())
+	// Restore the kind and the columns of uniqueness errors, created by NewErrUniqueField and NewErrUniqueEdge.
+	switch f := strings.Fields(e.msg); {
+	case len(f) > 1 && f[0] == "field":
+		e.Kind, e.Columns = UniqueConstraint, []string{f[1][strings.IndexByte(f[1], '.')+1:]}
+	case len(f) > 1 && f[0] == "edge":
+		e.Kind = UniqueConstraint
+	}
 	return nil
 }
 
@@ -644,12 +734,12 @@ func (ConstraintError) prefix() string { return "Error: " }
 
 // NewErrUniqueField creates a constraint error for unique fields.
 func NewErrUniqueField(label, field string, v any) *ConstraintError {
-	return &ConstraintError{msg: fmt.Sprintf("field %s.%s with value: %#v", label, field, v)}
+	return &ConstraintError{Kind: UniqueConstraint, Columns: []string{field}, msg: fmt.Sprintf("field %s.%s with value: %#v", label, field, v)}
 }
 
 // NewErrUniqueEdge creates a constraint error for unique edges.
 func NewErrUniqueEdge(label, edge, id string) *ConstraintError {
-	return &ConstraintError{msg: fmt.Sprintf("edge %s.%s with id: %#v", label, edge, id)}
+	return &ConstraintError{Kind: UniqueConstraint, msg: fmt.Sprintf("edge %s.%s with id: %#v", label, edge, id)}
 }
 
 // isConstantError indicates if the given response holds a gremlin constant containing an error.
@@ -109,19 +109,20 @@ func (evsc *ExValueScanCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (evsc *ExValueScanCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := evsc.mutation.Binary(); !ok {
-		return &ValidationError{Name: "binary", err: errors.New(`ent: missing required field "ExValueScan.binary"`)}
+		errs = append(errs, &ValidationError{Name: "binary", Entity: "ExValueScan", Field: "binary", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.binary"`)})
 	}
 	if _, ok := evsc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ExValueScan.text"`)}
+		errs = append(errs, &ValidationError{Name: "text", Entity: "ExValueScan", Field: "text", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.text"`)})
 	}
 	if _, ok := evsc.mutation.Base64(); !ok {
-		return &ValidationError{Name: "base64", err: errors.New(`ent: missing required field "ExValueScan.base64"`)}
+		errs = append(errs, &ValidationError{Name: "base64", Entity: "ExValueScan", Field: "base64", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.base64"`)})
 	}
 	if _, ok := evsc.mutation.Custom(); !ok {
-		return &ValidationError{Name: "custom", err: errors.New(`ent: missing required field "ExValueScan.custom"`)}
+		errs = append(errs, &ValidationError{Name: "custom", Entity: "ExValueScan", Field: "custom", Reason: "missing required field", err: errors.New(`ent: missing required field "ExValueScan.custom"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (evsc *ExValueScanCreate) gremlinSave(ctx context.Context) (*ExValueScan, error) {
@@ -174,5 +175,6 @@ func (evsc *ExValueScanCreate) gremlin() *dsl.Traversal {
 // ExValueScanCreateBulk is the builder for creating many ExValueScan entities in bulk.
 type ExValueScanCreateBulk struct {
 	config
+	err      error
 	builders []*ExValueScanCreate
 }
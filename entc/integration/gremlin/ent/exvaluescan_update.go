@@ -11,6 +11,7 @@ import (
 	"errors"
 	"math/big"
 	"net/url"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -318,7 +319,7 @@ func (evsuo *ExValueScanUpdateOne) gremlinSave(ctx context.Context) (*ExValueSca
 	res := &gremlin.Response{}
 	id, ok := evsuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExValueScan.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "ExValueScan", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "ExValueScan.id" for update`)}
 	}
 	query, bindings := evsuo.gremlin(id).Query()
 	if err := evsuo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -387,3 +388,62 @@ func (evsuo *ExValueScanUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (evsuo *ExValueScanUpdateOne) sameUpdate(other *ExValueScanUpdateOne) bool {
+	m1, m2 := *evsuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// ExValueScanUpdateMany is the builder for updating many ExValueScan entities by their ids.
+type ExValueScanUpdateMany struct {
+	config
+	builders []*ExValueScanUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (evsum *ExValueScanUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*ExValueScanUpdateOne
+Builders:
+	for _, b := range evsum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*ExValueScanUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(exvaluescan.IDIn(ids...))
+		if err := (&ExValueScanUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evsum *ExValueScanUpdateMany) ExecX(ctx context.Context) {
+	if err := evsum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
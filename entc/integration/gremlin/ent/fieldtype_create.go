@@ -872,82 +872,83 @@ func (ftc *FieldTypeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftc *FieldTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Int(); !ok {
-		return &ValidationError{Name: "int", err: errors.New(`ent: missing required field "FieldType.int"`)}
+		errs = append(errs, &ValidationError{Name: "int", Entity: "FieldType", Field: "int", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int"`)})
 	}
 	if _, ok := ftc.mutation.Int8(); !ok {
-		return &ValidationError{Name: "int8", err: errors.New(`ent: missing required field "FieldType.int8"`)}
+		errs = append(errs, &ValidationError{Name: "int8", Entity: "FieldType", Field: "int8", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int8"`)})
 	}
 	if _, ok := ftc.mutation.Int16(); !ok {
-		return &ValidationError{Name: "int16", err: errors.New(`ent: missing required field "FieldType.int16"`)}
+		errs = append(errs, &ValidationError{Name: "int16", Entity: "FieldType", Field: "int16", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int16"`)})
 	}
 	if _, ok := ftc.mutation.Int32(); !ok {
-		return &ValidationError{Name: "int32", err: errors.New(`ent: missing required field "FieldType.int32"`)}
+		errs = append(errs, &ValidationError{Name: "int32", Entity: "FieldType", Field: "int32", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int32"`)})
 	}
 	if _, ok := ftc.mutation.Int64(); !ok {
-		return &ValidationError{Name: "int64", err: errors.New(`ent: missing required field "FieldType.int64"`)}
+		errs = append(errs, &ValidationError{Name: "int64", Entity: "FieldType", Field: "int64", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.int64"`)})
 	}
 	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "validate_optional_int32", Entity: "FieldType", Field: "validate_optional_int32", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FieldType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "mac", Entity: "FieldType", Field: "mac", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.Dir(); !ok {
-		return &ValidationError{Name: "dir", err: errors.New(`ent: missing required field "FieldType.dir"`)}
+		errs = append(errs, &ValidationError{Name: "dir", Entity: "FieldType", Field: "dir", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.dir"`)})
 	}
 	if v, ok := ftc.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ndir", Entity: "FieldType", Field: "ndir", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "link", Entity: "FieldType", Field: "link", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "raw_data", Entity: "FieldType", Field: "raw_data", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ip", Entity: "FieldType", Field: "ip", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "FieldType.role"`)}
+		errs = append(errs, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.role"`)})
 	}
 	if v, ok := ftc.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "role", Entity: "FieldType", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)})
 		}
 	}
 	if v, ok := ftc.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "FieldType", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.Pair(); !ok {
-		return &ValidationError{Name: "pair", err: errors.New(`ent: missing required field "FieldType.pair"`)}
+		errs = append(errs, &ValidationError{Name: "pair", Entity: "FieldType", Field: "pair", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.pair"`)})
 	}
 	if _, ok := ftc.mutation.Vstring(); !ok {
-		return &ValidationError{Name: "vstring", err: errors.New(`ent: missing required field "FieldType.vstring"`)}
+		errs = append(errs, &ValidationError{Name: "vstring", Entity: "FieldType", Field: "vstring", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.vstring"`)})
 	}
 	if _, ok := ftc.mutation.Triple(); !ok {
-		return &ValidationError{Name: "triple", err: errors.New(`ent: missing required field "FieldType.triple"`)}
+		errs = append(errs, &ValidationError{Name: "triple", Entity: "FieldType", Field: "triple", Reason: "missing required field", err: errors.New(`ent: missing required field "FieldType.triple"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FieldTypeCreate) gremlinSave(ctx context.Context) (*FieldType, error) {
@@ -1174,5 +1175,6 @@ func (ftc *FieldTypeCreate) gremlin() *dsl.Traversal {
 // FieldTypeCreateBulk is the builder for creating many FieldType entities in bulk.
 type FieldTypeCreateBulk struct {
 	config
+	err      error
 	builders []*FieldTypeCreate
 }
@@ -200,18 +200,19 @@ func (fc *FileCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (fc *FileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Size(); !ok {
-		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
+		errs = append(errs, &ValidationError{Name: "size", Entity: "File", Field: "size", Reason: "missing required field", err: errors.New(`ent: missing required field "File.size"`)})
 	}
 	if v, ok := fc.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "size", Entity: "File", Field: "size", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)})
 		}
 	}
 	if _, ok := fc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "File.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "File", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "File.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FileCreate) gremlinSave(ctx context.Context) (*File, error) {
@@ -286,5 +287,6 @@ func (fc *FileCreate) gremlin() *dsl.Traversal {
 // FileCreateBulk is the builder for creating many File entities in bulk.
 type FileCreateBulk struct {
 	config
+	err      error
 	builders []*FileCreate
 }
@@ -122,26 +122,27 @@ func (ftc *FileTypeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftc *FileTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FileType.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "FileType", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "FileType.name"`)})
 	}
 	if _, ok := ftc.mutation.GetType(); !ok {
-		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FileType.type"`)}
+		errs = append(errs, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: "missing required field", err: errors.New(`ent: missing required field "FileType.type"`)})
 	}
 	if v, ok := ftc.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)})
 		}
 	}
 	if _, ok := ftc.mutation.State(); !ok {
-		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "FileType.state"`)}
+		errs = append(errs, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: "missing required field", err: errors.New(`ent: missing required field "FileType.state"`)})
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FileTypeCreate) gremlinSave(ctx context.Context) (*FileType, error) {
@@ -205,5 +206,6 @@ func (ftc *FileTypeCreate) gremlin() *dsl.Traversal {
 // FileTypeCreateBulk is the builder for creating many FileType entities in bulk.
 type FileTypeCreateBulk struct {
 	config
+	err      error
 	builders []*FileTypeCreate
 }
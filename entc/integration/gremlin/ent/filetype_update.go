@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -137,17 +138,18 @@ func (ftu *FileTypeUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftu *FileTypeUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := ftu.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)})
 		}
 	}
 	if v, ok := ftu.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftu *FileTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -346,17 +348,18 @@ func (ftuo *FileTypeUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ftuo *FileTypeUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := ftuo.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "FileType", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)})
 		}
 	}
 	if v, ok := ftuo.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "FileType", Field: "state", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftuo *FileTypeUpdateOne) gremlinSave(ctx context.Context) (*FileType, error) {
@@ -366,7 +369,7 @@ func (ftuo *FileTypeUpdateOne) gremlinSave(ctx context.Context) (*FileType, erro
 	res := &gremlin.Response{}
 	id, ok := ftuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileType.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "FileType", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "FileType.id" for update`)}
 	}
 	query, bindings := ftuo.gremlin(id).Query()
 	if err := ftuo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -439,3 +442,62 @@ func (ftuo *FileTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (ftuo *FileTypeUpdateOne) sameUpdate(other *FileTypeUpdateOne) bool {
+	m1, m2 := *ftuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// FileTypeUpdateMany is the builder for updating many FileType entities by their ids.
+type FileTypeUpdateMany struct {
+	config
+	builders []*FileTypeUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (ftum *FileTypeUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*FileTypeUpdateOne
+Builders:
+	for _, b := range ftum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*FileTypeUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(filetype.IDIn(ids...))
+		if err := (&FileTypeUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftum *FileTypeUpdateMany) ExecX(ctx context.Context) {
+	if err := ftum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
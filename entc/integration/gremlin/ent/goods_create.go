@@ -56,7 +56,8 @@ func (gc *GoodsCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gc *GoodsCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (gc *GoodsCreate) gremlinSave(ctx context.Context) (*Goods, error) {
@@ -88,5 +89,6 @@ func (gc *GoodsCreate) gremlin() *dsl.Traversal {
 // GoodsCreateBulk is the builder for creating many Goods entities in bulk.
 type GoodsCreateBulk struct {
 	config
+	err      error
 	builders []*GoodsCreate
 }
@@ -185,34 +185,35 @@ func (gc *GroupCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (gc *GroupCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gc.mutation.Active(); !ok {
-		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Group.active"`)}
+		errs = append(errs, &ValidationError{Name: "active", Entity: "Group", Field: "active", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.active"`)})
 	}
 	if _, ok := gc.mutation.Expire(); !ok {
-		return &ValidationError{Name: "expire", err: errors.New(`ent: missing required field "Group.expire"`)}
+		errs = append(errs, &ValidationError{Name: "expire", Entity: "Group", Field: "expire", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.expire"`)})
 	}
 	if v, ok := gc.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "Group", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)})
 		}
 	}
 	if v, ok := gc.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "max_users", Entity: "Group", Field: "max_users", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)})
 		}
 	}
 	if _, ok := gc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Group.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Group.name"`)})
 	}
 	if v, ok := gc.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)})
 		}
 	}
 	if _, ok := gc.mutation.InfoID(); !ok {
-		return &ValidationError{Name: "info", err: errors.New(`ent: missing required edge "Group.info"`)}
+		errs = append(errs, &ValidationError{Name: "info", Entity: "Group", Field: "info", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Group.info"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gc *GroupCreate) gremlinSave(ctx context.Context) (*Group, error) {
@@ -291,5 +292,6 @@ func (gc *GroupCreate) gremlin() *dsl.Traversal {
 // GroupCreateBulk is the builder for creating many Group entities in bulk.
 type GroupCreateBulk struct {
 	config
+	err      error
 	builders []*GroupCreate
 }
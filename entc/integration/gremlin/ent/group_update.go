@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/gremlin"
@@ -267,25 +268,26 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (gu *GroupUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := gu.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "Group", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)})
 		}
 	}
 	if v, ok := gu.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "max_users", Entity: "Group", Field: "max_users", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)})
 		}
 	}
 	if v, ok := gu.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)})
 		}
 	}
 	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.info"`)
+		errs = append(errs, &ValidationError{Name: "info", Entity: "Group", Field: "info", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Group.info"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gu *GroupUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -652,25 +654,26 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (guo *GroupUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := guo.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "type", Entity: "Group", Field: "type", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)})
 		}
 	}
 	if v, ok := guo.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "max_users", Entity: "Group", Field: "max_users", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)})
 		}
 	}
 	if v, ok := guo.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Group", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)})
 		}
 	}
 	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.info"`)
+		errs = append(errs, &ValidationError{Name: "info", Entity: "Group", Field: "info", Reason: "clearing a required unique edge", err: errors.New(`ent: clearing a required unique edge "Group.info"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
@@ -680,7 +683,7 @@ func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
 	res := &gremlin.Response{}
 	id, ok := guo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Group.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Group", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Group.id" for update`)}
 	}
 	query, bindings := guo.gremlin(id).Query()
 	if err := guo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -793,3 +796,62 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (guo *GroupUpdateOne) sameUpdate(other *GroupUpdateOne) bool {
+	m1, m2 := *guo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// GroupUpdateMany is the builder for updating many Group entities by their ids.
+type GroupUpdateMany struct {
+	config
+	builders []*GroupUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (gum *GroupUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*GroupUpdateOne
+Builders:
+	for _, b := range gum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*GroupUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(group.IDIn(ids...))
+		if err := (&GroupUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gum *GroupUpdateMany) ExecX(ctx context.Context) {
+	if err := gum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
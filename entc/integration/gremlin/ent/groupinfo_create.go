@@ -104,13 +104,14 @@ func (gic *GroupInfoCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (gic *GroupInfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gic.mutation.Desc(); !ok {
-		return &ValidationError{Name: "desc", err: errors.New(`ent: missing required field "GroupInfo.desc"`)}
+		errs = append(errs, &ValidationError{Name: "desc", Entity: "GroupInfo", Field: "desc", Reason: "missing required field", err: errors.New(`ent: missing required field "GroupInfo.desc"`)})
 	}
 	if _, ok := gic.mutation.MaxUsers(); !ok {
-		return &ValidationError{Name: "max_users", err: errors.New(`ent: missing required field "GroupInfo.max_users"`)}
+		errs = append(errs, &ValidationError{Name: "max_users", Entity: "GroupInfo", Field: "max_users", Reason: "missing required field", err: errors.New(`ent: missing required field "GroupInfo.max_users"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (gic *GroupInfoCreate) gremlinSave(ctx context.Context) (*GroupInfo, error) {
@@ -167,5 +168,6 @@ func (gic *GroupInfoCreate) gremlin() *dsl.Traversal {
 // GroupInfoCreateBulk is the builder for creating many GroupInfo entities in bulk.
 type GroupInfoCreateBulk struct {
 	config
+	err      error
 	builders []*GroupInfoCreate
 }
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -312,7 +313,7 @@ func (giuo *GroupInfoUpdateOne) gremlinSave(ctx context.Context) (*GroupInfo, er
 	res := &gremlin.Response{}
 	id, ok := giuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupInfo.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "GroupInfo", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "GroupInfo.id" for update`)}
 	}
 	query, bindings := giuo.gremlin(id).Query()
 	if err := giuo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -381,3 +382,62 @@ func (giuo *GroupInfoUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (giuo *GroupInfoUpdateOne) sameUpdate(other *GroupInfoUpdateOne) bool {
+	m1, m2 := *giuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// GroupInfoUpdateMany is the builder for updating many GroupInfo entities by their ids.
+type GroupInfoUpdateMany struct {
+	config
+	builders []*GroupInfoUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (gium *GroupInfoUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*GroupInfoUpdateOne
+Builders:
+	for _, b := range gium.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*GroupInfoUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(groupinfo.IDIn(ids...))
+		if err := (&GroupInfoUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gium *GroupInfoUpdateMany) ExecX(ctx context.Context) {
+	if err := gium.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
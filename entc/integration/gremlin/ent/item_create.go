@@ -96,17 +96,18 @@ func (ic *ItemCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ic *ItemCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := ic.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "text", Entity: "Item", Field: "text", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)})
 		}
 	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "id", Entity: "Item", Field: "id", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ic *ItemCreate) gremlinSave(ctx context.Context) (*Item, error) {
@@ -160,5 +161,6 @@ func (ic *ItemCreate) gremlin() *dsl.Traversal {
 // ItemCreateBulk is the builder for creating many Item entities in bulk.
 type ItemCreateBulk struct {
 	config
+	err      error
 	builders []*ItemCreate
 }
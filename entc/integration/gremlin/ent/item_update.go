@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -87,12 +88,13 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (iu *ItemUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := iu.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "text", Entity: "Item", Field: "text", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (iu *ItemUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -231,12 +233,13 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (iuo *ItemUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := iuo.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "text", Entity: "Item", Field: "text", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
@@ -246,7 +249,7 @@ func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 	res := &gremlin.Response{}
 	id, ok := iuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Item.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Item", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Item.id" for update`)}
 	}
 	query, bindings := iuo.gremlin(id).Query()
 	if err := iuo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -309,3 +312,62 @@ func (iuo *ItemUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (iuo *ItemUpdateOne) sameUpdate(other *ItemUpdateOne) bool {
+	m1, m2 := *iuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// ItemUpdateMany is the builder for updating many Item entities by their ids.
+type ItemUpdateMany struct {
+	config
+	builders []*ItemUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (ium *ItemUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*ItemUpdateOne
+Builders:
+	for _, b := range ium.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*ItemUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(item.IDIn(ids...))
+		if err := (&ItemUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ium *ItemUpdateMany) ExecX(ctx context.Context) {
+	if err := ium.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
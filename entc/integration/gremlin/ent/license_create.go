@@ -105,13 +105,14 @@ func (lc *LicenseCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (lc *LicenseCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := lc.mutation.CreateTime(); !ok {
-		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "License.create_time"`)}
+		errs = append(errs, &ValidationError{Name: "create_time", Entity: "License", Field: "create_time", Reason: "missing required field", err: errors.New(`ent: missing required field "License.create_time"`)})
 	}
 	if _, ok := lc.mutation.UpdateTime(); !ok {
-		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "License.update_time"`)}
+		errs = append(errs, &ValidationError{Name: "update_time", Entity: "License", Field: "update_time", Reason: "missing required field", err: errors.New(`ent: missing required field "License.update_time"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (lc *LicenseCreate) gremlinSave(ctx context.Context) (*License, error) {
@@ -152,5 +153,6 @@ func (lc *LicenseCreate) gremlin() *dsl.Traversal {
 // LicenseCreateBulk is the builder for creating many License entities in bulk.
 type LicenseCreateBulk struct {
 	config
+	err      error
 	builders []*LicenseCreate
 }
@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"reflect"
 	"time"
 
 	"entgo.io/ent/dialect/gremlin"
@@ -179,7 +180,7 @@ func (luo *LicenseUpdateOne) gremlinSave(ctx context.Context) (*License, error)
 	res := &gremlin.Response{}
 	id, ok := luo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "License.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "License", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "License.id" for update`)}
 	}
 	query, bindings := luo.gremlin(id).Query()
 	if err := luo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -217,3 +218,62 @@ func (luo *LicenseUpdateOne) gremlin(id int) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (luo *LicenseUpdateOne) sameUpdate(other *LicenseUpdateOne) bool {
+	m1, m2 := *luo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// LicenseUpdateMany is the builder for updating many License entities by their ids.
+type LicenseUpdateMany struct {
+	config
+	builders []*LicenseUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (lum *LicenseUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*LicenseUpdateOne
+Builders:
+	for _, b := range lum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*LicenseUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(license.IDIn(ids...))
+		if err := (&LicenseUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lum *LicenseUpdateMany) ExecX(ctx context.Context) {
+	if err := lum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -125,7 +125,8 @@ func (nc *NodeCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (nc *NodeCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (nc *NodeCreate) gremlinSave(ctx context.Context) (*Node, error) {
@@ -189,5 +190,6 @@ func (nc *NodeCreate) gremlin() *dsl.Traversal {
 // NodeCreateBulk is the builder for creating many Node entities in bulk.
 type NodeCreateBulk struct {
 	config
+	err      error
 	builders []*NodeCreate
 }
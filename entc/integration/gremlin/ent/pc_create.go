@@ -56,7 +56,8 @@ func (_pc *PCCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_pc *PCCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (_pc *PCCreate) gremlinSave(ctx context.Context) (*PC, error) {
@@ -88,5 +89,6 @@ func (_pc *PCCreate) gremlin() *dsl.Traversal {
 // PCCreateBulk is the builder for creating many PC entities in bulk.
 type PCCreateBulk struct {
 	config
+	err      error
 	builders []*PCCreate
 }
@@ -174,16 +174,17 @@ func (pc *PetCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := pc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "Pet.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "Pet", Field: "age", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.age"`)})
 	}
 	if _, ok := pc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Pet.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Pet", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.name"`)})
 	}
 	if _, ok := pc.mutation.Trained(); !ok {
-		return &ValidationError{Name: "trained", err: errors.New(`ent: missing required field "Pet.trained"`)}
+		errs = append(errs, &ValidationError{Name: "trained", Entity: "Pet", Field: "trained", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.trained"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PetCreate) gremlinSave(ctx context.Context) (*Pet, error) {
@@ -252,5 +253,6 @@ func (pc *PetCreate) gremlin() *dsl.Traversal {
 // PetCreateBulk is the builder for creating many Pet entities in bulk.
 type PetCreateBulk struct {
 	config
+	err      error
 	builders []*PetCreate
 }
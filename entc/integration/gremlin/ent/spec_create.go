@@ -71,7 +71,8 @@ func (sc *SpecCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (sc *SpecCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (sc *SpecCreate) gremlinSave(ctx context.Context) (*Spec, error) {
@@ -106,5 +107,6 @@ func (sc *SpecCreate) gremlin() *dsl.Traversal {
 // SpecCreateBulk is the builder for creating many Spec entities in bulk.
 type SpecCreateBulk struct {
 	config
+	err      error
 	builders []*SpecCreate
 }
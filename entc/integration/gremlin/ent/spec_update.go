@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"errors"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -228,7 +229,7 @@ func (suo *SpecUpdateOne) gremlinSave(ctx context.Context) (*Spec, error) {
 	res := &gremlin.Response{}
 	id, ok := suo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Spec.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Spec", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Spec.id" for update`)}
 	}
 	query, bindings := suo.gremlin(id).Query()
 	if err := suo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -273,3 +274,62 @@ func (suo *SpecUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (suo *SpecUpdateOne) sameUpdate(other *SpecUpdateOne) bool {
+	m1, m2 := *suo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// SpecUpdateMany is the builder for updating many Spec entities by their ids.
+type SpecUpdateMany struct {
+	config
+	builders []*SpecUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (sum *SpecUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*SpecUpdateOne
+Builders:
+	for _, b := range sum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*SpecUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(spec.IDIn(ids...))
+		if err := (&SpecUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sum *SpecUpdateMany) ExecX(ctx context.Context) {
+	if err := sum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -181,26 +181,27 @@ func (tc *TaskCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TaskCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Priority(); !ok {
-		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Task.priority"`)}
+		errs = append(errs, &ValidationError{Name: "priority", Entity: "Task", Field: "priority", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.priority"`)})
 	}
 	if v, ok := tc.mutation.Priority(); ok {
 		if err := v.Validate(); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "Task", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)})
 		}
 	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "Task", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.created_at"`)})
 	}
 	if _, ok := tc.mutation.GetOp(); !ok {
-		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "Task.op"`)}
+		errs = append(errs, &ValidationError{Name: "op", Entity: "Task", Field: "op", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.op"`)})
 	}
 	if v, ok := tc.mutation.GetOp(); ok {
 		if err := enttask.OpValidator(v); err != nil {
-			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "op", Entity: "Task", Field: "op", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TaskCreate) gremlinSave(ctx context.Context) (*Task, error) {
@@ -256,5 +257,6 @@ func (tc *TaskCreate) gremlin() *dsl.Traversal {
 // TaskCreateBulk is the builder for creating many Task entities in bulk.
 type TaskCreateBulk struct {
 	config
+	err      error
 	builders []*TaskCreate
 }
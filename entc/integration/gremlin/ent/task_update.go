@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/gremlin"
 	"entgo.io/ent/dialect/gremlin/graph/dsl"
@@ -208,17 +209,18 @@ func (tu *TaskUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tu *TaskUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := tu.mutation.Priority(); ok {
 		if err := v.Validate(); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "Task", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)})
 		}
 	}
 	if v, ok := tu.mutation.GetOp(); ok {
 		if err := enttask.OpValidator(v); err != nil {
-			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "op", Entity: "Task", Field: "op", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tu *TaskUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -495,17 +497,18 @@ func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tuo *TaskUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := tuo.mutation.Priority(); ok {
 		if err := v.Validate(); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "priority", Entity: "Task", Field: "priority", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)})
 		}
 	}
 	if v, ok := tuo.mutation.GetOp(); ok {
 		if err := enttask.OpValidator(v); err != nil {
-			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "op", Entity: "Task", Field: "op", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.op": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tuo *TaskUpdateOne) gremlinSave(ctx context.Context) (*Task, error) {
@@ -515,7 +518,7 @@ func (tuo *TaskUpdateOne) gremlinSave(ctx context.Context) (*Task, error) {
 	res := &gremlin.Response{}
 	id, ok := tuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Task", Field: "id", Reason: "missing id for update", err: errors.New(`ent: missing "Task.id" for update`)}
 	}
 	query, bindings := tuo.gremlin(id).Query()
 	if err := tuo.driver.Exec(ctx, query, bindings, res); err != nil {
@@ -599,3 +602,62 @@ func (tuo *TaskUpdateOne) gremlin(id string) *dsl.Traversal {
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (tuo *TaskUpdateOne) sameUpdate(other *TaskUpdateOne) bool {
+	m1, m2 := *tuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// TaskUpdateMany is the builder for updating many Task entities by their ids.
+type TaskUpdateMany struct {
+	config
+	builders []*TaskUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (tum *TaskUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*TaskUpdateOne
+Builders:
+	for _, b := range tum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*TaskUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(enttask.IDIn(ids...))
+		if err := (&TaskUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tum *TaskUpdateMany) ExecX(ctx context.Context) {
+	if err := tum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
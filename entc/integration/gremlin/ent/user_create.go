@@ -414,37 +414,38 @@ func (uc *UserCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := uc.mutation.OptionalInt(); ok {
 		if err := user.OptionalIntValidator(v); err != nil {
-			return &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "optional_int", Entity: "User", Field: "optional_int", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "User.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "User", Field: "age", Reason: "missing required field", err: errors.New(`ent: missing required field "User.age"`)})
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "User", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "User.name"`)})
 	}
 	if _, ok := uc.mutation.Last(); !ok {
-		return &ValidationError{Name: "last", err: errors.New(`ent: missing required field "User.last"`)}
+		errs = append(errs, &ValidationError{Name: "last", Entity: "User", Field: "last", Reason: "missing required field", err: errors.New(`ent: missing required field "User.last"`)})
 	}
 	if _, ok := uc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+		errs = append(errs, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: "missing required field", err: errors.New(`ent: missing required field "User.role"`)})
 	}
 	if v, ok := uc.mutation.Role(); ok {
 		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "role", Entity: "User", Field: "role", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Employment(); !ok {
-		return &ValidationError{Name: "employment", err: errors.New(`ent: missing required field "User.employment"`)}
+		errs = append(errs, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: "missing required field", err: errors.New(`ent: missing required field "User.employment"`)})
 	}
 	if v, ok := uc.mutation.Employment(); ok {
 		if err := user.EmploymentValidator(v); err != nil {
-			return &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "employment", Entity: "User", Field: "employment", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) gremlinSave(ctx context.Context) (*User, error) {
@@ -589,5 +590,6 @@ func (uc *UserCreate) gremlin() *dsl.Traversal {
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
+	err      error
 	builders []*UserCreate
 }
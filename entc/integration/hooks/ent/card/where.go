@@ -149,6 +149,11 @@ func NumberContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldNumber, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Card {
 	return predicate.Card(sql.FieldEQ(FieldName, v))
@@ -224,6 +229,11 @@ func NameContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Card {
 	return predicate.Card(sql.FieldEQ(FieldCreatedAt, v))
@@ -329,6 +339,11 @@ func InHookContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldInHook, v))
 }
 
+// InHookHasPrefixFold applies the HasPrefixFold predicate on the "in_hook" field.
+func InHookHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldInHook, v))
+}
+
 // ExpiredAtEQ applies the EQ predicate on the "expired_at" field.
 func ExpiredAtEQ(v time.Time) predicate.Card {
 	return predicate.Card(sql.FieldEQ(FieldExpiredAt, v))
@@ -379,6 +394,18 @@ func ExpiredAtNotNil() predicate.Card {
 	return predicate.Card(sql.FieldNotNull(FieldExpiredAt))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.Card {
+	return predicate.Card(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
+// ExpiredAtWithinLast applies the WithinLast predicate on the "expired_at" field.
+// It matches values that are within the given duration before the current time.
+func ExpiredAtWithinLast(d time.Duration) predicate.Card {
+	return predicate.Card(sql.FieldWithinLast(FieldExpiredAt, d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -159,21 +159,22 @@ func (cc *CardCreate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CardCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.Number(); !ok {
-		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Card.number"`)}
+		errs = append(errs, &ValidationError{Name: "number", Entity: "Card", Field: "number", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.number"`)})
 	}
 	if v, ok := cc.mutation.Number(); ok {
 		if err := card.NumberValidator(v); err != nil {
-			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "number", Entity: "Card", Field: "number", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)})
 		}
 	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Card.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "Card", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.created_at"`)})
 	}
 	if _, ok := cc.mutation.InHook(); !ok {
-		return &ValidationError{Name: "in_hook", err: errors.New(`ent: missing required field "Card.in_hook"`)}
+		errs = append(errs, &ValidationError{Name: "in_hook", Entity: "Card", Field: "in_hook", Reason: "missing required field", err: errors.New(`ent: missing required field "Card.in_hook"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
@@ -183,7 +184,7 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -242,11 +243,15 @@ func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
 // CardCreateBulk is the builder for creating many Card entities in bulk.
 type CardCreateBulk struct {
 	config
+	err      error
 	builders []*CardCreate
 }
 
 // Save creates the Card entities in the database.
 func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Card, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -272,7 +277,7 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -194,6 +194,17 @@ func NameContainsFold(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefixFold(FieldName, v))
+}
+
+// DeleteTimeWithinLast applies the WithinLast predicate on the "delete_time" field.
+// It matches values that are within the given duration before the current time.
+func DeleteTimeWithinLast(d time.Duration) predicate.Pet {
+	return predicate.Pet(sql.FieldWithinLast(FieldDeleteTime, d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -7,6 +7,8 @@
 package user
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -190,6 +192,96 @@ func ByBestFriendField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newBestFriendStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "cards_count") that User queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that User queries can be ordered by.
+const (
+	OrderFieldID           OrderField = "id"
+	OrderFieldVersion      OrderField = "version"
+	OrderFieldName         OrderField = "name"
+	OrderFieldWorth        OrderField = "worth"
+	OrderFieldActive       OrderField = "active"
+	OrderFieldCardsCount   OrderField = "cards_count"
+	OrderFieldPetsCount    OrderField = "pets_count"
+	OrderFieldFriendsCount OrderField = "friends_count"
+)
+
+// OrderFields holds all fields and edge counts that User queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldVersion,
+	OrderFieldName,
+	OrderFieldWorth,
+	OrderFieldActive,
+	OrderFieldCardsCount,
+	OrderFieldPetsCount,
+	OrderFieldFriendsCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if User queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("user: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := user.OrderByField(user.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.User.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldVersion:
+		return ByVersion(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldWorth:
+		return ByWorth(opts...), nil
+	case OrderFieldActive:
+		return ByActive(opts...), nil
+	case OrderFieldCardsCount:
+		return ByCardsCount(opts...), nil
+	case OrderFieldPetsCount:
+		return ByPetsCount(opts...), nil
+	case OrderFieldFriendsCount:
+		return ByFriendsCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newCardsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
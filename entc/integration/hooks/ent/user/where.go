@@ -187,6 +187,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // WorthEQ applies the EQ predicate on the "worth" field.
 func WorthEQ(v uint) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldWorth, v))
@@ -312,6 +317,11 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// PasswordHasPrefixFold applies the HasPrefixFold predicate on the "password" field.
+func PasswordHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldPassword, v))
+}
+
 // ActiveEQ applies the EQ predicate on the "active" field.
 func ActiveEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldActive, v))
@@ -18,9 +18,16 @@ import (
 var (
 	// WithGlobalUniqueID sets the universal ids options to the migration.
 	// If this option is enabled, ent migration will allocate a 1<<32 range
-	// for the ids of each entity (table).
+	// for the ids of each entity (table), unless configured otherwise using
+	// the WithRangeAllocator and WithIDRange options.
 	// Note that this option cannot be applied on tables that already exist.
 	WithGlobalUniqueID = schema.WithGlobalUniqueID
+	// WithRangeAllocator sets the allocator of the id ranges of the entities
+	// (tables) when universal ids are enabled.
+	WithRangeAllocator = schema.WithRangeAllocator
+	// WithIDRange pins the id range of the given entity (table) to [start, end)
+	// when universal ids are enabled.
+	WithIDRange = schema.WithIDRange
 	// WithDropColumn sets the drop column option to the migration.
 	// If this option is enabled, ent migration will drop old columns
 	// that were used for both fields and edges. This defaults to false.
@@ -32,8 +39,36 @@ var (
 	// and therefore, it's recommended to enable this option to get more
 	// flexibility in the schema changes.
 	WithDropIndex = schema.WithDropIndex
+	// WithDropForeignKey sets the drop foreign-key option to the legacy migration.
+	// If this option is enabled, ent migration will drop foreign-key constraints
+	// that no longer correspond to the edges of the schema. This defaults to false.
+	WithDropForeignKey = schema.WithDropForeignKey
+	// WithDropTable sets the drop table option to the migration.
+	// If this option is enabled, ent migration will drop tables that
+	// were removed from the schema, if they are tracked by the global
+	// unique ids table, or match one of the prefixes that were set by
+	// WithDropTablePrefix. This defaults to false.
+	WithDropTable = schema.WithDropTable
+	// WithDropTablePrefix sets the prefixes of the tables that can be
+	// dropped by the migration when the drop table option is enabled.
+	WithDropTablePrefix = schema.WithDropTablePrefix
+	// WithSchemaName sets the schema (named-database) to migrate, instead of the
+	// current schema of the connection. Tables with the entsql.Schema annotation
+	// are migrated in their own schema.
+	WithSchemaName = schema.WithSchemaName
+	// WithNativeEnums sets the native enum types option to the migration.
+	// If this option is enabled, ent migration will create Postgres enum
+	// types for enum fields, instead of varchar columns. This defaults to false.
+	WithNativeEnums = schema.WithNativeEnums
 	// WithForeignKeys enables creating foreign-key in schema DDL. This defaults to true.
 	WithForeignKeys = schema.WithForeignKeys
+	// WithSQLiteTableRecreate enables modifying and dropping columns in the
+	// legacy SQLite migration, by recreating the modified tables. This defaults to false.
+	WithSQLiteTableRecreate = schema.WithSQLiteTableRecreate
+	// WithMigrationLock enables acquiring a database lock for the duration of
+	// the migration, to prevent multiple instances of the application from
+	// migrating the database at the same time. This defaults to false.
+	WithMigrationLock = schema.WithMigrationLock
 )
 
 // Schema is the API for creating, migrating and dropping a schema.
@@ -131,6 +131,8 @@ var (
 		NoSchemaChanges,
 		Tx,
 		Lock,
+		Recursive,
+		SetOps,
 		Indexes,
 		Types,
 		Clone,
@@ -162,6 +164,13 @@ var (
 		NamedEagerLoading,
 		Mutation,
 		CreateBulk,
+		UpdateMany,
+		Export,
+		Fixtures,
+		EntViz,
+		QuickGen,
+		Feed,
+		Filter,
 		ConstraintChecks,
 		NillableRequired,
 		ExtValueScan,
@@ -313,6 +322,41 @@ func Sanity(t *testing.T, client *ent.Client) {
 		require.False(client.Pet.Query().Where(pet.NameEqualFold("%A_\\")).ExistX(ctx))
 		require.False(client.Pet.Query().Where(pet.NameEqualFold("A_\\%")).ExistX(ctx))
 		require.False(client.Pet.Query().Where(pet.NameEqualFold("A%")).ExistX(ctx))
+		require.True(client.Pet.Query().Where(pet.NameHasPrefixFold("A_")).ExistX(ctx))
+		require.False(client.Pet.Query().Where(pet.NameHasPrefixFold("A%")).ExistX(ctx))
+		require.False(client.Pet.Query().Where(pet.NameHasPrefixFold("_\\")).ExistX(ctx))
+	})
+
+	t.Run("OrderFold", func(t *testing.T) {
+		client.Pet.Delete().ExecX(ctx)
+		client.Pet.CreateBulk(
+			client.Pet.Create().SetName("c"),
+			client.Pet.Create().SetName("B"),
+			client.Pet.Create().SetName("a"),
+		).ExecX(ctx)
+		names := client.Pet.Query().Order(pet.ByName(sql.OrderFold())).Select(pet.FieldName).StringsX(ctx)
+		require.Equal([]string{"a", "B", "c"}, names)
+		names = client.Pet.Query().Order(pet.ByName(sql.OrderFold(), sql.OrderDesc())).Select(pet.FieldName).StringsX(ctx)
+		require.Equal([]string{"c", "B", "a"}, names)
+	})
+	t.Run("OrderExpr", func(t *testing.T) {
+		client.Pet.Delete().ExecX(ctx)
+		client.Pet.CreateBulk(
+			client.Pet.Create().SetName("bb"),
+			client.Pet.Create().SetName("ccc"),
+			client.Pet.Create().SetName("a"),
+		).ExecX(ctx)
+		names := client.Pet.Query().Order(ent.AscExpr(sql.Expr("LENGTH(name)"))).Select(pet.FieldName).StringsX(ctx)
+		require.Equal([]string{"a", "bb", "ccc"}, names)
+		names = client.Pet.Query().Order(ent.DescExpr(sql.Expr("LENGTH(name)"))).Select(pet.FieldName).StringsX(ctx)
+		require.Equal([]string{"ccc", "bb", "a"}, names)
+		names = client.Pet.Query().
+			Order(sql.OrderByExpr(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("LENGTH(").Ident(pet.FieldName).WriteString(") = ").Arg(2)
+			}), sql.OrderDesc()).ToFunc(), ent.Asc(pet.FieldName)).
+			Select(pet.FieldName).
+			StringsX(ctx)
+		require.Equal([]string{"bb", "a", "ccc"}, names)
 	})
 }
 
@@ -381,6 +425,14 @@ func Upsert(t *testing.T, client *ent.Client) {
 	require.Equal(t, "A", users[0].Name)
 	require.Equal(t, "1111", users[1].Phone)
 	require.Equal(t, "B", users[1].Name)
+	client.User.CreateBulk(client.User.Create().SetName("C").SetAge(2).SetPhone("0000")).
+		OnConflictColumns(user.FieldPhone).
+		UpdateNewValues().
+		IgnoreName().
+		ExecX(ctx)
+	u = client.User.Query().Where(user.Phone("0000")).OnlyX(ctx)
+	require.Equal(t, "A", u.Name, "name was ignored by the UPDATE clause")
+	require.Equal(t, 2, u.Age, "age was modified by the UPDATE clause")
 
 	// Setting primary key manually.
 	a := client.Item.Create().SetID("A").SaveX(ctx)
@@ -540,6 +592,76 @@ func Paging(t *testing.T, client *ent.Client) {
 	}
 }
 
+func Recursive(t *testing.T, client *ent.Client) {
+	skip(t, "MySQL/5")
+	ctx := context.Background()
+	root := client.User.Create().SetName("root").SetAge(1).SaveX(ctx)
+	a := client.User.Create().SetName("a").SetAge(1).SetParent(root).SaveX(ctx)
+	b := client.User.Create().SetName("b").SetAge(1).SetParent(root).SaveX(ctx)
+	a1 := client.User.Create().SetName("a1").SetAge(1).SetParent(a).SaveX(ctx)
+	a11 := client.User.Create().SetName("a11").SetAge(1).SetParent(a1).SaveX(ctx)
+
+	names := client.User.Query().Where(user.ID(root.ID)).QueryChildrenRecursive().Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a", "a1", "a11", "b"}, names)
+	names = client.User.Query().Where(user.ID(a11.ID)).QueryParentRecursive().Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a", "a1", "root"}, names)
+	require.Equal(t, 2, client.User.Query().Where(user.IDIn(a.ID, b.ID)).QueryChildrenRecursive().CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(b.ID)).QueryChildrenRecursive().CountX(ctx))
+	names = client.User.Query().Where(user.ID(a11.ID)).QueryParentRecursive().QueryChildren().Where(user.Not(user.HasChildren())).Select(user.FieldName).StringsX(ctx)
+	require.ElementsMatch(t, []string{"a11", "b"}, names)
+
+	// Cycles are visited only once.
+	x := client.User.Create().SetName("x").SetAge(1).SaveX(ctx)
+	y := client.User.Create().SetName("y").SetAge(1).AddFollowers(x).SaveX(ctx)
+	client.User.UpdateOne(x).AddFollowers(y).ExecX(ctx)
+	ids := client.User.Query().Where(user.ID(x.ID)).QueryFollowingRecursive().IDsX(ctx)
+	require.ElementsMatch(t, []int{x.ID, y.ID}, ids)
+}
+
+func SetOps(t *testing.T, client *ent.Client) {
+	skip(t, "MySQL/5")
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		client.User.Create().SetName(fmt.Sprintf("u%d", i)).SetAge(i * 10).ExecX(ctx)
+	}
+	names := client.User.Query().
+		Where(user.AgeLT(20)).
+		Union(
+			client.User.Query().Where(user.AgeGT(40)),
+			client.User.Query().Where(user.AgeLT(20)),
+		).
+		Order(ent.Asc(user.FieldName)).
+		Select(user.FieldName).
+		StringsX(ctx)
+	require.Equal(t, []string{"u1", "u5"}, names)
+	require.Equal(t, 2, client.User.Query().Where(user.AgeLT(20)).Union(client.User.Query().Where(user.AgeGT(40))).CountX(ctx))
+	require.Equal(t, 3, client.User.Query().Where(user.AgeLT(20)).UnionAll(client.User.Query().Where(user.AgeGT(40)), client.User.Query().Where(user.AgeLT(20))).CountX(ctx))
+	// Operands with their own ORDER BY and LIMIT.
+	names = client.User.Query().
+		Where(user.AgeLT(20)).
+		Union(client.User.Query().Order(ent.Desc(user.FieldAge)).Limit(2)).
+		Order(ent.Desc(user.FieldName)).
+		Limit(2).
+		Select(user.FieldName).
+		StringsX(ctx)
+	require.Equal(t, []string{"u5", "u4"}, names)
+	if !strings.Contains(t.Name(), "MySQL") {
+		names = client.User.Query().
+			Where(user.AgeGT(20)).
+			Intersect(client.User.Query().Where(user.AgeLT(40))).
+			Select(user.FieldName).
+			StringsX(ctx)
+		require.Equal(t, []string{"u3"}, names)
+		names = client.User.Query().
+			Where(user.AgeGT(20)).
+			Except(client.User.Query().Where(user.AgeLT(40))).
+			Order(ent.Asc(user.FieldName)).
+			Select(user.FieldName).
+			StringsX(ctx)
+		require.Equal(t, []string{"u4", "u5"}, names)
+	}
+}
+
 func Select(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -779,6 +901,17 @@ func Select(t *testing.T, client *ent.Client) {
 		}).
 		ExecX(ctx)
 	require.True(allUpper(), "at names must be upper-cased")
+	// Execute multiple-table update modifier.
+	require.NotZero(client.Pet.Query().Where(pet.HasOwner(), pet.Trained(false)).CountX(ctx))
+	client.Pet.Update().
+		Modify(func(u *sql.UpdateBuilder) {
+			t := sql.Table(user.Table)
+			u.Join(t).
+				On(sql.Table(pet.Table).C(pet.OwnerColumn), t.C(user.FieldID)).
+				Set(pet.FieldTrained, true)
+		}).
+		ExecX(ctx)
+	require.Zero(client.Pet.Query().Where(pet.HasOwner(), pet.Trained(false)).CountX(ctx))
 
 	// Select and scan dynamic values.
 	const as = "name_length"
@@ -796,6 +929,33 @@ func Select(t *testing.T, client *ent.Client) {
 	// Order by random value should compile a valid query.
 	_, err = client.User.Query().Order(sql.OrderByRand()).All(ctx)
 	require.NoError(err)
+
+	// Delete modifiers.
+	n = client.Pet.Query().CountX(ctx)
+	require.NotZero(n)
+	affected := client.Pet.Delete().
+		Modify(func(d *sql.DeleteBuilder) {
+			d.Where(sql.EQ(pet.FieldName, "unknown"))
+		}).
+		ExecX(ctx)
+	require.Zero(affected)
+	require.Equal(n, client.Pet.Query().CountX(ctx))
+	err = client.Pet.DeleteOne(pets[0]).
+		Modify(func(d *sql.DeleteBuilder) {
+			d.Where(sql.EQ(pet.FieldName, "unknown"))
+		}).
+		Exec(ctx)
+	require.True(ent.IsNotFound(err))
+
+	// Optimizer and index hints.
+	hinted := client.Pet.Query().
+		Where(pet.Name(pets[0].Name)).
+		Modify(func(s *sql.Selector) {
+			s.Hint(sql.OptimizerHint("NO_ICP(pet)"), sql.ForceIndex("pet_name_user_pets"))
+		}).
+		AllX(ctx)
+	require.NotEmpty(hinted)
+	require.Equal(pets[0].Name, hinted[0].Name)
 }
 
 func Aggregate(t *testing.T, client *ent.Client) {
@@ -849,6 +1009,34 @@ func Aggregate(t *testing.T, client *ent.Client) {
 	require.Equal(t, 10, vs2[0].Max)
 	require.Equal(t, 10, vs2[0].Count)
 	require.Equal(t, 5.5, vs2[0].Avg)
+
+	// Dialect-specific aggregation functions.
+	var vs3 []struct {
+		Variance      float64
+		Names, Ages   string
+		Trained, Both bool
+	}
+	client.Pet.Query().
+		Where(pet.AgeLTE(3)).
+		Aggregate(
+			ent.As(ent.Variance(pet.FieldAge), "variance"),
+			ent.As(ent.GroupConcat(pet.FieldName, ","), "names"),
+			ent.As(ent.ArrayAgg(pet.FieldAge), "ages"),
+			ent.As(ent.BoolOr(pet.FieldTrained), "trained"),
+			ent.As(ent.BoolAnd(pet.FieldTrained), "both"),
+		).
+		ScanX(ctx, &vs3)
+	require.Len(t, vs3, 1)
+	require.InDelta(t, 2.0/3, vs3[0].Variance, 0.0001)
+	names := strings.Split(vs3[0].Names, ",")
+	sort.Strings(names)
+	require.Equal(t, []string{"pet1", "pet2", "pet3"}, names)
+	var ages []float64
+	require.NoError(t, json.Unmarshal([]byte(vs3[0].Ages), &ages))
+	sort.Float64s(ages)
+	require.Equal(t, []float64{1, 2, 3}, ages)
+	require.False(t, vs3[0].Trained)
+	require.False(t, vs3[0].Both)
 }
 
 func ExecQuery(t *testing.T, client *ent.Client) {
@@ -869,6 +1057,23 @@ func ExecQuery(t *testing.T, client *ent.Client) {
 	require.NoError(rows.Close())
 	require.Equal(1, count)
 	require.NoError(tx.Commit())
+
+	tasks, err := client.Task.QueryRaw(ctx, "SELECT *, 1 AS one FROM "+enttask.Table)
+	require.NoError(err)
+	require.Len(tasks, 1)
+	require.Equal(client.Task.Query().OnlyIDX(ctx), tasks[0].ID)
+	one, err := tasks[0].Value("one")
+	require.NoError(err)
+	require.EqualValues(1, one)
+	require.NotNil(tasks[0].Update(), "raw entities should be bound to the client")
+
+	// Operations that exceed their deadline fail with a timeout error.
+	drv := sql.NewTimeoutDriver(client.Driver(), sql.WithTimeout(dialect.OpQuery, time.Minute))
+	dctx, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = ent.NewClient(ent.Driver(drv)).Task.Query().All(dctx)
+	require.True(ent.IsTimeoutError(err))
+	require.False(ent.IsTimeoutError(context.Canceled))
 }
 
 func NillableRequired(t *testing.T, client *ent.Client) {
@@ -976,6 +1181,9 @@ func Predicate(t *testing.T, client *ent.Client) {
 	require.Equal(lab.ID, client.Group.Query().Where(group.Active(false)).OnlyIDX(ctx))
 	require.Equal(hub.ID, client.Group.Query().Where(group.ActiveNEQ(false)).OnlyIDX(ctx))
 	require.Equal(lab.ID, client.Group.Query().Where(group.ActiveNEQ(true)).OnlyIDX(ctx))
+	lab.Update().SetExpire(time.Now().Add(-48 * time.Hour)).ExecX(ctx)
+	require.Equal(hub.ID, client.Group.Query().Where(group.ExpireWithinLast(24*time.Hour)).OnlyIDX(ctx))
+	require.Equal(2, client.Group.Query().Where(group.ExpireWithinLast(72*time.Hour)).CountX(ctx))
 }
 
 func AddValues(t *testing.T, client *ent.Client) {
@@ -1320,6 +1528,19 @@ func Relation(t *testing.T, client *ent.Client) {
 		require.Equal(2, v2[i].Total)
 	}
 
+	t.Log("group by with having")
+	names = client.User.Query().
+		GroupBy(user.FieldName).
+		Having(sql.GT(sql.Count(sql.Distinct(user.FieldAge)), 1)).
+		StringsX(ctx)
+	require.Equal([]string{child.Name}, names)
+	names = client.User.Query().
+		GroupBy(user.FieldName).
+		Having(sql.GT(sql.Count("*"), 1), sql.GT(sql.Max(user.FieldAge), child.Age+1)).
+		StringsX(ctx)
+	sort.Strings(names)
+	require.Equal([]string{usr.Name, neta.Name}, names)
+
 	t.Log("group by a relation")
 	foo := client.User.Create().SetName("foo").SetAge(10).AddPets(
 		client.Pet.Create().SetName("a").SetAge(10).SaveX(ctx),
@@ -1503,6 +1724,8 @@ func UniqueConstraint(t *testing.T, client *ent.Client) {
 	foo := client.User.Create().SetAge(1).SetName("foo").SetNickname("baz").SaveX(ctx)
 	_, err := client.User.Create().SetAge(1).SetName("bar").SetNickname("baz").Save(ctx)
 	require.True(ent.IsConstraintError(err))
+	require.True(ent.IsUniqueConstraintError(err))
+	require.False(ent.IsForeignKeyConstraintError(err))
 	bar := client.User.Create().SetAge(1).SetName("bar").SetNickname("bar").SetPhone("1").SaveX(ctx)
 
 	t.Log("unique constraint violation on 2 fields")
@@ -1668,6 +1891,56 @@ func Tx(t *testing.T, client *ent.Client) {
 		require.Error(t, err, "expect creation to fail in read-only tx")
 		require.NoError(t, tx.Rollback())
 	})
+	t.Run("AfterCommit", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var calls []string
+		tx.AfterCommit(func() { calls = append(calls, "commit") })
+		tx.AfterRollback(func(error) { calls = append(calls, "rollback") })
+		tx.Item.Create().ExecX(ctx)
+		require.Empty(t, calls, "functions are called only after the transaction is completed")
+		require.NoError(t, tx.Commit())
+		require.Equal(t, []string{"commit"}, calls)
+	})
+	t.Run("AfterRollback", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var calls []string
+		tx.AfterCommit(func() { calls = append(calls, "commit") })
+		tx.AfterRollback(func(err error) {
+			require.NoError(t, err)
+			calls = append(calls, "rollback")
+		})
+		require.NoError(t, tx.Rollback())
+		require.Equal(t, []string{"rollback"}, calls)
+		require.Error(t, tx.Rollback())
+		require.Equal(t, []string{"rollback"}, calls, "functions are called once")
+	})
+	t.Run("AfterCommit Skipped", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var calls []string
+		tx.AfterCommit(func() { calls = append(calls, "commit") })
+		tx.AfterRollback(func(error) { calls = append(calls, "rollback") })
+		tx.OnCommit(func(ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(context.Context, *ent.Tx) error {
+				return errors.New("commit rejected")
+			})
+		})
+		require.EqualError(t, tx.Commit(), "commit rejected")
+		require.Empty(t, calls, "the transaction is still open")
+		require.NoError(t, tx.Rollback())
+		require.Equal(t, []string{"rollback"}, calls)
+	})
+	t.Run("TxOptions Deferrable", func(t *testing.T) {
+		tx, err := client.BeginTx(sql.WithTxDeferrable(ctx), &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true})
+		if strings.HasPrefix(t.Name(), "TestPostgres") {
+			require.NoError(t, err)
+			require.NoError(t, tx.Rollback())
+		} else {
+			require.Error(t, err, "deferrable transactions are supported only by PostgreSQL")
+		}
+	})
 	t.Run("TxOptions Commit", func(t *testing.T) {
 		skip(t, "SQLite")
 		tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: stdsql.LevelReadCommitted})
@@ -1753,6 +2026,20 @@ func Sensitive(t *testing.T, client *ent.Client) {
 	b, err := json.Marshal(usr)
 	require.NoError(err)
 	require.NotContains(string(b), "secret-password")
+
+	// Sensitive values are redacted from the debug logs.
+	var logs []string
+	debug := ent.NewClient(ent.Driver(client.Driver()), ent.Debug(), ent.Log(func(v ...any) {
+		logs = append(logs, fmt.Sprint(v...))
+	}))
+	debug.User.Create().SetName("bar").SetAge(30).SetPassword("secret-password").ExecX(ctx)
+	debug.User.Update().Where(user.Password("secret-password")).SetPassword("new-password").ExecX(ctx)
+	require.Len(logs, 2)
+	for _, l := range logs {
+		require.NotContains(l, "secret-password")
+		require.NotContains(l, "new-password")
+		require.Contains(l, "<sensitive>")
+	}
 }
 
 func EagerLoading(t *testing.T, client *ent.Client) {
@@ -2016,6 +2303,62 @@ func EagerLoading(t *testing.T, client *ent.Client) {
 		require.Len(users[2].Edges.Groups, 1)
 		require.Equal(users[2].Edges.Groups[0].Name, "BitBucket")
 	})
+
+	t.Run("Chunks", func(t *testing.T) {
+		// Eager-loading edges of more nodes than the bind parameters limit
+		// of the database is executed in chunks of separate queries.
+		const n = 1000
+		for i := 0; i < n; i += 50 {
+			users := make([]*ent.UserCreate, 50)
+			for j := range users {
+				users[j] = client.User.Create().SetName(fmt.Sprintf("chunk-%d", i+j)).SetAge(1)
+			}
+			owners := client.User.CreateBulk(users...).SaveX(ctx)
+			pets := make([]*ent.PetCreate, 50)
+			for j := range pets {
+				pets[j] = client.Pet.Create().SetName(fmt.Sprintf("chunk-%d", i+j)).SetOwner(owners[j])
+			}
+			client.Pet.CreateBulk(pets...).ExecX(ctx)
+		}
+		// count counts the executed queries, and sets the expected number of chunks by the dialect.
+		var calls, chunks int
+		count := func(s *sql.Selector) {
+			calls++
+			chunks = 1
+			if size := sqlgraph.MaxInValues(s.Dialect()); size > 0 {
+				chunks = (n + size - 1) / size
+			}
+		}
+		// The IN list holds the distinct foreign-keys (owners) of the pets.
+		pets := client.Pet.Query().
+			Where(pet.NameHasPrefix("chunk-")).
+			WithOwner(func(q *ent.UserQuery) {
+				q.Where(user.AgeGT(0)).Modify(count)
+			}).
+			AllX(ctx)
+		require.Len(pets, n)
+		require.Equal(chunks, calls)
+		for _, p := range pets {
+			require.NotNil(p.Edges.Owner)
+			require.Equal(p.Name, p.Edges.Owner.Name)
+		}
+		// The IN list holds the identifiers of the users.
+		calls = 0
+		users := client.User.Query().
+			Where(user.NameHasPrefix("chunk-")).
+			WithPets(func(q *ent.PetQuery) {
+				q.Modify(count)
+			}).
+			AllX(ctx)
+		require.Len(users, n)
+		require.Equal(chunks, calls)
+		for _, u := range users {
+			require.Len(u.Edges.Pets, 1)
+			require.Equal(u.Name, u.Edges.Pets[0].Name)
+		}
+		client.Pet.Delete().Where(pet.NameHasPrefix("chunk-")).ExecX(ctx)
+		client.User.Delete().Where(user.NameHasPrefix("chunk-")).ExecX(ctx)
+	})
 }
 
 func limitRows(partitionBy string, limit int, orderBy ...string) func(s *sql.Selector) {
@@ -2256,6 +2599,69 @@ func CreateBulk(t *testing.T, client *ent.Client) {
 	require.Equal(t, users[1].ID, pets[1].QueryOwner().OnlyIDX(ctx))
 	require.Equal(t, "layla", pets[2].Name)
 	require.False(t, pets[2].QueryOwner().ExistX(ctx))
+
+	names := []string{"a", "b", "c"}
+	pets = client.Pet.MapCreateBulk(names, func(c *ent.PetCreate, i int) {
+		c.SetName(names[i]).SetOwner(users[i%2])
+	}).SaveX(ctx)
+	require.Len(t, pets, 3)
+	for i, p := range pets {
+		require.Equal(t, names[i], p.Name)
+		require.Equal(t, users[i%2].ID, p.QueryOwner().OnlyIDX(ctx))
+	}
+	_, err := client.Pet.MapCreateBulk(names[0], func(*ent.PetCreate, int) {}).Save(ctx)
+	require.EqualError(t, err, "calling to PetClient.MapCreateBulk with wrong type string, need slice")
+}
+
+func UpdateMany(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	pets := client.Pet.CreateBulk(
+		client.Pet.Create().SetName("a"),
+		client.Pet.Create().SetName("b"),
+		client.Pet.Create().SetName("c"),
+		client.Pet.Create().SetName("d"),
+	).SaveX(ctx)
+	ids := make([]int, len(pets))
+	for i := range pets {
+		ids[i] = pets[i].ID
+	}
+
+	// Same changes are applied by a single bulk update.
+	client.Pet.UpdateMany(ids[:3], func(u *ent.PetUpdateOne) {
+		u.SetTrained(true).SetOwner(a8m)
+	}).ExecX(ctx)
+	require.Equal(t, 3, client.Pet.Query().Where(pet.Trained(true), pet.HasOwnerWith(user.ID(a8m.ID))).CountX(ctx))
+	require.False(t, client.Pet.GetX(ctx, ids[3]).Trained)
+
+	// Distinct changes are applied on their entities.
+	client.Pet.UpdateMany(ids, func(u *ent.PetUpdateOne) {
+		id, _ := u.Mutation().ID()
+		if id == ids[0] || id == ids[3] {
+			u.SetAge(1)
+		} else {
+			u.SetAge(float64(id))
+		}
+	}).ExecX(ctx)
+	for i, p := range client.Pet.Query().Order(ent.Asc(pet.FieldID)).AllX(ctx) {
+		switch i {
+		case 0, 3:
+			require.Equal(t, float64(1), p.Age)
+		default:
+			require.Equal(t, float64(p.ID), p.Age)
+		}
+	}
+
+	// Entities that do not exist are skipped.
+	client.Pet.DeleteOneID(ids[0]).ExecX(ctx)
+	client.Pet.UpdateMany(ids[:2], func(u *ent.PetUpdateOne) {
+		u.SetTrained(false)
+	}).ExecX(ctx)
+	client.Pet.UpdateMany(ids[:1], func(u *ent.PetUpdateOne) {
+		u.SetTrained(false)
+	}).ExecX(ctx)
+	require.False(t, client.Pet.GetX(ctx, ids[1]).Trained)
+	require.True(t, client.Pet.GetX(ctx, ids[2]).Trained)
 }
 
 func ConstraintChecks(t *testing.T, client *ent.Client) {
@@ -2476,6 +2882,19 @@ func OrderByFluent(t *testing.T, client *ent.Client) {
 		require.Equal(t, []int{users[0].ID, users[1].ID, users[2].ID, users[4].ID, users[3].ID}, ids)
 	})
 
+	t.Run("O2M/OrderField", func(t *testing.T) {
+		byCount, err := user.OrderByField("pets_count", sql.OrderDesc())
+		require.NoError(t, err)
+		byID, err := user.OrderByField(user.OrderFieldID, sql.OrderDesc())
+		require.NoError(t, err)
+		ids := client.User.Query().Order(byCount, byID).IDsX(ctx)
+		require.Equal(t, []int{users[0].ID, users[1].ID, users[2].ID, users[4].ID, users[3].ID}, ids)
+		_, err = user.OrderByField("pets_count; DROP TABLE users")
+		require.EqualError(t, err, `user: invalid order field "pets_count; DROP TABLE users"`)
+		_, err = user.OrderByField("password")
+		require.Error(t, err, "sensitive fields cannot be ordered by")
+	})
+
 	t.Run("O2M/SelectedCount", func(t *testing.T) {
 		const as = "pets_count"
 		ordered := client.User.Query().
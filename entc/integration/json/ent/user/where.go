@@ -8,6 +8,7 @@ package user
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/json/ent/predicate"
 )
 
@@ -176,6 +177,318 @@ func UnknownNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldUnknown))
 }
 
+// THasKey applies the HasKey predicate on the "t" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func THasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldT), sqljson.Path(path...)))
+	})
+}
+
+// TValueEQ checks that the value at the given path of the
+// "t" JSON field is equal to v.
+func TValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path(path...)))
+	})
+}
+
+// TContains checks that the value at the given path of the "t"
+// JSON field contains v. e.g. an array that contains the element v.
+func TContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldT), v, sqljson.Path(path...)))
+	})
+}
+
+// URLHasKey applies the HasKey predicate on the "url" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func URLHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURL), sqljson.Path(path...)))
+	})
+}
+
+// URLValueEQ checks that the value at the given path of the
+// "url" JSON field is equal to v.
+func URLValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path(path...)))
+	})
+}
+
+// URLContains checks that the value at the given path of the "url"
+// JSON field contains v. e.g. an array that contains the element v.
+func URLContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldURL), v, sqljson.Path(path...)))
+	})
+}
+
+// URLsHasKey applies the HasKey predicate on the "URLs" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func URLsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldURLs), sqljson.Path(path...)))
+	})
+}
+
+// URLsValueEQ checks that the value at the given path of the
+// "URLs" JSON field is equal to v.
+func URLsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURLs), v, sqljson.Path(path...)))
+	})
+}
+
+// URLsContains checks that the value at the given path of the "URLs"
+// JSON field contains v. e.g. an array that contains the element v.
+func URLsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldURLs), v, sqljson.Path(path...)))
+	})
+}
+
+// RawHasKey applies the HasKey predicate on the "raw" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func RawHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldRaw), sqljson.Path(path...)))
+	})
+}
+
+// RawValueEQ checks that the value at the given path of the
+// "raw" JSON field is equal to v.
+func RawValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldRaw), v, sqljson.Path(path...)))
+	})
+}
+
+// RawContains checks that the value at the given path of the "raw"
+// JSON field contains v. e.g. an array that contains the element v.
+func RawContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldRaw), v, sqljson.Path(path...)))
+	})
+}
+
+// DirsHasKey applies the HasKey predicate on the "dirs" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func DirsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldDirs), sqljson.Path(path...)))
+	})
+}
+
+// DirsValueEQ checks that the value at the given path of the
+// "dirs" JSON field is equal to v.
+func DirsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldDirs), v, sqljson.Path(path...)))
+	})
+}
+
+// DirsContains checks that the value at the given path of the "dirs"
+// JSON field contains v. e.g. an array that contains the element v.
+func DirsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldDirs), v, sqljson.Path(path...)))
+	})
+}
+
+// IntsHasKey applies the HasKey predicate on the "ints" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func IntsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldInts), sqljson.Path(path...)))
+	})
+}
+
+// IntsValueEQ checks that the value at the given path of the
+// "ints" JSON field is equal to v.
+func IntsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldInts), v, sqljson.Path(path...)))
+	})
+}
+
+// IntsContains checks that the value at the given path of the "ints"
+// JSON field contains v. e.g. an array that contains the element v.
+func IntsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldInts), v, sqljson.Path(path...)))
+	})
+}
+
+// FloatsHasKey applies the HasKey predicate on the "floats" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func FloatsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldFloats), sqljson.Path(path...)))
+	})
+}
+
+// FloatsValueEQ checks that the value at the given path of the
+// "floats" JSON field is equal to v.
+func FloatsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldFloats), v, sqljson.Path(path...)))
+	})
+}
+
+// FloatsContains checks that the value at the given path of the "floats"
+// JSON field contains v. e.g. an array that contains the element v.
+func FloatsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldFloats), v, sqljson.Path(path...)))
+	})
+}
+
+// StringsHasKey applies the HasKey predicate on the "strings" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func StringsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldStrings), sqljson.Path(path...)))
+	})
+}
+
+// StringsValueEQ checks that the value at the given path of the
+// "strings" JSON field is equal to v.
+func StringsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldStrings), v, sqljson.Path(path...)))
+	})
+}
+
+// StringsContains checks that the value at the given path of the "strings"
+// JSON field contains v. e.g. an array that contains the element v.
+func StringsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldStrings), v, sqljson.Path(path...)))
+	})
+}
+
+// IntsValidateHasKey applies the HasKey predicate on the "ints_validate" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func IntsValidateHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldIntsValidate), sqljson.Path(path...)))
+	})
+}
+
+// IntsValidateValueEQ checks that the value at the given path of the
+// "ints_validate" JSON field is equal to v.
+func IntsValidateValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldIntsValidate), v, sqljson.Path(path...)))
+	})
+}
+
+// IntsValidateContains checks that the value at the given path of the "ints_validate"
+// JSON field contains v. e.g. an array that contains the element v.
+func IntsValidateContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldIntsValidate), v, sqljson.Path(path...)))
+	})
+}
+
+// FloatsValidateHasKey applies the HasKey predicate on the "floats_validate" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func FloatsValidateHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldFloatsValidate), sqljson.Path(path...)))
+	})
+}
+
+// FloatsValidateValueEQ checks that the value at the given path of the
+// "floats_validate" JSON field is equal to v.
+func FloatsValidateValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldFloatsValidate), v, sqljson.Path(path...)))
+	})
+}
+
+// FloatsValidateContains checks that the value at the given path of the "floats_validate"
+// JSON field contains v. e.g. an array that contains the element v.
+func FloatsValidateContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldFloatsValidate), v, sqljson.Path(path...)))
+	})
+}
+
+// StringsValidateHasKey applies the HasKey predicate on the "strings_validate" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func StringsValidateHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldStringsValidate), sqljson.Path(path...)))
+	})
+}
+
+// StringsValidateValueEQ checks that the value at the given path of the
+// "strings_validate" JSON field is equal to v.
+func StringsValidateValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldStringsValidate), v, sqljson.Path(path...)))
+	})
+}
+
+// StringsValidateContains checks that the value at the given path of the "strings_validate"
+// JSON field contains v. e.g. an array that contains the element v.
+func StringsValidateContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldStringsValidate), v, sqljson.Path(path...)))
+	})
+}
+
+// AddrHasKey applies the HasKey predicate on the "addr" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func AddrHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldAddr), sqljson.Path(path...)))
+	})
+}
+
+// AddrValueEQ checks that the value at the given path of the
+// "addr" JSON field is equal to v.
+func AddrValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldAddr), v, sqljson.Path(path...)))
+	})
+}
+
+// AddrContains checks that the value at the given path of the "addr"
+// JSON field contains v. e.g. an array that contains the element v.
+func AddrContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldAddr), v, sqljson.Path(path...)))
+	})
+}
+
+// UnknownHasKey applies the HasKey predicate on the "unknown" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func UnknownHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldUnknown), sqljson.Path(path...)))
+	})
+}
+
+// UnknownValueEQ checks that the value at the given path of the
+// "unknown" JSON field is equal to v.
+func UnknownValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldUnknown), v, sqljson.Path(path...)))
+	})
+}
+
+// UnknownContains checks that the value at the given path of the "unknown"
+// JSON field contains v. e.g. an array that contains the element v.
+func UnknownContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldUnknown), v, sqljson.Path(path...)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -160,25 +160,26 @@ func (uc *UserCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := uc.mutation.Dirs(); !ok {
-		return &ValidationError{Name: "dirs", err: errors.New(`ent: missing required field "User.dirs"`)}
+		errs = append(errs, &ValidationError{Name: "dirs", Entity: "User", Field: "dirs", Reason: "missing required field", err: errors.New(`ent: missing required field "User.dirs"`)})
 	}
 	if v, ok := uc.mutation.IntsValidate(); ok {
 		if err := user.IntsValidateValidator(v); err != nil {
-			return &ValidationError{Name: "ints_validate", err: fmt.Errorf(`ent: validator failed for field "User.ints_validate": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "ints_validate", Entity: "User", Field: "ints_validate", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.ints_validate": %w`, err)})
 		}
 	}
 	if v, ok := uc.mutation.FloatsValidate(); ok {
 		if err := user.FloatsValidateValidator(v); err != nil {
-			return &ValidationError{Name: "floats_validate", err: fmt.Errorf(`ent: validator failed for field "User.floats_validate": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "floats_validate", Entity: "User", Field: "floats_validate", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.floats_validate": %w`, err)})
 		}
 	}
 	if v, ok := uc.mutation.StringsValidate(); ok {
 		if err := user.StringsValidateValidator(v); err != nil {
-			return &ValidationError{Name: "strings_validate", err: fmt.Errorf(`ent: validator failed for field "User.strings_validate": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "strings_validate", Entity: "User", Field: "strings_validate", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "User.strings_validate": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -188,7 +189,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	_node, _spec := uc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -262,11 +263,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
+	err      error
 	builders []*UserCreate
 }
 
 // Save creates the User entities in the database.
 func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
@@ -292,7 +297,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
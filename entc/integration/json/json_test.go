@@ -671,6 +671,22 @@ func Predicates(t *testing.T, client *ent.Client) {
 			OnlyX(ctx)
 		require.True(t, u1.T.B)
 	})
+
+	t.Run("Generated", func(t *testing.T) {
+		client.User.Delete().ExecX(ctx)
+		client.User.CreateBulk(
+			client.User.Create().SetT(&schema.T{S: "a", Ls: []string{"x", "y"}, T: &schema.T{I: 1}}),
+			client.User.Create().SetT(&schema.T{S: "b", Ls: []string{"y"}}),
+			client.User.Create(),
+		).ExecX(ctx)
+		require.Equal(t, 1, client.User.Query().Where(user.THasKey("t", "i")).CountX(ctx))
+		require.Equal(t, 2, client.User.Query().Where(user.THasKey("s")).CountX(ctx))
+		require.Equal(t, "a", client.User.Query().Where(user.TValueEQ("a", "s")).OnlyX(ctx).T.S)
+		require.Equal(t, "a", client.User.Query().Where(user.TValueEQ(1, "t", "i")).OnlyX(ctx).T.S)
+		require.Equal(t, 2, client.User.Query().Where(user.TContains("y", "ls")).CountX(ctx))
+		require.Equal(t, "a", client.User.Query().Where(user.TContains("x", "ls")).OnlyX(ctx).T.S)
+		require.Equal(t, 1, client.User.Query().Where(user.Not(user.THasKey("s"))).CountX(ctx))
+	})
 }
 
 func Order(t *testing.T, client *ent.Client) {
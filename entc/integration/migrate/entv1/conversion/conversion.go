@@ -7,6 +7,8 @@
 package conversion
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -113,3 +115,100 @@ func ByInt64ToString(opts ...sql.OrderTermOption) OrderOption {
 func ByUint64ToString(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUint64ToString, opts...).ToFunc()
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that Conversion queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Conversion queries can be ordered by.
+const (
+	OrderFieldID             OrderField = "id"
+	OrderFieldName           OrderField = "name"
+	OrderFieldInt8ToString   OrderField = "int8_to_string"
+	OrderFieldUint8ToString  OrderField = "uint8_to_string"
+	OrderFieldInt16ToString  OrderField = "int16_to_string"
+	OrderFieldUint16ToString OrderField = "uint16_to_string"
+	OrderFieldInt32ToString  OrderField = "int32_to_string"
+	OrderFieldUint32ToString OrderField = "uint32_to_string"
+	OrderFieldInt64ToString  OrderField = "int64_to_string"
+	OrderFieldUint64ToString OrderField = "uint64_to_string"
+)
+
+// OrderFields holds all fields and edge counts that Conversion queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldName,
+	OrderFieldInt8ToString,
+	OrderFieldUint8ToString,
+	OrderFieldInt16ToString,
+	OrderFieldUint16ToString,
+	OrderFieldInt32ToString,
+	OrderFieldUint32ToString,
+	OrderFieldInt64ToString,
+	OrderFieldUint64ToString,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Conversion queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("conversion: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := conversion.OrderByField(conversion.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Conversion.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldInt8ToString:
+		return ByInt8ToString(opts...), nil
+	case OrderFieldUint8ToString:
+		return ByUint8ToString(opts...), nil
+	case OrderFieldInt16ToString:
+		return ByInt16ToString(opts...), nil
+	case OrderFieldUint16ToString:
+		return ByUint16ToString(opts...), nil
+	case OrderFieldInt32ToString:
+		return ByInt32ToString(opts...), nil
+	case OrderFieldUint32ToString:
+		return ByUint32ToString(opts...), nil
+	case OrderFieldInt64ToString:
+		return ByInt64ToString(opts...), nil
+	case OrderFieldUint64ToString:
+		return ByUint64ToString(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
@@ -176,6 +176,11 @@ func NameContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // Int8ToStringEQ applies the EQ predicate on the "int8_to_string" field.
 func Int8ToStringEQ(v int8) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldInt8ToString, v))
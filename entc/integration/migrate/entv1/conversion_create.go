@@ -182,7 +182,8 @@ func (cc *ConversionCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *ConversionCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (cc *ConversionCreate) sqlSave(ctx context.Context) (*Conversion, error) {
@@ -192,7 +193,7 @@ func (cc *ConversionCreate) sqlSave(ctx context.Context) (*Conversion, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -250,11 +251,15 @@ func (cc *ConversionCreate) createSpec() (*Conversion, *sqlgraph.CreateSpec) {
 // ConversionCreateBulk is the builder for creating many Conversion entities in bulk.
 type ConversionCreateBulk struct {
 	config
+	err      error
 	builders []*ConversionCreate
 }
 
 // Save creates the Conversion entities in the database.
 func (ccb *ConversionCreateBulk) Save(ctx context.Context) ([]*Conversion, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Conversion, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -279,7 +284,7 @@ func (ccb *ConversionCreateBulk) Save(ctx context.Context) ([]*Conversion, error
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -136,6 +136,11 @@ func CustomContainsFold(v string) predicate.CustomType {
 	return predicate.CustomType(sql.FieldContainsFold(FieldCustom, v))
 }
 
+// CustomHasPrefixFold applies the HasPrefixFold predicate on the "custom" field.
+func CustomHasPrefixFold(v string) predicate.CustomType {
+	return predicate.CustomType(sql.FieldHasPrefixFold(FieldCustom, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CustomType) predicate.CustomType {
 	return predicate.CustomType(func(s *sql.Selector) {
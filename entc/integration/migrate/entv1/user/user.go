@@ -248,6 +248,116 @@ func ByCarField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCarStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "children_count") that User queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that User queries can be ordered by.
+const (
+	OrderFieldID            OrderField = "id"
+	OrderFieldAge           OrderField = "age"
+	OrderFieldName          OrderField = "name"
+	OrderFieldDescription   OrderField = "description"
+	OrderFieldNickname      OrderField = "nickname"
+	OrderFieldAddress       OrderField = "address"
+	OrderFieldRenamed       OrderField = "renamed"
+	OrderFieldOldToken      OrderField = "old_token"
+	OrderFieldState         OrderField = "state"
+	OrderFieldStatus        OrderField = "status"
+	OrderFieldWorkplace     OrderField = "workplace"
+	OrderFieldDropOptional  OrderField = "drop_optional"
+	OrderFieldChildrenCount OrderField = "children_count"
+)
+
+// OrderFields holds all fields and edge counts that User queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldAge,
+	OrderFieldName,
+	OrderFieldDescription,
+	OrderFieldNickname,
+	OrderFieldAddress,
+	OrderFieldRenamed,
+	OrderFieldOldToken,
+	OrderFieldState,
+	OrderFieldStatus,
+	OrderFieldWorkplace,
+	OrderFieldDropOptional,
+	OrderFieldChildrenCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if User queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("user: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := user.OrderByField(user.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.User.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldAge:
+		return ByAge(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldDescription:
+		return ByDescription(opts...), nil
+	case OrderFieldNickname:
+		return ByNickname(opts...), nil
+	case OrderFieldAddress:
+		return ByAddress(opts...), nil
+	case OrderFieldRenamed:
+		return ByRenamed(opts...), nil
+	case OrderFieldOldToken:
+		return ByOldToken(opts...), nil
+	case OrderFieldState:
+		return ByState(opts...), nil
+	case OrderFieldStatus:
+		return ByStatus(opts...), nil
+	case OrderFieldWorkplace:
+		return ByWorkplace(opts...), nil
+	case OrderFieldDropOptional:
+		return ByDropOptional(opts...), nil
+	case OrderFieldChildrenCount:
+		return ByChildrenCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
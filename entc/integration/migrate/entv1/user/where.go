@@ -217,6 +217,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDescription, v))
@@ -292,6 +297,11 @@ func DescriptionContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DescriptionHasPrefixFold applies the HasPrefixFold predicate on the "description" field.
+func DescriptionHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldDescription, v))
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNickname, v))
@@ -357,6 +367,11 @@ func NicknameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNickname, v))
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldNickname, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAddress, v))
@@ -432,6 +447,11 @@ func AddressContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAddress, v))
 }
 
+// AddressHasPrefixFold applies the HasPrefixFold predicate on the "address" field.
+func AddressHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldAddress, v))
+}
+
 // RenamedEQ applies the EQ predicate on the "renamed" field.
 func RenamedEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldRenamed, v))
@@ -507,6 +527,11 @@ func RenamedContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldRenamed, v))
 }
 
+// RenamedHasPrefixFold applies the HasPrefixFold predicate on the "renamed" field.
+func RenamedHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldRenamed, v))
+}
+
 // OldTokenEQ applies the EQ predicate on the "old_token" field.
 func OldTokenEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldOldToken, v))
@@ -572,6 +597,11 @@ func OldTokenContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldOldToken, v))
 }
 
+// OldTokenHasPrefixFold applies the HasPrefixFold predicate on the "old_token" field.
+func OldTokenHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldOldToken, v))
+}
+
 // BlobEQ applies the EQ predicate on the "blob" field.
 func BlobEQ(v []byte) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBlob, v))
@@ -727,6 +757,11 @@ func StatusContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// StatusHasPrefixFold applies the HasPrefixFold predicate on the "status" field.
+func StatusHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldStatus, v))
+}
+
 // WorkplaceEQ applies the EQ predicate on the "workplace" field.
 func WorkplaceEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldWorkplace, v))
@@ -802,6 +837,11 @@ func WorkplaceContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldWorkplace, v))
 }
 
+// WorkplaceHasPrefixFold applies the HasPrefixFold predicate on the "workplace" field.
+func WorkplaceHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldWorkplace, v))
+}
+
 // DropOptionalEQ applies the EQ predicate on the "drop_optional" field.
 func DropOptionalEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDropOptional, v))
@@ -877,6 +917,11 @@ func DropOptionalContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDropOptional, v))
 }
 
+// DropOptionalHasPrefixFold applies the HasPrefixFold predicate on the "drop_optional" field.
+func DropOptionalHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldDropOptional, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
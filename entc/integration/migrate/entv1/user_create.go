@@ -285,39 +285,40 @@ func (uc *UserCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`entv1: missing required field "User.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "User", Field: "age", Reason: "missing required field", err: errors.New(`entv1: missing required field "User.age"`)})
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`entv1: missing required field "User.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "User", Field: "name", Reason: "missing required field", err: errors.New(`entv1: missing required field "User.name"`)})
 	}
 	if v, ok := uc.mutation.Name(); ok {
 		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`entv1: validator failed for field "User.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "User", Field: "name", Reason: err.Error(), err: fmt.Errorf(`entv1: validator failed for field "User.name": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Nickname(); !ok {
-		return &ValidationError{Name: "nickname", err: errors.New(`entv1: missing required field "User.nickname"`)}
+		errs = append(errs, &ValidationError{Name: "nickname", Entity: "User", Field: "nickname", Reason: "missing required field", err: errors.New(`entv1: missing required field "User.nickname"`)})
 	}
 	if _, ok := uc.mutation.OldToken(); !ok {
-		return &ValidationError{Name: "old_token", err: errors.New(`entv1: missing required field "User.old_token"`)}
+		errs = append(errs, &ValidationError{Name: "old_token", Entity: "User", Field: "old_token", Reason: "missing required field", err: errors.New(`entv1: missing required field "User.old_token"`)})
 	}
 	if v, ok := uc.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv1: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "blob", Entity: "User", Field: "blob", Reason: err.Error(), err: fmt.Errorf(`entv1: validator failed for field "User.blob": %w`, err)})
 		}
 	}
 	if v, ok := uc.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv1: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "User", Field: "state", Reason: err.Error(), err: fmt.Errorf(`entv1: validator failed for field "User.state": %w`, err)})
 		}
 	}
 	if v, ok := uc.mutation.Workplace(); ok {
 		if err := user.WorkplaceValidator(v); err != nil {
-			return &ValidationError{Name: "workplace", err: fmt.Errorf(`entv1: validator failed for field "User.workplace": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "workplace", Entity: "User", Field: "workplace", Reason: err.Error(), err: fmt.Errorf(`entv1: validator failed for field "User.workplace": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -327,7 +328,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	_node, _spec := uc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -469,11 +470,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
+	err      error
 	builders []*UserCreate
 }
 
 // Save creates the User entities in the database.
 func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
@@ -499,7 +504,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
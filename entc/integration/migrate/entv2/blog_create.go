@@ -86,13 +86,14 @@ func (bc *BlogCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (bc *BlogCreate) check() error {
+	var errs ValidationErrors
 	switch bc.driver.Dialect() {
 	case dialect.MySQL, dialect.SQLite:
 		if _, ok := bc.mutation.Oid(); !ok {
-			return &ValidationError{Name: "oid", err: errors.New(`entv2: missing required field "Blog.oid"`)}
+			errs = append(errs, &ValidationError{Name: "oid", Entity: "Blog", Field: "oid", Reason: "missing required field", err: errors.New(`entv2: missing required field "Blog.oid"`)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (bc *BlogCreate) sqlSave(ctx context.Context) (*Blog, error) {
@@ -102,7 +103,7 @@ func (bc *BlogCreate) sqlSave(ctx context.Context) (*Blog, error) {
 	_node, _spec := bc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -150,11 +151,15 @@ func (bc *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 // BlogCreateBulk is the builder for creating many Blog entities in bulk.
 type BlogCreateBulk struct {
 	config
+	err      error
 	builders []*BlogCreate
 }
 
 // Save creates the Blog entities in the database.
 func (bcb *BlogCreateBulk) Save(ctx context.Context) ([]*Blog, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
 	nodes := make([]*Blog, len(bcb.builders))
 	mutators := make([]Mutator, len(bcb.builders))
@@ -179,7 +184,7 @@ func (bcb *BlogCreateBulk) Save(ctx context.Context) ([]*Blog, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -357,7 +357,7 @@ func (bq *BlogQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range bq.ctx.Fields {
 		if !blog.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Blog", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
 	if bq.path != nil {
@@ -407,6 +407,22 @@ func (bq *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, e
 }
 
 func (bq *BlogQuery) loadAdmins(ctx context.Context, query *UserQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *User)) error {
+	if size := sqlgraph.MaxInValues(query.driver.Dialect()); size > 0 && len(nodes) > size {
+		preds := query.predicates
+		for len(nodes) > 0 {
+			n := len(nodes)
+			if n > size {
+				n = size
+			}
+			query.predicates = preds[:len(preds):len(preds)]
+			if err := bq.loadAdmins(ctx, query, nodes[:n], init, assign); err != nil {
+				return err
+			}
+			nodes = nodes[n:]
+		}
+		query.predicates = preds
+		return nil
+	}
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Blog)
 	for i := range nodes {
@@ -522,7 +538,8 @@ func (bq *BlogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // BlogGroupBy is the group-by builder for Blog entities.
 type BlogGroupBy struct {
 	selector
-	build *BlogQuery
+	build  *BlogQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -540,6 +557,14 @@ func (bgb *BlogGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*BlogQuery, *BlogGroupBy](ctx, bgb.build, bgb, bgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (bgb *BlogGroupBy) Having(ps ...*sql.Predicate) *BlogGroupBy {
+	bgb.having = append(bgb.having, ps...)
+	return bgb
+}
+
 func (bgb *BlogGroupBy) sqlScan(ctx context.Context, root *BlogQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(bgb.fns))
@@ -555,6 +580,9 @@ func (bgb *BlogGroupBy) sqlScan(ctx context.Context, root *BlogQuery, v any) err
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*bgb.flds...)...)
+	if len(bgb.having) > 0 {
+		selector.Having(sql.And(bgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -137,6 +137,11 @@ func NameContainsFold(v string) predicate.Car {
 	return predicate.Car(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -176,6 +176,11 @@ func NameContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // Int8ToStringEQ applies the EQ predicate on the "int8_to_string" field.
 func Int8ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldInt8ToString, v))
@@ -251,6 +256,11 @@ func Int8ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldInt8ToString, v))
 }
 
+// Int8ToStringHasPrefixFold applies the HasPrefixFold predicate on the "int8_to_string" field.
+func Int8ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldInt8ToString, v))
+}
+
 // Uint8ToStringEQ applies the EQ predicate on the "uint8_to_string" field.
 func Uint8ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldUint8ToString, v))
@@ -326,6 +336,11 @@ func Uint8ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldUint8ToString, v))
 }
 
+// Uint8ToStringHasPrefixFold applies the HasPrefixFold predicate on the "uint8_to_string" field.
+func Uint8ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldUint8ToString, v))
+}
+
 // Int16ToStringEQ applies the EQ predicate on the "int16_to_string" field.
 func Int16ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldInt16ToString, v))
@@ -401,6 +416,11 @@ func Int16ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldInt16ToString, v))
 }
 
+// Int16ToStringHasPrefixFold applies the HasPrefixFold predicate on the "int16_to_string" field.
+func Int16ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldInt16ToString, v))
+}
+
 // Uint16ToStringEQ applies the EQ predicate on the "uint16_to_string" field.
 func Uint16ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldUint16ToString, v))
@@ -476,6 +496,11 @@ func Uint16ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldUint16ToString, v))
 }
 
+// Uint16ToStringHasPrefixFold applies the HasPrefixFold predicate on the "uint16_to_string" field.
+func Uint16ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldUint16ToString, v))
+}
+
 // Int32ToStringEQ applies the EQ predicate on the "int32_to_string" field.
 func Int32ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldInt32ToString, v))
@@ -551,6 +576,11 @@ func Int32ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldInt32ToString, v))
 }
 
+// Int32ToStringHasPrefixFold applies the HasPrefixFold predicate on the "int32_to_string" field.
+func Int32ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldInt32ToString, v))
+}
+
 // Uint32ToStringEQ applies the EQ predicate on the "uint32_to_string" field.
 func Uint32ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldUint32ToString, v))
@@ -626,6 +656,11 @@ func Uint32ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldUint32ToString, v))
 }
 
+// Uint32ToStringHasPrefixFold applies the HasPrefixFold predicate on the "uint32_to_string" field.
+func Uint32ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldUint32ToString, v))
+}
+
 // Int64ToStringEQ applies the EQ predicate on the "int64_to_string" field.
 func Int64ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldInt64ToString, v))
@@ -701,6 +736,11 @@ func Int64ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldInt64ToString, v))
 }
 
+// Int64ToStringHasPrefixFold applies the HasPrefixFold predicate on the "int64_to_string" field.
+func Int64ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldInt64ToString, v))
+}
+
 // Uint64ToStringEQ applies the EQ predicate on the "uint64_to_string" field.
 func Uint64ToStringEQ(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldEQ(FieldUint64ToString, v))
@@ -776,6 +816,11 @@ func Uint64ToStringContainsFold(v string) predicate.Conversion {
 	return predicate.Conversion(sql.FieldContainsFold(FieldUint64ToString, v))
 }
 
+// Uint64ToStringHasPrefixFold applies the HasPrefixFold predicate on the "uint64_to_string" field.
+func Uint64ToStringHasPrefixFold(v string) predicate.Conversion {
+	return predicate.Conversion(sql.FieldHasPrefixFold(FieldUint64ToString, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Conversion) predicate.Conversion {
 	return predicate.Conversion(func(s *sql.Selector) {
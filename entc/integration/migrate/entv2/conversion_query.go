@@ -320,7 +320,7 @@ func (cq *ConversionQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range cq.ctx.Fields {
 		if !conversion.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Conversion", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
 	if cq.path != nil {
@@ -442,7 +442,8 @@ func (cq *ConversionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // ConversionGroupBy is the group-by builder for Conversion entities.
 type ConversionGroupBy struct {
 	selector
-	build *ConversionQuery
+	build  *ConversionQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -460,6 +461,14 @@ func (cgb *ConversionGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*ConversionQuery, *ConversionGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (cgb *ConversionGroupBy) Having(ps ...*sql.Predicate) *ConversionGroupBy {
+	cgb.having = append(cgb.having, ps...)
+	return cgb
+}
+
 func (cgb *ConversionGroupBy) sqlScan(ctx context.Context, root *ConversionQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(cgb.fns))
@@ -475,6 +484,9 @@ func (cgb *ConversionGroupBy) sqlScan(ctx context.Context, root *ConversionQuery
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if len(cgb.having) > 0 {
+		selector.Having(sql.And(cgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
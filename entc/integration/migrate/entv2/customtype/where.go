@@ -148,6 +148,11 @@ func CustomContainsFold(v string) predicate.CustomType {
 	return predicate.CustomType(sql.FieldContainsFold(FieldCustom, v))
 }
 
+// CustomHasPrefixFold applies the HasPrefixFold predicate on the "custom" field.
+func CustomHasPrefixFold(v string) predicate.CustomType {
+	return predicate.CustomType(sql.FieldHasPrefixFold(FieldCustom, v))
+}
+
 // Tz0EQ applies the EQ predicate on the "tz0" field.
 func Tz0EQ(v time.Time) predicate.CustomType {
 	return predicate.CustomType(sql.FieldEQ(FieldTz0, v))
@@ -248,6 +253,18 @@ func Tz3NotNil() predicate.CustomType {
 	return predicate.CustomType(sql.FieldNotNull(FieldTz3))
 }
 
+// Tz0WithinLast applies the WithinLast predicate on the "tz0" field.
+// It matches values that are within the given duration before the current time.
+func Tz0WithinLast(d time.Duration) predicate.CustomType {
+	return predicate.CustomType(sql.FieldWithinLast(FieldTz0, d))
+}
+
+// Tz3WithinLast applies the WithinLast predicate on the "tz3" field.
+// It matches values that are within the given duration before the current time.
+func Tz3WithinLast(d time.Duration) predicate.CustomType {
+	return predicate.CustomType(sql.FieldWithinLast(FieldTz3, d))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CustomType) predicate.CustomType {
 	return predicate.CustomType(func(s *sql.Selector) {
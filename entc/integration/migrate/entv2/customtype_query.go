@@ -320,7 +320,7 @@ func (ctq *CustomTypeQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range ctq.ctx.Fields {
 		if !customtype.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "CustomType", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
 	if ctq.path != nil {
@@ -442,7 +442,8 @@ func (ctq *CustomTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // CustomTypeGroupBy is the group-by builder for CustomType entities.
 type CustomTypeGroupBy struct {
 	selector
-	build *CustomTypeQuery
+	build  *CustomTypeQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -460,6 +461,14 @@ func (ctgb *CustomTypeGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*CustomTypeQuery, *CustomTypeGroupBy](ctx, ctgb.build, ctgb, ctgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ctgb *CustomTypeGroupBy) Having(ps ...*sql.Predicate) *CustomTypeGroupBy {
+	ctgb.having = append(ctgb.having, ps...)
+	return ctgb
+}
+
 func (ctgb *CustomTypeGroupBy) sqlScan(ctx context.Context, root *CustomTypeQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ctgb.fns))
@@ -475,6 +484,9 @@ func (ctgb *CustomTypeGroupBy) sqlScan(ctx context.Context, root *CustomTypeQuer
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ctgb.flds...)...)
+	if len(ctgb.having) > 0 {
+		selector.Having(sql.And(ctgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -298,7 +298,7 @@ func (gq *GroupQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range gq.ctx.Fields {
 		if !group.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Group", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
 	if gq.path != nil {
@@ -420,7 +420,8 @@ func (gq *GroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // GroupGroupBy is the group-by builder for Group entities.
 type GroupGroupBy struct {
 	selector
-	build *GroupQuery
+	build  *GroupQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -438,6 +439,14 @@ func (ggb *GroupGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*GroupQuery, *GroupGroupBy](ctx, ggb.build, ggb, ggb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (ggb *GroupGroupBy) Having(ps ...*sql.Predicate) *GroupGroupBy {
+	ggb.having = append(ggb.having, ps...)
+	return ggb
+}
+
 func (ggb *GroupGroupBy) sqlScan(ctx context.Context, root *GroupQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(ggb.fns))
@@ -453,6 +462,9 @@ func (ggb *GroupGroupBy) sqlScan(ctx context.Context, root *GroupQuery, v any) e
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*ggb.flds...)...)
+	if len(ggb.having) > 0 {
+		selector.Having(sql.And(ggb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -7,6 +7,8 @@
 package media
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -65,3 +67,76 @@ func BySourceURI(opts ...sql.OrderTermOption) OrderOption {
 func ByText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldText, opts...).ToFunc()
 }
+
+// OrderField is the name of a field or an edge count (e.g. "edge_count") that Media queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that Media queries can be ordered by.
+const (
+	OrderFieldID        OrderField = "id"
+	OrderFieldSource    OrderField = "source"
+	OrderFieldSourceURI OrderField = "source_uri"
+	OrderFieldText      OrderField = "text"
+)
+
+// OrderFields holds all fields and edge counts that Media queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldSource,
+	OrderFieldSourceURI,
+	OrderFieldText,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if Media queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("media: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := media.OrderByField(media.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.Media.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldSource:
+		return BySource(opts...), nil
+	case OrderFieldSourceURI:
+		return BySourceURI(opts...), nil
+	case OrderFieldText:
+		return ByText(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
@@ -146,6 +146,11 @@ func SourceContainsFold(v string) predicate.Media {
 	return predicate.Media(sql.FieldContainsFold(FieldSource, v))
 }
 
+// SourceHasPrefixFold applies the HasPrefixFold predicate on the "source" field.
+func SourceHasPrefixFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefixFold(FieldSource, v))
+}
+
 // SourceURIEQ applies the EQ predicate on the "source_uri" field.
 func SourceURIEQ(v string) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldSourceURI, v))
@@ -221,6 +226,11 @@ func SourceURIContainsFold(v string) predicate.Media {
 	return predicate.Media(sql.FieldContainsFold(FieldSourceURI, v))
 }
 
+// SourceURIHasPrefixFold applies the HasPrefixFold predicate on the "source_uri" field.
+func SourceURIHasPrefixFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefixFold(FieldSourceURI, v))
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v string) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldText, v))
@@ -296,6 +306,11 @@ func TextContainsFold(v string) predicate.Media {
 	return predicate.Media(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefixFold(FieldText, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Media) predicate.Media {
 	return predicate.Media(func(s *sql.Selector) {
@@ -98,7 +98,8 @@ func (mc *MediaCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (mc *MediaCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (mc *MediaCreate) sqlSave(ctx context.Context) (*Media, error) {
@@ -108,7 +109,7 @@ func (mc *MediaCreate) sqlSave(ctx context.Context) (*Media, error) {
 	_node, _spec := mc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -142,11 +143,15 @@ func (mc *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 // MediaCreateBulk is the builder for creating many Media entities in bulk.
 type MediaCreateBulk struct {
 	config
+	err      error
 	builders []*MediaCreate
 }
 
 // Save creates the Media entities in the database.
 func (mcb *MediaCreateBulk) Save(ctx context.Context) ([]*Media, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
 	nodes := make([]*Media, len(mcb.builders))
 	mutators := make([]Mutator, len(mcb.builders))
@@ -171,7 +176,7 @@ func (mcb *MediaCreateBulk) Save(ctx context.Context) ([]*Media, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
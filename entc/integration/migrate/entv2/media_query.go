@@ -320,7 +320,7 @@ func (mq *MediaQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range mq.ctx.Fields {
 		if !media.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Media", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
 	if mq.path != nil {
@@ -442,7 +442,8 @@ func (mq *MediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // MediaGroupBy is the group-by builder for Media entities.
 type MediaGroupBy struct {
 	selector
-	build *MediaQuery
+	build  *MediaQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -460,6 +461,14 @@ func (mgb *MediaGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*MediaQuery, *MediaGroupBy](ctx, mgb.build, mgb, mgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (mgb *MediaGroupBy) Having(ps ...*sql.Predicate) *MediaGroupBy {
+	mgb.having = append(mgb.having, ps...)
+	return mgb
+}
+
 func (mgb *MediaGroupBy) sqlScan(ctx context.Context, root *MediaQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(mgb.fns))
@@ -475,6 +484,9 @@ func (mgb *MediaGroupBy) sqlScan(ctx context.Context, root *MediaQuery, v any) e
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*mgb.flds...)...)
+	if len(mgb.having) > 0 {
+		selector.Having(sql.And(mgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
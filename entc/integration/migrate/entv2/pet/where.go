@@ -137,6 +137,11 @@ func NameContainsFold(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -80,7 +80,11 @@ func (f CommitFunc) Commit(ctx context.Context, tx *Tx) error {
 func (tx *Tx) Commit() error {
 	txDriver := tx.config.driver.(*txDriver)
 	var fn Committer = CommitFunc(func(context.Context, *Tx) error {
-		return txDriver.tx.Commit()
+		// Completion functions are called only if the underlying
+		// transaction was completed, and not skipped by a hook.
+		err := txDriver.tx.Commit()
+		txDriver.complete(err == nil, err)
+		return err
 	})
 	txDriver.mu.Lock()
 	hooks := append([]CommitHook(nil), txDriver.onCommit...)
@@ -136,7 +140,11 @@ func (f RollbackFunc) Rollback(ctx context.Context, tx *Tx) error {
 func (tx *Tx) Rollback() error {
 	txDriver := tx.config.driver.(*txDriver)
 	var fn Rollbacker = RollbackFunc(func(context.Context, *Tx) error {
-		return txDriver.tx.Rollback()
+		// Completion functions are called only if the underlying
+		// transaction was completed, and not skipped by a hook.
+		err := txDriver.tx.Rollback()
+		txDriver.complete(false, err)
+		return err
 	})
 	txDriver.mu.Lock()
 	hooks := append([]RollbackHook(nil), txDriver.onRollback...)
@@ -155,6 +163,25 @@ func (tx *Tx) OnRollback(f RollbackHook) {
 	txDriver.mu.Unlock()
 }
 
+// AfterCommit registers a function that is called after the transaction was committed
+// successfully. It allows deferring side effects, like cache invalidation or publishing
+// events, until the changes of the transaction are visible to other clients.
+func (tx *Tx) AfterCommit(f func()) {
+	txDriver := tx.config.driver.(*txDriver)
+	txDriver.mu.Lock()
+	txDriver.afterCommit = append(txDriver.afterCommit, f)
+	txDriver.mu.Unlock()
+}
+
+// AfterRollback registers a function that is called after the transaction was rolled back,
+// or failed to commit. The function receives the error returned by Rollback or Commit, if any.
+func (tx *Tx) AfterRollback(f func(error)) {
+	txDriver := tx.config.driver.(*txDriver)
+	txDriver.mu.Lock()
+	txDriver.afterRollback = append(txDriver.afterRollback, f)
+	txDriver.mu.Unlock()
+}
+
 // Client returns a Client that binds to current transaction.
 func (tx *Tx) Client() *Client {
 	tx.clientOnce.Do(func() {
@@ -196,6 +223,9 @@ type txDriver struct {
 	mu         sync.Mutex
 	onCommit   []CommitHook
 	onRollback []RollbackHook
+	// completion functions.
+	afterCommit   []func()
+	afterRollback []func(error)
 }
 
 // newTx creates a new transactional driver.
@@ -207,6 +237,23 @@ func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
 	return &txDriver{tx: tx, drv: drv}, nil
 }
 
+// complete calls the completion functions of the transaction.
+func (tx *txDriver) complete(committed bool, err error) {
+	tx.mu.Lock()
+	afterCommit, afterRollback := tx.afterCommit, tx.afterRollback
+	tx.afterCommit, tx.afterRollback = nil, nil
+	tx.mu.Unlock()
+	if committed {
+		for _, f := range afterCommit {
+			f()
+		}
+		return
+	}
+	for _, f := range afterRollback {
+		f(err)
+	}
+}
+
 // Tx returns the transaction wrapper (txDriver) to avoid Commit or Rollback calls
 // from the internal builders. Should be called only by the internal builders.
 func (tx *txDriver) Tx(context.Context) (dialect.Tx, error) { return tx, nil }
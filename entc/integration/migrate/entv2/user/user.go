@@ -375,6 +375,148 @@ func ByFriends(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFriendsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// OrderField is the name of a field or an edge count (e.g. "car_count") that User queries can be
+// ordered by. Its values are validated before they are used for ordering, and therefore, it can be used for
+// ordering by names that are received from API clients (e.g. "?sort="). Sensitive fields are excluded.
+type OrderField string
+
+// Fields and edge counts that User queries can be ordered by.
+const (
+	OrderFieldID           OrderField = "id"
+	OrderFieldMixedString  OrderField = "mixed_string"
+	OrderFieldMixedEnum    OrderField = "mixed_enum"
+	OrderFieldActive       OrderField = "active"
+	OrderFieldAge          OrderField = "age"
+	OrderFieldName         OrderField = "name"
+	OrderFieldDescription  OrderField = "description"
+	OrderFieldNickname     OrderField = "nickname"
+	OrderFieldPhone        OrderField = "phone"
+	OrderFieldTitle        OrderField = "title"
+	OrderFieldNewName      OrderField = "new_name"
+	OrderFieldNewToken     OrderField = "new_token"
+	OrderFieldState        OrderField = "state"
+	OrderFieldStatus       OrderField = "status"
+	OrderFieldWorkplace    OrderField = "workplace"
+	OrderFieldDefaultExpr  OrderField = "default_expr"
+	OrderFieldDefaultExprs OrderField = "default_exprs"
+	OrderFieldCreatedAt    OrderField = "created_at"
+	OrderFieldDropOptional OrderField = "drop_optional"
+	OrderFieldCarCount     OrderField = "car_count"
+	OrderFieldFriendsCount OrderField = "friends_count"
+)
+
+// OrderFields holds all fields and edge counts that User queries can be ordered by.
+var OrderFields = []OrderField{
+	OrderFieldID,
+	OrderFieldMixedString,
+	OrderFieldMixedEnum,
+	OrderFieldActive,
+	OrderFieldAge,
+	OrderFieldName,
+	OrderFieldDescription,
+	OrderFieldNickname,
+	OrderFieldPhone,
+	OrderFieldTitle,
+	OrderFieldNewName,
+	OrderFieldNewToken,
+	OrderFieldState,
+	OrderFieldStatus,
+	OrderFieldWorkplace,
+	OrderFieldDefaultExpr,
+	OrderFieldDefaultExprs,
+	OrderFieldCreatedAt,
+	OrderFieldDropOptional,
+	OrderFieldCarCount,
+	OrderFieldFriendsCount,
+}
+
+// String implements the fmt.Stringer interface.
+func (f OrderField) String() string {
+	return string(f)
+}
+
+// Validate returns an error if User queries cannot be ordered by the field.
+func (f OrderField) Validate() error {
+	for _, o := range OrderFields {
+		if f == o {
+			return nil
+		}
+	}
+	return fmt.Errorf("user: invalid order field %q", string(f))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f OrderField) MarshalText() ([]byte, error) {
+	return []byte(f), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface,
+// and fails if the text is not a valid order field.
+func (f *OrderField) UnmarshalText(text []byte) error {
+	if err := OrderField(text).Validate(); err != nil {
+		return err
+	}
+	*f = OrderField(text)
+	return nil
+}
+
+// OrderByField returns an order option for the given field, or an error if it is not a valid order field.
+//
+//	o, err := user.OrderByField(user.OrderField(r.URL.Query().Get("sort")), sql.OrderDesc())
+//	if err != nil {
+//		return err
+//	}
+//	client.User.Query().Order(o).All(ctx)
+func OrderByField(f OrderField, opts ...sql.OrderTermOption) (OrderOption, error) {
+	switch f {
+	case OrderFieldID:
+		return ByID(opts...), nil
+	case OrderFieldMixedString:
+		return ByMixedString(opts...), nil
+	case OrderFieldMixedEnum:
+		return ByMixedEnum(opts...), nil
+	case OrderFieldActive:
+		return ByActive(opts...), nil
+	case OrderFieldAge:
+		return ByAge(opts...), nil
+	case OrderFieldName:
+		return ByName(opts...), nil
+	case OrderFieldDescription:
+		return ByDescription(opts...), nil
+	case OrderFieldNickname:
+		return ByNickname(opts...), nil
+	case OrderFieldPhone:
+		return ByPhone(opts...), nil
+	case OrderFieldTitle:
+		return ByTitle(opts...), nil
+	case OrderFieldNewName:
+		return ByNewName(opts...), nil
+	case OrderFieldNewToken:
+		return ByNewToken(opts...), nil
+	case OrderFieldState:
+		return ByState(opts...), nil
+	case OrderFieldStatus:
+		return ByStatus(opts...), nil
+	case OrderFieldWorkplace:
+		return ByWorkplace(opts...), nil
+	case OrderFieldDefaultExpr:
+		return ByDefaultExpr(opts...), nil
+	case OrderFieldDefaultExprs:
+		return ByDefaultExprs(opts...), nil
+	case OrderFieldCreatedAt:
+		return ByCreatedAt(opts...), nil
+	case OrderFieldDropOptional:
+		return ByDropOptional(opts...), nil
+	case OrderFieldCarCount:
+		return ByCarCount(opts...), nil
+	case OrderFieldFriendsCount:
+		return ByFriendsCount(opts...), nil
+	default:
+		return nil, f.Validate()
+	}
+}
+
 func newCarStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
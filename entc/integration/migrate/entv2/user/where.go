@@ -11,6 +11,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/migrate/entv2/predicate"
 )
 
@@ -209,6 +210,11 @@ func MixedStringContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldMixedString, v))
 }
 
+// MixedStringHasPrefixFold applies the HasPrefixFold predicate on the "mixed_string" field.
+func MixedStringHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldMixedString, v))
+}
+
 // MixedEnumEQ applies the EQ predicate on the "mixed_enum" field.
 func MixedEnumEQ(v MixedEnum) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldMixedEnum, v))
@@ -344,6 +350,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDescription, v))
@@ -419,6 +430,11 @@ func DescriptionContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DescriptionHasPrefixFold applies the HasPrefixFold predicate on the "description" field.
+func DescriptionHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldDescription, v))
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNickname, v))
@@ -484,6 +500,11 @@ func NicknameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNickname, v))
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldNickname, v))
+}
+
 // PhoneEQ applies the EQ predicate on the "phone" field.
 func PhoneEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPhone, v))
@@ -549,6 +570,11 @@ func PhoneContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPhone, v))
 }
 
+// PhoneHasPrefixFold applies the HasPrefixFold predicate on the "phone" field.
+func PhoneHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldPhone, v))
+}
+
 // BufferEQ applies the EQ predicate on the "buffer" field.
 func BufferEQ(v []byte) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBuffer, v))
@@ -664,6 +690,11 @@ func TitleContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// TitleHasPrefixFold applies the HasPrefixFold predicate on the "title" field.
+func TitleHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldTitle, v))
+}
+
 // NewNameEQ applies the EQ predicate on the "new_name" field.
 func NewNameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNewName, v))
@@ -739,6 +770,11 @@ func NewNameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNewName, v))
 }
 
+// NewNameHasPrefixFold applies the HasPrefixFold predicate on the "new_name" field.
+func NewNameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldNewName, v))
+}
+
 // NewTokenEQ applies the EQ predicate on the "new_token" field.
 func NewTokenEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNewToken, v))
@@ -804,6 +840,11 @@ func NewTokenContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNewToken, v))
 }
 
+// NewTokenHasPrefixFold applies the HasPrefixFold predicate on the "new_token" field.
+func NewTokenHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldNewToken, v))
+}
+
 // BlobEQ applies the EQ predicate on the "blob" field.
 func BlobEQ(v []byte) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBlob, v))
@@ -989,6 +1030,11 @@ func WorkplaceContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldWorkplace, v))
 }
 
+// WorkplaceHasPrefixFold applies the HasPrefixFold predicate on the "workplace" field.
+func WorkplaceHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldWorkplace, v))
+}
+
 // RolesIsNil applies the IsNil predicate on the "roles" field.
 func RolesIsNil() predicate.User {
 	return predicate.User(sql.FieldIsNull(FieldRoles))
@@ -1074,6 +1120,11 @@ func DefaultExprContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDefaultExpr, v))
 }
 
+// DefaultExprHasPrefixFold applies the HasPrefixFold predicate on the "default_expr" field.
+func DefaultExprHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldDefaultExpr, v))
+}
+
 // DefaultExprsEQ applies the EQ predicate on the "default_exprs" field.
 func DefaultExprsEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDefaultExprs, v))
@@ -1149,6 +1200,11 @@ func DefaultExprsContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDefaultExprs, v))
 }
 
+// DefaultExprsHasPrefixFold applies the HasPrefixFold predicate on the "default_exprs" field.
+func DefaultExprsHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldDefaultExprs, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -1254,6 +1310,41 @@ func DropOptionalContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDropOptional, v))
 }
 
+// DropOptionalHasPrefixFold applies the HasPrefixFold predicate on the "drop_optional" field.
+func DropOptionalHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldDropOptional, v))
+}
+
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.User {
+	return predicate.User(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
+// RolesHasKey applies the HasKey predicate on the "roles" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func RolesHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldRoles), sqljson.Path(path...)))
+	})
+}
+
+// RolesValueEQ checks that the value at the given path of the
+// "roles" JSON field is equal to v.
+func RolesValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldRoles), v, sqljson.Path(path...)))
+	})
+}
+
+// RolesContains checks that the value at the given path of the "roles"
+// JSON field contains v. e.g. an array that contains the element v.
+func RolesContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldRoles), v, sqljson.Path(path...)))
+	})
+}
+
 // HasCar applies the HasEdge predicate on the "car" edge.
 func HasCar() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
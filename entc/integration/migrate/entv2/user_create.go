@@ -406,65 +406,66 @@ func (uc *UserCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := uc.mutation.MixedString(); !ok {
-		return &ValidationError{Name: "mixed_string", err: errors.New(`entv2: missing required field "User.mixed_string"`)}
+		errs = append(errs, &ValidationError{Name: "mixed_string", Entity: "User", Field: "mixed_string", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.mixed_string"`)})
 	}
 	if _, ok := uc.mutation.MixedEnum(); !ok {
-		return &ValidationError{Name: "mixed_enum", err: errors.New(`entv2: missing required field "User.mixed_enum"`)}
+		errs = append(errs, &ValidationError{Name: "mixed_enum", Entity: "User", Field: "mixed_enum", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.mixed_enum"`)})
 	}
 	if v, ok := uc.mutation.MixedEnum(); ok {
 		if err := user.MixedEnumValidator(v); err != nil {
-			return &ValidationError{Name: "mixed_enum", err: fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "mixed_enum", Entity: "User", Field: "mixed_enum", Reason: err.Error(), err: fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Active(); !ok {
-		return &ValidationError{Name: "active", err: errors.New(`entv2: missing required field "User.active"`)}
+		errs = append(errs, &ValidationError{Name: "active", Entity: "User", Field: "active", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.active"`)})
 	}
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`entv2: missing required field "User.age"`)}
+		errs = append(errs, &ValidationError{Name: "age", Entity: "User", Field: "age", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.age"`)})
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`entv2: missing required field "User.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "User", Field: "name", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.name"`)})
 	}
 	if _, ok := uc.mutation.Nickname(); !ok {
-		return &ValidationError{Name: "nickname", err: errors.New(`entv2: missing required field "User.nickname"`)}
+		errs = append(errs, &ValidationError{Name: "nickname", Entity: "User", Field: "nickname", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.nickname"`)})
 	}
 	if v, ok := uc.mutation.Nickname(); ok {
 		if err := user.NicknameValidator(v); err != nil {
-			return &ValidationError{Name: "nickname", err: fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "nickname", Entity: "User", Field: "nickname", Reason: err.Error(), err: fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.Phone(); !ok {
-		return &ValidationError{Name: "phone", err: errors.New(`entv2: missing required field "User.phone"`)}
+		errs = append(errs, &ValidationError{Name: "phone", Entity: "User", Field: "phone", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.phone"`)})
 	}
 	if _, ok := uc.mutation.Title(); !ok {
-		return &ValidationError{Name: "title", err: errors.New(`entv2: missing required field "User.title"`)}
+		errs = append(errs, &ValidationError{Name: "title", Entity: "User", Field: "title", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.title"`)})
 	}
 	if _, ok := uc.mutation.NewToken(); !ok {
-		return &ValidationError{Name: "new_token", err: errors.New(`entv2: missing required field "User.new_token"`)}
+		errs = append(errs, &ValidationError{Name: "new_token", Entity: "User", Field: "new_token", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.new_token"`)})
 	}
 	if v, ok := uc.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "blob", Entity: "User", Field: "blob", Reason: err.Error(), err: fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)})
 		}
 	}
 	if v, ok := uc.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "state", Entity: "User", Field: "state", Reason: err.Error(), err: fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err)})
 		}
 	}
 	if v, ok := uc.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "status", Entity: "User", Field: "status", Reason: err.Error(), err: fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err)})
 		}
 	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`entv2: missing required field "User.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "User", Field: "created_at", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.created_at"`)})
 	}
 	if _, ok := uc.mutation.DropOptional(); !ok {
-		return &ValidationError{Name: "drop_optional", err: errors.New(`entv2: missing required field "User.drop_optional"`)}
+		errs = append(errs, &ValidationError{Name: "drop_optional", Entity: "User", Field: "drop_optional", Reason: "missing required field", err: errors.New(`entv2: missing required field "User.drop_optional"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -474,7 +475,7 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	_node, _spec := uc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -634,11 +635,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
+	err      error
 	builders []*UserCreate
 }
 
 // Save creates the User entities in the database.
 func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
@@ -664,7 +669,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
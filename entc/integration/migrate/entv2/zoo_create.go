@@ -62,7 +62,8 @@ func (zc *ZooCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (zc *ZooCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (zc *ZooCreate) sqlSave(ctx context.Context) (*Zoo, error) {
@@ -72,7 +73,7 @@ func (zc *ZooCreate) sqlSave(ctx context.Context) (*Zoo, error) {
 	_node, _spec := zc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, zc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -100,11 +101,15 @@ func (zc *ZooCreate) createSpec() (*Zoo, *sqlgraph.CreateSpec) {
 // ZooCreateBulk is the builder for creating many Zoo entities in bulk.
 type ZooCreateBulk struct {
 	config
+	err      error
 	builders []*ZooCreate
 }
 
 // Save creates the Zoo entities in the database.
 func (zcb *ZooCreateBulk) Save(ctx context.Context) ([]*Zoo, error) {
+	if zcb.err != nil {
+		return nil, zcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(zcb.builders))
 	nodes := make([]*Zoo, len(zcb.builders))
 	mutators := make([]Mutator, len(zcb.builders))
@@ -129,7 +134,7 @@ func (zcb *ZooCreateBulk) Save(ctx context.Context) ([]*Zoo, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, zcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -298,7 +298,7 @@ func (zq *ZooQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range zq.ctx.Fields {
 		if !zoo.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Zoo", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 		}
 	}
 	if zq.path != nil {
@@ -420,7 +420,8 @@ func (zq *ZooQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // ZooGroupBy is the group-by builder for Zoo entities.
 type ZooGroupBy struct {
 	selector
-	build *ZooQuery
+	build  *ZooQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -438,6 +439,14 @@ func (zgb *ZooGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*ZooQuery, *ZooGroupBy](ctx, zgb.build, zgb, zgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (zgb *ZooGroupBy) Having(ps ...*sql.Predicate) *ZooGroupBy {
+	zgb.having = append(zgb.having, ps...)
+	return zgb
+}
+
 func (zgb *ZooGroupBy) sqlScan(ctx context.Context, root *ZooQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(zgb.fns))
@@ -453,6 +462,9 @@ func (zgb *ZooGroupBy) sqlScan(ctx context.Context, root *ZooQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*zgb.flds...)...)
+	if len(zgb.having) > 0 {
+		selector.Having(sql.And(zgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -76,7 +77,7 @@ func (zu *ZooUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{zoo.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return 0, err
 	}
@@ -141,7 +142,7 @@ func (zuo *ZooUpdateOne) sqlSave(ctx context.Context) (_node *Zoo, err error) {
 	_spec := sqlgraph.NewUpdateSpec(zoo.Table, zoo.Columns, sqlgraph.NewFieldSpec(zoo.FieldID, field.TypeInt))
 	id, ok := zuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`entv2: missing "Zoo.id" for update`)}
+		return nil, &ValidationError{Name: "id", Entity: "Zoo", Field: "id", Reason: "missing id for update", err: errors.New(`entv2: missing "Zoo.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := zuo.fields; len(fields) > 0 {
@@ -149,7 +150,7 @@ func (zuo *ZooUpdateOne) sqlSave(ctx context.Context) (_node *Zoo, err error) {
 		_spec.Node.Columns = append(_spec.Node.Columns, zoo.FieldID)
 		for _, f := range fields {
 			if !zoo.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("entv2: invalid field %q for query", f)}
+				return nil, &ValidationError{Name: f, Entity: "Zoo", Field: f, Reason: "invalid field for query", err: fmt.Errorf("entv2: invalid field %q for query", f)}
 			}
 			if f != zoo.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, f)
@@ -170,10 +171,69 @@ func (zuo *ZooUpdateOne) sqlSave(ctx context.Context) (_node *Zoo, err error) {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{zoo.Label}
 		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
 	zuo.mutation.done = true
 	return _node, nil
 }
+
+// sameUpdate reports if the two builders apply the same changes on their entities.
+func (zuo *ZooUpdateOne) sameUpdate(other *ZooUpdateOne) bool {
+	m1, m2 := *zuo.mutation, *other.mutation
+	m1.config, m1.id, m1.oldValue = config{}, nil, nil
+	m2.config, m2.id, m2.oldValue = config{}, nil, nil
+	return reflect.DeepEqual(m1, m2)
+}
+
+// ZooUpdateMany is the builder for updating many Zoo entities by their ids.
+type ZooUpdateMany struct {
+	config
+	builders []*ZooUpdateOne
+}
+
+// Exec executes the updates of the entities. Updates that apply the same changes are batched into a single
+// bulk update, and the rest are executed one by one. Hence, the number of executed statements is bounded by
+// the number of distinct updates, and not by the number of entities. Similar to bulk updates, entities that
+// do not exist are skipped, and batched updates run the hooks of the OpUpdate operation. Use a transaction
+// for applying the updates atomically.
+func (zum *ZooUpdateMany) Exec(ctx context.Context) error {
+	var batches [][]*ZooUpdateOne
+Builders:
+	for _, b := range zum.builders {
+		for i := range batches {
+			if batches[i][0].sameUpdate(b) {
+				batches[i] = append(batches[i], b)
+				continue Builders
+			}
+		}
+		batches = append(batches, []*ZooUpdateOne{b})
+	}
+	for _, batch := range batches {
+		if len(batch) == 1 {
+			if err := batch[0].Exec(ctx); err != nil && !IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, b := range batch {
+			ids[i] = *b.mutation.id
+		}
+		m := batch[0].mutation
+		m.op, m.id, m.oldValue = OpUpdate, nil, nil
+		m.Where(zoo.IDIn(ids...))
+		if err := (&ZooUpdate{config: batch[0].config, hooks: batch[0].hooks, mutation: m}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (zum *ZooUpdateMany) ExecX(ctx context.Context) {
+	if err := zum.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
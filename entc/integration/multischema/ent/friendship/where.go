@@ -200,6 +200,12 @@ func FriendIDNotIn(vs ...int) predicate.Friendship {
 	return predicate.Friendship(sql.FieldNotIn(FieldFriendID, vs...))
 }
 
+// CreatedAtWithinLast applies the WithinLast predicate on the "created_at" field.
+// It matches values that are within the given duration before the current time.
+func CreatedAtWithinLast(d time.Duration) predicate.Friendship {
+	return predicate.Friendship(sql.FieldWithinLast(FieldCreatedAt, d))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
@@ -122,25 +122,26 @@ func (fc *FriendshipCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (fc *FriendshipCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Weight(); !ok {
-		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Friendship.weight"`)}
+		errs = append(errs, &ValidationError{Name: "weight", Entity: "Friendship", Field: "weight", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.weight"`)})
 	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Friendship.created_at"`)}
+		errs = append(errs, &ValidationError{Name: "created_at", Entity: "Friendship", Field: "created_at", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.created_at"`)})
 	}
 	if _, ok := fc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Friendship.user_id"`)}
+		errs = append(errs, &ValidationError{Name: "user_id", Entity: "Friendship", Field: "user_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.user_id"`)})
 	}
 	if _, ok := fc.mutation.FriendID(); !ok {
-		return &ValidationError{Name: "friend_id", err: errors.New(`ent: missing required field "Friendship.friend_id"`)}
+		errs = append(errs, &ValidationError{Name: "friend_id", Entity: "Friendship", Field: "friend_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Friendship.friend_id"`)})
 	}
 	if _, ok := fc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Friendship.user"`)}
+		errs = append(errs, &ValidationError{Name: "user", Entity: "Friendship", Field: "user", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Friendship.user"`)})
 	}
 	if _, ok := fc.mutation.FriendID(); !ok {
-		return &ValidationError{Name: "friend", err: errors.New(`ent: missing required edge "Friendship.friend"`)}
+		errs = append(errs, &ValidationError{Name: "friend", Entity: "Friendship", Field: "friend", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Friendship.friend"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FriendshipCreate) sqlSave(ctx context.Context) (*Friendship, error) {
@@ -150,7 +151,7 @@ func (fc *FriendshipCreate) sqlSave(ctx context.Context) (*Friendship, error) {
 	_node, _spec := fc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -217,11 +218,15 @@ func (fc *FriendshipCreate) createSpec() (*Friendship, *sqlgraph.CreateSpec) {
 // FriendshipCreateBulk is the builder for creating many Friendship entities in bulk.
 type FriendshipCreateBulk struct {
 	config
+	err      error
 	builders []*FriendshipCreate
 }
 
 // Save creates the Friendship entities in the database.
 func (fcb *FriendshipCreateBulk) Save(ctx context.Context) ([]*Friendship, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
 	nodes := make([]*Friendship, len(fcb.builders))
 	mutators := make([]Mutator, len(fcb.builders))
@@ -247,7 +252,7 @@ func (fcb *FriendshipCreateBulk) Save(ctx context.Context) ([]*Friendship, error
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -20,8 +20,9 @@ import (
 // FriendshipDelete is the builder for deleting a Friendship entity.
 type FriendshipDelete struct {
 	config
-	hooks    []Hook
-	mutation *FriendshipMutation
+	hooks     []Hook
+	mutation  *FriendshipMutation
+	modifiers []func(*sql.DeleteBuilder)
 }
 
 // Where appends a list predicates to the FriendshipDelete builder.
@@ -44,10 +45,23 @@ func (fd *FriendshipDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (fd *FriendshipDelete) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FriendshipDelete {
+	fd.modifiers = append(fd.modifiers, modifiers...)
+	return fd
+}
+
+// Modify adds a statement modifier for attaching custom logic to the DELETE statement.
+func (fdo *FriendshipDeleteOne) Modify(modifiers ...func(d *sql.DeleteBuilder)) *FriendshipDeleteOne {
+	fdo.fd.Modify(modifiers...)
+	return fdo
+}
+
 func (fd *FriendshipDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(friendship.Table, sqlgraph.NewFieldSpec(friendship.FieldID, field.TypeInt))
 	_spec.Node.Schema = fd.schemaConfig.Friendship
 	ctx = internal.NewSchemaConfigContext(ctx, fd.schemaConfig)
+	_spec.AddModifiers(fd.modifiers...)
 	if ps := fd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -57,7 +71,7 @@ func (fd *FriendshipDelete) sqlExec(ctx context.Context) (int, error) {
 	}
 	affected, err := sqlgraph.DeleteNodes(ctx, fd.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
-		err = &ConstraintError{msg: err.Error(), wrap: err}
+		err = newConstraintError(err)
 	}
 	fd.mutation.done = true
 	return affected, err
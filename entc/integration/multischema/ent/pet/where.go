@@ -133,6 +133,11 @@ func NameContainsFold(v string) predicate.Pet {
 	return predicate.Pet(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Pet {
+	return predicate.Pet(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // OwnerIDEQ applies the EQ predicate on the "owner_id" field.
 func OwnerIDEQ(v int) predicate.Pet {
 	return predicate.Pet(sql.FieldEQ(FieldOwnerID, v))
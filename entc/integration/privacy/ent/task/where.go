@@ -138,6 +138,11 @@ func TitleContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// TitleHasPrefixFold applies the HasPrefixFold predicate on the "title" field.
+func TitleHasPrefixFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefixFold(FieldTitle, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldDescription, v))
@@ -213,6 +218,11 @@ func DescriptionContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DescriptionHasPrefixFold applies the HasPrefixFold predicate on the "description" field.
+func DescriptionHasPrefixFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefixFold(FieldDescription, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldStatus, v))
@@ -154,23 +154,24 @@ func (tc *TaskCreate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TaskCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Title(); !ok {
-		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Task.title"`)}
+		errs = append(errs, &ValidationError{Name: "title", Entity: "Task", Field: "title", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.title"`)})
 	}
 	if v, ok := tc.mutation.Title(); ok {
 		if err := task.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "title", Entity: "Task", Field: "title", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)})
 		}
 	}
 	if _, ok := tc.mutation.Status(); !ok {
-		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Task.status"`)}
+		errs = append(errs, &ValidationError{Name: "status", Entity: "Task", Field: "status", Reason: "missing required field", err: errors.New(`ent: missing required field "Task.status"`)})
 	}
 	if v, ok := tc.mutation.Status(); ok {
 		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "status", Entity: "Task", Field: "status", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TaskCreate) sqlSave(ctx context.Context) (*Task, error) {
@@ -180,7 +181,7 @@ func (tc *TaskCreate) sqlSave(ctx context.Context) (*Task, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -251,11 +252,15 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 // TaskCreateBulk is the builder for creating many Task entities in bulk.
 type TaskCreateBulk struct {
 	config
+	err      error
 	builders []*TaskCreate
 }
 
 // Save creates the Task entities in the database.
 func (tcb *TaskCreateBulk) Save(ctx context.Context) ([]*Task, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Task, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -281,7 +286,7 @@ func (tcb *TaskCreateBulk) Save(ctx context.Context) ([]*Task, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
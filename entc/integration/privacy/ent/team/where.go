@@ -127,6 +127,11 @@ func NameContainsFold(v string) predicate.Team {
 	return predicate.Team(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Team {
+	return predicate.Team(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasTasks applies the HasEdge predicate on the "tasks" edge.
 func HasTasks() predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
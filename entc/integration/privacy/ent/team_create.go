@@ -95,15 +95,16 @@ func (tc *TeamCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TeamCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Team.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Team", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Team.name"`)})
 	}
 	if v, ok := tc.mutation.Name(); ok {
 		if err := team.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Team", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TeamCreate) sqlSave(ctx context.Context) (*Team, error) {
@@ -113,7 +114,7 @@ func (tc *TeamCreate) sqlSave(ctx context.Context) (*Team, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -171,11 +172,15 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 // TeamCreateBulk is the builder for creating many Team entities in bulk.
 type TeamCreateBulk struct {
 	config
+	err      error
 	builders []*TeamCreate
 }
 
 // Save creates the Team entities in the database.
 func (tcb *TeamCreateBulk) Save(ctx context.Context) ([]*Team, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Team, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -200,7 +205,7 @@ func (tcb *TeamCreateBulk) Save(ctx context.Context) ([]*Team, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -132,6 +132,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // AgeEQ applies the EQ predicate on the "age" field.
 func AgeEQ(v uint) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAge, v))
@@ -0,0 +1,43 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"entgo.io/ent/entc/integration/ent"
+	"entgo.io/ent/entc/integration/ent/fieldtype"
+	"entgo.io/ent/entc/integration/ent/user"
+
+	"github.com/stretchr/testify/require"
+)
+
+func QuickGen(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(1))
+
+	// Generated entities pass the validators and the unique constraints of their fields.
+	for i := 0; i < 50; i++ {
+		// Names are set explicitly, as the hooks of the CreateBulk test derive the unique nicknames from them.
+		u := client.User.Generate(r, 10).SetName(fmt.Sprintf("quick-%d", i)).SaveX(ctx)
+		require.Equal(u.Name, client.User.GetX(ctx, u.ID).Name)
+		require.NoError(user.RoleValidator(u.Role))
+		client.FieldType.Generate(r, 10).ExecX(ctx)
+	}
+	require.Equal(50, client.FieldType.Query().CountX(ctx))
+	require.NotZero(client.FieldType.Query().Where(fieldtype.PriorityNotNil()).CountX(ctx), "optional fields are set at random")
+	require.NotZero(client.FieldType.Query().Where(fieldtype.PriorityIsNil()).CountX(ctx), "optional fields are left unset at random")
+
+	// Entities can be used as arguments of properties checked by quick.Check.
+	err := quick.Check(func(u *ent.User) bool {
+		return u.ID == 0 && u.Name != "" && user.RoleValidator(u.Role) == nil
+	}, &quick.Config{Rand: r})
+	require.NoError(err)
+}
@@ -159,6 +159,12 @@ func LicensedAtNotNil() predicate.Pet {
 	return predicate.Pet(sql.FieldNotNull(FieldLicensedAt))
 }
 
+// LicensedAtWithinLast applies the WithinLast predicate on the "licensed_at" field.
+// It matches values that are within the given duration before the current time.
+func LicensedAtWithinLast(d time.Duration) predicate.Pet {
+	return predicate.Pet(sql.FieldWithinLast(FieldLicensedAt, d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
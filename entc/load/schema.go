@@ -27,6 +27,8 @@ type Schema struct {
 	Interceptors []*Position    `json:"interceptors,omitempty"`
 	Policy       []*Position    `json:"policy,omitempty"`
 	Annotations  map[string]any `json:"annotations,omitempty"`
+	// View indicates if the schema is backed by a database view (i.e. embeds ent.View).
+	View bool `json:"view,omitempty"`
 }
 
 // Position describes a position in the schema.
@@ -56,6 +58,7 @@ type Field struct {
 	StorageKey    string                  `json:"storage_key,omitempty"`
 	Position      *Position               `json:"position,omitempty"`
 	Sensitive     bool                    `json:"sensitive,omitempty"`
+	Encrypted     bool                    `json:"encrypted,omitempty"`
 	SchemaType    map[string]string       `json:"schema_type,omitempty"`
 	Annotations   map[string]any          `json:"annotations,omitempty"`
 	Comment       string                  `json:"comment,omitempty"`
@@ -135,6 +138,7 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 		StorageKey:    fd.StorageKey,
 		Validators:    len(fd.Validators),
 		Sensitive:     fd.Sensitive,
+		Encrypted:     fd.Encrypted,
 		SchemaType:    fd.SchemaType,
 		Annotations:   make(map[string]any),
 		Comment:       fd.Comment,
@@ -181,6 +185,7 @@ func MarshalSchema(schema ent.Interface) (b []byte, err error) {
 		Config:      schema.Config(),
 		Name:        indirect(reflect.TypeOf(schema)).Name(),
 		Annotations: make(map[string]any),
+		View:        embedsView(indirect(reflect.TypeOf(schema))),
 	}
 	if err := s.loadMixin(schema); err != nil {
 		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
@@ -493,6 +498,23 @@ func safePolicy(schema interface{ Policy() ent.Policy }) (policy ent.Policy, err
 	return schema.Policy(), nil
 }
 
+// embedsView reports if the given schema type embeds the ent.View type.
+func embedsView(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.Anonymous {
+			continue
+		}
+		if ft := indirect(f.Type); ft == reflect.TypeOf(ent.View{}) || embedsView(ft) {
+			return true
+		}
+	}
+	return false
+}
+
 func indirect(t reflect.Type) reflect.Type {
 	for t.Kind() == reflect.Ptr {
 		t = t.Elem()
@@ -519,3 +519,28 @@ func TestMarshalMixin(t *testing.T) {
 		require.False(t, schema.Policy[1].MixedIn)
 	})
 }
+
+type ActiveUser struct {
+	ent.View
+}
+
+func (ActiveUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+	}
+}
+
+func TestMarshalView(t *testing.T) {
+	buf, err := MarshalSchema(ActiveUser{})
+	require.NoError(t, err)
+	schema, err := UnmarshalSchema(buf)
+	require.NoError(t, err)
+	require.True(t, schema.View)
+	require.Equal(t, "name", schema.Fields[0].Name)
+
+	buf, err = MarshalSchema(WithMixin{})
+	require.NoError(t, err)
+	schema, err = UnmarshalSchema(buf)
+	require.NoError(t, err)
+	require.False(t, schema.View)
+}
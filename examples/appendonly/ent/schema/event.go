@@ -0,0 +1,49 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Event holds the schema definition for the Event entity.
+type Event struct {
+	ent.Schema
+}
+
+// Annotations of the Event.
+func (Event) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Engine: &entsql.Engine{
+				Name:        "ReplacingMergeTree",
+				OrderBy:     []string{"user", "created_at"},
+				PartitionBy: "toYYYYMM(created_at)",
+				Settings:    map[string]string{"index_granularity": "8192"},
+			},
+		},
+	}
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		// ClickHouse does not generate IDs.
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("user"),
+		field.Enum("kind").
+			Values("page_view", "click"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"entgo.io/ent/examples/appendonly/ent"
+	"entgo.io/ent/examples/appendonly/ent/event"
+	"entgo.io/ent/examples/appendonly/ent/migrate"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Example_appendOnly() {
+	// The read-models are usually stored in ClickHouse. However,
+	// the append-only client works with the other dialects as well.
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	// Run the auto migration tool.
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	if err := Do(ctx, client); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// engine: ReplacingMergeTree order: [user created_at] partition: toYYYYMM(created_at)
+	// events: 3
+	// click: 1
+	// page_view: 2
+	// a8m: 2
+}
+
+func Do(ctx context.Context, client *ent.Client) error {
+	// The engine annotation is used by the ClickHouse migrator for creating the table.
+	e := migrate.EventsTable.Annotation.Engine
+	fmt.Println("engine:", e.Name, "order:", e.OrderBy, "partition:", e.PartitionBy)
+	// Append-only clients are restricted to the Create and Query APIs.
+	if err := client.Event.CreateBulk(
+		client.Event.Create().SetUser("a8m").SetKind(event.KindPageView),
+		client.Event.Create().SetUser("a8m").SetKind(event.KindClick),
+		client.Event.Create().SetUser("nati").SetKind(event.KindPageView),
+	).Exec(ctx); err != nil {
+		return fmt.Errorf("creating events: %w", err)
+	}
+	n, err := client.Event.Query().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("counting events: %w", err)
+	}
+	fmt.Println("events:", n)
+	var kinds []struct {
+		Kind  event.Kind `json:"kind"`
+		Count int        `json:"count"`
+	}
+	if err := client.Event.Query().
+		GroupBy(event.FieldKind).
+		Aggregate(ent.Count()).
+		Scan(ctx, &kinds); err != nil {
+		return fmt.Errorf("grouping events: %w", err)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i].Kind < kinds[j].Kind })
+	for _, k := range kinds {
+		fmt.Printf("%s: %d\n", k.Kind, k.Count)
+	}
+	n, err = client.Event.Query().Where(event.User("a8m")).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("counting user events: %w", err)
+	}
+	fmt.Println("a8m:", n)
+	return nil
+}
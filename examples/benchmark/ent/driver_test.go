@@ -0,0 +1,29 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package ent_test
+
+import (
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestBenchmarks runs the generated benchmarks once to ensure
+// they work against the default in-memory SQLite database.
+func TestBenchmarks(t *testing.T) {
+	for name, fn := range map[string]func(*testing.B){
+		"UserCreate":     BenchmarkUserCreate,
+		"UserCreateBulk": BenchmarkUserCreateBulk,
+		"UserQuery":      BenchmarkUserQuery,
+		"UserGet":        BenchmarkUserGet,
+		"UserUpdate":     BenchmarkUserUpdate,
+		"PetCreate":      BenchmarkPetCreate,
+		"PetQuery":       BenchmarkPetQuery,
+	} {
+		if r := testing.Benchmark(fn); r.N == 0 {
+			t.Errorf("benchmark %s did not run", name)
+		}
+	}
+}
@@ -0,0 +1,636 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/examples/benchmark/ent/pet"
+	"entgo.io/ent/examples/benchmark/ent/predicate"
+	"entgo.io/ent/examples/benchmark/ent/user"
+	"entgo.io/ent/schema/field"
+)
+
+// PetQuery is the builder for querying Pet entities.
+type PetQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pet.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Pet
+	withOwner  *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PetQuery builder.
+func (pq *PetQuery) Where(ps ...predicate.Pet) *PetQuery {
+	pq.predicates = append(pq.predicates, ps...)
+	return pq
+}
+
+// Limit the number of records to be returned by this query.
+func (pq *PetQuery) Limit(limit int) *PetQuery {
+	pq.ctx.Limit = &limit
+	return pq
+}
+
+// Offset to start from.
+func (pq *PetQuery) Offset(offset int) *PetQuery {
+	pq.ctx.Offset = &offset
+	return pq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pq *PetQuery) Unique(unique bool) *PetQuery {
+	pq.ctx.Unique = &unique
+	return pq
+}
+
+// Order specifies how the records should be ordered.
+func (pq *PetQuery) Order(o ...pet.OrderOption) *PetQuery {
+	pq.order = append(pq.order, o...)
+	return pq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (pq *PetQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pet.Table, pet.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pet.OwnerTable, pet.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Pet entity from the query.
+// Returns a *NotFoundError when no Pet was found.
+func (pq *PetQuery) First(ctx context.Context) (*Pet, error) {
+	nodes, err := pq.Limit(1).All(setContextOp(ctx, pq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pet.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pq *PetQuery) FirstX(ctx context.Context) *Pet {
+	node, err := pq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Pet ID from the query.
+// Returns a *NotFoundError when no Pet ID was found.
+func (pq *PetQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pq.Limit(1).IDs(setContextOp(ctx, pq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pet.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pq *PetQuery) FirstIDX(ctx context.Context) int {
+	id, err := pq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Pet entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Pet entity is found.
+// Returns a *NotFoundError when no Pet entities are found.
+func (pq *PetQuery) Only(ctx context.Context) (*Pet, error) {
+	nodes, err := pq.Limit(2).All(setContextOp(ctx, pq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pet.Label}
+	default:
+		return nil, &NotSingularError{pet.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pq *PetQuery) OnlyX(ctx context.Context) *Pet {
+	node, err := pq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Pet ID in the query.
+// Returns a *NotSingularError when more than one Pet ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pq *PetQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pq.Limit(2).IDs(setContextOp(ctx, pq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pet.Label}
+	default:
+		err = &NotSingularError{pet.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pq *PetQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Pets.
+func (pq *PetQuery) All(ctx context.Context) ([]*Pet, error) {
+	ctx = setContextOp(ctx, pq.ctx, "All")
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Pet, *PetQuery]()
+	return withInterceptors[[]*Pet](ctx, pq, qr, pq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pq *PetQuery) AllX(ctx context.Context) []*Pet {
+	nodes, err := pq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Pet IDs.
+func (pq *PetQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pq.ctx.Unique == nil && pq.path != nil {
+		pq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pq.ctx, "IDs")
+	if err = pq.Select(pet.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pq *PetQuery) IDsX(ctx context.Context) []int {
+	ids, err := pq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pq *PetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pq.ctx, "Count")
+	if err := pq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pq, querierCount[*PetQuery](), pq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pq *PetQuery) CountX(ctx context.Context) int {
+	count, err := pq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pq *PetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pq.ctx, "Exist")
+	switch _, err := pq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pq *PetQuery) ExistX(ctx context.Context) bool {
+	exist, err := pq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pq *PetQuery) Clone() *PetQuery {
+	if pq == nil {
+		return nil
+	}
+	return &PetQuery{
+		config:     pq.config,
+		ctx:        pq.ctx.Clone(),
+		order:      append([]pet.OrderOption{}, pq.order...),
+		inters:     append([]Interceptor{}, pq.inters...),
+		predicates: append([]predicate.Pet{}, pq.predicates...),
+		withOwner:  pq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  pq.sql.Clone(),
+		path: pq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PetQuery) WithOwner(opts ...func(*UserQuery)) *PetQuery {
+	query := (&UserClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOwner = query
+	return pq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Pet.Query().
+//		GroupBy(pet.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pq *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
+	pq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PetGroupBy{build: pq}
+	grbuild.flds = &pq.ctx.Fields
+	grbuild.label = pet.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Pet.Query().
+//		Select(pet.FieldName).
+//		Scan(ctx, &v)
+func (pq *PetQuery) Select(fields ...string) *PetSelect {
+	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
+	sbuild := &PetSelect{PetQuery: pq}
+	sbuild.label = pet.Label
+	sbuild.flds, sbuild.scan = &pq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PetSelect configured with the given aggregations.
+func (pq *PetQuery) Aggregate(fns ...AggregateFunc) *PetSelect {
+	return pq.Select().Aggregate(fns...)
+}
+
+func (pq *PetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pq.ctx.Fields {
+		if !pet.ValidColumn(f) {
+			return &ValidationError{Name: f, Entity: "Pet", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pq.path != nil {
+		prev, err := pq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pq.sql = prev
+	}
+	return nil
+}
+
+func (pq *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, error) {
+	var (
+		nodes       = []*Pet{}
+		withFKs     = pq.withFKs
+		_spec       = pq.querySpec()
+		loadedTypes = [1]bool{
+			pq.withOwner != nil,
+		}
+	)
+	if pq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, pet.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Pet).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Pet{config: pq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pq.withOwner; query != nil {
+		if err := pq.loadOwner(ctx, query, nodes, nil,
+			func(n *Pet, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pq *PetQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*Pet, init func(*Pet), assign func(*Pet, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Pet)
+	for i := range nodes {
+		if nodes[i].user_pets == nil {
+			continue
+		}
+		fk := *nodes[i].user_pets
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "user_pets" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
+		}
+	}
+	query.predicates = preds
+	return nil
+}
+
+func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pq.querySpec()
+	_spec.Node.Columns = pq.ctx.Fields
+	if len(pq.ctx.Fields) > 0 {
+		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+}
+
+func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pet.Table, pet.Columns, sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt))
+	_spec.From = pq.sql
+	if unique := pq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pet.FieldID)
+		for i := range fields {
+			if fields[i] != pet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pq *PetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pq.driver.Dialect())
+	t1 := builder.Table(pet.Table)
+	columns := pq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pet.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pq.sql != nil {
+		selector = pq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pq.ctx.Unique != nil && *pq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pq.predicates {
+		p(selector)
+	}
+	for _, p := range pq.order {
+		p(selector)
+	}
+	if offset := pq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PetGroupBy is the group-by builder for Pet entities.
+type PetGroupBy struct {
+	selector
+	build  *PetQuery
+	having []*sql.Predicate
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pgb *PetGroupBy) Aggregate(fns ...AggregateFunc) *PetGroupBy {
+	pgb.fns = append(pgb.fns, fns...)
+	return pgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pgb *PetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pgb.build.ctx, "GroupBy")
+	if err := pgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PetQuery, *PetGroupBy](ctx, pgb.build, pgb, pgb.build.inters, v)
+}
+
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (pgb *PetGroupBy) Having(ps ...*sql.Predicate) *PetGroupBy {
+	pgb.having = append(pgb.having, ps...)
+	return pgb
+}
+
+func (pgb *PetGroupBy) sqlScan(ctx context.Context, root *PetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pgb.fns))
+	for _, fn := range pgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pgb.flds)+len(pgb.fns))
+		for _, f := range *pgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pgb.flds...)...)
+	if len(pgb.having) > 0 {
+		selector.Having(sql.And(pgb.having...))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PetSelect is the builder for selecting fields of Pet entities.
+type PetSelect struct {
+	*PetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ps *PetSelect) Aggregate(fns ...AggregateFunc) *PetSelect {
+	ps.fns = append(ps.fns, fns...)
+	return ps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ps *PetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ps.ctx, "Select")
+	if err := ps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PetQuery, *PetSelect](ctx, ps.PetQuery, ps, ps.inters, v)
+}
+
+func (ps *PetSelect) sqlScan(ctx context.Context, root *PetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ps.fns))
+	for _, fn := range ps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -127,6 +127,11 @@ func NameContainsFold(v string) predicate.City {
 	return predicate.City(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.City {
+	return predicate.City(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasStreets applies the HasEdge predicate on the "streets" edge.
 func HasStreets() predicate.City {
 	return predicate.City(func(s *sql.Selector) {
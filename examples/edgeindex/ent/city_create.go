@@ -79,10 +79,11 @@ func (cc *CityCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CityCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "City.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "City", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "City.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CityCreate) sqlSave(ctx context.Context) (*City, error) {
@@ -92,7 +93,7 @@ func (cc *CityCreate) sqlSave(ctx context.Context) (*City, error) {
 	_node, _spec := cc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -134,11 +135,15 @@ func (cc *CityCreate) createSpec() (*City, *sqlgraph.CreateSpec) {
 // CityCreateBulk is the builder for creating many City entities in bulk.
 type CityCreateBulk struct {
 	config
+	err      error
 	builders []*CityCreate
 }
 
 // Save creates the City entities in the database.
 func (ccb *CityCreateBulk) Save(ctx context.Context) ([]*City, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*City, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
@@ -163,7 +168,7 @@ func (ccb *CityCreateBulk) Save(ctx context.Context) ([]*City, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -127,6 +127,11 @@ func NameContainsFold(v string) predicate.Street {
 	return predicate.Street(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Street {
+	return predicate.Street(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // HasCity applies the HasEdge predicate on the "city" edge.
 func HasCity() predicate.Street {
 	return predicate.Street(func(s *sql.Selector) {
@@ -83,10 +83,11 @@ func (sc *StreetCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (sc *StreetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := sc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Street.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Street", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Street.name"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
@@ -96,7 +97,7 @@ func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
 	_node, _spec := sc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -139,11 +140,15 @@ func (sc *StreetCreate) createSpec() (*Street, *sqlgraph.CreateSpec) {
 // StreetCreateBulk is the builder for creating many Street entities in bulk.
 type StreetCreateBulk struct {
 	config
+	err      error
 	builders []*StreetCreate
 }
 
 // Save creates the Street entities in the database.
 func (scb *StreetCreateBulk) Save(ctx context.Context) ([]*Street, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
 	nodes := make([]*Street, len(scb.builders))
 	mutators := make([]Mutator, len(scb.builders))
@@ -168,7 +173,7 @@ func (scb *StreetCreateBulk) Save(ctx context.Context) ([]*Street, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
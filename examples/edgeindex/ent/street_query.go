@@ -357,7 +357,7 @@ func (sq *StreetQuery) prepareQuery(ctx context.Context) error {
 	}
 	for _, f := range sq.ctx.Fields {
 		if !street.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			return &ValidationError{Name: f, Entity: "Street", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if sq.path != nil {
@@ -425,23 +425,30 @@ func (sq *StreetQuery) loadCity(ctx context.Context, query *CityQuery, nodes []*
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
-	}
-	query.Where(city.IDIn(ids...))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
-		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "city_streets" returned %v`, n.ID)
+	size := sqlgraph.MaxInValues(query.driver.Dialect())
+	preds := query.predicates
+	for len(ids) > 0 {
+		chunk := ids
+		if size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		ids = ids[len(chunk):]
+		query.predicates = append(preds[:len(preds):len(preds)], city.IDIn(chunk...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return fmt.Errorf(`unexpected foreign-key "city_streets" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				assign(nodes[i], n)
+			}
 		}
 	}
+	query.predicates = preds
 	return nil
 }
 
@@ -529,7 +536,8 @@ func (sq *StreetQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // StreetGroupBy is the group-by builder for Street entities.
 type StreetGroupBy struct {
 	selector
-	build *StreetQuery
+	build  *StreetQuery
+	having []*sql.Predicate
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -547,6 +555,14 @@ func (sgb *StreetGroupBy) Scan(ctx context.Context, v any) error {
 	return scanWithInterceptors[*StreetQuery, *StreetGroupBy](ctx, sgb.build, sgb, sgb.build.inters, v)
 }
 
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (sgb *StreetGroupBy) Having(ps ...*sql.Predicate) *StreetGroupBy {
+	sgb.having = append(sgb.having, ps...)
+	return sgb
+}
+
 func (sgb *StreetGroupBy) sqlScan(ctx context.Context, root *StreetQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(sgb.fns))
@@ -562,6 +578,9 @@ func (sgb *StreetGroupBy) sqlScan(ctx context.Context, root *StreetQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*sgb.flds...)...)
+	if len(sgb.having) > 0 {
+		selector.Having(sql.And(sgb.having...))
+	}
 	if err := selector.Err(); err != nil {
 		return err
 	}
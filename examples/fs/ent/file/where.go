@@ -137,6 +137,11 @@ func NameContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // DeletedEQ applies the EQ predicate on the "deleted" field.
 func DeletedEQ(v bool) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldDeleted, v))
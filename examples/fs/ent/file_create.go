@@ -120,13 +120,14 @@ func (fc *FileCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (fc *FileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "File.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "File", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "File.name"`)})
 	}
 	if _, ok := fc.mutation.Deleted(); !ok {
-		return &ValidationError{Name: "deleted", err: errors.New(`ent: missing required field "File.deleted"`)}
+		errs = append(errs, &ValidationError{Name: "deleted", Entity: "File", Field: "deleted", Reason: "missing required field", err: errors.New(`ent: missing required field "File.deleted"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
@@ -136,7 +137,7 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 	_node, _spec := fc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -199,11 +200,15 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 // FileCreateBulk is the builder for creating many File entities in bulk.
 type FileCreateBulk struct {
 	config
+	err      error
 	builders []*FileCreate
 }
 
 // Save creates the File entities in the database.
 func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
 	nodes := make([]*File, len(fcb.builders))
 	mutators := make([]Mutator, len(fcb.builders))
@@ -229,7 +234,7 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
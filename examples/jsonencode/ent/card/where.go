@@ -126,6 +126,11 @@ func NumberContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldNumber, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Card) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
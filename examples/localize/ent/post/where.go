@@ -0,0 +1,233 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package post
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/examples/localize/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldID, id))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSlug, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldTitle))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldBody))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// SlugHasPrefixFold applies the HasPrefixFold predicate on the "slug" field.
+func SlugHasPrefixFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefixFold(FieldSlug, v))
+}
+
+// TitleHasKey applies the HasKey predicate on the "title" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func TitleHasKey(path ...string) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldTitle), sqljson.Path(path...)))
+	})
+}
+
+// TitleValueEQ checks that the value at the given path of the
+// "title" JSON field is equal to v.
+func TitleValueEQ(v any, path ...string) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldTitle), v, sqljson.Path(path...)))
+	})
+}
+
+// TitleContains checks that the value at the given path of the "title"
+// JSON field contains v. e.g. an array that contains the element v.
+func TitleContains(v any, path ...string) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldTitle), v, sqljson.Path(path...)))
+	})
+}
+
+// BodyHasKey applies the HasKey predicate on the "body" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func BodyHasKey(path ...string) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldBody), sqljson.Path(path...)))
+	})
+}
+
+// BodyValueEQ checks that the value at the given path of the
+// "body" JSON field is equal to v.
+func BodyValueEQ(v any, path ...string) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldBody), v, sqljson.Path(path...)))
+	})
+}
+
+// BodyContains checks that the value at the given path of the "body"
+// JSON field contains v. e.g. an array that contains the element v.
+func BodyContains(v any, path ...string) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldBody), v, sqljson.Path(path...)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Post) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Post) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Post) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,542 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/examples/localize/ent/post"
+	"entgo.io/ent/examples/localize/ent/predicate"
+	"entgo.io/ent/schema/field"
+)
+
+// PostQuery is the builder for querying Post entities.
+type PostQuery struct {
+	config
+	ctx        *QueryContext
+	order      []post.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Post
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PostQuery builder.
+func (pq *PostQuery) Where(ps ...predicate.Post) *PostQuery {
+	pq.predicates = append(pq.predicates, ps...)
+	return pq
+}
+
+// Limit the number of records to be returned by this query.
+func (pq *PostQuery) Limit(limit int) *PostQuery {
+	pq.ctx.Limit = &limit
+	return pq
+}
+
+// Offset to start from.
+func (pq *PostQuery) Offset(offset int) *PostQuery {
+	pq.ctx.Offset = &offset
+	return pq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pq *PostQuery) Unique(unique bool) *PostQuery {
+	pq.ctx.Unique = &unique
+	return pq
+}
+
+// Order specifies how the records should be ordered.
+func (pq *PostQuery) Order(o ...post.OrderOption) *PostQuery {
+	pq.order = append(pq.order, o...)
+	return pq
+}
+
+// First returns the first Post entity from the query.
+// Returns a *NotFoundError when no Post was found.
+func (pq *PostQuery) First(ctx context.Context) (*Post, error) {
+	nodes, err := pq.Limit(1).All(setContextOp(ctx, pq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{post.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pq *PostQuery) FirstX(ctx context.Context) *Post {
+	node, err := pq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Post ID from the query.
+// Returns a *NotFoundError when no Post ID was found.
+func (pq *PostQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pq.Limit(1).IDs(setContextOp(ctx, pq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{post.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pq *PostQuery) FirstIDX(ctx context.Context) int {
+	id, err := pq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Post entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Post entity is found.
+// Returns a *NotFoundError when no Post entities are found.
+func (pq *PostQuery) Only(ctx context.Context) (*Post, error) {
+	nodes, err := pq.Limit(2).All(setContextOp(ctx, pq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{post.Label}
+	default:
+		return nil, &NotSingularError{post.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pq *PostQuery) OnlyX(ctx context.Context) *Post {
+	node, err := pq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Post ID in the query.
+// Returns a *NotSingularError when more than one Post ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pq *PostQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pq.Limit(2).IDs(setContextOp(ctx, pq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{post.Label}
+	default:
+		err = &NotSingularError{post.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pq *PostQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Posts.
+func (pq *PostQuery) All(ctx context.Context) ([]*Post, error) {
+	ctx = setContextOp(ctx, pq.ctx, "All")
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Post, *PostQuery]()
+	return withInterceptors[[]*Post](ctx, pq, qr, pq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pq *PostQuery) AllX(ctx context.Context) []*Post {
+	nodes, err := pq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Post IDs.
+func (pq *PostQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pq.ctx.Unique == nil && pq.path != nil {
+		pq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pq.ctx, "IDs")
+	if err = pq.Select(post.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pq *PostQuery) IDsX(ctx context.Context) []int {
+	ids, err := pq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pq *PostQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pq.ctx, "Count")
+	if err := pq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pq, querierCount[*PostQuery](), pq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pq *PostQuery) CountX(ctx context.Context) int {
+	count, err := pq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pq *PostQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pq.ctx, "Exist")
+	switch _, err := pq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pq *PostQuery) ExistX(ctx context.Context) bool {
+	exist, err := pq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PostQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pq *PostQuery) Clone() *PostQuery {
+	if pq == nil {
+		return nil
+	}
+	return &PostQuery{
+		config:     pq.config,
+		ctx:        pq.ctx.Clone(),
+		order:      append([]post.OrderOption{}, pq.order...),
+		inters:     append([]Interceptor{}, pq.inters...),
+		predicates: append([]predicate.Post{}, pq.predicates...),
+		// clone intermediate query.
+		sql:  pq.sql.Clone(),
+		path: pq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Title map[string]string `json:"title,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Post.Query().
+//		GroupBy(post.FieldTitle).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pq *PostQuery) GroupBy(field string, fields ...string) *PostGroupBy {
+	pq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PostGroupBy{build: pq}
+	grbuild.flds = &pq.ctx.Fields
+	grbuild.label = post.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Title map[string]string `json:"title,omitempty"`
+//	}
+//
+//	client.Post.Query().
+//		Select(post.FieldTitle).
+//		Scan(ctx, &v)
+func (pq *PostQuery) Select(fields ...string) *PostSelect {
+	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
+	sbuild := &PostSelect{PostQuery: pq}
+	sbuild.label = post.Label
+	sbuild.flds, sbuild.scan = &pq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PostSelect configured with the given aggregations.
+func (pq *PostQuery) Aggregate(fns ...AggregateFunc) *PostSelect {
+	return pq.Select().Aggregate(fns...)
+}
+
+func (pq *PostQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pq.ctx.Fields {
+		if !post.ValidColumn(f) {
+			return &ValidationError{Name: f, Entity: "Post", Field: f, Reason: "invalid field for query", err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pq.path != nil {
+		prev, err := pq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pq.sql = prev
+	}
+	return nil
+}
+
+func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, error) {
+	var (
+		nodes = []*Post{}
+		_spec = pq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Post).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Post{config: pq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pq.querySpec()
+	_spec.Node.Columns = pq.ctx.Fields
+	if len(pq.ctx.Fields) > 0 {
+		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+}
+
+func (pq *PostQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(post.Table, post.Columns, sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt))
+	_spec.From = pq.sql
+	if unique := pq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, post.FieldID)
+		for i := range fields {
+			if fields[i] != post.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pq *PostQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pq.driver.Dialect())
+	t1 := builder.Table(post.Table)
+	columns := pq.ctx.Fields
+	if len(columns) == 0 {
+		columns = post.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pq.sql != nil {
+		selector = pq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pq.ctx.Unique != nil && *pq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pq.predicates {
+		p(selector)
+	}
+	for _, p := range pq.order {
+		p(selector)
+	}
+	if offset := pq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PostGroupBy is the group-by builder for Post entities.
+type PostGroupBy struct {
+	selector
+	build  *PostQuery
+	having []*sql.Predicate
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pgb *PostGroupBy) Aggregate(fns ...AggregateFunc) *PostGroupBy {
+	pgb.fns = append(pgb.fns, fns...)
+	return pgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pgb *PostGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pgb.build.ctx, "GroupBy")
+	if err := pgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostQuery, *PostGroupBy](ctx, pgb.build, pgb, pgb.build.inters, v)
+}
+
+// Having adds the given predicates to the HAVING clause of the group-by query for filtering
+// the groups using aggregate functions. For example, sql.GT(sql.Count("*"), 5). Multiple
+// predicates are combined using the AND operator.
+func (pgb *PostGroupBy) Having(ps ...*sql.Predicate) *PostGroupBy {
+	pgb.having = append(pgb.having, ps...)
+	return pgb
+}
+
+func (pgb *PostGroupBy) sqlScan(ctx context.Context, root *PostQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pgb.fns))
+	for _, fn := range pgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pgb.flds)+len(pgb.fns))
+		for _, f := range *pgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pgb.flds...)...)
+	if len(pgb.having) > 0 {
+		selector.Having(sql.And(pgb.having...))
+	}
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PostSelect is the builder for selecting fields of Post entities.
+type PostSelect struct {
+	*PostQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ps *PostSelect) Aggregate(fns ...AggregateFunc) *PostSelect {
+	ps.fns = append(ps.fns, fns...)
+	return ps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ps *PostSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ps.ctx, "Select")
+	if err := ps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostQuery, *PostSelect](ctx, ps.PostQuery, ps, ps.inters, v)
+}
+
+func (ps *PostSelect) sqlScan(ctx context.Context, root *PostQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ps.fns))
+	for _, fn := range ps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,31 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Post holds the schema definition for the Post entity.
+type Post struct {
+	ent.Schema
+}
+
+// Mixin of the Post.
+func (Post) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Localized("title", "body"),
+	}
+}
+
+// Fields of the Post.
+func (Post) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").
+			Unique(),
+	}
+}
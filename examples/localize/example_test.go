@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/examples/localize/ent"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Example_localize() {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	// Run the auto migration tool.
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	if err := Do(ctx, client); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// en: Hello
+	// de: Hallo
+	// de-AT: Hallo
+	// fr: Hello
+	// body: Hello, World!
+}
+
+func Do(ctx context.Context, client *ent.Client) error {
+	p, err := client.Post.
+		Create().
+		SetSlug("hello").
+		SetTitle(map[string]string{"en": "Hello", "de": "Hallo"}).
+		SetBody(map[string]string{"en": "Hello, World!"}).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("creating post: %w", err)
+	}
+	// Localized fields are stored as JSON objects keyed by locale.
+	p, err = client.Post.Get(ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("getting post: %w", err)
+	}
+	// Without a locale in the context, the DefaultLocale is used.
+	fmt.Println("en:", p.LocalizedTitle(ctx))
+	fmt.Println("de:", p.LocalizedTitle(ent.WithLocale(ctx, "de")))
+	// Regional locales fall back to their base language,
+	// and missing locales fall back to the DefaultLocale.
+	fmt.Println("de-AT:", p.LocalizedTitle(ent.WithLocale(ctx, "de-AT")))
+	fmt.Println("fr:", p.LocalizedTitle(ent.WithLocale(ctx, "fr")))
+	fmt.Println("body:", p.LocalizedBody(ent.WithLocale(ctx, "de")))
+	return nil
+}
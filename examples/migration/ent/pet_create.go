@@ -116,19 +116,20 @@ func (pc *PetCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := pc.mutation.BestFriendID(); !ok {
-		return &ValidationError{Name: "best_friend_id", err: errors.New(`ent: missing required field "Pet.best_friend_id"`)}
+		errs = append(errs, &ValidationError{Name: "best_friend_id", Entity: "Pet", Field: "best_friend_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.best_friend_id"`)})
 	}
 	if _, ok := pc.mutation.OwnerID(); !ok {
-		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Pet.owner_id"`)}
+		errs = append(errs, &ValidationError{Name: "owner_id", Entity: "Pet", Field: "owner_id", Reason: "missing required field", err: errors.New(`ent: missing required field "Pet.owner_id"`)})
 	}
 	if _, ok := pc.mutation.BestFriendID(); !ok {
-		return &ValidationError{Name: "best_friend", err: errors.New(`ent: missing required edge "Pet.best_friend"`)}
+		errs = append(errs, &ValidationError{Name: "best_friend", Entity: "Pet", Field: "best_friend", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Pet.best_friend"`)})
 	}
 	if _, ok := pc.mutation.OwnerID(); !ok {
-		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Pet.owner"`)}
+		errs = append(errs, &ValidationError{Name: "owner", Entity: "Pet", Field: "owner", Reason: "missing required edge", err: errors.New(`ent: missing required edge "Pet.owner"`)})
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
@@ -138,7 +139,7 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 	_node, _spec := pc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -203,11 +204,15 @@ func (pc *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
 // PetCreateBulk is the builder for creating many Pet entities in bulk.
 type PetCreateBulk struct {
 	config
+	err      error
 	builders []*PetCreate
 }
 
 // Save creates the Pet entities in the database.
 func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Pet, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
@@ -233,7 +238,7 @@ func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -9,6 +9,7 @@ package user
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/examples/migration/ent/predicate"
 )
 
@@ -172,6 +173,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // TagsIsNil applies the IsNil predicate on the "tags" field.
 func TagsIsNil() predicate.User {
 	return predicate.User(sql.FieldIsNull(FieldTags))
@@ -182,6 +188,30 @@ func TagsNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldTags))
 }
 
+// TagsHasKey applies the HasKey predicate on the "tags" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func TagsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldTags), sqljson.Path(path...)))
+	})
+}
+
+// TagsValueEQ checks that the value at the given path of the
+// "tags" JSON field is equal to v.
+func TagsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldTags), v, sqljson.Path(path...)))
+	})
+}
+
+// TagsContains checks that the value at the given path of the "tags"
+// JSON field contains v. e.g. an array that contains the element v.
+func TagsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldTags), v, sqljson.Path(path...)))
+	})
+}
+
 // HasCards applies the HasEdge predicate on the "cards" edge.
 func HasCards() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
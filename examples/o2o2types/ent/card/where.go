@@ -174,6 +174,17 @@ func NumberContainsFold(v string) predicate.Card {
 	return predicate.Card(sql.FieldContainsFold(FieldNumber, v))
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(sql.FieldHasPrefixFold(FieldNumber, v))
+}
+
+// ExpiredWithinLast applies the WithinLast predicate on the "expired" field.
+// It matches values that are within the given duration before the current time.
+func ExpiredWithinLast(d time.Duration) predicate.Card {
+	return predicate.Card(sql.FieldWithinLast(FieldExpired, d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
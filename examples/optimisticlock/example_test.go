@@ -0,0 +1,82 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/examples/optimisticlock/ent"
+	"entgo.io/ent/examples/optimisticlock/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Example_optimisticLock() {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	// Run the auto migration tool.
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	if err := Do(ctx, client); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// user: a8m version: 0
+	// user: Ariel version: 1
+	// conflict: true
+	// conflict: true
+	// user: Ariel version: 1
+	// affected: 1
+	// user: Mashraki version: 2
+}
+
+func Do(ctx context.Context, client *ent.Client) error {
+	a8m, err := client.User.
+		Create().
+		SetName("a8m").
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("creating user: %w", err)
+	}
+	fmt.Println("user:", a8m.Name, "version:", a8m.Version)
+	// Updating the user increments its version.
+	updated, err := client.User.UpdateOne(a8m).SetName("Ariel").Save(ctx)
+	if err != nil {
+		return fmt.Errorf("updating user: %w", err)
+	}
+	fmt.Println("user:", updated.Name, "version:", updated.Version)
+	// The stale copy of the user fails to update it, because it was
+	// changed since it was read, and the change is not applied.
+	_, err = client.User.UpdateOne(a8m).SetName("Mashraki").Save(ctx)
+	fmt.Println("conflict:", ent.IsConflictError(err))
+	// Updates by ID read the current version of the user when they are saved,
+	// and the version that was read by the caller should be passed explicitly.
+	_, err = client.User.UpdateOneID(a8m.ID).Where(user.Version(a8m.Version)).SetName("Mashraki").Save(ctx)
+	fmt.Println("conflict:", ent.IsConflictError(err))
+	current, err := client.User.Get(ctx, a8m.ID)
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	fmt.Println("user:", current.Name, "version:", current.Version)
+	// Bulk updates increment the version of the updated users as well.
+	n, err := client.User.Update().Where(user.Version(current.Version)).SetName("Mashraki").Save(ctx)
+	if err != nil {
+		return fmt.Errorf("updating users: %w", err)
+	}
+	fmt.Println("affected:", n)
+	current, err = client.User.Get(ctx, a8m.ID)
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	fmt.Println("user:", current.Name, "version:", current.Version)
+	return nil
+}
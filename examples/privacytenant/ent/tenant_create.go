@@ -63,15 +63,16 @@ func (tc *TenantCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (tc *TenantCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Tenant.name"`)}
+		errs = append(errs, &ValidationError{Name: "name", Entity: "Tenant", Field: "name", Reason: "missing required field", err: errors.New(`ent: missing required field "Tenant.name"`)})
 	}
 	if v, ok := tc.mutation.Name(); ok {
 		if err := tenant.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)}
+			errs = append(errs, &ValidationError{Name: "name", Entity: "Tenant", Field: "name", Reason: err.Error(), err: fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)})
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TenantCreate) sqlSave(ctx context.Context) (*Tenant, error) {
@@ -81,7 +82,7 @@ func (tc *TenantCreate) sqlSave(ctx context.Context) (*Tenant, error) {
 	_node, _spec := tc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+			err = newConstraintError(err)
 		}
 		return nil, err
 	}
@@ -107,11 +108,15 @@ func (tc *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 // TenantCreateBulk is the builder for creating many Tenant entities in bulk.
 type TenantCreateBulk struct {
 	config
+	err      error
 	builders []*TenantCreate
 }
 
 // Save creates the Tenant entities in the database.
 func (tcb *TenantCreateBulk) Save(ctx context.Context) ([]*Tenant, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Tenant, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
@@ -136,7 +141,7 @@ func (tcb *TenantCreateBulk) Save(ctx context.Context) ([]*Tenant, error) {
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
+							err = newConstraintError(err)
 						}
 					}
 				}
@@ -9,6 +9,7 @@ package user
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/examples/privacytenant/ent/predicate"
 )
 
@@ -152,6 +153,11 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefixFold(FieldName, v))
+}
+
 // FoodsIsNil applies the IsNil predicate on the "foods" field.
 func FoodsIsNil() predicate.User {
 	return predicate.User(sql.FieldIsNull(FieldFoods))
@@ -162,6 +168,30 @@ func FoodsNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldFoods))
 }
 
+// FoodsHasKey applies the HasKey predicate on the "foods" JSON field.
+// The key is given as a path of keys. e.g. ("a", "b") checks for the key "a.b".
+func FoodsHasKey(path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(FieldFoods), sqljson.Path(path...)))
+	})
+}
+
+// FoodsValueEQ checks that the value at the given path of the
+// "foods" JSON field is equal to v.
+func FoodsValueEQ(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldFoods), v, sqljson.Path(path...)))
+	})
+}
+
+// FoodsContains checks that the value at the given path of the "foods"
+// JSON field contains v. e.g. an array that contains the element v.
+func FoodsContains(v any, path ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(FieldFoods), v, sqljson.Path(path...)))
+	})
+}
+
 // HasTenant applies the HasEdge predicate on the "tenant" edge.
 func HasTenant() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
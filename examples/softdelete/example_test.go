@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/examples/softdelete/ent"
+	"entgo.io/ent/examples/softdelete/ent/pet"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Example_softDelete() {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	// Run the auto migration tool.
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	if err := Do(ctx, client); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// soft-deleted: 1
+	// pets: [Luna]
+	// owner pets: 1
+	// all pets: [Lola Luna]
+	// deleted: Lola
+	// purged: 1
+	// all pets: [Luna]
+}
+
+func Do(ctx context.Context, client *ent.Client) error {
+	a8m, err := client.User.
+		Create().
+		SetName("a8m").
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("creating user: %w", err)
+	}
+	if _, err := client.Pet.CreateBulk(
+		client.Pet.Create().SetName("Lola").SetOwner(a8m),
+		client.Pet.Create().SetName("Luna").SetOwner(a8m),
+	).Save(ctx); err != nil {
+		return fmt.Errorf("creating pets: %w", err)
+	}
+	// Deleting a pet sets its "deleted_at" field
+	// instead of removing it from the database.
+	n, err := client.Pet.Delete().Where(pet.Name("Lola")).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting pet: %w", err)
+	}
+	fmt.Println("soft-deleted:", n)
+	// Queries and edge traversals skip the soft-deleted pets.
+	names, err := client.Pet.Query().Select(pet.FieldName).Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("querying pets: %w", err)
+	}
+	fmt.Println("pets:", names)
+	count, err := a8m.QueryPets().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("counting owner pets: %w", err)
+	}
+	fmt.Println("owner pets:", count)
+	// SkipSoftDelete returns the soft-deleted pets as well.
+	names, err = client.Pet.Query().Order(ent.Asc(pet.FieldName)).Select(pet.FieldName).Strings(ent.SkipSoftDelete(ctx))
+	if err != nil {
+		return fmt.Errorf("querying all pets: %w", err)
+	}
+	fmt.Println("all pets:", names)
+	deleted, err := client.Pet.Query().Where(pet.DeletedAtNotNil()).Only(ent.SkipSoftDelete(ctx))
+	if err != nil {
+		return fmt.Errorf("querying deleted pet: %w", err)
+	}
+	fmt.Println("deleted:", deleted.Name)
+	// Purge removes the soft-deleted pets from the database.
+	n, err = client.Pet.Purge().Where(pet.DeletedAtNotNil()).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("purging pets: %w", err)
+	}
+	fmt.Println("purged:", n)
+	names, err = client.Pet.Query().Select(pet.FieldName).Strings(ent.SkipSoftDelete(ctx))
+	if err != nil {
+		return fmt.Errorf("querying all pets: %w", err)
+	}
+	fmt.Println("all pets:", names)
+	return nil
+}
@@ -134,6 +134,11 @@ func ModelContainsFold(v string) predicate.Car {
 	return predicate.Car(sql.FieldContainsFold(FieldModel, v))
 }
 
+// ModelHasPrefixFold applies the HasPrefixFold predicate on the "model" field.
+func ModelHasPrefixFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefixFold(FieldModel, v))
+}
+
 // RegisteredAtEQ applies the EQ predicate on the "registered_at" field.
 func RegisteredAtEQ(v time.Time) predicate.Car {
 	return predicate.Car(sql.FieldEQ(FieldRegisteredAt, v))
@@ -174,6 +179,12 @@ func RegisteredAtLTE(v time.Time) predicate.Car {
 	return predicate.Car(sql.FieldLTE(FieldRegisteredAt, v))
 }
 
+// RegisteredAtWithinLast applies the WithinLast predicate on the "registered_at" field.
+// It matches values that are within the given duration before the current time.
+func RegisteredAtWithinLast(d time.Duration) predicate.Car {
+	return predicate.Car(sql.FieldWithinLast(FieldRegisteredAt, d))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -30,6 +30,42 @@ type Annotation struct {
 	//	}
 	//
 	ID []string
+
+	// Localized marks a JSON field of type map[string]string as a localized string field
+	// that holds its values keyed by locale. See mixin.Localized for more info.
+	//
+	//	field.JSON("title", map[string]string{}).
+	//		Annotations(
+	//			field.Annotation{Localized: true},
+	//		)
+	//
+	Localized bool `json:",omitempty"`
+
+	// SoftDelete marks an optional and nillable time field as the soft-delete marker of the schema.
+	// Deletions set the field to the current time instead of removing the rows, and queries filter
+	// out the rows where it is set. See mixin.SoftDelete for more info.
+	//
+	//	field.Time("deleted_at").
+	//		Optional().
+	//		Nillable().
+	//		Annotations(
+	//			field.Annotation{SoftDelete: true},
+	//		)
+	//
+	SoftDelete bool `json:",omitempty"`
+
+	// Version marks an integer field as the version of the schema entities, used for optimistic
+	// concurrency control. Updates increment the field, and updates of a single entity fail with
+	// a conflict error if it was changed since it was read. See mixin.Version for more info.
+	//
+	//	field.Int("version").
+	//		Default(0).
+	//		Immutable().
+	//		Annotations(
+	//			field.Annotation{Version: true},
+	//		)
+	//
+	Version bool `json:",omitempty"`
 }
 
 // ID defines a multi-field schema identifier. Note, the
@@ -72,6 +108,15 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if len(ant.ID) > 0 {
 		a.ID = ant.ID
 	}
+	if ant.Localized {
+		a.Localized = true
+	}
+	if ant.SoftDelete {
+		a.SoftDelete = true
+	}
+	if ant.Version {
+		a.Version = true
+	}
 	return a
 }
 
@@ -0,0 +1,140 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeyProvider provides the keys for encrypting and decrypting the values of encrypted fields.
+// Keys are identified by IDs that are stored alongside the encrypted values. Hence, keys can be
+// rotated by changing the encryption key, as long as the previous keys are still provided for
+// decrypting values that were encrypted with them.
+type KeyProvider interface {
+	// EncryptionKey returns the ID and the key for encrypting values.
+	EncryptionKey() (id string, key []byte, err error)
+	// DecryptionKey returns the key with the given ID for decrypting values.
+	DecryptionKey(id string) ([]byte, error)
+}
+
+// StaticKeys returns a KeyProvider that encrypts values using the key with the given ID,
+// and decrypts values using all given keys. Keys must be 16, 24 or 32 bytes long, in order
+// to select AES-128, AES-192 or AES-256.
+//
+//	field.StaticKeys("v2", map[string][]byte{
+//		"v1": oldKey,
+//		"v2": newKey,
+//	})
+func StaticKeys(current string, keys map[string][]byte) KeyProvider {
+	return staticKeys{current: current, keys: keys}
+}
+
+type staticKeys struct {
+	current string
+	keys    map[string][]byte
+}
+
+func (s staticKeys) EncryptionKey() (string, []byte, error) {
+	key, err := s.DecryptionKey(s.current)
+	return s.current, key, err
+}
+
+func (s staticKeys) DecryptionKey(id string) ([]byte, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("field: unknown encryption key %q", id)
+	}
+	return key, nil
+}
+
+// encryptionVersion is the version of the format of encrypted values:
+//
+//	version (1 byte) | key id length (1 byte) | key id | nonce | sealed value
+const encryptionVersion = 1
+
+// EncryptedValueScanner is a TypeValueScanner that encrypts values using AES-GCM before they
+// are stored in the database, and decrypts them after they are scanned. It is used by the
+// Encrypted option of string and bytes fields.
+type EncryptedValueScanner[T ~string | ~[]byte] struct {
+	Keys KeyProvider
+}
+
+// Value implements the TypeValueScanner.Value method.
+func (e EncryptedValueScanner[T]) Value(v T) (driver.Value, error) {
+	id, key, err := e.Keys.EncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("field: encryption key id %q exceeds 255 bytes", id)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 2+len(id)+aead.NonceSize()+len(v)+aead.Overhead())
+	b = append(b, encryptionVersion, byte(len(id)))
+	b = append(b, id...)
+	nonce := b[len(b) : len(b)+aead.NonceSize()]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	b = b[:len(b)+aead.NonceSize()]
+	// The key id and the version are authenticated as additional data.
+	return aead.Seal(b, nonce, []byte(v), b[:2+len(id)]), nil
+}
+
+// ScanValue implements the TypeValueScanner.ScanValue method.
+func (EncryptedValueScanner[T]) ScanValue() ValueScanner {
+	return &sql.NullString{}
+}
+
+// FromValue implements the TypeValueScanner.FromValue method.
+func (e EncryptedValueScanner[T]) FromValue(v driver.Value) (tv T, err error) {
+	s, ok := v.(*sql.NullString)
+	if !ok {
+		return tv, fmt.Errorf("unexpected input for FromValue: %T", v)
+	}
+	if !s.Valid {
+		return tv, nil
+	}
+	b := []byte(s.String)
+	if len(b) < 2 || b[0] != encryptionVersion || len(b) < 2+int(b[1]) {
+		return tv, errors.New("field: invalid encrypted value")
+	}
+	n := 2 + int(b[1])
+	key, err := e.Keys.DecryptionKey(string(b[2:n]))
+	if err != nil {
+		return tv, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return tv, err
+	}
+	if len(b) < n+aead.NonceSize() {
+		return tv, errors.New("field: invalid encrypted value")
+	}
+	plain, err := aead.Open(nil, b[n:n+aead.NonceSize()], b[n+aead.NonceSize():], b[:n])
+	if err != nil {
+		return tv, fmt.Errorf("field: decrypting value: %w", err)
+	}
+	return T(plain), nil
+}
+
+// newAEAD returns an AES-GCM cipher for the given key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -199,6 +199,18 @@ func (b *stringBuilder) Sensitive() *stringBuilder {
 	return b
 }
 
+// Encrypted encrypts the field values using AES-GCM before they are stored in the
+// database, and decrypts them after they are loaded. The column is stored as bytes,
+// and predicates on the field values are not generated.
+//
+//	field.String("ssn").
+//		Encrypted(field.StaticKeys("v1", keys))
+func (b *stringBuilder) Encrypted(kp KeyProvider) *stringBuilder {
+	b.desc.Encrypted = true
+	b.desc.ValueScanner = EncryptedValueScanner[string]{Keys: kp}
+	return b
+}
+
 // Match adds a regex matcher for this field. Operation fails if the regex fails.
 func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
@@ -357,6 +369,7 @@ func (b *stringBuilder) Descriptor() *Descriptor {
 		b.desc.checkDefaultFunc(stringType)
 	}
 	b.desc.checkGoType(stringType)
+	b.desc.checkEncrypted()
 	return b.desc
 }
 
@@ -601,6 +614,18 @@ func (b *bytesBuilder) Sensitive() *bytesBuilder {
 	return b
 }
 
+// Encrypted encrypts the field values using AES-GCM before they are stored in the
+// database, and decrypts them after they are loaded. Predicates on the field values
+// are not generated.
+//
+//	field.Bytes("token").
+//		Encrypted(field.StaticKeys("v1", keys))
+func (b *bytesBuilder) Encrypted(kp KeyProvider) *bytesBuilder {
+	b.desc.Encrypted = true
+	b.desc.ValueScanner = EncryptedValueScanner[[]byte]{Keys: kp}
+	return b
+}
+
 // Unique makes the field unique within all vertices of this type.
 // Only supported in PostgreSQL.
 func (b *bytesBuilder) Unique() *bytesBuilder {
@@ -717,6 +742,7 @@ func (b *bytesBuilder) Descriptor() *Descriptor {
 		b.desc.checkDefaultFunc(bytesType)
 	}
 	b.desc.checkGoType(bytesType)
+	b.desc.checkEncrypted()
 	return b.desc
 }
 
@@ -1297,6 +1323,7 @@ type Descriptor struct {
 	StorageKey    string                  // sql column or gremlin property.
 	Enums         []struct{ N, V string } // enum values.
 	Sensitive     bool                    // sensitive info string field.
+	Encrypted     bool                    // encrypted at rest.
 	SchemaType    map[string]string       // override the schema type.
 	Annotations   []schema.Annotation     // field annotations.
 	Comment       string                  // field comment.
@@ -1359,6 +1386,14 @@ func (d *Descriptor) checkGoType(expectType reflect.Type) {
 	}
 }
 
+// checkEncrypted checks the options of encrypted fields. Encrypted values are
+// randomized, and therefore, they cannot be compared in the database.
+func (d *Descriptor) checkEncrypted() {
+	if d.Encrypted && d.Unique && d.Err == nil {
+		d.Err = fmt.Errorf("encrypted field %q cannot be unique", d.Name)
+	}
+}
+
 // pkgName returns the package name from a Go
 // identifier with a package qualifier.
 func pkgName(ident string) string {
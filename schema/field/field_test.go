@@ -346,6 +346,68 @@ func TestString_ValueScanner(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestString_Encrypted(t *testing.T) {
+	k1, k2 := []byte("0123456789abcdef"), []byte("fedcba9876543210fedcba9876543210")
+	fd := field.String("ssn").
+		Encrypted(field.StaticKeys("v1", map[string][]byte{"v1": k1})).
+		Descriptor()
+	require.NoError(t, fd.Err)
+	require.True(t, fd.Encrypted)
+	vs, ok := fd.ValueScanner.(field.TypeValueScanner[string])
+	require.True(t, ok)
+	v1, err := vs.Value("123-45-6789")
+	require.NoError(t, err)
+	require.NotContains(t, string(v1.([]byte)), "123-45-6789")
+	v2, err := vs.Value("123-45-6789")
+	require.NoError(t, err)
+	require.NotEqual(t, v1, v2, "values should be encrypted with random nonces")
+
+	// Rotate the encryption key, and keep the previous one for decryption.
+	vs = field.String("ssn").
+		Encrypted(field.StaticKeys("v2", map[string][]byte{"v1": k1, "v2": k2})).
+		Descriptor().ValueScanner.(field.TypeValueScanner[string])
+	s, err := vs.FromValue(&sql.NullString{String: string(v1.([]byte)), Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, "123-45-6789", s)
+	v2, err = vs.Value("987-65-4321")
+	require.NoError(t, err)
+	s, err = vs.FromValue(&sql.NullString{String: string(v2.([]byte)), Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, "987-65-4321", s)
+	s, err = vs.FromValue(&sql.NullString{})
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	// Values encrypted with unknown or tampered keys fail to decrypt.
+	vs = field.String("ssn").
+		Encrypted(field.StaticKeys("v1", map[string][]byte{"v1": k1})).
+		Descriptor().ValueScanner.(field.TypeValueScanner[string])
+	_, err = vs.FromValue(&sql.NullString{String: string(v2.([]byte)), Valid: true})
+	require.Error(t, err)
+	b := v1.([]byte)
+	b[len(b)-1] ^= 1
+	_, err = vs.FromValue(&sql.NullString{String: string(b), Valid: true})
+	require.Error(t, err)
+
+	fd = field.Bytes("token").
+		Encrypted(field.StaticKeys("v1", map[string][]byte{"v1": k1})).
+		Descriptor()
+	require.NoError(t, fd.Err)
+	bvs, ok := fd.ValueScanner.(field.TypeValueScanner[[]byte])
+	require.True(t, ok)
+	v1, err = bvs.Value([]byte("secret"))
+	require.NoError(t, err)
+	b, err = bvs.FromValue(&sql.NullString{String: string(v1.([]byte)), Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), b)
+
+	fd = field.String("ssn").
+		Unique().
+		Encrypted(field.StaticKeys("v1", map[string][]byte{"v1": k1})).
+		Descriptor()
+	require.EqualError(t, fd.Err, `encrypted field "ssn" cannot be unique`)
+}
+
 func TestSlices(t *testing.T) {
 	fd := field.Strings("strings").
 		Default([]string{}).
@@ -88,6 +88,87 @@ func (Time) Fields() []ent.Field {
 // time mixin must implement `Mixin` interface.
 var _ ent.Mixin = (*Time)(nil)
 
+// Localized returns a mixin that adds localized string fields to the schema. Each field holds its
+// values keyed by locale in a JSON object (e.g. {"en": "Hello", "de": "Hallo"}), and the "localize"
+// feature-flag generates helpers for resolving them by the locale of the context.
+//
+//	func (Post) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.Localized("title", "body"),
+//		}
+//	}
+func Localized(fields ...string) ent.Mixin {
+	return localized{fields: fields}
+}
+
+type localized struct {
+	Schema
+	fields []string
+}
+
+// Fields of the localized mixin.
+func (l localized) Fields() []ent.Field {
+	fields := make([]ent.Field, 0, len(l.fields))
+	for _, name := range l.fields {
+		fields = append(fields, field.JSON(name, map[string]string{}).
+			Optional().
+			Annotations(field.Annotation{Localized: true}),
+		)
+	}
+	return fields
+}
+
+// SoftDelete adds the "deleted_at" field that marks the schema as soft-deleted. The generated
+// delete builders of schemas that use it set the field to the current time instead of removing
+// the rows, and the generated queries return only the rows where it is not set. Rows are removed
+// from the database using the Purge builder, and SkipSoftDelete(ctx) disables the behavior.
+//
+//	func (User) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.SoftDelete{},
+//		}
+//	}
+type SoftDelete struct{ Schema }
+
+// Fields of the soft-delete mixin.
+func (SoftDelete) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Annotations(field.Annotation{SoftDelete: true}),
+	}
+}
+
+// soft-delete mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*SoftDelete)(nil)
+
+// Version adds the "version" field that is used for optimistic concurrency control. The generated
+// update builders of schemas that use it increment the field, and updating a single entity
+// fails with a ConflictError if its version was changed by a concurrent writer since it was read.
+// Note that updates by ID (UpdateOneID) read the version when they are saved, and the version that
+// was read by the caller should be passed to them explicitly using a Where predicate.
+//
+//	func (User) Mixin() []ent.Mixin {
+//		return []ent.Mixin{
+//			mixin.Version{},
+//		}
+//	}
+type Version struct{ Schema }
+
+// Fields of the version mixin.
+func (Version) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Default(0).
+			Immutable().
+			Annotations(field.Annotation{Version: true}),
+	}
+}
+
+// version mixin must implement `Mixin` interface.
+var _ ent.Mixin = (*Version)(nil)
+
 // AnnotateFields adds field annotations to underlying mixin fields.
 func AnnotateFields(m ent.Mixin, annotations ...schema.Annotation) ent.Mixin {
 	return fieldAnnotator{Mixin: m, annotations: annotations}
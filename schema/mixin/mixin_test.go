@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
 
 	"github.com/stretchr/testify/assert"
@@ -45,6 +46,46 @@ func TestTimeMixin(t *testing.T) {
 	})
 }
 
+func TestLocalized(t *testing.T) {
+	fields := mixin.Localized("title", "body").Fields()
+	require.Len(t, fields, 2)
+	for i, name := range []string{"title", "body"} {
+		desc := fields[i].Descriptor()
+		require.NoError(t, desc.Err)
+		assert.Equal(t, name, desc.Name)
+		assert.Equal(t, "map[string]string", desc.Info.Ident)
+		assert.True(t, desc.Optional)
+		require.Len(t, desc.Annotations, 1)
+		assert.Equal(t, field.Annotation{Localized: true}, desc.Annotations[0])
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	fields := mixin.SoftDelete{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	require.NoError(t, desc.Err)
+	assert.Equal(t, "deleted_at", desc.Name)
+	assert.Equal(t, field.TypeTime, desc.Info.Type)
+	assert.True(t, desc.Optional)
+	assert.True(t, desc.Nillable)
+	require.Len(t, desc.Annotations, 1)
+	assert.Equal(t, field.Annotation{SoftDelete: true}, desc.Annotations[0])
+}
+
+func TestVersion(t *testing.T) {
+	fields := mixin.Version{}.Fields()
+	require.Len(t, fields, 1)
+	desc := fields[0].Descriptor()
+	require.NoError(t, desc.Err)
+	assert.Equal(t, "version", desc.Name)
+	assert.Equal(t, field.TypeInt, desc.Info.Type)
+	assert.Equal(t, 0, desc.Default)
+	assert.True(t, desc.Immutable)
+	require.Len(t, desc.Annotations, 1)
+	assert.Equal(t, field.Annotation{Version: true}, desc.Annotations[0])
+}
+
 type annotation string
 
 func (annotation) Name() string { return "" }